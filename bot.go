@@ -0,0 +1,155 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"flag"
+	"github.com/neko-neko/lgtmgen/config"
+	"github.com/neko-neko/lgtmgen/fetcher"
+	"github.com/neko-neko/lgtmgen/github"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/mask_image"
+	"github.com/neko-neko/lgtmgen/provider"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// DefaultUploader is default uploader of bot command
+const DefaultUploader = "imgur"
+
+// Run chat bot server
+func (cli *CLI) runBot(args []string) int {
+	var (
+		addr         string
+		useGitHub    bool
+		token        string
+		secret       string
+		providerName string
+		apiKey       string
+		upload       string
+		uploadKey    string
+		maskPath     string
+		style        string
+		text         string
+	)
+
+	// load config file
+	conf, err := cli.loadConfig(args[1:])
+	if err != nil {
+		return ExitCodeError
+	}
+
+	// Define option flag parse
+	flags := flag.NewFlagSet(Name+" bot", flag.ContinueOnError)
+	flags.SetOutput(cli.errStream)
+
+	flags.String("config", "", "Config file path (default ~/"+config.FileName+")")
+
+	flags.StringVar(&addr, "addr", DefaultAddr, "Listen address")
+	flags.StringVar(&addr, "a", DefaultAddr, "Listen address(Short)")
+
+	flags.BoolVar(&useGitHub, "github", false, "Run GitHub webhook bot on /github/webhook")
+	flags.StringVar(&token, "github-token", os.Getenv("GITHUB_TOKEN"), "GitHub token to post comments")
+	flags.StringVar(&secret, "webhook-secret", os.Getenv("GITHUB_WEBHOOK_SECRET"), "GitHub webhook secret to verify payloads(required)")
+
+	providers := strings.Join(provider.Names(), ", ")
+	flags.StringVar(&providerName, "provider", DefaultProvider, "Random image provider used without source image("+providers+")")
+	flags.StringVar(&apiKey, "key", os.Getenv("LGTMGEN_API_KEY"), "API key of provider")
+
+	flags.StringVar(&upload, "upload", stringOr(conf.Upload, DefaultUploader), "Uploader of generated images")
+	flags.StringVar(&uploadKey, "upload-key", stringOr(conf.UploadKey, os.Getenv("LGTMGEN_UPLOAD_KEY")), "Client ID or key of uploader")
+
+	flags.StringVar(&maskPath, "mask", conf.Mask, "Mask image path or embedded asset name. Overrides style")
+	flags.StringVar(&maskPath, "m", conf.Mask, "Mask image path or embedded asset name(Short)")
+
+	styles := strings.Join(mask_image.StyleNames(), ", ")
+	flags.StringVar(&style, "style", stringOr(conf.Style, mask_image.DefaultStyle), "Mask style("+styles+" or user mask name)")
+
+	flags.StringVar(&text, "text", conf.Text, "Render text instead of mask image")
+	flags.StringVar(&text, "t", conf.Text, "Render text instead of mask image(Short)")
+
+	// Parse commandline flag
+	if err := flags.Parse(args[1:]); err != nil {
+		return ExitCodeError
+	}
+
+	// has platform?
+	if !useGitHub {
+		cli.log.Errorf("bot platform is required(--github).")
+		return ExitCodeError
+	}
+
+	// has token?
+	if token == "" {
+		cli.log.Errorf("github token is required.")
+		return ExitCodeError
+	}
+
+	// has secret?
+	if secret == "" {
+		cli.log.Errorf("webhook secret is required.")
+		return ExitCodeError
+	}
+
+	// load mask image
+	mask, err := loadMask(maskPath, style, text, textOptions{})
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+
+	p, err := provider.NewProvider(providerName, apiKey)
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+	up, err := newUploader(upload, uploadKey)
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+	if up == nil {
+		cli.log.Errorf("uploader is required.")
+		return ExitCodeError
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/github/webhook", &github.WebhookHandler{
+		Secret:   secret,
+		Client:   github.NewClient(token),
+		Fetcher:  fetcher.NewPublicFetcher(),
+		Provider: p,
+		Uploader: up,
+		Options: []lgtm.Option{
+			lgtm.WithMask(mask.MaskImage),
+		},
+		Logger: log.New(cli.errStream, "", log.LstdFlags),
+	})
+
+	cli.log.Infof("listening on %s", addr)
+	if err := newHTTPServer(addr, mux).ListenAndServe(); err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+
+	return ExitCodeOK
+}
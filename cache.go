@@ -0,0 +1,225 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"image"
+	"image/draw"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// CacheFileName is file name of cache in cache directory
+const CacheFileName = "cache.json"
+
+// ErrUnchanged is reported when input and options are same as previous run
+var ErrUnchanged = errors.New("unchanged")
+
+// ErrBrokenCache is reported when cache file can't be parsed, empty cache is used instead
+var ErrBrokenCache = errors.New("broken cache")
+
+// uncachedFlags do not change output images
+var uncachedFlags = map[string]bool{
+	"config": true, "output": true, "o": true, "directory": true, "d": true, "input": true, "i": true,
+	"force": true, "f": true, "dry-run": true, "n": true, "recursive": true, "r": true,
+	"concurrency": true, "j": true, "timeout": true, "per-file-timeout": true, "fail-fast": true,
+	"q": true, "v": true, "verbose": true, "vv": true, "watch": true, "no-progress": true, "no-color": true,
+	"output-format": true, "upload": true, "upload-key": true, "print-markdown": true,
+	"snippet": true, "print-data-uri": true, "preview": true, "gallery": true,
+	"cache-dir": true, "no-cache": true, "version": true,
+}
+
+// cache remembers hash of input and options of each output
+// so outputs of unchanged inputs are not generated again
+// nil cache is disabled
+type cache struct {
+	path    string
+	options string
+
+	mu      sync.Mutex
+	entries map[string]string
+	changed bool
+}
+
+// Get default cache directory
+// e.g.
+// $HOME/.cache/lgtmgen
+func defaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, Name)
+}
+
+// Load cache file in directory
+// options is fingerprint of options, see optionsFingerprint
+// broken cache file is ErrBrokenCache with empty cache, which replaces the file on save
+func loadCache(dir string, options string) (*cache, error) {
+	c := &cache{path: filepath.Join(dir, CacheFileName), options: options, entries: map[string]string{}}
+	data, err := ioutil.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &c.entries); err != nil {
+		c.entries = map[string]string{}
+		c.changed = true
+		return c, fmt.Errorf("%w: %s", ErrBrokenCache, err)
+	}
+
+	return c, nil
+}
+
+// Fingerprint of flags and mask which change output images
+// version is included because output may change between releases
+func optionsFingerprint(flags *flag.FlagSet, mask image.Image) string {
+	var values []string
+	flags.VisitAll(func(f *flag.Flag) {
+		if !uncachedFlags[f.Name] {
+			values = append(values, f.Name+"="+f.Value.String())
+		}
+	})
+	sort.Strings(values)
+
+	hash := sha256.New()
+	fmt.Fprintln(hash, Version)
+	for _, value := range values {
+		fmt.Fprintln(hash, value)
+	}
+	pixels := image.NewNRGBA(mask.Bounds())
+	draw.Draw(pixels, pixels.Bounds(), mask, mask.Bounds().Min, draw.Src)
+	fmt.Fprintln(hash, pixels.Bounds())
+	hash.Write(pixels.Pix)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Hash of input file and options
+// empty key is never cached
+func (c *cache) key(input string) string {
+	if c == nil {
+		return ""
+	}
+
+	file, err := os.Open(input)
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+	hash := sha256.New()
+	io.WriteString(hash, c.options)
+	if _, err := io.Copy(hash, file); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Get output was generated by cache key
+// known is true if output was generated by lgtmgen with any key
+func (c *cache) lookup(output string, key string) (unchanged bool, known bool) {
+	if c == nil {
+		return false, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	previous, known := c.entries[cachePath(output)]
+
+	return known && key != "" && previous == key, known
+}
+
+// Remember output is generated by cache key
+func (c *cache) set(output string, key string) {
+	if c == nil || key == "" {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[cachePath(output)] = key
+	c.changed = true
+}
+
+// Save cache file if changed
+// it is written to temporary file and renamed, so interrupted save never breaks cache file
+func (c *cache) save() error {
+	if c == nil || !c.changed {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, err := json.MarshalIndent(c.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return err
+	}
+
+	temp, err := ioutil.TempFile(filepath.Dir(c.path), "."+CacheFileName+".*")
+	if err != nil {
+		return err
+	}
+	tempPath := temp.Name()
+	if _, err := temp.Write(data); err != nil {
+		temp.Close()
+		os.Remove(tempPath)
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+	if err := os.Chmod(tempPath, 0644); err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+	if err := os.Rename(tempPath, c.path); err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+
+	return nil
+}
+
+// Absolute path of output as cache entry name
+func cachePath(output string) string {
+	if path, err := filepath.Abs(output); err == nil {
+		return path
+	}
+
+	return output
+}
@@ -20,57 +20,356 @@ THE SOFTWARE.
 package main
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"github.com/disintegration/imaging"
+	"github.com/neko-neko/lgtmgen/config"
+	"github.com/neko-neko/lgtmgen/effect"
+	"github.com/neko-neko/lgtmgen/fetcher"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/logger"
 	"github.com/neko-neko/lgtmgen/mask_image"
+	"github.com/neko-neko/lgtmgen/metadata"
+	"github.com/neko-neko/lgtmgen/preview"
+	"github.com/neko-neko/lgtmgen/progress"
+	"github.com/neko-neko/lgtmgen/report"
+	"github.com/neko-neko/lgtmgen/retry"
+	"github.com/neko-neko/lgtmgen/storage"
+	"github.com/neko-neko/lgtmgen/text_image"
+	"github.com/neko-neko/lgtmgen/uploader"
+	"image"
 	"io"
+	"io/ioutil"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 )
 
-// MaskImage is load mask image path
-const MaskImage = "images/lgtm_mask.png"
+// Defaults of watermark mode
+// they are used unless given by flags or config
+const (
+	WatermarkPosition = "bottom-right"
+	WatermarkScale    = 0.2
+	WatermarkOpacity  = 0.5
+)
+
+// ErrAlreadyExists is reported when output file exists
+var ErrAlreadyExists = errors.New("already exists")
+
+// ErrAlreadyProcessed is reported when input is output of lgtmgen
+var ErrAlreadyProcessed = errors.New("already processed")
 
 // Exit codes are int values that represent an exit code for a particular error.
+// some or all images of batch may fail while others succeed
 const (
 	ExitCodeOK    int = 0
 	ExitCodeError int = 1 + iota
+	ExitCodePartialFailure
+	ExitCodeAllFailed
+	ExitCodeInterrupted
+	ExitCodeTimeout
 )
 
 // CLI is the command line object
 type CLI struct {
+	// inStream is the stdin to read image in streaming mode.
+	inStream io.Reader
+
 	// outStream and errStream are the stdout and stderr
 	// to write message from the CLI.
 	outStream, errStream io.Writer
+
+	// log writes messages of verbosity level to outStream and errStream
+	log *logger.Logger
+
+	// retries runs reads and writes of images again on transient error
+	retries retry.Policy
 }
 
 // Run invokes the CLI with the given arguments.
+// arguments without subcommand run gen, e.g. lgtmgen -d in -o out
 func (cli *CLI) Run(args []string) int {
+	cli.log = logger.New(cli.outStream, cli.errStream, logger.LevelInfo)
+	if os.Getenv("NO_COLOR") == "" {
+		cli.log.ColorOut = progress.IsTerminal(cli.outStream)
+		cli.log.ColorErr = progress.IsTerminal(cli.errStream)
+	}
+
+	if len(args) > 1 {
+		if c, ok := findCommand(args[1]); ok {
+			return c.run(cli, args[1:])
+		}
+	}
+
+	return cli.gen(args, false)
+}
+
+// Generate LGTM images of inputs
+// args[0] is command name, watching is default of watch flag
+func (cli *CLI) gen(args []string, watching bool) int {
 	var (
 		output    string
 		directory string
+		input     string
+		fileList  string
 		force     bool
+		dryRun    bool
+		skipDone  bool
+		recursive bool
+		hidden    bool
+		ext       string
+		watch     bool
+		failFast  bool
+		inPlace   bool
+		backupDir string
+		quiet     bool
+		verbose   bool
+		debug     bool
+		jobs      int
+		maskPath  string
+		style     string
+		watermark string
+		maskScale float64
+		position  string
+		opacity   float64
+		rotate    float64
+		tile      bool
+		spacing   float64
+		contrast  bool
+		shadowed  bool
+		blurred   float64
+		scrim     float64
+		scrimTint string
+		gradient  bool
+		quality   int
+		crop      string
+		effects   string
+		filter    string
+		maxWidth  int
+		maxHeight int
+		maxPixels int
+		minSize   string
+		maxSize   string
+		rounded   int
+		border    string
+		text      string
+
+		fontPath    string
+		emojiDir    string
+		textColor   string
+		strokeColor string
+		strokeWidth int
+		shadow      bool
+		textAlign   string
+		lineSpacing float64
+
+		stdin     bool
+		fromClip  bool
+		video     bool
+		toClip    bool
+		format    string
+		prefix    string
+		suffix    string
+		upload    string
+		uploadKey string
+		markdown  bool
+		snippet   string
+		previewTo string
+		printURI  bool
+		gallery   bool
+		thumbs    int
+		open      bool
+		compare   string
+		reportTo  string
+
+		nameTemplate string
+		hashName     string
+		partition    string
+		noProgress   bool
+		noColor      bool
+		noCache      bool
+		cacheDir     string
+		noAutoOrient bool
+		keepMetadata bool
+		noColorMgmt  bool
+		keepPalette  bool
+		quantizer    string
+		noDither     bool
+		pngLevel     string
+		outputFormat string
+		configPath   string
+
+		timeout        time.Duration
+		perFileTimeout time.Duration
+		retries        int
+		retryDelay     time.Duration
 
 		version bool
 	)
 
+	// load config file
+	conf, err := cli.loadConfig(args[1:])
+	if err != nil {
+		return ExitCodeError
+	}
+
 	// Define option flag parse
-	flags := flag.NewFlagSet(Name, flag.ContinueOnError)
+	// config values are flag defaults, so flags take precedence
+	flags := flag.NewFlagSet(commandName(args[0]), flag.ContinueOnError)
 	flags.SetOutput(cli.errStream)
+	flags.Usage = func() {
+		fmt.Fprintf(cli.errStream, "Usage of %s:\n", flags.Name())
+		flags.PrintDefaults()
+		cli.printCommands()
+	}
+
+	flags.StringVar(&configPath, "config", "", "Config file path (default ~/"+config.FileName+")")
+
+	flags.StringVar(&output, "output", conf.Output, "Output directory path or storage URI(e.g. s3://bucket/lgtms/)")
+	flags.StringVar(&output, "o", conf.Output, "Output directory path or storage URI(Short)")
+
+	flags.StringVar(&directory, "directory", "", "Input directory path or storage URI(e.g. s3://bucket/images/)")
+	flags.StringVar(&directory, "d", "", "Input directory path or storage URI(Short)")
 
-	flags.StringVar(&output, "output", "", "Output directory path")
-	flags.StringVar(&output, "o", "", "Output directory path(Short)")
+	flags.StringVar(&input, "input", "", "Input file path or http(s) URL")
+	flags.StringVar(&input, "i", "", "Input file path or http(s) URL(Short)")
 
-	flags.StringVar(&directory, "directory", "", "Input directory path")
-	flags.StringVar(&directory, "d", "", "Input directory path(Short)")
+	flags.StringVar(&fileList, "filelist", "", "File of input paths, one per line(- for stdin)")
 
 	flags.BoolVar(&force, "force", false, "Force overwrite if outputfile exists")
 	flags.BoolVar(&force, "f", false, "Force overwrite if outputfile exists(Short)")
 
+	flags.BoolVar(&dryRun, "dry-run", false, "Report files which would be processed without writing")
+	flags.BoolVar(&dryRun, "n", false, "Report files which would be processed without writing(Short)")
+	flags.BoolVar(&skipDone, "skip-processed", false, "Skip inputs which are outputs of lgtmgen(PNG, JPEG and GIF outputs are marked)")
+
+	flags.BoolVar(&recursive, "recursive", false, "Process subdirectories recursively")
+	flags.BoolVar(&recursive, "r", false, "Process subdirectories recursively(Short)")
+	flags.BoolVar(&hidden, "include-hidden", false, "Process files and directories starting with dot(e.g. .DS_Store, ._cat.jpg)")
+	flags.StringVar(&ext, "ext", conf.Ext, "Comma separated extensions of input files to process(e.g. jpg,jpeg,png). All images by default")
+
+	flags.BoolVar(&inPlace, "in-place", false, "Overwrite input images and save originals with "+BackupExt+" extension")
+	flags.StringVar(&backupDir, "backup-dir", "", "Save originals of in-place mode into this directory instead")
+
+	flags.DurationVar(&timeout, "timeout", conf.Timeout, "Stop processing after this duration(e.g. 5m, 0 is unlimited)")
+	flags.DurationVar(&perFileTimeout, "per-file-timeout", conf.PerFileTimeout, "Give up an image after this duration(e.g. 30s, 0 is unlimited)")
+
+	flags.IntVar(&retries, "retries", intOr(conf.Retries, retry.DefaultRetries), "Retry transient read and write failures of an image this many times(e.g. NFS, network)")
+	flags.DurationVar(&retryDelay, "retry-delay", durationOr(conf.RetryDelay, retry.DefaultDelay), "Wait before first retry, it doubles on each retry")
+
+	flags.BoolVar(&failFast, "fail-fast", false, "Stop processing at first failed image")
+
+	flags.StringVar(&cacheDir, "cache-dir", stringOr(conf.CacheDir, defaultCacheDir()), "Directory of cache to skip unchanged images")
+	flags.BoolVar(&noCache, "no-cache", conf.NoCache, "Process images even if input and options are unchanged")
+
+	flags.BoolVar(&quiet, "q", false, "Print errors only")
+	flags.BoolVar(&verbose, "v", false, "Print result of each image and skipped files")
+	flags.BoolVar(&verbose, "verbose", false, "Print result of each image and skipped files")
+	flags.BoolVar(&debug, "vv", false, "Print timing and decode details in addition to -v")
+
+	flags.BoolVar(&video, "video", false, "Process MP4, MOV and WebM in input directory by ffmpeg(experimental)")
+
+	flags.BoolVar(&watch, "watch", watching, "Keep watching input directory and process new or modified images")
+
+	flags.IntVar(&jobs, "concurrency", intOr(conf.Concurrency, runtime.NumCPU()), "Number of images processed concurrently")
+	flags.IntVar(&jobs, "j", intOr(conf.Concurrency, runtime.NumCPU()), "Number of images processed concurrently(Short)")
+
+	flags.StringVar(&maskPath, "mask", conf.Mask, "Mask image path or embedded asset name. Overrides style")
+	flags.StringVar(&maskPath, "m", conf.Mask, "Mask image path or embedded asset name(Short)")
+
+	styles := strings.Join(mask_image.StyleNames(), ", ")
+	flags.StringVar(&style, "style", stringOr(conf.Style, mask_image.DefaultStyle), "Mask style("+styles+" or user mask name)")
+
+	flags.StringVar(&watermark, "watermark", "", "Overlay any image as watermark instead of LGTM mask")
+
+	flags.Float64Var(&maskScale, "mask-scale", floatOr(conf.MaskScale, lgtm.DefaultMaskScale), "Mask width ratio to source image width")
+
+	flags.StringVar(&position, "position", stringOr(conf.Position, "center"), "Mask position(center, top-left, bottom-right, ..., x,y or x%,y%)")
+	flags.StringVar(&position, "p", stringOr(conf.Position, "center"), "Mask position(Short)")
+
+	flags.Float64Var(&opacity, "opacity", floatOr(conf.Opacity, 1.0), "Mask opacity(0.0-1.0)")
+	flags.BoolVar(&tile, "tile", conf.Tile, "Repeat mask across whole image like watermark pattern")
+	flags.Float64Var(&spacing, "tile-spacing", floatOr(conf.TileSpacing, lgtm.DefaultTileSpacing), "Gap between tiled masks to mask size")
+	flags.Float64Var(&rotate, "rotate", conf.Rotate, "Rotate mask counter-clockwise by degrees(e.g. 20)")
+	flags.BoolVar(&contrast, "auto-contrast", conf.AutoContrast, "Invert or outline mask to be readable on source image")
+	flags.BoolVar(&shadowed, "mask-shadow", conf.MaskShadow, "Draw soft shadow behind mask")
+	flags.Float64Var(&blurred, "blur-behind", conf.BlurBehind, "Blur source behind mask by radius in pixels(0 is off, --effects blur blurs whole source)")
+	flags.Float64Var(&scrim, "scrim", conf.Scrim, "Darken behind mask by translucent layer of opacity(0.0-1.0, 0 is off, e.g. 0.4)")
+	flags.StringVar(&scrimTint, "scrim-color", stringOr(conf.ScrimColor, "black"), "Scrim color(e.g. white for light scrim)")
+	flags.BoolVar(&gradient, "scrim-gradient", conf.ScrimGradient, "Draw scrim as full width band fading out above and below mask")
+
+	flags.IntVar(&quality, "quality", intOr(conf.Quality, lgtm.DefaultQuality), "JPEG and AVIF output quality(1-100)")
+
+	flags.StringVar(&crop, "crop", conf.Crop, "Center-crop source to aspect ratio before overlay(e.g. 16:9, square)")
+
+	effectNames := strings.Join(effect.Names(), ", ")
+	flags.StringVar(&effects, "effects", conf.Effects, "Comma separated effects applied before overlay("+effectNames+")")
+	flags.StringVar(&filter, "filter", conf.Filter, "Color filter applied before effects("+strings.Join(effect.FilterNames(), ", ")+")")
+
+	flags.IntVar(&maxWidth, "max-width", conf.MaxWidth, "Downscale outputs to this width at most(0 is unlimited)")
+	flags.IntVar(&maxHeight, "max-height", conf.MaxHeight, "Downscale outputs to this height at most(0 is unlimited)")
+	flags.IntVar(&maxPixels, "max-pixels", intOr(conf.MaxPixels, lgtm.DefaultMaxPixels), "Reject source images of more pixels before decoding them(0 is unlimited)")
+	flags.StringVar(&minSize, "min-size", conf.MinSize, "Skip input images of directory smaller than WIDTHxHEIGHT(e.g. 200x200)")
+	flags.StringVar(&maxSize, "max-size", conf.MaxSize, "Skip input images of directory larger than WIDTHxHEIGHT(e.g. 8000x8000)")
+
+	flags.IntVar(&rounded, "rounded", conf.Rounded, "Round corners of outputs by radius in pixels")
+	flags.StringVar(&border, "border", conf.Border, "Draw border of width and color on outputs(e.g. 8:white)")
+
+	flags.StringVar(&pngLevel, "png-compression", stringOr(conf.PNGCompression, "default"), "PNG compression level(default, none, fast, best)")
+	flags.BoolVar(&keepPalette, "keep-palette", false, "Write paletted PNG when source image is paletted")
+	flags.StringVar(&quantizer, "gif-quantizer", conf.GIFQuantizer, "Palette of GIF outputs("+strings.Join(lgtm.QuantizerNames(), ", ")+"). Source palette of animated GIF and plan9 of others by default")
+	flags.BoolVar(&noDither, "no-dither", false, "Map colors of GIF and paletted PNG outputs to nearest palette color without Floyd-Steinberg dithering")
+
+	flags.BoolVar(&noAutoOrient, "no-auto-orient", false, "Do not rotate images by EXIF orientation")
+	flags.BoolVar(&keepMetadata, "keep-metadata", false, "Copy EXIF, XMP and ICC profile to output(JPEG only)")
+	flags.BoolVar(&noColorMgmt, "no-color-management", false, "Composite on source colors as is instead of converting Display P3, Adobe RGB or other ICC profiles to sRGB")
+
+	flags.StringVar(&text, "text", conf.Text, "Render text instead of mask image")
+	flags.StringVar(&text, "t", conf.Text, "Render text instead of mask image(Short)")
+	flags.StringVar(&fontPath, "font", conf.Font, "TTF/OTF font file path of text(embedded Go Bold by default)")
+	flags.StringVar(&emojiDir, "emoji-dir", conf.EmojiDir, "Directory of emoji PNG files named by code points(e.g. Twemoji, Noto Emoji)")
+	flags.StringVar(&textColor, "text-color", stringOr(conf.TextColor, "white"), "Text color name or hex code(e.g. white, #ffcc00)")
+	flags.StringVar(&strokeColor, "stroke-color", stringOr(conf.StrokeColor, "black"), "Text outline color name or hex code")
+	flags.IntVar(&strokeWidth, "stroke-width", conf.StrokeWidth, "Text outline width in pixels of mask(0 is no outline)")
+	flags.BoolVar(&shadow, "shadow", conf.Shadow, "Drop shadow under text")
+	flags.StringVar(&textAlign, "text-align", stringOr(conf.TextAlign, "center"), "Alignment of text lines(left, center, right)")
+	flags.Float64Var(&lineSpacing, "line-spacing", floatOr(conf.LineSpacing, text_image.DefaultLineSpacing), "Distance of text baselines to line height")
+
+	flags.BoolVar(&stdin, "stdin", false, "Read image from stdin and write to stdout")
+	flags.BoolVar(&fromClip, "from-clipboard", false, "Read image from clipboard")
+	flags.BoolVar(&toClip, "to-clipboard", false, "Copy output image to clipboard as PNG instead of saving it")
+	flags.StringVar(&format, "format", conf.Format, "Output image format("+strings.Join(lgtm.FormatNames(), ", ")+"). Same as input by default")
+
+	flags.BoolVar(&noProgress, "no-progress", false, "Print per-file lines instead of progress bar on terminal")
+	flags.BoolVar(&noColor, "no-color", false, "Disable colored output on terminal(NO_COLOR is also respected)")
+	flags.StringVar(&prefix, "prefix", conf.Prefix, "Prefix of output file names(e.g. lgtm_)")
+	flags.StringVar(&suffix, "suffix", conf.Suffix, "Suffix of output file names before extension(e.g. _lgtm)")
+	flags.StringVar(&hashName, "name", conf.Name, "Name outputs by SHA-256 of their content("+HashNaming+", or "+HashNaming+":N for first N digits)")
+	flags.StringVar(&partition, "partition", conf.Partition, "Put outputs into YYYY/MM/DD directories by EXIF capture date or modification time of input("+DatePartition+")")
+	flags.StringVar(&nameTemplate, "name-template", conf.NameTemplate, "Template of output file names({{.Base}}, {{.Ext}}, {{.Hash}}, {{.Date}}, {{.Index}})")
+
+	flags.StringVar(&reportTo, "report", "", "Write CSV report of input, output, dimensions, bytes, duration and status of each file")
+	flags.StringVar(&outputFormat, "output-format", stringOr(conf.OutputFormat, "text"), "Result output format(text, json)")
+
+	uploaders := strings.Join(uploader.Names(), ", ")
+	flags.StringVar(&upload, "upload", conf.Upload, "Upload output images("+uploaders+")")
+	flags.StringVar(&uploadKey, "upload-key", stringOr(conf.UploadKey, os.Getenv("LGTMGEN_UPLOAD_KEY")), "Client ID or key of uploader")
+	flags.BoolVar(&markdown, "print-markdown", false, "Print markdown image snippet of output images")
+	flags.StringVar(&snippet, "snippet", "", "Print image snippet of output images("+strings.Join(uploader.SnippetNames(), ", ")+")")
+	flags.BoolVar(&printURI, "print-data-uri", false, "Print output images as base64 data URI")
+	flags.StringVar(&previewTo, "preview", "", "Draw output images on terminal("+strings.Join(preview.Modes(), ", ")+")")
+	flags.BoolVar(&open, "open", false, "Open output image in default viewer(single input only)")
+	flags.IntVar(&thumbs, "thumbnails", 0, "Write JPEG thumbnail of this width next to each output(e.g. 320)")
+	flags.StringVar(&compare, "compare", "", "Write comparison image of original and output next to each output("+strings.Join(compareModes(), ", ")+")")
+	flags.BoolVar(&gallery, "gallery", false, "Write "+GalleryFileName+" of thumbnails in output directory")
+
 	flags.BoolVar(&version, "version", false, "Print version information and quit.")
 
 	// Parse commandline flag
@@ -78,73 +377,1203 @@ func (cli *CLI) Run(args []string) int {
 		return ExitCodeError
 	}
 
+	if noColor {
+		cli.log.ColorOut, cli.log.ColorErr = false, false
+	}
+
+	// verbosity
+	switch {
+	case quiet && (verbose || debug):
+		cli.log.Errorf("quiet and verbose cannot be used together.")
+		return ExitCodeError
+	case quiet:
+		cli.log.Level = logger.LevelQuiet
+	case debug:
+		cli.log.Level = logger.LevelDebug
+	case verbose:
+		cli.log.Level = logger.LevelVerbose
+	}
+
 	// Show version
 	if version {
-		fmt.Fprintf(cli.errStream, "%s version %s\n", Name, Version)
+		cli.log.Resultf(logger.LevelQuiet, "%s version %s", Name, Version)
 		return ExitCodeOK
 	}
 
+	// watermark has its own defaults
+	if watermark != "" {
+		if text != "" {
+			cli.log.Errorf("watermark and text cannot be used together.")
+			return ExitCodeError
+		}
+		if !isFlagSet(flags, "position", "p") && conf.Position == "" {
+			position = WatermarkPosition
+		}
+		if !isFlagSet(flags, "mask-scale") && conf.MaskScale == 0 {
+			maskScale = WatermarkScale
+		}
+		if !isFlagSet(flags, "opacity") && conf.Opacity == 0 {
+			opacity = WatermarkOpacity
+		}
+	}
+
 	// has targetDir?
-	if directory == "" {
-		fmt.Fprintf(cli.errStream, "input directory path is required.\n")
+	if directory == "" && input == "" && fileList == "" && !stdin && !fromClip {
+		cli.log.Errorf("input directory path is required.")
+		return ExitCodeError
+	}
+
+	// file list replaces input directory
+	if fileList != "" && (directory != "" || input != "" || stdin || watch) {
+		cli.log.Errorf("filelist can not be used with directory, input, stdin or watch.")
+		return ExitCodeError
+	}
+
+	// watch needs input directory
+	if watch && directory == "" {
+		cli.log.Errorf("watch requires input directory path.")
+		return ExitCodeError
+	}
+
+	// in-place mode writes into inputs
+	// output options of config are ignored
+	if inPlace {
+		if stdin || watch || fetcher.IsURL(input) {
+			cli.log.Errorf("in-place can not be used with stdin, watch or URL input.")
+			return ExitCodeError
+		}
+		if isFlagSet(flags, "output", "o", "format", "prefix", "suffix", "name", "name-template", "partition") {
+			cli.log.Errorf("in-place can not be combined with output, format or naming options.")
+			return ExitCodeError
+		}
+	}
+	// storage URI is supported by directory and output
+	remote := storage.IsRemote(directory) || storage.IsRemote(output)
+	if remote && (directory == "" || inPlace || watch) {
+		cli.log.Errorf("storage URI requires input directory and can not be used with in-place or watch.")
+		return ExitCodeError
+	}
+	if (storage.IsRemote(input) && !fetcher.IsURL(input)) || storage.IsRemote(fileList) {
+		cli.log.Errorf("storage URI is supported by directory and output only.")
+		return ExitCodeError
+	}
+	if backupDir != "" && !inPlace {
+		cli.log.Errorf("backup-dir requires in-place.")
+		return ExitCodeError
+	}
+
+	// clipboard replaces input or output
+	if fromClip && (directory != "" || input != "" || fileList != "" || stdin || watch || inPlace) {
+		cli.log.Errorf("from-clipboard can not be used with other inputs, watch or in-place.")
+		return ExitCodeError
+	}
+	if toClip && (directory != "" || fileList != "" || stdin || watch || inPlace || isFlagSet(flags, "output", "o")) {
+		cli.log.Errorf("to-clipboard can not be used with directory, output, stdin, watch or in-place.")
+		return ExitCodeError
+	}
+
+	// stdout of stdin mode is image
+	if printURI && stdin {
+		cli.log.Errorf("print-data-uri can not be used with stdin.")
+		return ExitCodeError
+	}
+	var previewMode preview.Mode
+	if previewTo != "" {
+		if stdin {
+			cli.log.Errorf("preview can not be used with stdin.")
+			return ExitCodeError
+		}
+		if previewMode, err = preview.ParseMode(previewTo); err != nil {
+			cli.log.Errorf("%s.", err)
+			return ExitCodeError
+		}
+	}
+
+	// gallery is written in local output directory
+	if gallery && (stdin || inPlace || toClip || storage.IsRemote(output)) {
+		cli.log.Errorf("gallery can not be used with stdin, in-place, to-clipboard or storage output.")
+		return ExitCodeError
+	}
+
+	// thumbnails are written next to local outputs
+	if thumbs < 0 {
+		cli.log.Errorf("thumbnail width must not be negative.")
+		return ExitCodeError
+	}
+	if thumbs > 0 && (stdin || inPlace || toClip || storage.IsRemote(output)) {
+		cli.log.Errorf("thumbnails can not be used with stdin, in-place, to-clipboard or storage output.")
+		return ExitCodeError
+	}
+
+	// only one image is opened
+	if open && (input == "" || dryRun || toClip) {
+		cli.log.Errorf("open requires input and can not be used with dry-run or to-clipboard.")
+		return ExitCodeError
+	}
+
+	// comparisons are written next to local outputs and read local originals
+	if compare != "" && (stdin || inPlace || fromClip || toClip || storage.IsRemote(output) || fetcher.IsURL(input)) {
+		cli.log.Errorf("compare can not be used with stdin, in-place, clipboard, URL input or storage output.")
+		return ExitCodeError
+	}
+
+	// stdout of stdin mode is image and it has no results
+	if reportTo != "" && stdin {
+		cli.log.Errorf("report can not be used with stdin.")
 		return ExitCodeError
 	}
 
 	// has outputDir?
-	if output == "" {
-		fmt.Fprintf(cli.errStream, "output directory path is required.\n")
+	if output == "" && !stdin && !inPlace && !toClip {
+		cli.log.Errorf("output directory path is required.")
+		return ExitCodeError
+	}
+
+	// valid retries?
+	if retries < 0 || retryDelay < 0 {
+		cli.log.Errorf("retries and retry-delay must not be negative.")
+		return ExitCodeError
+	}
+	cli.retries = retry.Policy{Retries: retries, Delay: retryDelay}
+
+	// valid concurrency?
+	if jobs < 1 {
+		cli.log.Errorf("concurrency must be greater than 0.")
+		return ExitCodeError
+	}
+
+	// valid mask scale?
+	if maskScale <= 0 || maskScale > 1 {
+		cli.log.Errorf("%s.", lgtm.ErrInvalidMaskScale)
+		return ExitCodeError
+	}
+
+	// valid opacity?
+	if opacity < 0 || opacity > 1 {
+		cli.log.Errorf("%s.", lgtm.ErrInvalidOpacity)
+		return ExitCodeError
+	}
+
+	// valid quality?
+	if quality < 1 || quality > 100 {
+		cli.log.Errorf("%s.", lgtm.ErrInvalidQuality)
+		return ExitCodeError
+	}
+
+	// valid text style?
+	textStyle, err := parseTextStyle(textColor, strokeColor, strokeWidth, shadow)
+	if err != nil {
+		cli.log.Errorf("%s.", err)
+		return ExitCodeError
+	}
+	if textStyle.Align, err = text_image.ParseAlign(textAlign); err != nil {
+		cli.log.Errorf("%s.", err)
+		return ExitCodeError
+	}
+	if lineSpacing <= 0 {
+		cli.log.Errorf("line spacing must be greater than 0.")
+		return ExitCodeError
+	}
+	textStyle.LineSpacing = lineSpacing
+
+	// valid crop?
+	var cropRatio float64
+	if crop != "" {
+		if cropRatio, err = lgtm.ParseAspectRatio(crop); err != nil {
+			cli.log.Errorf("%s.", err)
+			return ExitCodeError
+		}
+	}
+
+	// valid effects?
+	// filter is first effect
+	if effects, err = effect.WithFilter(filter, effects); err != nil {
+		cli.log.Errorf("%s.", err)
+		return ExitCodeError
+	}
+	processors, err := effect.Parse(effects)
+	if err != nil {
+		cli.log.Errorf("%s.", err)
+		return ExitCodeError
+	}
+
+	// valid max size?
+	if maxWidth < 0 || maxHeight < 0 {
+		cli.log.Errorf("%s.", lgtm.ErrInvalidMaxSize)
+		return ExitCodeError
+	}
+	if maxPixels < 0 {
+		cli.log.Errorf("%s.", lgtm.ErrInvalidMaxPixels)
+		return ExitCodeError
+	}
+
+	// valid size filter?
+	// dimensions are read from headers of local files
+	sizes, err := newSizeFilter(minSize, maxSize)
+	if err != nil {
+		cli.log.Errorf("%s.", err)
+		return ExitCodeError
+	}
+	if sizes != nil && (storage.IsRemote(directory) || storage.IsRemote(output)) {
+		cli.log.Errorf("min-size and max-size can not be used with storage URI.")
+		return ExitCodeError
+	}
+
+	// valid timeouts?
+	if timeout < 0 || perFileTimeout < 0 {
+		cli.log.Errorf("timeout must not be negative.")
+		return ExitCodeError
+	}
+
+	// valid tile spacing?
+	if spacing < 0 {
+		cli.log.Errorf("%s.", lgtm.ErrInvalidTileSpacing)
+		return ExitCodeError
+	}
+
+	// valid blur radius?
+	if blurred < 0 {
+		cli.log.Errorf("%s.", lgtm.ErrInvalidBlurRadius)
+		return ExitCodeError
+	}
+
+	// valid scrim?
+	if scrim < 0 || scrim > 1 {
+		cli.log.Errorf("%s.", lgtm.ErrInvalidScrim)
+		return ExitCodeError
+	}
+	scrimColor, err := text_image.ParseColor(scrimTint)
+	if err != nil {
+		cli.log.Errorf("%s.", err)
+		return ExitCodeError
+	}
+
+	// valid corners and border?
+	if rounded < 0 {
+		cli.log.Errorf("%s.", lgtm.ErrInvalidRadius)
+		return ExitCodeError
+	}
+	var outputBorder lgtm.Border
+	if border != "" {
+		if outputBorder, err = lgtm.ParseBorder(border); err != nil {
+			cli.log.Errorf("%s.", err)
+			return ExitCodeError
+		}
+	}
+
+	// valid format?
+	if format != "" {
+		if _, err := lgtm.FormatFromExtension(format); err != nil {
+			cli.log.Errorf("unknown image format %s.", format)
+			return ExitCodeError
+		}
+	}
+
+	// valid png compression?
+	pngCompression, err := lgtm.ParsePNGCompression(pngLevel)
+	if err != nil {
+		cli.log.Errorf("%s.", err)
+		return ExitCodeError
+	}
+
+	// valid quantizer?
+	gifQuantizer, err := lgtm.ParseQuantizer(quantizer)
+	if err != nil {
+		cli.log.Errorf("%s.", err)
+		return ExitCodeError
+	}
+
+	// valid position?
+	maskPosition, err := lgtm.ParsePosition(position)
+	if err != nil {
+		cli.log.Errorf("%s.", err)
 		return ExitCodeError
 	}
 
 	// add directory suffix
-	directory = addDirectorySuffix(directory)
+	if directory != "" {
+		directory = addDirectorySuffix(directory)
+	}
 	output = addDirectorySuffix(output)
 
-	// load mask image
-	mask := mask_image.NewMaskImage()
-	err := mask.LoadMaskImage(MaskImage)
+	// load mask or watermark image
+	var mask *mask_image.MaskImage
+	if watermark != "" {
+		mask, err = loadWatermark(watermark)
+	} else {
+		mask, err = loadMask(maskPath, style, text, textOptions{style: textStyle, fontPath: fontPath, emojiDir: emojiDir})
+	}
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+
+	// generate options
+	opts := []lgtm.Option{
+		lgtm.WithMask(mask.MaskImage),
+		lgtm.WithMaskScale(maskScale),
+		lgtm.WithPosition(maskPosition),
+		lgtm.WithOpacity(opacity),
+		lgtm.WithRotate(rotate),
+		lgtm.WithAutoContrast(contrast),
+		lgtm.WithMaskShadow(shadowed),
+		lgtm.WithBlurBehind(blurred),
+		lgtm.WithScrim(lgtm.Scrim{Opacity: scrim, Color: scrimColor, Gradient: gradient}),
+		lgtm.WithQuality(quality),
+		lgtm.WithCrop(cropRatio),
+		lgtm.WithEffects(processors...),
+		lgtm.WithMaxSize(maxWidth, maxHeight),
+		lgtm.WithMaxPixels(maxPixels),
+		lgtm.WithRoundedCorners(rounded),
+		lgtm.WithBorder(outputBorder),
+		lgtm.WithPNGCompression(pngCompression),
+		lgtm.WithKeepPalette(keepPalette),
+		lgtm.WithQuantizer(gifQuantizer),
+		lgtm.WithDither(!noDither),
+		lgtm.WithAutoOrient(!noAutoOrient),
+		lgtm.WithKeepMetadata(keepMetadata),
+		lgtm.WithColorManagement(!noColorMgmt),
+	}
+	if tile {
+		opts = append(opts, lgtm.WithTile(spacing))
+	}
+	if text != "" {
+		opts = append(opts, lgtm.WithSVGText(strings.Replace(text, `\n`, "\n", -1)))
+	}
+	if textStyle.Color != nil {
+		opts = append(opts, lgtm.WithTextStyle(textStyle))
+	}
+
+	// create uploader
+	up, err := newUploader(upload, uploadKey)
 	if err != nil {
-		fmt.Fprintf(cli.errStream, "fatal error %s.\n", err)
+		cli.log.Errorf("fatal error %s.", err)
 		return ExitCodeError
 	}
 
+	// snippet printed after each image
+	snippetFormat, err := newSnippet(snippet, markdown)
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+
+	// comparison written after each image
+	compared, err := newComparison(compare, !noAutoOrient)
+	if err != nil {
+		cli.log.Errorf("%s.", err)
+		return ExitCodeError
+	}
+
+	// create reporter
+	var (
+		reporter     report.Reporter
+		textReporter *report.TextReporter
+	)
+	switch outputFormat {
+	case "text":
+		textReporter = report.NewTextReporter(cli.log)
+		textReporter.Snippet = snippetFormat
+		if previewMode != "" {
+			textReporter.Preview = previewOutput(previewMode)
+		}
+		if printURI {
+			textReporter.DataURI = dataURI
+		}
+		reporter = textReporter
+	case "json":
+		reporter = report.NewJSONReporter(cli.outStream)
+	default:
+		cli.log.Errorf("unknown output format %s.", outputFormat)
+		return ExitCodeError
+	}
+
+	// CSV report is written along with reporter
+	if reportTo != "" {
+		file, err := os.Create(reportTo)
+		if err != nil {
+			cli.log.Errorf("fatal error %s.", err)
+			return ExitCodeError
+		}
+		csvReporter := report.NewCSVReporter(file)
+		defer func() {
+			if err := csvReporter.Err(); err != nil {
+				cli.log.Errorf("[%s] %s", err, reportTo)
+			}
+			if err := file.Close(); err != nil {
+				cli.log.Errorf("[%s] %s", err, reportTo)
+			}
+		}()
+		reporter = report.NewMultiReporter(reporter, csvReporter)
+	}
+
+	// output file names
+	names, err := newNaming(format, prefix, suffix, nameTemplate, hashName, partition)
+	if err != nil {
+		cli.log.Errorf("%s.", err)
+		return ExitCodeError
+	}
+	exts := parseExtensions(ext)
+
+	// gallery lists outputs after processing
+	if gallery && !dryRun {
+		defer func() {
+			path, err := writeGallery(output)
+			if err != nil {
+				cli.log.Errorf("[%s] %s", err, filepath.Join(output, GalleryFileName))
+				return
+			}
+			cli.log.Infof("[gallery] %s", path)
+		}()
+	}
+
+	// clipboard mode
+	if fromClip || toClip {
+		return cli.runClipboard(clipboardOptions{
+			from:     fromClip,
+			to:       toClip,
+			input:    input,
+			output:   output,
+			names:    names,
+			force:    force,
+			thumbs:   thumbs,
+			opts:     opts,
+			uploader: up,
+			reporter: reporter,
+		})
+	}
+
+	// streaming mode
+	if stdin {
+		return cli.runStdin(format, opts)
+	}
+
+	// overall timeout starts here
+	deadline, cancel := context.Background(), context.CancelFunc(func() {})
+	if timeout > 0 {
+		deadline, cancel = context.WithTimeout(deadline, timeout)
+	}
+	defer cancel()
+	limits := timeouts{deadline: deadline, perFile: perFileTimeout}
+
+	// originals of in-place mode
+	var originals *backup
+	if inPlace {
+		originals = &backup{}
+		if backupDir != "" {
+			if originals.dir, err = filepath.Abs(backupDir); err != nil {
+				cli.log.Errorf("fatal error %s.", err)
+				return ExitCodeError
+			}
+		}
+	}
+
+	// images of cloud storage
+	if remote {
+		return cli.runStorage(storageOptions{
+			source:      directory,
+			destination: output,
+			names:       names,
+			recursive:   recursive,
+			hidden:      hidden,
+			exts:        exts,
+			skipDone:    skipDone,
+			force:       force,
+			failFast:    failFast,
+			dryRun:      dryRun,
+			jobs:        jobs,
+			opts:        opts,
+			uploader:    up,
+			reporter:    reporter,
+			limits:      limits,
+		})
+	}
+
+	// cache of outputs
+	// in-place outputs are inputs of next run, so they are not cached
+	var cached *cache
+	if !noCache && cacheDir != "" && !inPlace && !dryRun {
+		cached, err = loadCache(cacheDir, optionsFingerprint(flags, mask.MaskImage))
+		if errors.Is(err, ErrBrokenCache) {
+			cli.log.Warnf("[%s] %s", err, cached.path)
+		} else if err != nil {
+			cli.log.Errorf("fatal error %s.", err)
+			return ExitCodeError
+		}
+		defer func() {
+			if err := cached.save(); err != nil {
+				cli.log.Warnf("[%s] %s", err, cached.path)
+			}
+		}()
+	}
+
+	// single input mode
+	if input != "" {
+		return cli.runInput(limits, input, output, names, originals, force, dryRun, cached, thumbs, compared, open, opts, up, reporter)
+	}
+
 	// load target images
-	filePaths := mask.ReadImagePaths(directory)
+	var paths []string
+	switch {
+	case fileList != "":
+		paths, err = cli.readFileList(fileList)
+	case recursive:
+		paths, err = mask.ReadImagePathsRecursive(directory)
+	default:
+		paths, err = mask.ReadImagePaths(directory)
+	}
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+
+	// outputs mirror input tree under root
+	// root of file list is common directory of listed files
+	root := directory
+	if fileList != "" {
+		if root, err = commonDirectory(paths); err != nil {
+			cli.log.Errorf("fatal error %s.", err)
+			return ExitCodeError
+		}
+	}
+
+	// skip hidden files(e.g. .DS_Store, ._cat.jpg of macOS), files which are not images
+	// and outputs of previous run in same directory
+	sameDirectory := filepath.Clean(directory) == filepath.Clean(output)
+	var filePaths []string
+	for _, path := range paths {
+		name := filepath.Base(path)
+		if root != "" {
+			if relativePath, err := relativeTo(root, path); err == nil {
+				name = relativePath
+			}
+		}
+		if !hidden && isHidden(name) {
+			cli.log.Verbosef("[hidden] %s", path)
+			continue
+		}
+		if !exts.match(path) {
+			cli.log.Verbosef("[excluded] %s", path)
+			continue
+		}
+		if !isImageFile(path) && !(video && lgtm.IsVideo(path)) {
+			cli.log.Verbosef("[not an image] %s", path)
+			continue
+		}
+		if reason := sizes.check(path); reason != "" {
+			cli.log.Verbosef("[%s] %s", reason, path)
+			continue
+		}
+		if originals != nil && originals.dir != "" && isUnder(path, originals.dir) {
+			continue
+		}
+		if sameDirectory && (names.isOutput(path) || isThumbnail(path) || isComparison(path)) {
+			cli.log.Verbosef("[output] %s", path)
+			continue
+		}
+		filePaths = append(filePaths, path)
+	}
+
+	// results of watch mode are printed as they come
+	if textReporter != nil {
+		textReporter.Each = watch
+	}
+
+	// progress bar replaces per-file success lines on terminal
+	// results of watch mode are printed line by line
+	var bar *progress.Bar
+	if !noProgress && !watch && progress.IsTerminal(cli.errStream) {
+		bar = progress.NewBar(cli.errStream, len(filePaths))
+		if textReporter != nil {
+			textReporter.Quiet = true
+		}
+		cli.log.SetErr(bar)
+	}
 
 	// mask images
+	// semaphore bounds number of decoded images in memory
+	// Ctrl+C stops starting new images and waits for images in progress
+	// timeout also aborts images in progress
+	ctx, stop := signal.NotifyContext(limits.deadline, os.Interrupt)
+	defer stop()
+	go func() {
+		// second Ctrl+C kills immediately
+		<-ctx.Done()
+		stop()
+	}()
+
+	// results are reported in order of filePaths whichever finishes first
+	summary := report.NewSummary()
+	ordered := report.NewOrderedReporter(reporter)
 	wg := &sync.WaitGroup{}
-	for _, filePath := range filePaths {
+	semaphore := make(chan struct{}, jobs)
+	for index, filePath := range filePaths {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		if failFast && summary.HasFailure() {
+			<-semaphore
+			break
+		}
 		wg.Add(1)
-		go func(filePath string) {
+		go func(index int, filePath string) {
 			defer wg.Done()
-
-			maskedImage, maskErr := mask.OverlayImage(filePath, mask.MaskImage, mask.Width, mask.Height)
-			if maskErr != nil {
-				fmt.Fprintf(cli.errStream, "[%s] %s\n", maskErr, filePath)
-				runtime.Goexit()
+			defer func() { <-semaphore }()
+			if bar != nil {
+				defer bar.Increment()
 			}
 
 			// generate output file path
-			outputFilePath := output + filepath.Base(filePath)
-
-			// save image file
-			if existFile(outputFilePath) && !force {
-				fmt.Fprintf(cli.errStream, "[already exists] %s\n", outputFilePath)
-				runtime.Goexit()
+			// keep relative directory structure of input
+			// e.g. a/cat.jpg and b/cat.jpg => output/a/cat.jpg and output/b/cat.jpg
+			var result *report.Result
+			relativePath := filepath.Base(filePath)
+			var err error
+			if root != "" {
+				relativePath, err = relativeTo(root, filePath)
+			}
+			var outputName string
+			if err == nil {
+				outputName, err = names.filename(relativePath, filePath, index+1)
 			}
-			saveErr := imaging.Save(maskedImage, outputFilePath)
-			if saveErr != nil {
-				fmt.Fprintf(cli.errStream, "[%s] %s\n", maskErr, filePath)
-				runtime.Goexit()
+			fileCtx, cancel := limits.file()
+			defer cancel()
+			switch {
+			case err != nil:
+				result = &report.Result{Input: filePath, Status: report.StatusFailed, Error: err}
+			case skipDone && isProcessed(filePath):
+				result = &report.Result{Input: filePath, Status: report.StatusSkipped, Error: ErrAlreadyProcessed}
+			case originals != nil:
+				result = cli.maskInPlace(fileCtx, filePath, originals.path(filePath, relativePath), dryRun, opts)
+			case dryRun:
+				result = cli.planFile(filePath, names.planned(output+outputName), force)
+			default:
+				result = cli.maskFile(fileCtx, filePath, output+outputName, force, cached, opts)
 			}
-			fmt.Printf("[success] %s\n", outputFilePath)
-		}(filePath)
+			names.renameResult(result)
+			cli.thumbnailResult(result, thumbs)
+			cli.compareResult(result, compared)
+			uploadResult(result, up)
+			summary.Add(result)
+			ordered.ReportAt(index, result)
+		}(index, filePath)
 	}
 	wg.Wait()
+	if bar != nil {
+		bar.Finish()
+		cli.log.SetErr(cli.errStream)
+	}
+
+	if limits.deadline.Err() != nil {
+		cli.log.Errorf("timed out, %d of %d images processed.", summary.Total, len(filePaths))
+		reporter.Finish()
+		return ExitCodeTimeout
+	}
+	if ctx.Err() != nil {
+		cli.log.Errorf("interrupted, %d of %d images processed.", summary.Total, len(filePaths))
+		reporter.Finish()
+		return ExitCodeInterrupted
+	}
+
+	// process changed images until interrupted
+	if watch {
+		cli.log.Infof("watching %s (press Ctrl+C to stop)", directory)
+		err := cli.watch(ctx, watchOptions{
+			directory: directory,
+			output:    output,
+			names:     names,
+			recursive: recursive,
+			hidden:    hidden,
+			exts:      exts,
+			sizes:     sizes,
+			skipDone:  skipDone,
+			dryRun:    dryRun,
+			jobs:      jobs,
+			opts:      opts,
+			uploader:  up,
+			reporter:  reporter,
+			limits:    limits,
+			cache:     cached,
+			thumbs:    thumbs,
+			compared:  compared,
+		})
+		if err != nil {
+			cli.log.Errorf("fatal error %s.", err)
+			return ExitCodeError
+		}
+	}
+	reporter.Finish()
+
+	return batchExitCode(summary.Failed, len(filePaths))
+}
+
+// Get exit code of batch by number of failed images
+func batchExitCode(failed int, total int) int {
+	switch {
+	case failed == 0:
+		return ExitCodeOK
+	case failed == total:
+		return ExitCodeAllFailed
+	}
+
+	return ExitCodePartialFailure
+}
+
+// Load config file given by -config flag or default config file
+func (cli *CLI) loadConfig(args []string) (*config.Config, error) {
+	conf, err := config.Load(findConfigPath(args))
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+// Find -config flag value before parsing flags
+// e.g.
+// args=["-d", "in", "--config", "my.yaml"] => "my.yaml"
+func findConfigPath(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+
+		name := strings.TrimLeft(arg, "-")
+		if name == "config" && i+1 < len(args) {
+			return args[i+1]
+		}
+		if strings.HasPrefix(name, "config=") && strings.HasPrefix(arg, "-") {
+			return strings.TrimPrefix(name, "config=")
+		}
+	}
+
+	return ""
+}
+
+// Get value or fallback if value is empty
+func stringOr(value string, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+
+	return value
+}
+
+// Get value or fallback if value is zero
+func intOr(value int, fallback int) int {
+	if value == 0 {
+		return fallback
+	}
+
+	return value
+}
+
+// Get value or fallback if value is zero
+func durationOr(value time.Duration, fallback time.Duration) time.Duration {
+	if value == 0 {
+		return fallback
+	}
+
+	return value
+}
+
+// Get value or fallback if value is zero
+func floatOr(value float64, fallback float64) float64 {
+	if value == 0 {
+		return fallback
+	}
+
+	return value
+}
+
+// textOptions are rendering options of text mask
+// zero value renders with embedded font and default style
+type textOptions struct {
+	style    text_image.Style
+	fontPath string
+	emojiDir string
+}
+
+// Load mask image and render text on it if given
+func loadMask(maskPath string, style string, text string, textOpts textOptions) (*mask_image.MaskImage, error) {
+	// mask image path takes precedence over style
+	if maskPath == "" {
+		s, err := mask_image.LookupStyle(style)
+		if err != nil {
+			return nil, err
+		}
+		maskPath = s.Asset
+	}
+
+	mask := mask_image.NewMaskImage()
+	if err := mask.LoadMaskImage(maskPath); err != nil {
+		return nil, err
+	}
+
+	if text == "" {
+		return mask, nil
+	}
+
+	// render text as mask
+	// escaped newline in commandline separates lines
+	text = strings.Replace(text, `\n`, "\n", -1)
+	textImage, err := text_image.NewTextImage(mask.Width, mask.Height)
+	if err != nil {
+		return nil, err
+	}
+	if textOpts.style.Color != nil {
+		textImage.Style = textOpts.style
+	}
+	if textImage.Font, err = text_image.LoadFont(textOpts.fontPath); err != nil {
+		return nil, err
+	}
+	if textOpts.emojiDir != "" {
+		if textImage.Emoji, err = text_image.NewEmojiDir(textOpts.emojiDir); err != nil {
+			return nil, err
+		}
+	}
+	renderedImage, err := textImage.Render(text)
+	if err != nil {
+		return nil, err
+	}
+	mask.MaskImage = renderedImage
+
+	return mask, nil
+}
+
+// Parse text decoration flags
+func parseTextStyle(textColor string, strokeColor string, strokeWidth int, shadow bool) (text_image.Style, error) {
+	fill, err := text_image.ParseColor(textColor)
+	if err != nil {
+		return text_image.Style{}, err
+	}
+	stroke, err := text_image.ParseColor(strokeColor)
+	if err != nil {
+		return text_image.Style{}, err
+	}
+	if strokeWidth < 0 {
+		return text_image.Style{}, fmt.Errorf("stroke width must not be negative")
+	}
+
+	return text_image.Style{Color: fill, StrokeColor: stroke, StrokeWidth: strokeWidth, Shadow: shadow}, nil
+}
+
+// Load watermark image
+func loadWatermark(path string) (*mask_image.MaskImage, error) {
+	mask := mask_image.NewMaskImage()
+	if err := mask.LoadWatermarkImage(path); err != nil {
+		return nil, err
+	}
+
+	return mask, nil
+}
+
+// Mask image from stdin and write it to stdout
+// output format is same as input if format is empty
+func (cli *CLI) runStdin(format string, opts []lgtm.Option) int {
+	body, err := ioutil.ReadAll(cli.inStream)
+	if err != nil {
+		cli.log.Errorf("[%s] stdin", err)
+		return ExitCodeError
+	}
+
+	var outputFormat imaging.Format
+	if format == "" {
+		outputFormat, err = lgtm.DetectFormat(body)
+	} else {
+		outputFormat, err = lgtm.FormatFromExtension(format)
+	}
+	if err != nil {
+		cli.log.Errorf("[%s] stdin", err)
+		return ExitCodeError
+	}
+
+	if err := lgtm.Process(bytes.NewReader(body), cli.outStream, outputFormat, opts...); err != nil {
+		cli.log.Errorf("[%s] stdin", err)
+		return ExitCodeError
+	}
+
+	return ExitCodeOK
+}
+
+// Mask single input file or URL
+func (cli *CLI) runInput(limits timeouts, input string, output string, names naming, originals *backup, force bool, dryRun bool, cached *cache, thumbs int, compared *comparison, open bool, opts []lgtm.Option, up uploader.Uploader, reporter report.Reporter) int {
+	var result *report.Result
+	name := filepath.Base(input)
+	if fetcher.IsURL(input) {
+		name = fetcher.FileName(input)
+	}
+	name, err := names.filename(name, input, 1)
+	ctx, cancel := limits.file()
+	defer cancel()
+	switch {
+	case err != nil:
+		result = &report.Result{Input: input, Status: report.StatusFailed, Error: err}
+	case originals != nil:
+		result = cli.maskInPlace(ctx, input, originals.path(input, filepath.Base(input)), dryRun, opts)
+	case fetcher.IsURL(input) && dryRun:
+		result = &report.Result{Input: input, Output: names.planned(output + name), Status: report.StatusPending}
+	case fetcher.IsURL(input):
+		result = cli.maskURL(ctx, input, output, names, force, opts)
+	case dryRun:
+		result = cli.planFile(input, names.planned(output+name), force)
+	default:
+		result = cli.maskFile(ctx, input, output+name, force, cached, opts)
+	}
+	names.renameResult(result)
+	cli.thumbnailResult(result, thumbs)
+	cli.compareResult(result, compared)
+	uploadResult(result, up)
+	reporter.Report(result)
+	reporter.Finish()
+	if open {
+		cli.openResult(result)
+	}
+
+	if result.Status != report.StatusSuccess && result.Status != report.StatusPending {
+		return ExitCodeError
+	}
 
 	return ExitCodeOK
 }
 
+// Check image file would be processed without writing
+func (cli *CLI) planFile(input string, outputFilePath string, force bool) *report.Result {
+	result := &report.Result{Input: input, Output: outputFilePath, Status: report.StatusPending}
+	start := time.Now()
+	defer func() { result.Duration = time.Since(start) }()
+
+	if existFile(outputFilePath) && !force {
+		result.Status, result.Error = report.StatusSkipped, ErrAlreadyExists
+		return result
+	}
+
+	// read image header only
+	file, err := os.Open(input)
+	if err != nil {
+		result.Status, result.Error = report.StatusFailed, err
+		return result
+	}
+	defer file.Close()
+	if _, _, err := image.DecodeConfig(file); err != nil {
+		result.Status, result.Error = report.StatusFailed, err
+		return result
+	}
+
+	return result
+}
+
+// Print format and size of image file in debug level
+func (cli *CLI) debugImage(path string) {
+	if !cli.log.Enabled(logger.LevelDebug) {
+		return
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+	config, format, err := image.DecodeConfig(file)
+	if err != nil {
+		cli.log.Debugf("[decode] %s: %s", path, err)
+		return
+	}
+	cli.log.Debugf("[decode] %s: %s %dx%d", path, format, config.Width, config.Height)
+}
+
+// Mask image file and save it
+// outputs generated from changed inputs are overwritten if cache knows them
+func (cli *CLI) maskFile(ctx context.Context, input string, outputFilePath string, force bool, cached *cache, opts []lgtm.Option) *report.Result {
+	result := &report.Result{Input: input, Output: outputFilePath, Status: report.StatusSuccess}
+	start := time.Now()
+	defer func() { result.Duration = time.Since(start) }()
+
+	key := cached.key(input)
+	if existFile(outputFilePath) && !force {
+		unchanged, known := cached.lookup(outputFilePath, key)
+		switch {
+		case unchanged:
+			result.Status, result.Error = report.StatusSkipped, ErrUnchanged
+			return result
+		case !known:
+			result.Status, result.Error = report.StatusSkipped, ErrAlreadyExists
+			return result
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(outputFilePath), 0755); err != nil {
+		result.Status, result.Error = report.StatusFailed, err
+		return result
+	}
+	cli.debugImage(input)
+	result.InputBytes = fileSize(input)
+	err := cli.withRetry(ctx, input, func() error {
+		return lgtm.ProcessFileContext(ctx, input, outputFilePath, opts...)
+	})
+	if err != nil {
+		result.Status, result.Error = report.StatusFailed, timeoutError(err)
+		return result
+	}
+	result.Bytes = fileSize(outputFilePath)
+	result.Width, result.Height = fileImageSize(outputFilePath)
+	cached.set(outputFilePath, key)
+
+	return result
+}
+
+// Download image of url, mask and save it into output directory
+func (cli *CLI) maskURL(ctx context.Context, input string, output string, names naming, force bool, opts []lgtm.Option) *report.Result {
+	result := &report.Result{Input: input, Status: report.StatusSuccess}
+	start := time.Now()
+	defer func() { result.Duration = time.Since(start) }()
+
+	// generate output file name from url
+	name := fetcher.FileName(input)
+	if name == "" {
+		name = Name
+	}
+	name, err := names.filename(name, input, 1)
+	if err != nil {
+		result.Status, result.Error = report.StatusFailed, err
+		return result
+	}
+	outputFormat, err := lgtm.FormatFromFilename(name)
+	if err != nil {
+		outputFormat = imaging.PNG
+		name += ".png"
+	}
+	result.Output = output + name
+
+	if existFile(result.Output) && !force {
+		result.Status, result.Error = report.StatusSkipped, ErrAlreadyExists
+		return result
+	}
+
+	var body []byte
+	err = cli.withRetry(ctx, input, func() (err error) {
+		body, err = fetcher.NewFetcher().Fetch(input)
+		return err
+	})
+	if err != nil {
+		result.Status, result.Error = report.StatusFailed, err
+		return result
+	}
+	result.InputBytes = int64(len(body))
+	err = cli.withRetry(ctx, result.Output, func() error {
+		return writeImage(ctx, body, result.Output, outputFormat, opts)
+	})
+	if err != nil {
+		result.Status, result.Error = report.StatusFailed, timeoutError(err)
+		return result
+	}
+	result.Bytes = fileSize(result.Output)
+	result.Width, result.Height = fileImageSize(result.Output)
+
+	return result
+}
+
+// Create uploader of name
+// nil is returned when name is empty
+func newUploader(name string, key string) (uploader.Uploader, error) {
+	if name == "" {
+		return nil, nil
+	}
+
+	return uploader.NewUploader(name, key)
+}
+
+// Get snippet format of name
+// print-markdown is same as markdown snippet, nil is returned when neither is given
+func newSnippet(name string, markdown bool) (func(url string) string, error) {
+	if name == "" && markdown {
+		name = "markdown"
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	return uploader.Snippet(name)
+}
+
+// Upload output file of successful result if uploader is given
+func uploadResult(result *report.Result, up uploader.Uploader) {
+	if up == nil || result.Status != report.StatusSuccess {
+		return
+	}
+
+	data, err := ioutil.ReadFile(result.Output)
+	if err != nil {
+		result.Status, result.Error = report.StatusFailed, err
+		return
+	}
+	uploadData(result, up, filepath.Base(result.Output), data)
+}
+
+// Upload output data of succeeded result and set its URL
+// used when output is not local file, e.g. storage object
+func uploadData(result *report.Result, up uploader.Uploader, name string, data []byte) {
+	if up == nil || result.Status != report.StatusSuccess {
+		return
+	}
+
+	url, err := up.Upload(name, data)
+	if err != nil {
+		result.Status, result.Error = report.StatusFailed, err
+		return
+	}
+	result.URL = url
+}
+
+// Run read or write of file or URL again on transient error
+func (cli *CLI) withRetry(ctx context.Context, name string, operation func() error) error {
+	policy := cli.retries
+	policy.Notify = func(err error, retry int) {
+		cli.log.Verbosef("[retry %d/%d: %s] %s", retry, policy.Retries, err, name)
+	}
+
+	return policy.Do(ctx, operation)
+}
+
+// Mask image data and write it to file
+// file is removed if ctx is done before writing
+func writeImage(ctx context.Context, body []byte, outputFilePath string, outputFormat imaging.Format, opts []lgtm.Option) error {
+	if err := os.MkdirAll(filepath.Dir(outputFilePath), 0755); err != nil {
+		return err
+	}
+	file, err := os.Create(outputFilePath)
+	if err != nil {
+		return err
+	}
+
+	if err := lgtm.ProcessContext(ctx, bytes.NewReader(body), file, outputFormat, opts...); err != nil {
+		// do not leave broken file
+		file.Close()
+		os.Remove(outputFilePath)
+		return err
+	}
+
+	return file.Close()
+}
+
+// Flag is given in commandline
+func isFlagSet(flags *flag.FlagSet, names ...string) bool {
+	set := false
+	flags.Visit(func(f *flag.Flag) {
+		for _, name := range names {
+			if f.Name == name {
+				set = true
+			}
+		}
+	})
+
+	return set
+}
+
 // Add directory suffix
 // e.g.
 // directoryPath="/tmp" => directoryPath="/tmp/"
@@ -161,3 +1590,67 @@ func existFile(filename string) bool {
 	_, err := os.Stat(filename)
 	return err == nil
 }
+
+// File has known image extension
+// decode only images like HEIC are included
+func isImageFile(path string) bool {
+	_, err := lgtm.FormatFromFilename(lgtm.OutputFilename(path, ""))
+	return err == nil
+}
+
+// Path has file or directory starting with dot
+// path is relative to input directory, so input directory itself may be hidden
+func isHidden(path string) bool {
+	names := strings.FieldsFunc(path, func(r rune) bool { return r == '/' || r == filepath.Separator })
+	for _, name := range names {
+		if strings.HasPrefix(name, ".") && name != "." && name != ".." {
+			return true
+		}
+	}
+
+	return false
+}
+
+// File has marker of lgtmgen output in its header
+func isProcessed(path string) bool {
+	file, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+	header, _ := ioutil.ReadAll(io.LimitReader(file, headerReadLimit))
+
+	return metadata.IsMarked(header)
+}
+
+// Get file size or 0 if it does not exist
+func fileSize(filename string) int64 {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return 0
+	}
+
+	return info.Size()
+}
+
+// Get dimensions of image file from its header
+// 0 is returned for image which has no decoder, e.g. SVG
+func fileImageSize(filename string) (int, int) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return 0, 0
+	}
+	defer file.Close()
+
+	return imageSize(file)
+}
+
+// Get dimensions of image from its header
+func imageSize(r io.Reader) (int, int) {
+	config, _, err := image.DecodeConfig(r)
+	if err != nil {
+		return 0, 0
+	}
+
+	return config.Width, config.Height
+}
@@ -0,0 +1,137 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"bytes"
+	"context"
+	"github.com/disintegration/imaging"
+	"github.com/neko-neko/lgtmgen/clipboard"
+	"github.com/neko-neko/lgtmgen/fetcher"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/report"
+	"github.com/neko-neko/lgtmgen/uploader"
+	"io/ioutil"
+	"time"
+)
+
+// ClipboardName is reported as input or output of clipboard
+const ClipboardName = "clipboard"
+
+// clipboardOptions are options to mask image from or to clipboard
+type clipboardOptions struct {
+	// from reads input from clipboard instead of input
+	from bool
+
+	// to copies output to clipboard instead of saving it into output directory
+	to bool
+
+	input    string
+	output   string
+	names    naming
+	force    bool
+	thumbs   int
+	opts     []lgtm.Option
+	uploader uploader.Uploader
+	reporter report.Reporter
+}
+
+// Mask image from or to clipboard
+// e.g. screenshot in clipboard => LGTM image in clipboard to paste into PR comment
+func (cli *CLI) runClipboard(c clipboardOptions) int {
+	result := cli.maskClipboard(c)
+	cli.thumbnailResult(result, c.thumbs)
+	uploadResult(result, c.uploader)
+	c.reporter.Report(result)
+	c.reporter.Finish()
+
+	if result.Status != report.StatusSuccess {
+		return ExitCodeError
+	}
+
+	return ExitCodeOK
+}
+
+// Mask image and copy it into clipboard or save it
+func (cli *CLI) maskClipboard(c clipboardOptions) *report.Result {
+	result := &report.Result{Input: c.input, Status: report.StatusSuccess}
+	start := time.Now()
+	defer func() { result.Duration = time.Since(start) }()
+
+	var body []byte
+	var err error
+	switch {
+	case c.from:
+		result.Input = ClipboardName
+		body, err = clipboard.Read()
+	case fetcher.IsURL(c.input):
+		body, err = fetcher.NewFetcher().Fetch(c.input)
+	default:
+		body, err = ioutil.ReadFile(c.input)
+	}
+	if err != nil {
+		result.Status, result.Error = report.StatusFailed, err
+		return result
+	}
+
+	// clipboard images are PNG on every platform
+	result.InputBytes = int64(len(body))
+	if c.to {
+		result.Output = ClipboardName
+		var output bytes.Buffer
+		if err := lgtm.Process(bytes.NewReader(body), &output, imaging.PNG, c.opts...); err != nil {
+			result.Status, result.Error = report.StatusFailed, err
+			return result
+		}
+		if err := clipboard.Write(output.Bytes()); err != nil {
+			result.Status, result.Error = report.StatusFailed, err
+			return result
+		}
+		result.Bytes = int64(output.Len())
+		result.Width, result.Height = imageSize(bytes.NewReader(output.Bytes()))
+		return result
+	}
+
+	// clipboard has no file name, so it is named by time
+	name, err := c.names.filename(ClipboardName+"-"+start.Format("20060102-150405")+".png", ClipboardName, 1)
+	if err != nil {
+		result.Status, result.Error = report.StatusFailed, err
+		return result
+	}
+	result.Output = c.output + name
+	outputFormat, err := lgtm.FormatFromFilename(name)
+	if err != nil {
+		result.Status, result.Error = report.StatusFailed, err
+		return result
+	}
+	if existFile(result.Output) && !c.force {
+		result.Status, result.Error = report.StatusSkipped, ErrAlreadyExists
+		return result
+	}
+	if err := writeImage(context.Background(), body, result.Output, outputFormat, c.opts); err != nil {
+		result.Status, result.Error = report.StatusFailed, err
+		return result
+	}
+	c.names.renameResult(result)
+	result.Bytes = fileSize(result.Output)
+	result.Width, result.Height = fileImageSize(result.Output)
+
+	return result
+}
@@ -0,0 +1,75 @@
+// Package clipboard reads and writes PNG images of system clipboard.
+// platform tools are used: osascript on macOS, PowerShell on Windows,
+// wl-clipboard on Wayland and xclip on X11.
+package clipboard
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+var (
+	// ErrNoImage is returned when clipboard has no image
+	ErrNoImage = errors.New("clipboard has no image")
+
+	// ErrUnsupported is returned when clipboard tool is not found
+	ErrUnsupported = errors.New("clipboard is not supported on this system")
+)
+
+// Read PNG image in clipboard
+func Read() ([]byte, error) {
+	data, err := read()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, ErrNoImage
+	}
+
+	return data, nil
+}
+
+// Write PNG image into clipboard
+func Write(png []byte) error {
+	return write(png)
+}
+
+// Run command and get stdout
+// stderr of command is used as error message
+func run(name string, args ...string) ([]byte, error) {
+	if _, err := exec.LookPath(name); err != nil {
+		return nil, ErrUnsupported
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf("%s: %s", name, message)
+		}
+		return nil, fmt.Errorf("%s: %s", name, err)
+	}
+
+	return stdout.Bytes(), nil
+}
+
+// Run command with stdin
+// output is discarded because tools serving clipboard in background keep it open
+func pipe(stdin []byte, name string, args ...string) error {
+	if _, err := exec.LookPath(name); err != nil {
+		return ErrUnsupported
+	}
+
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s: %s", name, err)
+	}
+
+	return nil
+}
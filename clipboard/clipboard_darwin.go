@@ -0,0 +1,54 @@
+package clipboard
+
+import (
+	"io/ioutil"
+	"os"
+)
+
+// Read clipboard as PNG through temporary file
+// osascript can not write binary to stdout
+func read() ([]byte, error) {
+	path, err := tempFile()
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(path)
+
+	_, err = run("osascript",
+		"-e", `set f to open for access (POSIX file "`+path+`") with write permission`,
+		"-e", `try`,
+		"-e", `write (the clipboard as «class PNGf») to f`,
+		"-e", `end try`,
+		"-e", `close access f`)
+	if err != nil {
+		return nil, err
+	}
+
+	return ioutil.ReadFile(path)
+}
+
+// Write PNG into clipboard through temporary file
+func write(png []byte) error {
+	path, err := tempFile()
+	if err != nil {
+		return err
+	}
+	defer os.Remove(path)
+	if err := ioutil.WriteFile(path, png, 0600); err != nil {
+		return err
+	}
+
+	_, err = run("osascript", "-e", `set the clipboard to (read (POSIX file "`+path+`") as «class PNGf»)`)
+
+	return err
+}
+
+// Create empty temporary file
+func tempFile() (string, error) {
+	file, err := ioutil.TempFile("", "lgtmgen-*.png")
+	if err != nil {
+		return "", err
+	}
+
+	return file.Name(), file.Close()
+}
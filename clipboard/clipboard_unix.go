@@ -0,0 +1,30 @@
+//go:build !darwin && !windows
+
+package clipboard
+
+import (
+	"os"
+)
+
+// Read clipboard as PNG by wl-paste on Wayland or xclip on X11
+func read() ([]byte, error) {
+	if isWayland() {
+		return run("wl-paste", "--no-newline", "--type", "image/png")
+	}
+
+	return run("xclip", "-selection", "clipboard", "-target", "image/png", "-out")
+}
+
+// Write PNG into clipboard by wl-copy on Wayland or xclip on X11
+func write(png []byte) error {
+	if isWayland() {
+		return pipe(png, "wl-copy", "--type", "image/png")
+	}
+
+	return pipe(png, "xclip", "-selection", "clipboard", "-target", "image/png", "-in")
+}
+
+// Session is Wayland
+func isWayland() bool {
+	return os.Getenv("WAYLAND_DISPLAY") != ""
+}
@@ -0,0 +1,57 @@
+package clipboard
+
+import (
+	"io/ioutil"
+	"os"
+)
+
+// Read clipboard as PNG through temporary file
+func read() ([]byte, error) {
+	path, err := tempFile()
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(path)
+
+	_, err = powershell(`$image = [System.Windows.Forms.Clipboard]::GetImage(); ` +
+		`if ($image) { $image.Save('` + path + `', [System.Drawing.Imaging.ImageFormat]::Png) }`)
+	if err != nil {
+		return nil, err
+	}
+
+	return ioutil.ReadFile(path)
+}
+
+// Write PNG into clipboard through temporary file
+func write(png []byte) error {
+	path, err := tempFile()
+	if err != nil {
+		return err
+	}
+	defer os.Remove(path)
+	if err := ioutil.WriteFile(path, png, 0600); err != nil {
+		return err
+	}
+
+	_, err = powershell(`$image = [System.Drawing.Image]::FromFile('` + path + `'); ` +
+		`[System.Windows.Forms.Clipboard]::SetImage($image); $image.Dispose()`)
+
+	return err
+}
+
+// Run PowerShell script with Windows Forms
+// clipboard is available in single threaded apartment only
+func powershell(script string) ([]byte, error) {
+	return run("powershell.exe", "-NoProfile", "-STA", "-Command",
+		`Add-Type -AssemblyName System.Windows.Forms, System.Drawing; `+script)
+}
+
+// Create empty temporary file
+func tempFile() (string, error) {
+	file, err := ioutil.TempFile("", "lgtmgen-*.png")
+	if err != nil {
+		return "", err
+	}
+
+	return file.Name(), file.Close()
+}
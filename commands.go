@@ -0,0 +1,108 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"fmt"
+	"github.com/neko-neko/lgtmgen/logger"
+)
+
+// command is subcommand of CLI
+type command struct {
+	name  string
+	usage string
+
+	// run is called with args starting with command name
+	run func(cli *CLI, args []string) int
+}
+
+// Get subcommands in order of help
+// gen is default command
+func commands() []command {
+	return []command{
+		{"gen", "Generate LGTM images of inputs(default)", (*CLI).runGen},
+		{"watch", "Keep generating LGTM images of new images in directory", (*CLI).runWatch},
+		{"tui", "Pick images and options interactively", (*CLI).runTUI},
+		{"validate", "Check images of directory before generating", (*CLI).runValidate},
+		{"random", "Generate LGTM image from random image", (*CLI).runRandom},
+		{"masks", "List available mask styles", (*CLI).runMasks},
+		{"serve", "Serve LGTM image generation over HTTP or gRPC", (*CLI).runServe},
+		{"worker", "Generate LGTM images of jobs of queue", (*CLI).runWorker},
+		{"lambda", "Run as AWS Lambda function", (*CLI).runLambda},
+		{"bot", "Run chat bot server", (*CLI).runBot},
+		{"completion", "Print shell completion script(bash, zsh, fish)", (*CLI).runCompletion},
+		{"version", "Print version information", (*CLI).runVersion},
+		{"help", "Print commands", (*CLI).runHelp},
+	}
+}
+
+// Find subcommand of name
+func findCommand(name string) (command, bool) {
+	for _, c := range commands() {
+		if c.name == name {
+			return c, true
+		}
+	}
+
+	return command{}, false
+}
+
+// Get name of flag set of command
+// e.g. "gen" => "lgtmgen gen", "/usr/bin/lgtmgen" => "lgtmgen"
+func commandName(name string) string {
+	if _, ok := findCommand(name); ok {
+		return Name + " " + name
+	}
+
+	return Name
+}
+
+// Generate LGTM images
+func (cli *CLI) runGen(args []string) int {
+	return cli.gen(args, false)
+}
+
+// Generate LGTM images of input directory as they are added
+// same as gen --watch
+func (cli *CLI) runWatch(args []string) int {
+	return cli.gen(args, true)
+}
+
+// Print version
+func (cli *CLI) runVersion(args []string) int {
+	cli.log.Resultf(logger.LevelQuiet, "%s version %s", Name, Version)
+	return ExitCodeOK
+}
+
+// Print subcommands
+func (cli *CLI) runHelp(args []string) int {
+	fmt.Fprintf(cli.errStream, "Usage: %s [command] [options]\n", Name)
+	cli.printCommands()
+	return ExitCodeOK
+}
+
+// Print subcommands and their usage
+func (cli *CLI) printCommands() {
+	fmt.Fprintf(cli.errStream, "\nCommands:\n")
+	for _, c := range commands() {
+		fmt.Fprintf(cli.errStream, "  %-10s %s\n", c.name, c.usage)
+	}
+	fmt.Fprintf(cli.errStream, "\nRun '%s <command> -h' for options of command.\n", Name)
+}
@@ -0,0 +1,130 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"fmt"
+	"github.com/disintegration/imaging"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/report"
+	"image"
+	"image/color"
+	"image/draw"
+	"path/filepath"
+	"strings"
+)
+
+// CompareSuffix is added to base name of comparison image
+// e.g. cat.jpg => cat_compare.png
+const CompareSuffix = "_compare"
+
+// compareDividerWidth is width of line between original and output of slider comparison
+const compareDividerWidth = 2
+
+// comparison writes image of original and output next to output
+type comparison struct {
+	// mode is layout, side or slider
+	mode string
+
+	// autoOrient rotates original by EXIF orientation as output is
+	autoOrient bool
+}
+
+// Get names of comparison layouts
+// side puts original left of output, slider shows left half of original over output
+func compareModes() []string {
+	return []string{"side", "slider"}
+}
+
+// constructor
+// nil is returned for empty mode
+func newComparison(mode string, autoOrient bool) (*comparison, error) {
+	if mode == "" {
+		return nil, nil
+	}
+	for _, m := range compareModes() {
+		if m == mode {
+			return &comparison{mode: mode, autoOrient: autoOrient}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown compare mode %s(%s)", mode, strings.Join(compareModes(), ", "))
+}
+
+// Get comparison path of output
+// comparison is PNG not to blur difference
+func comparePath(output string) string {
+	return strings.TrimSuffix(output, filepath.Ext(output)) + CompareSuffix + ".png"
+}
+
+// File is comparison of output
+func isComparison(path string) bool {
+	return strings.HasSuffix(path, CompareSuffix+".png")
+}
+
+// Write comparison of original and output of successful result
+// output keeps success even if comparison fails, e.g. video output
+func (cli *CLI) compareResult(result *report.Result, c *comparison) {
+	if c == nil || result.Status != report.StatusSuccess || lgtm.IsVideo(result.Output) {
+		return
+	}
+
+	path := comparePath(result.Output)
+	original, err := imaging.Open(result.Input, imaging.AutoOrientation(c.autoOrient))
+	if err != nil {
+		cli.log.Warnf("[compare: %s] %s", err, path)
+		return
+	}
+	output, err := imaging.Open(result.Output)
+	if err != nil {
+		cli.log.Warnf("[compare: %s] %s", err, path)
+		return
+	}
+	if err := imaging.Save(c.compose(original, output), path); err != nil {
+		cli.log.Warnf("[compare: %s] %s", err, path)
+		return
+	}
+	cli.log.Verbosef("[compare] %s", path)
+}
+
+// Compose original and output by layout
+// original is fitted to output since output may be cropped or downscaled
+func (c *comparison) compose(original image.Image, output image.Image) image.Image {
+	size := output.Bounds().Size()
+	if original.Bounds().Size() != size {
+		original = imaging.Fill(original, size.X, size.Y, imaging.Center, imaging.Lanczos)
+	}
+
+	if c.mode == "side" {
+		canvas := image.NewNRGBA(image.Rect(0, 0, size.X*2, size.Y))
+		draw.Draw(canvas, image.Rect(0, 0, size.X, size.Y), original, original.Bounds().Min, draw.Src)
+		draw.Draw(canvas, image.Rect(size.X, 0, size.X*2, size.Y), output, output.Bounds().Min, draw.Src)
+		return canvas
+	}
+
+	half := size.X / 2
+	canvas := image.NewNRGBA(image.Rect(0, 0, size.X, size.Y))
+	draw.Draw(canvas, canvas.Bounds(), output, output.Bounds().Min, draw.Src)
+	draw.Draw(canvas, image.Rect(0, 0, half, size.Y), original, original.Bounds().Min, draw.Src)
+	divider := image.Rect(half-compareDividerWidth/2, 0, half+compareDividerWidth/2, size.Y)
+	draw.Draw(canvas, divider, image.NewUniform(color.White), image.Point{}, draw.Src)
+
+	return canvas
+}
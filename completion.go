@@ -0,0 +1,332 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"github.com/neko-neko/lgtmgen/effect"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/logger"
+	"github.com/neko-neko/lgtmgen/mask_image"
+	"github.com/neko-neko/lgtmgen/preview"
+	"github.com/neko-neko/lgtmgen/provider"
+	"github.com/neko-neko/lgtmgen/uploader"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+// Shells of completion scripts
+var completionShells = []string{"bash", "zsh", "fish"}
+
+// flagValues are candidates of flag values by flag name
+var flagValues = map[string]func() []string{
+	"compare":         compareModes,
+	"effects":         effect.Names,
+	"filter":          effect.FilterNames,
+	"format":          lgtm.FormatNames,
+	"gif-quantizer":   lgtm.QuantizerNames,
+	"output-format":   func() []string { return []string{"text", "json"} },
+	"partition":       func() []string { return []string{DatePartition} },
+	"png-compression": func() []string { return []string{"default", "none", "fast", "best"} },
+	"position":        lgtm.PositionNames,
+	"preview":         preview.Modes,
+	"provider":        provider.Names,
+	"snippet":         uploader.SnippetNames,
+	"style":           mask_image.StyleNames,
+	"text-align":      func() []string { return []string{"left", "center", "right"} },
+	"upload":          uploader.Names,
+}
+
+// shortFlags are long names of short flags which take values listed in flagValues
+var shortFlags = map[string]map[string]string{
+	"gen":    {"p": "position"},
+	"watch":  {"p": "position"},
+	"random": {"p": "provider"},
+}
+
+// completionFlag is flag of command
+type completionFlag struct {
+	name  string
+	usage string
+
+	// values are candidates of value, nil for flags of any value
+	values []string
+
+	// bool flag takes no value
+	bool bool
+}
+
+// Print shell completion script
+// e.g. lgtmgen completion bash > /etc/bash_completion.d/lgtmgen
+func (cli *CLI) runCompletion(args []string) int {
+	flags := flag.NewFlagSet(Name+" completion", flag.ContinueOnError)
+	flags.SetOutput(cli.errStream)
+	flags.Usage = func() {
+		fmt.Fprintf(cli.errStream, "Usage: %s completion %s\n", Name, strings.Join(completionShells, "|"))
+	}
+	if err := flags.Parse(args[1:]); err != nil {
+		return ExitCodeError
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return ExitCodeError
+	}
+
+	flagsOf := map[string][]completionFlag{}
+	for _, c := range commands() {
+		flagsOf[c.name] = commandFlags(c)
+	}
+
+	switch flags.Arg(0) {
+	case "bash":
+		writeBashCompletion(cli.outStream, flagsOf)
+	case "zsh":
+		writeZshCompletion(cli.outStream, flagsOf)
+	case "fish":
+		writeFishCompletion(cli.outStream, flagsOf)
+	default:
+		cli.log.Errorf("unsupported shell %s(supported: %s).", flags.Arg(0), strings.Join(completionShells, ", "))
+		return ExitCodeError
+	}
+
+	return ExitCodeOK
+}
+
+// Get flags of command from its usage
+// flags are defined while command runs, so command is run with -h and output is discarded
+func commandFlags(c command) []completionFlag {
+	if c.name == "completion" || c.name == "help" || c.name == "version" {
+		return nil
+	}
+
+	var usage bytes.Buffer
+	probe := &CLI{inStream: strings.NewReader(""), outStream: ioutil.Discard, errStream: &usage}
+	probe.log = logger.New(ioutil.Discard, ioutil.Discard, logger.LevelQuiet)
+	c.run(probe, []string{c.name, "-h"})
+
+	// PrintDefaults prints "  -name type" and usage in next line, or "  -x\tusage" for short bool flag
+	var flags []completionFlag
+	for _, line := range strings.Split(usage.String(), "\n") {
+		switch {
+		case strings.HasPrefix(line, "  -"):
+			head, usage, _ := strings.Cut(strings.TrimPrefix(line, "  -"), "\t")
+			name, typ, _ := strings.Cut(head, " ")
+			f := completionFlag{name: name, usage: usage, bool: typ == ""}
+			long := name
+			if l, ok := shortFlags[c.name][name]; ok {
+				long = l
+			}
+			if values, ok := flagValues[long]; ok && !f.bool {
+				f.values = values()
+			}
+			flags = append(flags, f)
+		case strings.HasPrefix(line, "    \t") && len(flags) > 0 && flags[len(flags)-1].usage == "":
+			flags[len(flags)-1].usage = strings.TrimSpace(line)
+		}
+	}
+
+	return flags
+}
+
+// Get flag as typed in shell
+// e.g. "o" => "-o", "output" => "--output"
+func dashed(name string) string {
+	if len(name) == 1 {
+		return "-" + name
+	}
+
+	return "--" + name
+}
+
+// Get names of commands
+func commandNames() []string {
+	var names []string
+	for _, c := range commands() {
+		names = append(names, c.name)
+	}
+
+	return names
+}
+
+// Get names of commands except name
+func otherCommands(name string) []string {
+	var names []string
+	for _, c := range commandNames() {
+		if c != name {
+			names = append(names, c)
+		}
+	}
+
+	return names
+}
+
+// Get sorted names of commands having flags
+func sortedCommands(flagsOf map[string][]completionFlag) []string {
+	var names []string
+	for name := range flagsOf {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Write bash completion
+// flags without command are flags of gen
+func writeBashCompletion(w io.Writer, flagsOf map[string][]completionFlag) {
+	fmt.Fprintf(w, "# bash completion for %s\n", Name)
+	fmt.Fprintf(w, "_%s() {\n", Name)
+	fmt.Fprintf(w, "    local cur=\"${COMP_WORDS[COMP_CWORD]}\" prev=\"${COMP_WORDS[COMP_CWORD-1]#-}\" cmd=gen\n")
+	fmt.Fprintf(w, "    prev=\"${prev#-}\"\n")
+	fmt.Fprintf(w, "    case \"${COMP_WORDS[1]}\" in\n")
+	fmt.Fprintf(w, "        %s) [[ $COMP_CWORD -gt 1 ]] && cmd=\"${COMP_WORDS[1]}\" ;;\n", strings.Join(commandNames(), "|"))
+	fmt.Fprintf(w, "    esac\n\n")
+
+	fmt.Fprintf(w, "    case \"$cmd:$prev\" in\n")
+	for _, name := range sortedCommands(flagsOf) {
+		for _, f := range flagsOf[name] {
+			if f.values != nil {
+				fmt.Fprintf(w, "        %s:%s) COMPREPLY=($(compgen -W \"%s\" -- \"$cur\")); return ;;\n", name, f.name, strings.Join(f.values, " "))
+			}
+		}
+	}
+	fmt.Fprintf(w, "    esac\n\n")
+
+	fmt.Fprintf(w, "    if [[ $cmd == completion ]]; then\n")
+	fmt.Fprintf(w, "        COMPREPLY=($(compgen -W \"%s\" -- \"$cur\"))\n", strings.Join(completionShells, " "))
+	fmt.Fprintf(w, "        return\n")
+	fmt.Fprintf(w, "    fi\n")
+	fmt.Fprintf(w, "    if [[ $cur == -* ]]; then\n")
+	fmt.Fprintf(w, "        case \"$cmd\" in\n")
+	for _, name := range sortedCommands(flagsOf) {
+		var names []string
+		for _, f := range flagsOf[name] {
+			names = append(names, dashed(f.name))
+		}
+		if len(names) > 0 {
+			fmt.Fprintf(w, "            %s) COMPREPLY=($(compgen -W \"%s\" -- \"$cur\")) ;;\n", name, strings.Join(names, " "))
+		}
+	}
+	fmt.Fprintf(w, "        esac\n")
+	fmt.Fprintf(w, "        return\n")
+	fmt.Fprintf(w, "    fi\n")
+	fmt.Fprintf(w, "    if [[ $COMP_CWORD -eq 1 ]]; then\n")
+	fmt.Fprintf(w, "        COMPREPLY=($(compgen -W \"%s\" -- \"$cur\"))\n", strings.Join(commandNames(), " "))
+	fmt.Fprintf(w, "    fi\n")
+	fmt.Fprintf(w, "}\n")
+	fmt.Fprintf(w, "complete -o default -F _%s %s\n", Name, Name)
+}
+
+// Write zsh completion
+func writeZshCompletion(w io.Writer, flagsOf map[string][]completionFlag) {
+	fmt.Fprintf(w, "#compdef %s\n\n", Name)
+	fmt.Fprintf(w, "_%s() {\n", Name)
+	fmt.Fprintf(w, "    local cmd=gen prev=${words[CURRENT-1]#-}\n")
+	fmt.Fprintf(w, "    prev=${prev#-}\n")
+	fmt.Fprintf(w, "    case ${words[2]} in\n")
+	fmt.Fprintf(w, "        %s) (( CURRENT > 2 )) && cmd=${words[2]} ;;\n", strings.Join(commandNames(), "|"))
+	fmt.Fprintf(w, "    esac\n\n")
+
+	fmt.Fprintf(w, "    case $cmd:$prev in\n")
+	for _, name := range sortedCommands(flagsOf) {
+		for _, f := range flagsOf[name] {
+			if f.values != nil {
+				fmt.Fprintf(w, "        %s:%s) compadd -- %s; return ;;\n", name, f.name, strings.Join(f.values, " "))
+			}
+		}
+	}
+	fmt.Fprintf(w, "    esac\n\n")
+
+	fmt.Fprintf(w, "    if [[ $cmd == completion ]]; then\n")
+	fmt.Fprintf(w, "        compadd -- %s\n", strings.Join(completionShells, " "))
+	fmt.Fprintf(w, "        return\n")
+	fmt.Fprintf(w, "    fi\n")
+	fmt.Fprintf(w, "    if [[ ${words[CURRENT]} == -* ]]; then\n")
+	fmt.Fprintf(w, "        local -a opts\n")
+	fmt.Fprintf(w, "        case $cmd in\n")
+	for _, name := range sortedCommands(flagsOf) {
+		var opts []string
+		for _, f := range flagsOf[name] {
+			opts = append(opts, zshQuote(dashed(f.name)+":"+f.usage))
+		}
+		if len(opts) > 0 {
+			fmt.Fprintf(w, "            %s) opts=(%s) ;;\n", name, strings.Join(opts, " "))
+		}
+	}
+	fmt.Fprintf(w, "        esac\n")
+	fmt.Fprintf(w, "        _describe option opts\n")
+	fmt.Fprintf(w, "        return\n")
+	fmt.Fprintf(w, "    fi\n")
+	fmt.Fprintf(w, "    if (( CURRENT == 2 )); then\n")
+	var cmds []string
+	for _, c := range commands() {
+		cmds = append(cmds, zshQuote(c.name+":"+c.usage))
+	}
+	fmt.Fprintf(w, "        local -a cmds=(%s)\n", strings.Join(cmds, " "))
+	fmt.Fprintf(w, "        _describe command cmds\n")
+	fmt.Fprintf(w, "    fi\n")
+	fmt.Fprintf(w, "    _files\n")
+	fmt.Fprintf(w, "}\n\n")
+	fmt.Fprintf(w, "compdef _%s %s\n", Name, Name)
+}
+
+// Quote word for zsh
+func zshQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// Write fish completion
+// flags of gen are completed without command too
+func writeFishCompletion(w io.Writer, flagsOf map[string][]completionFlag) {
+	names := strings.Join(commandNames(), " ")
+	fmt.Fprintf(w, "# fish completion for %s\n", Name)
+	for _, c := range commands() {
+		fmt.Fprintf(w, "complete -c %s -n \"not __fish_seen_subcommand_from %s\" -f -a %s -d %s\n", Name, names, c.name, fishQuote(c.usage))
+	}
+	fmt.Fprintf(w, "complete -c %s -n \"__fish_seen_subcommand_from completion\" -f -a %s\n", Name, fishQuote(strings.Join(completionShells, " ")))
+	for _, name := range sortedCommands(flagsOf) {
+		condition := "__fish_seen_subcommand_from " + name
+		if name == "gen" {
+			condition = "not __fish_seen_subcommand_from " + strings.Join(otherCommands("gen"), " ")
+		}
+		for _, f := range flagsOf[name] {
+			option := "-l " + f.name
+			if len(f.name) == 1 {
+				option = "-s " + f.name
+			}
+			switch {
+			case f.values != nil:
+				option += " -x -a " + fishQuote(strings.Join(f.values, " "))
+			case !f.bool:
+				option += " -r"
+			}
+			fmt.Fprintf(w, "complete -c %s -n %s %s -d %s\n", Name, fishQuote(condition), option, fishQuote(f.usage))
+		}
+	}
+}
+
+// Quote word for fish
+func fishQuote(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`).Replace(s) + "'"
+}
@@ -0,0 +1,117 @@
+// Package config loads default options from YAML file.
+package config
+
+import (
+	"gopkg.in/yaml.v3"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileName is default config file name in home directory
+const FileName = ".lgtmgen.yaml"
+
+// Config is default values of commandline flags
+// commandline flags take precedence over config
+type Config struct {
+	Output         string        `yaml:"output"`
+	Mask           string        `yaml:"mask"`
+	Style          string        `yaml:"style"`
+	MaskScale      float64       `yaml:"mask_scale"`
+	Position       string        `yaml:"position"`
+	Opacity        float64       `yaml:"opacity"`
+	Rotate         float64       `yaml:"rotate"`
+	Tile           bool          `yaml:"tile"`
+	TileSpacing    float64       `yaml:"tile_spacing"`
+	AutoContrast   bool          `yaml:"auto_contrast"`
+	MaskShadow     bool          `yaml:"mask_shadow"`
+	BlurBehind     float64       `yaml:"blur_behind"`
+	Scrim          float64       `yaml:"scrim"`
+	ScrimColor     string        `yaml:"scrim_color"`
+	ScrimGradient  bool          `yaml:"scrim_gradient"`
+	Format         string        `yaml:"format"`
+	Prefix         string        `yaml:"prefix"`
+	Suffix         string        `yaml:"suffix"`
+	NameTemplate   string        `yaml:"name_template"`
+	Name           string        `yaml:"name"`
+	Partition      string        `yaml:"partition"`
+	Ext            string        `yaml:"ext"`
+	Crop           string        `yaml:"crop"`
+	Effects        string        `yaml:"effects"`
+	Filter         string        `yaml:"filter"`
+	Quality        int           `yaml:"quality"`
+	MaxWidth       int           `yaml:"max_width"`
+	MaxHeight      int           `yaml:"max_height"`
+	MaxPixels      int           `yaml:"max_pixels"`
+	MinSize        string        `yaml:"min_size"`
+	MaxSize        string        `yaml:"max_size"`
+	Rounded        int           `yaml:"rounded"`
+	Border         string        `yaml:"border"`
+	PNGCompression string        `yaml:"png_compression"`
+	GIFQuantizer   string        `yaml:"gif_quantizer"`
+	Text           string        `yaml:"text"`
+	Font           string        `yaml:"font"`
+	EmojiDir       string        `yaml:"emoji_dir"`
+	TextColor      string        `yaml:"text_color"`
+	StrokeColor    string        `yaml:"stroke_color"`
+	StrokeWidth    int           `yaml:"stroke_width"`
+	Shadow         bool          `yaml:"shadow"`
+	TextAlign      string        `yaml:"text_align"`
+	LineSpacing    float64       `yaml:"line_spacing"`
+	Concurrency    int           `yaml:"concurrency"`
+	Timeout        time.Duration `yaml:"timeout"`
+	PerFileTimeout time.Duration `yaml:"per_file_timeout"`
+	Retries        int           `yaml:"retries"`
+	RetryDelay     time.Duration `yaml:"retry_delay"`
+	CacheDir       string        `yaml:"cache_dir"`
+	NoCache        bool          `yaml:"no_cache"`
+	Upload         string        `yaml:"upload"`
+	UploadKey      string        `yaml:"upload_key"`
+	OutputFormat   string        `yaml:"output_format"`
+	APIKeys        []APIKey      `yaml:"api_keys"`
+}
+
+// APIKey is key accepted by serve command
+type APIKey struct {
+	Name      string  `yaml:"name"`
+	Key       string  `yaml:"key"`
+	RateLimit float64 `yaml:"rate_limit"`
+	RateBurst int     `yaml:"rate_burst"`
+}
+
+// Get default config file path
+// e.g.
+// $HOME/.lgtmgen.yaml
+func DefaultPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, FileName)
+}
+
+// Load config file
+// empty config is returned if default config file does not exist
+func Load(path string) (*Config, error) {
+	explicit := path != ""
+	if !explicit {
+		path = DefaultPath()
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) && !explicit {
+			return &Config{}, nil
+		}
+		return nil, err
+	}
+
+	c := &Config{}
+	if err := yaml.Unmarshal(data, c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
@@ -0,0 +1,45 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"encoding/base64"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/storage"
+	"io/ioutil"
+)
+
+// Encode local output image as data URI
+// e.g. data:image/png;base64,iVBORw0KGgo...
+func dataURI(output string) (string, error) {
+	if output == ClipboardName || storage.IsRemote(output) {
+		return "", nil
+	}
+	format, err := lgtm.FormatFromFilename(output)
+	if err != nil {
+		return "", err
+	}
+	data, err := ioutil.ReadFile(output)
+	if err != nil {
+		return "", err
+	}
+
+	return "data:" + lgtm.ContentType(format) + ";base64," + base64.StdEncoding.EncodeToString(data), nil
+}
@@ -0,0 +1,75 @@
+package effect
+
+import (
+	"github.com/disintegration/imaging"
+	"image"
+	"image/color"
+)
+
+// FrameRatio is frame width to shorter side of image
+const FrameRatio = 0.04
+
+// compiled-in effects
+func init() {
+	Register("blur", blur)
+	Register("grayscale", grayscale)
+	Register("sepia", sepia)
+	Register("sharpen", sharpen)
+	Register("frame", frame)
+}
+
+// Blur background to make mask stand out
+func blur(img image.Image) (image.Image, error) {
+	return imaging.Blur(img, 3), nil
+}
+
+// Convert to grayscale
+func grayscale(img image.Image) (image.Image, error) {
+	return imaging.Grayscale(img), nil
+}
+
+// Tone in sepia
+func sepia(img image.Image) (image.Image, error) {
+	gray := imaging.Grayscale(img)
+
+	return imaging.AdjustFunc(gray, func(c color.NRGBA) color.NRGBA {
+		return color.NRGBA{
+			R: clamp(float64(c.R) * 1.07),
+			G: clamp(float64(c.G) * 0.74),
+			B: clamp(float64(c.B) * 0.43),
+			A: c.A,
+		}
+	}), nil
+}
+
+// Sharpen details
+func sharpen(img image.Image) (image.Image, error) {
+	return imaging.Sharpen(img, 1), nil
+}
+
+// Draw white frame inside image edges
+func frame(img image.Image) (image.Image, error) {
+	size := img.Bounds().Size()
+	shorter := size.X
+	if size.Y < shorter {
+		shorter = size.Y
+	}
+	width := int(float64(shorter) * FrameRatio)
+	if width < 1 {
+		return img, nil
+	}
+
+	inner := imaging.Crop(img, img.Bounds().Inset(width))
+	dst := imaging.New(size.X, size.Y, color.White)
+
+	return imaging.Paste(dst, inner, image.Pt(width, width)), nil
+}
+
+// Clamp float to color value
+func clamp(v float64) uint8 {
+	if v > 255 {
+		return 255
+	}
+
+	return uint8(v)
+}
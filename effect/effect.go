@@ -0,0 +1,114 @@
+// Package effect applies image effects before overlaying mask.
+// Effects are registered by name and chained in order.
+package effect
+
+import (
+	"fmt"
+	"image"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Processor applies effect to image
+type Processor func(image.Image) (image.Image, error)
+
+var (
+	mu       sync.RWMutex
+	registry = map[string]Processor{}
+)
+
+// Register effect by name
+// registering same name again replaces effect
+func Register(name string, p Processor) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	registry[name] = p
+}
+
+// Get registered effect
+func Lookup(name string) (Processor, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	p, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown effect %s", name)
+	}
+
+	return p, nil
+}
+
+// Get registered effect names
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	var names []string
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Parse comma separated effect names
+// e.g.
+// "blur,frame" => [blur, frame]
+func Parse(s string) ([]Processor, error) {
+	var processors []Processor
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		p, err := Lookup(name)
+		if err != nil {
+			return nil, err
+		}
+		processors = append(processors, p)
+	}
+
+	return processors, nil
+}
+
+// Apply effects in order
+func Apply(img image.Image, processors ...Processor) (image.Image, error) {
+	for _, p := range processors {
+		var err error
+		if img, err = p(img); err != nil {
+			return nil, err
+		}
+	}
+
+	return img, nil
+}
+
+// FilterNone is color filter which applies nothing, e.g. to override configured filter
+const FilterNone = "none"
+
+// Get color filter names
+// filters are effects which tone whole source
+func FilterNames() []string {
+	return []string{"grayscale", "sepia", FilterNone}
+}
+
+// Prepend color filter to comma separated effect names
+// e.g.
+// "sepia", "frame" => "sepia,frame"
+func WithFilter(filter string, effects string) (string, error) {
+	switch filter {
+	case "", FilterNone:
+		return effects, nil
+	case "grayscale", "sepia":
+	default:
+		return "", fmt.Errorf("unknown filter %s", filter)
+	}
+	if strings.TrimSpace(effects) == "" {
+		return filter, nil
+	}
+
+	return filter + "," + effects, nil
+}
@@ -0,0 +1,53 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// extensions are file extensions of inputs to process
+// nil includes every extension
+type extensions map[string]bool
+
+// Parse comma separated extensions
+// e.g. "jpg,.PNG" => .jpg, .png
+func parseExtensions(value string) extensions {
+	var exts extensions
+	for _, ext := range strings.Split(value, ",") {
+		ext = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(ext), "."))
+		if ext == "" {
+			continue
+		}
+		if exts == nil {
+			exts = extensions{}
+		}
+		exts["."+ext] = true
+	}
+
+	return exts
+}
+
+// Path has one of extensions
+// case of extension is ignored
+func (e extensions) match(path string) bool {
+	return e == nil || e[strings.ToLower(filepath.Ext(path))]
+}
@@ -0,0 +1,146 @@
+// Package fetcher downloads source images over HTTP.
+package fetcher
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// DefaultTimeout is timeout of a whole request
+const DefaultTimeout = 10 * time.Second
+
+// DefaultMaxSize is max bytes of downloaded image
+const DefaultMaxSize = 20 << 20
+
+var (
+	// ErrTooLarge is returned when response body exceeds max size
+	ErrTooLarge = errors.New("image is too large")
+
+	// ErrNotImage is returned when response is not an image
+	ErrNotImage = errors.New("content is not an image")
+)
+
+// StatusError is returned when response is not 200 OK
+type StatusError struct {
+	URL    string
+	Status string
+	Code   int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("fetch %s: %s", e.URL, e.Status)
+}
+
+// Temporary reports whether server may respond successfully later
+// e.g. 429 Too Many Requests, 503 Service Unavailable
+func (e *StatusError) Temporary() bool {
+	return e.Code == http.StatusTooManyRequests || e.Code >= 500
+}
+
+// ReadError is returned when response body is cut off, e.g. connection is closed before end
+type ReadError struct {
+	URL string
+	Err error
+}
+
+func (e *ReadError) Error() string {
+	return fmt.Sprintf("fetch %s: %s", e.URL, e.Err)
+}
+
+func (e *ReadError) Unwrap() error {
+	return e.Err
+}
+
+// Temporary reports body may be read fully on next request
+func (e *ReadError) Temporary() bool {
+	return true
+}
+
+type Fetcher struct {
+	Client  *http.Client
+	MaxSize int64
+}
+
+// constructor
+func NewFetcher() *Fetcher {
+	return &Fetcher{
+		Client:  &http.Client{Timeout: DefaultTimeout},
+		MaxSize: DefaultMaxSize,
+	}
+}
+
+// Download image of url
+func (f *Fetcher) Fetch(rawURL string) ([]byte, error) {
+	if !IsURL(rawURL) {
+		return nil, fmt.Errorf("invalid url %s", rawURL)
+	}
+
+	resp, err := f.Client.Get(rawURL)
+	if errors.Is(err, ErrNonPublicAddress) {
+		// dial error has resolved address
+		return nil, fmt.Errorf("fetch %s: %w", rawURL, ErrNonPublicAddress)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &StatusError{URL: rawURL, Status: resp.Status, Code: resp.StatusCode}
+	}
+
+	// validate content type
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil || !strings.HasPrefix(mediaType, "image/") {
+		return nil, ErrNotImage
+	}
+
+	// validate size
+	if resp.ContentLength > f.MaxSize {
+		return nil, ErrTooLarge
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, f.MaxSize+1))
+	if err != nil {
+		return nil, &ReadError{URL: rawURL, Err: err}
+	}
+	if int64(len(body)) > f.MaxSize {
+		return nil, ErrTooLarge
+	}
+
+	return body, nil
+}
+
+// Is http or https url
+func IsURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// Get file name from url path
+// e.g.
+// rawURL="https://example.com/cat.jpg?size=large" => "cat.jpg"
+func FileName(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+
+	name := path.Base(u.Path)
+	if name == "/" || name == "." {
+		return ""
+	}
+
+	return name
+}
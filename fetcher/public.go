@@ -0,0 +1,87 @@
+package fetcher
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// ErrNonPublicAddress is returned when url resolves to loopback, private or link-local address
+var ErrNonPublicAddress = errors.New("address is not public")
+
+// nonPublicNetworks are reserved ranges which net.IP methods do not cover
+// e.g. carrier-grade NAT, benchmarking and NAT64 of private IPv4
+var nonPublicNetworks = parseNetworks(
+	"0.0.0.0/8",
+	"100.64.0.0/10",
+	"192.0.0.0/24",
+	"198.18.0.0/15",
+	"240.0.0.0/4",
+	"64:ff9b::/96",
+)
+
+// constructor of fetcher for servers which download urls of clients
+// it connects only to public addresses, so loopback, private networks and
+// metadata endpoints such as 169.254.169.254 are not reachable. address is checked
+// after DNS resolution on every connection, which includes redirects
+func NewPublicFetcher() *Fetcher {
+	dialer := &net.Dialer{
+		Timeout: DefaultTimeout,
+		Control: func(network string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			// resolved address is not told to client
+			if ip := net.ParseIP(host); ip == nil || !IsPublicIP(ip) {
+				return ErrNonPublicAddress
+			}
+			return nil
+		},
+	}
+
+	// proxy would be dialed instead of url host
+	return &Fetcher{
+		Client: &http.Client{
+			Timeout: DefaultTimeout,
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: 10 * time.Second,
+				MaxIdleConns:        100,
+				IdleConnTimeout:     90 * time.Second,
+			},
+		},
+		MaxSize: DefaultMaxSize,
+	}
+}
+
+// IP is public unicast address
+func IsPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Parse CIDR networks
+func parseNetworks(cidrs ...string) []*net.IPNet {
+	var networks []*net.IPNet
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		networks = append(networks, network)
+	}
+
+	return networks
+}
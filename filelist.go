@@ -0,0 +1,104 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// StdinFileList is file list name to read from stdin
+const StdinFileList = "-"
+
+// Read input paths from file list
+// file list has a path per line, empty lines and lines starting with # are ignored
+// e.g.
+// find . -name '*.png' | lgtmgen --filelist - -o out/
+func (cli *CLI) readFileList(name string) ([]string, error) {
+	var r io.Reader = cli.inStream
+	if name != StdinFileList {
+		file, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	var paths []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		path := strings.TrimSpace(scanner.Text())
+		if path == "" || strings.HasPrefix(path, "#") {
+			continue
+		}
+		paths = append(paths, path)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+// Get deepest directory which contains all paths as absolute path
+// outputs of file list mirror tree under it
+// empty if paths have no common directory(e.g. different volumes)
+func commonDirectory(paths []string) (string, error) {
+	var common string
+	for i, path := range paths {
+		path, err := filepath.Abs(path)
+		if err != nil {
+			return "", err
+		}
+		dir := filepath.Dir(path)
+		if i == 0 {
+			common = dir
+			continue
+		}
+		for common != "" && !isUnder(dir, common) {
+			parent := filepath.Dir(common)
+			if parent == common {
+				parent = ""
+			}
+			common = parent
+		}
+	}
+
+	return common, nil
+}
+
+// Get path relative to root directory
+// both are made absolute, so relative path of file list is resolved
+func relativeTo(root string, path string) (string, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return "", err
+	}
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Rel(root, path)
+}
@@ -0,0 +1,117 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"bytes"
+	"html/template"
+	"io/fs"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// GalleryFileName is name of gallery page in output directory
+const GalleryFileName = "index.html"
+
+// galleryTemplate is page of thumbnails linking to images
+var galleryTemplate = template.Must(template.New("gallery").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>LGTM gallery</title>
+<style>
+body { font-family: sans-serif; margin: 24px; background: #f6f8fa; }
+.grid { display: grid; grid-template-columns: repeat(auto-fill, minmax(220px, 1fr)); gap: 16px; }
+figure { margin: 0; background: #fff; border: 1px solid #d0d7de; border-radius: 6px; padding: 8px; }
+img { width: 100%; height: 180px; object-fit: contain; }
+figcaption { font-size: 12px; color: #57606a; overflow-wrap: anywhere; }
+</style>
+</head>
+<body>
+<h1>LGTM gallery</h1>
+<p>{{len .Images}} images, generated at {{.Date}}</p>
+<div class="grid">
+{{- range .Images}}
+<figure><a href="{{.Path}}"><img src="{{.Thumbnail}}" alt="{{.Path}}" loading="lazy"></a><figcaption>{{.Path}}</figcaption></figure>
+{{- end}}
+</div>
+</body>
+</html>
+`))
+
+// galleryItem is image in gallery
+// thumbnail is image itself unless thumbnail is written
+type galleryItem struct {
+	Path      string
+	Thumbnail string
+}
+
+// Get gallery item of image path relative to directory
+func galleryImage(dir string, path string) (galleryItem, error) {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return galleryItem{}, err
+	}
+	item := galleryItem{Path: filepath.ToSlash(rel), Thumbnail: filepath.ToSlash(rel)}
+	if existFile(thumbnailPath(path)) {
+		item.Thumbnail = filepath.ToSlash(thumbnailPath(rel))
+	}
+
+	return item, nil
+}
+
+// Write gallery page of all images in output directory
+// outputs of previous runs are also listed, paths are relative to directory
+// thumbnails are shown instead of images if they exist
+func writeGallery(dir string) (string, error) {
+	var images []galleryItem
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || !isImageFile(path) || isThumbnail(path) || isComparison(path) {
+			return nil
+		}
+		image, err := galleryImage(dir, path)
+		if err != nil {
+			return err
+		}
+		images = append(images, image)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Slice(images, func(i, j int) bool { return images[i].Path < images[j].Path })
+
+	var page bytes.Buffer
+	err = galleryTemplate.Execute(&page, struct {
+		Images []galleryItem
+		Date   string
+	}{images, time.Now().Format("2006-01-02 15:04")})
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, GalleryFileName)
+
+	return path, ioutil.WriteFile(path, page.Bytes(), 0644)
+}
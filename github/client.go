@@ -0,0 +1,60 @@
+// Package github provides GitHub webhook bot integration.
+package github
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// APIEndpoint is base url of GitHub REST API
+const APIEndpoint = "https://api.github.com"
+
+// DefaultTimeout is timeout of API request
+const DefaultTimeout = 30 * time.Second
+
+// Client calls GitHub REST API with token
+type Client struct {
+	Token    string
+	Endpoint string
+	HTTP     *http.Client
+}
+
+// constructor
+func NewClient(token string) *Client {
+	return &Client{
+		Token:    token,
+		Endpoint: APIEndpoint,
+		HTTP:     &http.Client{Timeout: DefaultTimeout},
+	}
+}
+
+// Post comment to issue or pull request
+func (c *Client) CreateComment(repository string, number int, body string) error {
+	payload, err := json.Marshal(map[string]string{"body": body})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/issues/%d/comments", c.Endpoint, repository, number)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("github: create comment %s", resp.Status)
+	}
+
+	return nil
+}
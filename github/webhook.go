@@ -0,0 +1,192 @@
+package github
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/disintegration/imaging"
+	"github.com/neko-neko/lgtmgen/fetcher"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/provider"
+	"github.com/neko-neko/lgtmgen/uploader"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Command triggers LGTM image comment
+const Command = "/lgtm"
+
+// MaxBodySize is max bytes of webhook payload
+const MaxBodySize = 5 << 20
+
+// ErrInvalidSignature is returned when payload is not signed with secret
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// imagePattern finds attached image in markdown
+var imagePattern = regexp.MustCompile(`!\[[^\]]*\]\((https?://[^)\s]+)\)`)
+
+// issueCommentEvent is part of issue_comment webhook payload
+type issueCommentEvent struct {
+	Action string `json:"action"`
+	Issue  struct {
+		Number      int              `json:"number"`
+		PullRequest *json.RawMessage `json:"pull_request"`
+	} `json:"issue"`
+	Comment struct {
+		Body string `json:"body"`
+		User struct {
+			Type string `json:"type"`
+		} `json:"user"`
+	} `json:"comment"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// WebhookHandler comments LGTM image when "/lgtm" is commented on pull request
+type WebhookHandler struct {
+	// Secret verifies payload is sent by GitHub, it is required
+	Secret string
+
+	Client   *Client
+	Fetcher  *fetcher.Fetcher
+	Provider provider.Provider
+	Uploader uploader.Uploader
+	Options  []lgtm.Option
+
+	// Logger reports errors of background generation
+	Logger *log.Logger
+}
+
+// ServeHTTP implements http.Handler
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, MaxBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := h.verify(r.Header.Get("X-Hub-Signature-256"), body); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	// ignore other events
+	if r.Header.Get("X-GitHub-Event") != "issue_comment" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	var event issueCommentEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sourceURL, ok := parseCommand(event.Comment.Body)
+	if event.Action != "created" || event.Issue.PullRequest == nil || event.Comment.User.Type == "Bot" || !ok {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// GitHub expects response within 10 seconds
+	go func() {
+		if err := h.comment(event.Repository.FullName, event.Issue.Number, sourceURL); err != nil {
+			h.Logger.Printf("[%s] %s#%d\n", err, event.Repository.FullName, event.Issue.Number)
+		}
+	}()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// Generate LGTM image and comment it
+func (h *WebhookHandler) comment(repository string, number int, sourceURL string) error {
+	// use random image if no source is given
+	if sourceURL == "" {
+		if h.Provider == nil {
+			return fmt.Errorf("no source image")
+		}
+		randomURL, err := h.Provider.RandomImageURL("")
+		if err != nil {
+			return err
+		}
+		sourceURL = randomURL
+	}
+
+	body, err := h.Fetcher.Fetch(sourceURL)
+	if err != nil {
+		return err
+	}
+
+	// keep source format if possible
+	name := fetcher.FileName(sourceURL)
+	format, err := imaging.FormatFromFilename(name)
+	if err != nil {
+		format = imaging.PNG
+		name = "lgtm.png"
+	}
+
+	var output bytes.Buffer
+	if err := lgtm.Process(bytes.NewReader(body), &output, format, h.Options...); err != nil {
+		return err
+	}
+
+	url, err := h.Uploader.Upload(name, output.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return h.Client.CreateComment(repository, number, uploader.Markdown(url))
+}
+
+// Verify payload signature
+// every payload is rejected without secret
+// https://docs.github.com/en/webhooks/using-webhooks/validating-webhook-deliveries
+func (h *WebhookHandler) verify(signature string, body []byte) error {
+	if h.Secret == "" {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(h.Secret))
+	mac.Write(body)
+	expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Parse "/lgtm [image-url]" command in comment
+// source url is argument or image attached to comment
+func parseCommand(comment string) (string, bool) {
+	for _, line := range strings.Split(comment, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != Command {
+			continue
+		}
+
+		if len(fields) > 1 && fetcher.IsURL(fields[1]) {
+			return fields[1], true
+		}
+		if match := imagePattern.FindStringSubmatch(comment); match != nil {
+			return match[1], true
+		}
+
+		return "", true
+	}
+
+	return "", false
+}
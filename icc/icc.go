@@ -0,0 +1,299 @@
+// Package icc converts colors of RGB ICC profiles to sRGB.
+package icc
+
+import (
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/draw"
+	"math"
+)
+
+// ErrUnsupported is returned when profile is not RGB profile of matrix and tone curves
+// e.g. CMYK, gray or LUT based profiles
+var ErrUnsupported = errors.New("unsupported ICC profile")
+
+// headerSize is size of ICC profile header before tag table
+const headerSize = 128
+
+// srgbMatrix is sRGB primaries adapted to D50 as in sRGB IEC61966-2.1 profile
+// columns are XYZ of red, green and blue
+var srgbMatrix = [3][3]float64{
+	{0.4360747, 0.3850649, 0.1430804},
+	{0.2225045, 0.7168786, 0.0606169},
+	{0.0139322, 0.0971045, 0.7141733},
+}
+
+// curve maps device value to linear light, both in 0..1
+type curve func(float64) float64
+
+// Profile is transform of RGB ICC profile from device values to XYZ
+type Profile struct {
+	// matrix converts linear RGB to XYZ of D50
+	matrix [3][3]float64
+
+	// curves linearize red, green and blue
+	curves [3]curve
+}
+
+// Parse RGB ICC profile of matrix and tone curves
+// Display P3, Adobe RGB, ProPhoto RGB and sRGB profiles are of this kind
+func Parse(data []byte) (*Profile, error) {
+	if len(data) < headerSize+4 || string(data[16:20]) != "RGB " || string(data[20:24]) != "XYZ " {
+		return nil, ErrUnsupported
+	}
+	tags := map[string][]byte{}
+	count := int(binary.BigEndian.Uint32(data[headerSize:]))
+	for i := 0; i < count; i++ {
+		entry := headerSize + 4 + i*12
+		if entry+12 > len(data) {
+			return nil, ErrUnsupported
+		}
+		offset := int(binary.BigEndian.Uint32(data[entry+4:]))
+		size := int(binary.BigEndian.Uint32(data[entry+8:]))
+		if offset < 0 || size < 0 || offset+size > len(data) {
+			return nil, ErrUnsupported
+		}
+		tags[string(data[entry:entry+4])] = data[offset : offset+size]
+	}
+
+	p := &Profile{}
+	for i, name := range []string{"rXYZ", "gXYZ", "bXYZ"} {
+		xyz, ok := parseXYZ(tags[name])
+		if !ok {
+			return nil, ErrUnsupported
+		}
+		for j := range xyz {
+			p.matrix[j][i] = xyz[j]
+		}
+	}
+	for i, name := range []string{"rTRC", "gTRC", "bTRC"} {
+		c, ok := parseCurve(tags[name])
+		if !ok {
+			return nil, ErrUnsupported
+		}
+		p.curves[i] = c
+	}
+
+	return p, nil
+}
+
+// Profile has same colors as sRGB
+// small differences of rounding in profiles are ignored
+func (p *Profile) IsSRGB() bool {
+	for i := range p.matrix {
+		for j := range p.matrix[i] {
+			if math.Abs(p.matrix[i][j]-srgbMatrix[i][j]) > 0.005 {
+				return false
+			}
+		}
+	}
+	for _, c := range p.curves {
+		for v := 0.0; v <= 1; v += 0.125 {
+			if math.Abs(c(v)-srgbToLinear(v)) > 0.005 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Convert image of profile to sRGB
+// colors out of sRGB gamut are clipped
+func (p *Profile) ToSRGB(img image.Image) *image.NRGBA {
+	bounds := img.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, bounds.Min, draw.Src)
+
+	// device values are linearized by tables of 8 bits
+	var linear [3][256]float64
+	for c := range linear {
+		for v := range linear[c] {
+			linear[c][v] = p.curves[c](float64(v) / 255)
+		}
+	}
+	// linear sRGB is encoded by table of 12 bits
+	var encode [4096]uint8
+	for i := range encode {
+		encode[i] = uint8(math.Round(linearToSRGB(float64(i)/4095) * 255))
+	}
+	m := multiply(invert(srgbMatrix), p.matrix)
+
+	for i := 0; i+4 <= len(dst.Pix); i += 4 {
+		r, g, b := linear[0][dst.Pix[i]], linear[1][dst.Pix[i+1]], linear[2][dst.Pix[i+2]]
+		for c := 0; c < 3; c++ {
+			v := m[c][0]*r + m[c][1]*g + m[c][2]*b
+			dst.Pix[i+c] = encode[int(math.Round(clamp(v)*4095))]
+		}
+	}
+
+	return dst
+}
+
+// Parse XYZType of s15Fixed16 numbers
+func parseXYZ(tag []byte) ([3]float64, bool) {
+	var xyz [3]float64
+	if len(tag) < 20 || string(tag[:4]) != "XYZ " {
+		return xyz, false
+	}
+	for i := range xyz {
+		xyz[i] = s15Fixed16(tag[8+i*4:])
+	}
+
+	return xyz, true
+}
+
+// Parse curveType or parametricCurveType
+func parseCurve(tag []byte) (curve, bool) {
+	if len(tag) < 12 {
+		return nil, false
+	}
+	switch string(tag[:4]) {
+	case "curv":
+		count := int(binary.BigEndian.Uint32(tag[8:]))
+		if len(tag) < 12+count*2 {
+			return nil, false
+		}
+		switch count {
+		case 0:
+			return func(x float64) float64 { return x }, true
+		case 1:
+			gamma := float64(binary.BigEndian.Uint16(tag[12:])) / 256
+			return func(x float64) float64 { return math.Pow(x, gamma) }, true
+		}
+		table := make([]float64, count)
+		for i := range table {
+			table[i] = float64(binary.BigEndian.Uint16(tag[12+i*2:])) / 65535
+		}
+		return func(x float64) float64 {
+			position := clamp(x) * float64(count-1)
+			i := int(position)
+			if i >= count-1 {
+				return table[count-1]
+			}
+			return table[i] + (table[i+1]-table[i])*(position-float64(i))
+		}, true
+	case "para":
+		// number of parameters of function types 0 to 4
+		sizes := []int{1, 3, 4, 5, 7}
+		kind := int(binary.BigEndian.Uint16(tag[8:]))
+		if kind >= len(sizes) || len(tag) < 12+sizes[kind]*4 {
+			return nil, false
+		}
+		var v [7]float64
+		for i := 0; i < sizes[kind]; i++ {
+			v[i] = s15Fixed16(tag[12+i*4:])
+		}
+		return parametric(kind, v), true
+	}
+
+	return nil, false
+}
+
+// Get function of parametricCurveType
+// parameters are g, a, b, c, d, e, f
+func parametric(kind int, v [7]float64) curve {
+	g, a, b, c, d, e, f := v[0], v[1], v[2], v[3], v[4], v[5], v[6]
+	pow := func(x float64) float64 { return math.Pow(math.Max(x, 0), g) }
+
+	switch kind {
+	case 1:
+		return func(x float64) float64 {
+			if x >= -b/a {
+				return pow(a*x + b)
+			}
+			return 0
+		}
+	case 2:
+		return func(x float64) float64 {
+			if x >= -b/a {
+				return pow(a*x+b) + c
+			}
+			return c
+		}
+	case 3:
+		return func(x float64) float64 {
+			if x >= d {
+				return pow(a*x + b)
+			}
+			return c * x
+		}
+	case 4:
+		return func(x float64) float64 {
+			if x >= d {
+				return pow(a*x+b) + e
+			}
+			return c*x + f
+		}
+	}
+
+	return pow
+}
+
+// Decode s15Fixed16Number
+func s15Fixed16(b []byte) float64 {
+	return float64(int32(binary.BigEndian.Uint32(b))) / 65536
+}
+
+// Decode sRGB value to linear light
+func srgbToLinear(v float64) float64 {
+	if v <= 0.04045 {
+		return v / 12.92
+	}
+
+	return math.Pow((v+0.055)/1.055, 2.4)
+}
+
+// Encode linear light to sRGB value
+func linearToSRGB(v float64) float64 {
+	if v <= 0.0031308 {
+		return v * 12.92
+	}
+
+	return 1.055*math.Pow(v, 1/2.4) - 0.055
+}
+
+// Clamp value into 0..1
+func clamp(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
+
+// Multiply 3x3 matrices
+func multiply(a [3][3]float64, b [3][3]float64) [3][3]float64 {
+	var m [3][3]float64
+	for i := range m {
+		for j := range m[i] {
+			for k := 0; k < 3; k++ {
+				m[i][j] += a[i][k] * b[k][j]
+			}
+		}
+	}
+
+	return m
+}
+
+// Invert 3x3 matrix
+func invert(m [3][3]float64) [3][3]float64 {
+	det := m[0][0]*(m[1][1]*m[2][2]-m[1][2]*m[2][1]) -
+		m[0][1]*(m[1][0]*m[2][2]-m[1][2]*m[2][0]) +
+		m[0][2]*(m[1][0]*m[2][1]-m[1][1]*m[2][0])
+
+	return [3][3]float64{
+		{
+			(m[1][1]*m[2][2] - m[1][2]*m[2][1]) / det,
+			(m[0][2]*m[2][1] - m[0][1]*m[2][2]) / det,
+			(m[0][1]*m[1][2] - m[0][2]*m[1][1]) / det,
+		},
+		{
+			(m[1][2]*m[2][0] - m[1][0]*m[2][2]) / det,
+			(m[0][0]*m[2][2] - m[0][2]*m[2][0]) / det,
+			(m[0][2]*m[1][0] - m[0][0]*m[1][2]) / det,
+		},
+		{
+			(m[1][0]*m[2][1] - m[1][1]*m[2][0]) / det,
+			(m[0][1]*m[2][0] - m[0][0]*m[2][1]) / det,
+			(m[0][0]*m[1][1] - m[0][1]*m[1][0]) / det,
+		},
+	}
+}
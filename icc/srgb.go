@@ -0,0 +1,99 @@
+package icc
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// srgbDescription is description of embedded sRGB profile
+const srgbDescription = "sRGB IEC61966-2.1"
+
+// srgbCurveSize is number of entries of sRGB tone curve table
+const srgbCurveSize = 1024
+
+// d50 is XYZ of PCS illuminant
+var d50 = [3]float64{0.9642, 1.0, 0.8249}
+
+// Build ICC v2 display profile of sRGB
+// it is embedded in outputs converted from other profiles
+func SRGB() []byte {
+	trc := append([]byte("curv\x00\x00\x00\x00"), u32(srgbCurveSize)...)
+	for i := 0; i < srgbCurveSize; i++ {
+		v := srgbToLinear(float64(i) / (srgbCurveSize - 1))
+		trc = binary.BigEndian.AppendUint16(trc, uint16(math.Round(v*65535)))
+	}
+	var xyz [3][]byte
+	for i := range xyz {
+		xyz[i] = xyzTag([3]float64{srgbMatrix[0][i], srgbMatrix[1][i], srgbMatrix[2][i]})
+	}
+
+	// tone curves of channels share same data
+	tags := []struct {
+		signature string
+		data      []byte
+	}{
+		{"desc", descTag(srgbDescription)},
+		{"cprt", append([]byte("text\x00\x00\x00\x00No copyright, use freely"), 0)},
+		{"wtpt", xyzTag(d50)},
+		{"rXYZ", xyz[0]},
+		{"gXYZ", xyz[1]},
+		{"bXYZ", xyz[2]},
+		{"rTRC", trc},
+		{"gTRC", trc},
+		{"bTRC", trc},
+	}
+
+	table := u32(len(tags))
+	var data []byte
+	offsets := map[*byte]int{}
+	for _, tag := range tags {
+		offset, ok := offsets[&tag.data[0]]
+		if !ok {
+			offset = headerSize + 4 + len(tags)*12 + len(data)
+			offsets[&tag.data[0]] = offset
+			data = append(data, tag.data...)
+			for len(data)%4 != 0 {
+				data = append(data, 0)
+			}
+		}
+		table = append(table, tag.signature...)
+		table = append(table, u32(offset)...)
+		table = append(table, u32(len(tag.data))...)
+	}
+
+	header := make([]byte, headerSize)
+	binary.BigEndian.PutUint32(header[0:], uint32(headerSize+len(table)+len(data)))
+	binary.BigEndian.PutUint32(header[8:], 0x02100000)
+	copy(header[12:], "mntrRGB XYZ ")
+	copy(header[36:], "acsp")
+	copy(header[68:], xyzTag(d50)[8:])
+
+	profile := append(header, table...)
+	return append(profile, data...)
+}
+
+// Encode XYZType
+func xyzTag(xyz [3]float64) []byte {
+	tag := []byte("XYZ \x00\x00\x00\x00")
+	for _, v := range xyz {
+		tag = append(tag, u32(int(math.Round(v*65536)))...)
+	}
+
+	return tag
+}
+
+// Encode textDescriptionType of ASCII description
+// Unicode and ScriptCode descriptions are empty
+func descTag(description string) []byte {
+	tag := append([]byte("desc\x00\x00\x00\x00"), u32(len(description)+1)...)
+	tag = append(tag, description...)
+	tag = append(tag, 0)
+
+	// Unicode language and count, ScriptCode code, count and 67 bytes of description
+	return append(tag, make([]byte, 4+4+2+1+67)...)
+}
+
+// Encode big endian uint32
+func u32(v int) []byte {
+	return binary.BigEndian.AppendUint32(nil, uint32(v))
+}
@@ -0,0 +1,354 @@
+// Code generated by go-bindata.
+// sources:
+// images/lgtm_approved.png
+// images/lgtm_comic.png
+// images/lgtm_mask.png
+// images/lgtm_outline.png
+// images/lgtm_stamp.png
+// images/lgtm_wip.png
+// DO NOT EDIT!
+
+package images
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func bindataRead(data []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _imagesLgtm_approvedPng = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xec\xb8\x75\x5b\x9b\x4d\xbb\x3d\x7c\x05\x77\x28\xee\x52\xdc\xa1\x58\x71\x6d\x71\x77\xa7\x50\xa0\x40\xf1\x52\x24\x48\x68\xa1\x94\xe2\x45\x5b\xb4\xb8\xbb\x43\x20\x2d\xee\xee\x24\x50\xdc\x43\x71\x29\xf0\xeb\x7d\xdf\xcf\x7e\xf6\xf3\xee\x0f\xf0\xee\x7f\x76\xd6\x24\x73\x24\x47\x8e\x99\xeb\x9c\x59\xe7\x3a\xd7\x4c\xa4\x8e\x96\x32\x3e\x0e\x35\x0e\x00\x00\xf8\xaa\x2a\x4a\x7a\x00\x80\x02\x01\x00\xd0\x2a\x16\xc6\x9f\x5f\x44\x26\x96\x37\x00\x00\x02\xa8\x2a\xc9\x1b\xf8\x65\x1c\xcc\x82\xf0\x01\x00\x0f\x25\x07\x79\xa7\x68\x0e\xc3\x50\xc0\xb9\x07\x80\xb4\xc7\x10\x14\x8e\xff\x6b\xff\x7f\xb5\x79\x54\xca\xb7\xfb\x36\x7f\x76\x06\x08\x4d\xeb\xf4\x89\x13\x70\x23\x47\x95\x03\xd0\xfe\xaf\xfd\xaf\x34\xd8\x53\x80\xf0\xff\x56\xe1\x7f\xaf\x45\xa3\x1e\xff\xdf\x2a\xfc\xef\xd2\x1f\x56\xe3\x2b\x2a\xa9\x67\xf0\x82\x32\xb8\x6c\x87\x6a\x9c\x48\xe9\x77\x17\x1e\x01\xc1\xce\x31\xfb\x93\xbd\x1f\xe1\xe0\x8b\xca\xe5\x5a\xdf\x75\x3a\x3f\xba\xa3\x66\x2c\x12\x62\x3e\x18\xb7\xc0\x73\x5f\x89\xe7\x76\x4f\x22\xdd\xba\x87\x6d\x7c\x52\xc5\x07\x6e\x13\xdc\x09\x9e\x4d\x41\xec\x44\x0e\x4f\xe9\xb5\xf7\x1c\x4b\x07\xe9\x9f\xa8\xca\xfa\x77\x54\x69\xdb\x01\x82\x10\x4a\x39\x3c\x46\x0c\x22\xd4\x8f\xa0\x6e\xc0\xf6\x7f\xf3\x2b\xcc\x50\x14\x92\x38\xcf\x14\x46\xa4\xd0\x7f\xe2\x70\x7a\x31\x32\x1f\x14\x0f\x37\xf7\xb5\xa3\x00\xd0\x00\x90\x1c\x40\xf4\x5f\x9d\x05\x3a\x20\x7c\x26\xe2\x79\x70\x56\xa3\xd8\x3f\x55\x48\x25\x83\x7f\x0c\x0a\x04\x18\x60\x98\x7f\x75\x2b\x1f\x61\x73\x01\x92\xa7\xb4\xd4\x05\x9f\xc4\xe0\x50\x9f\x26\xfe\xcb\x17\xa8\x35\xa1\xda\xb6\xa8\xec\xff\xf5\x8f\xbf\xba\x18\x9c\x40\x10\xb8\xe2\x85\xfb\x1e\x95\x9d\xe6\x24\xa7\x2a\xbf\x6a\x80\xf6\x24\xbe\x2a\x83\x7a\x2b\xfb\x6e\x5d\xf3\x47\x62\x01\x56\x1b\x35\x58\x12\x84\x13\xa4\x8f\x52\x88\xa6\x81\x9d\x46\x7c\xfc\x39\x09\x82\xef\xef\x2f\xec\xc4\xf5\xdd\xea\x35\xee\x8e\xab\x8a\x04\xfb\xee\xe7\xfc\xb2\x2c\x63\x96\xcc\xf8\x69\x2a\xf2\x4b\x16\x28\xc7\x2e\xff\x0a\xe6\x5f\xf5\x9c\x11\xc0\xfa\xaf\x2e\x16\x04\xc1\xe8\x80\xb4\xf5\x4a\x83\x5b\xdc\xab\x64\x10\x02\x3b\x96\xbf\x2e\x02\xbf\xa2\x1f\x13\x48\x21\xc1\x1f\x78\x79\x88\x1d\x95\x27\xc5\x64\x82\x0d\x4a\xd2\xe2\x23\x26\x69\x65\x5e\x0a\x50\xe2\x61\xbc\xaf\xe9\xff\xd8\xd1\x86\x16\x08\x72\x08\xaa\xf5\xf7\x51\x13\xf7\x5d\x5a\x35\x52\x6b\x99\x63\x85\x3e\xde\x8d\xde\xc6\xfd\xd0\x0e\xe9\xf8\x92\x4d\x35\x36\xca\xd9\x67\xad\x76\xa3\x36\x29\x78\x89\xda\x3b\x26\xf0\x3f\x97\xf1\x21\x05\xa4\x01\x9b\x73\x43\x74\x50\xad\x5e\x36\x7d\xe7\xe7\xb1\xd8\xd7\x3b\x72\x52\xa5\xc0\x0b\x94\xc4\xe8\x10\xc2\xf8\xee\xd3\x49\xe8\x9b\x55\x90\x74\xeb\x88\x9e\xd8\xf7\xbb\x5d\x42\x68\xe9\x3b\x0d\x16\x5d\xd8\xe8\x14\x56\x26\xb6\x11\x61\x59\xc4\x4c\xd7\xde\x8b\xf3\xea\x00\x13\xa8\x7c\x99\x98\x81\x20\xee\xd3\x32\xd9\x62\xcf\x1f\xd2\xe0\xf4\xcf\x7c\xad\x19\x34\xa7\x86\xa8\x56\xcb\x42\x42\x3c\x47\x60\x9a\x31\x57\x69\x0a\x55\x24\x98\x8f\x9a\x45\x92\xe1\x92\xd0\x1a\xe7\x7f\xc4\x2e\x03\xc0\x42\x9f\x54\xbe\x3e\xff\x51\xfb\xec\xc6\x19\x2c\x11\x24\xb0\xfe\x99\x77\x45\xfc\xf2\x10\xea\xd0\x1c\xf5\xe4\x88\x21\xf8\xed\x07\x15\xc3\xed\x2c\xe1\x0a\xf5\x1e\x74\xbe\x26\x96\x3b\x22\xfb\xd5\x66\x48\xbc\xbf\x53\xd6\xe1\x5b\x8c\xf7\xdf\x6d\x04\xb2\x3a\x13\x20\xfc\x63\x4e\x28\xed\x19\x7a\x95\x41\xfa\x93\x11\x7c\x2d\x1d\xfc\xbb\xbc\xfc\x23\x27\xb5\x4d\x96\x28\x1d\xd5\x9e\x87\x42\x0a\x88\xf6\x33\x6c\x14\xd9\xd4\xb3\x86\x02\x2d\x65\x06\xc6\x20\x48\xd4\x19\x48\xab\x3a\xd0\x44\x86\x87\x21\x85\x70\x28\x62\xf3\x36\xdb\xa4\xa3\x10\xa5\xae\xb2\x36\xb0\x06\x4d\x3d\xb4\x55\x8c\xf8\xf8\x90\xf2\x8a\xba\x6b\xb5\x1b\x20\x87\x82\xc1\xf7\xa6\xc3\x0d\x7b\x5f\x94\x13\xfc\xee\x7a\x80\x8e\xf4\xd1\xc8\xff\xb9\xe5\x7e\x9b\xea\x30\x43\x2a\xb1\x38\x20\xb7\xf7\x98\xe2\x03\xbf\xe2\x8a\xff\x9f\x47\xe7\xdd\xad\xdd\x36\xcc\x8e\xea\x80\x02\x3d\x88\xde\xc3\x9c\x80\x7b\x13\x64\xc1\x04\x66\xb3\x6b\x71\xe6\xff\x37\xf6\x4a\x29\xe0\x39\xe7\x1c\x84\x42\x87\xd4\x7e\x39\xc0\x7c\x78\xb9\x61\xba\xed\x32\x76\x6e\x95\xfb\x2d\xb2\xbc\xea\x4f\xf0\x48\xec\xe3\xaf\xca\x07\x0f\x14\x1e\x62\xfc\x15\xd6\xfb\x29\xa9\x9f\x17\x4e\x5b\x16\x8e\x37\xff\x73\x04\x14\xc8\xd8\x23\x80\x20\xc9\x33\x7d\xaf\xcd\xeb\xb6\xcb\xf7\x15\x9c\x61\xfa\x63\x16\x34\xee\x77\xe4\x42\xa2\x4c\xc8\x60\x75\xbd\x7b\x59\x53\x6a\x39\xa2\x7d\xd3\x38\x96\x2b\xc8\x9e\x7d\x2e\x50\x8a\x3f\x06\x74\xfc\x0e\x4c\x9a\x47\xaa\xd9\x6f\xd7\xc5\x80\x13\xc2\x81\x7b\xf7\x0d\xf8\x17\xd7\xd1\x02\x5f\xb4\xee\x21\x07\x47\xc4\x77\x3b\xa0\x73\xae\x57\x38\xc7\x41\xab\x88\x66\x91\x2e\x0b\xac\x38\x8b\xd9\x18\x42\x3d\x34\x8d\x58\xf4\x40\x68\xa8\x0f\x7c\x68\x40\x58\x34\x3e\x8a\x07\x7b\xea\xfa\xf2\xde\x60\x92\x8e\x3c\xcc\x41\x7e\xe7\x19\x45\x58\x16\xe0\x2f\x47\x1f\x8f\xb9\x01\xf2\x97\x43\x10\xcb\x59\x69\x59\xc5\xb2\x9a\xd7\x00\x9d\x75\x47\x13\x4b\x5e\x0f\x4b\xf6\xfc\x3d\xc4\x2e\x6b\x70\x31\xca\x67\x63\xd6\xa9\xe4\xce\x04\xe3\x34\x5d\xc7\x9b\xae\xfe\x0f\xd2\x47\xa6\x72\x48\x31\xd5\x15\xf5\xc5\xe4\xb3\xbc\x7c\x77\xd6\xa0\x9f\x2b\xae\xb2\xd0\xa3\x6f\x1a\x50\xd8\x19\x1d\x92\xe4\x64\x5e\x03\x90\x81\x10\xae\xa2\x5e\xfd\xe9\xdc\xd1\x56\x5d\x96\xdb\xfe\x2c\x97\x8d\x30\x9a\xfa\xd2\xd1\x52\x9b\x5b\x59\x53\xc2\x96\x67\xc8\xfb\xe7\xa1\x1d\xe0\x19\x31\xa7\x97\xaf\x4a\xe3\xe2\x1e\x9f\xef\x13\xb8\x88\x0b\x87\x88\x6c\x04\x6d\x5f\x27\xe6\x5b\xb3\xdc\x1d\x80\x87\x42\xb2\x9b\x8d\x3d\x13\xce\x81\xaa\xeb\x95\x35\xd8\xc3\xca\xfd\xb1\x7d\x34\x2b\xf5\x43\xb5\xaf\x68\xa7\xa7\xe5\x39\x8d\xac\xaf\x54\x56\xc9\x92\x75\x48\xbb\x88\x8f\x22\x42\x85\x76\xf2\x43\xaf\x0f\x27\x31\x31\xae\x28\xa3\xa9\x2d\xc8\x25\x2c\xec\x65\x8d\x23\x3a\x31\x4d\x77\x27\xec\x2b\xba\x1e\x0b\xcb\x2c\x4f\x02\x05\xcf\x3c\x93\x7c\x46\x37\xcb\xb8\xc4\xb7\xd5\xdc\xb0\x24\xb4\x88\x7c\x27\xbb\x38\x47\xbe\xd6\xc9\x6d\x2a\xba\x6c\x04\xb8\x4f\xf3\x40\x72\xfd\xea\x9a\x9e\x6e\x64\xe3\x66\xb4\xc5\x3d\x11\x2c\x7b\x1a\x68\x1d\x0b\x1a\x07\x5e\x01\xc2\x00\x35\x80\x0f\x19\x93\x01\x52\x34\x32\x0b\x5b\xbc\xf4\x55\x38\xa7\xff\xc4\x03\x45\x74\xc7\x80\xb3\x4a\xa6\xee\xe3\x88\x79\xa7\x80\x31\x72\xf4\x14\x3b\xb1\x23\x37\xc7\x89\x79\x42\xb1\x4a\x83\x1e\xa7\x56\xd8\x81\x60\x9f\x19\x47\x60\x08\x0e\x93\x05\x36\x53\x39\x00\x26\x04\x66\xeb\x7d\xae\x87\xb9\xec\xa6\x43\x5d\x7a\x25\x4e\x33\xda\x46\x32\x43\x44\xff\x1e\x19\x43\x0e\x35\xe7\xef\x89\x5e\x03\x80\xdd\xea\xba\x48\x2c\x34\xd1\x67\xc0\x42\x5a\x41\xf4\x08\xe5\x0e\xa5\x3a\x15\x64\xc0\xb5\x32\x89\x99\x82\x31\xd1\x7c\x28\x08\xca\xce\xa9\xd2\x1c\xb6\xa2\xd8\x01\xcb\x3d\xee\xc5\x80\x18\x03\xcb\x86\x10\x24\x34\x8e\x90\x42\x61\x2d\x76\x76\xde\x7c\x5f\x7d\xe8\x7a\x20\x2a\x8a\xfb\x75\x91\xd6\x84\xbe\x34\xec\x07\x9d\x4c\x5b\x47\xfd\x6f\x86\xd1\xce\x74\x73\xd7\xa1\xe7\x55\xb6\xfd\x42\x1b\x54\x27\x78\xfe\x1f\xa4\x14\xad\x38\x37\x92\xa5\xd5\xcf\xac\x4a\xd3\x0e\xf4\x5f\x96\x65\x61\x5c\x59\xf5\x64\x9a\x73\x04\xf2\xe7\x26\x95\x54\xd7\xca\xa4\x2b\x68\xb7\x67\xb2\xf1\x20\x3a\x92\x9e\x7f\x47\xe0\x45\x52\x8b\x79\xae\x90\x57\x21\xc7\xa3\x4c\x3a\x6e\x6f\x56\x3f\xda\x71\xf4\xd2\xb5\xf6\x32\x53\xf8\xcb\x4d\xdc\x32\xe3\xbc\xb2\x7c\x6d\x4d\xf0\xf1\x5e\x20\xec\xd8\xa5\x31\x41\xf2\x51\x77\x24\xa3\x4f\x24\xa3\x2b\x6a\x76\xe8\x18\xcc\x71\x55\xe8\x98\xea\xea\x2d\x76\xa0\x13\xad\x25\x55\xf1\xee\x9d\xe0\xb4\xd4\x0c\x5c\x52\xab\xe4\xb3\x1d\x90\x8d\xc3\x45\xc1\x37\x19\xf7\xd8\x90\x9f\x5e\x36\xb8\x33\x9b\x6d\x93\x57\x7b\xed\x6c\xda\x30\xc5\x1e\x55\x04\x29\x31\x88\x58\xd1\xbd\x71\xf3\xa4\xf5\x29\xb5\xb2\x42\x0f\xe4\x59\xf9\x95\x75\xc1\xc4\xb5\x26\x06\xac\xeb\x6e\xdb\x81\x80\xfa\x85\xe7\x66\xb3\x07\x5b\xcb\x7f\xbd\x08\xd4\x98\xad\xf8\x7d\xd6\x0b\x67\xe5\xc9\x24\x35\x87\x11\x28\x55\xe1\x2e\x97\xfe\x36\xee\x18\xe8\x2e\x24\xc7\xfb\x42\x0e\x54\x67\x22\x2f\xb8\xa0\x4f\x54\xd6\x2e\x16\x5f\xe2\x86\x74\x07\xbb\xc5\x17\x7b\x8a\x87\x79\x70\x86\x79\x30\x7f\x5a\x1a\xf2\x25\x10\x27\x35\x10\x3e\xd2\xe7\xf4\x48\xc0\xb9\x9a\x22\xfa\x11\xa7\xe2\x51\xf2\xc9\x73\xb8\x05\xa3\x47\x86\xcf\x0c\x54\x8f\xf2\x0a\x7d\x1c\x27\x87\x4c\x8e\x1b\x62\x04\x54\x00\x47\x66\x20\xb4\x2b\x5b\x81\x7d\x90\x4b\x2d\x5e\xb5\xe4\x8f\x69\xa7\xd3\xe9\x65\x73\x1b\xd3\xdf\xd7\x20\x58\xb1\xd2\xc7\xf1\x63\x8b\x96\x1a\xfc\x6f\xd1\x87\xbe\xa0\x39\x2a\x1b\xe4\x1d\xd1\x0f\x38\xd1\x8f\x4c\xa2\x1f\x4b\xc0\x0a\xd9\x72\xcb\x9d\x65\xef\xa1\x6b\x6a\x49\x80\xf6\x8b\x4f\xb7\x6d\xc3\x1d\xc1\x3a\xd0\x3d\x0f\x59\x2d\x01\xb1\x63\xf8\xce\xa9\xa8\x79\x89\xfd\xfa\x42\x39\xbc\xf9\xed\xfa\x62\x8c\x8b\x58\x25\xbc\xd9\xce\x6b\x7d\x16\xfe\xa0\x6f\xb3\xa3\x63\x8e\xc4\xf3\x04\x6f\x7f\x5c\x92\x54\xfe\xf5\x53\x26\xbe\x19\xdd\x26\x39\x66\x62\xc3\x9b\x0d\xde\xd8\xc8\x1f\x8e\x14\x87\x54\x79\xc0\xef\x63\x3a\x30\xce\x2a\x7e\x0e\x56\xd4\x96\xf3\xb9\x69\x87\xcc\x73\x4b\xfa\x7b\xea\x12\x59\x66\xec\xaf\x78\x57\x96\x8d\xda\x13\xc4\x93\xe7\xdc\xbd\x27\xfa\x81\x44\xfb\x79\x5b\xe3\xfe\x57\x18\xff\x1d\x17\x0c\xdb\x08\x00\x75\xf0\xe8\xae\xe4\x87\x25\x7f\x9f\x2c\xd2\x3d\xb3\x62\x0f\x46\x92\x3e\x4b\x8d\xcb\xe5\x71\x55\xf6\x04\xeb\x7d\x59\xa3\x77\x77\xef\xd4\xec\xcb\x59\x1b\xd5\x27\xf5\x8d\x85\x0f\x72\x25\x78\xe1\x1b\x1f\x65\xab\x8e\x37\x3f\x33\x4d\xc1\x1c\x48\xd3\x17\xd9\x7e\x85\x15\x64\xa6\x73\x81\x94\x16\x76\xbd\x95\xb9\xed\x84\xf9\xf3\x7b\xa3\xba\x64\x3b\xea\xd8\x47\x00\xc5\x64\x78\xff\xcd\x6b\x61\x40\xa6\x0b\x30\x59\xeb\x85\xf3\x85\x7e\x11\xad\x16\x50\x87\x42\xd1\xad\xb4\x13\xfc\x6f\x50\x0a\x4c\x30\x80\x69\x72\x18\x69\x1c\x9c\x3e\x8f\xc6\x58\x95\x8a\xce\x0a\x93\x03\x1c\xe7\x77\xc7\x3d\x40\xcd\x2d\xb7\x12\x74\x9f\xd2\x2b\x80\x43\x29\xe2\x16\x22\x6b\x08\x65\xaf\xc7\x27\x1d\xac\xfa\x4c\xaa\x47\x86\x39\xbf\xfd\x75\x84\x7d\x08\xff\x3f\xc7\xa7\x7f\x03\x68\x2c\x37\x3b\xd7\xec\xcd\x60\xbe\xda\xf8\x8c\x9f\xf4\xc8\x9f\x8a\xd5\x3d\xeb\x1e\x23\x92\xa6\x82\x65\x4b\x43\xe4\x52\x94\x8d\xd1\x4d\x7b\x9c\xdd\xf7\xec\xb4\xd9\x13\x73\x7a\x09\x1c\x77\x05\xa5\x79\xb9\xc9\xf5\xc2\x2e\x6a\x2c\x9f\xcd\x6f\x66\x1e\xfd\xf8\x5c\x7f\x35\x9d\xe8\x2a\xd1\xbc\x09\x73\xfc\xad\xa9\x91\x23\x6a\x61\xbf\xfe\xeb\xbc\x75\x80\x0e\xd3\x84\x0a\x33\x4d\xa4\x4d\xfc\xdf\x84\x96\x46\x3f\x4e\x86\xd7\x0d\xd1\x1f\x17\x45\xc2\xd3\xf3\x10\x08\xd3\x1b\x27\xc6\x17\xb8\xd5\xf1\x12\x4f\x47\x1d\x15\xad\x98\x75\xde\xec\xf0\x3a\x1f\xc2\x3f\xee\xfd\x79\xdf\x43\xd3\xef\xc6\xd8\xf1\x8e\xdf\x7a\xc3\x97\x2c\xf2\xdc\xc3\x89\x82\x0d\xd7\x51\x66\x7f\xf9\x70\xa8\x9f\x06\x0d\xf6\x3d\x25\x18\xf7\x84\x24\x7b\x42\x5c\x15\xb3\xbf\x8d\x8d\x3b\xee\x08\x9d\x69\xb2\x9c\xa9\xfa\x97\x7d\x52\x4d\xf4\xd1\x39\xe3\x3b\xbe\x79\x36\xdd\x61\xec\xe7\x0a\x22\x48\x2f\x76\x28\xb1\x9d\x17\x3e\xe8\xe9\x13\x4c\xee\x3e\x72\xca\x8c\x46\x8b\x31\x34\x80\x2a\xc0\xdb\x62\x68\x6e\xe8\xf7\x4b\x38\xef\xb5\xd7\xf7\x2c\xf0\x5d\x51\xe0\xad\x6d\xae\x7a\xb2\x73\xbb\xec\xcd\xb1\x86\x44\xf3\xeb\x5f\xb3\x63\x40\x3a\x21\xc2\xff\x29\x31\x4f\x41\x00\x07\x07\xe8\x0a\xcd\x3e\xe0\x55\x91\xf7\x66\xe3\xc7\x11\x70\x42\x9a\xf4\xcc\x92\xa4\x36\x54\xfa\xbc\x44\xe5\x67\x5b\x6a\xb3\x4c\x7a\xc7\x76\x91\x8e\x68\x66\x71\x00\xc3\xf3\x3b\x86\x66\x77\x11\xe3\x23\xba\x94\xcb\xcf\x82\x42\xb1\x76\x4f\x8f\x6e\x26\xbd\x4c\x83\x7e\xd0\xbd\x1d\x5f\x8d\x43\x86\x57\x3e\xfc\xc9\x53\x5a\x01\x6b\x07\xae\x25\xef\xa4\xe1\x17\xbc\xb8\xff\xb1\x1d\xd4\x40\x1f\x00\x01\xac\x16\x3f\x45\x78\x3f\x6f\x21\xec\x41\x70\xa6\xc9\x02\x01\x75\x1c\xfe\x55\xdf\x8e\x46\x50\x03\x33\xd1\x6a\x49\x2f\x8b\x50\x7a\xf4\x2e\x7a\xd1\x03\xf7\xbe\x72\x5f\x8a\x6f\x0d\x42\xd5\x97\x11\x74\x6a\x35\x1c\x92\x97\x5c\x53\xa5\xc9\x5f\x56\x0d\x05\x0c\xda\x2f\x08\x72\xbe\x5e\xb2\x68\x6e\x1f\x33\x11\x3d\x1d\xa9\x15\x40\x41\xd9\xbe\x62\xca\x59\x50\xdc\x93\xb1\x03\xfe\x23\xaa\x63\x27\x90\x80\xee\xf9\x99\x4e\xac\x26\xe1\xba\xf0\xc0\x4f\x32\x0b\xdf\xed\x1d\x47\xbf\xf8\x6c\xa6\x62\xeb\xba\x57\x67\x5c\x06\x8c\xa1\x7a\x24\x46\x18\xa4\x5e\x3d\x5c\x42\xbe\xd4\xe3\x6d\x5a\xe5\x08\xea\x3b\x36\x69\xe9\x0f\x4f\x1a\xd4\xb6\x8b\x43\x54\x20\x55\xc3\x6f\xac\xee\x15\xd8\xea\xa6\xc7\x6a\x4f\x59\x7d\x1f\x68\x2b\x57\x97\xc8\xf6\x17\x08\x5a\xc8\xb6\xf8\x3f\x74\x52\x16\x58\xe3\xfd\xbd\xf3\x97\x4a\x30\xf6\x4d\x5e\xf5\xd5\xca\x61\x2b\x56\x12\x5f\x50\xb8\x7b\x4a\xc4\xc5\x50\xb3\x17\x59\x53\x13\x95\xf4\xf1\x26\x9b\xdb\x24\xda\x8b\x77\x1d\x46\xc4\x4d\x45\x9b\xe2\x82\x1f\x8c\x23\x65\xcd\x9f\x6d\x82\xb3\xb3\xb2\x70\x93\xdd\x07\x3c\xd7\xb7\x24\x1f\x0e\x8c\xea\x13\x29\x28\x8a\x74\xfc\x3a\xa9\x14\xae\xd0\x07\x3d\x66\xda\x61\x37\x59\xa4\xb2\xc4\x1d\xef\x25\x83\x4a\xfb\xdf\xcc\xe2\x88\xa6\xf3\xd0\x8c\x39\xe0\x07\x62\xc8\xfc\x99\x0f\xf9\x05\xd4\x41\x39\x38\x69\x52\xcf\xf3\x69\x29\x9d\xd9\x01\x39\xc4\xf2\x3a\x90\x7c\x6e\x07\xaf\xd4\x94\x7f\xef\xee\xd5\x72\xa1\x01\x94\xf4\x0a\xcd\x51\xef\x5c\xa2\x58\x3b\xce\x53\x44\xf6\x71\xaf\x47\xf8\x3c\x34\xb7\x53\x7d\xd9\xca\x94\x2a\x65\xf7\xcd\x81\x62\xf9\x5e\xd0\x64\x6b\xac\x38\xce\x2f\x77\x45\xac\xbe\x6b\x5d\x9d\xf3\xdf\x47\xbf\xec\xd6\xda\x98\xc2\x56\x9e\x92\xcc\x7f\x81\x19\x7e\x81\xb9\x2a\x66\x31\x8d\xc5\x3b\xfe\xa9\x08\x33\x0a\x56\xa6\xcb\x59\x06\x1d\xae\x36\x9a\xb3\xac\x58\x0f\x1c\x95\x4b\xb8\x67\xb6\x0e\x31\x63\xcf\x20\x94\x7d\x87\xd2\x18\x5f\x85\x1d\xa2\x10\xf9\x3d\xb0\x39\x14\x8d\x36\xc8\x2e\x66\x33\xb1\x7b\xe2\x47\x8f\x98\x0e\x35\x29\xeb\xe4\x9e\x3e\x8f\xb4\xb5\x8b\x8b\xc5\x37\x9a\xf7\xe1\x9f\x03\x3e\xd9\x1b\x02\xf3\x7f\x36\x09\x04\x93\x20\x5b\xfc\x77\xd9\xc4\x80\x75\xe1\x42\x58\x61\xa9\xac\xc7\x14\x6d\xe2\x76\xc6\x9b\x0f\xeb\x83\x33\xb4\x2d\xde\xd3\x8b\x92\x43\xd3\x4e\x83\xb6\xf0\xc7\xf1\xb2\x41\xaf\x70\x6f\x0a\x3f\x6f\xde\xbe\xf0\x1f\x68\x3c\x2f\x6d\x86\x12\x0c\x22\x96\xa6\x17\xf5\x97\xb3\xb6\x46\x0c\x3d\x5f\x99\x1e\xa0\x68\x35\xd9\xcd\x38\x21\x0d\x8b\x14\x5c\x83\xa2\x4e\xe9\x9f\x03\xab\xe8\x8c\x56\xce\x2f\xac\x3a\x5a\x97\x5e\xb8\x9a\x5e\xdb\x8a\x89\x89\x35\x39\x76\x82\xfc\xdf\x09\xc0\x09\xf7\xd7\x0a\x62\x7f\xfe\x30\x84\x43\xd7\x9b\xbd\x1c\x43\xbf\xd3\x13\x2e\x6b\x4e\x77\x18\x0d\x5c\x69\x7e\xb1\x31\x74\x9e\x20\x95\xbd\x41\x27\x0c\x4a\x91\x55\x18\x31\xef\x89\x69\x7b\xde\x78\xd4\xa9\xcb\x3e\xa4\xf4\x9e\x16\x26\x64\x20\x0c\x90\xf5\x9c\x05\xff\xab\x80\xae\xe0\x32\xfd\x0e\xec\xf6\x35\x20\x32\x81\xdd\xcc\xcd\xa6\xa6\x20\xf7\x4f\x6f\xf2\xfe\xab\x12\xfc\x25\xa1\x7e\xaf\x48\x61\x2a\x9b\x24\xd1\xd8\xa3\x8d\xea\xcb\x2f\xbf\xe2\x36\x79\x6c\x06\x87\x2b\xc5\x34\x5d\x5d\xbe\x21\x6d\x12\xfb\xb8\x76\x71\xc4\xb2\x2e\x2a\xed\xf3\xd5\xa2\x19\xb5\x71\xc5\x9e\xa1\xf5\x60\x05\x75\xab\x1b\xce\x98\x67\x50\x03\x9e\x0b\x85\x8d\xbc\x94\x22\x73\x4e\x09\x30\x38\x73\x2d\x0b\x00\x57\xee\x05\x7d\x6e\x24\xb6\x5c\x91\xc8\xe1\x5e\xbd\xc7\x7a\x81\x95\x5a\x3f\xee\x10\xc5\xfb\x17\x17\xfc\x30\xa4\x50\xb3\x40\xee\x2b\x80\x76\x94\x6d\xe2\xde\x0c\xca\x95\x1e\x77\x12\x74\x4c\x28\x6e\x6f\xff\xcc\x3f\x85\x30\x37\x8b\xfd\x21\x16\x99\xa7\xb9\xa2\x8d\x6e\x8f\x02\xea\x39\x18\xf1\x88\x7e\xc0\x63\x5a\xce\xad\xfa\x76\x6c\x89\x79\x5f\x5a\x9e\x1c\xeb\xbe\x4a\xc3\x7d\x8c\xdf\xb9\xd4\xce\x6b\x2b\xf4\x20\xa7\xa0\x92\x89\x93\x76\x1a\x74\xa5\x8a\xf5\x33\x35\x50\x2c\x88\x97\x7d\xd5\xec\x3f\x8a\x81\xb4\x1e\xe0\xfb\xa8\x89\x42\xbb\x44\x44\x6e\x46\x42\x7b\x19\xe1\xd2\x7d\x98\xb9\x76\xce\xb1\xdf\x1f\x73\x2b\x10\xda\x56\x8e\x92\x72\x31\xb4\x66\xa7\x72\x69\xf7\x15\xec\x87\xb9\xda\x46\xd5\x73\x04\x55\x7c\x7e\xd4\xc6\xe2\x1e\xf6\xa8\x06\x1c\x20\x4b\xa4\x44\x43\xce\x46\x4c\x1e\x50\xdc\xb8\xb2\x15\x04\xf7\x15\x50\x6c\x69\x5c\x50\xe3\xbd\xbd\x7a\x5c\x16\x68\x74\x5d\x7e\x62\xba\x99\xee\x97\xac\xed\x66\x71\xfe\x66\xb1\x7e\x5e\xa6\xeb\xfd\x31\xfe\xda\x65\x07\xac\x36\xf5\xe3\x0a\x6e\x77\xab\xeb\x89\x0e\x19\xa3\xd3\x81\x67\x71\x80\xd7\x69\x03\xc8\xe8\xce\x04\x39\x05\x26\x35\xee\xd0\x80\x43\x5d\x9a\x73\x90\x09\x0d\x34\xe9\x7b\x17\xf8\x64\x1a\x8a\x08\x25\x45\x44\x31\x83\x84\x2c\x01\xd3\x6f\x9e\xb0\x63\xeb\x4a\x17\xd3\x21\x5d\x5c\xdc\x9e\xcb\xfd\xff\x2c\x6b\xab\xa1\x7a\xc0\x10\x24\xae\x64\xca\x40\x81\xb5\x91\x6a\xef\x10\x1a\x76\x7b\xa1\xb9\x76\x31\xba\x4a\xa0\x9f\xfa\xb9\x56\xb3\x56\x11\x2f\x50\x73\x85\x04\x1d\xfc\xce\xd8\x7e\xed\xad\x69\x61\x33\x34\xa2\xd8\x3f\x15\xd2\x61\x68\xe3\xce\x7d\xf3\x9a\xec\x98\xac\xb2\xd3\x0a\x27\xf0\x43\x28\x2a\x26\xea\x57\xe6\x1b\x9c\x39\x31\x7e\x6b\xd7\x79\x8c\xdf\xd6\x4f\xe5\x5b\xb2\x74\x3d\xdf\xff\xe7\x4c\x36\xa7\x38\x36\x6b\x1d\x33\x77\xaa\xd2\x1f\x58\x16\x12\x38\xdc\xaa\xfe\x30\x20\x72\x1e\x2a\x2e\xe7\x96\x3e\x76\x96\x38\x0f\xbd\x42\x34\x55\x5d\xc2\x1e\x6a\x7c\x65\xfe\xb8\x55\x7b\x4a\x19\x5f\x07\x62\xf1\xb4\x45\x55\x56\x3d\x0a\x03\x76\x57\xda\x88\x66\x27\x92\x56\x79\x95\x62\x15\x9e\x5a\xfb\x31\x2e\x9e\xf9\x6a\xbb\xb1\x77\xb2\x28\x29\x2a\x0b\x35\x8a\x1f\xd1\xf5\xea\x6c\x22\xe3\x75\xa2\x12\x45\x59\x7c\x18\x85\xdd\xcc\x3c\xbe\x8a\x26\x35\x7a\xb2\x5d\x0c\xe7\x44\x65\xf9\x5e\x05\x7a\x21\x9f\xba\x4a\x09\x9d\x0f\xdf\xd2\xd1\x59\x82\xb7\x10\x92\x24\xdb\x59\xde\x59\xa7\x77\xb4\x39\x3f\x87\x42\x91\x44\x9d\xee\x68\x2b\xd0\xf4\xe9\x01\x28\xc6\xb1\x21\xab\xf1\x6c\xe7\xb7\xfe\xbb\x0b\xaf\xd5\xba\x03\xf7\x17\xeb\x53\xac\xf0\x71\x17\x29\xa1\x49\xd2\xed\x5c\x34\x44\x81\xc3\x2d\x3b\x67\xc1\x0a\x2e\xe3\x9d\x86\xc6\xa8\xd0\x0b\xfc\x1c\x11\xc8\x2c\x60\x86\xda\xcc\x2f\x02\x01\xb2\xc9\xab\x1e\xa3\xe6\xc9\x0c\xef\x50\xc0\x8e\x48\xef\xc1\xea\x69\x5e\x71\x13\x43\x2d\xaf\x49\x54\x16\xd1\x3e\xaf\x41\x95\x77\x9b\x8a\x90\x56\xe8\x4e\x15\xe3\xe9\x30\x84\x48\xe0\x65\x65\xde\xa6\x39\xe6\xfd\xc9\x6c\xff\x11\x5d\x5c\x5c\xda\x65\xfb\xb8\xf6\xb2\x42\xd5\x03\x12\x2c\xc5\xbf\xc2\x16\xd0\x36\x88\xc7\xd3\xf4\xd1\x74\x92\xec\x1c\xa4\xc3\x4e\xf9\x1f\x85\x88\x18\xc0\x83\xb1\x7b\xbb\x4c\xf7\x3b\x79\x26\x06\xa2\xc7\x20\x4c\x7f\x5f\xa4\x08\x3a\xd2\x7d\xcb\xed\x05\xd5\xd3\x10\x0a\x86\x97\x9a\xfd\x68\x2e\x7d\xd5\xcd\x97\x2d\xbd\xb9\x9c\x38\x66\x85\x72\x84\x80\xb9\xbb\x93\x69\xae\x48\x07\x90\x7e\xb7\x5d\x51\x6a\x0f\xfe\xa9\x37\x67\x06\x1d\x98\xdc\x0b\x92\xbf\xfe\xc8\x98\x6d\xcc\xfd\x5b\xda\x6e\x3f\x97\x35\xb0\xc6\x21\x9a\xea\xbf\xa7\x69\xbe\x04\xd2\x40\xd9\xb9\x5a\xa7\x93\x4e\xac\xfd\x23\x53\xda\xeb\x4d\xbe\x04\x15\x3d\x3c\x48\x86\x8d\x1b\xd7\xca\x87\x34\xf0\xb3\x8a\x45\x8e\x67\x39\xec\x75\x6f\x70\x23\xc0\x33\x83\x77\xa4\x39\xb7\x61\x93\x4e\xa9\xbb\xee\x40\xa0\xb9\x45\xba\x3a\x37\xea\x80\xb2\x5a\x11\xd5\x7d\x21\xf5\xfd\x59\xb9\x2b\x2a\x24\xc0\x0a\x63\x40\xb4\x24\x3a\xf6\x58\x63\x91\x70\x3c\x18\x12\x24\x0b\x4b\xf9\x97\x84\x4a\x33\x6d\x18\x7e\x91\x88\xe2\x71\x36\x1c\x17\x53\x3e\x8f\xde\x3e\xb6\x37\x03\xfd\x36\x1a\xbf\xc1\x26\xca\x5b\x41\xb0\xc9\xee\x67\xf9\x24\xc4\x75\x25\x61\x39\x68\x42\xb4\xe5\x59\x19\x6c\x6b\x83\x08\x49\x8e\x7c\x72\xf9\xab\x6a\x77\x66\x55\xdd\x26\xe1\x8d\x48\xc4\xc6\xf5\x11\x7e\x09\x3c\x47\x6f\x28\xf4\xf5\x6b\x25\xce\xf1\x78\x59\x45\xc6\xe9\xfd\xcd\xe7\xce\xbf\xd6\xae\x63\xb3\xf9\x65\xad\x37\x4f\x67\x9e\xfc\xf1\xd2\x78\x81\x18\x1d\x63\xd8\x81\xd9\x7d\xee\xee\x20\x98\xa4\x66\x4e\xaf\xe0\x3b\x51\xf2\x92\xfa\x09\x87\x04\x67\x92\xc3\xaf\xa8\x2f\x4b\xaf\x82\x4c\x72\x91\xf0\xa1\x0e\xe5\xf7\x2d\xb5\x91\x29\x48\x3e\x79\x2a\x77\x54\x7f\xd6\x33\xf8\x8f\x76\xee\x87\x2e\x89\xfe\xa9\x1a\xd1\xed\x2c\x0a\x47\xfe\xd1\xcd\x35\x0b\xe6\x49\xda\xce\xf7\xa2\xd7\xe8\xbd\x5a\xc9\xc2\x94\xe6\xd6\x45\x32\xe7\xfb\xed\xb3\xfe\x67\x06\xff\x51\xc5\x51\x01\x8c\x55\x12\xac\x81\xf2\xa4\xae\xe8\xe1\x16\xf5\x34\x8f\x31\x2f\x9e\xbb\x0b\x82\x29\x47\xd4\x03\x57\x5b\xae\x64\xd9\x1c\xbd\xd4\x68\x35\xb4\xd2\xbd\x77\xed\xa8\x81\x84\xd7\x87\x3e\xe3\xf5\xed\xe1\x63\x08\xae\xc5\x0e\x30\xe0\x52\xe4\xa0\x95\x7c\xe1\x35\x57\xac\xfe\xa7\x9c\xb9\x03\xc7\xe7\x34\xe9\xe8\x31\x2d\xbe\x2a\xc7\x41\x5f\x6b\x0c\xc4\xde\x4a\xbc\xae\x4f\x11\x82\xfc\x67\x15\x7f\x00\x4c\x1e\x15\xeb\x02\x0a\x28\xd4\xd0\x10\xca\xce\xdc\x75\xd0\xc5\x28\x4a\xa0\xa6\x5c\x29\xba\x8a\x2b\x6a\xd0\x65\x4b\xe3\x15\xf1\xf4\xa0\xab\x0e\xf7\x25\xe3\xd6\xf2\xad\x1c\x5c\x5a\xa3\xd5\x38\x14\xda\x69\xe3\xe6\xe2\xed\x6a\x59\x61\x01\x5f\x72\xb0\x48\xf7\x64\x98\x8b\xbe\x7d\x96\xdf\xd7\xec\x8f\x85\xb6\x45\x66\xb9\x37\x0c\x1b\x92\x42\xba\x5a\xd1\xbc\x89\xf9\x77\x3c\xec\xd8\x00\x3a\xab\x9c\xd5\xeb\x27\x55\x38\xc3\xb9\x7f\x58\xf5\x99\x5c\x70\x45\x86\x61\xb8\x65\xf3\x17\xc7\xf6\xa0\xaf\x76\x02\xd4\x35\xf7\xcd\x3a\x54\x59\x7a\x65\x83\x5a\xe6\xfa\x60\x24\x5e\xc6\x2e\x13\xad\x65\x3f\xcd\xfb\xe1\xd3\x03\xa2\x6d\x21\x28\x97\x74\x01\x65\xb1\x04\x45\xa6\x2b\xfb\xa7\x3b\xd6\x71\x51\xa1\x23\x92\x2b\xf4\xf9\xfb\x5e\xc3\xf7\x35\xcd\x4a\x88\xe1\xa5\x85\x53\x97\x2f\xb6\x4e\x31\xdb\x76\x15\x33\x75\x46\x63\x66\x50\xc1\x57\xe7\x06\xba\xcc\x3f\x74\x2d\x74\xde\x64\x30\x73\xd8\xa1\x8f\x83\x99\xd0\xdc\x68\x47\x7e\x5f\x90\x9a\x52\xca\xf3\xdb\x57\xe1\xeb\xaf\xf9\xf2\x3f\x39\xb4\x17\x3a\x13\xf5\xf0\xe5\xdd\x80\x83\x53\xd1\x05\x09\xa7\xc7\xcf\x6f\x68\x76\x85\x88\x77\x4d\x02\x0c\xb7\xc4\xef\x5e\xd7\xe0\x99\xb6\x52\x01\xa3\x35\xa0\x32\x6a\x77\x7c\x51\x5b\xb5\x3c\x16\x40\xe3\xdf\x4e\x2b\x43\xe0\x4f\x1a\x51\x80\x8b\x50\x1c\xf2\xe1\xe6\x58\x6c\xcb\xde\x90\x2c\xbd\xba\x61\xcf\x8a\x1c\xe4\x1d\x4e\x3f\x75\x41\x7f\x18\xf7\x6a\xe2\x8a\x55\xd4\x5c\xd3\x55\x16\xa1\x50\xa7\xf4\xb3\x8d\xe0\x61\xb0\xae\xd9\x17\xaa\xa6\x27\x4f\xbb\x46\x5c\x97\x63\xb6\xf5\xec\xae\x78\x7f\xbf\xca\xc6\x00\xf6\xf3\xd5\x23\xbb\xed\x7b\x15\x7c\xb7\x97\xdc\xa6\x16\xdb\x49\x8e\xb5\x56\x43\x24\x41\x4d\x77\x0b\x5c\xce\x9b\x38\xc3\x06\x18\xbc\x83\x52\x51\x3d\xba\x9f\xb9\x3b\xf1\x8e\xe9\x50\x37\x92\x60\x26\xd7\x37\xd3\x3e\xee\xe7\x9e\xa0\x6c\x88\x61\xe1\x32\x81\xaa\xe7\x8c\x7b\xbc\xbb\x7b\xb2\xd2\x50\xb6\xa7\x26\x9f\x20\xff\x0b\x86\xab\xb3\x42\x31\x3f\xc4\xea\xe1\x7b\xfc\xac\xd2\x80\xdf\x7c\x05\x67\x96\x28\xf2\x7b\xbd\x0e\x11\x31\xcb\xfc\x7a\x6a\xb2\xeb\x5f\x2e\xb7\x1c\x06\xc5\xc4\x36\xb7\x0a\xed\x2b\xb2\x1a\x02\x66\xf7\x0e\x69\x2a\xac\x48\x35\x2f\xd0\x91\xe7\x0d\xc6\xed\x59\xe3\x1b\x74\x45\xd9\x38\xc1\xe0\x31\xcc\xe6\xa1\xa0\xcb\xd2\x7a\xbb\xbc\x24\xab\xb7\x52\x44\x10\xed\x64\x61\xb7\x09\x77\x9b\xd9\xa0\x74\x2b\x76\x14\x63\xf8\x69\x4d\xae\x37\x7c\xf9\x42\x9e\xfb\x7d\x52\x47\x9e\xb5\xb3\x52\xa8\x05\x7f\x4d\xa8\x33\xa1\x0c\xfc\xdf\xf6\xc3\x0e\x5d\x0e\xc6\xe7\x0c\x0f\x20\x83\xe4\xdb\xbd\xc7\xb6\xea\x00\xab\x6f\x3c\x04\x10\x90\xb8\x7f\xef\x7e\x80\x48\xbd\x6d\xad\x6a\x65\xb9\x97\xe2\x29\xb9\x39\xf2\x97\x48\x63\x43\xae\x74\x07\x96\x75\x0f\x36\xa9\x36\xb7\x7f\x6f\x70\x7e\x56\x38\xb3\x6f\x9f\x7d\xa9\x52\x61\x21\xd6\x14\xb4\x7c\x16\x68\xbd\x75\x4d\x2f\xec\x46\x03\x02\x1c\x41\xb0\x66\xa6\x27\xff\x45\xdd\xd5\xa7\x80\x40\x9a\xa7\x88\x29\xee\x60\x7e\x72\x14\x89\xcf\x77\xbe\x7b\xce\xc0\x8b\xc1\x7b\x9c\x7b\x69\xeb\x9e\x69\x21\x4c\xb9\x12\xa3\x23\xb8\x47\xc5\x09\x3c\x46\x0f\xed\xe5\x89\xf4\xe7\x95\xac\x49\x18\x47\xce\x06\xe8\x2b\xd4\x90\xbc\x71\x08\x82\x08\x11\xf0\xec\x61\xd3\x99\x0e\x52\x87\x0d\x79\x9f\x98\xf2\x90\xb0\x7f\xe5\x7e\x2a\x53\xa9\x6d\x1d\x77\xfd\x33\x7d\x9f\x82\xef\x2e\xa1\xa5\xd7\x75\xd0\x5c\xb0\x35\xee\x9f\xec\x47\x85\x68\xcb\x48\x8b\x77\x0f\x63\x74\x42\x93\x57\x9b\x7c\x71\xea\x8a\xe5\x54\xb0\xbf\x26\x7f\x2e\x48\xfd\xbe\xd1\x0c\xd1\xf6\x1f\x4a\x0a\x2e\x90\x17\xe8\x8d\x38\xf3\x91\x43\xe2\xf4\x49\xb6\xaa\xb2\xe1\x3c\x9d\x80\x72\xe7\x79\xc2\x97\x0b\xbf\xa1\x43\xb8\x87\xd8\xef\x43\x55\xee\x2a\x2c\x7b\x03\xc4\x09\x42\xb2\xf4\x6e\x58\xb7\x4d\xb9\x65\x36\xcd\x7e\xfd\x23\x64\x34\x12\x1b\xaa\x37\x89\x42\xf2\x34\xef\x58\xc4\x9d\xd9\xe5\x90\x4f\x49\x6e\x54\xe5\xdc\xa9\x12\x8f\xf0\xbe\xd6\xee\xb4\xcd\xc9\xbf\x27\xb7\xa7\x4d\x68\x21\x9e\x19\x4c\x5e\xb1\x22\x29\x36\x67\xf6\x3c\x94\x55\xe9\xaa\xfd\xf0\x80\x40\xb5\x4c\x6b\x08\x64\xda\x67\x37\x9d\xc5\x25\x1f\x4a\x52\xe7\x74\x15\xd5\x2e\x4a\xc3\xfc\xb4\x37\x75\xe3\xb0\xe6\x9d\x16\xe9\x8a\xd2\x88\x3d\xcf\x66\x93\x0e\x71\x45\x41\xe0\x92\x78\x60\x04\x3e\xc3\x1a\xd5\x19\x0f\x65\xfb\x99\x74\x67\xf1\x0e\x4e\x75\xa3\x7a\x72\x28\x3d\xe4\x89\x31\x55\x54\xfa\x2a\x90\xfc\x8f\xea\x47\x76\xb8\x4d\xae\xa8\xef\xda\x2b\x3b\xf1\xda\xcd\xb9\xd0\x4c\xff\x99\x2f\x6b\x2f\xa5\x83\xe2\x9e\x9c\xf8\x19\x5d\x1f\xe2\x81\xf6\x6f\x4b\xde\x84\x7a\x65\xab\x1f\x50\x44\x0c\x84\xf4\xcf\x3b\x1f\x48\xd9\x2b\x73\x57\x3f\xee\x37\x9e\x25\x7a\xba\x49\x64\x12\x7d\x94\xf7\x97\x54\x46\x62\xc8\x80\x74\xca\x93\x69\x36\xff\xa8\x46\xe2\xd5\x41\x50\xd4\xc0\x95\x93\x55\xf6\x36\xed\x66\x35\x98\x92\xed\x0d\x3c\x24\x89\x38\xb4\x3c\xfd\xfa\x50\xe4\xd7\xd2\xd2\x86\x5f\xaa\xc2\xa9\xf1\x47\xb5\xf6\x67\xc1\xb5\xda\x75\xe0\x41\xbc\x99\xce\xaa\x91\x66\xd1\x75\xad\x32\xaa\x4b\x55\xfb\x5f\x6b\xfe\x33\xe5\xf7\x56\x6a\xbb\x22\xc2\x87\x15\x96\x6a\xfd\xc9\x76\xe7\x98\x4a\xe3\x62\xb3\xfc\x16\x7b\x46\xd9\x6e\xff\xb6\xba\x74\x00\x25\x84\x5f\xc1\x94\x20\x10\x73\xa0\x35\x84\x54\xff\xc8\x34\xf0\xd2\x0a\xe5\xec\x25\xdb\x0c\x81\x55\x30\xf8\xd1\x55\xaf\xa0\xe8\x66\xdd\xdc\xc8\x70\x33\x66\x68\x8b\xe4\xd5\x21\x54\x9b\x78\x51\x0c\xf5\x78\x26\x72\x78\x42\x5a\x79\x5d\x82\xda\x17\x25\xcf\xb3\x8f\x2d\x8a\x62\x6c\xc8\xbd\xf9\xcd\xa3\x6b\xde\xf4\x01\xad\x91\x65\xa6\xec\x55\xaf\xae\x8b\xa9\xa4\x49\x77\xca\x74\xba\xb8\xbe\x5d\xba\x38\x5e\x97\xf3\xf2\x3f\x02\x53\xa5\x57\xc6\x5e\x92\xae\x81\xae\x16\xf9\x71\x99\x04\x86\xc0\xfe\xed\x99\xd2\xdf\xe2\x4b\x29\xdb\xe4\x9a\x51\xdf\xe4\x31\x39\xab\xfb\x58\x68\x8f\x23\xdf\xb8\xee\xe9\x30\x00\x16\x52\x42\xdc\xc7\x08\xf3\x84\x6c\x6f\x8b\x9c\x1c\x06\x09\x33\xae\x58\x25\x65\x51\xa4\xed\x5a\xd9\x2c\x3f\x9f\x94\x91\xcb\xbb\x74\xf6\x20\x29\x11\xa2\x48\xa5\x42\xb1\x42\x03\x68\xda\x1d\xfe\x15\xcf\x14\x36\x44\xb7\x8c\x62\xb5\xca\x1c\x55\x3d\xb2\x3d\x80\x7f\xbd\xa9\xe9\xdd\x0a\x02\xc5\xff\x72\x6b\xc1\xbe\x69\x97\x66\xd9\xfc\xab\xb4\xea\x0c\x8b\x34\xde\xae\x0b\xe2\x8a\xe0\x13\x75\xec\xd7\x5e\x36\x91\x67\x0f\xb4\xc2\x60\xb1\x89\xb3\x3b\x12\x7d\xa4\x5c\x85\xd5\x15\x94\x70\x02\x43\xf6\x7d\xf6\x77\xf7\xe2\xd5\x39\xfb\xdf\xf7\x34\x80\xd4\x58\x6f\x45\x4d\x14\x95\xdf\x97\x95\x02\xde\xf2\xaf\xeb\xff\xba\x24\xf0\xa8\x05\x04\x5e\x5d\xa3\xc9\x7e\xe5\x29\x53\x2b\x4a\x5e\xac\x56\x04\x10\xdc\x4c\xdb\x08\xa1\xf6\x7b\x52\xe5\x99\x41\xde\x86\x80\x07\x9c\x4b\xde\x5e\x81\xde\x5c\xaa\xa6\xef\x9d\xf2\x1d\x60\x73\xb4\xc4\xe5\x22\xb6\x23\x43\x8d\xe6\x32\xa5\x8d\xe0\x77\x25\x4d\xc6\x42\xda\x3b\x1d\x6d\xce\xe7\x78\x21\x1f\x64\xbb\x56\x74\x7e\x6a\x57\xf4\x34\x77\x8c\x5e\xf1\xd0\xe7\x3d\xee\x17\xf3\xd0\x6e\xe9\xa2\x9d\x28\x93\x81\xbb\x4e\x9a\x0b\x2e\xc6\xfd\x75\x27\xd5\x4b\x10\xf8\xae\x6e\x19\x2d\x1d\x68\xab\x4a\x9e\xb5\xf9\x7d\x3e\x1a\x2f\xfa\x22\xf4\xd2\x07\x7a\x72\x5e\x12\x66\x16\xe8\x3e\x6d\xa7\xa6\x53\x38\x60\x2d\x03\x8e\x78\x87\x71\x82\x0a\x7b\x13\x63\x01\x76\xff\xfc\xa9\x60\xf4\x37\xb3\xdf\xa1\xcb\xd5\x15\x4d\x6b\xf0\x4e\xd2\x1c\x21\xef\x7e\xc4\xa2\xd7\xaf\x82\x5a\x8b\xfa\xf9\xf6\x60\xd9\x82\x33\xee\x65\xb1\x7c\xaa\xe1\x82\xa1\xe7\xd5\x6f\x7e\x5e\xe9\xaa\xed\x6a\xbf\x3a\x3d\x2c\x14\x2c\x70\xa3\xee\xf1\x93\x99\x4d\xfc\xc6\xde\xf5\x4d\xe3\xc3\xfb\xac\x7d\xae\x8a\x59\x62\x19\x30\xfb\x2e\xbb\x40\x9c\xd4\x50\x7e\x6b\xb8\xb8\x31\x44\x64\xf4\x97\xfe\xe5\xf5\xe4\xd1\xc8\xb1\xe5\x66\x9d\x2f\x49\xdd\x38\xf5\x3d\xb8\xa9\xce\x65\xff\x1e\x5f\x74\x7d\x31\xda\x45\xe8\x68\x67\xb8\x19\x4c\x86\x6d\xb1\x45\x70\x27\x72\x3a\xc7\x96\xde\xcc\xc5\x39\x26\xfd\x59\xef\x46\x70\x3b\xd5\xbb\x2f\xa5\xef\xfa\x4d\xec\xe9\xec\x9f\x39\x46\x0e\x07\x68\xcc\xa0\x16\x42\x14\x41\x93\xb9\xc3\x98\x15\x56\xbb\x9d\xbe\x78\x42\xfd\x28\x66\x9d\x7c\xdf\xa9\x5d\x85\x1d\x5a\x1d\x0f\xf2\x3e\x77\x6c\xf1\x72\xaf\x14\xf6\xbf\x88\xd4\x90\x35\x1e\xdf\xb3\xfc\x63\x24\x50\x49\xdc\x93\x1c\x46\x56\x8a\x1f\x9a\x06\x29\x40\xfc\x38\x53\x2d\x1c\xf3\x84\x1e\xd6\x37\x20\xc4\xf3\xa1\x10\x0c\x8a\x38\x89\x5d\x5b\xa6\x6a\x86\xd5\xa4\x5b\x50\x4a\xf0\x1f\x8a\x43\x01\xd8\x43\xad\xaf\x68\x8d\xa7\xa5\x21\x6d\xa7\xbb\x54\x6c\xfa\x56\x9e\x56\xa2\x70\xc5\xd4\x62\xcc\xe9\x84\x91\x6a\x29\xe9\x27\x22\x62\xac\x57\x05\x49\x63\xe8\x28\xd8\x13\x86\xa6\xd5\x76\xa0\xa8\x1f\x04\x46\x8c\x26\x80\xc2\x81\xc9\x33\x5b\xdd\x30\x13\x88\x1c\xca\x33\xfb\x67\xac\x06\x66\x22\x31\x24\x93\x71\x81\xf1\x85\xdf\xd4\xf6\xf3\x55\xe1\x4f\x63\x0e\xbf\xf8\xef\xa8\x76\x3e\xec\x5c\x5d\x74\x50\x8d\x75\x0b\xd9\xcd\x6d\x5b\x48\xc7\x6c\x8f\xdc\xd2\xde\xde\xdd\xb5\x6d\x67\x80\x2b\x51\xad\x65\x43\xb5\xa3\x9e\x57\x63\xbf\x7a\x69\xb8\xb7\xe7\xca\xec\x88\x24\xf2\x07\x93\x94\x77\x66\xce\xd1\xc6\x2d\x48\xc1\x5e\xc6\x99\xd3\xd9\x50\x1a\x37\x9c\x08\x07\x99\xa1\xca\x87\x30\xc8\x49\x6b\x8e\x48\x6b\x66\xbf\x4d\x1e\x5b\xd7\x13\xa6\xde\xf9\xb6\x3e\x0b\x2f\xdb\xb6\x92\xbe\xdc\xab\x08\xac\xb2\x8a\xd0\x44\x95\x24\x1f\xff\xd0\x37\x5e\xf3\xa4\x6c\x95\x69\x39\x82\x60\xb4\x71\x01\x01\x1a\xff\x1b\xd5\x97\x00\x65\xb8\xe7\x7a\xa0\xb0\x7f\x55\x92\x77\xd1\xef\x06\xde\x8d\xa3\x18\x5d\xc4\x2a\xe3\x10\x4b\xc8\x8a\x7f\xbd\x7b\xa6\xae\xf7\xac\xf7\xd3\xe1\xdb\xe0\x2f\x52\x1e\x66\x1f\x06\x16\xcc\xc7\x6e\x9d\xb6\xfa\x33\xd9\x32\x24\x7b\x76\x96\xd7\xe5\x6c\x06\xd1\xaa\x24\x1c\x40\x81\xe7\x94\x57\xb4\xf7\x7f\x41\xe6\xc9\x71\x11\x9b\xa6\x14\xf6\x78\x72\x78\x48\x01\x89\x37\xb0\x9a\x89\x01\x9c\x12\xba\xef\x01\x3b\x7f\x63\x5e\x11\x10\x1e\x4f\x6d\x38\x8e\x36\x6d\x5b\xd9\x38\x3c\x64\x76\x77\xff\x1c\x28\x33\x12\x23\x1e\xa7\x5c\x6e\xf4\x18\xa2\x18\x65\x7c\x5e\x9e\x1c\xe9\x9c\x2c\x10\xed\x30\xe3\x47\x52\x85\x30\xf5\xba\x18\xad\xcd\x88\xbd\x70\x39\x97\x16\x81\x06\xd2\xf7\x9e\x0b\x54\x46\x78\x1a\x1b\x52\xfa\x8a\x83\x8e\x25\x1a\x94\x29\xd4\xea\x73\xdf\x3d\x3f\x2a\x58\xff\xed\x70\xd6\x82\x9a\xf3\x37\x6c\xdc\x01\xa2\xd5\xc4\x6d\x5e\xf5\x30\xd9\x0a\xfc\xc4\x29\x2f\x88\xc7\x91\xd5\xc9\xc5\x91\x42\xfb\x07\x22\xa1\xcd\xc3\x1e\xfa\xae\x24\xe0\x62\x10\xed\x45\x6b\x1c\x53\x16\xff\x0c\x62\xca\x91\xcc\x61\x74\xfc\xd5\x32\xa7\xf3\x21\xd4\xee\x88\xe9\xc3\x4c\xfb\x01\xf8\xa4\xba\x32\xc2\x47\xba\x5c\xf2\xaa\xba\x52\xa2\x36\x80\xfe\xf6\x3c\xd0\x2b\xd0\xe9\x87\xea\x8f\x36\x73\x10\xac\x36\x65\x0a\xc4\xeb\x9c\xd6\x41\xe9\xf2\x69\x69\xb1\xa6\xfc\xa1\xe8\xcd\x0b\x8b\xf7\x3f\x38\x38\xf4\xb5\xcc\x2c\x82\xf3\x4d\x3a\x13\x36\x81\xd3\x0d\xe7\xca\x65\x7c\x36\x29\x89\xb0\x6a\x27\x5d\x3f\xe3\xbe\xa3\xa2\x1e\x1d\xb6\x2a\x84\x44\x7b\x40\x1d\x8f\x98\xca\x78\xf0\x97\xcd\x34\xce\x4a\xda\xa8\x37\xbd\x9e\x5f\xfb\xce\x5b\xbc\x82\x2a\x2d\xb2\xb9\x9b\xda\x5a\xbc\x6e\x73\x5c\x7e\x3d\x68\x2f\xed\xd6\x17\x98\x9c\xe1\x5d\xbe\xa4\x56\xf2\xe6\xed\xf1\xbf\x27\x52\xf0\xcb\x30\x48\x78\xfd\x2b\x65\x64\x56\xce\x38\xaa\x56\x50\x7b\xff\x26\x00\xbd\xe4\x08\xf4\x86\x30\x07\x0c\xc8\xe6\xd2\xcb\x75\xb3\x27\x61\xbd\x20\xdf\xdc\xdf\xf5\x61\xce\x73\x8f\xda\x94\xcf\xd1\xae\x73\x2e\x57\xce\xe1\xb1\x11\x81\x70\xe2\x06\xde\xe1\xac\x9b\xd5\xcd\x19\x16\x9c\x7d\xda\x1a\x82\x6a\x3c\xdd\x74\x95\x0a\x7c\xf8\xa6\x1c\xfc\x6e\x6c\x70\x38\xe0\xea\x5d\xea\x4b\x93\x36\x56\x2a\x8b\x39\xa3\xf0\xca\x85\x14\xfe\x60\xf3\x1b\x2b\x8f\xc2\x2f\x1f\x4e\xfa\xdf\x37\x65\x1c\x9f\x13\xa3\xca\x9b\x35\x44\x9f\xa5\x29\xee\x98\x70\xbb\x7f\x5b\x52\x9e\xaf\x3d\x73\xa6\x50\xa6\xe8\x63\x8d\xb4\x93\x94\x4f\x3a\x64\x0c\x6c\x4b\xf5\xf7\x8a\xca\x80\x5a\xb6\xbb\x31\x3a\x6a\xab\xcb\xac\x76\x4e\xb5\x6f\x2c\x93\x29\x4c\x76\xf9\x5d\x17\x0e\xcc\x49\x8e\x78\x7d\x68\x87\x7a\xfb\xdd\xe9\xf5\xa9\x64\x15\x61\x3e\xa0\xb5\xfb\x9f\xce\xca\x0b\x7d\x35\xba\x58\xee\x6d\x25\xda\x01\x08\x26\x04\xdb\x51\x33\x97\x34\x94\x9b\x40\x2f\x2d\x95\x19\x3e\x8c\x37\xe9\xb5\x25\x6f\x77\x72\x44\x1a\x2a\xe1\xf6\xc6\xd1\xde\xdb\x62\xfd\xf4\xcd\xef\x79\xb9\x09\x16\xf6\x06\xc9\xca\xdf\xa8\x6e\x4d\x7c\x68\xa0\x70\xf5\xf8\x2b\x6c\x75\x69\xc8\x3b\xe2\x6e\xdd\x2c\xb1\x48\x35\xa8\x12\x29\x6a\xce\xa5\x80\x3f\x18\xb3\x7f\x69\x69\xb4\x16\xf4\xf3\x0b\x33\x9e\x04\x4d\xb8\xa7\x79\xe4\xac\x57\xba\x94\x7b\x2b\xe1\x48\x62\x59\x4f\xc0\xef\xca\xd5\x11\x49\xf9\x7b\x44\x48\x28\xcc\xa9\x03\xd7\xa5\xeb\x60\x6a\xb9\x33\xb3\x79\xe5\xd3\x95\xe4\x99\xf8\x1f\xf8\xe0\x07\xd5\x20\x36\x58\x65\x31\x31\x8c\x5f\xdb\x9f\x1d\x50\xd2\xcd\x83\xa9\xd2\xde\x52\x2c\x3a\x9c\xbe\xa5\xe0\x98\x12\x56\x8e\x19\xb5\x7b\x15\x91\x19\xae\x8c\xf8\xbe\x59\xbb\x9e\xd1\x94\x19\x2b\xfa\xd1\x42\x2a\xf0\x67\x49\xde\xd8\x89\xa5\x9f\xb9\x1d\xaf\xf9\xa2\x03\x5f\xb4\x8b\x01\x25\xfa\xcb\xaf\x8a\x4f\x1a\x63\x86\x23\x24\x55\x47\x11\x4c\x30\x77\xee\xb5\x5c\xa2\x92\x91\xa5\x32\xe5\xbe\xc3\xcb\x95\x9c\x9c\xf2\x50\x1d\x6e\x77\xf7\xb4\x76\xaa\x91\x88\x5b\x3e\xdb\xd2\x76\x68\xdd\xc6\xe1\x48\xd3\x8e\x8a\xc8\xb7\xb1\xd0\xd1\x3f\xa8\xec\x94\x9b\xb3\xef\x6b\x2c\xc7\x07\xf9\xd8\xf1\x48\x46\x9a\x71\x78\xd5\xa0\xd2\x25\x7e\xe0\x28\x37\xd3\x4e\x7a\x49\x2d\x2f\xe6\xa0\xf2\x88\xf3\x47\x7d\xc8\x4b\xe0\xc3\x60\xe3\x46\xd3\x5a\x68\xde\x73\x67\x82\xb0\xfb\xe4\xd8\x4b\xa9\x39\x3d\x87\xf0\xad\x3b\x9e\xb0\x63\xdc\xca\x6f\x3f\x5b\x1b\x1a\xdf\x94\xed\xad\x6d\xaf\x45\x5c\xf5\xc8\x15\xb6\xb8\xd9\x77\x3d\xc7\x42\x2a\xdb\x5c\xd4\xbd\x76\x34\x07\xc5\x93\xfb\x5f\xbf\xb1\x88\xf9\x78\x58\xa4\x1a\x46\x93\x61\x2e\x0e\x66\xfe\x88\x34\xc7\x1b\xe4\xb0\xd2\x4e\x68\xf2\xfd\x2c\xd5\xf1\xa4\xee\x52\x87\xb6\x6d\xeb\x47\x4b\x63\xd8\x94\xab\xbd\x19\xce\x5e\xbe\xef\x05\x95\xc8\x92\x53\xfd\xbd\xc4\x9b\xd7\xc4\x41\x4b\xe1\xbe\xda\x5f\x9b\x98\x27\x89\x6f\xeb\xf4\x64\x78\x5e\x73\x5a\x9b\x0a\x5d\x18\x40\xea\xa4\xf3\xbf\x07\xf2\x85\x1d\x5f\x12\xfd\x70\xc7\x7e\xc4\x3f\xb9\xc5\x77\xa6\x74\xec\x97\xc8\xd1\xc3\xbc\x53\x05\x65\x73\x11\xbf\xbc\xc7\x42\x0e\x35\x7a\x03\x6f\xfe\xc0\xa2\x02\x78\xf5\x0c\x5d\xff\xb1\x17\x0f\x2d\xb1\xf0\xc2\x73\x8c\x57\x07\x76\x0b\xef\x05\xb4\x1e\x27\xe1\xac\x8f\xae\x8b\xd4\xa1\xe4\x66\xdf\x8a\x98\x74\x59\xf9\x06\xde\xfb\x3c\x5f\x95\x65\x74\xaf\x32\xb1\x5a\x6f\xc0\xda\xcc\x62\xef\x4e\x7f\xd7\x2e\xe5\x49\x8f\xe4\xac\xaa\xc9\xaa\x6d\x4a\x5e\x78\xcc\x5b\x37\x16\xfc\xfb\xc0\xac\x0e\x51\xdb\xa7\x62\xf8\x05\xed\xec\x88\xe5\x9d\xe0\x27\x8c\xe2\xac\x9d\x11\xf2\xed\x1a\xba\x1f\xd3\xdb\x37\xe2\xdb\x26\xb8\xc0\x66\x13\xd5\xd5\xc5\x29\xd1\x8f\x4e\x1c\x9d\xe9\xbb\x37\xd8\xa1\x54\x74\xbf\x38\x73\xf9\x85\x58\xe7\x1c\x5c\x09\x97\x46\x9f\xed\xd3\x01\x7f\xc1\x98\x1b\x78\xcc\x42\x05\x4b\x7d\xf6\x73\x2c\x09\x6e\xa7\xd0\xfc\x8c\xf2\x52\xa6\xdb\x83\xc6\x96\xe3\x51\x46\x97\x39\x5b\x5c\x96\xd5\xad\xd3\x3b\xc3\xf3\xda\x99\x28\x86\x4f\xed\xf1\x62\xd8\x4d\x1a\x8f\x46\x83\xd0\xc7\xdb\x50\xf0\x0a\xb3\xc2\xb7\xee\x09\xfc\xff\xc2\xfb\x88\xf6\xb0\x4a\xd5\x25\x05\x94\x8b\xc1\x33\x5e\xfb\x70\xab\x40\xa1\xb0\x9c\xf7\x04\x79\xde\x22\xed\x01\xfe\x8f\x9f\x10\xbb\x68\x93\x3f\xa3\xac\xd7\xfc\xc2\xd6\xcb\x29\xd2\xc3\x18\x6d\xfd\x81\x28\x98\x44\xc1\xaf\xb7\x1c\xb9\x21\x33\x3d\x30\x77\x96\x56\x95\x1b\xd4\xda\xc8\x03\x35\x2e\x16\x7a\x65\xcf\x40\x5d\xf2\xc4\x92\x00\xf2\x17\xca\xd0\x81\x98\x54\xfe\x28\x58\x79\x8a\x5f\x8e\xd8\xf1\x38\x85\x55\x7b\xdc\xd4\xed\x1d\x25\xcc\x58\xe4\xe7\x04\x86\xad\x81\x5d\x52\x5a\x40\xeb\xfb\x34\x09\xcd\xd5\xd2\x45\xb3\xc1\x25\x9a\x9f\x37\x0e\xfd\x0b\x60\xc2\x31\x84\xa0\xd7\xa5\x01\x95\x6c\x72\x93\x58\x77\x8d\x17\xe1\x7a\x00\xe5\x5c\x57\x33\x37\x7b\xf4\x12\xca\x55\xad\x0a\xa1\xed\x50\x1a\xb5\x17\xfb\xcf\x25\x53\x87\x79\x55\x4d\x5f\x23\x79\x31\x84\x3c\x49\x51\x0c\x5b\xbe\x44\xc3\xa4\xae\x8f\xb0\x61\x62\x7d\x2c\x7d\xac\x92\x55\xbd\x78\xac\x2e\x86\xb5\x99\xec\x58\x83\xcc\xc8\xd8\xd8\x4e\x1d\x5f\x3b\xa4\xb5\x53\x5d\x49\xb4\xb7\xb9\xb7\xd6\xa5\xf8\x57\xa7\x8d\x2c\x90\xe6\xe3\xa9\x85\x29\xf7\x07\xb0\x60\x20\xe7\x91\xe2\x52\x79\xaa\x76\x16\x3f\x6e\xd0\xe0\x0b\x2a\x42\x26\xa9\xee\xae\xe0\xf8\xcb\xf3\x57\x0c\xc9\x7c\x69\x71\x4a\xa5\x2b\x21\xec\xef\x9e\x60\x7f\xb5\x41\x8a\xce\x92\x9d\x5f\xdc\xf7\x79\xa5\x64\xa4\xea\xfb\x5d\xd5\x6b\xbf\xbe\x60\x50\xf1\x5a\x14\x8e\x90\xfa\x83\xf6\x50\x85\x2c\x57\x53\x5c\x69\x1d\x76\x8f\xb4\x13\xee\x36\x4d\x04\xe2\xe9\xcf\x19\x55\x3d\xc9\x0e\xa1\xe0\x8d\x63\x1e\xea\x12\x85\xe1\xd2\xf5\x05\x98\xb9\x68\xdf\xed\x93\x2a\xcb\x1c\x19\xa7\xd1\x62\x04\x15\x6a\xdc\xd4\x41\x55\xec\xa9\xe8\x8f\x52\x12\xbf\x47\xe8\x65\x0b\x11\x84\x0b\x68\x13\xd4\x22\xba\x21\x90\x2c\x28\xec\xc2\x3f\x55\xee\x6d\x64\xad\x41\xe2\xf3\x21\xbb\xc4\xf7\x36\x49\x0f\xd8\xad\xe4\xe9\x97\x87\x7f\xd7\xa2\xa7\xaf\x80\x68\x25\xc6\xa3\x81\xde\x1f\xcd\xd9\x2c\xeb\x98\x1c\x7d\x4f\x62\x05\x62\x6b\x32\xbd\x2e\x1d\x86\x65\x2e\x0c\x15\xc6\x5d\xea\x2e\x89\xac\x23\xb6\xfa\x2d\x23\x20\x59\x8a\xc5\xd6\x76\xe2\x63\x27\xe4\xb7\x61\xdd\x0d\xdb\xbf\xca\xe6\x5a\xcc\xc0\x3e\x95\xb4\x57\x46\x52\xc7\xa6\x70\x55\xe9\xa6\x93\x59\xf7\x1f\xfa\x4d\xe8\xd5\xba\x96\x13\xd8\xb3\xae\x4c\xf6\xcf\xb2\x9a\xb9\xaa\x24\x77\x38\xb6\x58\xe9\xa1\x75\x90\x65\x8e\x63\x12\xeb\xb8\x46\x5e\xa9\x97\x0f\x3c\xb9\x15\x07\xa7\x18\x10\x63\xe6\x93\x6a\x88\x98\xab\x90\xc0\xcd\x00\xd1\x0f\xe3\xfe\xbc\xca\xfa\xa7\x8d\xc2\xfb\x6c\xba\x69\xc8\x70\xa2\x60\x34\x58\x8f\xd9\xca\x28\xe9\x87\x8e\x51\x11\x72\xba\x2d\x97\xc0\x90\x92\x42\x9d\x73\xb7\x46\xcf\x2b\x73\xc5\xe3\x6d\xa0\x59\x0d\x10\x20\xd6\xe4\xc6\xca\x0b\xf3\x20\x24\xee\x85\x6b\xc6\xf9\x71\xbf\xe3\x78\x51\xe3\xbd\xc9\xd5\x3e\x41\xd8\x8f\x8b\xad\x33\x92\x37\xe1\x7b\x43\x53\xce\x5c\x28\x82\xe1\xd8\x1e\xbd\x93\x95\xaf\x7c\xcb\xf7\x5e\xb3\xbc\x04\xef\x7d\xc0\x42\xcf\x2a\xde\xfe\x1d\x1e\x41\xe0\x5f\xe0\xc6\xb8\xaa\x25\xd6\xfc\xe8\x4a\xc7\x6a\xd2\xb8\xa6\xdb\xc6\x50\xb5\x68\x15\x8c\x40\x5b\x5e\xa6\xdf\x3c\x1c\xf9\xe6\x3b\xb8\x6d\xae\x1d\xbc\x34\xf4\x4a\xe9\xb9\xf2\xf8\x68\x36\xf8\x43\xc6\xaa\x2b\x2d\xb3\x4f\x4c\xf8\xf3\x3b\xc9\xb0\x63\xbb\x60\x2b\x93\x76\x55\xfd\xa8\x0d\x2a\x9e\xc2\xe0\xc0\x58\xdd\x0e\x6d\x94\x77\x97\xd5\xd6\x81\x81\xa6\xe8\xee\xf0\x22\x05\xac\x95\x1c\x29\xf5\x41\xd0\xf8\x68\x7e\x44\xd5\x2f\xcb\x62\x5a\xcd\xd9\x5c\xca\x33\xca\x39\xdf\x04\x1f\xfc\x7f\xc8\x42\x86\x06\x31\x61\x72\xd6\x0e\x23\x92\x19\x91\x8b\x81\x62\x7d\xf3\x5a\x4b\xb2\xb0\x60\x36\xf8\x46\x6f\x25\x0a\x59\x69\xe8\xb6\xf4\x10\x1d\x6e\x88\xf5\x90\x46\x32\xb5\x0b\x9d\x15\xfe\xa1\x30\x7a\xb9\x73\xa5\xb5\x4b\x2b\x21\x5d\x1d\xd1\x69\x12\x65\x5b\x4b\x0b\xfe\xbc\x8a\xfa\x84\xb7\x39\x06\x98\x4c\xce\x8d\x7d\x08\xaa\xc4\xcb\xf6\xd1\x42\x43\x27\x41\xce\xc3\x4e\xdf\x72\x2b\x34\xe3\xa9\xbb\x76\x09\xcf\x3e\xa8\x97\x6b\xa9\xb0\xdd\xda\x7d\x15\xb4\xdc\x79\xb5\xfe\xac\xe2\x13\xbb\x27\x0b\xc8\xb0\x95\x1d\xda\x74\x69\xa1\x81\x79\xad\x2f\x51\xb3\xd2\xe7\x5d\x72\xa7\x5f\x98\x30\x60\x4d\xc8\x44\x6b\x0f\x98\x17\xc9\x15\xba\x8e\xe9\x79\xeb\x08\x25\xe6\xf3\xa0\x02\xc8\x75\x70\xab\x4a\x71\x3b\xc9\xea\x9b\xbf\x95\xeb\x40\x10\x50\x33\xc0\x01\xdd\x30\xfd\x0c\x2f\x2b\x1f\xf2\x87\x39\xe6\xb9\xde\xea\x06\xf7\x7c\x25\x53\x38\xff\x9a\xdc\x64\x2c\xff\x72\xec\x51\xac\x78\xdc\xd3\xac\x35\x11\xfa\x04\xee\xba\x77\x7b\xb0\xed\x3f\x68\x52\x80\x8c\xf9\x9d\x7f\xd5\x1c\xb7\x01\x7e\x38\xbf\x93\x02\xef\x03\x1d\xd8\x10\x95\x78\xb1\xe7\xf4\xcb\x5a\x52\x81\xb8\x30\x8d\xba\xba\x3e\x89\x25\x03\x10\x63\xdc\x6a\xd1\xa0\xe3\xd2\x2e\x47\x15\x37\x48\xce\x7f\xe8\xd3\xc7\x2e\xc7\x79\xf6\x99\x3d\x8b\x25\xb4\xac\x86\xe8\x57\x8a\x1d\x7e\x75\x36\xd2\x51\xad\x1d\xa6\x18\x6f\x35\xf4\x3d\xae\xe9\x2b\x0b\xf0\x50\x28\x9e\x8e\xcd\x15\x5b\x8c\x7f\xec\x98\xd2\x8b\x92\x4a\x2f\x9a\x58\x76\x0d\x2b\xd5\xbf\x64\x8b\xf8\x0e\xff\x4d\x9f\xc5\xbd\x30\xfa\xb7\xbb\xf3\x80\x00\x1c\x39\xad\x5a\xee\x27\x8c\x8f\x6d\x99\xaa\x34\x74\x52\x96\x58\x7a\x89\x89\x32\x9b\xa8\x63\xe2\xb9\x3f\x71\xcb\xc6\xd9\x74\x0c\x16\xd7\xe3\xc2\xb2\xc3\xcc\x44\x49\xe9\xe6\x3a\xb8\x3c\x97\xbe\x8d\xfd\x81\xbb\xbd\x9c\x35\x1e\xb6\xbf\x6d\x3a\xb0\x13\x83\xf2\x86\x44\x89\xe7\x02\x08\x5e\xba\x7e\x8d\x6b\xc5\x1f\xee\xe9\xf2\x87\xe4\x3c\x32\xca\xf5\x56\x44\xc5\x05\xe3\x29\x43\x6f\x84\xab\x04\x73\x72\x89\xbf\xeb\x7e\x84\xfc\x62\x89\xcf\xe7\xa4\xb5\x73\x01\x54\xd7\x77\x4c\x25\xb1\x70\xb1\xab\x56\xeb\x98\xc2\xac\x13\x56\xc4\x6c\x65\xea\xd8\x90\x0e\xe8\x8c\x70\xfc\x8c\xb7\x6b\xe8\xd3\x78\xca\xa9\xc7\x00\xfd\x93\xd1\xd0\xa4\x5d\x09\xfa\x55\x89\xa4\xb2\xb4\x1b\x7a\xda\xbf\x75\x18\x13\x80\x04\xe8\xa0\xd2\xd7\x89\xd3\x9f\x04\x7f\xcf\x22\x4f\x6f\x2a\xea\x76\x08\x77\xe6\xd7\xf3\x92\x17\xb9\x44\x28\x41\xaa\xf8\x64\x5e\x0a\x25\xc1\xf1\x08\xad\xfd\xf6\x5e\x05\x71\x4a\x5b\x88\x89\x35\x7d\xa7\xd4\x61\x16\xe8\x37\x7f\x6d\xd7\xc4\xdd\xee\xbe\x82\xfe\xf2\xa8\x9b\xa4\x29\xcb\x98\xec\xd9\x2c\xe3\x27\x1e\x9c\xcd\xad\x47\xf9\x9c\xee\xa6\x9c\xbc\x27\x78\xa2\x3b\xaf\xb3\x98\x33\x6e\x31\xe8\x46\x9a\xfc\x34\x47\x66\x78\x75\xf4\x65\x56\x49\xbf\x2d\x24\x74\x37\x4a\xcc\x11\x30\x1a\xed\xab\x8d\xec\x51\x8e\x97\x80\x6f\x70\xbf\x6d\xb1\xab\x2b\x8c\x48\x5c\xa0\xc4\xff\x36\x05\x3c\x4a\x8e\xd9\x13\x45\x29\xe8\x90\x63\xa7\x32\xf5\x54\x90\x13\x20\x87\x9e\xe2\xdc\xf7\x6f\x42\xd0\xb2\x00\x6a\xe5\x7e\xe0\x77\xf4\xf0\x43\x4b\x2c\x45\xc9\xc4\x66\x75\x93\x08\xa2\x9e\x47\xf7\x46\x0e\x54\xd1\x82\xef\x77\x70\x2e\xac\x14\x5e\xbb\x1c\xd0\x98\x8b\x48\x5c\x28\xe0\x81\x7f\x81\xff\x7a\x56\xf3\x69\xc1\xa9\x3a\x4b\xd0\xda\x11\x5b\xc8\x6b\x72\xde\xe2\x5f\x2d\xe5\x7e\x0f\xac\x75\x56\x53\xea\xbd\xfa\x45\x0b\x58\x10\x61\x4d\x7a\xe9\x19\x5a\xe8\x80\xbf\xb3\x5f\xa3\x15\xfb\xd8\x21\x1e\xa2\x83\x3b\x4c\x4a\x9f\x6e\xf4\xc9\x50\xe8\xac\x71\x64\x65\xb4\x17\x3e\x27\x50\x1a\xcf\x2e\xd6\x2d\x82\xe5\x95\x6a\x4b\x0d\xd1\xb3\x58\x7f\xa4\xe7\x47\x8f\x76\x56\xfb\xf9\x84\x27\xbd\xff\xfc\x1e\x1a\x5a\xab\x35\x4a\x71\x95\xb9\xbc\xfa\x75\xe8\x62\x46\x2e\x3e\xe2\xb8\xf7\x6d\x00\xdf\x3f\x69\x67\x85\xc2\x58\x88\xe9\xf5\x09\xf3\x2d\x89\xed\x57\xe2\x32\x2d\x8d\x1f\x65\xf9\xaf\xf0\xa7\x75\xb0\x43\x4f\xfd\x4e\x47\x49\xee\xfd\xc2\x97\xa1\x43\x13\x9e\xe5\x86\xb9\x2e\x95\xa0\x1a\x82\x80\xbf\xc0\x4d\x74\x96\x32\xce\x25\x37\x24\x23\xf7\x8a\x18\xe0\x51\x68\x0d\x79\x82\x4a\xee\x15\xca\x1c\x2f\x3a\x22\x87\x26\x1f\xd9\x1e\x58\x63\x78\x15\x92\xff\x1b\xba\xff\x6a\x18\x0c\x81\x53\x9d\x2c\x5a\x61\x5e\x39\x84\x26\x1b\xfd\xd4\xcc\x64\x77\x9f\x1a\xc8\x60\xae\xf5\xb2\xae\x47\x98\xbd\x97\x6a\x71\x05\x5e\xb1\x67\xfa\x5a\x2a\x7e\x78\x2b\x33\xe4\xbb\xe0\xa6\xd6\x84\x2d\xe5\xcd\xbf\xf7\x9e\x35\x4b\xbb\x15\x8c\xe2\x81\xb0\x40\x49\x97\x48\x3d\x21\x40\x16\x32\x33\x2c\xa3\xee\xd0\xcb\x5d\x02\x95\x44\x84\x8c\x6c\xa0\x96\x41\xc5\x56\x28\x6e\x3f\xae\x6e\xda\x33\x7b\x83\xe9\xbc\xce\xde\x57\xcb\xc6\x44\x4a\x9b\x87\x96\x02\xd0\x0b\xa2\xf3\xc5\xf5\x75\xb1\xed\xc1\x98\xd0\xaa\x70\x00\x7c\xba\xe5\x1a\x2e\x02\x2b\x78\x12\xbc\xe1\x81\x3a\x7e\x8b\x0d\x34\x27\x78\xe9\x9f\xbc\x76\xc2\x88\xe6\x99\x7c\x27\xfe\x18\x2d\x4e\x68\xbc\x6c\xf2\x71\xe7\x35\xc6\x8b\x25\xf5\xfc\xc4\xe2\xd9\x6d\xaa\xd9\x10\xa0\x38\x1f\xe3\x5d\xff\x49\x77\xcd\xd2\x6e\x6c\x65\xcb\x8e\x49\x88\x3c\xb7\xfa\x6b\x8a\x02\x27\xc4\x16\x78\xb7\x30\x2d\xd0\xb3\xb2\x33\x9c\xa8\x03\xe7\x4d\x01\x46\x18\x16\xd0\x44\xf2\x24\xb8\xa6\x2b\x77\xfc\x06\x9a\xf1\x7c\x4a\x39\x6f\x6c\xa8\xe3\xbd\x62\x52\x9b\x13\xcf\xfd\xfc\x3f\x79\x36\x0e\x70\x0c\xf5\x37\x80\x0d\xb8\x53\x6c\xf6\x4c\x8d\xb1\xf9\x87\x4f\x03\x62\xac\xd6\x3c\x61\x48\xbc\xfd\x4a\x26\xb9\x92\x4e\xb2\x70\xa7\xc5\x4f\x2b\xc4\x3d\x83\xe9\x62\xee\x43\xd3\x12\x90\xb0\x02\x51\xd0\x2c\xbd\xd7\x8d\x68\x1e\x83\xd0\x1b\x14\xf7\xd5\x04\x12\x3f\x13\xac\x74\x45\xeb\xac\x8f\xb3\x7d\x05\x26\x08\x7b\xbe\x65\x3b\xbf\x5b\x3e\xaf\xd8\x69\x99\x3d\xd8\x5e\x5d\x25\x66\xe4\x82\xd6\xde\x55\x42\x57\x9f\xb8\xdb\x97\xcd\x68\x03\xfa\xae\xa8\xd4\x54\xf7\xd6\xe6\xdd\x1b\xc0\x19\x5f\xc2\x3f\x14\x39\xad\xc5\x40\x81\xba\x4a\x17\xb8\x0d\x5f\x44\xa1\xbb\xb6\x9c\xb8\x49\x9a\x39\x68\x09\x38\xf6\xdd\xf8\xe7\xd4\xc5\x0b\x50\xd7\x0c\x3e\x2a\xd3\x3a\x08\xca\x39\x7a\x82\x90\x06\x65\x7f\x0a\x2f\x1c\x9e\x5d\x76\x1e\x4d\xde\xff\x51\x2d\x1a\xf6\x1d\xfa\xa6\x6c\x50\x9d\xdd\x63\xe2\xec\xc5\x90\xb8\x97\x15\x22\x67\xe9\xca\xd4\xbf\xe3\x13\x2d\xe9\x76\x20\x83\xeb\xf8\x2a\x6a\xf8\x1b\x1a\xc0\x66\x61\x67\x71\x4b\x64\xaa\x24\x48\x66\xa2\x24\xe6\x5b\xb9\xb1\xd0\x4d\xc6\xe3\x8c\x65\xe7\xac\x53\x2e\xa6\x85\x10\x0f\x77\xf8\x73\x4f\x99\x55\xab\xce\xe4\x66\xd9\x86\x0c\x64\x3b\x56\x83\x7b\xc7\xf1\xf4\x04\x15\x6a\x7c\x66\xbd\xc2\x76\x67\xb9\x98\x6b\x61\x38\x38\x0c\x5c\x5e\x20\xf6\x8f\x0b\xa4\xc5\x02\x9e\x6b\x26\x1f\xa6\x3a\x77\x12\x30\xfd\xfe\xa2\xe3\x66\x1a\x05\x9e\x3d\x27\x3d\xe6\x5d\x97\x25\xc7\x94\xfe\x03\xe8\xa8\x5c\x15\x5e\xf4\xc9\x4e\x43\xcb\x18\xaf\x4c\x95\x84\xf2\xf3\xe0\xf9\x9b\x57\xd0\xe7\xf1\x56\xaa\x6f\x8c\x1a\x46\xc4\xa5\x02\xa9\xb1\x0a\xcf\x67\x3f\x65\xac\xa3\x62\x00\x15\x04\xbc\xac\xd1\x6d\xb4\x2b\x87\x3e\x8c\xc7\xba\xed\x71\xbf\x7c\xee\xb3\xa1\x75\xa0\xda\x53\xae\xfd\x2b\xff\x95\x11\xb8\xab\xa8\x6a\xa2\x2b\xb1\xd9\xd7\x97\x53\x1f\xf2\xe1\x2c\xc2\xbb\x58\x1f\x75\xea\xb9\xde\x16\x44\x33\x3c\x7d\xcc\xfc\xde\x36\x69\xe2\x11\x5d\xc6\x9e\x40\x30\x23\x57\xff\xc7\xa7\xd1\xb3\x13\x13\xbf\x96\xd0\x5f\x28\x45\xe8\x1a\x28\x45\xc6\xf6\x47\x90\x96\xcf\x13\x35\x8a\x30\xa9\x26\xf2\x68\xd6\x71\x6a\x26\x8a\x6a\x8a\xa6\xc3\xc1\xd6\xdb\x3b\xc8\x10\xf1\xcb\xb3\x8c\xcb\x20\x24\x5c\xf3\x6d\x9d\xf8\xd8\x17\xc9\x4b\xc4\xe5\x7a\x20\x14\x29\x3e\xf6\x20\x65\xad\x7c\xde\x8a\x6f\x23\xba\x39\x53\x67\x4e\x08\x8f\x30\x8d\x27\x4c\x39\x53\xff\x7e\xfd\x22\xff\x43\xc3\xa0\xf7\xf6\xe1\xcf\x9a\x89\x20\xc8\xf9\x93\xcf\xdd\x13\x6a\x38\xee\x19\xf3\x75\x97\x4a\xab\x41\xea\x80\x00\x08\x86\x06\x50\x50\x03\x4f\x04\xd8\xb1\x7d\xab\x23\x67\xcc\x8c\x9e\x54\x7b\xe7\x87\x90\x8c\xb2\x1c\x04\x6b\x98\x0e\x43\x87\xc4\x7f\xb1\xa9\x25\x15\x67\xa8\xc9\x65\x48\xbe\xbd\xd5\xa5\xa8\xc4\xe8\xd7\xf8\x14\x9a\xba\x6c\xcd\xae\x3a\x39\xc7\x82\x37\xa8\x76\x32\x26\xbd\x24\x9d\x3c\xe7\x35\x38\x89\x72\xae\x2a\x8c\xdf\x06\xd2\x0c\x0a\x25\xe2\xbe\x53\x99\x65\x11\xc9\xd0\xc6\x76\x20\x2b\x61\x18\xfb\x46\xe7\x4f\x95\x33\x5b\x55\x34\x3d\xd3\x84\x1a\x66\xf7\x7d\x7f\x3f\xc5\x97\x78\x77\x67\xe8\x95\xf7\x47\xb3\xe2\x65\x77\x5a\x7a\x1a\xba\xcb\x51\x43\xe2\xb1\x0d\xc6\xbd\x80\xc3\xb2\x2c\xc3\xe9\xfd\x7d\x9d\x5d\x73\x1a\xe5\x4b\x7f\x36\x20\x0d\xb0\x41\x81\xe0\xe2\x03\xdf\x38\xd9\xe2\x28\xaa\xcd\x0e\xc1\x47\xac\x87\xd8\xdd\x04\xbf\xdb\xa2\x05\x19\x6c\xe2\xc5\x48\xaa\xd5\x61\x7c\xa0\xb6\x70\xf4\xb7\xde\x1a\xdc\x0b\xdc\xbe\xd2\xb0\x03\xa3\xad\x77\x8f\x68\xd9\x10\xb1\x74\x99\x06\x3f\xa0\xb1\xbe\xa0\x7a\xf9\xc1\x8c\xad\xb4\xe2\xc8\x37\x55\xc7\x90\xcf\xea\xda\x44\x95\x9b\x05\x90\x67\xb2\x64\x2a\x40\xc5\xcb\x0a\xc1\x1a\x47\xa4\x30\x6c\x53\x8d\x4d\x75\x93\xbe\x44\xc7\x78\xfb\x60\xc3\xf3\xb4\x3b\x0b\xaa\xed\x63\xae\xcd\xe2\x35\x78\x8b\x37\x41\x76\x94\x1b\x67\x17\x54\x05\x59\xa8\xb9\xaf\xc8\x75\x8c\x17\x0d\x96\x86\x35\xe2\x01\x66\x56\x35\x2e\x7e\xaf\xcf\xf2\x24\xd2\x58\x20\xaa\x28\x7f\x3e\xa2\x94\x51\x8a\xbf\x87\x60\x27\xc3\x2e\xeb\xbc\x2a\xcc\xbe\xbb\xfb\xb2\xc7\xa1\xdd\x13\x5f\xa9\xaf\x22\xf7\x40\x21\x00\x3e\x8d\xca\x35\xeb\x53\x02\x3c\x30\xc3\x21\x4f\x42\x9d\x7a\xfc\xf9\xab\x3b\x5e\xdf\xe1\x4b\x15\x14\xa6\x21\x50\x86\x8c\x5f\x3f\x00\x71\x84\x5e\xab\x76\xda\x6b\xbf\xfd\xb8\x62\xef\x63\x8a\x19\x2f\x2d\xd7\x61\x3f\x37\x2a\xa6\x7a\xe2\xf7\xf1\xda\x80\x78\x94\xc7\x36\x55\xec\xc7\xef\x25\xee\x01\xff\x2d\x6c\x39\x63\x1e\xa5\xa8\xb7\xfa\x54\x1b\xbe\x1a\x7a\x94\x8f\xcf\x57\x48\x7d\xa0\x51\x2b\xf6\xd3\x11\xf9\x46\x48\x3d\x14\x08\x36\xc0\x06\x34\x7f\x04\x72\x30\x4a\x5e\xab\x98\x89\xc4\x80\x8d\x49\xda\xf6\x27\xd0\xd3\x2c\x48\x54\xd4\x19\x0f\xcd\x40\x4e\x96\xba\x23\xe1\x69\xbd\x2e\x89\x74\x2c\x1d\x11\x82\x15\x76\x9a\xf7\x43\xfb\x53\x28\x49\x73\x54\x76\x17\x4f\x94\x87\x9f\xe1\x14\xfb\x3f\x76\x24\xa0\x92\xc3\xe1\x88\x51\xd4\x5a\x28\xca\x0c\xf8\xa0\xf2\x53\xc5\x96\x80\x1c\xdd\x53\xd3\x9d\x86\x9e\x46\x3a\xe7\xfe\xa0\xb0\x23\xf4\xde\x1d\x95\x8b\x76\xfb\xdd\xe1\x65\xbe\x17\x3b\xb6\x74\x5d\x78\x87\xf0\x23\xc0\x4f\x72\xf3\x7c\xb2\x0a\xc5\x58\xd3\xa0\x42\xe8\x97\xfa\xd2\x21\x65\xf1\xce\x0a\x97\xcd\x2d\xab\xdc\x86\xe9\xf6\xc2\x75\x7e\xbb\x58\x9d\xc8\xca\x35\xfd\x90\xc6\x3f\x6c\xe3\x67\x06\xa6\x21\x81\x78\x64\x3f\xa8\xd9\x7e\xce\x7f\x72\x4d\x71\x79\x0b\x00\xe0\x18\xeb\x64\x0e\xdd\xc7\xfd\x20\x5a\x14\x6a\xdb\x14\xc1\x0f\x5f\xfc\x1f\xf6\xdf\x61\x82\x30\x68\x88\xae\x57\x62\x04\xf9\x31\xc3\xfa\x9d\x86\x46\xc4\x94\x25\xab\xbf\x53\x9e\xb2\xd9\x0d\x4b\xbe\xb8\x41\x41\x81\xeb\x61\xf8\x51\x3f\xbe\xcf\x2c\x43\x99\x5d\x3b\x79\x0a\xd3\xd2\xe3\x69\xba\xe3\x3a\xc5\x5a\x75\x66\xf6\xdf\x00\x71\xae\x9f\xab\x88\x69\x32\x66\x9b\x3c\xb4\xf9\xfc\x62\x7d\x59\x70\x09\x56\xbd\xcc\x04\x80\x58\x3f\x39\x63\xfb\xfa\x9d\xdb\x43\x89\x79\x3b\x3d\x7f\x76\xdd\x89\x4a\x34\xa2\x52\xba\xc7\xaa\x4c\x11\x57\xf0\x43\x6f\x83\xe0\x08\xff\x10\xd2\x36\x91\x28\x0b\xc9\x21\xef\x79\x55\x29\x58\x37\x77\xca\xd3\xaa\xfe\x21\xed\xa2\xfa\xb4\xcf\x62\x44\xec\x5f\x4c\x1a\xaa\x0a\x39\xa9\xa6\x5d\x32\x54\xb9\xa5\x4f\xcb\x76\xbe\x14\xd5\x37\x7f\x46\x77\xf5\x6b\xde\xa2\xdf\x39\xb5\x5b\xbd\xd6\x30\x99\xdb\xb5\x8b\x73\x14\xe5\xc7\xd4\xe8\xfd\x5c\xf9\xa0\x6c\x37\xdc\x47\x79\x2a\x73\x75\x3a\x22\xd3\xf1\x3e\xc8\x26\x68\x7c\x1a\xb7\x42\x5e\xa1\x28\xe0\x0f\xed\x05\xd1\xad\x70\xdc\x2b\x27\x1f\xf2\xd0\xb4\x63\x55\x01\x73\x48\x4e\x45\xb0\x8c\x5b\x6e\x15\x0f\x87\xae\x86\xfe\xb9\x7d\x54\x5c\x18\xf5\x7b\x1e\x3f\x6b\x17\xc3\xb5\xfa\xb6\xf2\xec\x82\x4a\x19\x24\xb0\x68\x43\xe7\x77\x34\x4b\x70\x7c\x5d\x2f\x78\x86\x97\xdb\x2d\xed\x55\x99\xf6\x83\x8f\xbf\x8a\x09\x24\x2c\x9d\x94\x27\xaa\xf1\x38\x1c\x5a\x99\x6f\xc9\x06\xe3\x6e\x75\x36\xff\x0c\xb0\x61\xdb\x7c\x06\xac\xa8\xb0\x6d\x3e\xf8\xc2\x75\x73\x0f\xb2\xed\x26\xf9\xbd\xd1\x5a\x32\x6f\x5b\x4d\xe5\xd9\xd4\xde\x8c\x24\xb2\xf8\xa0\x10\x47\xcb\x0b\x4e\x82\xf2\xd9\x5c\xaa\xfb\x96\x20\xd0\x8c\xde\x75\xae\x7c\xd5\xb8\xc7\x87\xfe\x78\xf1\xbf\x48\xb7\xe1\x70\xd1\xee\xe6\x20\x2e\x8e\x07\xec\x8b\xcf\x77\xe4\x3f\x6a\xc3\xd6\x1a\x9c\xa2\xab\x9e\xe1\x5c\x99\xc2\x8c\x49\x4d\x92\x59\x2c\x3b\x53\xf6\x93\x23\x60\xdb\xc2\xd9\xe4\x8c\x85\x1f\x81\x32\xc7\x84\x4a\xc3\x05\x10\xa2\x7b\x3b\x1f\x75\xde\xc0\x28\x36\x49\x17\xa2\x1d\xb5\x40\x73\x85\xc7\xdc\xb2\x18\x49\xb7\x81\x5f\x5c\xb1\x12\x85\x53\x5f\x7a\x87\x22\x89\x59\xe2\xbe\x10\x18\x20\xad\xc5\xcd\x0e\xf5\xf6\x51\xf5\x99\x70\xd3\xb0\x3f\x7f\x12\xfb\x09\xba\xd4\x62\x7e\xad\xf5\x83\xfb\x6d\xca\x3c\x88\x8b\x74\x18\x5f\x45\x8e\xc4\x12\x35\x09\x58\x9d\xc1\x06\x0a\xbc\xc4\xbb\xf3\x75\x1a\xf5\x86\x74\x70\x5d\x5f\x7a\x18\x2f\xec\xb3\x8c\xa7\xca\x59\xa3\x04\x65\xe6\x68\xeb\xc9\x82\x3a\x31\xe4\xdc\x25\x97\x1c\x17\xc6\x44\xc9\xcd\xc4\x27\x79\xfc\xdd\x9c\xab\x16\xc9\x20\x39\x75\x69\x6e\xbf\x23\x04\xb7\x40\x30\x55\xc9\xc2\x0c\xf3\xca\xbb\xb7\xfb\x30\x11\xd1\x6a\xa4\x35\xb5\xdd\xd1\xd4\x77\x60\x73\xe3\xfa\x71\xbe\x4d\x08\x8a\x8e\xcb\x76\x19\xae\x9d\xd2\x17\xaa\x0b\x4f\x45\xc8\x22\xd5\xc9\xdb\xdd\xea\x4b\xd9\xe7\xb7\x09\x6a\x29\xa0\xb2\xf3\xf9\x1c\x4d\xaf\xcc\xd7\x74\x9b\x9e\xcb\x63\xd7\x2f\x68\x9f\x37\xfe\x6a\xc9\xbc\xe1\x64\x58\x50\xac\x7c\x5e\x3a\xff\x11\x62\x9f\x4a\x0a\xbb\x21\x8a\xda\x6f\xa0\x1d\x42\x59\x5d\x94\x59\xaa\xe8\xb2\xcb\xcb\x3e\x30\xd2\x2c\x0d\xde\x74\xef\x1a\x98\x4a\x3a\x98\xba\xd5\x88\xe3\xb2\xf9\x75\xc5\x99\x95\x98\x11\x6e\xcb\xe2\x01\xed\xf1\xd6\xe2\x6c\x63\xff\x8b\xff\x24\x40\xfb\x3b\x60\x3c\x73\x83\x20\xf7\x5d\x0b\x9f\x80\x69\xa4\x17\xdb\x83\xa6\x46\x46\x51\x5b\x66\xbd\x9e\xda\xae\x99\x06\x28\xd2\x3a\x36\x75\xa3\x89\x39\x9c\xe6\x39\x7c\x7e\x35\xa3\xd2\xf5\x85\x77\xc1\x2f\xc7\x2b\x7e\x73\x09\x5c\xb3\x0e\x2a\xa7\x55\xc7\x68\xc1\x10\x3c\xa2\x37\xf2\xc6\x54\x41\xe3\x94\xa7\x31\x07\xcb\xe3\x6f\x06\x3d\x54\xf5\xea\xe6\x7e\xa2\xdf\xa8\xde\x37\xed\xbd\x93\x5b\xb4\x8d\xf7\xc3\xf7\xfb\x2c\x38\xc2\xed\x08\x2a\xa9\x99\x0f\xfa\x5a\xd6\x0b\xea\x7a\xcf\x0a\xdb\x63\x23\x2f\xcd\x46\xad\xae\xc0\x78\x19\x07\x9c\x88\xd6\x91\x2d\x3d\x07\xac\x49\xe2\x9f\xa8\x5b\x2e\x4b\xe1\x4e\x97\xd1\x60\x25\x4f\x11\x44\xe5\xe1\x2a\x95\xca\x86\x74\x46\xbf\x31\x22\x9e\xe5\x19\xa7\x07\xa9\x37\xc5\xb5\x7e\x7b\xe7\xeb\x22\x7e\x47\x89\xfa\x96\x6a\x31\xf3\x34\x33\xa2\x6d\x96\xbc\xc4\x72\x92\xb4\xa5\xcf\xae\xdd\x96\x59\xcf\x45\x9c\x8e\xcd\xec\x5d\x52\x9f\x76\x41\x3d\x25\x49\x62\x39\x1b\x5d\x9b\xd9\x1d\x97\x9c\x8c\xe8\x23\x55\xc3\xbc\xf8\x47\x12\x29\x10\x6b\x94\x60\xec\xab\x9e\xd0\xec\x2d\x65\x90\x2c\xf7\x26\x38\x4e\x70\x8b\x30\x46\xd0\x9d\xc9\xe6\x08\x5f\x45\x6d\x23\x41\x72\xa8\xe9\xe0\xb8\x3e\xd3\xb6\x17\xc9\x36\x79\x15\x05\xa4\xbe\x50\x10\xbf\x4b\x10\xdc\x2a\x02\xc4\xb8\xaa\x2c\x09\x31\x4d\x9c\xa2\xcd\xb1\xd7\x6e\x4d\x87\x93\x17\xca\x46\x6c\x70\x9b\x22\xa4\xec\xa2\x65\x95\x50\xd4\xef\x80\x77\xb8\xf5\xf2\x36\x4f\x51\x0d\xc8\x44\xb6\x4b\x3d\xd9\xe3\x88\xc8\x54\xb4\x90\x22\xbd\xed\x33\x94\xa7\x4c\x76\xc3\xca\xbb\xe9\xbe\x94\x76\x88\xe1\x16\x3a\x48\x7f\x11\x28\x7f\x51\x68\x7e\xdb\x1e\xfd\x14\xe1\x20\x67\x43\xb6\xfb\x25\xd3\x56\xd7\x92\x8d\x76\x00\x50\x29\x03\xb9\xc1\x87\x8b\x2a\xbb\xb5\xe9\x9b\x04\x21\xac\x44\x21\xb0\xfd\x21\xb7\xcf\x83\x12\x0a\x6d\xd4\x64\x3e\x6d\xdd\xe0\x16\x4e\x01\x7a\x62\xe0\x6f\xd1\x0a\x47\x83\xc4\xb3\xad\xda\x01\xb0\xe7\x31\xfb\xb7\x74\x4b\xb1\xf5\xb9\xe6\xb9\xdc\xad\x49\x5b\xbc\xaa\x5c\x76\x33\x41\xb2\x04\x44\xfe\x24\x3f\x93\xba\x39\x1c\x5b\x9e\x33\x6a\xe7\xa8\xea\xc9\xdd\x5d\x50\xb5\x6e\xc1\xa3\x36\xec\x0a\x54\x65\x23\xe6\x8e\xe8\x96\x69\xd2\xd3\x0d\x47\x65\x29\xed\x64\x7a\x9a\xf9\xb8\x60\xbd\xdc\x58\x0c\x53\xea\x83\x09\x00\x41\x80\x8d\xf2\x4a\xdf\x55\xe5\xd7\x32\xad\x17\x42\xa9\xc6\x16\xb7\x5c\x76\xc3\x63\x36\x4b\xed\x74\xbb\x96\xb2\x36\x48\xa9\x9c\x59\xcc\x79\x14\x89\xbd\x15\x7b\x15\x9e\x74\xad\x55\x87\xa6\x51\xc3\x33\x81\x55\xc1\xb7\x41\x53\x09\x46\x6f\x13\xab\x16\x8b\x7d\x6d\x70\x19\x9f\xe4\x43\x75\x69\x78\x3d\x24\x97\x70\xb1\x4e\xe5\xd6\xe5\x0d\x96\xec\x71\x31\x4a\x32\xc8\x2c\xa4\x26\x88\x8b\xf2\x51\x3a\x24\x0c\x61\x4f\x2a\xb3\xec\xdf\xf9\xb5\xf8\x22\xd8\x0f\x17\xc4\xdf\xb5\x9a\x3c\xfa\x19\x75\xb2\xf4\x87\x6a\x8b\x2a\xf4\xef\xc9\x3c\x16\x3f\x0a\xef\x19\x02\xdf\xa3\x46\xfd\x05\x5f\x53\x6c\x6c\x46\xf3\xab\x0e\x92\xae\x6b\xea\x7d\xe7\x01\x36\x48\xe7\xe1\x88\xdb\x4c\x95\x32\x84\x3c\x65\x6c\xbc\x84\x09\x6c\x55\xaa\x74\x95\x82\xb9\x06\x3b\x14\x4b\xf0\x6c\xa6\x37\x4d\xac\x36\xe2\xea\x91\x98\xb5\x9c\x82\xa9\x59\xec\x9e\x19\xb1\x52\x2a\xda\xa0\x41\x15\xd7\x62\xc4\x62\x6b\xf4\xb2\x8c\x27\xe7\xe5\xa0\x6e\xc8\x85\xa7\xd6\xde\x10\xa7\x0c\x45\x35\x58\x32\x74\x2a\x86\x1b\xbf\xec\x73\xca\x00\x9f\x10\x46\xa5\xb9\x8a\x8b\x18\x88\xdc\xc5\x35\xbd\x57\xb2\xd0\x77\xdf\x08\x38\x22\xf5\x21\x4c\x08\x2a\xb4\xf6\xa0\xfd\xa0\xe0\x53\x92\x4b\x1e\x74\x46\xda\xd6\xbd\x6c\x9a\x10\xa7\x80\x7d\xb8\xea\x4b\x4c\xce\xf3\xe2\x85\xbc\x55\xb0\x44\xc1\xb3\x0c\x61\xd3\xc3\xaa\x34\xa5\xb3\x8c\xa2\x3f\x2a\x2c\xa1\x5d\x90\xa4\xa7\x66\x55\x8b\xe7\x95\x2a\xb8\x15\x3c\x5d\xe4\x62\x13\x26\x73\x4c\xe2\x7d\xa8\xac\xe0\x40\xb5\x1d\x42\x65\x27\x63\x1b\x7d\xfd\x74\xb2\xa6\x8f\x32\xe4\x6e\x34\x48\xc6\x49\x50\x74\x28\xc5\x09\xe9\x50\x33\xeb\x59\x81\x2a\xb0\x31\x7e\x9c\x23\xb8\xd5\x35\xfa\xf0\x04\x39\x0a\x47\xfb\x5c\x29\xbd\xad\x8f\x78\x92\x9f\xe5\xd6\x4c\xcc\x2b\x57\x61\xb9\x00\xc1\xcc\x1a\x9a\x94\x41\x86\x58\xea\x65\xe3\x1e\x8b\xc4\x1f\x37\xc4\x3b\x2f\x04\x04\x66\x05\x3f\xd0\xe5\x97\xc8\x7e\x64\xf9\x65\xec\x91\x51\x32\x65\x14\x84\xdc\x36\xed\xbd\x29\x85\xa5\x9c\x92\x6f\x87\xd0\x1d\xb3\x0f\xce\xa3\xfa\x71\xbb\x1e\xab\xae\xce\xbe\xdb\x7f\x28\xe4\x33\xdd\x66\xb5\x2c\xc2\xab\x36\x9b\xe5\xb3\x14\x1b\xb9\x6d\x1a\xdc\x7f\x50\x52\x8f\x3e\xd9\x53\xd7\x33\x7b\x3d\x90\x5e\xde\xd1\x0f\xa9\x10\x2a\xae\x60\x3a\x27\xba\x2c\x90\x87\x2a\x61\x9e\x13\xc7\x40\x1d\x45\x63\x62\xde\xfe\x9e\xbb\x6e\xf9\x9c\x98\xda\x1b\x66\xc9\x71\x97\x2b\xb8\x95\x6e\xf3\xa3\xe3\x8d\xa6\x5e\xf3\x26\xe5\xe0\xc3\x17\xc1\x2d\xfc\x83\x26\xe7\xd0\xbe\x31\x1a\xbb\x60\xe2\xf4\x86\xa6\x6a\xde\xa5\xd4\x16\x31\x16\xd5\x53\xa8\x6f\x05\xf5\x55\x2a\x80\x45\x67\x97\x5c\x53\x66\x97\xf2\xe5\x01\x5f\x8b\x64\x88\xd9\x46\x61\xd5\x0b\xe0\x5c\xd5\xba\x77\xb4\x5a\xb2\xab\x47\xb4\x74\x1c\x6f\x25\x46\x3d\x25\xaf\xdf\x66\x91\xdd\x7d\xba\x72\x9b\x21\x55\xca\x71\x39\xdd\x8e\x83\x2a\x24\x0f\x7e\x20\x07\x0f\xfb\x32\x17\xdc\xbd\x00\x56\x90\xc4\xfc\xbf\x18\x11\xdd\x14\xad\x1c\xfa\xa3\x54\x11\x35\x55\x6f\x96\x5e\xff\x76\x0c\x25\xd5\x2e\x75\xb8\xf4\xc3\x14\x0f\x2a\x77\x29\x3f\xdc\xe1\xf8\xbc\x10\x9f\x17\x29\x35\x42\xb8\xce\x8b\x98\x48\x95\x66\xd9\xcf\xd1\x67\x5c\x68\x97\xb3\x36\xfa\x85\x63\x6c\xb2\x3a\xc7\x5f\x02\x49\xc4\x79\x5a\x02\xa1\xd0\x41\x3f\xd0\x32\x8a\x83\xe5\xbf\xd4\xb0\x10\x35\x39\x84\xad\x48\x4b\x89\xde\xc8\x86\x46\xf2\x16\x24\x91\xf3\x88\x6b\x4c\x6a\xd2\x21\xbd\x2e\x1a\xe6\x3d\xc2\xbd\xb5\xfb\xc0\x52\x98\x6c\x9c\x0e\x9d\x38\xe9\x9d\x6d\xcc\xc3\xa6\x4b\x82\x66\xc7\xa7\xee\x7e\xc7\xc1\xa1\x3a\x74\x4c\x37\x3c\x93\x9c\xb8\xd3\xc1\xd3\x94\xa7\xf0\x2c\x25\x41\xb6\x9a\x36\x9a\xe3\xab\xb7\x72\x46\x5b\xfe\x8b\x38\x49\x74\x6c\xa2\xab\x70\x84\x67\xb6\x19\x10\xc7\x20\xd4\xdb\x8e\x1b\x01\x21\x82\xfd\xda\xf5\x1f\xe6\x75\xeb\xc0\x11\x78\x30\xbc\x37\x26\x1e\xdb\x43\x23\x9e\x48\x57\xbf\x31\xf2\xc9\x44\x5f\x75\xf6\x8d\xc5\x36\x68\x21\xd9\x10\x04\xa5\x61\x27\xf0\x97\x1c\xbe\x25\x3c\x63\xb3\xd9\x45\x97\x20\xfa\x66\x9e\x80\x26\x63\x95\xc7\x0f\x3f\xad\x12\xeb\x5d\x46\xcd\x19\x79\x2c\xfa\xee\xe2\xee\xc6\xa5\x28\x6e\xe8\x24\xf6\xb3\x54\xc6\xee\x43\x50\x92\x3f\xdd\x9d\x79\xa3\x3f\xec\xd4\x53\x4f\x1c\xe1\x2f\xf1\x8f\x98\x5b\x90\x02\xf1\x48\xc7\x04\xbe\xe5\xee\x07\x4a\xa7\x95\x51\xb8\xdf\xfc\x79\x6d\x9c\x7d\xae\xd2\xa9\x82\x00\x8b\x6c\xcb\xf0\x78\x43\x85\x10\x99\x46\xf5\xa7\x6b\xa3\x99\x2e\xde\xb9\x27\xdb\xc8\x98\x0d\xf6\x81\x95\xba\xa7\xe1\xbf\x45\x19\x7b\xaa\x3a\x09\x7f\x7c\x05\x1a\xac\x7b\xbc\x57\x7a\x8f\xcd\xfb\x3c\x2f\x25\x3a\x79\x67\x15\xaf\x48\x8a\x45\x97\xce\xc3\x4b\xf6\xe3\xbf\x53\x6d\x30\xab\xd0\xde\xfd\xb6\x68\x0d\xdb\x18\x58\xa4\xb8\x0f\x27\x30\x3a\xd3\x24\x20\xf2\x18\xd1\xc0\x61\x89\xed\x43\xf1\x21\x48\x18\xd5\x04\x95\x8b\x1a\x43\xb2\xf5\xe7\xc5\x82\x1f\x01\x67\x2c\x90\x19\x4c\xde\x6f\x8d\x7b\xd0\xc8\xf0\x82\x92\xc7\x0d\x3d\xa5\xa1\xd6\x79\xef\x41\xce\x20\xa2\x08\x34\x00\x7e\x3d\x0d\x96\x19\xac\x93\xcf\xba\xfc\x45\xdf\x43\x0e\x1a\xd2\x41\x86\xfc\x68\xeb\x34\x9e\x84\xf6\xed\x0c\xcd\xd0\xe2\x26\x57\x9c\x38\x54\x53\xfc\x36\x48\xfd\xf6\xfe\x5f\xaa\xc7\x0d\x00\xf0\xc8\x9f\x4c\xd3\x86\x86\x2c\xe6\x39\x2d\x5a\x1c\x91\xc4\x3e\xcf\x08\x14\x87\x1f\x0f\x0f\x3c\xc0\xe3\xf2\xcb\x41\x75\x16\x63\x8f\x95\x31\x0e\x8d\xce\xfd\x30\x54\xe3\x8f\x5c\xd4\x07\x02\x6c\x4c\xd0\xdf\x9a\xba\x17\xbe\x5e\x29\x57\xb0\x37\x21\x3a\x37\xee\x4d\x93\xdd\x6c\x6a\x0f\x87\xbb\xfc\x5e\x71\x6e\x4a\x9e\x7e\x10\x7f\x58\x21\xdb\xc1\x0d\xb1\x10\xd3\xe1\x0c\x51\x1d\xa5\xb1\xea\xb0\x5d\xdb\xc6\xba\x68\x7f\x4f\xf9\x9e\x49\x92\x5f\x9c\x61\x5e\xe6\x0e\x64\x10\x65\xef\x89\x30\x18\x7d\x9e\xaf\x5b\xb9\xd7\x4a\xc8\x28\x48\x03\xe4\x19\x96\xf6\x78\x4a\x7d\xb6\xcb\x1a\x75\xbe\x8d\xf0\x6f\xe4\x6b\x79\xf1\xea\xcc\x69\xca\x02\xf4\x69\x2e\xe5\x4e\xcb\xbf\x66\xbb\x9e\x40\xe0\xb6\x7a\x6f\x6d\xb9\xea\x6d\x31\x7f\xc4\xc7\x81\x2b\x12\xd6\x45\x6a\xd0\xaa\x50\xfd\x43\x71\xea\x58\xe0\xb8\x62\xac\x93\x3c\x5d\x9b\x6e\xe2\x7f\x59\x75\x0d\x94\xe2\x81\x4f\xc8\x20\x11\x36\x6e\x41\xc0\x65\xb9\xcd\xc1\x76\x0c\x21\x34\xfa\x32\x59\x4f\xed\x34\xcb\xaf\xf3\x49\x1c\x53\x6f\xda\x02\x8e\xc1\xfb\x45\x82\x03\xf2\xb4\x7d\xb6\xe0\xaa\x8d\xbb\xcc\xde\xa3\xbd\xc7\x7a\x0d\x55\x39\x5c\xb7\xda\x10\x6d\x7e\x3c\x7d\xc1\x1d\xab\x7d\x80\x5e\xd6\xca\x12\x57\x39\x0e\xb3\x5a\xba\x4e\xd4\xf6\xfa\xcf\xa1\x21\x79\xf2\xd1\x28\xc5\x01\xdb\xa4\x52\x6b\x03\xba\xd3\xba\xcf\x46\xe6\x33\xa9\x4b\x6b\x3c\x9b\xd6\x6c\xb0\x9d\x1e\x0c\x53\x57\xa8\x33\x19\x3c\xc4\x22\xc9\xff\xdd\xe1\xd7\x5c\xbb\xc4\x5e\x95\x3a\x0d\xf4\x16\x7d\x79\x14\xe8\xde\xff\x2c\xa5\xd6\xf7\x53\xd2\x7e\xec\x15\x3a\xe3\x66\x98\x7e\x21\xfa\x85\xf1\x24\xf3\x39\xc7\x64\xdf\x38\x7d\x7f\x2e\x6d\xdb\x5e\xc8\xdb\x9b\x67\x73\x25\x95\x77\x67\xdf\x62\x7a\xae\x82\x80\x63\x9d\x63\xb7\xf3\x08\xf4\xbb\x90\xb8\x94\x42\xd7\x29\xd3\x8b\x60\xc3\x47\xc8\x4f\xbd\x3d\x32\x90\x57\x64\x22\x9e\x61\x7a\xf5\x91\x7f\x51\x52\x03\xf0\xa8\x01\xe4\xe2\x06\x62\x25\x87\xab\xb4\xa4\x60\x96\xaf\xed\x36\x96\xc8\x58\xd5\xa6\x85\x7e\xf1\x39\xd0\x9b\x70\x48\x61\x14\xc5\xfc\x36\x16\x5f\x75\x13\xca\xc6\x17\x18\xd2\x84\x54\x98\x12\x3e\x1d\x7c\xce\xcf\x2c\x0a\x8f\x11\xd0\x33\x3f\x5e\x61\xb2\x94\x64\x74\x30\x46\x71\x56\x38\x87\x87\xd2\x65\x52\xb6\xa8\x9f\x04\x34\x40\x0e\x82\x54\x0a\x17\x3f\x77\x96\xfe\x5a\xcf\x48\x0d\x73\xc4\x21\x12\xff\x11\x4b\x5f\x93\xe6\xad\xd7\x80\x88\x9a\xea\x7a\xd4\x64\xc1\x3f\x46\xee\x0c\xd7\x7d\x67\x90\x3a\x82\x79\xb1\x68\x8c\xea\xf0\x90\x1c\x8c\x05\x1a\xda\x1c\xa6\x78\xaf\xc2\x8f\xee\x51\xdf\x79\xb5\xde\x5a\x8d\x01\x4c\xac\x54\xd9\x74\xb1\xcb\x21\xe7\x74\x09\xb8\x99\xe7\x4d\x6e\x82\xfc\x0a\xfa\x17\xdf\x98\x99\xef\xb6\xa1\x8c\x29\x77\x7a\x5f\xa0\xd1\x6f\x7c\x69\x05\x7c\xf0\x47\xa5\x2e\x6b\xab\x4d\x86\x84\xb0\xa1\xd6\x83\xdc\xa8\x1a\x1d\x98\x39\x32\x55\xdd\xde\x7e\x1f\x6b\xf7\xb0\x70\x0f\xae\x5a\x7d\xfe\xec\x9a\x22\x01\x44\xc3\x09\xbb\x40\x2a\x98\xab\xa0\x8c\x63\x21\xf9\x0a\xc4\xb6\x39\xeb\xad\xdd\xa4\x1f\x45\xb6\x75\x74\xf6\x3b\x75\xfa\xd4\x5a\x83\x25\xa7\xf0\xd2\x03\x3d\x16\xcd\x7c\x5e\xe6\x50\xb9\x3a\xfc\x36\xa8\xdd\x6e\xc5\x29\xfa\xda\xb4\xfd\xa9\x75\xa4\x65\xb2\xdc\x24\x9e\x86\xfe\x52\x50\xc3\xd5\xf0\xc9\x97\x50\x71\xf3\xf3\x45\xc2\x47\x27\x76\xbe\xa9\x56\x95\xa5\x48\x28\x30\x16\xef\xfd\x8e\x5c\x2b\xdb\x53\x4e\xb5\x6a\x58\x27\xa7\x8f\x7a\x2e\x04\x42\xc7\x4b\x4d\x1b\x57\xb2\xd6\x18\x6e\x1b\x24\x91\xa3\xe2\x2f\x03\xc2\x92\xa2\x8a\xb7\xb9\x61\xfa\x26\x3a\xe5\xa8\x48\x8b\xdc\xe1\x3f\xc9\xda\x6c\x69\x09\x7a\x3c\x73\xc6\x72\x3d\xcd\xda\x80\x24\x95\x33\x7a\x61\x61\x41\xdc\x4f\x29\x57\xbb\x25\x1b\xc1\x2d\x1f\x8e\x09\xdc\x72\x1f\xc8\x2b\xfb\x29\xa7\x3f\x0d\x9f\x4f\x4b\x70\x17\xea\xfd\x9d\x25\xb8\x25\x86\x05\xad\xfb\xd4\xa3\x88\xf5\x66\xf7\x2e\xbd\x07\x7c\x9d\x9a\xc0\xd7\x24\x5f\xd5\xb1\xad\x26\xb5\xa4\xac\xbb\x72\x99\x6c\x7d\x17\x42\xac\xd9\xb6\xd3\xa2\x8f\x0c\xeb\x20\xf9\x27\x2f\x7b\xd0\x81\xb1\x75\x7c\xda\xf4\x72\x5b\x40\x40\x37\xf7\xba\xd0\x47\xe5\x99\xd5\xa7\xd2\x5d\x19\xca\x9f\xb7\x19\x94\xae\xa2\xb4\x03\x4d\x4c\x4c\xfe\xf4\xc8\x4d\x21\x3f\x9f\x51\xbf\xac\x8c\x23\xe5\x0d\xfa\xc4\xb9\xdc\xbb\x40\x6e\x1b\x2f\xba\xf5\x1c\xe7\x60\xfd\xac\x8b\x1b\x0a\xf1\x07\x3b\xab\xce\xd9\xa5\xcb\xf2\xca\xfd\x14\x41\xf0\xdc\xb6\xc0\x6f\x4c\xca\xc4\x9a\x5a\x73\x20\x17\x0c\xb8\xd0\x11\xb3\xb0\x6b\x87\x46\x85\x3c\xe9\x3d\x78\x16\xb0\x8d\xca\x93\x30\x18\xc0\xfa\xbd\xc8\xae\x3e\xba\x03\x5b\x55\xb1\xe2\x0c\xa9\x97\x9a\xc3\x88\x1a\x48\x2b\x7f\x4e\x87\x6a\x84\x4f\x8e\xc2\x0d\x95\x69\xd3\x2b\x89\xeb\x90\xd4\x79\x52\x54\x4a\x58\x31\x7d\x43\x18\x2f\x17\xf2\x31\x3c\x26\xa8\xaf\x33\xca\x53\x00\x76\xd7\x6a\x95\x2f\x45\x73\x56\x14\xe4\xd6\x49\x20\x37\x34\x73\x34\x34\x7e\xcf\x79\x40\xa3\x08\x48\xd5\xef\x2c\xf4\x3c\x94\xd6\x24\xed\x70\xe5\x9f\xe9\x54\x1d\x45\x94\x46\xda\x63\x28\x7a\x6a\x8a\xda\x27\x09\xb5\xf0\xd9\xcd\x7c\x0d\xe7\x57\xe7\x2c\xb8\xc1\x66\x8c\x67\xfa\xd5\xcc\x50\x14\xb6\xd2\xbd\x73\x37\x73\x10\xe0\x41\x42\xfc\x9d\x2d\xab\x5e\x1f\x10\x13\xb4\x54\x85\xb0\xec\x9e\x86\xf4\xf5\xa3\xbf\xbb\x24\x72\x2e\x18\x5d\x4e\xf9\x6e\xd3\xd4\xd5\xaf\x68\xbf\x33\x2f\x4e\x6e\x5a\xbb\x82\x63\xb9\x74\xd8\x9c\xf9\x7c\xf3\xf2\xe5\xb3\x20\x5c\x60\x4f\xeb\x68\x3f\xbe\xd7\x8f\xf1\xa7\xb0\xe8\x87\x3e\x10\x45\x4d\x00\xce\x2b\x68\xc2\xb5\x9c\x8f\x5e\x88\x73\x95\x19\x03\xcb\x06\xe0\xd3\x03\x2a\x57\x47\x89\xe5\x65\x67\xb3\xa5\xc2\x67\xd2\xf5\xde\x17\xca\x46\x96\xae\xa9\x3a\x14\x8b\x29\x9e\x71\xbc\x85\xd4\x56\x5d\x94\x84\x73\xdd\x8a\xf0\xc8\x34\xe6\x12\x04\x63\xd0\x0c\x44\x59\x30\x4f\xde\x1e\x2c\x4e\x9b\xfa\x5c\x81\x63\x04\x3b\x21\x9a\x65\xd3\x55\xa7\x9a\xa5\x0f\x75\xb1\xd5\x39\xe5\xb4\x50\x06\x5f\xbf\xbe\x44\x7c\x9b\x5a\x90\x86\x28\x24\xaa\x07\x23\x7e\xc0\x89\xdc\xe7\x19\x47\xbd\x42\x3f\x56\xe0\xc7\x99\x5d\xe6\x01\x82\xe3\xc5\x3a\xd4\x37\xdf\x15\xc3\x9e\xc4\x39\xd5\x1f\x36\x3b\xb7\xb3\xd3\x54\x17\x48\xf6\x85\x94\x34\x39\xa0\x48\x4a\xec\xb4\x53\xae\x3f\x89\x1d\x2a\xd4\xd4\x50\x19\x65\xe0\x53\x89\x5c\xe0\x3d\xff\x1e\x2f\x1b\xe1\xeb\x70\x7c\x57\x48\x48\xca\xe0\x4a\x73\xa1\xa5\x57\x70\x33\x0d\xf1\x8c\xbd\xbd\x9b\xcc\xa3\x4b\x39\xe9\xf7\xb0\xa2\x72\xec\x44\xcc\x5f\x65\x7e\x17\x3d\x21\x98\x1e\xff\xe6\xa2\x44\x45\x9c\x75\xf9\xc7\x29\xb4\x6e\x98\x8d\xa0\x33\x3f\x0d\x5b\xd6\xa6\x81\xef\xdf\x7a\x0e\x3b\xa1\xbf\x3e\xd3\x3a\xd6\xb0\xbd\x8e\x50\xb9\x7b\x8b\x66\xc3\x0a\xd9\xdb\x9e\xf8\xd1\x7b\xda\xf8\xb3\x66\xe2\x2e\xc0\x4a\x04\x01\xdd\x66\xb9\x59\xd4\xa4\x42\x86\x21\xcb\x27\xf8\xba\x5a\xb6\x3d\x21\x67\x2a\xcf\xcc\xc9\x5e\x4b\x9c\x22\x5c\x3b\xe1\x8b\x45\x7e\x44\x75\x99\x71\x35\x3a\x01\xe3\xfa\xa1\xd6\x7c\x93\xcd\xd4\x8e\x16\x86\xc9\x05\xd0\xa4\xb9\x1a\x95\x96\xe5\xf3\x7f\xdd\x29\xb5\xa1\x41\xbe\x66\xfc\x39\x31\xbb\xf7\xb2\xb1\xb2\xe9\xa6\xb1\x32\x1e\xbc\x05\x72\xd8\xc2\x95\xd4\x93\xa4\xd8\x28\x6d\x6d\x9f\xf5\x3c\x8c\x67\xd5\x33\x71\xd9\x38\xa0\x04\x05\x65\x85\x55\xbe\x6e\x8a\xb0\x17\xe8\x99\x12\x30\xa0\x1a\xb6\x7e\xec\xb6\xc0\x6b\xc1\x5c\x9d\x96\x0c\xf9\x5a\xf1\x54\xa1\xbe\xcb\xdb\xc0\xaf\x10\x01\xd2\x8f\x3d\xfc\xac\x93\x73\x63\x38\xdc\x29\x11\xa4\xfb\xcc\xb9\x4b\x50\xa1\x76\x59\x13\x1e\x44\x3c\x65\xf9\x21\xef\xd3\xde\xc2\xf7\x68\x10\x9f\x1e\x58\xec\x17\x56\x23\x01\xde\x8a\xce\xa4\xf5\xe0\xc3\x39\x5a\xd9\xfd\xd3\xb1\xdc\x1e\x49\x21\xb9\x95\xb6\x73\x1d\xcb\xac\xc7\x2b\x6e\x5b\x84\x96\xbe\x94\x80\x1f\x2e\x8c\x0b\x6d\xc3\xf8\x94\x16\x5e\x9b\xb7\x11\xda\xa4\x63\x8e\xeb\xfe\x9d\xa9\x76\x94\x8a\x22\xa2\xef\xf9\x18\xe6\x5e\x09\x64\xaa\x8d\x2b\x3f\xf3\x48\x72\x7d\x9b\x5f\x2e\xed\xd7\x32\xbd\x23\xb8\x29\xe4\x45\x79\xc6\x46\x46\xc8\xca\x66\xce\xf0\xa9\x07\xdf\xfa\x03\xb5\xba\xe2\xf1\x27\xed\x3e\x72\xfd\xbf\xee\x5c\xd2\x80\x55\x14\x40\xce\x71\xd2\x38\xe9\x6e\xbd\x58\xb3\x90\x1b\xe3\xdc\x8c\x05\x22\x91\xf2\x0a\x5b\x37\x0c\x9b\x1c\xd1\xa4\x8e\xef\x96\xc6\x79\xcf\x60\x53\xee\x3b\x6d\x72\x24\x6d\x8f\x27\x27\xb7\xca\x58\x6a\x29\xd8\x30\x7f\x3b\xa3\x7c\x4f\x82\xe0\x23\x2c\x8a\x17\xd5\x40\x79\x49\xed\x67\xe0\xd6\x53\xb9\x47\x46\x39\x13\x91\x21\xb2\x21\xcc\x94\xde\x41\x28\x31\xbc\xac\x04\xe9\xc5\x81\x68\x71\xc8\xea\x82\xd6\xdd\xa6\x2c\xd2\x72\x50\x0d\x58\xe0\x4b\xda\xe9\x55\x45\x5a\x35\xf1\x02\xe6\x9e\x83\x94\x48\x29\x57\x0d\xa0\x9d\x56\xb9\xf5\x4c\xe1\x86\xdb\xe1\x68\x42\xa1\xf5\x0a\xe3\x35\xad\xb6\xbe\x55\xe2\x49\xfe\x3b\x8a\x73\x54\xb3\xac\x8b\xc1\xb2\x29\x07\x28\x21\x0a\xa4\xe9\x57\x65\x18\x8b\xc3\xd0\x97\x21\xd2\x0f\x4a\x48\x7d\xbc\x63\xf4\x82\x80\x47\xa7\xa9\x92\x20\x1f\x7e\xb4\xd5\xf6\x18\x3a\xf3\xc0\x78\x49\x7c\x93\x3d\x5c\xc7\x39\xb7\xce\xa3\x84\x30\xed\xa8\xaf\xe4\x87\x92\x5d\xa7\x57\x52\xa1\xc9\x0c\x1e\x80\xbd\x68\x44\xce\x4c\xc3\xc0\x6e\x48\xd7\xa9\xa4\xc2\x2d\xbf\x8e\x71\xf6\x4b\x3d\xc1\x30\xc5\xd1\xa2\x4c\x51\xd6\x7f\xdf\xd3\xd1\x0d\x7e\x89\x8f\x23\xc6\xc8\x3d\x1e\x8d\x78\x95\x2e\xd4\x2f\xc9\x36\xf7\xe7\xe4\xc8\x0f\x76\x44\x61\x99\xf3\x9c\xfd\x15\x5c\xb7\x38\xb3\x97\xb2\x96\x6c\x6f\x6e\x45\x97\xf2\xd2\x3c\x15\xdf\x73\x6d\x7e\x55\x8e\xc3\x58\x1c\xa4\x90\x00\x14\x32\xe6\x7f\xc4\xfa\x2e\xf7\x82\x91\x8d\x8c\x80\x06\xcb\x80\x51\xa7\x84\x08\x47\x51\x21\x34\xd1\x06\x85\x8c\x31\x54\x8e\x71\xdb\x8b\xa5\x3b\x1e\x67\xde\xc4\x0c\x9e\xc2\xef\xc4\xaa\xde\x58\xfb\xb9\xe2\xdc\x19\xfa\x35\xf3\x8b\x32\x74\xe3\x32\xfb\xac\xb1\x15\x8c\x38\x8a\xcd\x37\x2d\xe9\x44\x94\x2c\xf8\x9f\x16\x5a\xaf\xac\x74\x3e\xac\xcc\xc1\xeb\x7e\xc9\xaa\x63\x2f\x22\xbf\x85\xb6\xda\xba\x96\x2e\xf7\x3d\x8e\xfe\x31\xec\xed\x73\x1f\x23\x3a\x81\xdb\xa6\xbf\x1e\x24\xf9\xc9\x85\xc1\xf3\x83\x5c\xce\xe2\x18\xb6\x56\x5b\x33\x39\xfa\x57\xf1\xcb\x37\x13\x46\x51\x31\x49\x0c\x3f\xa9\xc6\x6a\xde\x89\xe4\x37\x47\x00\xaa\xa8\x07\xde\xbe\xc6\x36\xd9\x92\xe6\x38\x9d\x8d\xc9\x8a\x4b\x18\x04\xa8\x78\x27\x00\x60\x11\xfd\xd9\xbb\xb3\xa9\x00\x85\x48\xfa\xc8\xc2\x3d\x95\x16\x83\x14\x8a\x63\x13\xef\x3f\x69\x36\xdd\xc7\x65\xc4\x85\x8c\xe5\xf0\x87\x8a\x09\x08\xde\x43\x04\xb7\xce\x5b\xfb\x98\xc9\x86\xcf\xab\x1c\x3a\xbf\x13\xea\x93\x53\xc1\x6a\xe6\x5a\xac\x6d\xb6\x9f\x8e\x71\x92\xf3\x24\xa1\xa3\xa3\x18\x89\xfc\xde\x77\xdb\x14\x97\x19\x70\xca\xe3\x06\x69\xbb\x99\x57\x99\x40\xaa\x7a\xef\x1a\x79\x72\xdd\x70\x89\xae\x2b\xc8\x77\xf6\xf3\xf8\xc0\x4e\x51\x51\xaf\xb8\xad\x6b\xd6\x9a\x36\x05\xe7\xad\xaf\x41\x7b\xac\x17\x75\x7d\x01\x99\x2f\xd6\x84\xd5\xda\x10\xaa\x34\x21\x61\x32\x82\xdf\x91\x4f\x06\xbf\x5d\x75\x7a\x56\x7e\xcf\x66\xe5\xca\xb3\x4b\xda\xd5\xe4\x61\xc4\x53\xc5\xaf\xb5\xc9\x3b\xbd\xfd\xc7\x11\x54\x2b\x02\x39\x18\x86\x35\xfc\x47\xd8\x18\xdf\xe8\xae\x8d\x85\x60\xf4\xeb\xf7\xd3\x2d\x92\xf5\xde\x92\xaf\x23\xe8\x5e\x6f\x7e\x91\x44\xea\x69\xc1\x81\x0e\x17\x81\x96\x8a\x67\x25\x56\x91\x6d\x30\xb5\xb5\x9a\x2f\x78\x3d\x96\x7d\x52\x26\xcd\x38\x81\x5f\xa7\xb0\xb3\x88\xb1\xf0\xca\xdd\xa4\x55\x25\x97\x9d\x6b\x68\x1b\xa7\x05\x91\xd2\x68\x24\x6b\x24\x9b\x43\x18\x6e\x64\xa4\xfb\x98\xfd\x11\x6c\x12\x6f\xa9\x54\xa8\x05\x54\xb8\x01\x63\x19\xdd\xf6\xf5\xa4\x31\x0b\xd2\x7a\xfe\x0e\x1c\xc6\x09\xcd\xa9\xfa\xf0\x1b\x03\xa9\x08\x53\xcf\x1b\x3a\x81\xe7\x4c\x5b\xc1\xe8\x40\x61\xf9\x06\xd0\x8a\xcf\xe1\xb9\x82\x80\x94\xde\x3e\x2b\x5f\x0c\x46\x1b\xe7\xdf\x9a\x81\xe7\xb2\x15\x11\xf4\x00\x37\x83\xdc\x6c\x83\xa7\x7c\x27\xd4\xd4\x65\xd4\x1a\x5a\x46\x82\xa0\x9d\xda\xc1\xb2\x51\xf5\x49\xfc\xa8\x96\xcf\x9e\xae\xdc\x5b\x27\xbc\xd3\x87\x61\x75\x39\x2a\x32\x05\x5f\x7f\x5b\xe1\x32\xd2\x5e\xe6\x9f\x7a\xb2\x89\x0c\x5d\x5b\xf2\xdf\xa9\x5d\x32\x84\xd2\xc9\x28\xb4\xad\xc4\xec\x7f\x9f\x7a\x34\x87\x9a\xa7\x6f\x70\xf6\x53\x2a\x32\xd0\x99\xdd\x06\xb0\x1b\x2e\xdc\x6b\x54\xfb\x12\x60\x49\xf8\x2f\x7b\x8b\x8b\x0b\x88\x98\x38\x57\x53\x3b\xd8\x9b\xf5\x6f\xd8\x21\x51\x1b\x89\x81\xf9\x97\x04\x6f\xf4\x3f\x9b\x32\x5d\xc8\x2d\x2b\x42\xf9\x01\x96\xd7\xb0\xb0\x26\xd0\x66\xf3\x70\x80\xcf\x37\xb4\x3d\xbc\x3d\xc1\x67\x56\x5d\xd8\xc1\x74\xc1\x3e\xc8\x9e\x88\x01\xfc\x27\xa1\x31\xa8\x0e\x5b\x2f\x6e\xf6\x4e\x04\x4c\x83\xf2\x0f\xcb\x7e\x3f\x5f\xd5\xcf\xed\xba\x6d\x50\x51\x54\xb3\x5e\xfa\x18\x48\xfe\xff\x98\x7a\x0b\xae\x38\xd6\x26\x5a\xb8\x67\x70\x77\x0d\x1e\x34\x40\x70\x77\x0d\x16\x08\x12\xdc\x13\x2c\x04\x06\xd7\x01\x06\x77\x77\x27\x40\x70\xd7\xe0\x1a\x08\xee\xae\xc1\x1d\x82\x3b\x37\xe7\x3d\xe7\xde\xef\x5b\x4f\xff\x80\xee\xaa\xda\x7b\x57\x3d\xab\x57\xed\x97\x86\x9f\xbd\x16\x85\x06\x2f\x6c\x12\xf2\xc1\x92\xb2\x8f\x04\x09\xfa\x45\x1d\x7b\x0b\x79\x40\x1c\x27\xd2\x8b\x8a\xcc\x9b\x2a\x76\x4f\x85\xde\x25\x0c\xcb\x64\x5c\x31\xc5\x32\xd1\x32\x37\x0a\x73\x3c\xab\xbb\x0f\xf7\xd2\x66\x55\x81\x4f\xc4\x8d\x78\x2f\xba\x59\x74\x9a\x6a\x85\xdf\xf0\x77\xee\xf0\xa6\xdf\x6f\x1e\xed\x29\x44\x7e\x6c\x09\x71\xcd\x2a\x3c\xba\x2a\x63\x36\x11\xde\x56\x7f\xa3\x83\x9c\xd3\x16\x35\xc7\x13\x61\x31\x07\x4c\x8f\x9e\x95\x80\x35\x56\x7e\x5c\x55\x64\x62\x57\xe4\xb1\x46\xea\x1d\x4b\xee\x2f\x2b\x0a\x6a\x75\xc2\x8b\x62\x8e\x7b\x46\xbc\x64\x37\x8c\xce\xd7\xd3\x39\x1e\xa8\x9f\xee\x40\x53\xa0\x8a\xf0\x1c\xe9\xe7\xa5\x23\xa3\xed\x66\x71\x15\x3f\xd2\x66\x96\x1c\xb0\xe4\xfe\xbb\x99\xe5\x06\x9c\x52\x02\xfa\x8d\x8d\x93\xdc\xf1\xf3\x84\x4a\xa7\x98\xd0\xa3\xc7\x8b\x5f\xc5\x43\x14\xbf\xf5\xf8\x43\xaf\xde\x6e\x7a\x89\x50\xd9\xc0\x95\x0d\x08\x1f\x7e\x4f\xdc\xe0\x01\xb2\x11\x1f\x23\x9a\x21\x74\xe0\x57\x95\xbf\xdd\x5d\xa7\x66\xdf\xcc\xfa\x81\x98\x22\x10\xc3\xec\x13\xc6\x2a\x1c\x70\xe0\xda\x02\x4f\x0f\x31\xed\x52\x69\x9b\x6d\x45\x5e\xf7\xd3\x3e\x09\x87\xa7\x42\xcb\x0c\x94\x53\xa5\x09\x08\xec\xdf\xd2\x96\xe3\xc5\xea\x99\x9d\xe8\x75\xee\x6a\x8a\xdb\x08\x7a\xdd\x30\xd2\x9b\x1f\xaf\x25\x23\x74\xde\x7e\xa9\x4e\xa4\xa3\xb7\x31\xc5\x14\x8b\x0a\xbd\x71\x51\x4a\x30\xc0\x51\xec\x3e\xd4\x8e\xa9\xc9\xac\xdb\xa2\xa0\x54\xb4\xf9\x9d\x67\x7d\x2d\x62\x76\xc1\x8c\xf8\x21\xfe\xa3\x96\xc3\xe2\x2d\x29\x6c\xfd\x3d\xbb\x27\x1f\xbb\x0b\xab\x19\x4d\x75\x80\x03\xce\x1e\x9e\x78\xa5\xf4\x62\xa3\x4d\x1f\x39\x92\xd2\x5b\x8a\x45\x5e\xbb\x65\x2b\x0b\x92\xed\x2b\x8b\xa3\xd3\x46\x72\xde\xb3\xfc\x44\x9f\xd0\x16\x8f\x36\xf6\xe3\xa4\x37\xc9\x17\x04\x8c\x3e\x89\x57\x20\xf1\xc3\x30\xb9\xa5\xe4\x0a\x6d\x38\x1d\x3b\xe0\xba\xe3\x63\xcb\x7f\xd2\x35\x86\x04\x38\xe1\x4f\x9b\x24\xfc\x72\x1e\xbd\xa9\xb0\xa4\x4a\x86\x04\x43\x00\xe5\x49\x01\x1a\xba\x25\x24\xb4\x39\xac\x5f\x7a\x43\xa5\x94\x88\x55\xdd\x8a\xe8\x61\xf8\x19\x09\xa9\xe5\xd8\x17\x56\xc2\x1d\x41\x34\xed\xaf\x8c\x84\x53\xf1\x1d\x19\x46\xa5\x52\x7a\xdf\xde\x00\xdc\xbe\x71\xef\x19\x5f\xd4\x95\xc9\xcc\xb6\x68\x8f\x64\x08\xdb\x7f\x66\x30\x09\x14\x5b\xd5\xb7\x45\x4c\xb6\xfa\x71\xda\xfa\xf5\xbb\x30\x49\xe0\x5f\xc8\xa7\xd4\x63\xe4\x0f\xbc\x8f\x3e\xa8\x63\xd7\x9a\xbd\x8b\x6c\x47\xfb\xc9\x94\x3d\x64\xea\x3b\xdf\x7f\xe6\x7f\x68\x6f\xeb\x7c\x68\x86\xc2\x62\xcc\x03\x00\xd6\x30\xaa\xf2\x90\xac\x8a\x88\xf5\x1b\xb7\x26\xc7\x15\x04\x4a\x31\xab\x08\xf7\x57\xfb\x59\x68\xaf\x53\xe3\x67\x49\xc3\xe5\xb9\xef\x7e\xdd\xae\x12\x2c\x3d\xa6\xe3\x24\x0f\xff\x20\x7d\xa8\xa0\x12\x71\xf1\xbe\x7a\x79\xe7\xd6\xe8\x7f\x9f\xd6\xf9\x59\x95\xe1\x4a\x07\x88\x9a\x9e\xc5\x62\x0f\x3d\x28\xcc\xa8\x95\x4b\xd7\x56\x6e\x79\xa4\xe9\xe0\x0b\x05\x29\xa1\x1b\x9b\x02\x42\x30\x50\x9d\x74\xed\x98\x17\xd9\xab\xc4\x40\x88\x4d\x0d\xfb\xdf\x6c\xf7\x37\x5d\x1c\x87\x30\x59\xea\x29\x30\xa2\xdf\x2d\x1b\x8c\x84\xfd\x1c\x49\x3a\x8f\x35\x9d\x7a\x79\x9b\xe2\x0a\x32\xea\x01\xf9\x71\x76\x14\x3f\x4b\x1d\xcb\xae\xcd\x19\x83\xd1\x0a\xf6\x8c\x7e\x98\xc3\x61\xfa\xcb\x1f\x91\x24\x0c\x49\x8b\x57\x43\xfa\x4e\x8f\xa6\xb7\x2a\xf3\x60\xb2\xa7\x68\xa9\x7b\x01\xf3\x62\xef\x83\x46\xa5\x0f\xc4\x6f\x32\x01\xee\xe1\xd7\xac\xa2\x2e\x4a\x4b\x81\x2c\xce\x15\xf5\xea\x59\x70\xf3\x83\x5b\x07\xf1\x88\x47\xde\x8d\xc1\xbe\xa8\xa1\x07\x5b\xe1\x29\x7e\x3e\x01\x63\xdf\x62\x36\xc3\x6f\x79\x0e\xd8\x2e\xbe\x42\x1f\x85\xad\x66\x52\xb8\x3e\xaa\xea\x2d\xfe\x16\xda\x18\xc5\xad\xae\x00\x77\x08\xde\xab\xd8\x07\x38\xa5\x88\xa7\x9e\xbf\xed\x64\x70\xb9\x62\x3c\xde\x64\x14\x2d\x17\x02\x49\x73\xa8\x52\xaa\x3e\x22\xf4\xcc\xd9\x81\x8c\x42\xdb\x97\x99\x44\x37\xf3\x12\x24\x4a\xd5\xbf\xe5\x33\xf3\x4e\xf0\x1d\x3c\x2f\xfd\x5f\xb8\xa3\x00\x3c\xd8\xa7\xd2\xbe\xc8\x54\xbd\x50\x6f\xde\xcd\x07\x4e\xde\xbb\x43\xf8\xdb\x45\xf8\xdb\x9a\x01\xca\x74\xb8\xb3\xfc\x53\x1a\xbd\xd2\x1f\x1d\x02\xa8\xd6\x13\x3f\x81\xd5\xed\x3e\xb3\x27\x88\xbf\xc0\xb1\x4c\x81\x16\x39\x9d\x2d\x7b\xde\xac\x15\x96\x2e\x32\x0e\xd8\x8f\xa0\xdd\x87\x04\x69\xd9\x27\xd5\x2d\x7f\xbc\x1e\xe7\x42\x3e\x61\xc8\xb5\xfb\x12\xf9\xeb\x1b\x56\x8c\x0d\x6d\xbe\x3a\x9b\x75\x39\xf8\xfc\x26\x33\x66\xaa\x65\x0c\xec\xc1\xee\x8e\x5b\xc1\xd7\xcf\x09\xea\xde\x7d\xb5\x34\xee\xe0\x1d\xd6\x04\x8b\xab\xf2\xbf\x94\x8e\x22\xfd\xb0\x91\x59\x91\x91\x5b\x86\xdf\xa8\x02\xaf\x5d\xb9\xb1\xca\x69\xf5\x01\xca\xc0\x1f\x75\xca\xab\xfd\x89\xd2\x4d\x73\x75\x47\xbd\xc3\xb1\xec\x01\x3e\x65\xac\xb3\xfd\x0b\x94\x29\x3e\xa8\x16\x26\x4c\x89\x74\xe7\x95\x6a\xff\x3e\xb7\x21\x41\xe8\xae\xd6\x68\x31\x4a\xe6\xeb\x6f\x6a\xfa\xbe\x07\x77\xf2\xcf\x26\x03\x38\x1b\xc8\x05\x53\x0a\x90\x93\x8b\x15\xeb\x09\x88\x90\x81\xd6\xd1\xfa\x16\x59\x8b\x4f\xd0\x23\xc0\xf7\x8d\xf9\xe8\x39\xeb\x1f\x55\x46\x01\x0c\x2d\x81\x5f\x54\x89\x17\xa2\xbf\x72\x6c\xd2\xf9\x24\xa7\xbd\x7f\xab\x38\xcd\x8c\x37\xf8\x5a\x94\x4b\x08\x04\xc6\x75\xbd\xe1\xd2\x0a\xdd\x1e\x6d\x51\x39\x3a\xe5\xdc\x40\x05\x49\x30\xad\x6d\x32\xd6\x46\x42\x31\x99\x54\x04\x7e\x1c\x92\xe0\x3d\x9e\x60\x0f\x2f\xcb\x3f\xfa\xc8\x7e\xd8\x69\xbb\xd5\xb2\x09\x8a\x9d\x28\x39\x8f\xf9\xbc\xdc\xd8\x9d\x87\xbd\x35\x57\xf8\x04\x48\x0d\x7e\xef\x93\xb8\x78\x29\xc6\x13\x28\xc8\x51\x4a\xee\x0c\x32\xc7\x09\xda\x54\xdc\xaa\x8c\xa0\x59\xa6\x94\x2f\x15\x8f\xbd\xfe\x1e\x2f\x66\xd6\xb2\x14\x3b\xfa\xea\x3a\xd5\xc7\xc6\xaa\xd3\x3c\x91\x9c\x48\x83\x9e\xc4\xdb\x95\xd2\x5f\x26\x4a\x24\x4f\x39\x7d\x1c\x42\x67\xa0\x44\x98\x6d\x5f\xa1\xa2\x23\xb7\x84\x39\xa9\x29\xdb\xbb\x87\x72\x66\x75\x71\x26\x00\x9d\xb5\xe2\xe2\xe9\x5c\xc9\x53\x0a\x42\x02\xdd\xb2\xb9\x2d\xfa\xf3\xd2\x40\x6a\xf6\x5c\x5e\x4d\xbf\x5c\xd7\x2f\xbc\xfd\x82\xd7\x7a\x6a\xba\x41\x74\x78\x4a\x83\x86\x0a\xfc\xb0\xb4\x5c\xea\xbc\xa8\x63\x8a\xff\xf7\x73\x5d\x00\x40\xba\xce\xd4\x81\xed\x68\xbc\x75\xc0\x08\xa9\x07\xc3\xf7\x63\xa6\xbc\xb0\x24\x89\xa2\x3b\xea\x6d\x40\x42\x4a\x83\x71\xaa\x8a\x91\x5e\x58\xa5\x2a\xb9\xcb\x81\x4c\x0a\xc9\xf1\xcf\x19\x7d\x0d\x1c\x55\x41\x4f\x01\x57\xee\x82\xce\xd2\xde\x8e\xe0\x1b\x06\x76\x0e\xcc\x8b\xf3\x54\x03\xb1\xd0\xda\xa7\xf4\x48\x0e\x48\x74\x2f\x9b\xb8\x06\xbb\x5b\x25\xb0\xb7\x4d\xf1\xa1\x1e\xeb\xbb\x50\xf6\xe3\xfd\x86\xb5\x99\xb7\x83\xcc\xd1\xa8\xf5\x9f\x60\x27\x1d\x96\x6a\xff\x60\x01\x2b\x89\xb5\x6d\x90\x4c\x91\xfc\x14\x75\xa7\x56\x65\xa2\xb7\x5e\x6f\x98\x8d\x1f\x5d\x79\x31\x6b\x1d\x0e\x59\x06\xb5\x58\xf5\x88\xcb\x36\xd2\xbd\x9f\x63\x5b\xd9\x16\x0b\x72\x53\x66\x33\x1e\x3b\xfa\x4c\xf5\x43\x26\x30\xcf\xf8\xa2\xb2\x44\x2b\x27\x36\x99\x15\x7b\x08\x76\x2e\xde\x2d\x36\xdc\x55\x7b\xa7\x9b\xc1\xa1\x44\x49\xea\x37\xb2\xd8\xa5\xcd\xab\x04\xad\x40\xe6\x7f\x73\x92\xfb\xcc\xb0\xa8\x1f\x5d\x4b\x5d\x24\x86\x3e\x79\x02\xf5\x8e\x6f\xb0\x4c\xcf\x39\x64\x3a\xfc\x4f\xda\x7b\x80\x1c\xc9\x48\xdf\x33\xe7\x2c\x89\x99\x46\xec\x2d\x59\xc4\xcd\xe2\x1a\x76\xa2\xef\x1f\x08\x6d\xcf\x98\xe8\x37\xff\x70\xa4\x45\xa7\xda\xb1\xa2\x7f\x14\x83\x41\x10\x4a\x9d\x88\x6a\x8f\xa3\x46\xd5\x45\x7e\x51\x3d\xba\x47\xc1\x79\xca\xa7\x13\xbe\x3b\x30\x25\xf2\x6f\xc0\x4f\x8d\x7e\x7a\x83\xb8\x7f\x63\x57\xd3\xe3\x86\x86\xd7\x71\x9e\x14\xdf\xf0\xa0\xf3\xe7\x46\xf6\x7c\x4b\x54\x4a\x4d\x9f\x8d\xf7\xed\xb3\x90\xa7\xcc\x1b\x7a\xdd\x1e\x6f\xcb\xbc\x2d\x01\x78\xdc\x86\x1c\x28\xbd\xee\x74\xb0\x92\xa7\x33\x61\x17\x94\xed\xce\x49\xab\xf9\x70\xc1\x68\xe7\x4e\x60\xc9\xc3\x4e\x80\xf8\x25\x7e\x34\x37\x78\x38\x5e\xab\xe7\xcb\xaf\x19\xd8\x99\x8b\x82\xac\xce\xc7\x3f\xee\x42\x12\xcf\x5f\xab\x75\x66\x39\x18\x0c\x24\xbe\x29\x3b\x41\x64\xc9\x5f\xbe\xcf\xfb\x2c\xaa\x85\xdc\xa1\xdf\xf9\x7b\x95\x1f\x5d\xe8\x05\x4c\xd5\xed\x46\x6a\x0a\x4e\x77\xec\x8c\xbf\xab\x3b\xdb\xe4\x8f\x8f\x7c\xad\xf4\xe5\xf3\xe8\x8a\xcd\xfb\x1c\x7d\xd5\x16\x55\x5d\xc7\xb5\x67\xcf\xea\x6b\xfb\xd8\x78\x84\xfe\x14\x16\x9b\xeb\x4b\x3a\xad\x03\xf6\x6b\xb8\x03\xbe\x64\xe3\xa6\xff\x35\xa0\xef\xe0\xc4\x99\x42\xc1\x1a\x50\xbe\x97\x7e\x41\x67\x7f\x83\x73\x36\xf7\x7d\x9f\x33\xcc\x48\xef\xd4\x77\x4d\x4d\x61\xb6\x38\x95\x3f\x81\x36\xeb\x72\x1b\xed\xa4\x4f\xb9\xfa\x41\x3d\x50\xff\xae\xfa\xb0\x8d\xf6\x08\x0b\x6e\x6d\x55\xcf\xd7\x11\x4a\xbf\xf3\x5f\x76\x19\x79\xf4\x16\x5a\xbe\xce\x28\x03\xea\x7c\xfe\x35\xcc\x24\xfb\x25\xa6\x07\xf5\x17\x5b\x43\x8a\x27\xaa\x67\x94\x05\x06\x5f\x51\x52\x64\x11\xfa\x2a\x5c\x2b\x4a\xdf\x9b\x31\x26\x99\x0c\xf6\x33\x98\xe2\xcc\x61\xd9\xbc\x9a\xb7\x80\x33\xf2\xae\x74\x65\x3a\xc9\xd8\x5d\x19\x6a\x29\xad\x22\x8d\xb0\x40\x90\xbb\x65\x4e\xf9\x26\xbf\x4d\x8c\xf2\x0e\xd8\xfc\xd6\x6e\xfc\x20\x6a\x5b\x10\x45\xaa\x1e\xd2\x11\xf1\xab\x91\x8b\xdb\x0a\x8f\xf1\x8d\xf6\x0a\x45\x69\x86\xae\x68\xb6\x4b\x1e\x7f\xd8\x0f\xb1\x60\xb6\x75\xbd\x54\xaa\x04\x0c\x47\x4b\xac\x7e\x4b\xac\xa9\x91\x96\x49\x0e\x09\x6f\x8f\xfd\x57\x8e\x4c\x70\xe4\x7c\x37\xec\x57\x4d\xc1\xf7\x13\xd5\xbe\x15\x28\xab\xe6\x57\x47\x4e\x3c\x4f\xd7\xb8\xc6\x28\xe0\xa4\xe3\x1f\x3e\xbd\xd2\xc7\x47\xb2\x85\xd0\xff\xae\x05\x94\x01\xd8\x35\x55\x30\xe4\xbd\xd6\x23\xd1\x7b\x6c\xb5\x2a\x0d\x2d\x4c\x9b\x57\xfc\xf4\x74\x65\xaf\xde\xaa\xda\x5f\x64\x83\x0e\x92\x3e\x79\xeb\x0d\x24\x21\xcf\x01\xf7\xf7\x1f\xb4\xdb\x18\xd9\xcb\x13\xca\x75\x0a\x34\x39\x70\x46\xbc\x4e\xc9\xe8\x77\x51\x47\x4d\x59\x89\x0b\x1e\xd8\xb3\x9b\x95\x72\x3a\x27\x4c\x12\x36\x11\xb1\x90\x71\xb1\xd3\x90\x4a\xcf\x09\x4e\x38\xca\xaf\x69\x5f\xdb\xfa\x66\xb6\xb1\x73\x09\xbe\x29\xc1\x15\x28\xfe\x36\x2f\x76\x1c\x30\xe5\x32\xdd\xe0\xb2\x69\x6a\x0c\x06\x6a\x18\x1b\xcb\x4b\x29\x5a\x3f\x75\xec\xe6\x23\xb5\xbc\xbd\x17\xb8\xdb\x2c\x87\xd9\x50\xde\x1e\x82\x86\xa1\x96\xa8\x9e\x7a\x6f\x9e\x71\x3a\xfb\xde\x43\xaf\xe5\xc9\x8d\x9f\x14\x94\x65\x98\x5a\x50\xe7\x12\x8e\x82\xc3\x53\xc9\x2c\x41\xc9\x2a\xdc\xa9\xc4\x2e\x93\x46\x1e\x50\xaa\x13\x8e\x51\xf9\xf6\x44\x5e\x22\xce\x89\x16\xb5\xe0\xcc\x84\xcf\x5c\xf3\x87\xca\x5e\x26\x58\xee\xa2\xc3\x2f\xfe\xb0\x38\x6a\xa5\x15\x51\x47\xd7\xc6\x4f\x14\x15\x8d\x60\xd7\xf2\xf6\x8e\x84\x80\x86\x1c\x8c\x4a\x24\x56\x80\xfc\x0b\xdc\x41\xe5\x1a\xd8\xdc\xb2\x14\xeb\x2b\x2a\xd1\x5b\x8d\x0d\xa3\x42\x76\xc5\x1e\x6f\xe2\x18\xcd\x2b\x66\xf5\x7c\x73\x17\xb3\x84\x4e\x19\x06\x84\xdb\x81\x8e\x7d\xca\xe9\x70\xf7\x23\xde\x28\x09\xcf\x42\x8e\x1d\x83\xbe\x94\xb5\x5d\xe2\x9a\x7f\x86\x63\x42\xbc\x07\xc4\x14\xd1\x54\xcc\x7e\x00\x91\x94\xaa\xfe\x60\xd6\xb0\x2a\x56\x00\xf6\x41\xfc\x7b\x18\x64\xad\x2d\x25\x42\x2a\xcc\x19\xbc\x7b\xad\x00\xaf\xe4\x04\xf7\x21\xb1\x22\xc9\xcf\xaf\xee\xa9\xd9\x05\x4f\x4b\xe9\x40\xb8\x9f\x40\xc4\x31\xc6\xb3\x26\xc4\x68\x05\x63\xd9\x68\x73\x55\xd0\x5d\x0f\x35\x4f\x37\xc3\xec\x41\xaf\x2f\xc5\xf5\xc3\xea\x47\x66\x6f\x79\xac\xe3\x27\x6f\xa5\x49\x30\xa2\x99\x36\x65\xe2\x82\x96\x7b\x83\x0a\xbc\x6d\xf8\x2e\x94\xe8\x6a\x13\xbc\xbb\x1f\x76\xce\x99\x7c\x1e\x92\xba\xc2\x03\x4a\x19\xa0\x80\x9a\xbe\xa5\xfc\x5e\xa6\xcb\x2a\xf8\x27\x53\x40\xd4\x0c\x38\xb0\xb2\x16\x84\xbb\x31\x11\xaf\x27\x1e\x10\xeb\x0e\xf0\x19\x07\xfb\x38\x66\x78\x73\x73\xd4\xd9\xe4\xc8\x92\x7d\x0b\x54\x97\x1f\x1c\x50\xde\x75\x94\x73\x60\x21\x4d\x67\x3c\xad\xb1\xa1\x97\x47\x52\xfc\xbe\x6c\xc3\x34\x9c\x04\x93\xf4\xc0\x21\x2c\x0c\x06\x75\xcc\x84\x75\x97\xab\x7d\xf6\xe8\x98\xa6\x7d\x08\xf2\xbb\xa3\x00\x56\x1f\x22\x38\x3a\x2c\x22\xdc\x1b\x39\x76\xe6\x00\xbf\xc6\xce\x6b\xab\x9f\xc6\xe4\xec\x05\xaf\x67\xa6\xb3\x8f\x44\xe9\x6a\x35\x98\x65\x98\xd8\xf9\x11\x91\x59\x1a\xd6\xf7\x30\xa7\xe5\xe1\x5c\xde\x72\x03\xbb\xb0\x79\x8c\xd6\xa5\xd2\x88\xa1\x90\xc5\xa1\xda\xb2\x33\x82\xa5\x8d\xf8\x37\x05\x67\x05\x72\x24\x17\xed\x06\x73\x22\xdf\x40\x7b\x4d\x51\x4e\x15\x26\x26\xdd\xaf\x80\x15\xca\x5e\xbe\xf3\x3c\x7d\xb4\xcc\x7b\x13\xfe\xa8\xc3\xc6\x62\xbe\x63\xbc\xb9\x92\x61\x83\x05\x50\x55\x68\x64\x28\xcf\xe4\xdd\x17\x83\x14\xf9\xec\xdf\xae\x83\x63\xa8\x29\xa3\xc4\x2d\x6a\xfd\x5a\xd3\xcf\x06\x56\xdf\x94\x35\xde\xca\xdc\xbd\xe0\xed\x72\xf4\x1f\xcc\x13\x96\xc3\x28\x76\xee\xcc\xbc\xf1\x70\x9f\xae\xf1\xd1\x24\xe8\x85\x9f\x24\xf0\xbe\x77\x43\x2a\x4f\xc0\xa2\x6d\x0c\xa9\x59\xe3\xcf\x51\x35\x42\xe2\xa5\xbc\x39\xa1\xdf\xa1\x7d\x85\x9c\x05\xb4\xfb\x1f\x8a\xeb\xda\xe9\x02\x75\xf0\xa7\x7d\xdf\x01\x30\x47\x45\xa0\xb3\xe9\xc3\x43\xb2\x83\xe7\xaf\x1a\x48\x16\xca\xfd\x54\x95\xf0\xdc\x66\x8c\x0f\xa9\xb1\xa6\xf3\x20\x67\x34\xd0\x24\x00\x1c\xda\x1e\x9a\x09\xfd\xe8\xd7\x2e\xb0\x05\xc6\xf2\x05\x25\x16\xe4\xf7\xb1\x1c\x8c\x28\xfe\x54\xea\xfa\x5a\x09\x24\xcc\xc6\x30\x46\x17\xa6\xca\xdd\xea\xa3\x24\x44\xb9\xdf\x8e\x35\xd1\x38\x5e\xc5\xdf\x52\x0f\xf6\x7e\x56\x44\x68\x70\xc2\xcb\x69\x71\x13\x01\xce\x1e\x64\x60\x67\x50\xf1\x3d\xc5\x7c\x66\xc7\x73\x26\x2e\xe3\xea\xae\x3f\x46\x17\x5e\xf4\xc6\x39\x37\x31\xfc\x6b\x58\x3d\xb2\xa1\x22\xc3\x70\x57\xea\x5d\x64\x6d\x93\xcd\x6f\xcd\x04\xc9\x27\x1c\x79\x6b\x50\x97\x90\x1c\x66\x54\x5c\x7b\x80\xa4\x41\x23\xda\xa2\x48\xb2\xfc\x56\xa9\xce\x45\xd6\xc3\x37\x1d\xfb\xdb\x8e\x47\x7c\xea\x38\x7c\x59\xe0\x2d\x2b\xbd\xa4\xff\xf5\x1f\x60\x13\xf8\x29\x4f\x92\x9a\x9c\x39\x87\xfd\x22\x5a\xca\xce\x2d\xb8\x14\x28\x20\xd4\x8b\x34\x8e\x9b\x7a\x06\x0f\x38\xbe\xd8\x8c\xc4\xf4\x08\xb3\x48\xef\x2b\x4e\x38\x27\xe4\x18\x73\xe1\x4b\xb4\x4c\x31\x70\xe5\x34\x8c\x93\x7c\x87\x20\x8f\xdf\x49\x7b\x86\x14\xb7\xf6\x2e\xb7\x86\x44\xaf\x22\xef\x98\x70\x98\x95\x9e\x5d\xc4\xa5\x72\x85\x1f\x8d\xfd\x40\x48\xda\x37\xc9\xda\xc4\x1c\x0e\x06\x19\x2a\xde\xbd\xbd\x20\x9b\xab\xc7\x26\xb4\x9b\xc3\x3f\x54\xbf\x9b\xa0\x21\xcc\xd6\xd1\xf8\x63\x06\xab\x8d\x89\xf8\xf3\xb8\x54\x7e\x6e\x7f\xa1\xcb\xee\xe9\x7a\x4e\x06\x12\xac\x36\xf8\x32\x56\x2d\x78\xf8\xad\x4c\x94\x6e\x4d\xde\x97\x63\xc8\x87\x93\x30\x21\xb9\x1b\xeb\x98\x0b\x38\x65\x96\xd3\x23\x9c\xc7\x3b\x26\x14\xbf\x47\x92\xcb\xae\x8c\x18\x6c\xb0\x68\x32\x59\xfe\xf2\x9d\x0e\xad\x2a\xfa\xc1\x06\xe0\x87\x7b\x61\xf3\x1a\xe6\xf0\x22\x32\xbb\xf1\x38\xa7\xa9\x2a\x4c\x8e\xd2\x3a\x2c\x28\x33\xca\x41\x4f\xb8\xb6\x0a\x3d\x08\xbc\x11\x76\x51\x41\xdd\xa5\x2d\xb2\xed\xdf\xe3\x83\x18\x1f\xa1\x86\x2e\x4b\x39\x41\x8b\x84\xe8\xff\xbb\x39\x20\x07\x80\xef\xc2\x14\xfd\xad\xb9\x18\xbe\xb5\xbf\xdc\xd3\x87\xec\x2a\x7c\xf0\xe9\xf8\x8e\x7d\xdb\x0c\xba\xd4\xd4\x38\xe1\x78\x90\xc8\x05\xd8\x1d\xcb\x33\x7f\x33\x76\x96\x60\x05\xbe\x7a\xc3\x75\xbc\x74\x8d\x6b\x56\xd6\x76\x9d\x65\xdc\x12\x86\x53\x41\x99\x07\x9d\xab\xdd\xee\x7b\xb5\xd0\xdd\x4b\x54\x29\xdd\x6e\xce\x66\xd1\x27\x32\xfa\xe4\xe2\xfd\x8e\xb8\xce\xa8\x07\x38\xe9\x2b\x94\x41\xb0\x2e\x1e\x6b\x66\x88\x8a\x41\xb6\xab\xa0\x8e\x9a\x42\x7f\xac\x70\xb2\x87\x6a\x0e\xe3\xd3\xfa\xfc\x9c\x3b\xff\xca\x97\xa9\x10\x3f\x56\x38\xd2\xce\x37\xed\xcd\x3c\x3d\x35\xd2\x97\x72\x43\xfa\xc9\x9b\x48\x5c\x9d\xb7\xf2\xa4\xf6\xb9\x87\xf8\xe2\xe9\x96\xe7\x9e\x90\xb2\xe8\x6b\xf8\x4f\x52\x08\x67\xdf\xa3\xc8\x03\x73\x33\x9a\xcb\x60\x83\x8d\xc8\x03\x01\x4c\x61\x26\x51\xe5\x0f\xe1\xf1\x3e\xda\x71\x4a\x3e\x08\xfc\xb1\xe0\xa3\xa6\x17\x24\xe0\x8a\x22\x78\x61\x86\x90\xe5\x23\xa1\xb7\x70\xb4\x5e\xa7\xe1\x60\xce\xeb\xbe\x50\x7c\x73\xfc\xcf\x93\x6b\xfd\x2f\xe5\x07\xb5\x7d\xdd\x95\xde\xf4\x34\xed\x03\x56\x71\x3a\xb4\xa1\x7d\x6d\xc8\x96\xfa\x16\x7d\x65\xca\x8f\xe8\x3b\x69\x9a\xca\xdb\x3c\xc0\x58\x3d\xf4\x24\x8c\xf1\xd2\x91\xc6\xc3\xf0\x8f\x21\x4c\x48\x33\x41\x81\x29\x9c\x60\x92\x97\x72\x25\x29\x74\xe2\x63\xc1\x14\x41\xe2\x98\xfd\xe3\x80\xa9\x59\x92\x89\x1f\xeb\x23\xbc\xcf\x00\xf1\x05\xe1\xea\x5a\x30\xc9\xf9\x45\x59\xfb\x8a\xff\x9d\x23\xfe\x4b\xcd\x58\x4d\xcc\x6f\x10\x21\x67\xaf\xb4\x5e\x99\x7e\x3f\x21\xf3\xdb\x5f\xa4\xbe\x18\xe7\xdf\xd5\x99\xaf\x09\x41\x9f\x15\x32\x49\x25\x1e\xc1\x1a\x08\xb7\x0f\x2e\x80\xbe\x65\x44\x11\x18\x18\x5b\xc4\x0d\x70\x29\xba\x5f\x02\xc5\x84\x30\xa1\xce\xb8\xee\x4c\xcb\x1b\x3a\x64\xa4\x01\xd2\x6d\xaf\x2e\xca\x94\xea\xcd\x46\x6a\xc1\x3a\x3d\x98\xbf\x50\x07\xf8\xd1\x02\x57\x31\x89\x87\x87\x44\x87\xee\x0d\xa9\x6c\x45\xc3\x29\x67\xd5\x0a\x4e\x1c\xb7\x09\xcf\x7c\xfa\x52\x82\x7f\x10\x2a\x2e\x69\xd0\xf5\x68\x5d\xe0\x29\xf1\x27\xd5\x8e\xad\xf9\x80\xd0\x7d\xe2\x65\x54\xc4\x7a\xb7\xb6\xb3\xef\x0c\xd4\x1e\x6b\xb5\xff\x08\x39\xbb\x61\x8b\xcf\x7d\x59\xc6\xfb\x44\x49\x9a\x1c\x37\xcc\x59\x9c\xd0\xc2\xea\x7c\xa0\x33\x5f\x1c\xbd\xbe\xfc\x4a\xbd\x9d\x93\xe8\x6d\xbd\xe0\x55\x20\x21\x93\x37\x53\xfb\x76\x19\xa2\x17\x5d\xd9\x90\xca\xc0\x4b\x49\xa4\x7f\x34\xfe\x0f\xc0\xb8\x68\xf8\xb3\xc3\xf2\x80\x79\x73\xf6\xa7\xec\x1a\x0b\x90\x99\xa9\xac\x3e\x7b\x3c\xc8\xf0\xdd\x87\x12\xa3\xb0\x4b\x11\x8c\x3a\xe6\x81\xc6\x15\x15\x84\xaf\x9f\x37\x1a\xa3\x3d\x6b\x65\x6e\x08\xca\x36\x09\x80\x9a\x5a\x30\x6c\x09\xf8\xc1\x93\xce\xa6\xdd\x4f\x19\x1c\x5b\xf2\x6e\xb0\x04\x71\x89\xf0\x9c\x72\xaa\xbe\xf0\xb5\x37\xac\x81\x11\x63\x25\x6a\x60\x42\xfb\x6b\xc6\xa9\x40\x1c\xe2\xb3\xfc\xa9\x09\x87\x8a\x7f\xa8\x24\x7e\x8a\x8e\x59\xde\xbd\x77\xc8\x3d\xca\x9d\x36\xea\x0f\x67\x50\x98\x77\x77\x49\x70\x58\x39\xfe\xb1\x45\x49\xd9\x5d\xdb\x7c\xad\x9f\xd5\x12\xa2\xe5\xd5\x71\x63\x51\xdb\x8b\xac\xf1\x2b\x23\x98\xcd\x65\xfd\xc8\x7e\xc8\xb9\xd6\xf4\x85\x18\xf1\xa7\x13\x8e\xbe\x47\xf6\xf0\xac\x30\x5e\x3b\xbe\x52\x87\x0e\x71\x11\xc2\xfd\xd2\xa2\xe1\xb2\x1a\x45\x93\x24\x84\xc2\x93\xac\x54\xbf\x8a\xbd\x9e\x7a\xda\xdf\xc1\xe1\x80\x18\x2d\xde\xd9\x4f\x0b\x70\xd2\x24\x71\x9e\x7a\xbf\xb9\x73\x9c\xa0\xb1\x54\x21\xa7\xdc\x40\x26\x9f\x84\xc0\x75\x8d\xdb\x6f\x05\x25\x0c\x6b\xf9\x39\x0f\xe8\x1e\x21\xbb\x38\x41\xed\xb8\x18\x2c\xb4\x9a\x60\x41\x49\xfa\x68\x0e\xaf\xb5\xbf\xb3\x2f\x31\x65\xe4\xdd\x5d\x26\xe2\x48\x46\x62\x98\xed\xea\xd6\xeb\x22\x8c\x38\x31\x2b\xdf\x84\x71\xf1\x96\x94\xdd\x1f\xb9\x4e\xe4\xdd\x8f\x8c\x39\x71\xee\x1b\x8c\x88\x87\x33\x7f\x15\x79\xd3\xea\xc4\x2a\x89\xc7\x6a\x98\x6c\xbc\x8d\x58\xdc\x81\x61\x0d\x65\xfc\x5e\x93\x04\x62\xd1\xd1\x86\x4d\x3c\x7e\xa1\x6c\x6c\xda\x1c\x7b\x5f\x33\x65\x45\x96\xa4\x55\x98\x44\xe6\x27\xb0\x1c\x8a\x65\x12\x1b\x6d\x14\xbc\x2d\x34\xd5\x9d\x69\x82\xc1\xec\xc7\x5c\x55\x5d\xaf\x49\xaf\xab\x94\x8b\xb1\x8a\x55\x1d\x7b\x29\xbb\x98\x3b\x66\xa4\xfe\x94\x67\x19\x2d\x26\x95\xf6\x1c\x20\x8c\xef\x43\xb0\x58\x9b\xf2\xa5\x31\x83\xa7\xe9\x4e\xf5\xd3\xb0\x01\x21\xff\x28\xb8\xf3\x7a\x53\x89\xb7\x46\xce\x5d\x45\xfe\x57\xe9\x64\xfe\xc7\x35\x9f\xae\x97\x8a\x6c\xeb\x1e\x9b\x25\x2e\x2f\xef\xb8\x28\xec\xfa\x26\x8b\x7d\xc7\x46\xf6\x82\x7a\x5d\x6e\xc6\x1a\xe3\x37\x15\x74\xff\x5d\x9f\xba\x03\xb0\x86\xee\x85\xe0\x4f\x90\xb2\x2c\xe7\xfb\x9e\x2f\x40\xff\x38\xc2\xde\xb4\xc7\x6a\xa9\xf1\xed\x2c\x70\xeb\xc1\xb0\x36\x4b\x66\x77\x12\xe0\x75\xcf\x27\x9a\xec\x7c\xcb\xf6\x5a\xc5\x14\xf5\x32\xd3\x84\xf9\xe2\x60\xa6\xca\x3a\xf8\x3b\xd9\xa7\xb1\x86\x84\x72\xc4\x95\x97\xa0\x19\x9d\x4e\x0b\xad\x37\xb0\x6f\xcc\xb7\x1d\x05\xef\x5d\x41\xf1\x0d\xa1\x46\x85\xce\x3f\xf6\xcb\x40\xc6\xf7\xce\xb1\xdc\x21\xdf\xd5\x5a\xcf\x05\xf4\x51\x7a\xee\x09\x61\x39\x63\x8f\xcc\x5c\x8f\x68\xb0\xc0\x3c\xe1\xa1\x64\xa4\x01\x79\xa5\x9f\x7c\xf1\xa3\xe3\xde\x30\x2c\x63\xc3\xe5\x7e\x88\x7e\x13\x96\x8b\xca\x03\xe2\x7d\x7e\xb8\x83\x5e\xe4\xbe\x03\xca\x7a\x31\x0a\xd8\xf9\x93\x34\x69\xc6\xeb\xd6\x2c\xfe\x83\xdf\x7a\x1d\x98\x8b\x84\x26\xa8\x6f\x0f\x0e\x5e\x4f\x87\x7a\xe7\x59\x2c\xee\x67\xf6\xf7\xee\xf4\xcf\x6f\xda\xa5\x16\x66\x32\x9d\x08\x1c\x85\xde\x04\x6b\x92\x07\x9f\x8b\xda\x60\x5b\xd6\xab\xd8\x7c\xca\xe7\x26\x62\xb6\x63\x18\x7d\x36\x43\x25\x92\x1f\x97\xfe\x0c\xce\xfe\xa3\x99\x2b\x3f\xab\x7b\x3f\xcb\x54\x41\x97\xfa\x5d\xed\x8f\x91\xb2\x49\x12\x12\x53\x4e\xbf\xdd\x6a\x96\xba\xa4\x55\x45\x2d\x71\xe1\xc5\xce\x52\x97\xe3\x67\x35\xe6\xa8\x8d\x7b\x51\x25\xf4\x15\xc6\x13\x52\xb8\x69\xf8\xc1\x26\x65\xc1\x53\x09\xf7\xd1\x79\x4a\xec\x33\xfa\xeb\x1d\x26\x68\x8e\x24\x7d\x27\x29\x70\x76\xde\x79\xc3\x31\xf1\x54\xb8\x30\x99\xb7\xe4\x51\x01\xc9\xf1\x22\x01\x7a\xf8\xac\xc5\x50\x81\xab\x54\x9b\xde\x55\x56\x38\x39\x6b\x99\x5a\xfb\x6b\xf6\x61\x93\x99\xd5\x50\xac\x79\x21\xb7\xac\x96\x1a\xed\xb1\x99\xb0\xb9\x0e\x7b\x12\xa6\xe8\x3d\xef\x3b\xd4\xa0\x61\x45\x30\xac\x05\xe8\xe3\xbd\x8d\x67\x12\xe9\x41\x3a\x11\x3d\xa9\x96\x50\xd8\x9b\x6f\xf0\xe6\x9d\x69\x1f\x6a\x16\x8b\x67\xe2\x9d\xd4\xd4\xb8\xa6\xdc\x76\x13\x6d\x3c\x89\x40\xb2\x64\xeb\xc4\x7f\xec\xef\x4b\xc9\xb6\x90\x81\x50\x8e\xb5\x45\x5c\x37\x87\x6d\x92\xf8\x24\x4e\x29\x78\xfb\xde\xdb\x0f\xe9\x7e\xdd\x23\xeb\x3c\x7b\x38\x0e\xa4\xd7\xf4\x14\x21\xfa\xf6\x4d\xe4\x26\xcc\x46\x7b\x74\x42\xb2\x14\x5f\x90\xb9\x28\x0a\x6b\xfc\x46\x72\x33\x4b\x91\x90\xbb\x51\x22\xed\xe4\x47\x3a\x71\x67\x2f\x40\x8f\x58\x35\x82\x4e\x55\xeb\xee\xbc\xee\x05\x74\x8a\x26\x98\xca\x99\xcc\x19\xb3\x87\xa5\xae\xbc\x48\x23\xc2\x1c\x09\xe0\xb5\x9d\x18\x5b\x87\x44\x9b\xf6\x67\x07\xc7\xba\x03\xae\xa9\xcc\x7b\x5c\xaa\x5e\x93\xd0\xaf\x9b\x4c\xfe\x33\xbd\x17\xe0\x1a\x9a\x82\x4a\xd5\x8e\xe2\xb4\x09\x57\x77\x3b\xeb\x82\xee\xb3\xb5\x9b\x50\x06\x7b\xfa\xd1\x23\x37\x93\x09\xe2\xd1\xab\x9a\xe7\x49\x97\xe7\x4c\xba\xdd\x05\x2c\x60\x86\xef\x57\x6e\x62\x8c\x5c\xd5\x8a\xa0\xa1\x1c\x8f\xef\x1a\xc4\x26\x70\xec\x45\x3f\xec\xce\x2e\xe1\x55\x05\xa5\x85\xcc\x5f\xe9\x50\x47\xf3\xf5\xcc\x7e\x14\x19\xd3\xd4\x8f\x06\x0e\xbc\xe1\xd8\xb7\x93\x22\x97\xb2\xc2\x5c\x5e\x98\xf5\x1f\xb0\x6f\x3c\x0f\x07\xc1\x1f\x15\x8c\x26\x20\xde\xc1\x16\x2b\xb2\xab\x2b\x75\x4b\x63\x9c\x5b\x26\xcc\xf4\xf5\xbb\x8c\x17\xaa\x8b\x43\xdb\x40\x97\xcc\x74\x0f\xd3\xb9\x6d\xf8\xc6\xa9\x19\x8e\xaa\x3d\x78\xf0\x25\x68\x72\x19\x53\xb5\xbd\xfc\xb1\xd0\xce\x0a\xa1\xde\x80\xe3\x54\x4b\xc0\x6b\xdc\xed\xfe\x40\xb0\x2f\xa2\x4d\xdf\x5a\xa9\x9d\xf5\xbf\xdf\xc0\xa8\x80\x71\x13\xa3\x20\x9d\xef\xee\x5e\xb7\xb3\xb5\xd7\xd9\xb5\x48\x81\x17\xd4\x6a\xb6\x68\xaa\x71\x23\x33\xe9\xac\x92\xdf\x62\xd6\xd0\x87\xee\x4e\xc3\xde\x51\x59\x23\x9a\x69\x22\x54\x41\xf5\xc2\x9d\xf4\xfd\x4d\xc5\xec\xaf\xb5\xd4\x4d\xad\xbc\xbe\x49\xb8\x47\x80\x2f\x97\x25\x34\x8d\x8d\xc5\xc4\xbb\x91\xca\x34\x64\x7f\xca\x0e\x89\xbd\xd3\x9d\xeb\x79\x73\xcd\x01\x73\xe5\xca\xd1\x92\x57\x41\x8e\xb9\xb4\x1b\xb3\xd0\x3e\x86\x46\x1c\x46\x62\xbb\x01\x7b\x6f\x46\x90\x74\x12\xa6\xc7\x2e\x38\xb1\x36\x1b\x70\x2e\x2c\x3f\xee\xd8\x06\x33\xcd\xd2\x84\xc3\x65\x8c\x4e\xfe\x34\x9f\x88\x14\x5f\x25\x80\xb0\xd1\x2f\x1a\x0e\xcf\x32\x84\x16\xe2\x58\x31\x87\x41\x90\xfa\x81\x67\x4e\x98\x23\xcd\x19\xcb\x00\xff\xfe\xc1\x3b\xf9\x2f\xb5\x87\x11\x82\x93\x3a\xed\x83\xe1\xf9\xbf\xd9\xcb\x5e\x06\xe5\xb9\xfd\x1f\x1b\x8e\xaa\x5d\xe4\xe3\x12\x08\xef\x8b\xfa\xe2\xe0\x94\x3c\x34\x3d\xef\x8b\xc6\xbe\xb6\xc6\xda\xc4\x9b\x6e\x6a\xc7\x61\x26\x87\xbd\x38\xa0\x12\xa9\xce\x07\x6e\x0c\xea\xd6\xf9\xbf\x31\x36\xa1\x9e\x33\xbd\x69\x54\xbf\xcc\x98\x49\xba\xab\x8e\xe0\x06\xb8\x21\x3d\xc3\x3b\x7a\x55\xec\x1f\x27\x8c\x7f\x30\x74\x06\x2d\xdc\x94\x75\x05\x68\x0d\x3e\x96\x9d\xba\x8f\x99\xfc\x86\x2e\x79\x5b\x9a\xf2\x82\xdd\xb0\x6f\x87\x84\xad\xdc\xc8\xc4\xa7\x46\x2c\x36\xff\x68\xe9\xbf\x23\x69\x43\xa1\x3f\xf4\x98\x14\x41\x64\xe6\x3b\xa9\x72\x68\x82\xd3\x20\x85\x7d\x25\x0f\xe7\xfb\x0c\x57\x17\x97\xb3\x45\x95\x33\xd1\xc8\xd1\x44\x4f\x29\xd3\x9c\x14\xb0\xb1\xd9\x7c\x70\x8b\x3c\xbc\x5c\x38\xf0\xc9\x32\xd0\x9e\xc8\x9e\xcd\xb4\xba\x4f\x64\x76\x67\xc1\x20\x76\xb7\x88\x8c\x53\xe9\xea\xc6\xf4\x7c\x76\x61\x94\xe3\xc2\x51\x39\xad\x0f\x38\xbb\x17\x56\xf6\x6a\x31\x2b\x8f\xcc\x49\xde\xf0\xb0\x13\xdb\x27\xab\x3b\x91\x8f\xdb\xd2\x0f\xa5\xa7\xe0\x79\xcc\xd6\x8b\xec\xe0\x84\x83\x98\x2d\x57\x2f\x39\xc6\xe9\xd0\x27\x5e\x4b\xc7\x4b\x22\x21\x3a\x6f\x65\xe2\xe4\xfc\x56\x7b\xe0\xeb\xec\xce\x20\xaa\xee\x8c\xc9\xd8\xac\xdc\x78\xa9\x51\xdb\x4c\x08\xa5\xac\x5b\x3c\x57\x7a\x24\x72\x3e\x9a\x36\xc4\x77\x41\xd6\xde\x62\x87\xb1\x6d\x3a\x2e\x80\x3e\xdc\x18\xa0\x59\x62\xfc\xcc\x04\x51\x64\xd5\x89\xb6\x88\x33\xf6\x7c\xfd\xc4\x05\x13\x2d\xee\x5c\xb7\xba\xf8\x72\xa7\xf6\x25\xb0\x70\xf0\x7d\xc7\xa9\x1f\xab\xde\xe8\x53\xb6\xb1\x50\x8c\x58\x20\xb5\x25\xa7\x1a\x9d\x1f\x74\xc1\xb0\xd7\xa8\x9a\xf3\xee\xd0\x7c\xdc\xdb\x2d\x3d\xe3\x50\x54\x75\x35\xbb\x57\xfa\x81\x89\x59\xa4\xdd\x29\xb4\x58\x1f\xc0\xf6\x26\xba\xd2\x64\xfb\x8d\x57\x54\x23\xe6\xc7\x74\xe7\x0d\xd8\xd1\x81\xe1\xb7\x6b\xf7\xb2\xd0\x0c\xfe\x00\x62\xbe\x0f\xa6\x08\x3b\x6b\xe7\x11\x58\xba\xe8\xa5\xf6\x07\x22\xf2\x71\x8e\x4c\xfe\x11\x58\xdf\xbc\x4b\xae\x59\x6b\x8c\xbb\xb6\x2a\xcd\x15\x6f\x7d\xae\x47\x59\xfb\x2a\x4a\x9e\x37\x3d\xb3\x53\x5d\x82\x92\x30\xb7\xc7\xea\x10\x9e\xb7\xeb\x2e\x83\xda\xc0\x86\xb8\xd8\x78\x9f\xfc\x36\xc9\x25\xf0\x36\x02\x8d\xf3\x42\xfb\x02\xe4\x68\x73\x38\xda\x73\x42\xb1\xf6\xfc\x7b\x19\xe8\x44\xd1\x41\x14\xbd\x0c\x67\x32\xf7\x39\x7e\x12\x70\x34\x97\x9c\x75\x52\xaf\xbe\x08\x25\x1a\x32\xb6\x89\x17\x07\xa6\x0a\x3c\xa4\xbc\x17\x5d\xdb\x14\x0c\xaf\x67\xe3\x72\x7c\xcb\x75\x7c\xda\xe8\xe0\x30\xba\x60\x91\xd9\x36\x67\xe8\x59\xb9\x76\x7f\x72\x7a\x5a\x73\x14\x2f\xfa\x31\xac\x54\x3b\xd5\x5a\x26\x1e\xe1\x74\x09\xa4\x20\x33\xd7\x1c\x64\x65\x97\xf8\xbc\x46\x3d\x21\xdd\x88\x1b\x98\x8b\xd2\x2e\xc7\x5d\xad\x75\x86\xd0\xea\x7b\xfa\xf6\x07\x0b\x6b\x46\x2d\xfd\x05\x0e\x71\x06\x95\xe2\xd6\x1d\x08\x15\xdb\xac\x21\xb5\x21\x84\x5c\xa1\x6b\x9e\x95\x5f\xd5\x42\xc6\xe7\x3a\x26\xde\x4e\x68\xed\xca\x48\xcb\x14\x36\x93\x72\x21\xa2\x78\xe0\x2b\xa8\x50\xc1\x3a\x3e\xd7\x6f\xaf\x8c\x6b\xe2\x25\xf6\x74\xaf\xbe\x48\x0d\xa8\xdf\xdc\x97\x95\x1d\x15\xa8\xd4\x9e\xf4\x34\xd7\x8e\x43\x27\xae\xd4\x7d\x28\x69\xd5\x6e\x54\x17\xd7\xa6\x45\x2b\x91\x9d\x56\x24\x14\x52\xb7\xa1\xd1\x5c\x27\x08\x2f\xda\xef\xd8\x87\x56\x23\x2b\x37\xec\x29\x74\x3d\x04\x18\x0c\xf2\x9a\xfb\xf3\x0f\xee\xad\x22\x2d\xb7\xf9\xf4\xc5\x8d\xc4\x30\x6b\xef\x45\x26\x67\xb9\x35\xef\x3f\x08\xb5\x5e\x6b\x57\x4f\x1e\xc4\x29\x81\x80\x41\xdd\x4b\xd0\x2e\x88\x9f\x93\x52\xfc\x02\xc4\x4d\xd9\xb9\xb0\x09\xb7\xb7\x43\xf5\x40\x84\xb9\xcf\xcc\x09\x04\x63\x15\x55\x54\x62\x62\x89\xec\x3e\xcd\x2a\xab\x7a\x6a\xb2\x87\x2a\x92\xc8\x73\x17\x07\xd3\x5b\x33\xe5\x39\xe7\x47\x93\x80\xf0\x79\xc4\x7b\x35\x18\x54\xee\x8f\x58\xa1\xa6\x35\xe7\x67\x28\xf9\xe5\x08\xf0\xad\x10\x01\xde\xeb\xbc\x7b\x85\x55\x7d\x93\x91\x99\x4a\x10\x3c\xbe\xf8\x46\xd8\xc1\x29\x2d\xcd\xc2\xc7\x71\x9d\xd2\xb8\xe5\xe1\xdd\x56\x1a\x71\x1a\xaa\xc9\x21\x81\x56\x8c\x79\xe1\x08\x79\x77\x24\x9c\xc6\x96\x8c\xcd\xbe\x41\x10\x5f\xa4\xe8\x8c\xa8\x61\x81\x11\x91\x59\x86\x6b\xaf\x60\x6b\xea\x06\xc5\xdc\x1f\x14\xe1\x01\x5c\x3b\x82\xc9\x92\x4b\xc7\x61\xfe\xcd\xe6\xf7\x71\x47\x6e\x20\x9c\x06\xcf\xfd\x60\xdd\xd9\x6a\x62\x45\x52\x4a\x9f\x5d\x7d\xb5\xf5\x6b\x14\x43\x45\x77\xaa\xbd\x42\x72\x3c\xcc\xf1\x57\xc1\xef\x95\xf7\x0e\xdb\x2e\x8f\xd1\x80\xc3\x4f\xec\xe5\xe0\x8d\xf7\x13\x8d\x86\x42\x65\xda\x56\x56\xb1\x94\x54\xa7\x35\x84\x23\x43\xcf\xef\xa4\x92\xf3\x6f\xae\x0b\xaa\xcb\x97\xae\x85\x9a\x47\x81\x1c\x90\x38\x1c\xac\x16\x1e\x28\x51\x02\xd3\x6b\x72\x70\x6c\xcb\xaf\xa4\x06\x58\xe0\x7e\x50\xd5\xdc\x2a\x6c\x00\xae\x39\xac\x9e\xef\xda\x50\x9e\xd9\x6f\xf6\x09\x18\x0b\xb1\xed\x8a\x8c\x51\xdb\x33\x2d\x55\xde\x5f\x4f\x25\x0a\x8a\xef\x6d\x2e\xc6\x54\xd3\xfc\x22\x45\x02\xee\xcb\x12\x95\x7c\x15\x5a\x38\xb3\x67\x08\x4e\xfa\xd6\x98\xd1\xe0\xaf\x8c\x1d\xa0\xdd\x02\x46\xa9\x05\x5e\xdf\x33\x0d\xf3\x70\x0e\xcc\x52\x4b\xe9\x2e\x40\x8c\x90\xee\x0c\xf2\x4d\xfe\xbc\x65\xca\x96\x05\xfa\xd0\x05\x0b\xf5\x2c\xa9\x46\x72\x99\x5c\x17\x8f\xb2\x92\xd1\x95\x64\xe2\xfe\x73\x51\x24\x6c\x82\x63\x3d\x18\xef\x95\x19\x14\x38\xf3\x61\x50\x72\x08\x19\x18\xbf\xc2\xc6\x36\x8a\x60\xfb\x2d\x1a\xc7\xb7\xeb\xab\x7f\x03\xda\x62\x9d\x9c\x10\x1e\x7a\x6a\x40\x89\x7f\x46\xdc\x7d\x24\xb4\x7b\xfb\xce\xc4\xca\x08\xce\xb2\xa3\xab\xa5\xe3\xcc\xbb\xf9\x74\x51\xe3\x7e\xae\x37\xff\xa3\xdf\x89\x60\xa4\xe8\xad\x5c\x94\xf7\x97\xe5\xd2\x7d\x7d\x55\x72\x0a\x0f\xb5\x97\x46\x4f\xd7\x93\x95\x96\xb6\x9d\x25\xfb\xae\x5b\x17\xe8\x42\x7a\xaf\xa4\x1a\x03\x91\x51\xe1\x77\x24\x3c\x3b\x31\x2a\x89\x9d\x3a\x51\x87\x6a\xf2\x6e\x5a\x26\xe3\xf5\x6c\x29\x30\xf6\x03\x61\xfc\x79\xd6\xbe\x34\x82\xf5\xf9\xee\xfc\x6b\xdf\xcd\xdb\x58\x3b\x2d\x21\x26\xcd\xc7\x66\x53\xd6\x87\xfd\x29\xbd\x50\x5a\xf2\x61\xd5\x0a\x53\xc2\x29\xc6\xf1\x42\xae\x83\xdb\x6d\xba\xeb\xe4\x5d\x24\x6d\x7f\xfb\x7b\xe6\x76\x47\x24\xe3\x20\x15\xf7\xab\xe5\xf6\xf2\x46\xe4\x98\x90\x62\x21\x44\x62\xdd\x93\xa7\xb7\x38\x96\x8b\xf0\x70\x3a\x84\xbd\x87\xdf\xa8\x11\x3e\x62\xe1\xf2\x3b\x12\xcd\x31\xf4\xba\xb5\xac\x89\x8d\x88\x5c\x4b\xa5\x34\xe9\x83\xec\x1e\x1e\x04\x07\x87\x12\x7f\x2e\x97\x2a\x40\x9f\x44\x83\x62\x37\xb2\x3e\xe1\xfd\x6a\x34\xfd\x8e\x26\x83\xd5\xce\xb7\xa5\x65\x8d\x3f\x42\x60\xd6\x57\x3c\xc0\x92\xa0\x60\x4f\xc0\xec\x58\xcd\x9e\xba\x77\x6f\x6b\x21\x39\xfc\xaa\xc8\xf4\x1d\x22\xe8\xd3\xe2\xac\xf2\xc4\x33\x12\xb2\x20\xb2\x4c\xfa\x31\x0d\xf6\x6f\x12\xed\xb2\x56\x6e\x5e\x2b\xdb\x76\x88\x10\x88\x30\x91\xa4\x4e\xde\x97\xea\xfe\x24\x3c\xdd\x72\xe1\xea\x66\xd2\x5a\x80\x7e\x20\x21\xf5\x1e\x03\xed\x99\x6d\x93\xcf\x27\xfb\x5e\x85\xf2\x70\xfc\x19\x95\xaa\xec\xd8\x9d\xfe\x79\x13\x68\xc6\x86\x2b\xfa\x34\x23\xb7\x70\x86\x96\xf4\xcd\x13\xe7\xac\xb0\x68\xf6\x03\x4c\xd2\x6a\x5d\x3f\xb7\x88\x7c\x69\xbf\x90\x45\xd6\x84\x9a\x25\xad\x25\x43\x57\x27\xd4\x84\x9d\x82\x96\xba\x76\x72\xf7\xf3\x95\x11\xfa\x2b\x0f\x3c\xe6\x88\x65\xb9\xa7\xd6\x3f\xd3\xd3\x0c\x03\x2f\xa3\xa5\x1b\x6d\xd9\x88\x8f\x57\x67\x06\xe2\xd3\x5e\xc5\x46\xab\x4b\x85\x2d\x46\x78\xf1\xca\xa8\x37\x59\x27\x03\xe4\xc2\x4a\x41\x7d\xc5\xcc\x28\x15\x7c\x99\xa3\xa4\x26\xe8\x39\x1c\xb0\x3e\x40\x39\x16\x24\x1c\x82\xe7\xa9\x1a\x23\x83\x91\xe3\xb6\x32\x3f\x6b\x83\xa4\xd6\xc1\x70\x17\x5c\x27\x6f\xaa\xdd\xb8\x0d\x6b\x08\x1a\x88\x1f\xb8\x60\xa1\xaf\xed\x61\xa9\xad\x07\xfb\x94\x8e\xe7\x57\xd8\x34\x02\x76\x14\xbf\x1f\x9c\x46\xf0\x0a\xd9\x31\x6c\x9d\x16\x09\xc0\x2c\x87\x20\xde\x64\x38\x43\xde\x98\xcf\xee\xac\x4a\x1f\x28\x5f\x54\x6d\x59\x3b\x4e\x11\x30\x3d\x50\xc0\xb7\xa1\x78\x1c\xe9\xa9\x16\x63\x4c\x28\x2c\xeb\x84\x90\x96\x45\x26\x1f\xf2\xef\xe5\xb1\xfb\xd5\xf4\x41\x90\xb4\x27\xf5\x12\xfe\x07\x15\xd0\x21\xe6\xe2\x2b\x8b\x8c\x7e\xc9\x49\xc5\xa2\xe3\x26\xdc\x33\x94\x69\xa9\x1e\x7f\x61\x6c\x68\x3c\x12\x5a\xaa\x3f\xc1\x6d\xee\x72\xd0\x53\x2b\x96\xfd\x11\xf7\xcc\xc8\xda\x72\x3b\x9b\xe0\x5b\x71\xfd\x9b\xac\xc5\x9c\x86\xb9\xd6\xc3\xf1\x06\xef\x06\x53\xb3\x20\xba\xeb\x3e\xcb\xac\x1f\xf7\x90\x9e\xbf\xaf\x3e\xb6\xf9\x5a\x0f\x52\x61\x0e\x8c\xff\x85\x55\xa7\x2d\x58\x1c\xe3\xd7\x14\xbb\x2e\xa5\xbf\xce\x60\x9a\x7e\x3d\xcb\xdc\xce\xc1\xd9\x38\x9e\xc0\x95\x39\x4b\x95\x10\xca\xa9\x62\x4a\xc4\xd3\x26\x39\x52\xc8\x8c\x49\xd5\x2c\x0e\x07\x89\x20\x12\x47\x4a\x38\x0a\xb2\x7b\x12\x55\x58\x71\x9a\xdf\x8c\x05\x64\xca\x62\x4e\x60\x9d\xf5\x9c\xfe\x12\x01\xde\x09\x1a\x8a\xcf\xeb\xd5\x5a\x17\x54\x7d\xbe\x1f\x38\xef\xe2\x1b\x18\xbe\xf0\x82\xc7\xae\xf5\x10\x16\xc7\x62\x2d\xe4\xa5\xd5\xa3\xcf\x8a\x38\x7e\x9a\x1c\x70\x5d\xd6\xa7\x94\x75\x6d\xf1\x5d\xd1\x17\x91\x6d\x07\x9e\xd4\x32\x30\xde\x39\x43\x71\x82\x16\x1f\x37\xf3\x85\xdf\x1a\xad\xb0\xdd\xe3\xe6\x6c\x3c\x07\x0a\x7b\xd2\xc7\x4b\xcd\x37\x62\xf8\x73\xa3\xfc\x78\x59\x97\x3d\xb9\x71\x65\xb4\xd7\xa1\xbe\x98\x4d\xc0\x6c\xc0\x58\xc3\x98\x41\x73\xba\x3b\x13\x91\xca\xaf\xff\xd4\x86\x94\x2f\x4a\x73\x93\xad\xb5\xe5\x8d\xe4\x1c\x9a\x48\x98\xa0\xd0\x75\x4a\xef\xa8\xc7\xfd\x27\xa3\xab\x89\x2f\x21\x84\x0f\x7f\xb9\xaa\x3a\xc7\xb0\x74\xfb\x0d\x2c\xfd\xf0\x15\x03\x76\x15\x1a\xf6\x19\x61\x59\x56\xa7\xb2\x5c\xdd\xb9\xe4\x60\xb7\x0d\x1d\x27\x17\x7c\x58\x18\x2d\xe2\xe7\x93\x6f\x62\x71\x64\x6b\x0d\xf5\x55\x10\x7f\x58\x93\x21\xe4\x78\x83\xff\xb3\xe1\x36\x19\xfd\x1f\xd7\x07\x9c\x7d\x25\xd9\x0c\x0f\x33\x58\xea\xf5\xa1\xec\x91\xf9\x40\xce\xa6\x0f\x83\xd9\xda\xfe\x80\x19\xfa\xf8\x4c\x7c\xbc\xd1\x57\x94\x1b\x7f\x3f\x1e\x85\xd0\xfb\x41\x50\x03\x13\xcc\x87\x58\xf3\x14\x7d\x67\x55\x0c\x54\xd3\x71\x4a\xdb\xdf\x1e\x59\x1f\x43\xe9\xcb\x04\xb9\xec\x20\x60\x26\x32\xc6\xd1\x89\xa9\x77\x69\x3c\x75\x6d\xfb\x59\xd5\xbb\xfc\x13\xd7\x65\x62\x67\x5d\x26\xdd\x14\x27\x66\x31\x13\xce\x1c\xfa\xe5\x45\x81\x41\xc7\xb6\xe5\x04\xc7\x97\x88\x03\xc6\xa9\xa2\x68\x0a\x5d\xaa\x7b\xdf\x34\xef\x8d\xc2\x95\x62\xe6\x30\x9e\x56\xdf\x88\xbb\x5f\xfc\x12\xc6\xc1\x0c\x47\x5b\x77\xea\x45\x07\x1d\xc0\xdf\xd6\xbb\x58\x2c\xf1\xba\xcd\x81\x50\x54\xfa\x03\x09\x0f\xda\xb9\x4a\x68\xcf\x2a\xfd\x0c\x47\x8c\x0f\x68\x77\xee\x07\x69\x6f\x69\x70\xf5\x8f\x0f\x82\x94\x8c\x2b\xb2\x76\x2f\x13\x4f\xfb\x9b\xd0\x1b\x1c\x06\xe5\xdb\x66\x2c\x5a\xfb\x97\x91\x3a\xfa\x59\xd7\x79\x95\xba\x6f\xba\x30\x9f\x53\x45\xd5\x75\xc9\xee\x0e\x53\x53\xf4\x43\x72\x91\xb6\x5d\xa9\xd0\x12\x94\x99\x54\x4c\xfb\x4a\xfc\x32\x4c\xe1\x60\x88\x00\x06\x30\xfa\x16\x28\xca\x85\xc2\xdc\xbb\xa8\xd2\x02\x90\x74\x4a\xbe\x16\x30\x5b\xe3\xcb\x67\x02\x31\x33\xec\xae\x27\x4f\xda\x0c\xa6\xce\xdd\xd6\x6b\xea\xaa\xf8\x22\xa1\x0e\x83\x60\x2e\x1e\xe8\x79\x83\xe0\x57\x35\x2b\xec\xbd\x84\x1e\xd8\xf8\x1d\x82\x57\x41\x32\x16\x72\x3d\xea\x3b\x4a\xe3\xcf\x62\x87\xfb\xab\x52\x1a\xd7\x4f\x00\x0d\x82\xa9\xd2\xdc\xda\xb9\x13\x48\x4f\xc7\xdf\x84\x00\x38\x4a\x8a\xe8\x71\x08\xba\x9f\x42\x80\x95\xc2\x03\xee\xd9\x03\x01\x49\x76\xc3\xaa\xf7\xc5\xdd\xc4\x76\x0f\x50\x5c\x1f\xb9\x8b\x29\x2e\xb1\xb6\x85\xa3\xc2\x39\x5b\x1c\x2e\xb1\x41\x6d\xd6\x49\x02\x3c\xe1\xd6\x4a\xa7\xbe\xf4\x7f\xd4\x00\x11\xb0\x80\x03\x2e\xe9\x6b\xa4\x52\xd6\xcf\x3b\x23\x10\xaf\xd4\xc4\x75\x18\x4e\x62\x41\xf3\xb2\x7c\x91\xf2\x08\x78\x47\xe9\x51\x2f\x99\xde\x8a\x70\x52\x14\x92\xf2\x57\x42\x4b\x5e\xf2\x0c\x57\xd6\xa1\xa8\xa3\x61\x14\xa3\xb8\xc7\x17\x72\x28\xc0\x5e\x7a\xc0\x03\x7f\x21\xe8\xd1\xea\x79\x40\x81\x40\xcc\x51\xa9\x2d\x3a\xce\x5f\x45\x02\xcc\x74\x2b\x57\x80\x22\x5c\x47\xf3\x8b\x39\xe5\x33\x14\x60\xc4\xae\xc3\x96\x61\x7d\xe9\xfd\xd1\x73\xf3\xed\x24\xcf\x00\x8a\x79\x55\x38\x7b\x65\xf0\xfe\xe1\xcb\x88\x98\x4a\x39\xb0\xe7\x64\x80\xff\x2d\xdd\x3b\x9b\x78\xa9\xf9\xbd\x97\x6d\xce\x98\xf8\x5a\x20\xfa\xbd\xfe\x44\x0a\xd9\x66\xe2\x2e\x27\x6d\x90\x17\x8a\x2d\xfb\x31\x3d\x1a\x0b\x72\xaf\x9f\x38\x0b\xf2\xe7\x30\x09\x2a\xd3\xfc\xba\xac\x7d\xea\x5c\x96\xda\xa4\xad\xc9\x1c\xc3\x00\xae\xf6\x04\x3f\xb4\x4e\x5d\x72\xea\x66\xf8\x59\x06\x94\xad\x85\xf8\x97\xec\x6c\x86\x53\x2a\xf3\x29\x66\x93\x06\x52\xf4\x6c\x24\x8f\x49\x1b\xa3\x90\xdc\x3d\x81\x14\xbe\x6a\x98\x8c\x52\xba\x78\x0f\x0a\xfe\x37\x5c\xc6\x60\xe8\x9c\x53\x2b\xaa\x04\xbc\x27\xca\x9b\x79\x34\xb7\x04\xce\xab\x87\x4a\x02\xaf\x92\xe7\x22\x10\x70\x31\xcc\xee\xac\x3d\x71\xf0\x17\xe0\x79\x06\x1a\xd3\x9f\x80\xc3\x70\xcb\x4a\x58\x6a\xdc\xc7\xc2\x6c\x31\x78\xb4\x4c\x8b\x83\x31\x82\xd3\x7b\x65\x22\xfd\xf4\x14\x7d\x6c\xbf\xb7\x49\x98\x14\x9f\x2a\x6e\x7d\x52\x3a\x85\xb3\x57\xbe\x6b\x87\x3f\xff\xd2\xce\x9a\x90\xfa\x2f\xae\x7a\x68\x00\x81\x81\x40\x14\xca\x64\xa6\x9d\x54\xae\x06\xa9\xee\xf6\x86\xbe\xc4\xd3\xbe\x82\xd2\x7d\x04\xc8\x61\x3f\x72\x8a\xd7\x37\x8d\xbb\xe9\x1c\x05\x3f\xbe\x33\x57\x3b\xb7\x4e\xc4\x87\x4a\xf2\x06\x13\xd2\xf1\xcc\x69\xcf\xd4\xf9\x88\x79\x57\x8e\xb9\xaf\xaf\x20\x5d\x16\xea\xbb\xf8\x8e\x32\x20\xf7\x2b\x82\xc6\x0d\xae\xfe\x19\x96\xb6\x98\x58\x97\xc3\x30\x8f\xe5\x16\x2a\xfe\xc5\x34\x78\xc2\xbb\x6e\xb1\x17\xa0\x6a\xc7\x05\xae\xad\x8c\x58\xfe\xb6\x2e\x91\x49\x47\xfe\x9a\x61\x8b\x77\x88\xc0\x1d\xfd\xee\xaa\x5a\x07\x59\x17\xdf\x29\x09\x34\xd6\x9c\x85\x5c\x58\x8c\x59\x73\xc7\xc3\xde\xf9\xb4\x43\x51\x5b\xf4\x32\x87\x05\xf1\x83\x04\xd4\xfd\x85\x03\xb0\xfc\xcb\x5e\xc6\x48\xa0\x1c\x3d\xd9\xd0\x82\x11\x05\x12\x9a\x2a\x76\x65\x52\xd8\xd0\x1f\xf2\xaf\xfd\x39\xb3\x53\x3b\x3c\x4a\xd7\x61\x82\x78\xc6\x22\xe2\x7a\xaf\x36\x60\x9d\xc8\xb2\x27\x26\x34\x49\x90\x4e\xee\xa3\xda\x34\x30\x11\x65\x64\xee\xde\xa1\x2a\xfe\x53\xc6\xa9\x59\xd8\xa8\x2b\xa5\xd2\x34\x00\x63\xa7\x45\xa1\xb2\xeb\x68\x8c\x48\x53\x8a\x39\xe7\xb2\x2e\x76\x7b\xfa\x56\x78\x7d\xa8\xda\xab\xe3\x1d\xfb\xf1\xf4\x51\x90\x15\x12\xe3\xb0\x4d\x0a\x8e\x77\xd9\x44\x6a\xca\xb4\x7e\xae\x58\x1c\xbb\x73\x2e\xcd\xa4\xa7\x27\xca\x0d\xf9\xe9\x19\xe8\x9e\x83\x5b\x31\x5b\xf4\x40\xb9\x13\x26\xd1\xe7\x92\xe4\x19\xfc\x9f\xfa\xed\x4a\x4c\xc0\x96\xfd\x5f\x43\x29\xce\xb4\x68\xe7\x75\x01\xa9\x21\x2f\xa1\x0c\xaf\x2b\xe3\xe7\x36\x62\xec\xef\xc6\x61\xf0\x2e\xbf\x0c\xd2\x79\x2f\xb7\x9a\xc2\xe1\x27\x36\x47\xaf\x59\x6a\xaf\xb5\x06\xfc\xe7\x91\xe7\x7f\x09\x32\x44\xf3\x78\x10\xa8\xdb\x19\x06\xd8\xcc\xfc\xd1\x9b\x4a\x49\xbb\x7c\x1a\x5e\xa4\x05\x9f\xbf\x43\xd6\x45\x91\x39\x2d\x56\x21\xc2\xc3\x9f\x8a\x44\x12\xab\xe7\x32\xf9\x1e\xef\x94\xb9\x3d\x26\xab\xea\x04\xc1\x97\x89\x96\x51\xbd\xe4\xa4\xf2\xae\x79\xdf\xcf\xc7\xc1\xc1\x94\x44\xcb\xae\xf5\x79\x9f\x2d\x16\xa1\x94\x1a\x0d\xfa\x64\xfb\xff\x68\xfe\x1f\x90\x0c\x55\x7d\x91\xf1\x6f\x96\xc5\x6c\xe3\xf9\x3a\x54\x22\xa4\xae\x03\xdb\xc0\xd6\x2b\xf8\x34\x12\xfc\x5e\x28\x9c\xb3\xd8\x73\x77\x53\xc1\xc3\x2f\x7a\xea\x84\x1f\x29\x9f\xf5\xb5\x44\x1d\xcd\x6c\xd8\x89\x17\x68\x92\x23\x0e\xc1\xc2\x97\x9e\x2e\x80\xb0\xea\x4d\xad\xc7\x3b\xa1\x61\xde\xf8\x14\xcd\x8b\x47\xc0\xba\xce\x91\x64\xad\xf6\xa0\x5e\xd1\xc9\x06\x53\x80\x49\x7b\x2b\x6e\x7c\xbf\x21\x25\x25\x85\x7f\x63\x84\xa0\x45\x31\x06\xc1\xa1\xb6\x69\x70\x80\xe3\xcb\xa6\x1b\x66\xe4\xe6\xea\xc6\x9b\xbf\x0d\x0d\xc5\x9d\x4b\x86\xd9\x86\x81\x1e\xfb\x07\x99\x63\xc8\x8e\xe3\xe4\xa3\xe3\xd0\x70\x32\x87\x62\xf1\x1d\xc9\x2e\x1e\x4c\x0b\xef\xd5\xd6\x5d\xd9\x7e\xc6\x28\x36\x76\x4d\xac\x16\xa1\x7f\x82\xed\x0b\x2d\x93\x04\xc5\x2f\x50\xc4\xb7\x69\x5a\xcf\x3f\x3a\x43\x07\xb5\x82\xe6\x31\x8a\xa0\x70\xd5\x64\xd8\x0c\xc5\x23\xd6\xae\x05\xd0\x05\xdf\xe0\xc0\xf4\xec\x47\x7e\x01\x77\x09\x15\x48\x3c\xad\x3a\x6b\x3f\x90\x5e\x52\x52\x52\x22\xcd\x0d\xde\x84\xfb\xb1\x50\xd6\x4b\xa3\xc1\x6d\xf4\x95\x16\xfb\x2f\xfe\x76\x3e\x60\x87\x70\xc0\x76\x05\xe0\x88\xd9\xbb\x8e\x19\x39\xe4\x10\xa0\xbc\x38\xc8\xb9\x36\xe5\xb8\xc7\xa5\x61\x56\x33\x58\xda\xee\xe4\x1d\x2d\x41\x00\xe9\x9b\x99\xdf\xdf\x64\x9f\x79\x47\x5f\x9b\x84\x56\xaf\x9d\x73\xa7\xb9\xaa\x14\x3e\xb4\x67\x06\xbe\x14\xe6\x16\xc9\x7e\xf0\x4b\x24\x3b\xab\x47\x9c\xad\xdb\xa6\xbf\xc0\x05\xe4\x6d\x88\x47\x42\x35\x93\xee\xae\xde\x00\x39\x9e\xe4\x82\x0b\xb3\x97\x6d\xab\xe6\xb5\x3f\x42\x43\x07\xf0\x7f\x4e\xe8\x78\xb0\xaf\xfa\x67\x45\x28\xfe\x40\xb7\xd9\xe8\x52\x5f\x8a\x00\x71\x23\x8e\x13\x77\xea\x74\x03\xbc\xf4\xab\x7f\xc5\x55\xb9\xd3\x60\x22\x27\x6b\xbd\xd8\x16\xd8\xa9\x22\x1f\xdc\x41\xd5\xae\xa5\x05\x34\xb0\xce\x2b\x12\x95\xf0\xa9\x68\x52\x55\x6c\x5b\xc2\x41\xdc\x4f\x29\x8d\x6c\x17\x07\xc5\x1a\x1f\x34\x98\xb5\x71\x68\xbc\xba\x45\x0e\x18\xdb\x10\xc5\x27\x2c\xbe\x87\x17\xff\x45\x0b\xf0\x96\xc8\x1c\xe8\x27\x66\xf7\x24\x91\x78\xbd\x02\xbe\x79\x30\x06\x92\xf1\x94\x7e\x1b\x6d\xc2\xc7\xe8\x7e\x17\x93\x7c\xfa\x6c\x6a\xea\x40\x11\x5e\x7a\x41\x16\x2b\x23\x5d\x29\x78\x58\xbc\xa6\x99\xc3\xfc\x19\x71\x15\x5d\x87\x7d\xf5\xb1\x36\x71\x41\xff\xcd\xcf\x8f\x65\xc3\x57\xe0\x2a\xf9\x56\x84\xbb\x70\xc0\x18\x7c\xdb\xd2\x87\x20\xcb\xaa\x29\x9a\x8c\x2d\x42\xef\x23\xc4\x01\x63\x40\x06\x8a\x3f\x35\x3b\xe3\x91\x14\x0a\x6c\x88\x12\xc9\x4c\xb8\xea\xc8\x6f\xce\xb1\x1c\x3b\xb3\xdd\xa1\x19\xb0\xa7\x12\x60\xc7\x21\x52\x41\x03\x9f\x76\x09\x9e\xda\xb1\x7c\x52\x58\x64\x8a\xae\xcb\x0b\xa2\x46\x0b\xa7\x80\x33\x32\x09\xe6\x2f\x79\xb9\x31\x06\x67\x64\x42\x5f\xbf\x3f\x5b\x18\x8f\x75\x66\xf9\xb6\x5d\x10\x1f\x7d\xf7\xe0\xab\xcf\xfd\x15\x87\x4b\x7c\x33\x89\xc7\xe3\xf4\x39\x31\xa7\x9a\xb4\x83\x60\x7f\xfa\xb2\xeb\xfe\x5c\x73\x94\xa0\x0f\x20\x85\xb3\x44\x37\x46\x23\x11\x4f\xe8\xe2\x50\x6b\x29\x63\xed\x82\x2f\xf0\x7f\x69\xfe\x1b\x29\xa3\xcb\x17\x42\x0c\x3b\xdf\x36\x8c\xc5\x75\x04\x18\x59\xce\x28\x61\x7f\xd7\x8f\x41\x6b\x3a\xdf\xd7\xba\xea\xae\x14\xce\x52\x2c\x26\x84\x55\x6d\x01\x66\x1b\x59\xdd\x0b\x02\x2b\x86\x58\x99\x94\x5e\x2e\x51\x1e\x33\x45\xfb\x73\x05\x5e\xd7\x60\x80\xed\x0b\xd2\x27\xdd\xdc\xbd\xd6\x29\xa7\x37\xd9\x1b\x9e\x58\xc7\x71\xf2\xda\x73\x9e\x02\x11\xc5\x8b\xd9\x73\x16\xf4\xf4\xf4\x8e\xd2\xf9\x3d\xe3\x7a\x1c\x27\x8c\x1e\x76\xe5\xee\xcd\x92\x93\x2a\xba\x05\x87\x3a\x22\x56\x22\x58\x3b\xe1\x6d\x1e\xee\x6a\xd4\x6c\x87\xcc\x77\x33\xe8\x00\x29\xc0\x0d\xcc\x13\x00\x72\xa9\xb4\xa7\x8b\x1e\x7b\xf7\xc2\x00\x71\x1e\xbe\x2e\x2b\x8a\x38\x04\xfb\x27\xb5\x58\x3c\xaa\xb1\x35\x86\x62\x81\x41\xdf\x17\x4a\x45\x90\x87\x69\x19\xe9\xdd\x15\xc0\xb3\x48\x81\x84\xaf\xbf\xf7\xf8\xe5\xe8\xa6\x82\x31\xcc\x3b\x62\x81\x1b\x0b\xf9\x7d\x9c\xc3\x0e\xd7\xe2\xba\x7c\xa6\x70\xd0\xe1\x87\x02\x0b\xb4\xeb\x39\xb5\xd8\xee\x5d\x2f\xa2\x61\x9b\xc0\x80\x0a\x06\x81\x8e\x27\xfd\x1b\xf2\x56\x65\x35\x31\x26\x51\x3f\x02\x66\x4d\x73\x47\x7b\xc0\x08\x21\x49\xc5\x43\x71\x38\x6b\xd2\x96\x75\xa7\xbe\x0f\x04\x4d\x5f\x98\xf5\x37\x3c\xd9\xee\x88\xdf\x1b\xac\x71\xb0\x71\x76\xdb\xb3\x27\x0a\x39\x40\xfa\x57\xa4\x5d\x70\x00\xce\x85\x43\xeb\x03\x24\xb7\xc2\xf6\x2d\x39\x72\xb1\x4e\x77\x46\x7f\xcd\x10\xd8\x44\x4e\x7c\x54\xaf\xc5\x94\x5a\x81\x0d\xc1\xd5\x17\x04\x95\x4a\x7b\x00\xbf\x6f\x79\x49\x27\x22\x47\x69\x88\xaf\x56\x69\xf9\xd3\xe8\xb3\x94\x97\x66\xe1\x98\x64\x17\xc1\x7d\x5b\xb2\x8b\x51\x18\x4a\x8b\x9e\x82\x1d\x91\x95\xbd\xe1\xe6\x05\xe3\xe8\x32\x9e\xa1\x08\x1e\x5e\xbc\x00\xd5\xe4\xeb\xa8\xe7\x6b\xe7\x6c\xd1\x39\x61\x91\x06\x20\x53\x83\x4e\xb1\x4b\x96\xe2\x91\x0f\xb8\xed\x9b\x88\x45\x2d\x50\x3c\x8b\xaf\x40\xba\x36\xa2\x75\x5e\x76\xcc\xf7\x89\xfc\xaf\xcc\xfb\x80\x41\x31\x1b\x21\xff\xba\x2c\x83\xfa\xae\x69\xd6\x3e\xfd\xd6\xa5\xbb\x97\xba\x5e\x75\x63\x85\x4c\xdc\x73\xa9\xf7\xc8\xa6\x31\x72\xdf\x8a\xda\xd4\x38\x2b\xc4\xc2\x0d\xdb\xd8\xed\xac\x2a\x9a\x5e\xfc\x63\xc3\x39\x61\x3b\x92\x50\x14\x23\xf0\xfa\xf7\x07\x05\xe1\xf5\x02\x6f\x0f\x02\xb1\xce\x3b\x10\x1d\xc3\x76\x0b\x32\xd7\x59\xe9\x51\x47\x7c\x6e\xbb\x80\x72\x4c\x38\xdb\x7b\xe6\x78\xcb\x3c\x90\x28\x1c\x14\xf1\xd6\x9c\x67\x5d\x7d\xd2\x63\xff\x95\x56\xe5\xed\x27\x77\x6c\xbb\x46\x8a\xf8\x13\x05\x7d\x4b\xcd\xdc\xfe\x35\x89\xca\xf6\x70\xb3\xce\x99\x74\xdc\x17\x4f\x9d\x62\x38\x6e\x36\x56\x13\xd5\xff\x04\x83\x08\xc8\x19\x54\xcd\xfd\x8a\x04\x7a\xfb\x0d\xc4\xf6\x21\x3e\x8d\x9b\x0f\x0e\xf8\x84\x45\x36\xf2\xb9\xf8\x5b\xfd\xed\xd0\x0c\xe7\x81\xbc\x6f\xc8\x53\x29\x60\xea\xe1\x8e\x42\x9b\xf5\xda\xe2\xb7\x21\xec\x58\x19\xa9\xec\x99\x2a\x2c\x97\xed\xdc\x93\x6e\xf3\x51\x2d\xf0\x0e\x03\xac\x30\xea\xe6\xac\xef\x8e\xc7\x7b\xdc\xc3\xa8\xd6\x31\x46\x0f\x82\x95\x0a\xd7\xba\xf0\x25\xe4\xdc\x2b\xec\x64\x73\x1f\x2b\xb0\x3a\x1d\x9b\xbb\xb1\x96\xff\x1d\xdc\x73\x37\x00\x51\x04\x6b\xc6\x4e\x49\x40\x31\x17\x69\x05\xdf\xce\x0b\x9b\xeb\x84\xb0\xe6\x0d\xfa\xd9\x7a\x4b\xcc\x0b\xea\x19\xb9\x2c\x9b\x65\x87\xce\x6c\xc4\x7e\x88\x93\x15\xe5\xb1\x5b\x73\x78\xf3\xd8\xbf\x73\x2e\x09\xf0\x01\xee\x1c\xdf\xbc\x6b\x1a\xfe\xde\x25\x8a\x53\x8e\xfd\x75\xbe\xff\xbd\x2b\x63\x0c\x4b\x27\xef\xc7\x26\x5c\xba\x6d\x2e\xb3\x8a\xb1\x30\x3f\xe1\xfb\x0f\xe6\x0c\x6e\x0e\x22\xef\x3f\x9f\x60\x0e\x67\xbc\xce\x08\xee\xa8\x0c\xd6\x46\x72\xc3\x79\xb8\x92\xd0\x43\x68\x38\xbb\x50\xc5\x6f\x8b\xc9\xca\x83\x6e\x90\x55\x69\xdc\x00\xeb\x91\xb0\xd1\x18\x70\x2b\x3c\x62\x55\x63\xe0\x93\x86\x82\x30\x55\x32\xb8\x3d\xd4\x4f\x70\xb7\xe5\x31\xb9\xcd\xf1\x21\xf2\xb0\xe4\xf9\x56\x94\xcb\xa5\x4f\x7e\xfa\x36\xf9\x32\xd9\x5a\x1e\xce\x7e\x33\x60\x36\x4a\x5d\x17\x5b\xa5\x53\xdd\xed\xb7\x98\x78\x88\x1c\x81\x70\xb7\x58\xa7\x50\x48\x07\x28\xd4\xc1\x7d\x1d\x83\xb6\x22\x4c\x83\xe2\x43\x20\x36\x81\xbc\x23\x9d\x55\x1c\xef\x0f\xab\x0d\x7d\x4b\x8d\xb8\x69\x92\x31\x0c\xf2\xcb\x3b\xdd\x21\xa1\x87\x88\x4f\xfd\x8e\x46\xd6\x87\x7b\xc3\xc3\x4d\x23\x11\x5e\xed\x17\x0b\xd6\xe5\x6d\x0d\x62\xe7\xef\xbc\x1e\xe4\xee\x4d\xc5\x55\xec\x0a\x32\xcc\xad\x97\xfe\xac\x1f\xf3\x55\xdd\x90\xfa\xff\xe6\x44\xec\x29\xd6\xd7\xcc\x1c\x3f\xa1\x59\xaa\x9b\xf7\x28\xcd\x64\x97\xd1\x30\x47\x80\xc9\x4a\xfd\xbb\xc3\xea\x01\x00\x10\x98\xb1\x88\xcb\xaf\x30\xbe\xec\xd6\xc3\x75\x0a\x6f\xb9\x07\x6c\xa6\xed\xaf\xcc\x2e\xab\xb3\xc4\xbe\x24\xa7\x19\xb9\x2e\xbb\x21\x42\x38\x69\xdc\xb2\xac\x46\xdf\xc2\x79\x27\xe7\x7e\x2a\x93\x3f\x3b\xbf\x78\xf6\xd7\x91\x6c\xb7\x1e\xd5\x51\x08\xf0\xbb\xa0\xb8\x68\x3c\x23\xcb\x32\xc9\xa6\x17\x71\xf0\xdd\xf8\x94\xe3\x0e\x26\x46\x47\x78\x9d\xe2\x18\xbb\x2a\xea\xb3\xb1\x15\x46\xea\xbf\xf2\x05\xd9\x96\xb9\x6d\x26\x9d\x0f\xb2\xf2\x15\x05\xe3\x03\x83\x86\xa1\xe8\x36\xbf\xa2\x9e\x62\xc0\xd7\xc4\x46\xd7\x00\xbb\x13\x66\xb7\xdb\x84\x5d\x0b\x24\x4b\x8d\x11\x11\xb6\xa7\xc3\x6b\x88\x27\x9c\x45\x17\xf3\xc4\xee\x7f\xfb\x73\xf0\x80\x69\xe3\x40\x41\xf6\xc4\xf3\xb0\xef\xf4\x0f\xab\x23\x9f\xd7\x1a\xb1\x8b\x76\xb8\x94\xb9\xa6\xa5\xe7\xe9\x7d\x99\x40\x96\x7d\x0f\xf7\x70\x17\x84\x3e\xbd\x35\x9d\x2e\xa9\x1e\xc3\xa8\x38\x7a\xeb\x13\x8e\x6d\x37\x46\x93\x7a\xcf\x69\x38\x4a\x4b\x9c\xf0\x97\xce\x0e\xbb\xed\x10\xc3\xc6\x5c\x52\x25\x0a\xe3\xa6\xf5\x96\x52\x4e\x07\xf7\x97\x70\x08\x85\x11\x7b\x7f\x4b\x53\x53\x64\x08\xc8\x0b\xcc\x51\x2a\x4f\x5d\xbd\x8a\xce\xdd\x36\x2c\x18\xdc\x41\x04\x74\x1a\x58\x7e\x77\xb8\x39\x79\xb7\x5b\x6f\x2d\x72\x92\xff\xf3\x74\x65\x74\xdb\xe0\x6f\x37\xcc\x38\xba\x36\xa3\x80\xfc\xdf\x7a\x2d\xb8\x9c\x36\x77\x31\xa0\x8c\xf7\x35\xa7\xce\x2b\x60\x59\x66\x74\xb5\xdc\xc2\xe9\x18\x6d\xb1\xa1\x76\xda\xed\xfd\xdc\xe0\x12\x44\x0a\x59\x6c\xc7\x3e\x63\xaa\x40\xb5\x2d\x50\xc0\x46\x16\x86\x90\xce\xc7\x48\xe8\x35\xad\xcf\xf5\x5c\xe6\xe1\x4d\x56\xb9\x77\x9a\xeb\x75\x99\xc9\xba\x1e\x2b\x6c\x29\xc9\xf2\x7e\x75\x89\x9f\xa2\x93\x29\xc9\x95\xbf\xef\x6f\x88\xa4\x96\x95\x88\xb5\xc8\xd5\x13\x8d\xac\x64\x48\xe3\xad\x7b\x95\xeb\xf6\x88\xcd\xb4\x31\x63\x1a\xf9\x06\x26\xc1\xd7\x25\xd3\x5f\x47\x68\x7e\x1d\x14\xec\x73\xed\xdd\x48\xdc\xcd\x85\x2d\xb3\x54\x0b\xd3\x73\xc6\xef\x1a\x75\x8c\x78\x42\x44\xdc\x05\xff\x5d\xd8\x92\x05\xa2\x12\x60\x68\xe6\xa8\xe4\x52\x73\x49\x92\xc0\x25\x74\x2b\x9b\x39\x03\x76\x3e\x5c\x10\xf5\x32\x6e\xcb\x7b\xea\x5a\x53\xfb\xb5\x60\x2b\x58\x37\xed\xad\x28\x26\xd8\x0f\x44\x17\xff\xac\x4a\xf6\x13\xbb\x10\x20\xe8\x1e\xfd\x79\x51\x8e\xdd\x0d\x91\x44\x5e\xdb\x72\xc7\xd9\xdb\xf9\xb6\x7e\x8a\x6d\xda\x3e\x78\xd8\xec\x0d\xd0\x57\xe2\xc5\x15\x4f\x6d\x7e\x5c\xe9\xf0\x44\x2f\xd3\x09\xd9\xe5\xb8\xde\x19\x59\x3a\xe3\xae\x93\xf9\x40\x2f\xa2\xa1\x21\xbd\x78\x4b\xe6\x51\x83\x54\x99\x92\x73\x4a\xb5\x68\x8e\x56\x57\xba\xb3\xda\x3f\xbd\x7c\xa8\xb9\x6a\xf8\xd5\x4f\x64\x3f\xf3\xbc\x92\xc1\xed\x49\xf4\xc3\x3d\xca\x44\x8c\xfa\x96\x71\x36\x35\xe7\x06\xaf\xf1\x0e\xc0\x2f\x09\xc8\xe1\xe1\xaf\xd0\xf4\x9f\x38\x23\x7e\xc0\xf7\x55\xc5\x03\xf1\x93\xa1\x2c\x7b\x48\xab\x77\xd7\x90\xfb\xb2\xbc\x8a\xce\xa0\x27\xe8\xe8\xf2\xbe\x73\x4e\x93\x89\x69\x44\x9c\xe2\xf1\x51\xfe\x33\x55\xe9\x90\xa9\x26\xe1\x6d\xfa\x76\x12\x12\x80\xed\xdd\xe9\xe8\x6b\x4f\x12\xdb\xd2\xef\xd9\x31\x7c\x73\xe3\x3b\xa0\xd8\xda\x7b\x93\xd6\xf8\xf5\xb9\x57\xde\x60\x89\x7a\xec\xef\x81\x9c\x51\xa9\x24\xc9\x1c\x9a\x83\x6f\xaf\x71\xda\x9f\x58\x74\xdd\x53\xb8\x89\x3e\x22\x68\x9b\x1a\xb9\x23\x59\xb7\x9a\x97\x13\x51\x88\xb6\x50\xe4\xa9\xeb\xfd\xae\x5d\xa5\x25\xb0\xa0\x88\x6c\x19\x76\x98\x20\xc3\xd3\x93\x0d\xa0\x7f\xc6\xfc\x7f\xb0\xa0\xe3\xe6\xf1\xb0\x97\xf9\xbd\xe6\x06\xf4\xe2\x37\x91\xfb\xfd\xdc\x19\x5c\xc9\x2a\x19\xc8\x6b\x88\xde\xff\xc5\x8d\x17\xda\x5d\x8f\x21\x0c\xd1\x4c\x98\x35\x93\x85\x25\x94\x26\x87\xcf\x8e\x9d\xb9\xd9\x4a\x85\x38\xdb\xb4\xf1\xbd\x0f\xbf\xb4\x69\x0d\x82\xa4\x1e\xeb\xee\xbe\x0e\xf7\x19\x73\x75\xe5\x52\x15\xec\x0b\x2a\x59\x59\x5e\x46\xec\x1c\x47\x75\x96\x76\xee\x38\xa4\x88\x9a\x4b\x65\x2a\x12\x0e\xe7\x6d\xef\xac\xc9\x3e\x1e\x5c\x9a\x82\x55\xb3\x08\xfa\x84\x50\xb8\x0e\x6e\xdc\xd8\x8a\x24\xbb\x30\xc1\x79\xec\xc2\xda\x7d\xce\x1f\x5f\x3b\x8d\xa6\x75\x4f\xdc\x47\xe9\x86\x1e\x12\xc8\x5a\xfe\xbb\xed\xc8\x13\x0d\xe6\xd7\x88\x86\x9c\xd0\x5d\xce\x24\x11\x97\xd2\x26\xcd\xbf\x79\x30\x38\x62\xfd\x05\x37\x78\x05\x39\x84\xf5\x63\x1c\x87\xc1\x82\xc3\xe9\x1f\x1d\x84\x5e\xf1\x9c\x8a\x4c\xac\xb7\x77\xa8\xfe\x22\x25\x97\x4e\xfd\xbf\x56\xd4\x47\xca\x64\x56\xb5\x28\x6a\xee\x4a\x51\xa2\xf9\x04\xb2\x0b\xd4\xe2\x23\xad\x84\xe6\x2c\xa5\xe7\xae\x76\x46\xf4\xb2\xeb\x19\x23\xd2\x65\x6a\x31\x75\x96\xa4\xfb\x5d\x83\xf6\x3a\x4b\xdd\x6e\xe8\xf7\xe5\x6c\xa5\xe0\x4a\x9c\x2c\x4f\xd3\x30\x6d\x2a\x2f\xae\x25\x13\x1a\xb5\x36\xbc\x34\x27\x5a\xb0\x50\x36\xb3\xbe\x99\x76\x1b\x94\x48\xf6\x3a\xa6\x5a\x64\x7c\x9a\x69\xce\x5d\x45\x2c\x15\x00\xa2\x88\x01\x30\x03\x6e\x13\xda\xf2\x99\x10\x4e\xe5\x38\xbd\xd8\x89\x23\x22\x30\x37\xe5\x98\xf8\x4b\x93\x5f\xeb\xc8\x77\x8a\x85\xf0\x06\xb7\x56\xfb\x6b\xef\xee\x47\x87\x6b\x36\x49\x64\x23\xe9\xe1\x17\x9b\x2f\x68\x86\xbc\x81\xf9\x63\xe7\xa9\x57\x02\xc7\x9e\x1a\xd1\x3a\x86\xed\x82\x73\xda\xd4\x41\x6f\x23\xfc\x59\x23\x30\xa1\x52\x28\xd0\x73\xe8\x7e\x7b\x94\x63\x0c\x1f\x57\xd8\x8b\xbb\x30\xb4\xa4\x89\x4f\xea\xb3\xf8\x4d\xfb\xa4\xcc\x81\x34\x80\x6f\x86\xcd\x78\x85\x7a\xab\xb1\x6d\xe2\x67\x16\x51\x97\x28\x64\xb9\x1c\x2c\xbb\xb6\xca\xb3\xff\xa6\x7a\xdf\x13\xf5\x70\xa0\xcb\xe5\x5f\x02\x51\x07\xf2\x07\x96\x6e\x28\x80\x1f\x0c\xab\xd4\x20\x5b\xbc\xad\xba\xd6\x72\x52\xf7\x43\x7c\x18\x4a\x5c\x5e\xa3\x83\xcd\x55\x4c\x7a\xc8\x0c\x5c\xef\xa2\xe4\x9c\xac\x87\xc8\xc5\x78\x1f\x16\xc9\x0c\x34\xf1\x74\x9d\x74\xc9\x54\xd1\xfd\x75\x7a\xf1\x72\xef\x6d\x93\x54\xd6\xdf\x63\xa8\x42\xa5\xc2\xaa\x28\x3c\xdb\xda\x58\xb3\x3b\xb0\x30\x24\x94\x3f\xe5\x76\x5d\xa0\xc3\x3b\x85\x52\x86\x87\xf3\xe1\xa9\x4d\x3b\x79\x43\x7f\x43\x39\xbd\xcd\x1b\xed\x69\x59\xba\xbf\x59\x92\xe1\x1e\xd7\x7f\x26\x1f\x72\xfa\x67\x51\x8a\x9c\xb8\x9e\xc5\x3e\x72\x5b\x2e\xc8\x7a\xe9\xec\x4b\x3a\xdc\xba\x25\x38\x27\xe0\x90\x83\x86\x83\xdc\x86\x79\xf1\x86\xd1\x6f\xca\xcd\x21\xf9\x63\xba\xb8\x03\x9a\x00\x57\x03\x99\x62\x38\x76\x20\x46\xb9\xcb\xe0\xf9\xe1\x73\xe8\xf7\x5f\xa5\x44\xe1\xad\xfe\x59\x7f\x8f\x61\x36\xcc\x10\x1e\x7a\xde\xfa\x93\xbc\x8d\x38\x01\x4c\xa7\x65\xae\x6d\x48\x4f\xe4\xce\x76\x58\x4e\x2f\xdc\x2c\x47\x5c\x97\xc0\xdf\xe1\xb5\x2c\x1f\x8f\xc9\xe4\x81\xc3\x86\xca\xb6\xdb\x79\xe3\x9a\x2b\xb0\x0b\x12\xdb\x3e\xfe\x59\x0b\x4a\xc4\xdb\x9d\xbd\x88\x01\x8b\xce\xb5\xfc\x9e\x15\x42\xea\x9c\x40\x10\x5f\x79\x2c\x94\x42\x36\x32\x92\x99\xe7\x96\x99\x60\xa1\x68\xbd\xc2\xbf\x4b\xe0\xb3\x41\xa2\xb6\x78\x05\x7e\xab\x6a\xba\x2e\x76\x54\xfb\xed\xb5\xa5\x80\x05\x19\xe6\xdb\x77\x02\xac\x57\x97\x13\x6f\xfd\x7a\xde\xfa\x61\x42\xe1\x90\xa0\xf6\xc4\x7c\xb3\x61\xef\xdd\xce\x0a\x7e\x2d\x08\xc9\xf2\xbb\x22\xdd\x41\xc7\xbe\x7d\x22\x0d\x7d\x60\xfa\xa6\x9f\xa9\x15\x33\xc3\x5a\x2b\x6e\x7e\x18\xf6\xa5\x78\x12\xf4\x92\x90\x40\x10\x68\xa7\x12\xcf\xf7\x94\xd3\xd9\xc2\x47\x91\xd9\x58\x9d\x96\xd4\x34\x1b\x99\x34\xfb\xb7\x44\xa8\x00\xd4\x00\xed\x6c\x77\xd4\xe8\x2c\x80\x1d\x0b\xa4\x32\x9a\xcd\x7f\x87\xcc\x61\xa8\x18\x2f\xd7\x11\x20\xa7\xb7\xce\x12\x9f\x87\x1b\xcd\xbb\xc4\x1e\x1e\xc0\x4a\xd2\xf7\x4f\x59\xbe\x70\xc3\x41\x09\xef\xf1\xa3\x12\x13\xf7\x3f\x9c\xd0\x26\x08\xbd\x7b\xe7\x9c\x84\x5d\x29\x62\x68\xa2\xdf\xa5\xc1\x66\x7d\xb2\x60\x29\x35\x10\x60\xc7\xec\x54\x75\x5a\xb3\xdc\x55\xeb\x8a\x78\xd6\x82\x9b\x59\x98\x19\x5c\x99\x74\x7e\xe0\x7e\x51\xc0\xbb\xe3\xf3\x10\xfb\xb4\xa4\x68\x3c\x36\xd9\x53\x46\xa5\x20\x53\xd0\x64\xbb\x3b\x8b\xfa\x45\xff\xdd\x5c\x3a\xed\x6e\x15\x69\x64\xbb\xba\xfd\x41\x54\x1a\xda\x37\x34\x13\x05\xe4\x95\x66\xeb\xbe\xd7\x95\xc9\xf0\x9f\xaa\x51\xcc\xf3\xdf\xeb\x15\x95\xf3\xc9\x58\x9c\x63\x0f\x71\x63\xff\x80\xa7\x9d\x3d\xc3\x3c\x20\x9b\x6d\x16\x10\xe4\x6b\x6f\xa8\x10\xf3\xcd\xb2\x05\xdf\xae\x16\x16\xec\x3d\xa0\xcb\xb5\x74\x3b\x08\x09\x36\xec\xb5\xe8\x8e\x2e\x4d\xa1\x55\xb8\x57\xb8\x97\x5a\x65\xc1\xad\xef\xfd\xcd\xf0\xbe\xa8\x24\x17\x52\xc8\x77\xe9\xf9\xc5\xe5\xbf\x62\xf3\x92\x47\xf0\x87\x67\xd1\xbb\xb7\xf1\xf3\xf3\x4b\x03\x67\xb8\xac\x09\xf1\xec\x5f\xae\xf7\x5f\x03\xda\x23\xfa\xcf\xdb\x79\x68\xde\xd7\x5c\xbf\xb8\x5d\x98\xa7\x30\xdf\x00\x66\xf9\xd7\x35\xef\xdd\x3d\x3f\xb6\x9a\x09\x5a\x14\x63\xb0\x38\x0f\xc2\x97\x92\x12\xe8\xf3\xea\x5a\xac\xcd\xd1\x50\x2f\x5f\xb0\x24\xa7\x48\x58\x52\xe3\x0c\x46\xb6\x79\x94\xdf\xb6\x38\x74\x3a\x76\x32\x83\x85\xff\x61\x7d\x1a\x20\x61\x06\x27\x6f\x0e\x7b\xc2\xc5\x34\x15\xf2\xe0\x97\x55\xf6\x73\xef\x58\x8b\xb1\x2b\xb7\x8b\x18\x0b\xbb\x4d\x1f\xde\xc7\x26\xac\xe0\x6d\x78\xfa\x58\xe2\xa1\xe3\xf6\xbd\x7e\x93\x14\x97\x56\x85\xb8\x9c\xbb\xe7\xb5\x28\x25\x03\x04\x52\x39\xb0\x24\x24\xdb\x75\xcb\xea\x84\xdb\x08\x0e\x4f\xf3\xbf\x36\x77\x5a\xad\xa6\x08\xb2\xaf\x65\x84\x54\x82\xf6\x44\x7a\x7b\x5c\x63\x33\xda\xa6\xf6\x56\xa4\x92\xf8\x0f\x68\x8c\xbf\x36\x5c\xfc\x0d\x6e\x03\xce\x08\xc8\x4e\x1a\x84\x4d\xa5\x8e\x7c\xcd\x42\x6d\x4c\x27\xd9\x58\xdb\x13\xc0\x9a\xb4\x78\xda\x90\xbb\x2f\xe8\x7e\x7b\x03\xa1\x0e\x70\x65\x14\xf6\x88\xc2\xb1\xb6\xb5\xb5\x85\x33\x47\x30\xd1\xe7\x91\x48\x15\x86\xba\xdc\xd7\x89\xff\x70\x21\xea\x74\xc5\x67\x5b\x23\xa3\x10\x4d\x2c\x56\xf3\x75\x4e\x84\x34\xa9\xdc\x25\x19\x6e\x1f\xa3\x5c\x5f\x0a\x7c\x5d\x35\xf4\xb7\x3b\x26\x4f\x62\xde\x3a\x1e\x18\x22\x8a\xfe\x15\xd9\xc1\x90\x29\x84\xd4\xde\x37\x68\xe0\xf7\xdf\xaa\xde\xf5\x3e\x90\x31\xbc\x39\x11\xa8\x79\x46\x92\x0e\xc3\x63\x9f\x4b\x23\x66\x8c\x3b\xe5\x33\x81\xba\x8a\x98\xca\x97\x67\x9c\x68\x9b\xa1\xdc\x27\xe1\x88\x43\x13\x7d\xac\xcd\x6b\x57\x62\xad\x68\xf5\x34\x13\x6a\x79\x97\x90\xd6\x36\xa6\xf3\xab\xba\xd5\xe8\x0a\x4b\xe9\x93\xcd\xbd\x1f\xde\x83\x4b\xaf\x18\x20\x95\xdd\xd6\x5a\x03\x0b\x2d\xb2\xe5\x6d\xcc\xc2\xed\xab\xb2\x9b\x65\xf5\x50\xf6\x34\x2c\xd9\xc3\x6d\xe3\x3a\x8e\x17\x3e\xe2\x3f\x3e\xfe\x2f\x4f\xaf\x02\x96\x42\xe6\x40\xd2\xfc\xdb\x08\x1a\x7b\xb2\x53\x34\x99\xa6\x1d\xf5\x97\x9a\x1f\x7f\x4c\x2b\x67\x16\x98\x2d\xe8\x63\x1b\x60\x7b\xd2\x85\x9c\x6c\x5b\x1f\x6e\x0f\xd1\x8c\x2d\xe3\xdd\xbc\xb5\x01\x43\x56\xd1\x57\xdb\x98\x84\xbd\x3d\xb5\x2d\x02\xde\x95\x3a\xa1\x1e\xbb\xab\xe7\x4c\xcb\x5b\xcb\x64\xe7\x0d\x9f\xe6\x2a\x74\x31\xb6\xea\x21\x9d\x8c\x56\xeb\x53\xc1\x7b\x2d\xb9\xd1\x9b\x5d\x6b\x77\x55\x59\x67\x58\xeb\xaf\x59\x65\xfc\xe5\x06\xb8\x1c\xe9\xfd\x4f\x1e\x55\x53\x21\x0e\xb1\x3c\xc5\xdf\x78\x7a\x2e\x0a\xc1\x9d\x72\x47\xc2\x85\x3f\x1d\x75\x05\x93\xa6\x36\x3a\x1b\xbf\x75\x6a\x75\x0f\x55\x66\xd4\xb9\xd1\x47\x70\x9c\x5f\x34\xc0\x79\x38\x6e\xe1\x52\x82\xc1\x8d\x35\x06\x8e\xe3\xb3\xc3\x1a\xd1\x83\x2b\x7f\x3b\xa8\x18\x04\x4e\x82\x9c\xca\xf5\x85\xa1\x5f\x73\x53\xe7\x6c\x82\xcd\xa7\xb8\xa3\x6d\xfd\x87\x7f\x8c\x79\x66\x08\x0c\x44\x21\x2d\xcd\xb9\xb6\xb6\x4f\xa6\x98\xb0\x3c\x0c\x18\x6e\xe5\x3b\x60\xdd\xbc\xe0\x13\x33\x6a\x7d\x91\xf9\x0c\xf5\xb3\xab\xda\xc5\x9d\x28\x2b\x72\x73\x53\xd3\xd5\x2f\xa0\xf5\x87\x19\xe2\xed\x57\x77\xfa\x72\x06\x12\xdf\x96\xe8\xb4\x9c\x5a\xd8\x1a\xb5\xb8\x42\xc1\xa7\x57\x5b\x04\x43\xe1\x49\x57\xeb\x7c\xef\x72\x0a\x65\xfc\xdf\x73\x37\x9e\x1a\x0c\x56\x2e\x83\x6e\x47\xe3\x6a\x5d\x47\xd1\x15\xc2\xdf\x9f\x35\x43\x57\xef\xf0\x18\xc2\x1d\x1e\xff\x55\xac\xbf\x72\x8a\x0b\xf0\xb8\xbb\xe1\x01\x2c\xa8\x2f\x83\x9c\xd7\x0b\x3d\x7b\xad\x87\x9d\xa6\x9f\xb6\x6d\x4f\x66\x1c\x2b\xb3\x3c\xaf\xee\xa7\x4b\x0b\x1f\xe6\xd3\x04\xed\xca\x19\x7d\x4e\xb9\xa6\x17\xd3\x0b\x66\xb3\x0e\x4e\xc9\x7b\x3f\x5d\x13\x57\x1a\x96\x53\xb9\xaa\xe4\x4c\x63\x58\xd7\x0f\xbc\x5a\x86\xbf\xdd\xd6\x75\x45\x83\xac\xf2\x4c\x1a\x26\x34\xba\xf2\xfc\x5e\x50\x0c\xcc\xd3\x13\x15\x9c\x7b\xb7\xeb\xe2\xe1\x64\xdb\xa8\xab\xd9\x3a\xd0\x06\xbc\xde\x40\xc0\xee\xf4\x12\x08\x38\x53\x2f\x50\x2c\x21\x40\x6e\x14\x86\x35\xcd\x77\xe0\x48\xde\x72\xe5\xea\xba\x72\x38\x71\x90\xfd\x7d\xd4\xfe\x59\xf6\x1c\xbe\xfe\xb6\x41\x1f\x49\x34\x5a\x0b\x82\xb9\xa7\x3e\xba\x6a\xdb\x9d\x1f\xee\x04\x94\x66\x28\xc2\xe6\x8e\xd7\x70\x24\xb4\x99\x51\xbb\x4a\x02\xc2\x56\x1a\xc4\x91\xb5\x0c\xdb\xdc\x5b\xbd\x8b\xc1\x43\x9b\xaa\xc4\x8d\xdf\x4f\x27\x47\x6c\x88\x59\x2f\x28\xc3\x63\x5f\xaa\x3f\x78\xab\x18\xc6\xfd\xd6\x9a\x5e\x9f\x94\xde\x63\x2a\x55\x87\x5d\x79\x52\x07\x64\x53\xa5\xf1\x10\xc9\x13\x0d\x98\x10\x0c\x80\x0e\xc7\x7f\x7e\x48\x3a\xff\x62\x25\xba\xfc\x7a\x7a\xd1\x33\x91\x66\x28\x1e\xb6\x4a\xb8\x32\xc7\xa2\x68\x19\x30\xaa\x97\x8f\x91\xc9\x09\xca\x5c\xff\x1a\x71\xa3\x05\xba\x27\x44\xe1\x61\x03\xbb\x14\x9e\x9d\x6f\x1f\xbe\x6a\x6d\x9f\xa6\x07\xcf\x3a\xad\x3b\xae\xbf\x42\x58\x67\x6e\x06\x2d\xcb\xce\x6d\xcd\xb4\x48\xbd\x6f\x67\xfb\xa3\xab\xaa\x3e\xf6\x82\x2c\xf2\xe2\xe1\xc1\x07\x59\x6d\xb5\xd1\x08\x6f\xf5\x68\xd0\xc1\xca\x3e\xbf\xfa\xaa\x10\x14\xc6\x7c\xfa\xda\xa9\x54\x87\xc7\x1c\xd6\x29\x0b\xaf\xfd\x1e\xb6\x1f\x2b\x50\xd9\x6a\xbd\xc2\x8d\x06\x35\x73\xb8\x7e\x13\xe0\x28\x66\x02\x7f\x02\x86\xb9\xe2\x60\xad\xe9\x18\x32\x73\xa2\x55\x94\x6b\x6c\x75\xff\x1e\xfb\x0d\xd1\x58\x1f\x03\x8d\x83\x3e\x00\x96\x17\x80\x75\x88\x77\x6c\x22\x0a\x68\x57\x40\xe2\xe5\x1d\x0a\xbc\x3b\xce\x7f\xeb\xc0\x6d\x5a\xb5\xd8\xd8\xf9\x5f\xb7\x43\xea\x1b\xc8\x1b\xc8\xf1\xad\xb3\xe9\x7d\x54\x04\x3c\xef\x36\x6d\x96\x5b\xd2\xe4\x37\xa9\xb9\xac\xb4\x6b\xc7\xe7\xac\xac\xe9\x2a\x58\x3f\xfc\x0d\x24\x3c\xdc\xd4\x68\x7c\xa1\x6b\x6a\x86\xc8\xcc\x9a\x67\x27\xc7\x1f\x01\xb5\x39\x2b\xd4\x5c\xf2\x4b\xb2\x4b\x59\x94\xcb\x20\x42\xe0\x65\x68\x75\x41\xb8\xdf\x3e\xc1\xa4\xd5\x82\x4e\x86\xd0\x05\x96\x20\x25\x2d\xca\xf2\xc9\xff\xb8\xb7\x3b\x6e\x73\x43\xfc\x0e\xfc\xd3\x17\xae\x30\x83\xbf\xf3\xe7\xcf\x50\x2e\x86\x67\x4c\xd4\x13\xbb\x82\xb0\x37\x61\xe9\x02\x6a\x64\xfe\x66\x64\x7a\xdd\x66\x8f\x36\x6a\xfc\x61\xf2\x1a\x9a\xb8\xe5\xd7\xfe\x07\xd3\xa7\x3a\xba\xc7\x24\xac\x7f\x14\x6a\x5b\x16\x77\x33\x0f\x74\x2b\xcd\x5d\x5c\x68\xc7\x94\x5e\x76\xdc\xb3\xbd\x97\x5e\xe6\xe3\x7d\x90\x57\x4d\x6d\x4a\x31\xb2\x6a\x3e\x46\x8a\x45\x83\xb1\xc6\x99\x1e\x25\xdc\x31\x58\xfe\xe7\x8d\xc0\x47\x7c\x86\xd2\xed\xf4\xb4\xbd\xad\x35\xc9\xaf\x8c\xaa\x35\x68\xa8\x71\x1f\x65\xba\xcf\x0f\xde\xb3\x01\x79\xa7\xeb\x90\xba\xa6\x14\xeb\x27\xc2\x62\x9b\x8b\x62\x7b\x10\x50\xd7\x78\xf0\xdb\x4a\x3b\x57\x3f\x0d\x56\x71\x33\xdf\xb3\x17\x07\x7a\xa1\x4d\xbd\x7a\x46\xf7\xc1\xc3\xf4\xc9\xee\xc9\x06\xd9\x75\x83\xd8\xc1\x54\x2a\xbd\x07\xfa\x15\x13\xf2\xc3\xf6\x52\x2b\x36\xb4\x67\xf2\x6a\x8a\x7c\x77\xa9\x3d\xbf\xdd\xa2\x8c\x79\x31\x7c\x75\x7c\x51\xb3\xe1\xd6\x70\xd2\x60\x90\xd0\x34\x4f\xb6\x8b\x4e\xde\xfe\x52\xf3\x99\xf9\x41\x18\x84\x58\xbd\xd0\x99\x73\xdd\x93\xda\xea\xbd\x38\x24\x5e\x30\x3f\x8d\xf9\x06\x12\x31\xbc\xf2\x39\xbd\x44\x54\xf1\xc1\x46\x67\xa6\xb6\xc4\xe2\xe3\xa9\x4f\xc9\x2a\x91\x0e\xaa\xab\xf8\x09\x6c\x0f\xe6\x24\xfa\x88\xe0\xb3\x66\xd1\x92\x41\xc9\xaa\xf6\xbe\x03\x9d\xfa\x31\xed\xf8\x39\x9b\xe9\x77\xd9\x81\xce\x4c\xba\xc6\x47\x36\x0c\x90\x8e\xea\xef\xbc\xc8\xaa\x4b\xc1\x2f\xab\x7c\xc4\x39\xf6\x84\x89\x10\x51\xb8\xd2\x3a\x14\xd2\xa9\x43\x77\x62\xd4\x6c\x68\x77\x1a\xaf\x4d\xd4\xc3\x80\xd4\x60\x95\x30\xfc\x39\xb7\xca\x49\x79\x98\xbb\xc3\x55\xe3\x87\x4b\x7c\xd1\x7f\x1d\xdc\x7e\xfa\x56\x1e\x58\x0d\xc2\x49\x08\x63\xd3\x62\xc2\xef\x9f\xd1\x7a\xcb\x32\xbf\xc9\xf5\x1d\xac\xda\x12\x84\x55\xc0\xed\x09\xc2\x84\x0e\x01\xfa\xce\x64\x38\x6d\x3e\x38\xba\x9f\xd4\x14\xcd\xca\xea\x61\xdb\xf0\xeb\xae\x71\x1a\xb6\xaf\x64\x20\xa5\xbd\x8d\x85\x3e\x68\xf4\x37\x42\x93\x9b\xa3\x1d\x46\xed\x6d\x3a\xb0\x53\x42\x12\x3f\x1f\x00\xda\x58\x4c\x7e\x9f\x32\x16\x70\x34\xdf\x42\xb7\xa6\x7e\xb0\xc4\xf5\x48\xf2\x37\x74\xfe\x5d\xd9\xa0\x71\x80\x11\x50\x82\xfc\xa8\x36\x9f\x15\x5a\x8e\x29\x25\xfc\x5e\x9c\xf4\x63\xc1\x27\x97\x40\x79\x53\xd0\xba\x84\x7b\x09\x00\xc8\x42\xe5\xa5\x25\x34\x22\x85\xba\xac\x96\xeb\x8e\x77\x96\x3c\x03\x75\x3d\xd3\x44\x6d\x40\x6b\xab\xaa\x05\x1f\x0f\x81\x0f\x7a\x09\x3c\xf2\xea\xbd\x3d\x97\xc7\x04\xa1\x10\x15\xaf\x77\xe9\xce\x7a\x45\xee\xcc\x6e\x37\xb6\x80\x87\x8a\xdc\x6e\xc1\x37\x0f\x2f\x11\xba\x5a\x23\x14\xc2\x7f\x93\xcd\x7f\x16\x18\x56\xc8\xd7\x1f\xf0\xee\xa8\xf3\x07\x8a\xd1\xdf\xc0\xe1\x74\x1e\x36\xf6\xa6\xdf\xfe\x7c\x48\x7e\xbb\x36\xbb\x5b\xdf\xdd\x69\xb1\x9e\x8e\xbd\x8e\x97\x82\x12\x30\x99\xf2\x69\xeb\x58\x38\xa7\x51\x69\xa5\x6d\x53\x47\x27\xbe\xf3\xaa\x21\x33\xdf\xbf\x7e\xad\xf9\x74\xc2\x4f\xc4\x60\x88\x8b\x6c\x93\xda\xbb\x1f\x63\x51\x7d\x5a\x64\x66\xb9\x65\x30\x64\xd9\xfc\x5c\x27\x10\x31\xe3\xd2\x78\xd2\x4a\xbf\xd0\x3e\xb3\x6f\x25\x9d\xbb\xe2\x1f\x5b\x25\x06\xe0\xd7\x88\x80\xc7\xc3\xea\x76\xd0\x1a\xeb\x5a\x73\xa3\x34\xc1\xfa\x2e\x75\x80\xd8\x3c\x92\x25\xe5\xba\xc4\x39\x28\xe9\xf6\xda\xa9\x43\x43\x91\x5a\x9e\x23\xda\xf5\x34\xb2\xb7\xb1\x51\xee\x8d\x56\x0c\xe3\x07\x7c\x4b\xe7\x1a\x4f\x7b\x0e\xda\x40\x95\xf7\x55\x7c\x43\x0f\xda\x5a\xa7\x20\xe7\x1b\xdb\x6f\x47\x2b\xc6\x9d\xda\x85\xd0\xe4\xb6\x0e\x55\xc8\x4b\x3b\xdb\x6d\xbb\x1d\xf6\x09\x9a\x32\x48\x08\x01\x3a\xae\xde\x99\xbe\xbc\xcc\x16\x17\xf4\xe5\x92\x09\xb9\x2d\x03\x9c\xa3\x49\x7a\x8b\x0e\xfd\x84\x0a\x05\x13\x65\x8e\x31\x6d\x6d\x9f\x8e\x8d\xaf\xe6\xb3\x4c\xda\x2f\x82\x0f\xd5\xd7\x0c\xdb\x21\x32\xe4\x4c\x2b\x97\x29\x17\x9b\x29\xa3\x8b\x99\xd8\xdd\x5f\x90\x4d\x30\x4e\x57\x0d\x16\xe1\x44\xf1\x14\xa2\xda\xf4\xce\x0f\x82\x25\xc4\xd0\x29\x42\x82\x25\xfe\xf1\x9b\xc0\x05\x3e\x4c\xc0\x03\x1d\xfd\x11\x40\x0b\xab\x7a\x42\x3e\xfb\x25\xc9\x7d\x1f\xc1\xfd\xa4\x86\x75\xc9\x2f\x14\x73\xfc\xe0\x3f\x47\x99\xbb\xdf\x76\xf1\xbf\x34\x2d\x6a\x80\xa1\x94\x67\x24\xdf\x43\x0e\x61\xad\x1f\xc0\x25\x5f\x98\xd6\xad\xa6\xab\xa7\x7f\x09\x85\x42\x4e\x15\x36\x96\x1e\xf8\x2f\x8e\x51\x08\x3d\x99\x36\xfe\xc6\xd9\x35\xa3\xcf\x99\x74\xce\x6a\xf9\x77\x81\x3d\x52\xe8\xc6\x02\xa5\x00\x86\xf0\x6f\xab\xf5\x14\xc4\x67\x7b\xe4\xdf\xae\x4e\x9f\x3f\x62\xa1\x18\xa8\xc9\xd8\x3b\x5c\x9d\x4e\x99\xfc\x2f\x4e\xbf\xee\x00\x04\x26\x92\xb3\x81\xf8\xd9\xc5\xa6\xab\x5d\x99\xf1\x8e\x6e\xe3\x08\x19\xd8\xa9\xb0\x4c\xd2\xc7\x1e\xdb\xa0\xb1\x46\xdc\xb5\x2c\x7c\xfb\xe3\x8e\x51\xec\x3d\xa6\x7a\x96\xb5\xac\xbf\x03\xda\xfa\xfd\x23\x31\x1d\x04\x61\x6c\x15\x95\x69\xb5\xc6\xc3\x33\x0d\x43\xfa\xa1\xe1\x2f\x84\xc6\x4a\x56\x89\x8b\x3c\xfe\x71\xa2\xb9\x00\xe7\x28\x34\xf7\x62\x9e\x72\x92\x4b\xe5\x8d\xe1\x3b\x54\x3b\x36\xe1\x4b\xde\x32\x2f\xb9\x2c\xcb\xff\x88\x27\xd6\x64\x00\x6d\x25\xde\x4d\x5c\xda\xc5\x3f\xcc\x1a\x76\xd7\xba\x46\x38\x9d\xe8\xd5\xf1\x81\x18\x99\xcc\x8e\xd1\x6b\xe0\xa1\x3d\x3f\xa2\x0f\xa8\x15\xfb\xbf\x4d\x32\xfb\xb5\xf7\xc3\x56\xa5\x14\x2a\xcb\xe8\x56\xdb\x30\x44\xf5\xae\x5c\x17\xab\x7d\x8c\x86\xda\x93\x82\x9d\x59\x85\x10\x8e\xf0\xab\xd4\x3b\xaf\x0a\x60\xce\x9b\x46\x16\x01\xd9\xa8\x92\xc9\x12\x39\x4a\xc7\x6c\xb9\x09\x97\xc0\x87\x57\xda\x7f\x11\xad\x15\x5f\xc2\x60\x87\xb3\xe5\x5c\xeb\x28\xe8\xe5\x13\x71\x71\xcf\xd2\xd9\xd8\x39\x46\x28\x77\x51\xf2\xa8\x10\xef\x43\x44\x42\x16\x18\x77\xf3\x40\x20\x68\xdb\x55\x80\xdf\x87\x78\x90\xa1\x50\x23\x6c\xce\x7d\x42\xfa\xdc\x96\x32\xdb\x16\x2c\x98\x5d\xea\x59\x5c\x92\xec\x13\x1c\xce\xd2\xf2\xab\x26\x9c\xe5\x54\x35\xd6\xec\x9f\x54\xe9\x83\xce\xe0\x64\x1f\x59\xd5\x37\x2b\x15\x10\xbe\xaa\xb6\x7c\x8d\xbd\x71\x76\x0a\x43\x14\x85\x33\x82\x6f\x37\xfd\xdd\x28\xa6\x41\xda\x98\x99\xd9\xb1\x68\x4e\x58\xb9\xba\x54\xb4\x18\x2e\x5f\xde\x36\xdf\x0e\xbf\xc5\x43\x52\x54\xdd\xd5\xf4\x76\x3c\x6d\x72\xf4\x8b\x36\xbc\xd9\x17\xb0\xb0\x84\x61\x9a\xad\xa1\x8a\xda\x0f\x2d\x92\x7c\x9c\xdd\xad\xe7\x90\xb1\x82\x21\x74\xb1\x78\x8f\x85\x13\x24\xd7\x20\xb1\x9e\xec\xdf\xa7\xeb\x3d\xff\x80\x56\x1b\x11\xc6\x59\xbb\x46\xfb\x7d\x9a\x75\xad\x6e\x6e\xc0\x25\xa5\x5e\xfc\xc2\x53\x21\xd0\x9e\x1f\x31\x0a\xfe\x96\xd4\x4d\xe3\x6a\x9d\x3a\x27\xb6\xe4\xea\x63\xce\xc9\xe7\x9d\x25\xa1\xc1\x7b\x12\x43\x1e\xad\x13\x06\x71\x23\x0e\xda\xf5\xa1\xdd\xc1\x4c\xd3\x8a\x65\x9d\xbf\x99\x82\xdf\x13\x05\xdf\xaa\xad\xad\xb2\x7a\x07\xaf\x9c\xec\x2f\xde\x34\xf8\x8e\x8a\x5b\xe4\xa4\xa3\xe7\x70\x01\xa4\x00\x12\x48\x3c\x75\x6b\x09\x58\x9f\x12\xcd\x34\x85\xd3\x86\x12\x20\xb7\x86\x72\x7e\xaa\xfd\x31\xb2\xe1\x35\xb2\x91\x5a\x92\xfc\x56\xe6\x7a\xf1\x77\x86\xc6\x70\x6c\x8d\xc8\x68\x9d\x55\x78\xa8\xcf\xa9\x19\x85\xf4\x22\x2c\x57\xf6\xda\xbb\xef\x97\xe1\xb1\xe3\x7c\x9e\xe3\x2b\xf4\x5c\xdc\x4b\x11\x3a\x33\xcf\xec\xcb\xf2\xc9\xa6\x52\xf4\xe9\xa2\x30\x1a\xcf\xbd\x2f\x77\x8b\x17\xf5\x73\xa7\x8b\x5c\xff\x79\xd1\x70\x02\x09\x25\x0e\xc1\x1b\x95\x17\x4e\x45\x1f\x33\xcd\xb8\x52\xeb\x9e\xe9\x97\x9c\x5d\x6c\x4d\xff\xb1\x30\x85\x83\x22\x88\x29\x52\xab\x4c\x8c\x18\xf2\xd0\xdc\xb0\x3c\xb7\xe1\xe1\xeb\xcf\xe2\x7f\x79\xd3\x81\xbb\x14\x7c\xa4\xf7\x3e\xb6\x63\xad\x52\x2e\xba\xed\x97\x2c\xa2\xca\x44\x81\x2a\x55\x25\xc8\x8d\x2f\x85\x59\x0b\x8e\xef\xdc\x97\x54\x9d\x0e\x28\xde\xf3\xd8\x6a\x7b\xb7\x1a\xbd\xf8\xcb\x93\x6d\xb3\x1a\xff\xca\x2b\x31\xd9\x5e\x7b\x2f\xa6\xc5\xa9\xb9\x03\x8b\x1a\x1a\xcc\x94\x55\x92\xd0\x99\x12\x76\xef\x81\xfc\x9b\x88\x3a\x60\x6d\xbc\x49\x27\xb0\x0a\x75\x42\x9d\x1a\x77\x5d\x51\xaf\x54\x52\x35\xeb\xb7\xef\x06\x98\x62\xda\xcc\xf8\x54\x66\x74\xe0\x35\xb4\x01\xe5\xac\x9e\xf3\xd7\x9b\xa0\x84\x3e\x82\xb3\x11\x3b\x0c\xa5\xda\x8a\x01\x9e\x9a\xd4\xe1\xc4\x16\xf0\x6d\x31\xb7\x62\xd6\x68\xe6\xe8\x01\xae\xae\x6a\x63\xee\xaa\x96\x6a\xe3\xff\xb5\x2a\x94\x20\x10\xbf\x03\xf2\x8a\x1f\x55\x91\x7f\xb7\xe8\xaa\xe2\x1c\x0c\xab\x4c\xa1\x94\x4a\x9d\xe8\x6e\xa7\x5b\x26\x56\xfe\xea\x54\xda\x9f\xe7\xe6\x26\xde\xe4\x11\xf6\x54\x84\xad\x1d\x96\x25\x43\x2a\x7b\x76\x1b\xff\xf4\x58\xad\x81\x2b\x04\xa5\xb2\xda\x30\xd7\xd8\xc6\x56\xa9\xa3\x4e\xa5\x2a\xc8\x23\xf3\x3b\xfc\x5c\x4f\x11\x4b\x8c\x74\x18\xc9\xcb\xbd\x1e\x40\x83\x58\x1f\xda\x90\x18\xff\xb3\xd6\x2a\x43\x03\x10\x9c\xed\x9b\x28\x24\x6f\x71\x32\xd5\x67\xd4\x72\x7a\x33\xcc\x65\x03\xae\xbe\xb6\x7d\x6c\xf3\x2f\x3d\x48\xcc\xe4\x42\x38\xcc\x8f\xf8\x3e\xee\x1a\x57\x77\x8c\xa2\xd6\x74\x7e\xdc\x16\xeb\x76\xcd\xad\x28\x93\x7c\x51\xf2\x95\x37\x4c\x30\x2f\xbd\xad\x84\xf8\x92\xac\xdf\x23\xda\x25\xb9\x8b\xa4\x54\xe9\xdb\xb5\x4a\x03\x9b\x1e\x7c\xe5\xa2\x45\x59\x66\x6a\x4f\xf5\xff\xcf\x55\xba\x1e\x2e\x47\x30\xef\x46\xce\x80\x3c\x57\x5d\x93\xc1\x88\x17\x7b\x0b\xfd\xcb\xa8\xc7\x6c\x93\xce\x8c\x10\x7f\x89\x21\x2a\x9b\x1f\xcd\x93\xd8\x6e\x25\x4e\xdc\x28\xc8\x6d\x0d\xf1\xd9\x10\xee\x7a\x67\x61\x47\x5d\x2d\x5f\x85\x93\x7d\xf2\xf9\xaa\x79\x2d\x96\xd2\xf3\xf5\x5f\x0a\x12\xd6\x83\xad\x38\xe3\xb7\xf1\x20\xda\x3c\x86\x4f\xca\x5b\xc9\x3d\x4d\xaf\xfd\x6a\x17\x79\xb8\x3e\x5a\xaa\x85\x40\x8c\xd7\x56\x0d\xdf\xca\xb4\x19\x59\x50\xae\xa7\xdc\x01\xd1\x1c\xaf\x43\x64\x35\x09\xbd\x0e\x40\xed\x78\x92\xb7\x8e\x47\xb7\x65\x96\x11\x95\xb9\xaa\x71\x7d\x04\xc3\x75\x5c\x32\x2a\x37\x31\x44\xb7\x2f\x62\xef\xde\xdc\x80\xdf\x18\x5d\x1e\x7b\xa5\xd2\x7f\xd7\xce\xfa\x11\xf3\x97\xfe\xd0\x41\xa9\xde\x44\x77\xd7\x09\xca\xca\x48\x89\xcb\x05\xbf\xce\x51\xfd\x79\x67\xff\xf4\xee\x5c\x7c\x2e\x8d\x50\x1a\xc3\x23\x46\xb2\xd8\x83\xec\xdb\xf1\x93\xe5\x11\xfe\xe3\x91\x98\xc4\x04\xe3\xac\x13\xcb\xe9\xf1\x94\x61\x7e\x23\x59\xeb\xc7\x02\x07\xcb\x3c\x1f\x41\x87\xbe\xc6\x84\x82\x49\x08\x74\x94\x7d\x55\xcc\xb4\xa3\x00\xcf\x8b\x88\x61\x03\xf0\x7d\x3b\x82\xb8\x55\x7e\x29\x05\xfa\xe2\x74\x8d\xfa\x3e\x5e\xb1\x55\x0f\x62\xbb\x4e\xc2\x31\x0c\x7c\x07\x69\xf5\x74\xfd\x73\xfc\x44\x7e\xba\xea\x33\x4f\x8f\x55\xec\xbf\xe8\xaa\x71\xd5\xe0\xb8\xfe\xaf\xc2\x06\x75\xa8\x06\x88\xad\xf4\x92\x45\x91\xc7\xe6\xdf\x9b\xf6\x5f\x97\x62\x77\xab\x14\xe7\x69\x3a\xb7\x3b\xa5\x7c\xfc\xad\xf2\xb7\x4f\x45\x21\x5c\xbe\x8b\x54\xde\x4d\x96\x30\x5c\xb8\xaa\x54\x2d\x74\x6f\x0d\x4b\x73\x05\x67\xef\x5a\x4d\x3e\xad\xc1\x6f\xa5\xc1\xb2\xc2\xb8\xd0\x7e\x94\xb4\x17\x46\xfd\x8a\x96\x72\xdc\x1e\x05\x7f\x3b\x8d\x1f\xfc\x3b\x46\x90\xc2\xf1\x6a\x3a\x9f\x24\xb1\xdc\x9d\x8e\x5f\x09\x88\xea\xee\xfe\xf8\xff\x3c\x3f\xc9\xf4\x41\x4a\xaf\xa3\x8c\x1f\x43\x57\x35\xa4\xfd\xeb\x56\xdd\xa7\x13\xaf\x4f\xd2\x5f\xed\x04\x03\x17\xa4\x19\xb1\x1e\xc2\x2d\x02\xe3\xeb\x0b\x42\x8a\x82\x37\xf8\xed\x22\xee\x37\x05\x78\x6b\x59\x5f\x66\xbe\x5b\x6d\xbc\xd1\x5e\x8c\xde\x33\xaf\x3c\x3f\x96\x37\x53\x5a\x13\xa3\xf1\xf2\xcc\xa4\x5f\x5b\xcd\x0b\xf3\x34\x65\x78\x10\xba\x6a\x7f\x87\x6d\x73\xc6\x75\x4b\xf2\x88\xee\xbd\x89\xea\xed\xbc\x5a\x44\xfe\x89\x78\xe2\x25\x30\x78\x1f\x2c\xda\x59\x2e\xba\x1b\x3e\xfb\xb4\xfe\xfc\x7d\xa8\xf4\x5e\x58\x8c\xeb\x6c\x06\x0d\xea\x47\x55\xfc\xc3\x4c\x9c\x62\xa6\xaa\xa7\x73\xd5\x7d\x67\xc8\x2b\xdc\x57\x38\xf8\xcf\xf1\xc8\xfa\xd2\xd2\x0e\xcf\xfb\xcd\x13\x5e\x4e\x68\xc7\xd4\x0f\x03\x71\x9b\xa0\x59\x40\x41\x6c\x5b\x7b\xad\xbf\x91\x88\xd2\x70\x75\xfb\x49\x1d\x91\xfb\xfb\x37\x27\x27\x48\xc7\xf5\xf1\x42\xa3\xc5\x5a\x96\x97\x67\xde\xdf\xc2\xf8\x8b\xa6\x2a\x65\x84\x33\xde\xd7\x64\x46\xb9\xf1\x47\x5a\x62\x67\x36\x6a\x56\x14\x75\xea\xa7\x39\xe4\xbe\xa9\xad\xae\x56\xd2\x95\x4b\x73\xc4\xc3\x8e\xa8\xeb\x42\xef\x84\x32\x1e\xd7\xe4\xaf\xbe\x80\x94\xce\xfd\x08\xa5\x7e\x68\x5d\xb6\x3a\x93\x4d\x71\x77\xf4\xb3\x8c\x37\xfa\x42\x4e\x51\x6a\x2d\x5a\x83\x07\x5d\xbf\x4b\x2e\xa8\x2b\x99\x3d\xf8\x98\x57\xe8\xf7\xac\x15\x75\xad\xf9\x2c\x08\xa5\x53\x08\x54\xbc\x41\x5f\x4b\xdb\x27\x51\x11\x0d\x40\xef\xa2\xad\x4e\xfc\x43\xfc\x8a\x2c\x84\x81\x9a\x4a\x5a\xf3\xa8\x0a\xde\x0f\x01\x37\x84\x20\xce\xf5\x11\x9e\xb0\xec\x16\xab\x4a\x9a\x33\x84\x0e\x17\x57\x52\xb2\xc6\x38\xb0\x17\xd5\x6e\x78\x3e\x0a\xe5\x93\x50\x43\xd5\x46\x04\x4a\xcc\xfc\xe8\xe7\xf6\xe6\x5c\x87\xac\x46\x67\xa8\xfa\x8c\x48\x54\x4b\x69\x8b\xc8\xa9\x40\xfb\xc1\xc1\x41\x85\x9e\xc1\xcc\x4c\x85\xc5\xaa\xe3\x49\x93\xe1\x79\x5c\xb2\x6c\xb5\xc6\xf2\xcb\xb4\xed\xcb\xb8\x6f\x58\xdc\xab\x93\x4a\x35\x85\x35\x71\x93\x7d\xd7\x11\x8d\x3c\x09\x9f\x58\xb5\x7b\x0e\xf1\xc9\xab\x91\xcb\xdf\x90\x02\x6a\x27\xbd\x9b\xf4\xf2\xa1\x95\x07\x5a\x33\x2d\x51\xb4\x33\xaf\xb7\x5b\x27\x22\xbe\xba\x0d\x81\xba\xf4\x89\xbc\x5a\xd6\x0f\x5e\x63\x3d\x0d\x35\xbf\xa3\x43\xdd\x8d\x4f\xed\x11\x5f\x97\x73\xca\xfc\x36\x2b\x50\xf6\xcc\x4c\xf3\xbc\x9b\xb6\x7a\xdf\xbf\xce\x5e\xd1\xf3\x03\x73\x53\x30\xe2\x41\x2e\xd0\xfe\x7f\x45\x11\x08\x2e\x4a\x3f\x47\x3c\x18\x6c\x44\xfe\x26\x2f\x31\x50\x3e\x64\xcd\x36\xd7\x6a\xfe\x7f\xda\xbb\xd2\x70\xa8\xd7\x36\xfe\x9f\x19\x44\x88\xb4\x91\xf5\xb4\x38\x45\xa1\x8c\x25\x8c\xad\x54\x44\x26\x0c\x49\xc4\x11\xda\x18\x4b\x88\x19\x4c\x0b\xce\xc9\x7a\x6c\x25\x35\x14\x92\x11\xa3\xd0\x60\x66\x70\x28\x6a\x2c\x43\x8e\x25\xc3\xcc\x14\x43\x0d\x63\x06\xc3\x60\x2c\x2f\x75\x4e\xe7\xfb\xfb\xe5\x3d\xef\x75\x75\x3d\xbf\x0f\xcf\xfd\xe5\x7f\x5d\xcf\x73\xdf\xf7\xef\x77\x3f\xff\x2f\x3f\x26\x2a\x90\x99\x3e\xad\x3e\xee\xcb\x48\xb0\x65\x45\x66\x29\x64\x0a\xc1\xba\x97\x4d\x4e\xa1\xe4\x98\x34\x4f\x35\x2a\x8a\xaf\xb8\xad\xc9\xe8\xec\xce\x4c\xeb\x08\x47\x43\x57\x96\xa3\x13\xd2\x7e\x55\x1b\xa1\x7b\x65\x29\xf3\x2a\xd7\xc4\x86\x9f\x53\x49\x32\xbf\xfe\xf3\x38\x41\x49\x04\xfd\x55\x97\xa8\x3c\xc2\xbd\x8f\xf6\xab\x41\x03\xd7\xec\xf4\x21\x37\x9e\xe7\xbd\x16\x9f\x5e\xcc\xbc\xb5\x20\x15\x02\x61\x5c\x74\x77\x5d\x0d\x83\x5c\x90\xe6\x49\x50\xee\xda\x3e\x4a\x6f\xcf\x8f\x53\xfe\x29\xb1\x64\x90\x5c\x04\x9f\xc3\xee\xb1\xc5\xd5\x04\xf7\x49\xb0\x9a\xeb\x68\x2b\xed\x9a\x7e\xf6\x7d\xc9\xd3\x73\xa3\x96\x74\xba\xbb\x65\xcd\x98\xc4\x47\xac\x15\x8b\xd8\x7e\xd5\xa7\x69\xd5\x8d\x1d\x8e\x4a\xc5\x7d\xf3\x69\x97\x42\xa3\x94\xe7\x83\x35\x07\x86\x1e\x45\x3d\x40\x4f\xf1\xdf\xb9\xe6\x95\xe1\xb8\x02\xe3\x80\x01\x8a\x64\xab\xdd\x6f\x0b\x3d\x76\xc2\x13\xfb\x0b\xcd\x10\x06\x4b\xe3\x45\x1d\x13\x86\xaf\xe9\xf2\xbf\xb8\x74\xef\x63\x5f\xd2\x6d\xb8\xde\x93\x05\x9f\xcf\x2a\x26\x86\x63\x38\x7c\xc5\xc9\x69\xd8\x9b\xda\xa8\x17\xab\xfa\x76\xf0\xa7\xe3\xc7\x58\x2e\xdb\xe1\xaa\x7d\x97\x90\x67\xa2\x59\x4a\x7a\x82\x95\xec\xd9\x2d\x76\xbc\x01\xc7\x79\x31\x50\x6e\xbb\x6f\xc5\x87\x45\xb7\x43\xd8\x21\x23\x99\x40\xca\x34\x50\x02\xff\x84\x3f\xf3\xb0\xb7\xe6\xa7\xb1\xa7\x8a\xd1\x85\x13\x4b\xd0\x42\xbe\x6e\x96\x59\xda\xc5\xfa\x78\x27\x72\x74\x7d\xc5\xf9\x9e\x73\x79\xaf\xe8\x5d\xa9\x84\xf0\xc4\x96\x22\xd0\xeb\x83\x4e\xba\xa0\x5e\xc3\x18\xaa\x9d\x30\xf7\x68\xf1\x60\x4b\x55\xb0\xa8\x1e\x77\xe3\x11\x14\x3e\xbd\x35\x5c\x97\xf6\xea\x8b\xfc\x43\xc2\x18\x09\x36\xc6\x39\x82\xa4\x46\xa0\x46\x38\xd2\xdd\xc6\xc3\x1f\x4a\xa3\xee\x92\xcc\x5f\x14\x07\x7c\xc2\xcb\xa6\x95\x40\x1a\x6e\x75\x0e\x47\x53\x7e\x83\x5a\x34\xe6\x4b\xa1\xad\x92\x0e\xef\x08\x79\xff\x24\x6a\xa3\x1e\x57\x24\x62\x4d\x35\xe8\xba\x89\xbd\x43\xbb\xfc\x90\xb7\x67\xf0\x75\xde\x57\x5b\x23\x29\x8a\xc2\x47\xa5\xef\x68\xa7\x1a\xbe\xd8\x5c\xba\x07\x90\x09\xf0\xb8\xfc\x76\xfd\x87\xf9\xf4\x1a\x8a\x17\x95\xe7\x37\xff\x8d\x91\x9a\x6e\x9a\xad\x4f\x2b\xb2\x40\x96\xc3\x14\x60\x1e\x8b\xc9\xb1\xad\xb9\x9a\xd0\x06\x41\xe5\x84\x2e\xbf\xca\x0e\x8b\x85\x8e\xf6\xf9\x43\xa0\x5d\xd0\xbb\xb3\x97\xa7\x67\x3d\x2c\xad\x61\x6d\x91\x55\x5a\x40\xa1\x51\xd8\x0b\x46\x87\x7c\x53\x35\x05\x96\xf1\x92\x70\x74\x9f\x40\x3f\x05\xff\x7b\x45\x78\xf2\x53\x12\x79\xc9\xa4\x2d\xd8\x84\x1d\xde\x82\xc3\x22\x60\x25\x91\xce\xb3\xcf\xd9\xd2\x2e\x3d\x28\x59\xcd\x01\x46\x61\xd4\x39\x25\x44\x09\xa4\x1f\x6d\x50\xc8\x9f\x39\x30\x98\x83\xba\xd7\x79\x41\x2f\x9e\x27\x93\x1d\xea\xc5\xa6\xfc\x21\x6e\x63\xb8\x32\x28\xea\xb3\x93\x9f\xd6\xc4\x71\x7c\x76\x83\x2a\x16\x8b\xcc\x4e\x3b\x30\x45\xda\x8f\xb4\x4f\x59\x84\xe1\xaa\x2e\x3b\x30\x45\xfc\x37\x7e\xd4\x2a\x0f\x90\xde\x8b\x4c\x9a\xd6\xd4\x94\xea\x3f\x0b\x3a\x37\x6e\x0b\x94\xd4\x9d\xba\x8d\xb5\xa0\xe6\xf9\x75\xea\x7e\xae\x92\x89\x5c\x67\xbd\x68\x25\x51\x78\x5e\xda\x5b\xda\xb9\xfc\xd7\x27\xbb\xed\x62\xe2\x52\x59\xbb\x4a\x37\x60\x22\x9b\xb6\x02\xf8\xac\x04\x53\xca\xd9\xca\xfc\x11\xb6\x72\x7e\x2f\x16\xab\x3a\x4a\xce\xa9\x14\x54\x70\x2e\x6e\x2c\x7e\xe5\xe5\xd3\xb4\xa2\xa7\x11\x8e\xe5\x8e\x6b\x30\x04\x1a\xb7\x75\xb5\xba\xcb\x7c\x69\x57\x84\xea\x79\x83\x87\xd6\xa6\x6c\xa9\xa8\x38\xd3\xa3\x8c\x11\xf3\xf5\x59\xab\x5a\x13\x38\x26\xaa\xa9\x0b\x9c\x07\xfb\x3e\xc8\xf3\x89\xbf\xfc\x1a\xf7\x87\x64\x0c\x20\xa2\xee\xce\x4a\xa0\xe4\x58\x66\x84\xe6\xa2\xb0\x3c\x8e\xc4\x9f\x64\x6f\xaf\x82\x49\x10\xd3\x3a\x02\x45\xb8\xb2\xb5\x49\xbf\xb4\x2d\xd9\x3b\x8b\x41\x0c\xe6\x56\xd6\x49\xf6\x2f\x43\xac\x90\xb1\xb2\xfc\x9d\x85\xae\x6c\x4f\xc4\x32\xfb\xfc\x6c\x5b\xc3\xdf\xc5\x9c\x2e\x81\x5e\x1f\x8a\xcb\x40\x3a\x9a\xdc\x97\x1d\x7b\x16\xf1\x23\xc8\xb7\x24\x00\x83\x3d\x81\x18\xa0\x60\xbc\xe5\x30\x5c\x95\x8c\x83\x58\xd3\xfd\xa1\x87\x65\x58\x61\xcf\x4a\x08\xaa\xfd\xa8\x6c\x67\xe5\x6e\xef\xc7\xc5\xe8\x56\xf5\xd8\x84\xd0\x25\x5d\x7e\xd7\x53\x2b\x35\xc7\xc9\xd1\xba\x3c\xf6\xd0\x63\xc4\x42\x44\x9f\x83\x0d\x3e\xad\x62\xed\x48\xd0\x5e\x89\xd9\x13\x8e\x2b\x5e\x78\xee\x5a\x27\x8c\x70\x5f\x7c\xb8\xa6\x4f\xdb\x7a\xae\xe5\xde\x5d\xed\xcc\x21\x77\x0d\xc1\x0a\x82\xdd\x19\x0e\xbf\xf9\x1e\xa3\xc3\x0b\x7a\xba\xc7\xad\xd5\x43\xb5\x18\x74\xa3\x66\x07\x68\xde\x06\x63\x7f\xc5\xc7\xf2\xc5\xf3\xe9\xb9\x4d\xe0\x88\x9f\x2f\x51\x21\xf0\x5b\x1a\x37\xe1\x3b\xee\x3b\x9c\xce\x35\x2f\xb6\x4b\xc8\xb5\x4e\x5d\x11\xe9\xec\xf3\x62\x57\x1a\xbc\x1d\x22\x1e\xef\xa0\x8b\x66\xd4\xad\x96\xa4\x83\x22\x50\x97\xf1\x59\x53\x87\xfe\xf1\x4a\x0d\x4c\x81\x30\x61\x80\x13\x9d\xb6\xd1\xa6\x0b\xcc\x73\xe2\x83\x1a\x76\xc0\x3b\x9d\x1a\x0b\x96\x3e\xbb\xc5\xe3\xd8\x12\x9e\x17\x83\x62\x7c\xb2\xea\xc3\x53\x65\x67\x83\x54\xf4\x90\x93\x72\x14\xba\x70\x1f\xca\x4d\x09\xb9\x65\x5f\x95\xd7\x42\x44\xf7\xa1\xe6\x9a\x60\xbf\x23\xd4\xb1\x26\xa5\x9a\x92\xc7\x62\x0c\xdf\xa3\xaa\xc5\xa3\x4a\x5f\x5b\xa5\x83\x16\x42\xc7\xce\xcc\xa9\x43\x91\xc8\xfb\x1e\xd0\xfa\x8b\x84\x98\xcf\x52\xbf\xee\x2b\xb6\x17\xf8\xa9\x18\x08\xcf\x9b\x19\xff\x59\x23\xb4\xb0\xf9\x22\xe8\x11\x96\xe5\x1a\x39\xf6\xd6\xcf\x7a\x43\x72\x33\x08\xbb\x1c\x67\x82\x73\x7c\x02\xe3\x28\x78\xa5\xa2\x3d\xad\x45\xef\x86\x50\x52\x6f\xbb\xd1\x35\x0e\xb3\x19\xc4\xbe\xa7\x12\x8d\xd7\xcb\xa0\xf6\x01\xf1\x2c\xf9\x4f\x64\x2b\x71\x97\x1a\x14\xb2\x7c\x72\x3b\xfe\x44\x4e\x6b\x63\xf5\xc2\xb8\x7e\x4e\xbb\x2f\xcd\xdb\x77\xdd\xb6\x39\x60\xab\xb9\x45\x57\xcc\xbe\xa9\x60\x35\x14\x8c\x00\xce\x5b\x94\x03\xe6\xe1\x0d\x9b\x31\x48\xf5\xc4\x8e\xa1\x8e\xc5\x83\xf7\x25\x05\x5b\xa4\x3a\xe6\x0f\x0b\xcd\x2c\xf6\x45\xaa\xf4\xe7\x78\xd3\x0e\x36\x56\x73\x01\x17\xff\xe4\xe6\xcc\x4c\xd7\x49\xac\x13\x39\xea\x59\xe4\xf8\x63\x3a\xbd\x14\x8e\xe4\x1e\x80\x18\xe8\x1b\x52\xed\xea\xc7\xd3\xe4\x4c\x16\xa0\x21\xef\xfe\xb1\x22\x37\xff\xd6\xa1\xc7\xf1\xcc\x61\xcd\xc8\xf7\x9e\xb8\xdf\x05\xbb\x5a\x0b\xff\x24\x82\x70\x1e\x7c\xa3\x58\x6d\x1c\x5a\xf2\x4e\xb0\xcc\xe6\xda\xd2\xb9\xd9\x37\x00\xfa\x96\x0a\x6b\x22\x54\x82\x53\xb7\x63\xf1\xd1\xe3\x24\xc9\x45\x5f\xc3\x4d\xd2\xbd\xcf\x3c\xb9\x62\xf9\x81\xab\x8f\x1c\x6a\x4f\xd2\xe9\x11\xbd\x06\xae\x26\x71\x38\xae\x7f\x7c\x90\x72\x73\xa8\x7c\x9f\xd7\xf9\xf8\x20\xd5\x65\x9d\xf5\xf7\x71\xbf\x3a\x80\x6d\xc5\x0a\x20\x0d\x3e\xbe\x35\xd5\xb4\x0d\xf3\x77\xa5\x56\x28\x52\x19\x17\x85\x0a\x19\x53\x11\x03\x67\xae\x70\x94\xf7\x0e\x92\x36\xf1\x11\xb8\x7e\x22\x5a\x2c\xe2\xf7\xcc\x43\x0a\x55\x6f\xa8\xca\x02\x7c\xd7\x1f\xca\x0d\x5c\xfd\x54\x81\x36\x9b\x53\xfa\x64\xc2\xdd\x22\xfb\xf9\x6d\xd2\x4b\x5f\x33\x47\xc4\x25\x5a\x00\x81\xc6\x19\x16\x7c\x5a\x29\x0a\x43\x91\x5d\x1e\xf7\x69\xa4\x20\xd7\x08\x5a\xbb\x20\x63\x45\x2e\xa3\x52\xdd\x85\xce\x24\xe0\x73\x8d\x66\x04\xb2\xb5\xed\x08\xb2\x3a\x2a\xf9\xbd\xa6\x36\x38\x2d\x1e\x3e\xb7\x49\x76\x74\xdb\x84\xbf\xec\x7b\x84\xbc\xc1\x9c\x99\x16\xc2\x7e\x76\xce\x49\xd7\xde\xc7\x77\x32\x6f\xf4\xf2\x40\x25\xc2\x92\x88\x0c\x51\x0a\x8d\x79\x58\x7c\x81\x5a\xf0\x31\x1b\x6a\x92\x1e\xe7\xf7\x9e\x67\xbb\x73\x0b\x34\xbd\xb9\x12\xb5\x46\xcc\x03\x45\xed\xa0\x6a\xb9\x2a\x4e\x0a\xf0\x61\x0e\x73\xf5\xd9\xad\xc7\xb9\x05\x19\xd1\x12\x52\xac\xd4\xf4\x35\x1d\xf9\x09\xc3\xdd\x6b\xd9\x46\x2d\x6f\x07\x7f\xec\xd2\xea\x24\x6c\x6b\x38\x64\x79\x21\xe7\xe9\x43\xb2\x96\xd0\x59\x3c\x1c\x4b\x15\x6c\x2a\x17\x30\x74\x5c\x02\x10\x48\xae\xdc\x95\x4b\xee\x74\x8f\x03\x9d\xd7\xfe\xea\xcb\xb5\xa7\x1b\x03\x7e\x53\x5b\xef\x99\x94\x3e\x2d\x80\xa3\x95\x7f\x3a\x05\x30\xb6\xe3\x21\xc0\xc7\x5a\x31\x4f\x36\x8a\x9b\x6d\x98\x50\x56\xf7\xb3\x8f\x40\x6d\xa8\x40\xc3\xf4\x92\x3a\x92\x62\xca\xaf\x37\x17\xf0\x2e\xeb\x24\xae\x29\xb2\x29\x2d\xa9\x13\xea\x32\x69\x45\x79\x01\x75\x15\xe9\xf3\x58\xd8\x16\xfb\xd6\x7f\xbd\x88\x14\x0b\xd7\x3d\x9f\x89\xa2\xe6\xac\x1c\x7f\x1d\x9d\x8f\xa8\x91\xf2\x37\x20\xf1\x1a\x04\x0d\xad\x57\xc8\x8f\x52\x43\xfe\x76\xe4\x56\x4a\x1e\x2f\xf4\x0b\xf2\x76\xdd\xae\x91\x37\x27\x0e\x12\x82\xd0\xb7\x48\xfd\x4e\x77\x7d\x54\xd4\xc8\xb6\x1d\xf4\xbd\x6a\x5c\x8d\x8a\x82\xc1\x02\x99\x9a\x57\x29\x51\xad\x36\x96\x6c\x01\x68\x5d\x76\xff\x26\xf6\xfc\x38\xb3\xa1\xbc\x6b\xaf\x6e\x36\x9b\x27\xb9\x36\xe2\x19\xb6\xfb\x97\xb3\x3e\x35\x11\x17\x76\x3f\x39\xf5\xb9\xfa\xe2\x9e\x49\xf5\x83\xbd\x34\xcd\xe6\x02\xf6\xfe\xc4\x12\x12\x4c\x99\xaa\xdf\x52\x1b\x8b\x0b\xcf\x7a\x80\xda\x61\x38\xb9\xba\x79\x34\xc9\xb4\x75\x8a\x6f\xf9\x7a\x9d\xbe\xbf\xfd\xf0\xa2\xe2\x3c\xdc\x70\xd8\x75\x07\xec\xeb\x80\x08\x58\x14\xd3\xd3\x90\xc8\x48\x25\xf6\x41\x77\x9f\xac\x04\xa7\xee\xf4\xa3\x7b\x68\x13\x92\xc7\x42\xf6\x45\x4a\x3e\x8f\xb9\xb7\xff\xfd\x64\x1e\x6f\x59\x9a\x69\xdc\xb2\x58\x4b\x82\x65\xfe\xbe\xd9\xb6\x17\x17\x19\x46\x1c\x0a\x20\xd8\x3f\x08\x0f\x5b\x75\x16\xaa\x08\x28\xdb\x1a\xd1\xe9\x0e\x7b\x2f\xec\xfe\x39\x50\xb6\x2d\xb4\x25\xb0\x54\x82\x52\x69\x72\xa2\x83\x6e\x6a\xca\xb5\xcb\x20\x2b\x26\x36\x47\x25\x91\xc8\x92\x20\xe7\x90\x04\xa6\x46\xac\x7d\x77\xeb\x6c\xdb\xf7\x73\xc5\xd5\x5b\xc7\xad\x4b\x16\xcf\x02\x04\xf7\x39\x4d\x57\xe7\x72\xc6\x89\x6f\x21\x5b\x0d\x93\xe4\x4e\xce\xca\x1f\xa5\xbc\x50\x3a\x15\xaf\x44\x4e\x18\xe1\xb8\xff\xc2\xae\xbc\x32\xa4\xf7\x2a\xa9\x6c\xfa\x60\xc4\xaa\xc3\x82\x52\x6f\xe8\xd9\x3e\x2f\xf7\xf8\x50\xe5\xb5\x4d\xf8\xa7\xc6\xda\x75\x96\x7d\x06\xc0\x03\x2b\xdf\x30\x37\x48\xdf\x97\x8f\x8b\x6a\xa0\x2b\x3f\x3f\xb6\xed\x78\x10\xb0\xfa\x50\x7d\x4f\xe4\x06\x17\x8b\x14\x59\x3e\x60\x6a\x4e\xd3\x37\x7d\x90\x13\x29\xce\xc8\x4b\xb2\x95\x8f\xad\x14\x2f\xd8\xbe\xf9\x4e\x75\xf9\x5b\xa5\xa3\xf3\xaa\x8a\x5a\x06\xda\xc9\xf9\x6d\xd0\x7a\x6e\xe0\xe3\xe3\xa3\xdd\x6f\xdc\x97\x0c\xc7\xd0\x63\x44\x6b\x67\x3c\x76\x27\xce\xa4\x83\x71\xc6\x85\x64\x07\x21\x59\xdb\x54\x0b\x4b\xab\xf0\x5f\x68\xe3\x9b\xce\x96\xad\xd4\x97\xc8\x4d\x75\x75\xd0\x17\x64\x79\x4c\x99\x95\x6f\x70\xde\x16\x03\x88\x63\x66\x80\xf2\x97\x5e\xaa\x21\x06\x81\xed\x9f\x89\x92\xe2\x1e\xd7\x23\x05\x6c\xb1\xa4\xf6\x25\xe1\x9e\x8a\xb9\xd3\xc1\x27\x82\x66\x27\x38\x45\xb6\x43\x2e\x94\x52\x47\x72\x95\x80\x15\xd2\x2b\xaa\xcc\x04\xa9\x7c\x05\x5d\x03\x82\xbc\xe4\x3f\xb8\x8a\xbf\x2e\x3b\x62\x76\x44\x7c\xba\xb2\x6a\xce\xc8\xdb\x9d\x7d\x8c\xfb\x24\xd0\x55\x07\x25\x3b\xe1\x2f\x16\xcd\x70\x78\xb9\xfc\x50\xe5\x75\x53\x36\x9d\x3c\x8c\x26\x20\x93\x6a\x85\x9a\xc3\x1a\xdf\x57\x59\xa4\x82\x8b\x18\x5c\x8c\x67\xaa\x8b\xe9\xd9\x30\xbf\x5f\xa1\xe1\x67\x05\x4e\x70\xba\x71\x98\xe4\x8d\xa8\x28\x54\x68\xec\x80\xa1\xb0\x5e\x7a\xda\x74\xe4\xfa\xe4\x19\x60\x5e\x97\xa1\xca\xa6\x11\x6b\x35\x09\x92\xa6\xf3\xb7\xcd\xbe\xa2\x3e\xf4\xb5\x2a\x00\x66\x9a\x00\xcf\xdb\xce\x4b\x1f\x8b\xde\x4e\x92\xf6\xc2\x63\xcf\x9c\x1e\x71\xe6\xd2\xb9\x13\xb3\x9a\xac\xc1\xdb\xfc\x49\xbb\x34\x96\xf1\x69\x86\x87\xa0\x92\xd3\x94\x0d\x2b\x0a\x4d\x3f\xbe\x5c\x8e\x04\xa9\xf2\xbe\xa1\x54\x73\x1e\x90\x05\x90\x92\x0d\x96\xee\xa7\xc2\x26\xad\xe0\x06\x8a\x3d\x15\x2e\x1f\x1a\x35\x86\x3d\xe6\x24\x57\xc4\xf9\x75\x63\xd0\x29\x8b\xb9\xec\x11\xcf\x77\xf5\xc7\xcb\xff\x74\xaf\xa9\x12\x64\x1f\x2e\xfb\xbe\x26\x8d\xee\x05\x21\xb7\x28\x26\xd0\x6c\x9e\x9f\xba\xc6\x09\x48\x49\x0e\x9b\x7b\xea\x46\xa4\x51\xdd\xae\xe2\xdf\xe7\x1a\x70\x06\x33\xdd\x3e\x1b\xdb\x31\x3c\x60\x3d\x84\xf0\xfb\x0a\x68\x7f\xcf\x51\x0f\xaf\x19\x30\xdf\x5c\xe6\x2f\x04\x88\xc1\x14\xcc\x15\x1a\xac\x7c\x81\x12\x25\x63\x6b\x09\xc1\x19\x53\xc5\x22\x03\xee\x72\x5b\xc8\x7d\x2c\x59\x1b\xb6\xfb\x2c\x0e\x59\x6e\x53\x13\x4b\x06\x33\x26\x56\x0f\xb6\xbe\x3c\xd0\x7a\xae\x73\x27\x2a\x8a\x64\x3a\x53\x6f\xca\x81\xee\x76\x51\x21\xf4\xd2\x0b\x32\x84\x5a\x9d\xc6\x5f\xf6\x8f\x85\x71\x29\xca\x13\x34\x88\x39\x20\xf2\x1d\x0d\x2c\x30\xd3\xe7\xb7\x39\x9f\x97\x18\x7f\x66\xd9\xa0\xd1\xb3\xa0\xe8\x21\x0b\xe9\x9f\xee\xc8\x5a\x0e\x46\x5d\xbb\x11\x4e\x32\x0e\xa9\x33\xee\x91\xb9\x31\x24\x2c\x40\xda\x33\xb0\x75\xb0\x28\xd4\xe2\x1c\x9f\xf3\x85\xe6\x06\x67\x60\xa5\xf2\xa4\x98\x2f\x2f\x02\xc4\xf1\xc6\xea\x6d\xc3\xce\x89\x6e\x87\x02\xde\x7c\xdb\x2c\x18\x07\x58\x89\xaf\x7f\xe3\xd7\xc2\x5f\xcf\xac\xb6\xac\x55\xce\xff\x31\x6e\x20\x81\xa5\xa6\x64\x65\x29\x00\x00\xd4\x64\x67\x08\x93\x9b\x9b\xed\xbc\x45\x52\x01\x39\x73\xf0\x49\x40\x42\x0d\xb4\x07\xf3\x23\xf8\x37\x05\xc3\xe0\x1b\x20\x25\x62\xa8\xce\x5a\xba\x30\xe6\x6d\x8c\xda\x13\xf2\x06\x09\x20\x35\x00\xfc\x03\xff\x13\x78\x2a\x61\x36\x30\xfb\x5f\xdc\x06\xad\xe5\x43\xe4\x97\x95\x5e\x58\x63\xf5\xe5\xc4\x1f\xd5\xfa\x6f\x0d\x3c\xcf\x03\xda\x18\xef\x96\x64\xd1\xb5\x74\x01\x77\x16\xdc\xfc\xba\xf4\x92\x36\xff\xb8\x9d\xff\x2a\x58\x01\xf7\x03\xa4\xc0\x52\x76\x6a\xfe\x0e\xe9\xf5\xeb\xb4\xb6\x3a\x7d\xac\xcc\xd2\xf3\xd6\x7f\x00\xe5\x14\x30\x40\xce\x9c\x00\x00")
+
+func imagesLgtm_approvedPngBytes() ([]byte, error) {
+	return bindataRead(
+		_imagesLgtm_approvedPng,
+		"images/lgtm_approved.png",
+	)
+}
+
+func imagesLgtm_approvedPng() (*asset, error) {
+	bytes, err := imagesLgtm_approvedPngBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "images/lgtm_approved.png", size: 40142, mode: os.FileMode(420), modTime: time.Unix(1792052089, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _imagesLgtm_comicPng = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xed\x7c\x67\x54\x53\x5d\xb7\xee\x4e\x48\xe8\x25\xf4\xde\xa5\x23\xa0\x48\x47\x3a\x2a\x45\x9a\xf4\x1a\x14\x51\x3a\x48\xaf\x09\x1d\x44\x51\xa4\x2a\xbd\x2b\x5d\xe9\x35\x01\x14\x94\xaa\xf4\x26\x04\xa4\x49\x0d\x3d\xd4\x5c\x7d\xcf\x38\xe3\x9e\xdf\xf7\xc7\x3d\xdf\x8f\x37\x73\x8e\xec\x31\xf7\x1e\x59\x6b\x66\x3e\xcf\x9c\x6b\xed\xb5\xc6\xde\xf1\x06\x7a\x77\x29\x48\x59\x48\x01\x00\xa0\xd0\xba\xa7\x61\x04\x00\x60\x24\x00\x80\x30\xc4\x84\x7f\xce\x48\xfd\x98\x5b\x06\x00\x9d\xfb\x5a\x1a\xaa\xc6\x01\x59\x5b\x13\x20\x0a\x00\x20\x07\xe7\xed\x5e\xaa\x5b\xa1\x09\xd5\x48\xaf\x00\x20\x83\x07\x09\x16\xfc\x57\xff\x7f\xe9\x14\x01\x93\xdf\x26\xfc\x0f\x32\x40\x78\x06\xca\xf7\x95\x84\x3b\x03\x81\x0a\x00\xf9\x57\xff\x57\x14\x2d\x0b\x50\xfd\x1b\x85\xff\x3d\x7d\x41\x80\xfd\x37\x0a\xff\xbb\xf4\x47\x7f\x7c\x44\x44\xf0\xa7\x1a\x11\x7f\xbe\xa8\x0c\x8e\xf2\x1a\x65\x02\x5d\x43\x42\x5e\x03\x34\x2a\xe0\x7b\x00\x09\xd7\xbf\xc6\x7f\x90\x81\x6e\x02\x6c\xff\x8d\xc8\x7f\xae\xd1\x03\xc5\x82\x65\x8f\x77\xa4\xeb\x9e\x6f\x25\xfe\xc2\xc9\xbc\x63\xb2\xa2\xaf\xa4\x72\x1b\x2b\x72\xab\xa8\xb4\x54\x27\xfe\x4c\x54\xf7\x59\xb8\xb2\xfe\xfd\x17\xb5\x62\x7a\xde\x8e\x37\x0c\xbf\xc9\x66\x19\xeb\xc5\x8b\x0c\x48\xd3\x8e\x4d\xce\xcb\x8c\x8d\xd4\x3b\x6d\xcc\xd3\xe9\x2d\xe8\x18\x7e\x3c\xf6\xb7\x70\x98\xc6\xf0\x66\xfa\xda\x18\x9a\x9a\xf9\xcb\x54\xb4\xb4\xe2\x6a\x5c\x78\x32\x45\x7b\x83\xaf\x64\xc9\xbd\x93\x33\xe7\x14\x4e\x72\x9a\x4f\x2f\x13\x63\xdf\x4a\x36\x27\x69\xad\xce\x7f\x7b\x32\xf4\x96\x08\x64\x00\xd0\x01\x50\x95\xff\xa0\x03\xda\x32\xd3\x90\xee\xb1\xee\x5b\xe2\x5b\x60\x6f\xe4\xdb\x8e\xa1\xe7\x03\xad\x8f\x1f\x84\x6b\xa9\xd8\xb8\x3a\x1f\x48\xa9\x28\x4b\x60\x5c\x10\x39\x1f\x06\x95\xc1\x3b\x60\xff\x90\x76\xf6\xb7\xa8\x27\x22\xfc\x00\x29\x12\x9c\x07\x08\xfe\xf7\x21\x91\x1d\x49\xe9\x74\x1d\xcd\x93\xc7\x6c\x0e\x7b\x2b\x0d\x3d\x96\x63\xb9\x87\xb6\xe7\xf4\x1c\x9c\x2c\x47\xfb\x52\x69\xd1\xff\xf9\xa4\x7a\x59\x3a\x3f\x74\xd3\x64\xde\xf5\x22\xd2\xd4\x09\xf7\x83\xbf\xad\xae\x54\x69\x6f\xcf\x23\xbd\x49\x3c\xf7\x3c\xef\x3b\xfc\xe9\xe8\xf9\xb1\xda\xff\x75\x6a\x80\x14\x68\xbd\xfc\x6e\x39\xb3\x42\x58\x33\xcc\x02\xb6\x5b\xed\x5c\xab\x69\xa4\x36\xa7\xd2\xad\x15\x4f\xec\x26\x0f\x24\xce\xd9\x3b\x12\xf5\x14\x2b\xce\x5f\x59\x41\x73\xb8\x08\x76\x2e\x33\x79\x4e\xb1\x68\x7e\x66\xbf\xc5\x5e\x77\x07\x90\x23\x65\xfb\x78\x87\xbe\x88\x2c\xc3\xf5\xe5\xcd\x27\xca\x9e\xd3\x2c\xf4\xcf\x15\x24\x16\x5c\x5e\xd2\x79\x13\xcb\xda\x53\x80\x24\x44\x81\x9e\xce\xc6\x7a\xdd\x76\x29\xc6\x70\xdb\x70\x89\x64\xae\xac\xcd\x8f\x32\x43\x9d\x27\x90\x90\xe8\xcf\xf9\xd8\xe7\xbd\x79\x9d\x58\x45\x2c\xda\xd6\x00\x82\x93\x77\xbb\x43\xc2\x3f\x3c\x33\x20\xa8\x9a\x01\xe9\x78\xdd\xb0\xdb\x7f\xe5\x13\x57\x11\x21\x41\xd9\xc1\x2e\xfd\x20\x5c\xf1\x91\xe4\x63\x88\x3d\x0d\x4e\x5e\x04\x1b\xf8\x9e\x05\x36\x61\x0c\xba\xe8\x83\x76\xa8\xa9\x0a\x12\xc7\x90\x74\x46\xa3\xf5\xe0\x92\xdf\x4d\xf9\x88\x94\x53\x45\xdd\x99\x4e\x9b\xc1\xaa\x4c\xd1\x76\xf7\xb8\x60\xc4\x77\x89\xba\x42\x60\x2f\xba\xc4\x75\x66\x27\x55\x98\xe4\x21\x14\x61\x0a\xc2\x28\x61\xde\x08\x09\x0a\xe2\x3b\x8b\x93\xcc\xdf\xd7\xf2\x9d\x83\x09\xd5\xc5\x7f\x5e\x69\x7f\xcd\x07\x36\xa1\x78\x6e\x83\x0f\x21\x5e\x66\xeb\x57\x23\x88\x7b\x44\xb4\x0d\xa9\xce\xbf\x37\xd2\x55\x62\x51\x4b\x72\x96\x69\xe7\xca\x94\x5a\x0b\x85\xef\x2a\x37\x1a\x7e\x79\xb8\x76\x9f\x8d\x99\x75\x1e\xdf\x86\x5e\xf4\x85\xe5\xa1\x47\x52\x6b\x3a\xb8\xcd\x62\x0b\xbe\x34\xec\xf3\xdd\x73\x41\xef\x8a\x37\xda\xb2\xba\x6a\x46\x2b\x2f\xea\x13\x93\x13\x84\xbb\xc7\x9d\x88\xa3\x07\xa3\xdc\x0a\xdf\xb9\xfb\xc2\xac\xb9\x2f\x4c\xd8\xaf\xde\xc0\xcc\x29\x70\x64\xcc\xe1\x36\xcc\x97\x36\x69\xa4\xe1\xdc\xbf\xf4\x89\x2b\x60\x60\xf5\xde\xdc\x71\xb2\x8f\x47\xa6\x23\xaf\x22\x17\x10\x17\x95\x06\x6f\x1a\xe2\x61\xe6\xb6\x10\xf7\xfb\xb8\x77\xce\xc7\x9b\xca\x17\x6b\x6e\xda\x5d\x93\x9f\xac\xf5\x4f\x73\x45\xa2\xea\xf8\x2a\xec\xd2\x36\x5e\x6d\x13\x09\xf1\x28\xeb\xe3\x10\xea\x15\x88\x1a\xfa\x89\x64\x95\x91\x50\x9a\x7b\xfe\x3d\x39\x90\x02\x5b\xf4\xfe\x21\x78\xe8\x18\xeb\xbd\x66\xb7\x73\x7c\xb2\xfd\xe0\x83\xb5\xd7\x79\xaa\xcc\x05\xb6\x55\xfb\x28\xf5\xce\x02\x59\x00\x9a\xc1\x58\x4b\x1e\xbc\x01\xbd\x68\x1f\x74\xb8\xd5\xfa\x7c\x0b\xea\x4a\x71\x91\xd5\xfb\x34\xed\x71\x3d\xa3\x84\x8b\x40\x97\xf2\x7e\x37\xa5\xc5\x37\x4d\xc4\xb5\xd2\xa2\x64\x4b\x54\xe1\x85\x88\x44\x37\x43\x9a\x24\xad\x80\xca\x23\x82\x81\x53\xc2\x81\x2c\x4a\xc9\x27\xe8\x0a\xe6\xb0\xf9\xc9\x0f\x76\xb1\x5a\xbe\x06\x66\x6b\xa5\xf5\xcd\x1f\x06\x27\xc7\x54\xf0\x00\xb9\xe2\xd0\xf8\x55\xf8\xf2\x1f\xee\x6e\xc4\xb6\xe6\x76\xb2\x9b\x29\x47\xcf\xe6\x2e\x97\x54\xcd\x07\x3f\x75\x29\x11\xfc\xb0\x9a\x8b\xce\xad\x34\xd4\xf4\x62\x8c\xb9\x18\x63\xa1\xd7\xce\x44\xbd\x17\xde\xb4\x20\x6b\x73\x7c\x69\xd8\x73\x74\xd5\xee\xf0\x92\x7c\xf9\x15\x1a\x55\x99\x2f\x10\xbe\xae\xc5\x15\x44\x73\xaf\x45\x1f\xec\xa5\x10\x87\x48\x5d\xb7\x4e\xb6\x4f\x50\xb7\xec\x3f\xb1\x37\x94\xbc\x35\x1c\x0b\xbd\xff\x7d\x2e\x23\x53\x5a\x4a\x65\xfb\xf3\x94\x8c\xb1\x04\x65\xbc\xff\x6d\x72\x76\x18\x3b\xe5\xf9\x0e\xba\xfe\x64\x71\xe6\x7e\x53\x10\x41\x15\x02\xb8\x7c\xb5\xad\x40\xa7\x19\x5f\xd4\x4d\xe5\x66\xb6\x6f\xe0\x9c\x3c\xe8\xa0\xd8\x32\xaa\xe6\xe5\x6f\x09\xfb\xcb\x78\x09\x42\x34\x21\xe2\xd9\xe1\xea\xd4\xfe\x9b\xcf\xf6\xee\xbf\xd5\x9e\xda\x91\xf6\x5b\x3e\xab\x3f\x22\x32\xb0\x4b\xb4\x4a\x48\xe7\xaa\x32\xf3\xda\xc9\xb2\x1e\x4c\xef\xe4\x6e\x1a\x4f\x27\xb0\xa7\x8b\x91\x6e\xc7\x37\xb7\x1b\x19\xf6\xd8\xcc\x09\x84\xb2\x7b\xce\x31\x10\x23\x1f\x11\xe6\x69\x63\xf8\x74\xd4\xfc\xd7\xf0\x6e\x9c\x4e\xc4\x4d\xc1\x08\xf7\xa7\xd7\xb7\xe7\x70\xb0\xe3\x97\x73\x8b\x9b\x1c\x8d\xe3\xb4\x98\xf1\xc7\x16\xd7\x5d\x2e\x42\xbf\xb2\xbc\x41\x29\x36\x2c\xd5\xd4\x79\xdd\xaf\xaa\xb2\xa3\x18\x2d\xf9\x6c\x2b\x91\x78\xf3\x56\x4a\x24\xe6\xed\xbc\xf5\xed\x73\xe9\xac\xd6\x94\xa1\xef\xfb\xfb\x0c\x53\xb5\x0f\xd4\xfb\xf3\x29\xae\x73\x06\x43\x29\x5f\x29\xe2\x26\x68\x9b\x67\x14\x6e\x1c\xf9\x7a\x4a\xe4\xd5\xe6\xbd\xca\x89\xe2\x15\xa8\x94\x72\xcd\x79\x65\xfd\xdb\x7a\xe7\x26\x19\xae\xe9\xf5\xc1\x41\xeb\x15\x61\xb2\x88\x8f\xda\xd8\x15\xbe\x6b\xe5\xa4\x22\x55\xc6\x03\x8c\x00\xdb\x36\x42\x0b\xdb\x76\x17\x6a\x4e\x2e\xbc\x89\x56\x83\xfd\x8b\x11\xf0\xb0\x75\x4d\x59\xaf\x19\xcb\xc0\xd1\x5f\x2f\x9f\xa9\xdc\x46\xfe\x3e\x04\x12\xe3\x94\x4f\x3c\xce\x4b\xf6\x0f\x27\xac\x43\x1a\xb9\x00\xe9\x33\xde\xbe\x85\x69\x30\x1e\x79\x25\xfa\x33\x54\x29\xa5\x89\xfd\xdb\x82\xce\x51\x8e\x0d\xce\x6d\xaa\x19\x2a\x5d\x1d\xdd\xf0\xa5\xf9\x00\xdb\x13\x98\xf3\xf9\x92\x63\x6e\xb7\x67\xdc\x8d\x35\xb7\x00\xdb\xe7\x2b\x1b\xfc\x5b\x88\x7c\x18\x45\x40\xf5\x7c\x4b\xe8\x62\x48\x71\x5d\xb7\x98\xd3\xac\x38\xe3\x59\x79\x35\x17\x40\x99\xa0\x7c\x52\xdd\x20\x5d\xb1\x3d\x2b\xd1\x0a\x07\xae\xae\x35\x0c\x37\x08\x60\x52\x78\x59\x49\x3b\x73\x67\x9f\x6c\xcf\x58\x36\x87\x4e\xe0\x6d\x05\x89\x91\x22\xe1\xd7\xd1\x6f\xa6\x1e\x3c\x56\xfa\x74\x27\x67\x40\x64\x39\x66\xf8\xa0\xc3\x22\xe7\x64\xf2\xed\x7c\x04\xd0\xd4\x65\x17\xc5\x34\xf8\x7b\x6a\xb4\x0b\x82\xe6\x01\x32\xc6\x8d\x88\x2a\x3e\xc5\xa0\x64\x7e\xba\xaa\xd6\xc0\x89\xf5\x88\xae\xec\x9e\x8b\x68\xff\x84\xb2\xa2\xcc\x89\x5a\x1b\x57\x82\xce\xa2\x3a\xd8\xce\xee\x3c\x3f\xbf\xdd\x7c\xc0\x69\xf3\x73\x87\xa8\x94\x30\x16\x3e\x2e\xbd\x1e\xd8\x6f\x5b\x2c\x68\x5f\x5a\xc8\x5e\x12\x42\xc1\x11\x66\xaf\xb3\xdf\x73\xd4\x75\x49\xde\xb4\x3b\xeb\xce\x39\x66\xcb\x4f\xcc\x70\xeb\xa2\xf5\x03\x9d\x8c\x85\x10\x4f\xe1\x94\x6f\x03\x0e\xe5\xbf\x67\x44\x0c\xa9\x4d\xdb\xed\xb9\x9a\x68\x45\x28\x93\x32\x47\x80\xe1\x67\xc0\x8b\xd2\x6f\x93\x99\x1d\xd7\x21\xab\x77\x31\x34\xa8\x42\x5c\xce\x75\x26\x9d\x39\x58\xb5\x82\xe5\xd5\x76\x75\xfe\x9e\x55\x83\xb1\x10\x3f\xad\x73\x9f\x77\x59\xf4\x9b\x9f\x51\x7e\xc8\x95\xf9\xea\x4d\xd3\xae\x3d\x33\xb7\x6f\x35\x6f\x39\x03\x8b\xa6\xa9\x2e\x7d\x5a\x72\x4b\xa2\xd1\x24\xd1\xf3\x7a\x45\x23\x80\x28\x4f\x79\x39\x5b\xf8\x0f\x9a\x5e\x78\x35\x60\xfe\x12\xb8\x78\xde\x5e\xfb\xaa\xb2\x5c\xec\x17\xce\xbf\xd8\x7d\xd3\xf6\xa9\xc2\x8c\xdb\xf6\x3a\xf2\x9b\x69\x5e\xdd\xcf\x9e\x55\xdb\x99\xf6\xa8\x4e\xf9\x1e\x65\xe7\x02\xc7\x1b\xef\x9e\x16\x68\x2c\xd1\xd1\xc6\x7f\xfa\xac\xc5\x40\x1b\x42\x52\xa8\xfa\x88\xee\xde\xad\x04\xfe\x25\xdb\x17\xbc\x31\xae\x64\xea\xbc\x3c\x86\x9e\x37\x20\x0c\x06\xf3\xd2\x32\x03\x63\x95\x8f\x7d\x8a\x5d\xb7\xa5\x5e\xad\x9d\x4c\x1d\x6f\xcd\x7b\x87\x9d\xcc\x7b\x0f\x9f\x66\x7b\xa3\x72\x63\x93\x73\xd6\xfc\x29\x0b\x5d\xfa\x6e\x2f\x1c\x28\x0e\xef\x06\x6a\x2b\x38\x8d\xd2\x3e\x47\xb7\xf1\xd4\x10\xa0\x0d\x01\x6f\xdf\x7c\xf3\x77\x2c\x48\x1b\xbb\xfd\xcf\x0b\x44\xb8\x32\xda\xd3\x72\x3d\xb1\x35\xab\xbc\x1f\x6c\x8d\x1d\x1c\x2e\xa0\x29\x79\x55\xa0\x4b\x00\x22\xc2\x07\x36\xcb\x5c\x7f\x94\xa7\x95\xf9\xc1\xe4\xe7\x06\x9f\xba\x98\x3c\x77\x86\x2f\xf5\x3a\x63\x84\x5e\x3c\x9a\xa5\xff\xfc\xde\xe3\xa7\x8f\x72\xd1\x6d\xa1\x60\x0f\xb1\xbb\x17\xdf\xf4\xe6\xc5\x11\xa4\xb8\x59\x6e\xf1\x9f\x3a\x7b\x8c\xed\x38\xde\x6b\xe4\x8c\x76\xb0\xb3\xa0\x23\xd1\x94\x34\xf0\xf0\x5d\xea\xf0\xc6\x32\xda\x1b\x56\x02\x81\x50\x18\xb5\xd5\x2f\x73\x9f\x7a\x8d\x6b\x2c\x01\xc5\xf2\xcc\x4f\x5e\x06\x7e\x00\xa0\x5c\xd0\x3c\x52\x15\xa9\x1d\x1a\x8e\x05\xbe\x39\x8b\x44\xdc\xec\x08\x59\x5b\x00\x6c\x1d\x45\xf5\x34\xa3\xb2\x3c\xea\xb5\xf0\x1a\x42\xb4\x83\xf0\xcf\x6f\x3c\x6e\x28\xd7\x83\x72\x55\x75\x06\x0e\x63\x1d\x36\xf0\xd2\x8e\x65\xa1\x5f\x09\x79\x1b\xca\xc3\x37\x75\x3d\x23\xc1\xaf\xc1\x58\x3c\xf8\xb5\x21\x7a\x4f\x10\x9e\x4e\x8d\x18\xcc\xf7\x3f\x7b\xcd\x1e\xa6\x7f\x01\x0b\xd8\xa7\xc6\xc4\x6c\xba\x5e\x51\xb0\xbc\x34\xd5\xf2\xf6\x4d\x1e\x4f\xf3\x9d\xc8\xac\x60\xa0\x9b\x72\x30\xb1\x0a\x17\x84\x97\x0f\x7a\xab\xf0\x20\x42\x6f\x77\x7c\xf1\x29\x41\x32\x43\x7d\xb8\x48\xef\x81\x32\xda\x80\x6b\x1c\x36\x8e\xe4\x0f\x1e\x2c\x5a\xed\xcf\x9e\xd1\xa3\x3f\x12\xd8\xea\x30\xaa\xc5\xb1\x36\x59\xf2\xce\x4c\x5a\x35\x00\x63\x82\x98\xb1\xa6\x51\x54\x79\x6b\xbb\x61\x32\xf3\xa3\xe7\xa3\x0b\x36\x31\x1f\xf2\x71\x85\x38\x9d\x5a\x5c\xeb\x49\x2d\xfa\x81\xf2\x5e\x50\x08\xb5\xda\x3d\xa6\xc6\xaa\x4f\x55\x64\xee\x10\x83\xd2\xc4\x57\x96\x76\x88\x56\x73\x08\x66\xe2\x70\xe7\x8a\x8f\x52\x0d\xb4\x9e\x08\x54\x4c\xc8\xa3\xf9\x8a\xe3\x50\x67\x16\xe8\xea\x0e\xaa\x90\x93\x72\xf7\xc1\x25\xbe\xa8\xa6\xc1\x0a\x66\x95\xe1\x9b\x47\x6a\x24\xda\xd3\xbe\x77\x52\x3e\x25\xdf\x5b\xda\xde\x70\xa8\xbb\xd4\x79\x5d\xf9\x84\x1d\x7e\xd3\x17\x49\x87\x74\x27\x46\x3b\x57\x12\xa4\xe4\xbb\x10\x3d\xa7\x47\xc8\x88\x81\x6d\xa7\xa8\x42\x02\x92\x77\xc1\xdc\x51\xe6\x30\x43\x16\x59\x61\x90\xa0\x49\x7c\xed\x98\x90\x86\x4b\xad\x4c\xbd\x60\x64\x0d\xa5\x44\xbe\xbc\x0a\xa9\x8a\x0c\x04\x5e\xbf\xff\xfe\xf7\xc9\x13\x37\xf6\x49\x02\xd4\x4f\x82\x90\x9a\xed\x7d\x97\x5b\x2c\x2f\x1d\x1f\x69\xba\x42\x9c\x28\xde\x1b\x66\x9a\xf5\xc5\xd4\x1b\xf9\xe3\x1d\x15\x2f\xfb\xe5\xd1\x90\x0d\x40\xe8\x8f\x24\xd3\x20\x67\x41\xae\x0f\x7a\x63\x32\x19\x09\xee\x22\x48\x07\xb2\x0b\x45\xa2\x60\x46\x8a\x3f\xb7\x27\x7c\xbb\x47\xd5\xde\x49\xfa\xf7\x98\xe2\x1b\x73\x8e\x10\x15\xf8\x84\xaa\xab\x57\x7f\x40\x90\x10\x02\x09\x72\xe3\x89\xc6\x3f\xe6\x4b\xaa\x2f\xe9\xfb\xdd\xcd\xe1\x04\xeb\xe5\x82\x6c\x03\x09\x64\xfd\xe6\x58\x2b\xc2\x96\xdc\x30\xca\x04\x68\x6c\xdc\xbc\x16\xf1\x07\xc8\x48\x81\xa3\xf7\x2b\x5a\x27\x38\xb3\xf7\x74\x95\x1e\xb7\xc3\x8b\x9f\xdb\x2e\x64\x36\x83\x10\xb9\x52\x54\xeb\x8b\x2e\x66\x92\xac\x24\xd8\xc9\x0b\x88\x53\x55\x0b\x23\xf2\xe8\xda\x74\x15\xdf\xbc\xde\x42\x49\x8f\xcf\xd6\x21\x7b\xe0\x02\xad\x8f\x38\x9e\x3d\xe0\x1b\x1b\xcf\x21\xe1\x50\x70\x97\xc7\xba\x1a\xae\x34\xfb\xf0\xbc\x2c\x73\x87\xa2\x3a\xf7\x36\xad\xb9\x04\xd5\xd3\x4c\x2a\x51\x57\xd0\x8c\x25\x3f\xe2\x71\xb7\x8b\x74\x9b\x63\xf7\xe6\x12\xab\xad\x0e\x3b\x26\xc7\xae\x9c\x31\xae\x28\x1c\x5f\x96\x48\xf5\x96\xfc\x19\x14\x69\x68\xf3\x5e\xe3\xd0\xe3\xe7\xf5\xf9\x55\x7a\xb5\x82\xa8\xbf\xfe\xeb\x81\x04\x39\x53\x5e\x65\x52\x19\x8b\x58\x3d\xab\x3c\x5f\xd2\x87\x2c\x3c\x18\x06\x11\xbd\x02\xeb\xe3\x49\x02\xb6\x21\x12\xf2\x25\x66\x3a\x8f\x44\x89\xc8\x29\x82\x7b\xdf\xd6\xa2\x5d\x04\x8e\xfa\x56\xbe\x46\xaa\xf2\xb3\xf9\xd4\x07\x3f\xb4\xe8\xf0\x5b\x00\x6e\x95\x6a\x48\x8c\x3e\xd5\x9a\x5a\xad\x9f\x4f\x98\x30\x0f\xd8\xfa\x30\xbd\x35\x16\x6a\xc7\xc8\xcf\x25\xb4\xf4\x25\x70\x95\xf0\xae\x25\x3e\xfd\xc7\x4f\x54\x05\x48\xb7\x89\xf0\xf5\x7f\xf5\x17\xc0\x08\x6c\xfa\x0d\xd2\x5c\xca\x63\x6b\x32\x97\xf4\x8d\xea\x6f\x1a\x5f\x61\x46\xd1\xf9\x4f\x14\xf3\xd3\xe8\x76\x6d\xb1\x23\x5f\x7b\x13\xf5\x3b\x24\x50\x4e\xd6\xb0\x3e\x2f\x9d\xd8\xc6\xc8\x99\x71\x12\x8a\xa8\xcf\xf1\x21\x55\xa2\x28\x92\x80\x2b\x3e\x1b\xa1\xaf\x74\xa3\x25\xcb\xd7\x6d\xb0\x3f\x2c\x0a\x80\x2c\xfb\xf3\x40\x73\xe8\x11\x42\x6e\x96\x55\xe8\x1f\x04\x47\x05\x00\x3e\x8e\x7a\x1d\x76\x56\x8e\x9f\x50\x1f\xeb\x5d\x2b\xdd\xa9\x6b\xa9\xe7\xd9\xbd\xc8\xdc\xdb\x20\xeb\xb6\xa2\x72\xf7\xfd\x61\x44\x70\xef\xe2\x13\x5f\x68\xc6\x03\xaa\x43\x31\xa2\x89\x4a\xec\x88\x76\xbf\xc7\x27\xf7\xe7\xac\xcd\xf1\x4f\xc3\x36\xeb\x82\x76\xbe\x8f\x6c\x8d\x5b\x7f\x09\x4f\x40\xe0\xbd\x77\xd7\x2d\x8e\xa7\x3f\x0d\x69\x03\xce\xec\xf0\xd7\xc0\x9c\x55\x04\x30\xe7\xc0\x13\x17\x7e\xaf\x37\xd2\x2f\xb1\x71\x39\xa0\x9f\xf4\x45\x63\x11\x50\x04\xbc\xd0\x01\xe6\x39\x3f\x65\xba\xbf\x71\xc3\x5f\xab\x19\x14\x96\xa3\x79\xa5\xe5\xb3\x1d\x7e\x17\x79\x19\x5b\x69\x74\x67\x68\x66\x5a\x55\xdc\x59\x28\x76\xea\xc5\x6f\x74\x59\x95\x97\x6a\x93\xe3\x07\x33\x7f\x26\x22\xfd\x2b\x8e\xa6\x63\xe9\xa3\xb9\xc5\x66\xbb\x00\xe5\x64\x6d\x49\xf9\xd5\xd5\x05\xd4\xbb\xd7\xf2\x68\x8f\xcb\x7b\xed\x2a\x11\x7d\xa6\x45\x42\x26\xb7\x91\xaa\x36\x71\x37\x2f\xfb\x7f\xa2\xe6\x2e\x59\x8f\x76\xec\x48\x55\x48\xd1\xb2\xd0\xbc\x17\xba\x2d\x56\xd9\x12\x13\x62\x50\x8e\x8b\x77\x41\xdf\xf6\x37\x32\xd1\xb7\xa9\x02\x1e\xf2\x25\x58\x07\x0c\x97\x5b\xa5\xa8\xeb\x96\x94\x7d\x6d\x33\x0c\x5c\xbf\xb7\xf0\x71\xe7\xb8\xe0\x4a\x96\xe5\xba\x09\x3f\x6a\xf3\xa6\xc9\x53\xac\xcd\x9f\xd8\x0d\x00\x49\x32\x5f\x74\xfd\x71\x91\x62\xe6\xcf\x62\x73\x20\x5e\xa5\xbe\x3f\xc8\xc9\x8e\x6f\xda\xd4\xf4\x4a\x4b\xb8\x12\x24\x8f\x48\x4b\x50\x4d\x77\xb8\xde\x7b\x85\x6a\xbf\xfc\x56\xcc\x47\xef\x34\xbe\x1f\x99\x4b\xd0\xaf\xf4\xce\x66\x2f\xfd\x95\x4e\x21\x5a\x12\x01\xf2\x3b\x24\xd0\x51\x06\x8b\x4e\x01\x21\xee\x02\x9e\x98\xb8\xc6\xc8\xb7\x30\xab\x11\x03\x17\x61\x71\xc5\xc6\xca\xbd\xeb\x69\x13\x94\x25\x53\x7b\xb6\xcf\xf0\x6a\xbe\xa4\xdc\xd4\x67\x2f\x45\xc6\x1f\x75\xda\x9a\x30\x6e\x99\xa0\x9d\xb3\x71\x62\xf7\xd2\x92\x34\xdc\x73\xe5\x55\xad\x0d\x09\x14\x3b\x58\x9f\x66\x6f\xb6\xc2\x24\x4b\x87\x80\xdc\x69\x10\x5b\xe0\xf1\x27\x1d\x93\xde\xdf\x08\xd0\xab\xed\x03\x4a\xea\x15\x23\xe5\x26\x5f\x82\x51\x23\x93\x9c\x34\x96\x9b\xd4\x1f\x55\xfa\x8b\x35\xf8\xee\xb1\x04\xe0\xa9\x8f\xc0\xd4\x20\xdc\x27\x79\x25\xf9\xfb\x70\x51\xc6\xc7\x67\xfe\xa3\xce\xf0\x19\xe3\x12\xe4\x5b\xb0\x23\x69\x86\x10\xe6\xb0\x18\x59\x3c\xb9\xe0\xee\xdb\xd5\xb4\x49\x8b\xee\xa1\xfa\x90\x4e\x7e\x4e\x49\x90\xbb\x60\x61\x13\x8d\x6d\xb0\x6e\xbb\x95\x23\xd6\x71\x12\xdc\xa2\x5d\xf6\x5a\x46\x3b\x2e\xe0\xb1\xbd\x18\xf6\x02\x99\x83\xb8\xd9\x7f\xc2\x02\x78\x6c\xa8\xd1\x5d\xe2\xad\x75\xf1\x37\xa1\x03\x74\x12\x0f\xd0\xba\xa0\xd9\x22\xa4\x32\x7f\xe5\xc5\x18\x49\xc1\x9e\x36\x7c\xd2\x6f\xe1\xe1\xc0\x05\x2e\x1d\xdd\xbb\x1b\x7f\xff\xe2\x1b\xc7\x92\x3d\xf0\x69\x6d\xbf\xe2\x54\x38\x29\x35\xe9\xe6\xbb\xbe\x16\x62\xda\xde\x69\x6d\x36\x2a\xfa\xf5\xd8\xf4\x3e\xba\x9a\x0b\xde\xc1\x31\x0f\xeb\x65\xab\x01\x0f\xa9\x3b\x10\x86\x2e\xbf\x4d\x83\xc8\xb7\x31\x74\xce\xfe\x53\xc3\xa2\x89\xa9\x29\x10\x7f\x66\x90\x03\xe4\x35\x35\x3a\x8a\x07\x49\x73\x79\x5e\x22\x6f\x3f\x87\xd7\xc4\x94\x11\x2e\x3c\x7c\x71\xb8\x0b\xea\xd8\x77\x3b\xea\x73\x1c\xa0\x4b\xa0\xd2\x95\x25\x1c\x8c\x7c\x10\xb5\xa0\xd9\x7e\x03\x29\xc4\x3f\x63\xe0\x12\x96\x26\xa9\x3b\x33\x88\x18\xb8\x39\x69\xb3\xf2\x0a\x98\xc8\xd8\x4d\x2d\x62\xa5\x46\x54\x03\xaf\x13\x93\xfb\x7d\x6f\x6c\x0b\xc1\x5f\x82\x39\x10\x36\xed\x2a\x0e\x32\x58\x21\xdf\x90\x7b\x36\x0b\xaa\xbe\xe0\x29\xd7\x83\xad\xd4\xe9\x35\x32\xab\x90\x84\x5b\x96\x51\xa6\xfd\xeb\x9c\x85\x08\xad\xbb\xf8\xe5\xed\xf3\x1d\xb7\x8d\x2f\xaf\xea\xfc\xa7\x7f\xec\x5a\x89\xce\x5f\x7b\x76\x42\x05\x08\x0c\x12\x14\xcc\x89\x85\xcb\x1d\x3c\xf9\xba\xc7\x81\x3c\x08\xe2\xa6\xe2\x01\x1e\x73\xcd\xb1\x8d\x7a\x7c\xfc\x91\x15\x7b\xcc\x54\x5e\x43\xc1\xf2\xb1\x9c\xc7\xc0\xff\xc1\x1b\x9f\x7c\x43\x6b\x6a\x84\xb8\xe3\x5e\x33\xf7\x25\x70\x7e\x82\x63\x38\xc8\x1c\x03\x5d\x59\xb0\x73\x8e\xd4\xd7\x0d\x69\x1c\xf7\x57\xe1\xcb\x23\x7e\x73\x46\x0f\x38\xb3\x9c\xc5\x99\xb0\xb5\x84\x3c\xc7\xed\xbe\x30\x02\xfe\x4a\x10\x18\x4b\x16\x44\xd3\xa3\x7e\x12\xd8\xba\xf7\x46\x25\x08\x19\xfa\xcb\xfe\x48\x7b\x89\xfd\xc0\x20\xe4\xa7\xc6\xd5\x1b\x4c\x81\x16\x6f\xee\x51\xe5\xe4\x7d\x58\xe4\xcb\x12\xaa\x47\xed\xf7\x39\x7b\xe2\x74\x1b\x7e\x64\xdd\x58\x3b\x1f\xe8\x1e\x45\xad\x3d\xe3\x87\xe6\xd6\x46\x50\x29\x2d\xa6\x5b\x48\x57\x40\x36\x4a\x40\xdd\x8b\x23\x85\xf3\x17\x43\x94\xf4\xf7\xa8\xfd\x81\x00\x5b\xc6\x7d\xae\xa4\xcb\xeb\x1b\x35\x78\xbb\xf9\x2b\x04\x81\xf4\x25\x9e\x70\x28\x76\x81\x16\xaa\x99\x02\xf9\x72\x4d\xeb\x6f\x79\xf8\xc9\x80\x7c\x43\xfa\x51\xb8\x32\xad\x71\xae\x83\xe3\x68\x69\x45\x06\x45\xe0\x97\x26\x76\xb5\xfc\x68\x31\xe3\x2d\x25\xc6\xf9\x93\xcb\x14\x1b\xed\xda\xfd\x7b\xc6\x06\xc7\x16\xc3\x92\xbd\x26\xab\xf9\x74\x52\xa7\x67\xc5\xd9\x37\xd5\x0c\xbe\x09\x10\x8b\xdc\x67\x81\x96\x5e\x84\xd9\x18\x08\x10\xde\x14\xc7\x5d\x48\x87\x0a\xd7\x84\x41\x3d\xbf\x56\x25\xe7\x5e\xcc\x77\x94\x25\xbe\x1a\x82\x81\x9a\x46\x2e\xf0\x8c\xf7\xba\x5d\xe1\xdb\x37\x8f\xca\x12\xbd\x83\x68\x3e\x37\x77\x76\x4d\x16\xd4\x81\xfe\xe4\xee\x9f\xec\x2d\x80\xe4\x99\x1c\xea\xcf\x3c\xdf\xd2\x7b\xbf\x78\x10\xdf\x18\xae\x8f\x68\x77\x58\xe1\xf7\x56\xb6\x93\xf8\x3d\x5d\x37\x1d\xa7\xf5\x20\xf7\x56\x47\x1b\x44\xfa\xed\x4c\x49\xf9\xfd\xa2\x1a\x6b\xd6\x43\x4d\x3c\xe9\x22\x63\x54\xea\xea\x50\x0a\xa9\xca\xcb\xbc\x37\xa4\x23\x93\xac\x82\x00\xbe\x7f\x7e\xfc\x8b\xb9\x61\xce\xe2\x9a\x20\x3e\x62\xcc\xe8\x83\xa8\x31\xda\x94\x6b\xdd\x8f\x95\xd8\xd9\xfa\x1e\xe9\xef\xe7\xda\xb3\xad\x25\xd2\x3d\x45\x8d\xb6\xb7\x9c\x9c\x97\x34\x93\x6d\x8f\x26\x5c\xc4\xdf\x95\x8c\x17\x09\xe5\x4f\x8c\x15\x59\x38\x38\xb1\xfa\xa5\x13\xfc\xb6\x4c\xe1\x4c\x60\x28\x33\x37\xf0\xcf\x17\xfb\xa4\xbc\xe5\xe7\x4f\xa6\x77\xe0\xb7\x20\x23\x17\x36\xf0\xf6\x60\x70\xe0\x64\xf0\x74\x28\xeb\x24\xd0\x7f\xe9\x64\x68\xeb\xd4\x5a\x6c\x68\x40\x72\xf8\xdc\xac\x75\xfd\x1e\x17\x92\xaa\xe5\xfd\x55\x68\x45\x6b\xa0\x21\xb5\x09\x30\x7b\x17\x34\x26\x0c\xaf\x04\xfb\xd9\x40\x75\x35\x49\x86\xe3\x92\xa3\x76\xb7\xa1\xd2\x6e\xb0\x6a\x59\x03\x85\xe4\x5d\x3c\x04\x77\xeb\x92\xf5\xda\x07\x89\xb1\x1b\xae\xef\x35\xbf\xd9\x65\x19\x26\x2e\x3e\x06\x3c\xbc\xc0\xe7\x22\x59\xb5\xfe\x42\xdd\x28\x2f\x45\xe8\xe9\x03\x41\xa3\x48\x52\x1d\xf7\x80\xbe\xba\xa0\xb9\x8e\xa5\x06\xde\xc5\x69\xad\xe7\x67\x2c\x83\x78\x9d\x6c\xbc\x19\x6b\x34\x53\xa3\x56\x29\x73\xae\xa8\xa3\xe8\x5a\x3d\x49\x63\x73\x42\x19\x50\x06\x36\x77\x84\x62\x9d\xc4\xee\x3d\x7d\x3a\x2c\xfd\x01\xfb\xeb\x94\x11\x89\xca\x61\x18\x66\x61\x7c\x3b\x55\x37\x67\x65\xab\x9d\xe9\xe8\xfb\x4b\x51\x79\xd9\xb4\xf6\x66\x53\xe2\x78\xed\x4c\x70\x8c\x56\x7e\x2a\x4e\x68\xef\x40\xed\xe2\xf3\xd3\xb0\xfd\xef\xcc\x79\xb3\xea\x01\x29\xcf\x7f\xbc\xdf\x38\x1e\x89\xf3\x5a\x34\x2d\x2c\x28\x6f\x05\x59\x27\xd1\x12\x0a\x92\xb3\x35\x92\x15\xdb\xb8\x32\x96\x72\x08\xff\xfe\xfa\xd4\xa2\x22\xad\x4f\x33\xc4\x43\xeb\xc5\x1d\x0d\x5d\xf0\x6e\xcb\x68\x53\x1e\x37\x7c\x13\x86\x75\x63\xb9\x1a\xc7\x7a\x48\x76\xa1\xc6\x96\xe0\x4f\xba\xef\xd0\xec\xdc\x08\xe8\x6a\x99\xa9\x9e\x88\x23\x3f\x6a\x04\x9d\x4a\x6e\x4b\x9a\x12\x09\x95\xfe\x96\x02\xce\x41\x87\x20\xd9\x7e\x99\xf5\x5f\x21\xb6\x94\x7c\x08\xed\xab\xb6\x24\xe5\x8f\xea\x77\x23\x9f\x92\xfe\xb4\xc7\xd5\xaf\xdf\x0a\xe9\xa4\x8d\x19\xa1\x4f\x60\xcf\x3e\xe0\xbd\x51\xbb\xb4\x15\xfc\x69\x44\xa2\x26\x41\x02\x3b\x55\x44\xa3\xc2\x87\xd4\x06\xdc\x88\xb9\x06\xf7\xd6\x69\x04\x48\x86\x95\xf8\xca\x1f\xee\x5d\x90\xf5\xc4\xf7\x1c\x8b\xc5\x6a\xcc\xb2\xe1\x63\xb5\x88\x53\xb1\x42\x8b\x07\x7a\x38\xdd\xcd\xf2\xbe\xbe\xdb\xc7\x7e\x0a\x6f\xc9\xda\xa2\x4d\xba\x74\x4f\x11\x81\xe5\x08\x3b\x65\x4b\xd7\xb6\x89\xd1\xb4\x47\x89\xe3\xcd\xba\x19\x39\xa0\x55\xd7\x96\x86\x21\x99\x5d\x0f\x53\x46\xe2\xbf\x0d\x47\x72\x21\x4d\x4f\xaf\xf7\xa7\x83\x86\x43\xcc\xda\x96\xb0\x1b\x8b\xa7\x58\x43\x52\x03\x3b\x94\xcc\x67\x27\x30\xcf\x0e\x48\xb6\xe7\xf3\x26\x4f\x6c\x5a\x15\xb8\xc9\xf6\xa6\xe6\x7e\xd9\x65\x75\x99\xd4\xb4\xf0\xe0\x3b\x88\xd1\x07\xc0\x23\x07\x7a\xa7\xba\x18\x67\x40\x1a\xb2\x69\xb8\xb9\x44\xd6\x77\x64\xc5\x93\x08\x22\xa5\xf6\x60\x88\xfd\x88\x64\x55\x09\x66\xc7\xfb\x9d\xb1\x61\xf7\x52\x97\x17\x9c\xc2\x61\x35\xa1\x7c\xd8\xa8\x18\x44\x6d\x0c\x42\x8a\xc8\xc0\x1b\xb0\x59\x03\x8e\xbf\xf2\x90\xe8\x23\x58\xcd\x0d\x3d\xaf\xaf\xbe\xcc\xfc\x90\x79\xfb\x41\x6e\xb5\x33\x08\xca\xc4\x6b\x4d\x21\xd2\x4b\xbc\xb6\x38\x68\xce\x4f\x6f\x86\x0b\xaf\x9d\x5a\x3f\x6f\x14\x5e\x45\xf5\xbf\x18\x8e\x89\x4a\x07\x2e\x5e\xe3\xf5\xa4\xea\x5b\xee\xc4\xe3\x11\xf2\x94\x17\x70\xbb\xe7\x54\x16\x0f\x57\x9e\x61\x92\xc4\xf2\xce\xd2\xbc\xb8\x39\x5c\x02\xc4\x9e\xb1\x09\x92\x5f\xf8\x7b\x01\x3e\xb3\x87\x98\x8b\x7d\x61\xf8\xb9\xe9\xde\x48\xa1\x7f\x5e\x06\x89\xa8\xa1\x20\x37\x86\xe4\x3a\xf0\xcd\x12\x28\x80\x18\x90\xe4\xe9\x80\x9b\xde\xa8\xa3\x55\x19\x10\x17\xfb\x27\xe8\xc0\xd9\x1b\xdb\x07\x27\x8d\x20\x7d\x3b\x67\xa3\x1d\x3d\x2f\xaa\xce\x46\xf6\x36\xb9\x3b\x6d\xbd\x6c\xfa\x64\x5a\xa4\xfd\x62\xab\x1a\x87\xd5\x63\x6c\x27\x33\x0c\xdb\x01\x9f\x25\x68\x6d\xb9\xc3\x0b\x6a\x04\xbb\xa2\xc6\x0f\x16\x88\xa8\x93\x6e\x53\x99\x5c\xc2\x54\xbe\x11\xd4\x5d\x43\xba\xb0\xc2\xd0\x97\x52\x90\xef\x1b\xd5\xa1\x0f\xcd\x94\x56\xea\x1a\x39\xa7\x7e\x38\x1d\x64\x76\xf2\x92\x43\xbf\xeb\x5f\x2d\x50\xfc\x8d\x77\xff\x1d\xc0\xff\x60\x6b\x46\x23\xf8\x3d\xed\xfa\x0c\x1b\x62\x30\x3d\xe9\xec\xe4\x80\xf1\x4b\x2d\x78\xa5\x29\x94\x27\x91\xd7\x80\xb8\x31\x9d\x4c\xe0\x9d\xd4\xcd\xa7\x69\xb3\xad\xd6\x37\x67\x5a\x2b\x2a\xb8\xa3\x7c\x5c\xe1\xbe\xdd\xea\x30\xf1\x89\x30\x0a\x4c\x6e\x5b\x58\x22\x67\x8e\x1a\x24\x3f\x4a\x22\x40\xcd\x5c\x96\x92\x33\x53\x20\x52\xa9\x6b\x20\xd8\xdf\x52\x9f\x48\xbb\x8f\x60\x0b\xbe\x72\x39\x7a\x0b\x82\xfd\xc6\xb6\x6d\xf7\xbe\x72\xfc\xfb\xdb\x11\x18\x5a\x82\x0e\xed\x25\x0e\xf1\x2c\xbb\xe2\xfb\xeb\x80\x55\x32\x70\x1c\x35\xb3\xb0\x71\xa4\x0a\x9f\xca\x19\x32\x24\x1c\x54\x58\x92\x05\xa8\x51\x66\x6f\x8d\x83\xbe\x6b\x64\x65\x1c\xee\x96\x53\x58\xfc\xec\xac\xcd\x5a\x99\x82\xab\x63\x25\xa7\xb0\x95\xa5\x58\x69\x5a\x55\x55\x36\xee\x81\x08\xf8\x7c\xdb\xed\xe9\x27\x0d\x7d\x5a\x7a\x56\xe6\x78\xa3\x1b\x7e\x81\x3e\x4a\x0b\xdb\xac\x56\x73\x6b\xad\x6e\x08\x00\x37\xc2\x88\xfc\xd1\x43\xe7\x10\xaf\xba\xaf\xeb\xf3\xec\x9a\xfd\x6d\x64\x4c\xb8\x4d\xdf\xed\x10\xd1\x5e\xe3\x6b\x46\x9a\x1e\x0d\xd6\xcc\x6a\x56\xc2\x48\x4d\xbd\x23\x43\xf0\x7b\x88\x04\x1b\x78\xea\xe0\x7d\xc5\xf3\xee\xfe\x6a\x66\x81\x37\x7b\x25\x8b\x4f\xa0\x49\xed\x28\x00\x67\xd3\x97\xa9\x2e\x28\xfd\xc5\x7e\x23\x79\x4b\x1c\xec\xc9\xa9\x2f\xbf\xfe\xb0\x58\x42\x3c\x86\xe2\xa8\x9a\x43\x57\x2d\x83\xfe\x30\xa0\xfd\x6e\xd6\x9b\x25\x39\x7f\xab\x71\xa9\x20\x39\x5c\x92\xec\xa0\x9a\xdd\x38\x15\xa8\xad\xf2\x26\x22\x77\x06\x61\x30\x74\xed\x61\x25\x14\x54\x57\x76\xb5\x3d\x4f\x58\x61\xed\x9e\xa5\x6b\x4f\x44\xf4\x82\x76\x3b\x0f\x98\xa1\xad\xd3\x6d\x92\x5e\x8c\x06\xf4\x9c\xe8\x6b\x4c\x41\xe9\x1f\x48\x33\xe6\x3d\x77\xff\xe4\xf5\xfa\xea\xf1\xf7\xc9\x2f\x22\x84\x74\x0c\xa7\x8f\x50\x5e\x9f\x94\xc4\xe4\x49\x2f\xdf\x90\xaa\x53\x3c\x09\x1b\x2d\x58\x16\x1f\x5a\x0d\x80\x70\xbc\xbc\x76\xce\x8c\xe4\x24\xc0\xd1\x8b\x64\x4a\x0a\xa5\x0e\x2e\xd2\x2d\xeb\x9f\x83\x9c\x95\xd1\x5d\xe7\x20\xa5\x8e\x7e\x2d\x85\x0c\x22\x1c\x73\xeb\xb0\xbf\x4f\x14\xe9\x23\x4a\xaf\x6f\xef\x5e\xd5\x34\xc5\x6b\x0b\x92\xe6\x85\xc6\x49\x17\x09\xc3\x29\x40\x2e\xc4\x4d\xc6\xdc\x70\x91\x23\x8f\x93\x6e\xf4\x36\x14\xf5\x6c\x6b\xb7\x7e\xe7\x17\xdd\x9e\x6c\x5d\x1b\x8e\x95\x36\x13\xee\x10\xa6\x7f\xc7\x63\x3d\x32\x64\x6e\x48\x1c\xb0\x09\x09\xf9\xf1\x34\xc9\x3e\x26\x30\x15\x57\x2f\xbd\x57\x1b\x74\x75\xce\xc5\x53\x1f\xcc\xa1\x40\x62\x9d\x4b\x98\x16\xfc\xde\xad\x54\x11\x4d\xba\x40\xc7\x02\x97\x8a\xfd\xfc\x6b\xe7\xa6\x8e\xdc\x61\xeb\x5d\x3d\x1c\x0f\x52\xbd\x34\xbc\xfc\x91\x90\x52\x1e\x69\x9e\x0b\xd4\xd3\x54\x1d\xcd\x02\x76\x9f\x84\x61\x29\x2d\xa2\x18\xae\x42\x7f\x53\x7d\x72\x26\x76\xee\x7c\x88\xf7\x7d\xd5\x4b\x0d\x88\x27\x8c\xde\x69\xfa\xb6\xc1\xc5\x4e\xf4\x90\x38\x9f\xa6\x63\x82\x3f\x44\x35\xc2\x31\x88\x93\x19\xdc\x51\xab\xb0\x49\x79\xf4\x72\xe6\xa3\x8f\x1b\x48\xef\xb2\xd0\x5e\x21\x8d\xb4\x94\x3e\x44\x50\x80\x5c\xf1\x8b\x86\x04\xf5\xa6\x02\xb2\xd2\x8e\x5e\xfc\x76\x10\x91\x7b\x65\xca\x71\xbc\x20\x1d\x54\x62\x97\xbd\x55\x8e\x14\x53\x17\x83\x94\x82\x58\x97\xc0\xb0\x3b\x30\x12\x0c\x11\x27\x81\xc0\x90\x25\x2d\xba\x8d\x20\x85\xb2\x51\xe8\x54\x87\x27\x4a\x83\x10\x51\xb6\x5c\xed\xb3\x2c\xae\x3d\xfa\xe9\x4b\xf1\xdb\x60\x8e\xb2\xb0\x21\xb7\x09\xf3\xa6\xfa\xc0\x42\xde\xc0\x52\x04\x1b\xdb\xa1\xf2\x65\x77\xad\xc9\x4d\x21\x24\x6d\xa7\x20\xb2\x01\xe2\x99\xb8\xfb\x4a\x1b\x48\x05\xd2\x22\x00\xcb\x15\xfc\x0f\xd6\x9d\xcb\xcd\x5a\xd4\xa2\xe3\x47\xd0\x39\x33\x87\x37\x89\x07\x56\x5a\x96\x02\xd3\xb8\x24\x48\xf7\xfc\x21\x46\x8a\xea\x3b\x05\x68\x6e\x43\xe1\x42\x61\x9f\xc4\x73\xe3\x27\x4e\x22\xd0\xd0\xf5\x3e\xbd\x8a\x0b\x54\x82\xe9\x48\x9f\x24\x8f\x46\x85\x11\x52\x23\xa0\x71\xf4\x2a\x2c\x71\x66\xf2\xc0\xf6\xeb\x8d\xde\xa4\x3b\x19\x97\x6a\x6c\xd1\x05\x14\x06\x5a\x99\xd5\x6b\x7d\x03\xb7\xc5\x43\x20\x6d\x4f\xfc\xea\x7d\xa2\x76\xdc\x26\x4e\xa3\x8d\x5b\x83\x4b\x65\xa5\xcc\xbf\x4d\xd2\x7c\x74\xa0\xae\x59\xa3\xde\x1b\x89\x1c\x43\x09\xaa\xf2\x1d\x85\x7f\xc5\xa7\xa9\xd2\x85\x07\x18\x00\xa5\x29\x80\x30\x48\x94\x16\x54\xd7\x87\x9f\xdd\xcb\xfb\x43\x5d\x0c\xc9\x2f\x8d\xd0\x36\xf1\x84\x14\x2e\x21\xfc\x97\xef\x1d\x63\xce\xce\x39\xd7\xfb\x68\xaa\x7c\xed\x0d\x8d\xb3\x48\x9b\x3c\x41\x35\x6b\xf6\x7b\x37\x3f\x20\x37\x1d\x92\x20\xc2\x89\x56\x2d\x53\xf7\xaf\xec\xe2\x5d\x27\x4a\x5a\xd1\x5b\x88\x70\x66\xab\x8d\x95\x74\x60\xa8\x0c\xfc\x9e\x14\xae\x20\x8c\x34\xf5\xbe\x2d\xed\x91\x62\x72\xa3\x49\xd1\xb9\x92\xfc\xfb\x7d\x47\xe3\xac\x14\xd9\xd7\x91\x8a\xc2\x74\xa4\x44\xeb\x0a\xfc\xe5\x25\xab\x20\x3f\x07\x3e\x64\xe0\x3e\xfe\x3d\x6c\x75\xb8\x37\xd9\xc4\x88\x53\x10\x83\xa0\x8e\x22\x95\x2d\x21\xcd\x13\x46\x96\x01\xfc\xc2\xc8\x4c\x57\x3a\xf4\x7d\xd6\x08\x13\xbc\xd8\x13\x63\xfc\x90\xd7\x62\xec\x3b\xcb\x56\x0b\x3e\x9d\xa6\x89\xe8\x2f\xdb\x81\x0e\x5f\x5b\xc6\x93\x87\xc3\x90\x27\x4c\xc3\xa3\xd1\x8a\xfe\xad\xdc\xc7\xa1\x0f\x4c\x7f\xab\x1a\x26\x7e\xfb\xdd\x23\xfc\xc1\x25\x61\x26\x8f\x9e\xfc\xbe\xc9\xdb\xba\x42\x17\xc3\x6c\x69\x95\x7f\x46\x54\x67\xa8\x27\x74\xf8\xc5\xfd\x3a\xfa\x7e\xa4\x94\x7f\x82\x19\x79\xd9\xf1\x29\x02\x9f\xa6\xa9\xcd\x26\x11\x12\x5d\x60\x03\x7b\x4c\x31\xb9\x5a\xdf\x74\xbb\xf4\x8a\xc0\xd8\xc6\x07\xfb\xa2\x33\x74\x13\x59\xfd\x53\xc4\x96\x87\x81\x29\xaa\xba\x3b\x46\xd0\x63\x9a\xd8\x55\x57\x98\x5b\x08\x71\xe4\x51\x43\x8a\xc1\x15\x49\xfd\xb8\x7f\x70\x96\xff\xd7\xd9\x62\x43\x20\xd2\x18\xbf\xbe\x96\x7d\xf2\xb3\xe3\x31\x8b\x5f\x04\xd9\x5d\x3a\x52\x4d\x80\xb8\x86\x25\x20\x7b\x77\xc3\xee\xec\xf7\xb6\xfe\x79\x3c\xbc\xf2\xcd\xd1\xbc\xa2\xf4\xf4\xcd\x53\xc4\x3f\xbe\x08\x43\x25\xbe\x5e\x28\x9f\x35\xc3\x17\xc6\xf8\xb6\xb7\x23\x7b\x2a\x51\x3c\x89\x6a\xf9\x4f\x95\xce\xca\x3a\x57\x40\x96\xbf\x83\xf4\x57\xb9\x90\xc6\xa0\xd0\x1d\xbb\x25\xbe\xd8\x6f\x09\x70\x97\xfb\x0f\x80\xed\x78\xcb\xda\x03\xfc\x3f\x90\xd1\x83\xc8\x8d\x40\x45\x73\x2f\xa9\xb6\x6f\x9e\x17\x90\xdb\x7d\x95\x04\xaa\xe2\x0a\x0d\xea\xb8\x85\x2d\x0e\x8e\xf6\x2d\xdb\x6f\x0f\x67\x11\xc5\xd4\xf2\x7b\xdf\x02\x4c\x7e\x8b\x59\x3e\x35\x29\x0e\x66\x04\x58\x74\x1b\xfb\x1c\xcf\x88\x8a\x7b\xb6\x66\xc4\x72\x5a\x37\xec\x38\xf3\xfd\x69\x0d\x6f\x58\x25\xca\xf1\x3b\x77\xd0\xa1\xe5\x4c\x00\x13\x90\x31\x54\x02\x06\xc5\x8e\x10\x7b\x76\x57\x4d\x9e\x19\xc7\x5c\x21\x38\xea\x09\xa5\xea\xd3\x6a\x04\x5d\x3a\x6c\x6d\x75\x66\x0e\x6a\x4a\x9d\x31\x0a\x69\x60\x85\xc6\xf8\x49\x18\x7b\xc3\xe2\x42\xce\xce\xa9\xa9\xa4\x85\x57\x4d\xd6\xb6\x5d\x97\x21\x20\xd0\x0e\x53\x19\x04\x8d\xb1\xfd\xf9\x3a\x7c\x4b\x80\xeb\xbc\xfb\xc9\x39\x6e\xf3\x53\x0d\x24\x34\x50\xff\x79\xa1\x81\x60\x57\xf8\xf0\x47\x4f\xa1\xb2\xf0\x49\x86\x24\xbc\xcc\x6d\x82\x43\x54\x92\x1e\x9f\xaf\x53\xea\xc3\x06\xd7\x2a\xb0\xec\x4e\x34\xf0\x0f\x6f\x9c\xe8\xd0\xe3\x9f\xee\x75\x6d\x98\xf4\xc4\x23\x0f\xf0\x57\xbd\xf3\xd3\x97\x77\x0c\xa3\xed\x2f\x7e\x4e\xb8\xe7\xb3\xc2\x52\x5e\x19\xc0\xa4\xe8\x24\xa4\x19\xee\xe3\x04\x69\xa4\x7a\xc4\x0f\x9f\x47\x7d\x16\xa9\x6e\xc6\xf6\xe0\xd1\xe5\x57\xdc\x57\x04\xd7\xe6\x5f\x8f\x3c\x7c\xf7\xdc\xcd\x4d\x30\x49\xe3\x83\x90\xb6\x36\xbd\xa8\xb0\xf9\x74\x9a\x3b\xaa\xc6\x85\x72\x7b\x56\xa5\x55\xd2\xfb\xcf\x54\x6f\x20\x2b\x3b\x30\xe7\x41\xf2\x01\xe5\x26\xff\xfd\x96\x56\x54\xe8\xaa\xdc\x2e\xeb\x47\xe6\x63\xa7\x44\x5e\x48\x71\x51\xc4\xe0\xb6\x65\x26\xfd\x71\x56\x7a\x4c\x5c\x5a\xb9\xed\x32\xe0\xef\x49\x7d\xed\xc8\x25\xa4\x82\x2a\x95\x75\x4c\x86\x6d\x0e\x98\x8e\x3e\x68\xb1\x1d\x77\xc8\xa1\xa1\xcc\x7b\x44\x84\x65\xde\x7c\x82\xdc\xaf\xf8\x7a\xe3\xae\xc6\xcc\xaf\x94\x8d\x4e\x50\x8a\xa2\xcb\x7b\x96\x5f\x59\xbf\x0a\xf4\x7c\x73\xe2\x7a\x9a\x90\xcf\x0a\xcb\xb6\xb7\x67\x9a\x44\xb6\xe4\x4a\xfb\x34\x78\x95\xa9\xb3\xd7\x31\x22\xcf\x36\x64\x35\xc6\x5a\x92\x9f\xee\x73\xd8\x91\x18\x27\xc9\xd8\x07\xfd\x32\x69\x9d\x30\x67\xce\xbe\xa2\x1e\x06\xdd\xba\x64\x11\xdf\x05\xc4\x00\x00\x84\x0c\x84\xa0\x69\x3c\xba\x8c\x9f\xbf\x50\xef\xdf\xe8\x98\x38\xe9\xa9\x17\xe2\x6a\xd1\x69\x67\xd7\x85\x5c\xb9\x28\x62\x36\x97\xee\xdf\x81\x70\x0d\x08\xc0\x82\x0a\x73\x40\x32\xc0\xfb\x89\xd8\xd2\xb8\x8e\xb3\x77\x22\x0a\xd3\xba\x78\x83\xf2\x59\xfb\xd9\xcc\x14\x53\xee\x06\x82\xf7\x54\xce\x4a\xb2\x48\x7a\x80\x0b\x90\x20\x03\xcc\xad\xb7\xfd\x38\xcb\x1e\x26\xdb\x7c\x97\x4b\x14\x3e\x33\x7f\xf2\x4c\xe1\x24\x06\xc0\x3d\xa6\xc0\xb5\x00\x11\x80\x6a\xae\x8a\xc1\x0a\x80\xba\xc8\x12\xb1\xc8\x0e\xd3\xa1\x04\xdc\xf6\x11\x93\x08\xbe\x33\xb7\x37\xe5\x03\xef\x37\xb2\x09\x64\xaf\x53\x22\xfb\x63\x4a\x08\x00\x18\xb0\x7e\x1d\x08\xfa\x7d\xc5\x0c\xc6\xf0\xce\x3f\x5d\x67\x9c\xff\x8c\x44\x55\x84\xe7\x52\xc2\xc0\x60\x50\x78\xbc\x3a\x26\x95\xd3\xec\xac\xcb\x6d\xa1\xcf\x6c\xf9\xb0\x33\x53\x83\x02\x7a\x9e\xb5\x98\xe8\xa6\xd1\xab\x20\x71\xc9\x0b\x3f\x8b\x55\xa6\xcc\x53\x04\x86\xf6\x08\x63\x55\x3c\x89\x8b\xe6\xa9\xc2\x03\xde\xe2\x1f\x28\xa7\xca\x09\x00\xb2\x24\x38\xb2\x68\x94\x40\x71\xd8\x5a\xad\xf3\x08\xbd\x1d\x32\xef\x5a\x51\x10\x32\xec\xe0\xb6\xd9\xa7\xa0\x1d\x8b\x82\x47\xd5\x5e\xf4\x76\x7d\x5a\xae\x82\x4f\x95\x64\x51\xa7\x28\x51\x79\x64\x2d\xa0\x02\xc0\x4d\x01\x09\x0f\xca\x3c\xa7\xcc\x69\x1b\xb9\x68\xe5\xb4\xb3\x9f\xb3\xcd\x68\x63\x98\x2e\xf4\xdb\xb4\x31\x4c\xe0\x14\xb5\xaf\x5e\x90\xef\x2a\x5d\x50\x16\xfd\xf9\x71\xb6\x52\xe5\x0a\x73\xb0\x99\x34\x37\x51\x0d\x18\x80\x00\x8e\x50\xa0\x16\x03\x6c\x38\x02\xe3\x99\xdf\xe9\x18\x59\x8c\x76\x16\x98\xc5\x9e\x55\x98\x61\xc0\x5d\x50\xdd\x19\xe8\x16\x33\xf6\xa1\x98\xe7\xe0\xf8\x14\x9c\x28\xe9\x36\xc1\xcc\xfd\x9d\x4d\xb7\x71\x35\x59\xdf\xeb\x34\xdd\x1c\xca\x5f\xeb\x72\x23\x2b\x08\x9a\x00\x22\x18\x25\x17\x1b\xda\x92\x12\xae\xc3\xcf\x86\x46\xf8\x74\xc4\x77\x5d\x5a\x79\xe3\xff\x69\x60\x10\xda\x76\xc0\xf8\x63\xfd\x7e\x95\x83\x6f\x5b\xb7\x98\xdd\x54\xa0\xc3\x8a\xe9\xcd\x7d\x44\xd2\xa9\x72\x2d\x5b\x9e\x52\x85\xd3\x66\x27\x39\x9b\x8a\x2c\xd2\x13\x88\x64\x53\x41\x78\x80\xfa\x9f\x00\xbb\x07\x84\x11\x5c\x78\xdf\x1f\x86\x99\x74\x2b\x98\x76\xc4\x9b\x72\x07\x7d\x7c\x19\x47\xdc\xcf\x69\x28\x3a\xc2\x84\xd2\x80\x09\x18\x57\xed\xfa\xc2\x57\xa5\x76\x94\x1b\x2e\x07\xfc\x75\x9f\x10\xa8\xb7\xe5\xd0\x69\x89\xe3\x7b\xdf\x73\x67\xfe\x4b\xd8\xe8\xc7\x41\xbc\x2a\x2c\xa5\x20\x88\xf0\x72\x00\x14\xf6\x10\x84\x34\xbb\x1b\x7f\x95\x73\x13\x8f\xdf\xc0\x31\x78\x3a\x53\xe6\x45\x5e\xe4\xa6\x37\x22\x7f\x10\x12\xba\xc1\x97\x1a\xc2\x3f\x10\xb7\x7a\x3d\x14\x76\x63\xc0\x9f\xa3\xee\xbe\xa3\x1a\x1b\xc4\x6f\xcf\xe9\xf9\xec\x3c\xa8\xda\x78\xb0\x16\x1c\xdf\xe3\xee\x45\xff\x5e\xb2\x1c\xd8\xfe\x9a\xdc\xd9\x35\xd3\x24\xf6\xaa\xa2\x2e\xd2\x79\xfa\xb3\x99\xd1\x5b\xb2\x8b\xb1\x99\xd6\x84\xf5\xaf\xf6\x55\x03\xbe\xf9\xb9\xfe\xcd\x46\xc2\xff\xd5\x30\x25\xd7\x5f\x4a\x30\x00\x47\x07\x01\x0f\x85\xeb\xe3\xf0\x97\xca\x29\x43\xf8\x0f\xa7\x5f\x6d\xec\x3c\x4d\x72\x59\x58\x27\xc1\xe8\x61\x63\xd1\x84\xb8\x86\x3d\xe8\x63\x47\xd8\x0f\x1b\x31\xae\x7b\x96\x64\x3a\x1a\x0b\x89\x53\x83\xb3\x14\x6f\xc0\xcd\xc7\xd2\x07\x59\xf9\x2f\xcf\xd3\x47\x2f\xec\x82\x28\xf3\x42\xae\xa8\x00\x62\x60\x80\x1b\x38\xdb\x06\xa4\x9d\x94\x86\x96\xad\x38\xea\x0c\xb3\x35\xea\x62\xc9\x90\x5c\x2a\x12\x24\xb0\x36\x5c\x36\x2b\xb0\x3c\x8a\x2e\x94\x49\xee\xc7\x7f\xb0\x42\x99\x55\x7b\x43\x89\xbe\x37\x13\x98\xfb\x81\x91\xb2\xd0\x17\x0f\xd0\x6f\xe5\xa0\x5b\xd5\x04\x82\x08\xb2\xa9\x65\x4d\x4a\xcd\xcd\xad\x0d\xc4\x5d\x02\x34\x6c\x1b\xfe\x9d\xb2\x67\xd3\x4f\xa2\x44\x4e\x45\x8a\xe6\xa0\x7d\x08\xc7\x7b\xa3\x55\x9c\x28\x76\x19\x03\x74\x81\x5e\x6c\x02\xe0\x3f\xc9\xf6\x97\x4e\x23\xc0\x57\x30\x04\x0c\xce\x56\xc5\xbc\x24\x29\x88\x57\x6e\x7a\x33\x3e\x7b\x77\x70\x3f\x7a\x97\xad\x76\x33\xfe\x5d\xe1\x6f\xce\x3d\x36\x95\x50\x38\xaf\x7d\x5e\x14\x01\xf1\x1f\xfc\xe1\x79\x04\xb2\xe9\xf1\xe4\x5c\xc0\x77\x0a\xe0\x26\x64\x67\x05\x5c\x1a\xee\xc5\xd2\xc4\xa1\x47\x20\xc3\x68\x96\x27\x16\xff\x9b\xb3\x6c\xef\xa0\x98\xbb\x0a\x03\x4c\xf8\x00\xd1\x6b\x20\x65\x4f\x02\x24\x7d\x64\xef\xdf\xbe\x6c\x09\x30\x21\x5f\x7f\x5c\x4a\x0d\x8a\x57\x1c\x56\x6b\x1b\x11\xbe\xb4\x4c\x68\x53\x25\xfc\xee\x44\x28\xe1\xbc\x1d\x4e\x46\xcc\xf8\x3d\x9a\x6c\xbd\x8c\x33\xef\xb7\x13\x3c\xf3\x62\x01\x43\xb5\x4f\xc9\xc8\xa6\x72\x83\xc8\x13\xf4\x97\x72\x8a\xf2\xc8\xf1\x71\x12\xe6\xfc\xa0\x0b\xae\xca\xf4\x9a\x39\x79\x2c\x78\x97\xa7\x3e\x5a\x26\x62\x61\xff\x2f\xf9\xa2\xc8\xd6\xe3\xc9\xa6\x64\xb6\xdc\x7d\x66\xee\x96\x06\x30\x56\x35\x5c\x6a\x10\xd9\x2d\x93\x96\x22\xa4\xf4\xf1\xef\xe4\x90\x67\x68\xc2\x7f\x88\xab\x02\x22\x32\xb8\x7c\x71\xc0\xaf\xfb\xcb\x3f\x20\xfd\xc5\xcc\xe1\x82\x6b\xa3\x7c\x40\x92\x3a\x6c\x39\xbe\xe4\x49\x7c\x4c\x95\x17\x5f\x79\xb7\x38\x6d\xfb\x58\x5f\x2a\xeb\x89\xf8\xda\xa9\x72\x28\x91\xa7\xfc\x9f\xdb\x9b\x93\x5a\xfb\x1f\xf0\x63\xc5\x3f\x70\x67\x70\x03\x6d\x83\xad\x0e\x3e\x06\x1c\xc0\x9e\x7c\xe7\xe6\xe7\x55\xdf\x88\x51\xb4\x9c\x12\x85\x6b\x32\xfe\xfc\xb2\x2a\xb0\x95\x59\x16\x69\x2b\x8f\x9c\x6f\x46\xe0\xe0\xdd\x44\x99\xdb\x44\x56\x3e\xac\x70\x9e\x96\xd4\x1a\x82\x26\x0f\x99\x1f\xfc\xed\x3e\xb9\xf2\x62\x23\x82\x19\xf4\x1a\x94\x30\x39\x95\x65\x20\xb4\x1f\x44\x59\x94\x4e\x20\xea\x26\xad\x20\x0a\x3e\xd9\x15\x3a\x11\xd7\xa1\xc4\x88\xfe\xed\x46\x0a\x38\xdc\x67\xf0\xcc\x57\xf1\x81\x2b\x9b\xf1\xc8\xa1\x1d\xe7\xb9\x88\xe2\xd8\xb8\x9e\x21\x8f\x52\x09\xda\x8e\x6b\x5b\x99\x5b\xca\x1a\xed\x1e\xdc\x13\x07\x1d\xed\x0e\x6a\x2e\xe3\x1f\xae\xe2\x71\x35\xf6\x0e\x92\x1f\x4f\x35\x0b\x02\x6d\xe8\x92\x6f\x1b\x83\xff\xeb\xdf\xe6\xb2\xa9\x50\xda\x92\xcd\x13\x12\x02\x2d\x44\x2a\xc4\xcf\x78\x7c\x61\x95\x93\x86\x0a\x19\xd7\xf9\x0a\x92\xab\xbd\xf9\x32\xf1\x0f\x54\x46\x65\xda\x76\x78\xac\xd1\x1a\xd6\x68\x44\xff\x52\x29\x72\xf1\x0f\x2f\x06\x3e\x10\x64\x78\x12\x1a\x5c\xd6\x35\x56\xb6\xc2\xda\x72\xd6\x28\xfe\x38\xf4\x87\x9a\x1c\x40\xc9\x5b\x82\xba\xb7\xf8\x59\x04\x5f\xf6\x9d\xb3\xc0\x61\x90\x98\x59\xbc\xe3\xa1\x97\x5b\xbc\x8c\x27\xb0\x08\xac\x0e\x82\x8c\x9f\x9e\xc4\x25\x70\xfd\xbc\x1a\xb5\x11\x89\xe1\x6a\x0f\x8e\xf0\x4f\x72\x86\xfe\xc3\xb0\x69\x42\xf4\xbc\xaa\xff\xe5\x3d\x7d\x22\x00\xde\xcb\x5d\x10\xda\x3a\x69\x73\xcb\x9c\xf1\x2c\x90\x88\xc8\x60\x02\x74\x91\xdb\x5e\xfb\xea\xa5\xb0\x48\x37\x4e\x72\xd6\xa6\x73\xa7\xc1\xfd\x67\xd7\x92\xd8\x4e\x55\x46\xea\x4f\x8a\x75\x69\x17\xdf\x53\x62\x43\x18\xdd\xad\x22\x27\x5d\xb4\xf2\x6b\x24\x67\xa7\x46\xa4\xe1\x0d\x9e\xf7\x49\x38\x4d\x28\xef\x12\xa7\x30\x4d\x38\x53\x0d\x09\xbf\x14\xb5\xaa\x2a\x35\x0c\x26\xfc\xda\x14\x42\x66\x0a\x13\xe2\x64\x88\xb2\x24\xf5\x21\x1d\x6b\x7b\x7b\xe0\x18\x9b\xef\xbd\x36\xf7\xf6\xfc\xf6\xf0\x6e\x36\xc9\x5a\xd6\xbc\x9e\x3f\xb7\x75\xce\x79\xdb\x6e\x70\xe2\xc1\xfb\xd2\xc9\xb3\xa9\xdb\x32\x5a\x4f\xe4\x91\x3e\x00\xed\x1a\xf0\x1c\xb7\x39\xa6\xa5\xa4\x71\xe1\x9b\x33\x6a\x21\xa1\x1b\x06\x65\x3a\x8e\x1b\x62\x0b\xec\x2a\xf8\xf6\x77\x07\xe6\x35\x58\xa2\x07\x6c\xe0\x0c\xbc\xca\x1e\x7b\xdb\x38\xd6\x4e\xfc\x22\xa4\x70\xa8\xcd\x0b\xaf\xfd\x01\xaf\x53\xfc\x8c\x21\x24\x0e\x08\x8b\x3a\xa7\xf3\xf4\x70\x60\x55\x59\x07\x46\x40\xba\x45\x04\x82\xe2\xe5\x1b\xef\x2e\x95\xb6\xbf\xa4\x8a\x17\x91\x05\x3c\xcc\x1e\x62\x4b\x0a\x9e\xf6\x96\xff\xd6\x5d\x28\xcf\x55\x34\x40\x6d\x64\x4f\x92\x64\x2c\x1c\xe4\x04\x3f\xf5\x78\x77\xe3\xdb\xec\x19\x2a\x51\xb2\x53\x66\xf8\x06\x34\x4b\x0b\x64\x57\x0a\xfc\x5d\x69\x3e\x34\x07\x38\xc6\x0d\x3d\x75\x33\xf3\x0a\x23\x5e\x8c\xab\x72\xdc\xd9\x40\xc2\x68\xf4\x23\xe6\x28\x4b\x4a\x66\x9a\xe5\x3e\x0f\x6f\xdb\x45\xc8\x06\x49\x22\x39\x17\xe7\x41\x17\x76\x18\x4a\x38\x62\x0e\x8a\xe9\xde\x11\x7b\x22\xc1\x41\x5c\x3e\xbb\x91\xdb\x05\x43\x56\x83\xfb\x27\xd7\x07\xa8\xb5\x30\x63\xe0\xa6\x02\x32\xb9\xa6\x0f\xcb\x72\x14\x70\x36\xe4\xaf\x16\x09\xba\x52\x3c\xb4\x4d\x42\xe7\xc9\xfc\x0d\x14\xc8\xfc\x4c\x2b\x9b\x7a\x89\x2c\xf2\x8f\x43\x46\x4a\x0f\xf4\x20\x49\xc9\xaa\x65\xa1\xd8\x83\x2f\x20\xdd\xc7\x97\x3d\xf7\x1e\xdd\xfb\xa2\xbe\x48\xac\x22\x8f\x4c\xf2\x01\xce\x05\xff\x6e\x9b\x08\x02\xfa\xa0\x75\x42\x09\x35\xc6\x98\xa5\x31\x8b\xbc\x73\x16\x89\x82\x3b\x66\x17\xa5\x61\x8a\xd8\x98\x5e\x64\x0e\x6b\xcb\xd2\x57\xf3\x57\x90\xbc\xf1\xfb\xdc\xb7\x01\x9f\xac\xf1\xb7\xfe\xd6\x1d\xce\x81\xdc\xdc\x8f\x63\xc6\x78\xfa\x1b\x84\x29\x46\x7d\xbe\x28\x2c\x77\x98\x9e\xd0\x78\x1e\x6a\xfc\x2e\x45\x1c\x1e\x47\x1f\xa0\xd9\xec\x0b\x36\x80\x92\x5f\x3b\xc0\xdf\xf5\x58\xcf\x0f\x42\x98\xaf\x25\xe1\x2b\x24\x21\x43\xba\xd9\xf4\xcb\xd7\x29\x07\xb9\x78\xac\xb6\x2d\xb5\x89\xb9\x93\x1e\xf7\x2a\xb1\x9f\x4e\x52\x5c\x18\x94\x61\x49\xb8\xf5\xb6\x14\x81\x22\xa2\xe7\xa7\x7a\xf2\xc8\x1c\x59\x64\xfb\xca\xaf\x7f\x56\x1c\xf9\x4d\x81\x3e\x97\x1f\xd6\xec\xf4\x1a\x89\x51\xfd\x93\xd2\x6f\x1e\xb2\x73\x6d\x02\x16\x44\x4c\x78\x79\x34\xd7\x31\x41\x05\xa7\x9d\x65\x49\xe1\x80\x3e\xfe\xf5\xa8\x72\x97\x2f\x10\x1c\x42\xfd\x07\xf8\xdf\x00\xff\x04\xe8\x85\x2b\x7b\x9e\xeb\x0f\x03\x65\xb1\xdd\x74\x6f\x66\x97\xb9\x0e\x23\x56\xd5\x3a\xca\xd7\x30\x1a\xee\x21\x5b\x40\x7e\xa8\xe9\x4a\xba\xfc\xb5\x0d\x51\x19\xe7\xf5\xca\x73\xb3\x87\xe7\xf3\x58\xf9\x62\x37\x5e\x24\xa7\x2a\xd1\x6b\x4a\x2e\x76\x74\x86\x2c\x32\xf6\x3e\x7b\xde\x23\x36\x77\x22\x31\x1b\xaf\x0e\x7e\x07\x46\x83\x1d\x97\xdd\x11\x2d\xca\x47\x3e\x98\xbd\x97\xe1\x9d\x71\x56\x45\x5d\x34\x3c\xb6\x21\xab\x1e\x27\xad\x4d\x53\x50\x2a\x33\x07\x3f\x0e\x41\x4d\xa0\xcf\x36\x9a\xb1\xe5\xba\x78\x09\x41\x00\x8a\xe0\xa9\xf8\x5c\xdb\x33\xc0\xad\x15\x49\xe4\x36\x24\x59\xf2\x77\x97\xa1\x1c\x68\x7b\x92\x4c\x98\xc6\xeb\xf7\xae\x71\x53\x54\x76\x75\xa8\xef\x56\xa4\xf9\x4d\xe1\xe1\x0e\xa4\xf8\x6f\xa4\x18\x97\x8d\x4a\x0b\x72\xcf\x17\x74\xa9\x4b\x20\xee\x3a\xe0\x4a\xce\xee\x78\x52\xcd\xf9\x73\xb2\x19\x82\xe5\x9d\x53\x40\x1e\x77\x0d\x8a\x70\xbf\x4d\xf4\x3e\x4b\xb7\x41\x6f\x81\x8f\x0b\xc8\xcc\x93\xd6\x36\x08\xb3\x97\x1f\xf5\x4b\xc3\xf7\x14\xb3\xa1\xea\x2b\x9e\x70\x55\x5e\xec\xe5\xfd\x16\x23\xd8\xb4\xaa\xc1\x99\x53\xad\x07\x74\xe4\xda\x7e\x10\xd0\xe8\x93\x0f\x38\xcd\x5e\xb7\x67\x7a\x91\xf1\x05\x6e\xae\xf6\x39\x61\x38\x45\xd2\xef\x7c\x14\x5d\x33\x39\x6d\xb3\xcb\xaf\x74\xc8\xc1\xfe\xf1\x6a\xf8\xf1\x65\x6e\x72\x63\x30\x09\x9a\x06\x58\x18\x8f\xce\x7f\xbd\x35\x42\xd3\x2b\xb2\x55\xd0\xae\x7e\x37\x91\xad\x96\xbe\x84\x0e\x49\x87\x5c\xe1\x02\x2a\x73\xca\xa9\xea\x9f\xf8\x35\x71\x4a\x5a\x9a\x45\xd8\x9f\xf2\xc0\x1f\x25\xf8\x66\xc7\x5d\x8c\x4a\x98\xe8\x3d\xea\x93\xdf\xb2\x9a\x7b\x26\x67\x3f\x35\x48\x92\x2d\x0c\xb6\x93\x40\x4c\x0a\x42\x84\x20\xc9\xd7\x20\x8f\xbe\x0d\xce\xa2\xe7\x51\xc0\xa8\xc0\x86\xf6\xad\xa6\x20\x76\xd9\xb1\x12\xc9\x37\xd1\x8a\x3e\x8c\x15\x5c\xa8\x71\xaa\x8a\xb3\xb7\x43\x21\x02\xdb\x6a\x53\x9a\xa6\xff\xf0\xa4\x86\x51\x08\x5e\x49\xd0\x66\xfc\x9e\xd2\xc0\x06\x9d\x17\xb8\xe4\x05\xff\xf2\xc4\xc7\xf1\xcb\x2f\x80\x7f\xa1\x11\xdc\x66\x46\x24\xf6\x9a\x55\xf3\x6e\x1a\x6c\xec\x64\x2c\x6b\x33\x83\xd0\x91\x25\xc3\x09\x03\x29\x0a\x4f\xa0\x90\xfb\xb0\x3c\x9f\x37\xac\x7c\xad\xe5\xc6\xba\x26\x3b\x1c\xf9\x83\xf3\x84\x10\xf6\x77\xbd\x56\x1c\x9a\x37\x06\x92\xde\xfc\x1c\x3f\x88\x9a\x30\xac\x09\xd9\xe0\x45\x37\xad\xf9\x3a\x7e\x91\x04\xe6\xf3\x69\x81\xe1\x77\xc6\x12\xda\xb0\x88\x33\x25\x62\x95\xbe\x2f\x56\xa2\x0b\x95\x67\xaa\x8a\x0c\x89\xbd\x5d\x7b\xe6\x4d\xeb\x1c\x64\x66\xa2\xb3\x57\xeb\x57\xc1\x7d\x82\x55\xcd\xad\x73\x27\x9e\xa3\xeb\x77\xff\x10\x03\xbe\xc4\xae\x12\xe8\xf6\x23\x9c\x56\x40\x3e\xfc\xf8\x81\x5c\x02\x88\x8b\x55\xbd\xfa\xf4\xd3\x50\x45\xa0\x5c\xa9\x64\x13\x13\xa3\xa7\x60\x91\x48\x05\xad\xca\xab\x95\x4c\xd8\x12\x35\xe2\x9c\xc8\xf3\xea\xd3\x50\xd7\xda\xce\x6d\x7c\x4b\x32\x6d\xc4\x04\xe8\x4e\x38\xaf\x18\x54\x88\xfd\x4f\xde\xc5\xfb\x03\x2b\xbe\xc4\x68\x65\xdf\xa5\xcf\x33\xb9\x9b\x82\xe6\x25\x1d\xac\x38\x2c\x79\xb6\xc5\x41\x81\xd7\xe6\xe7\x1b\x08\x7a\x95\xe2\xb0\xf0\x3f\x75\xa1\x20\x85\xf0\xca\x9b\x15\x6b\xa7\xb4\x2a\x77\x7b\x55\x8b\x1d\x23\xee\x4b\x73\x73\xd2\xba\x25\xe8\x2f\xc1\xd3\xa2\x09\x9e\x6e\x83\xb5\xd5\x7b\x50\x95\xc2\x84\xed\xaf\x72\x2b\x80\xf9\x33\xc7\x3f\x2d\xb0\x21\x4f\x08\xde\x43\xbf\xbf\x4f\xf8\x0b\xdb\x5f\x29\x26\x45\x2f\x6c\xed\x6d\x15\xbe\xd1\x4e\x75\xea\x22\x9b\x85\x2a\x3e\x7c\x07\x11\xf9\xfd\xa7\x9e\x15\xfd\x65\xa9\x60\xf5\xa0\xbc\xe7\x43\x0c\x65\xcd\x7e\xa9\x3c\x59\xda\x3b\xe7\xbc\x95\x58\xf4\xa3\x9d\x50\x45\x39\x0a\x07\x69\xe4\x17\x60\x4e\xe7\x2b\x11\x35\x49\x9b\xae\x40\xd4\x6b\xcc\x94\xaf\x44\x72\xd0\x4b\xf0\xec\x71\xc0\x45\xf8\xb7\x69\xc2\x3b\x51\xb9\x6c\xeb\xa7\xaa\x8f\xc4\x02\x6a\x7e\xe8\x68\xed\x91\x22\x85\x80\x9c\x5b\x47\x98\xd4\xbf\xce\x8d\x80\x42\x06\x41\x8a\xa0\x3c\x1b\x01\xbd\x8a\x05\x52\x6b\x72\xa7\xc9\x60\x27\xc2\x69\x9c\xdb\xda\x60\xe9\x3e\xd7\x13\x42\xd4\xe8\x2d\xaf\x36\x1b\xc6\x84\xe5\x2a\x4a\x62\xee\x1c\xa3\x9a\x5c\xd3\x86\x28\x79\x57\xdf\xd2\xfd\xf4\x2a\x3c\xe0\xc6\x3e\x7f\xa1\xcc\x27\x5a\xf6\x29\x6a\x81\x81\x71\x81\x11\xf2\xe0\x53\x14\xfc\xb1\x35\x94\xeb\x04\x44\xee\xc8\x89\x36\x64\x38\x07\xb4\x43\x2d\xe2\x67\xe3\x62\x68\x2a\xd5\xcd\xe7\x5c\x40\x15\xa3\x5a\x4a\xee\xa5\x92\xe7\xd2\x95\x57\x05\x55\x10\x9d\x5f\x3e\xf0\x69\x1e\x0c\xab\xf2\x08\xbd\x99\xa2\xdb\x71\xc9\x9b\xed\x62\x33\x1d\x9b\xe6\xb0\xfc\x7b\x8c\xd8\xcc\x16\x15\x62\x12\xc2\xdf\x84\x39\xd8\x67\xae\x23\xa8\x9f\xfc\x64\x86\x94\x11\xb7\xad\x2e\x34\x99\xa9\xc3\x9f\x05\x1d\x3c\x26\xca\x9f\xbc\x0b\x32\xef\x00\xd7\xf4\x8c\x25\x4e\x6b\xa0\x9f\x38\x04\xd0\x34\xdb\x7c\xe4\x21\x96\x01\xe3\xca\xee\xbf\x3b\xec\x1d\x44\x8f\xe8\x03\xf5\x52\xd1\x55\x87\x02\x0e\x1e\x68\x6f\xc6\xf0\xfb\xa8\x3f\x9d\xc4\xb1\x36\xa1\xdb\xfc\x01\x73\x28\xe6\xe4\x4c\x53\x67\xc7\x08\xf2\x57\x62\x78\xc1\xc5\x94\xd8\xcd\xdc\x09\x0b\xd9\x26\xd9\xcb\x42\x91\x4e\xb0\x84\x4c\x4e\x62\x38\xee\x81\x06\x64\x87\xd0\x53\x8f\xf1\xd3\x14\x29\xa6\x4d\x2f\x03\xec\x48\x2a\xeb\x24\x84\xf9\x29\xb7\x1b\x04\x24\x03\x15\x63\x64\x59\xeb\xad\xd0\x5b\x95\x93\x16\xd8\xaf\x8b\xfb\xac\x1d\x50\x51\x8b\xf7\x22\x9a\x07\x20\xa0\x75\xe0\x7e\x50\xb6\x1c\x43\x75\xdf\xd2\xf9\x27\x4d\x4c\xfa\x0b\x98\x83\x04\x53\xf1\xdd\xce\x14\xa7\x4d\xbb\xee\xed\x8e\xfb\x54\x0e\xce\xd4\xd7\x9b\x0b\x23\xa3\x1e\x3c\x6e\x58\x78\x4e\x4b\x1f\x68\xd4\x29\x63\xe3\xce\xac\x4e\x24\x61\x4f\x09\x6f\xfe\xe2\x7e\x50\x2e\x1b\xc9\x08\x6a\xfb\x36\x70\x10\x93\xf5\x43\xc1\x43\x5a\xe2\xca\xad\x85\x46\x94\x07\x9c\xd9\x01\x28\x4a\xd1\xe0\xe6\xfc\x45\xaf\xc6\x3d\x13\x4b\x81\x90\xb9\x36\xfb\x9c\x2b\x3d\xc7\x76\x7c\x8e\xef\x50\x4d\x96\x54\x48\xf7\x96\x70\x35\x4f\x4d\x17\xb1\xaa\x66\xdf\xad\x77\x3e\x6a\xc4\x1f\x57\x49\x91\xd4\xf2\xcc\xa4\x72\x81\x8f\xd6\x40\xf0\x21\x5d\x1b\x12\x06\xb9\x37\x3b\x31\x36\xd1\x19\x3f\x4d\x7c\xf0\x11\x7e\x78\xd2\x66\x64\xd2\x95\x77\xe1\xdf\x81\xce\x12\x10\xde\x4f\x5f\x3c\x28\x51\x1a\xba\x51\xc4\x8b\x7b\xd4\x1f\x1e\x89\x02\x84\xd5\x0e\x8b\x94\xcf\x0d\xe1\xc6\xd7\x25\x10\x4e\xab\xdf\xe6\x78\xef\x64\x9e\xf8\xd8\x30\x45\x80\x78\x21\xc1\x2f\x5d\x6e\xb0\xa1\x25\x0e\x36\xb1\x59\x2e\x24\xdc\x7e\x3e\xf4\x34\xc8\xfd\x99\x8f\xe2\x8a\xa0\xf5\x82\xf3\x46\x91\xfc\x9f\x28\x2d\x81\x4f\x47\x08\x5d\x90\x24\x74\x4b\x93\x74\x2a\x7e\x7c\x55\xa8\x8d\xab\xb2\x37\x5c\x2a\xf3\x19\x83\x3a\x7c\x47\x14\x43\xc5\x8a\x5c\xcb\x1d\x3c\x8d\x40\x29\x3e\x74\xd6\xcc\xbd\xd1\x3b\x78\xdb\x69\x88\xa7\x04\x57\xf8\x47\x44\xf4\x2e\xb4\xac\xbf\x87\xd3\xa2\x66\x39\x13\xce\x50\x5f\x27\x92\x6e\xec\xdf\xf1\x1e\xc3\xcf\xfd\x1d\xd3\x0f\xfb\x41\xc6\xef\x5c\x1f\x64\xd8\x9d\x82\xbe\x63\x95\xe0\x03\xeb\x9e\x0f\x32\x92\x37\x90\x0d\x0c\x18\x17\x97\xf0\x5d\xb7\xb8\x21\x5b\xfb\xcf\xf4\xf8\x27\xbb\x73\x8f\x2a\x04\x87\x25\xae\xba\x3f\xa9\xbc\x4c\x68\x2d\x70\xd7\x84\x06\x7f\xfc\x52\x50\x7c\x9d\xaf\x32\xaa\xc8\xcd\x92\x26\xf8\x60\x64\xa6\xae\x44\xc8\xd0\xc9\xf9\xda\x9b\x3a\xe9\x4a\xa1\x06\xa7\x91\xa7\xdf\x35\x66\x3f\x37\xec\x51\xb1\x68\xbb\x38\x5b\x49\x49\xd7\x1f\x7d\xe2\x61\x5c\xfc\xfa\x9c\x27\x20\xdf\xc2\x01\x04\x65\xe8\x03\x0b\x83\x6c\xd2\x8c\xfc\x32\xd3\x77\xcf\xdf\xfc\xe4\xcd\xca\x96\x0b\x1e\x9a\x1b\x3a\x50\xd4\x75\x9c\xf7\x70\x5c\x73\x95\xb6\x1f\x92\xdc\x35\x21\x92\x9f\xda\x7d\xd2\x90\xf8\xe1\x56\xad\xef\x89\x92\xcc\xbb\xfd\x47\x24\xa5\x79\xae\x3f\x49\x32\xed\xa8\x4c\x35\x0f\xcf\x7d\xde\x05\x24\x0f\x90\x65\xe1\x27\x43\x84\x43\xf8\x3a\x73\x98\xcb\x27\x9b\xfd\x6e\x53\x1f\x00\xea\x53\x8d\x0a\xfd\xa7\x08\x1a\x24\x0d\x52\x8b\x04\x7d\x19\x16\x71\x47\xf3\xf0\xb8\x93\x02\x85\x2c\x16\x62\x88\x86\x74\xf8\x57\xf4\x93\xd5\x7d\xff\x7e\xd4\x58\x22\xd9\x6a\x0e\x8b\xa2\xb6\x47\x07\x83\xc4\x99\x86\xc8\xa6\x6c\xb5\x6d\x81\x1e\x62\xfc\x31\xee\x16\xf5\x0a\xfe\xf4\x02\xe5\x3d\x8b\x11\xf7\xe7\x4b\xc7\xfb\x8f\x87\x4c\xd5\x8b\x9a\x72\x60\x16\xda\x98\x5c\x07\x3f\x11\x1b\x13\x9b\x73\x63\x82\x62\x90\x04\x3c\x8c\x37\xcc\x35\xe7\x0a\x88\x22\x53\x95\x4a\xf4\xa3\x7d\xa9\xe4\x6e\xe0\x3a\x24\x1f\x5d\xbe\x17\x7c\xfe\x5b\xd2\x68\x67\x8e\xb6\xbc\x9f\x81\x06\x53\x28\xd2\x9d\xd4\x85\x82\x92\xe3\xc3\xc8\x9a\xa8\x71\x35\x9c\xf6\x93\xef\x36\x3c\x78\x41\x82\xa0\x8c\x02\x20\x84\x2d\x80\x8f\xa9\xdb\xfe\xca\xad\xc6\x51\xbd\x66\xe4\xee\xe8\xd3\x49\x7e\xc7\xf3\x9b\xe6\x21\x0a\xba\x97\x06\xb2\xbc\xc4\x6a\x3d\xd4\x42\x54\x35\x75\xf3\xf5\x45\xda\xa4\xc7\x16\xfa\xca\xe2\x20\x73\x38\x88\xc9\xf3\x17\x17\xf7\x54\x19\xcc\x53\x55\x0d\x2d\x7d\xf7\xd8\x5b\xb2\xec\xf4\xdb\xc3\xbd\xd3\x97\x49\x1c\x59\xb9\x10\xf7\x1a\x63\xd2\x5c\x01\xc3\xa4\xbb\x89\xf2\xe9\xe7\xde\x54\xe8\xe5\x78\x7a\x9b\x9d\xf8\x87\xba\xb4\xd6\xfe\xa9\xb3\xae\x11\x68\x9b\x04\xfb\xe9\xda\xc5\x0a\x2e\x62\x66\x8d\xf5\x6e\xd3\xbd\xfe\xc9\xb2\x2b\xb3\x8a\xe8\x6a\xfe\x14\x33\xc7\xe1\x97\xbc\x3f\x22\x5a\x26\x98\x31\x70\x89\xa8\xb9\x2e\x0d\x4c\x3b\x7a\x55\x34\x3a\x8a\x0f\x28\xa7\x43\x72\x2a\x72\x9a\x5e\x18\x82\xb1\x6f\x44\xe0\x8f\xcc\x6a\xf4\x48\xcf\x75\x6c\x28\xe7\x88\xac\x59\x19\x44\xec\x77\x33\x3d\x4f\x15\x03\x56\xc3\x04\x1a\x6a\x63\xfa\x1d\x9b\xdd\x4e\x6c\x27\xbf\x2d\xec\x07\xe6\x6c\xc4\xcd\x8d\xea\xd0\x7d\x59\x6e\x52\x78\x7e\x52\xdc\x9e\x92\x05\x84\x6c\x39\x05\xe2\x59\xd0\xe7\x66\x7a\xb4\xe1\x27\x0e\x5a\x80\x30\xa4\x02\xe6\x49\xc3\x0d\x0f\x79\xb4\xcc\xbf\xb0\x2b\x46\x53\x81\x5d\x52\x50\xf6\x1b\xb4\xac\x25\x75\xe1\xb8\x6c\x2f\x5f\xdc\x45\x85\xcb\xc8\x63\xbe\x2d\xb4\x86\x45\x48\x8a\xc5\xac\xd2\x45\x6d\x36\x7d\x4c\x12\x7b\xc0\x91\xb7\x0f\xa4\x12\x99\xe7\x10\x99\x93\xde\x3d\x75\xc7\xdf\x74\x36\x9e\x56\x63\xcf\x40\xdf\xa5\x4b\xcd\x5f\x49\x57\x44\x06\x1e\x03\xa4\x26\x9d\x77\x98\x7d\xc9\x47\xe9\x02\x89\x39\x2d\x40\xca\x97\xf4\x73\xa7\x85\x9f\x68\x99\xa3\xa2\xe3\x9e\x0c\xfb\x58\xa9\xf8\x53\xf9\xb8\x47\x0f\xb9\xb9\xa3\x9c\x58\xdb\xbc\x00\xca\x76\x82\xd8\xa0\xc5\x6d\x7f\xe1\xd8\x88\x2d\xd5\xe0\x20\x49\xdd\xa7\xcc\x2d\xd9\xbe\x84\x59\xc1\x0d\xe5\x31\xb5\x6e\x05\xca\x1c\x66\x1f\x17\xa3\x05\x27\xa2\x0a\x47\x68\x78\x4c\xe6\x6b\x7b\x95\x83\x85\xc3\x16\xdc\xa6\x41\xb3\x2d\x9b\x0b\x31\x1b\xa9\x83\x35\xde\xc3\x35\xe9\xd1\x6b\x8f\xf5\xa7\x67\x6a\x98\xee\x61\xa2\xe5\xba\x77\x97\xf7\xc1\x3d\x58\x70\xa2\xa9\xec\x9d\xc5\x0c\xba\xae\x1b\x36\x5e\x41\x96\xd3\x15\x70\xda\xdb\xca\x6f\x59\x4d\xf7\x23\x0f\x66\xe6\xa4\x83\x57\xb5\xc4\xb0\xc9\x06\xa2\x3e\xae\x05\xdf\x39\xe6\x57\xbf\xb0\x2a\xf4\xec\x66\x6e\x1c\x68\x0a\x13\xf3\xa2\x55\x2c\xd5\x8a\xfa\x82\x8f\xcf\x6f\x24\x74\x6b\x2b\x94\x13\xe6\xf8\xdf\xaf\x31\xf2\xc0\xef\x73\x2a\xf3\x18\xd1\x6d\xaa\x45\x23\xe9\xc1\x7a\xc2\x10\x45\x26\x97\xf0\xd0\xce\xb8\x08\xab\x9d\x18\x28\x8a\xd2\xf4\xe3\xf7\x5b\x2c\x96\xbd\xdd\x45\xde\xd6\x3f\x74\x66\x21\x4f\x9d\xfc\xe6\x15\xe3\x95\xe3\x51\x2f\xe2\x51\x6a\x66\x04\x8d\xe9\x37\xe9\xaf\x5e\xd2\xb5\xa4\x01\x29\xff\xc8\x40\x32\xa0\x48\x28\xfa\x4a\x41\xc0\x6b\x91\x27\xe3\xa4\xff\x2c\x80\x01\xa2\x2a\x78\x8b\x27\xfe\xc3\x8a\x4b\xf6\xdd\x0e\x5b\x59\x4f\x62\x79\x5b\x72\xd3\xed\x03\x7c\x94\x35\x97\x64\x20\xf2\x6f\x12\x39\x11\xab\x3c\xca\xf5\x55\xc7\x06\x8a\x25\x05\xee\xda\x2d\xb6\x36\xa2\xf1\x74\x4c\x86\xf0\x22\xf7\x70\xea\x8f\x48\x45\xa6\x62\x60\xd0\xe8\x46\x8e\x05\x67\xc5\x4c\x61\x3b\xdd\xe9\xb4\x2f\xd7\x0e\xd9\xa7\xd7\xac\xe6\x1c\x98\xf3\x48\xee\x3f\x34\x9f\x8a\x03\xc3\x72\x89\x5b\x85\x77\xa8\x2e\x4f\xed\xb0\x47\xa7\xdf\xa4\xdd\x53\x19\x0d\x6c\x55\x02\x41\xac\xdd\x64\x38\x0d\x21\xda\x53\xc4\xfe\x15\xfd\xe8\x79\xba\xd0\x3d\x40\x1b\x28\x11\x02\x5e\x2a\x84\x33\x46\x50\xd3\xe3\xad\x49\x46\x51\x20\x56\xf9\xee\xe7\x90\xbc\x7f\x04\xf3\x15\x0a\x6f\xdf\x07\xce\x89\x1e\xbe\xcc\x2a\xb7\x04\xf8\x93\x64\x2a\xc6\x96\x1f\x16\xf1\x97\x93\x9a\x9b\xa0\xb5\xcc\x40\xb9\xc4\xac\xa0\x4d\xb8\xbf\x97\x79\x5c\x84\x99\x5d\xec\x70\x08\xeb\x4e\xd3\xf4\xd1\xfc\x83\xce\x28\x52\x7e\x63\x09\xc3\xe4\x88\xf8\x77\x21\xf8\xc9\x5f\xf8\x5f\xc4\xbb\xcd\xbd\x66\x12\x66\x6d\xfd\x3a\x99\xf0\x9d\x61\x4c\x0a\x4b\xeb\xdd\x8a\x3e\x84\x5d\xdd\x2a\xe7\xdd\x5f\x0c\xdf\xe2\x1f\x4a\xfd\x13\xa6\x08\xc0\xe1\x35\xa3\x44\x1a\x71\x1d\xb5\x9e\xcc\x02\x89\xce\x24\xfa\x4d\xb4\xe7\x31\xf3\xe2\x0b\x2e\xe2\x2e\x58\x46\xcd\x0c\x59\x5d\x74\x34\x6d\x27\xdd\xd2\x52\x6c\x64\x2a\xa9\xdf\xe9\x84\x14\x29\x48\xe4\x0f\xa3\x6b\xd4\xd0\xd1\x94\x20\xaa\x55\xb2\x30\xdb\x87\xae\x8a\x1d\x2c\x25\x36\x24\x78\x73\x5b\x3f\x35\x7b\x78\x74\x38\x3d\xa1\x29\x93\xac\x23\x93\xec\x63\xe9\x87\x57\x0b\xef\x2d\xe7\x34\xc0\x06\x7f\x44\x02\x05\xfe\x1e\xa0\x50\xc3\xc5\xe4\x55\xf3\x44\xd9\x3a\x90\x36\xc4\x8f\xb2\x22\x54\xae\x42\x1b\xf8\x2f\x59\xfd\xa3\x8b\xfe\x15\x52\x84\xd7\x00\xbe\x7f\x64\x7b\x41\x0d\x6e\x2b\xb1\xf4\x70\x5c\x3e\x9d\x15\x37\xc8\xa9\x32\x79\x5c\x23\x4c\xac\x16\xd9\x8d\xe5\xaf\xe9\xd8\xc9\x35\xba\xad\xdd\xa1\x99\xc6\x35\xc6\xfe\x17\x37\x0b\x3a\x24\xce\x00\x9b\x95\xb4\xdc\xa5\x78\x78\x4d\xf7\x92\x65\xce\xc7\x6a\x79\x3a\xf3\x21\xf9\x46\xea\xed\x68\x33\xbf\x40\x50\xc2\x0e\xd4\x55\x63\x37\xf7\xbf\xc3\x0b\x03\x3d\xc5\xef\x10\x54\xc5\xcf\x8a\x06\x49\x54\x96\xe3\x77\x77\x62\xc9\x3a\x00\xbb\xb3\x0e\xda\xe8\xa3\xad\x43\x3b\xe3\xa9\xc3\x54\x84\x47\xb8\x26\x55\x57\x3d\x19\xa6\xab\x1f\xb3\x58\xbc\xe8\xaa\x59\x01\x59\x8e\x4d\xe5\x94\xd6\x09\x33\x3f\x00\x57\x91\xdc\x89\xa9\xd1\x9e\x57\x0e\xc4\xbf\xd9\xbf\xfa\xf0\x17\x13\x8b\x32\xb0\x12\x33\x07\x76\x9b\xb5\x42\xcc\x43\xcd\xfc\x45\xc2\xaf\xdb\x4d\xda\xee\x0a\xe5\xf4\xab\xd6\x20\xef\xea\x98\x04\x25\x58\x15\x8c\xb0\xb0\x91\x2a\x27\x98\x79\xa2\x6d\x9d\x9f\x77\x0a\x57\xd6\x48\xd9\xd3\x1c\x29\xe8\xf8\xce\x26\x4c\x79\x74\x77\xb4\x24\x1e\x35\x19\xf1\x97\x42\x7f\x65\x0a\x03\xc6\x36\x01\xe4\x54\xbd\xe2\x04\x08\x40\x89\x85\xa1\x3c\xfa\x68\xa9\x25\x38\x7e\x56\xc9\x8b\x7a\x20\xe7\x77\x83\xc5\xa8\xfd\x5c\x86\xa7\xc9\x2f\x8c\xb9\xb2\xeb\x34\xa5\x78\xfb\x46\x54\x0e\x49\x07\x45\x76\xd2\x5d\xd3\xf8\x87\xb6\xd3\x2b\x92\xcc\x69\x40\x42\xd7\xc3\xae\x75\xec\xb6\x93\xed\x03\x75\x7d\xa9\xc3\x01\x07\xf5\x61\xf2\xfc\x61\x19\x72\x86\xfd\xe8\xbb\x17\x53\x8d\x82\x6d\xf5\x6a\x1c\x2f\xe2\xd1\x9c\xe0\x5a\xa4\x97\xfd\x47\x53\x89\xbd\x27\x3c\xd8\x84\xf4\xae\x17\x3a\xf0\x99\xbb\x70\x35\x1d\xd9\x4c\x84\x39\x93\xff\xb9\xfb\xd4\xd4\x8a\x34\xd9\xef\x99\x0e\x6f\x11\x36\x39\x4b\x3f\xaf\x6d\x98\x00\xe4\xc2\x0a\x6e\x23\xb1\x11\xf5\x24\x5c\x9f\x88\x97\x48\x22\x7c\xbf\x14\x4e\x4e\xb0\xc9\xee\x1f\x2a\xb1\xf1\x62\xb4\xa2\xd1\x4b\xe2\xd9\x95\x4c\x26\x99\xb5\x8c\x20\x9f\x33\x0d\xd6\x1b\x61\xed\xe2\x97\x72\xc4\xf7\x42\xe4\x62\x0b\x1a\x70\x47\xe2\x66\xa2\x1f\x67\x7b\x7c\x97\x02\xf2\xfe\x55\x00\xe9\xeb\x5e\x66\x7d\x11\x24\x7b\x27\x13\xe1\x89\x43\x3e\x93\x16\x87\x4a\x20\x70\x80\x01\x91\x07\x0c\x20\xf6\xcc\x29\x87\x8b\xd1\xa7\x8b\x1a\xd7\xbf\x9f\xd8\x05\x94\x75\xbb\x81\xd6\xea\x3b\xeb\x84\xb0\x7b\x42\xee\x88\x45\x87\x0c\x45\x6a\xcb\xde\x58\x91\x4f\xb1\x97\x1e\xaa\x7f\xea\xdb\x6e\xf8\x75\x85\x18\xd2\x8e\xc6\xe0\x7e\xb1\x2a\xf1\x54\x7c\xe9\x05\xaa\x62\x05\x9f\x4d\xcc\xff\x89\x91\xa8\x1b\x65\x1d\xc6\x11\xa0\x6f\xfe\x9e\x7a\xf9\x77\x72\xa9\x38\xbd\xfe\x5f\xf4\xe1\xd4\x10\xf8\x57\x92\x5b\x1c\xc7\xec\x97\xca\xd0\xac\xdf\x93\xce\x43\xbf\xd4\xca\xd6\x7e\xaa\xcf\xe9\x2b\x8c\x93\x74\x6a\xad\xe1\xdc\x0a\x32\xd5\x46\x6e\xce\xc7\x77\xa7\x39\x3e\xbd\xfd\x73\xdb\x6d\x3b\x45\x38\x74\x7b\xb5\x5e\xd2\x7e\x26\x78\x6a\x65\x85\x7d\xc7\xbb\x39\x80\x7f\xc7\x6f\xdb\xf7\xbc\xb3\xb7\x35\x68\x63\x63\x90\xf6\xcb\x9d\x3c\xcd\x77\x1c\x47\x39\x82\x86\xa2\xa2\xde\xe2\x00\x7e\x78\xbe\xb9\xed\x8e\x91\xa6\xfd\x85\xcb\xfd\x27\x31\x90\xd4\x6f\xc8\x25\x0c\x19\x27\x6f\x81\x3b\x84\x9b\x8b\xb7\xca\x7a\xdd\xd4\xa6\x89\x9a\x4e\xd5\x5f\xec\x67\x5c\x1e\x59\x3a\x69\x55\xc8\x25\xd1\x73\xd6\xf4\x0d\xfe\x38\x41\xe2\x02\xe6\x85\xb8\xc4\xb7\x16\x2f\x58\xee\xee\x4b\xb0\x67\x3b\xc9\x9d\xd9\xac\xae\x96\x24\xfb\xf8\x9a\x99\xe5\x06\x6f\x9e\x53\xa6\x6f\x58\xfd\xd4\x7e\xff\x7b\xe3\x6c\xdc\x5f\x3f\x9b\x97\xd8\x55\x0a\xa2\x84\xe6\xdc\x60\x05\xe6\xa0\x98\x9b\x3b\xb9\x01\x19\xc9\x8e\x89\x33\xdf\x38\xdf\xc8\x10\x6a\xc4\xa2\x65\x15\xc8\x7c\x9e\xbc\xf9\xd1\x8e\x9b\x99\x99\x61\x5e\x66\xde\xba\x1c\x3f\x47\xb4\x8e\xf7\x37\xcc\x3d\x9f\x53\x6e\x46\xc8\x35\x21\x82\x7f\x93\x81\xf2\x00\x03\x60\x9d\x0c\xa0\x12\xa1\x92\x68\x55\x44\x0b\x9f\x22\x4c\x1c\xf4\xf2\xae\x3f\x55\x82\x60\x04\x56\x6d\xd9\x88\x3c\x05\x90\xcd\x58\x6e\xaa\x9b\x48\xab\xd9\x65\xbb\x59\xca\xc0\x18\xd4\x97\x17\xfb\x40\xf9\xf2\x27\xa9\x4a\xdf\x79\xcf\x4a\xfa\xa0\x81\x34\x90\x22\x0d\x7f\xe2\x21\x7d\xa3\x3f\x63\x0a\x3e\xbd\xef\x19\xc8\xa3\x5f\x94\xaa\x46\xfb\x2a\x77\x03\x28\xa3\xcc\xdb\x00\x28\x0d\x89\xb0\x79\x01\xaf\x94\x8f\x43\xf9\xbd\x67\xa9\x7a\x15\x00\x25\x27\x7a\xf7\xd3\xf8\x57\x40\x9b\xc8\x9a\xfe\x90\x5d\xcf\x90\x5d\xdc\x83\x1f\xda\x91\xd7\xe8\x3f\xb0\x81\xbe\x5b\xa3\x6d\x33\xbe\x5e\xba\x14\x9f\x33\xf6\xc6\x02\xb6\x02\x40\x4c\xe9\x19\x39\x64\x81\x10\xab\x64\xaf\x71\x5f\x84\x7f\xb4\x6e\x89\x4d\xc7\x09\x82\xad\x85\xee\x79\x95\x41\xa5\x8f\x76\xeb\xbe\x66\x42\x9a\x11\x85\xcd\x88\x0f\x64\xe6\x1b\x37\xac\x72\xd0\x37\xca\xd5\x05\x40\x8a\xac\xfc\xd1\x7f\x4f\x09\x9e\x78\xa8\xed\xd8\x0d\xc9\x21\x2d\x49\x8f\x51\x74\x73\x90\x0b\x68\xe8\x40\xac\x5a\x4e\x62\x07\x6d\x68\x5a\xb2\xdc\xe0\x6e\xff\x7d\xc0\x1d\xe4\x09\x5d\x30\x33\xa6\x22\xe8\xb0\x03\x65\xad\xbc\xaf\xbd\x55\x97\x8a\x3c\x15\xd5\xb5\xec\xa0\xcb\x22\xb8\x28\x86\x35\x99\x62\x6e\xd4\x26\x9c\x59\x89\xd2\xfe\xe2\x3b\x73\x4d\x3e\x15\x7a\xf9\x3a\xbd\x9b\x15\xda\x69\x47\x1a\x6e\x47\x76\xb5\x11\x8b\xca\x02\x49\xa8\xe4\x39\x13\x9c\x95\x53\x4c\x5d\xc3\x7d\x39\x63\x43\x1f\x44\xa1\x37\xbb\xf5\x9c\x13\xfc\x07\xa5\x19\x00\xb6\x5f\x4d\xda\x4e\xb0\xe0\x12\xd5\x2c\x22\xac\x15\x24\x98\x30\x74\x76\x71\x50\xad\xee\x6b\x25\x23\x6c\x80\xe4\xe2\x61\x65\xec\x93\xec\x12\x20\x22\x59\xc9\x51\x67\x4e\x69\x91\xfc\x4a\xd4\x76\x31\xa0\x07\x44\x3c\x41\x12\xf6\xb1\x63\xd1\xbf\xdf\xca\x0d\x5d\xd1\x59\x0d\x01\x74\x55\x25\xd6\xdb\xc4\x36\x82\x6d\xc7\x74\xc0\x1d\xaa\x9f\x38\xf3\x18\x33\xda\x42\x95\x11\xb2\x23\xe6\x2b\xe6\x8e\xd9\xe2\x91\xe8\x72\x0b\xed\xd5\xbe\xb4\x30\xff\xb3\x36\xcf\xea\x3b\x94\x0b\xce\x75\x15\xb6\x2d\x0c\xfe\x7a\x7b\x64\x48\x97\xae\x6b\x8f\x02\xde\x32\x10\x13\x4e\x0f\x4e\xec\xda\xbf\xd9\xbb\x41\x06\x9b\x72\xaa\xf9\xee\x57\xa1\xd4\xca\xe5\xa6\x89\x65\x14\x41\xc2\x5f\xab\x2e\x3d\xb0\x7f\xc3\x8c\x65\x87\x44\xa8\x0a\x4c\x71\x6b\xa3\xcd\x40\xb8\x91\xf5\xad\x79\x13\x8d\x36\x0b\xe2\xb9\x86\xeb\xc7\x9b\x60\x23\x93\xe3\x5b\xd8\x64\xa0\x2c\xc5\x55\x2f\x1c\x7b\x2f\x7c\x67\x9f\x30\xfc\x92\x2d\xb6\xf3\x3c\xb7\xcd\xeb\x9d\x63\xdb\x0c\x96\x06\xab\xba\xc7\x36\x4a\xf8\xcc\x30\xd9\x46\xa5\xec\xab\xe9\x13\x5b\xb2\x64\x70\xe2\x74\xfc\x72\xcb\x29\x0e\x12\x2e\xfe\x54\x1c\x3b\xab\xef\x47\x70\xf1\x34\x43\xd6\x6c\x0e\x5a\xf4\x7c\xcb\xff\xac\x58\xe2\xdb\x3d\xb1\x16\x64\xca\x12\xa0\x3d\xc2\x15\xfa\x2d\x01\xf7\xa8\x82\x5a\x56\x37\x23\x38\x24\x86\xf7\xb3\xa2\xaa\x67\xf8\xaf\x67\x3b\x07\x73\x52\x4d\xbd\xa5\x79\x32\xeb\x5b\x5f\xd0\x6b\x1f\x75\x7d\x46\x4f\x11\x6f\x6e\x95\xa5\xf9\xe9\xfd\xd0\x47\xd6\x24\x32\x41\x71\xd7\x3e\x7c\x82\x20\xc1\x68\x23\x10\xcc\xa7\x61\xcb\x7d\xd3\x54\x8c\xec\xd5\xd2\xba\xab\x67\x45\x14\xbb\x71\x3a\x97\xec\xf0\x5c\x69\x60\x09\xef\xc0\x9d\x5f\x06\x87\x41\x6c\xcc\x19\x57\xb4\x6e\x8d\xa7\x32\xd1\x1b\x11\x7b\x9b\xf7\xc3\xc4\x04\x8e\x92\x9b\xaf\xad\x14\x14\x77\xb6\xd2\x93\xe2\xb9\x01\x3a\x20\x8d\x1c\xa9\xba\x00\x0e\x48\x72\xfb\xc5\xb1\x7a\x73\xb7\x69\xcf\x7f\xb9\x8c\xa2\x50\xec\x80\x8c\xe8\x25\x65\x23\x23\xcd\x77\xb2\x6a\x9d\xb4\xc6\x62\x3b\xad\x37\x45\xa6\xef\x25\xdf\xe2\x2b\xff\x99\x8e\xc9\x51\xd5\x0c\x5d\xa0\x2e\x1d\x02\xc3\x4e\x40\x01\xaf\x75\x5a\x9e\xff\x8e\xf6\xc7\xf5\xf3\x51\x7d\x77\xb9\xca\x5d\x53\x54\xd9\xed\xad\xa0\x5c\x79\x4d\x24\xeb\x7c\x65\x57\x15\x88\x74\xdf\xc7\x2f\x03\x7d\x20\xd7\x04\x02\xa6\x7e\xb6\x7a\xaa\xa9\x2a\x5d\x2e\x59\x76\x58\x48\x24\xb4\x68\x98\xff\x7c\xe5\xcf\x95\x14\x02\x41\x22\x03\xca\x3c\x36\xf4\x47\x76\x95\x66\xe4\x69\x13\xf2\x7e\x33\x22\xd2\x41\x0c\xc7\x0e\xec\xac\x74\xf3\xa4\x53\xe2\x73\x85\x63\x5e\x8c\x6e\xd1\xec\xdb\xc4\x60\xd6\x7e\x42\x07\xbe\x7c\xdb\x5f\x06\x37\x74\x5e\xf6\x77\x42\xd9\x26\x2f\xfb\x07\xa1\x3e\x2b\x57\xf5\x20\x00\x1a\x7e\xc5\xed\xf9\x0e\x9b\xde\x27\xcf\x32\xa7\xce\xa4\x98\xd6\x26\x40\x51\xaa\x94\x5b\x30\x44\x8f\x7b\x16\x81\x9e\x53\xe9\x21\x80\x34\x90\x5c\x29\xaa\xc3\x6d\xb0\xe9\x64\x90\x96\x48\x54\x03\x90\xa7\xf2\x5d\x80\x38\x08\x8c\x52\x6d\x49\x1c\xc2\x6c\x03\x89\x07\xdc\x94\x5f\x76\x6a\x04\xde\x20\xae\xad\x87\xea\x63\x67\x75\x95\x1e\x3c\x6f\x43\xc2\x77\x60\x92\x14\x30\x57\x5e\x5c\x20\xa4\xb3\xa5\x73\x58\x81\xb8\x05\x82\x92\x06\xe7\x21\x3d\x5b\x54\xd6\xd9\x71\xc7\x0f\xd2\x43\xa3\x28\x08\x3d\x20\x9e\xde\x3a\xe1\x58\xf0\xcf\xb6\x07\x90\xdb\x04\x1d\xb9\xcd\xa8\x97\x3f\xe8\x60\x69\x94\xe2\xc9\x40\x57\x1a\xc1\x02\x02\xbd\x06\x22\x16\xa0\x08\x99\x47\x7e\x97\xc2\x92\x00\xe2\xe0\xbc\x6b\x2f\xcb\xe8\x88\xab\x1f\x5f\x47\x1b\x15\x0c\x33\xd8\x8a\x44\x1d\xd7\xdc\xee\x1e\xb9\xf5\xe6\x9a\x96\xd1\xea\xc6\xfa\xe1\x8f\xdf\x85\xa3\x4f\xc6\x46\x9f\xdc\x65\x5b\xfc\xe4\xc5\x34\x61\xb4\x23\x48\xa6\x11\xfc\xd2\xf7\xf8\xc2\xa6\xb8\xb6\x91\xa6\xd3\x56\xfc\xb4\xe2\x9a\xd6\x1b\xe3\xd7\xf2\xf2\xc5\x3e\xda\xbf\x53\xca\x0e\x52\x66\xe8\x63\xa7\xcc\x02\xc2\x55\x02\x52\xb6\x97\x57\x89\x63\xf7\xfc\xdc\x5f\x78\x7f\x1a\x5e\x1a\x9b\xfd\x41\x1c\x8a\xc0\x73\x2d\x17\x57\xaf\xac\x7e\x1b\x4c\xce\x14\x90\xd1\x77\xb7\x05\xb9\xe7\xfc\xa9\x7b\x82\xc0\x21\x11\xd2\xc4\x37\xa3\xf8\xa9\x63\xd3\xfd\x5e\xf2\xce\x4a\xc6\xf0\x79\xe9\x45\xfd\x3f\x3e\xfc\xb7\x3a\x35\x8a\x69\xad\x82\x55\xfe\xa9\x92\x82\x7f\xa8\x42\x0a\x40\x91\xff\x2f\xa6\xb9\x32\x70\xd5\x9f\xc8\x4e\x0e\x00\x00\x17\xec\xa0\x71\x87\xba\xe7\xfe\xa3\x7f\x9f\xc3\xfc\x8f\x35\x7e\x81\x03\xfe\x0d\xc2\x7f\xae\xc1\xc4\x88\x26\xfb\x37\x22\xff\xd9\x4f\xbe\xf7\xba\xff\xcf\x6a\x37\xf8\x10\x20\x46\xfe\xab\xff\x3b\xca\x44\x84\x01\xc9\xfa\x6d\xfd\xcf\xd7\xd8\xb0\xf0\xfe\xcb\xd6\xff\xe4\xea\x86\x99\xfa\xf8\x08\xf4\x17\x2f\xfb\xab\x09\x0b\x9f\x7f\xe7\x0a\xff\xaf\x06\x0a\x90\x06\xa4\xc9\x87\x89\x58\xc1\xfd\x54\x7f\xc3\xa9\xa5\xa9\xa7\x51\xa5\x06\x8f\xf8\x3f\x30\xe1\xa0\xcb\x86\x4b\x00\x00")
+
+func imagesLgtm_comicPngBytes() ([]byte, error) {
+	return bindataRead(
+		_imagesLgtm_comicPng,
+		"images/lgtm_comic.png",
+	)
+}
+
+func imagesLgtm_comicPng() (*asset, error) {
+	bytes, err := imagesLgtm_comicPngBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "images/lgtm_comic.png", size: 19334, mode: os.FileMode(420), modTime: time.Unix(1792052089, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _imagesLgtm_maskPng = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\xbc\xe7\x57\x53\xdb\x1f\xf5\x0b\x31\x88\x20\xc2\x31\x88\x88\x94\xa0\x04\x82\x74\x50\x29\x52\x02\x82\x40\xa8\xd2\x7b\x6f\x22\xbd\xf7\xd0\x54\x0e\x4a\x0d\xbd\xa3\xf4\xde\xa4\x77\x29\x01\x69\x02\x52\x43\x0f\xbd\x17\xe9\x21\xb9\xf1\x77\xc7\x1d\xf7\xf9\x0f\x9e\x37\x87\x17\x93\x01\x23\x23\x7b\xef\x55\xbe\xf3\x33\xd7\x5a\x49\xf8\x5b\x15\xb9\x7b\xe4\x8f\xc9\x89\x88\x88\xee\xc1\xe5\x65\xd4\x89\x88\x00\x41\x44\x44\xc4\x4b\x77\x6e\x13\xfe\xf3\x72\x74\x6e\x95\xf0\x8b\xd8\x4d\x5d\xee\x35\xf1\x4c\x3f\x57\x20\x11\x91\x24\x11\x5c\x46\x4a\xd3\x9b\x78\x6f\x9e\x34\xd9\xd2\x2a\x05\x7f\xd3\x15\x63\x66\x1a\x44\xf7\x29\xf8\x89\x22\x28\x96\x59\xaa\xe3\x8d\xd9\x9d\xa7\xff\x02\xd8\x1e\xd0\x58\x9d\x55\x5c\x6f\xe3\xe7\xa7\xde\x4c\x35\xec\x5c\xa5\xe2\x4a\x9b\xec\xf4\x8d\xca\x75\x04\x36\x9f\xde\xbd\x2d\x23\x13\xf2\xa3\xfc\xe9\x1d\x79\xeb\x3b\xb7\xc6\x57\x9f\x6c\x30\x9b\x5d\xdd\x04\xf8\xed\x74\xa6\x66\x16\x6f\x6f\x93\xcf\x8b\x3f\x5f\xdc\x68\xda\x97\x98\xa0\x51\xb9\x48\xd7\xf0\xd8\x6f\xca\x72\x53\xd1\x4a\x50\x26\x26\x79\xf0\x56\xf2\x3f\xf9\x4f\xfe\x93\xff\xe4\x3f\xf9\x4f\xfe\x93\xff\xe4\x3f\xf9\xbf\x20\xbd\x5a\xa6\x29\x2e\x19\x4d\xd4\x3a\x92\xc1\x3d\x52\x41\x23\xe1\xa0\xc1\x83\xc3\x91\xc8\xce\xa6\x8f\xec\xf5\x74\x89\x68\x0f\x2a\x3f\x8d\xbf\x2f\x34\x7d\x7a\x87\x02\xcf\x9c\x75\xe7\x64\x55\x8c\xfd\x97\x42\xb6\x7d\x8d\xd5\xf4\x76\xe3\x8d\x6f\x29\x44\xd0\x6a\xba\x62\x2b\xe2\xd4\x9b\x3f\x23\x9e\x5d\xda\x91\x2a\xfa\x58\xf7\xa6\x25\xc1\xb2\xc2\x9b\xab\x2f\xf4\x54\x8d\x69\x43\xe2\x71\x97\xeb\xe2\x2f\x46\x46\xdc\x33\xb4\x24\x9d\xa7\xf0\x63\x93\xc9\xaf\x92\x7a\x8b\x56\xd3\xcb\xef\xae\xce\xaa\xab\x8d\xe2\xcd\x60\x6c\x9a\x89\xad\x14\xd7\xfc\xf7\x50\x45\x92\x26\xc0\xab\xcb\xde\xcd\x01\x0a\xd1\xb1\x3c\xf5\xa0\x3d\xf7\x99\x6e\xd7\xda\x27\x70\x32\x07\xb1\x3a\x13\x89\x4d\x32\x64\x32\x2d\x35\xef\xc4\xd3\xd0\x9e\xf2\x65\x1d\x90\x84\xfd\x8e\xc5\x25\xd6\x04\xbc\x56\x85\xfc\xe7\xe1\xb5\x6f\xf4\x8a\xba\xd2\xe1\x33\xb5\x7d\xce\x2e\xd7\xc0\x80\x6f\xba\xa0\xee\x96\x52\x5b\xfa\x36\xee\xc3\xc0\xf0\x7c\x5e\xfd\x10\x70\x47\x8b\x1a\xe7\x4e\xc1\xa8\xc5\xfc\x82\x4e\x7e\x7f\x25\x4e\xfc\xd7\xf0\xd5\x8e\xe7\xec\x5e\x4b\xa6\x43\xfc\xc8\x97\xe3\x14\x35\xae\x9f\x95\x19\x2e\xdf\x4f\x18\x12\xdb\x81\x68\x8f\x2a\x76\x89\xbd\x88\xae\x0d\x76\x3e\x47\x40\x2b\x42\x3a\x02\x1d\x22\xbc\xcc\xaa\x1d\xe4\x10\x2f\x63\x99\xd0\xc4\x1e\xbe\xe4\xcd\xf5\x02\xbc\xef\x3f\x8d\x17\x39\x72\xe7\xce\x1c\x89\xa1\xc2\xf7\xe4\x55\x59\x58\x42\xc3\x31\xe4\xb5\xba\xcf\xba\x12\xa8\x4b\xd8\x04\x95\x4a\xb3\x92\x53\x92\x33\x97\x24\x98\x30\x22\xa5\x54\x89\xa7\x1f\x81\x03\x46\x80\x68\x14\x88\x8a\x95\x49\x45\x2c\x6e\x49\x64\xcd\x67\x4d\x70\xa4\x24\x7a\xe3\x38\x90\x8e\x14\xc3\xaa\xa0\x9e\x9c\x76\xb7\xb6\x9a\x3b\xfe\xd2\xb2\xaf\xae\xf3\x98\xab\xac\x4c\xaf\x7a\xf4\x60\xc4\x73\xf2\xfe\xce\xe7\x53\xd4\x75\x76\xa9\xd3\x02\x3d\x66\x66\xfa\xe1\x36\x8c\x77\x5a\x76\x7a\x78\x66\xc1\x6f\xbe\x12\x15\xec\x90\xb6\x86\x5a\x9e\x01\xf2\x0c\x4d\x2a\x32\x55\x3f\xe3\xe3\x07\x7a\x5b\x4c\x3f\x48\xee\x03\xa0\x2d\xd7\xeb\x14\x4a\x13\x75\x59\xaa\x7c\x43\x64\x72\xc1\xe0\x4d\x3a\x87\x81\xde\x61\x07\x95\x95\xa2\x6e\xea\x70\xd2\xf7\x52\x2b\x5d\x16\xd4\x97\xd2\x59\x1f\xb2\x35\x65\xe4\xaf\xf8\x20\x45\x0b\x3c\x47\x4d\xdd\x25\x20\x03\xa5\xd4\x17\xb6\x39\x1e\x53\x46\x5e\x97\xf7\x84\xe6\x2a\x67\x4d\x43\xef\x15\xcf\x95\x6b\x1b\x79\x4c\xbc\x5f\x4b\xd0\x29\xd7\xd6\xb3\x4a\x78\xef\xec\x5c\xe9\x7b\x36\x63\x43\x1b\x6e\x93\xe9\x93\x9f\x0a\x0b\x44\xfc\x7d\xb9\xd6\xf9\x82\x9f\xd3\x29\xf6\x64\xa4\x46\xba\xfb\x0e\x3d\xe8\x87\x1f\xb5\x17\xe4\x36\x48\x95\xcd\xbb\x65\xf7\xc5\x11\x57\xff\x0b\xd1\x5f\xc8\x07\x89\x96\x7c\x5e\xf7\x6a\x75\xd6\x0f\x26\xc9\xea\x3e\xbe\xa4\xb6\xbf\xcb\xc9\x96\x38\x09\x8c\x91\x49\x05\x7e\xf0\x10\x69\x39\x7d\x4e\x6c\x19\x30\x8f\x34\x15\xaf\x42\xf8\x80\xc5\x92\x4d\x03\x9e\xc3\x30\xa7\x12\xa6\x29\x5e\x8a\xf6\x31\xc9\xea\x5f\xfa\xe9\x90\xff\x9c\x61\x4e\x56\xf6\x23\xce\xdb\x80\x68\x7e\x13\xff\x75\xa8\x2e\xc2\xbc\x85\x7a\x79\x30\x44\x39\x78\x12\xc0\x16\x54\x16\xe0\xef\xee\x4f\x22\x68\x68\x11\xf9\xb2\x7c\x26\xe0\x7c\xfe\xba\xf1\xb9\x9d\x1f\x19\xd8\x77\xf3\xeb\x76\x74\x20\x16\x73\xcf\xa7\x53\x95\x7a\xf7\xcf\xf4\x91\x7a\xeb\x53\xdb\xaf\xe4\x7c\xf7\x61\x7f\xaa\x38\xb5\xee\x23\xf3\x6a\x41\xa4\xac\x67\xca\xbb\x4d\x47\xbd\xf4\xaa\x19\x2f\xed\xb4\x6b\x31\x51\xa2\xda\xa2\xbb\x95\xd2\xb5\xf3\x9e\xe8\x7b\xac\xbe\x3e\xa5\x18\x59\xa4\x37\xfe\xe6\xbc\x02\x69\x19\x93\xec\x82\x76\xa8\xd7\x9a\x32\xf6\x73\xf0\x18\x7a\x39\xa5\xe9\xb6\x3e\xe8\xb3\xd5\x43\x6b\x62\x29\x27\x12\xd3\xc0\xef\xe2\xb6\x72\xa4\x1f\xa3\x24\x96\x6a\xda\x17\x00\xf9\x08\x11\x69\x18\xd6\x75\xa8\x9d\x28\x9f\xb7\x88\x16\x4f\x9c\xb4\xe4\x05\xde\xad\x0f\x0e\x0e\xde\xce\x4b\x32\x2e\x36\x3e\xfa\xac\xc3\x85\x95\xcc\xc2\x37\xe3\xae\x76\x4a\x44\x0f\x5a\x4e\x4a\x95\xd6\xb7\x81\x07\xa1\x5a\xa0\x52\x96\x85\xbb\x14\xf0\x39\x54\x0e\x23\x92\x97\xdb\xa6\xed\x6a\xa2\x82\x29\xce\xd6\xc4\xb9\x93\xe4\x32\xf3\x04\xeb\xf5\x9c\x1f\xd9\x2e\x0a\xe2\x12\xed\x37\x9d\xb1\x76\x1b\xcf\xef\xe8\xa6\xe1\x23\x4e\x6f\xd8\xe9\x15\x29\x29\xf6\xa8\x9f\x73\x1d\xb0\x42\x5c\x0e\x98\xa8\xdc\xf9\x72\x32\x02\x6b\x9f\x75\x6a\xbf\xc9\xb7\xb1\x16\x54\xfc\x55\xdb\x1d\x26\xde\x4f\x41\xf3\x89\x2c\x36\xd7\x5a\x62\xdb\x00\x8e\x95\xd7\x3c\x57\x62\xc8\x24\x5f\xc4\xc1\x2f\xd8\xc0\xae\x7d\x4f\xb8\x14\x6b\x67\xac\xb9\x14\x9c\xd0\x02\x03\x5c\x1a\x43\xc9\xfc\x16\xec\x9e\x0b\x3e\x1b\x1f\x65\x71\xbf\x35\x54\xb9\xfd\xfa\x59\x93\x06\x5b\x36\xbf\x7d\xb1\xc6\x61\x37\x60\x81\xf3\x91\x42\x0b\xf2\x48\xb9\x97\x5b\xd5\x40\x51\x35\x0e\xb8\xa6\x56\xe1\x23\x73\x45\x01\xf9\x37\x56\xef\x8b\x91\x03\x2a\xf1\x0e\x4f\x4e\x9e\xef\xef\x19\x2c\x5e\x4d\xbe\x65\x3d\x7b\x9e\x75\x39\x98\x3c\x3c\x60\x5b\x90\xa1\xdd\x7a\x3d\x7f\xb0\xc0\x4d\xbd\xf6\x85\x2a\x41\x10\x04\x2e\x0e\x04\x6a\x75\x1e\x29\x10\xe1\xd7\xb3\xf0\x97\x59\x29\xf5\x83\xaf\xf1\xe8\x3f\x5b\xe3\x3a\xde\x87\x9d\xa4\xf5\x3f\xe3\xf7\xe1\x95\x83\xf6\xcd\xf6\x35\x2d\x07\x17\xaf\xdf\x69\x98\xbe\xbe\x9b\x4c\xa4\xb2\x39\x51\xf3\x7b\x64\x4e\x46\xa9\x58\xfe\x6c\x13\x01\x71\xf9\x7a\x2c\xa5\x55\x06\x9e\x57\xa4\x73\x40\x45\x5e\x2e\xb7\x2a\xaf\xc8\xd5\x1e\x50\x1b\xd8\x57\xf5\x9c\xc9\x31\xf9\x58\x9e\x2e\x9c\xb7\xd5\xa7\x8a\xb8\x59\xef\x3f\xce\x12\xf4\x39\x5e\xfd\xda\x1d\xb7\x7a\x52\x62\x72\xf3\xde\x63\x5c\x59\xa8\xcc\x77\xce\x05\xb5\x17\x33\x8d\x1b\x95\x03\xb7\x3e\x73\xf3\x30\x06\x98\xa4\x17\xe5\xc6\xbc\xad\x7c\x1e\xf0\x84\xfb\x96\xc1\xfd\x47\xbc\xd1\xa1\x54\x12\x0d\xa9\x02\x56\x3f\xbf\xa3\xc0\x81\xfe\x37\xb3\x2d\x6f\xcb\x58\x8f\x1a\x61\x52\xe7\x12\x9c\x9f\x73\x03\xb8\xd0\xb2\x5b\xdc\xd3\xd7\xbf\xa4\xa9\xf2\xae\x7d\xb8\x60\x42\x93\x7d\x7f\x2e\xb8\xb2\x4b\x52\x46\x00\xe7\x45\x52\x95\xdc\x4c\x00\x6a\x07\x54\xad\x77\xa1\x48\x92\x62\x0d\x91\x28\x6d\x08\xe3\xbd\xce\x28\xc7\x96\x28\x9f\x9f\xb9\xb4\xb6\xbb\x41\xd3\xf7\xf7\x92\x2e\x8c\x9a\xba\x54\x94\x7b\xac\xaf\x21\xfa\xa1\x59\x57\xbf\x0b\x7a\x5f\x5e\x1a\x58\xaa\xb8\xa1\xb4\x0d\x1c\xb4\x02\x6e\x76\x4c\x0a\xbe\x3c\x1a\x1f\x02\xd1\x4f\x98\xcf\x53\x14\xf1\xb8\xb4\x3c\x8d\x16\xc7\x6f\xc0\xe2\xa8\x66\x3f\x4b\x36\x81\x07\x86\xf8\x19\xcb\xbf\x8f\x29\x4c\x98\x85\x31\xf6\xbe\x9a\x61\xee\x74\x49\xd6\xa0\x1e\x07\x95\xf2\xb6\xb9\x47\xa0\x10\x6b\xa1\x61\x60\xc4\x45\x2e\x8d\x8e\x61\xec\xe5\xe3\x01\xed\x7c\xd4\x46\x87\xcc\x35\xd7\x23\x45\xd2\x82\xda\x47\xe4\x11\x28\x7a\x0f\x7e\x4b\xd7\x44\x14\x7c\x29\xaf\x20\x0b\x91\x8a\x49\x67\x93\x09\x39\xed\x63\x89\xee\x90\xd4\xa1\xa2\xa1\x8a\xd2\xb2\x90\xff\xf8\x99\xa7\xf2\xc8\x71\xf7\x5f\x79\xdc\xa4\x22\x43\x3b\x61\x70\x66\x79\x5c\x6d\x15\xb4\xbf\x0a\x9f\x58\x6a\x5e\x38\x2d\xe7\x98\xaf\xb6\x92\x7b\xb9\x70\x32\xe3\x7c\xb5\x95\xba\x8e\x98\x7c\x4e\x46\x12\x0e\xa4\xa1\x41\x92\x35\x8d\x7b\x1f\xf6\xbb\x83\x6d\x4c\x39\x01\xde\x62\x47\xc0\xd6\x72\x8b\x45\x89\xc3\x60\x2a\x3e\x35\xca\x30\x53\x46\x35\x12\x16\xb2\x5c\x57\x45\x2d\xff\xd2\x12\x53\x7b\xb0\xdc\x60\xa6\xb8\x68\x68\x95\x6d\xfa\x1f\xcd\x92\x8e\x6a\xb5\xc4\x11\x00\x25\xd9\xd7\x2b\x91\x27\x12\x15\xbd\xb2\x3f\x8a\xb8\x86\x09\x53\xf9\xcb\xc9\xac\xc1\xf1\xc6\x4d\x13\x83\x33\x42\x85\xd2\xd5\x64\xfd\x7a\x11\x8f\x48\x20\xff\x95\x1c\x58\xa9\xee\x71\x35\x1a\x8d\xd0\xdc\x6b\xdc\xc8\xd4\x14\x67\x6f\x34\x4c\x7a\x24\x3f\x17\x9b\x8d\xfe\x22\xce\x77\x1e\xed\x91\xa4\xc3\x76\xe9\xca\xd2\x98\x33\xf0\xc7\x01\x9e\x5b\x7e\x85\x6e\xbf\x41\x77\xb9\x56\xbf\x39\x8d\xf2\x6a\x09\x85\x2a\x6d\x76\x47\xd3\xe5\xba\x58\xbb\x3c\xde\x49\xfa\x10\x0d\x0c\x59\xf2\x4c\xc1\x17\x64\xfa\x23\x17\xde\x18\xdc\x67\x26\x16\xbb\xda\xcc\x21\x1d\xdf\xd3\x5b\xd3\xfd\xe9\x7a\x9e\x4c\xe1\x35\xef\x31\xc5\x81\xda\x40\x62\x63\x2f\x62\x4b\x2b\xb4\x9a\x87\xe8\x66\x35\x1d\xf4\xbc\x2c\x26\xce\x86\xfb\x15\xe9\xdd\x40\x1a\xed\x06\x4d\xad\x25\x15\xfe\x6b\x9c\x13\xb2\xf4\x0e\x2f\x2e\xa6\x2d\xa2\x87\x06\xfe\x38\x2a\x1e\xea\x75\xb8\x04\x41\x42\xa8\xd7\xee\x9a\x59\xf7\xe4\x4b\xda\x05\x57\xe1\xf2\x27\x7d\x1d\xfd\x35\x83\x3f\x92\x5c\xf0\x05\x0c\x30\x7a\xcd\x1d\x35\x45\x23\xfe\xe4\x64\xdd\x8c\x25\xb5\x7d\x5f\x44\x49\x9c\x55\x6d\x97\x39\x05\xec\xbc\x9d\x5a\xfe\xa1\xba\x37\x1f\xe8\x5e\x46\x28\xb5\x5a\x13\x7f\x0b\xd6\x76\x6e\x9c\x4a\xb1\xfa\x25\x4b\x7f\x33\xdb\x11\x0a\x08\x07\xa3\x5e\xb9\x20\x01\xe0\x2b\x4d\x62\x38\x11\x4a\x4c\x64\xe8\x85\xad\x7f\x78\xce\xc0\xb9\x62\xd1\x15\x63\x42\xf1\xa2\x40\xf3\x5e\x1d\xfd\x79\x52\x77\x38\xda\xe8\xe5\xf0\x52\x86\x90\xd3\xc2\xaf\x35\xa3\xfd\xe6\x83\xb6\xcf\xd9\xd9\x0d\x57\xdb\x25\x6e\x5c\xd3\xbf\x9c\xcb\x6a\x27\x8b\x5d\xd3\xcd\x6a\x4f\x27\x0d\x74\x44\x0c\xbc\x7e\x8f\x97\x68\x55\x2b\xb0\xd6\xba\x17\x24\xf0\xbc\xb4\x17\xc1\x1c\x7f\xec\x3e\xe6\x2c\x95\x44\x98\x95\xb0\x76\xdf\x82\xdd\x6c\xa9\xd2\x42\xac\xd7\xed\x2f\x13\xa2\x84\x57\xfe\xdd\x0e\xba\x45\x59\x58\xcd\xfd\x87\x71\x33\x14\x8c\x35\x3d\xdd\x52\x0d\xf4\x95\x65\xeb\xf6\xfb\xa6\x49\x5c\xb4\xe0\xaa\xcd\x45\x17\xa0\x97\xd5\x38\x33\xe5\xb9\x6a\x82\x3f\x35\x39\xf7\x00\xa4\x5f\x0e\xd2\x76\xfa\xdf\x67\x54\x02\xc9\xaf\x9c\x87\x01\xfb\xc1\x59\x24\xfe\x94\x73\x19\xec\x03\xe2\x99\x26\xb7\x9f\xd3\x0b\x92\xcc\x52\x6f\xce\xa8\x7c\x6b\xed\xbf\xe4\x22\x79\x05\x5a\xfa\x42\x3e\xf1\x29\x03\x04\x56\x6e\xe1\xb1\x49\x29\xbf\xfe\x24\xd4\x62\x56\x28\x70\xbe\x2e\xd4\xde\x58\xc2\xc8\x34\x55\x76\x3d\xe4\x84\xd0\x30\xf2\x9c\xb1\x32\x5c\xcf\x6b\x22\x3c\x4b\xbf\xa0\xf0\xf5\x9f\xb3\x6e\xad\xf0\x34\x6a\x88\xef\x1f\xe2\x13\x6e\xfc\x32\x30\xda\x0d\x2c\xda\x74\x54\x4c\x84\xc4\xaa\x05\x8b\x04\x4c\x96\xea\xf9\xb1\x99\x31\xa6\x0b\x3a\xe8\x4f\x1d\xd8\x36\xd5\x21\xae\xd1\x8b\xda\x22\x8a\xf5\xaf\x76\x4a\x6b\x8a\x09\x8d\x92\x06\x6c\xe0\x7a\x7e\x1b\x68\xb4\xac\xc9\xa8\xc7\x0b\xd4\x5b\x8c\xb0\x4a\x32\xf2\x9c\x37\x2c\xb6\xfa\x88\xc7\x8e\xe0\x19\x26\x5a\xf8\x5a\xb9\x6e\xb1\xb6\x71\x82\x76\x95\xe0\x0d\xbc\xaa\x73\x48\xd3\x59\xf7\xb2\xf9\xac\x55\xb7\x57\x36\x8f\xa2\xf3\x8d\xbc\x97\x3f\x4a\xf7\xdb\x35\x57\x3c\xf1\x99\xb5\xab\x68\x9d\x0d\xb8\x7a\x3a\x82\xd1\xff\x57\x64\xc0\x27\xf6\xda\x60\x5e\x8a\x26\x7a\x87\x3b\x2a\x9f\xd1\xfd\x97\xd4\xc4\x8c\x4d\xd6\x50\x1d\xc1\x19\x38\xa5\x26\x1c\x4a\xba\x4b\x04\x17\xfd\x4f\x26\x30\x7a\x7b\xad\xe7\x0b\x34\x07\x54\xe5\x98\xe9\x9d\xde\x6b\xb6\x24\x23\x1f\xdb\xce\x10\x92\x24\xcb\x39\xff\x27\x4d\x4f\x8e\x0a\xba\xd3\xbb\xb1\xc0\x83\x48\x44\x20\xbf\x3e\x27\xea\x66\x3e\x6e\xcf\xbc\xe7\xe5\x94\x61\xcd\x8e\x03\xec\xe4\xda\xf5\x8b\x43\x8f\xde\xc6\x46\xba\x1b\x65\x52\xeb\x57\x3f\x2f\xe6\xa3\x6b\x60\xd6\x5d\x88\xdf\x69\xe7\x06\x60\xbe\x93\xa1\xf0\xa7\x5f\x57\x7e\x10\x80\x9c\xfd\x17\x0b\xb1\xa7\x23\xe4\xb1\xe6\xe3\x49\xc5\xdd\x94\x8b\x82\xee\x7b\x59\x37\xbb\x36\x6e\x5b\x8d\xc3\x68\xda\xb6\x43\x12\x4f\x75\xff\x8e\x1a\x6a\x56\x62\x91\x67\x47\x8d\xdd\xbb\xdd\x9d\xe1\x7a\xe1\x19\xd4\xbb\x86\xcf\xca\x7e\xcd\xf7\x54\xa9\x40\x24\x21\x66\x98\xd1\xe9\x99\xb2\xb8\x06\x81\x89\xd1\x4e\x06\x68\x63\xb6\x5b\x30\xde\xc7\xaa\xd8\x39\xd4\x04\x77\x9c\x44\x5a\xa0\x92\xa1\x40\x12\x9a\x81\xb6\x0a\xc7\xf3\x1e\xf5\x03\x59\x1f\x5e\xc5\x9f\xab\xb6\x3c\xa9\xb2\x0b\xf9\x80\x7d\x19\xce\x08\x12\x9d\xc4\x2d\xb2\x74\x7d\x69\xbb\xd9\x73\x8a\x7e\xef\xe9\xfa\x8d\x7c\x27\x48\xe6\x20\xa4\xfb\x28\xbc\x9d\xd0\xbb\xfc\xe2\x9d\xb4\x44\xb3\xf7\x08\xb8\x30\x5f\xd8\x9b\x66\x51\x7b\x5b\x23\xcf\xd2\x87\x49\x3e\x46\x77\x58\xd9\x30\x8b\x59\xed\xeb\x37\x51\x31\x47\x91\xc3\x8e\x5b\x72\x2f\x23\x97\x55\x71\x33\x23\x88\xde\xea\x98\x3b\x7c\xca\xc5\xd2\x9a\x9f\x7f\xbe\x80\xbf\x0f\x53\x3d\xc1\x51\xab\x8a\xb9\x5c\xbc\x93\xc5\xc2\x12\x8c\xf3\xdd\x8c\x56\x76\xbb\x85\xa8\xc1\xf2\xa6\x75\xca\x2b\xbf\x80\xe7\xe2\x89\xbc\x82\x2c\x51\x25\xe3\xd0\xdd\x76\x3c\x6e\xf0\x16\x7c\x93\xf7\xfd\xb8\x5f\xe0\x27\x34\x74\x52\x29\xfe\x34\x31\x2d\xc0\x0e\x2f\x1a\x66\xed\xfe\xea\x5a\xd9\x24\xc9\x4e\xab\xda\xb0\x9b\x91\x0c\x34\x17\x47\x6d\x22\xef\xcf\x7c\xd4\x0b\x44\xe3\x1e\x92\xb5\x45\x96\x66\xea\xf2\x57\xd9\xff\xc8\xef\x30\x67\xe1\x7b\xe8\xf4\xab\xe7\x76\x17\x5d\xc6\x2f\x80\x3b\xb4\x8a\x65\x03\xfd\x32\xe1\x23\x27\xf5\xae\xe6\xb3\x34\xfb\x8c\xcd\x3a\x71\xe4\xfd\xb9\xd4\x06\x5e\x7e\x5e\xa9\x6f\x1d\x61\xb0\x73\x58\x86\x69\x98\x4a\x4b\x8c\x29\x53\x32\xd8\xf3\x1f\xcc\x84\x3c\x0e\x71\x73\x14\x1d\xe8\x8f\x76\xf2\xc7\xd0\x0f\xde\x22\x33\x43\x71\xc3\x15\x4f\x85\x02\x4f\x84\x0c\x3b\x52\x3e\xe4\x69\x2e\x54\x66\x23\xa5\x05\xe4\x7b\x4e\x8a\x80\x67\xc2\xf1\xa7\x9d\xaf\xbe\xf2\xdf\x80\x16\x15\xa7\x2e\xe9\x5d\xb9\x56\x7e\xdd\xda\xc3\xf6\xbb\xfb\x3c\x4e\xd0\x54\xa2\xf3\x72\xe1\xc1\x8c\x18\x4d\x74\xd9\x52\xa0\x1d\xb9\x74\x9a\x76\x0b\x7f\x23\xd9\xab\xc4\xea\x27\x42\xc8\xaa\x8d\x77\x50\x59\x81\x61\xa0\xc2\x0a\x97\x9c\x81\x5e\xda\xcf\x22\xe1\xa4\xd4\xf5\xd0\xe9\xba\x42\xa4\x9a\xb2\xd5\xe5\x36\xda\x18\x4e\xd4\xae\x74\xbe\xf7\xee\x31\x2d\x9b\x2a\xa7\x4a\x29\xf5\x24\x15\x9a\x73\x1c\x32\x73\x63\x14\xc2\xcb\x8b\x66\xbb\x1a\x13\xa6\x5b\x9d\x73\x1b\xb1\x32\x68\xe1\xec\x4c\xaa\x29\xaa\x7d\xd2\xcf\x7b\xbb\x45\xe7\x8a\x40\x15\x3e\x39\x12\x2f\xeb\xde\x2c\x50\x25\x83\xb8\x2c\xf4\xa2\x55\xd7\xcf\xdc\xc2\x57\x60\xfe\x19\xeb\x4f\xd2\x00\xd4\x06\x1d\x40\x67\xf3\x0a\xf0\x86\xa3\x19\xb1\x44\xcc\xcc\xd7\x7f\xfc\x0f\x91\x11\x95\x71\x5b\x5a\x18\xe9\x74\x97\xb1\x0c\xe9\x10\xc9\x1f\x1d\x7b\xb2\x64\xaa\xfb\x4c\xf5\x75\xfa\xf9\xfe\x2a\x3c\x44\x2d\xdc\x53\x63\x3b\xf9\xa3\xf3\xd7\xb1\xd1\xfe\xb9\xaf\xce\xa6\x2d\xf4\xf6\x39\x41\x42\x6c\xd3\xa3\xb1\xd8\xea\x13\x96\x14\xe6\xe0\x15\x17\x4f\xef\xe9\xb8\x17\x09\xdd\xe1\x1e\xea\xfa\xf0\xd7\x81\x47\x05\x84\xca\xb3\x40\xef\xd8\x60\xd6\xaa\xd3\xba\xad\xb5\x56\x95\xd8\xa5\x0f\xe8\x6b\xd0\x23\xb5\xba\x49\xb4\x4d\x98\x62\xdb\xa0\x53\xf0\xfd\x89\x67\xa1\x8c\xd3\x3d\x62\x40\xde\x5f\xcf\xda\x8d\xb5\x6a\xe6\xee\x6c\xb2\x42\xd3\xb5\x61\x16\x5e\x9c\x9f\x7e\x5b\x0e\xb9\x6f\x0d\x19\x51\x72\x32\xde\x13\x44\x3b\x06\x34\x63\x56\xbe\xf0\xe2\x2e\x3a\x3a\x3a\x52\x2f\xad\x12\xaf\xae\x0d\x16\xca\xf5\xe2\x73\xe9\x38\x1d\x6b\xca\xaa\x0d\x29\xf4\xc0\x73\x8b\xd5\x2e\x52\xb6\xa0\x46\x43\xb9\x8a\xb5\x93\xc7\xe2\x7d\x47\x0d\x3e\xf3\xf1\xe9\xff\x74\x0a\xc2\x63\x88\xd4\x6f\xd2\xd2\x99\x67\xac\xf4\x06\xea\x90\x69\xe2\x0e\xa2\xab\x96\x11\x7b\xe7\x33\x36\xe0\xd2\x81\xba\xa9\x71\x32\x4b\xcb\x41\xd1\x4f\xc9\x89\xab\xa9\x7b\xff\xe2\x7d\x4a\x4d\x06\x12\xd5\xd0\x0f\x36\x2b\x3a\x72\x71\x51\x0b\x55\x9c\xbf\x84\x82\x8f\x3b\xc5\x56\x28\x1d\x1f\xb8\xbd\xed\xd5\xf3\xfc\xa6\xf9\xaf\x3c\xc3\x45\xd6\x96\xd2\x07\xaa\x6b\xab\xe7\x60\xd4\x7a\xc8\xb0\xa8\x7b\x1a\xd6\x05\x44\x0c\xa5\x80\x5c\xb2\x94\xbc\xe2\x75\x51\x5e\x59\x07\x26\x4c\xd0\x53\xbb\x51\x6b\x22\xe5\x01\x70\xf3\xe9\xad\xd8\x5f\x31\xef\x96\xa4\x62\x24\x13\xd8\xcf\x8f\xd2\x35\x54\x25\x2e\x57\x29\x66\xed\x3c\x68\x3a\xaa\x29\x15\x08\x65\x4a\x11\x79\xd9\xf7\x8e\x23\x48\xc4\xb3\xea\xe7\x88\x07\xbf\x13\xe4\x44\x13\x35\xed\xa2\x31\xba\x1a\x98\x98\x82\x87\x1f\xee\x84\x6e\x9c\x16\x27\x58\x99\x5d\x9b\xb5\x40\xc6\x4e\xc7\x39\xea\x0f\x54\x4d\x56\xa3\xe5\x30\x3d\xaa\x1d\xac\x5b\x3f\x48\xa0\x89\x55\xd3\x6e\x69\x5b\x89\xd7\x0d\xf3\xd5\x2e\x8c\xbd\x2a\x81\x66\x1f\xed\x00\x93\x08\x1f\x8b\x79\x25\xcb\x04\x99\x22\x40\x79\x26\x9a\x93\xf5\xaa\x31\x3a\x65\x28\x9c\x7d\x88\xc7\xa5\xbb\x62\x7e\x0e\x71\xbd\x97\xf2\xb1\x9c\x3e\x51\xb8\x86\x69\xa7\x1e\x7f\xdf\xa1\x39\xb3\x9a\xe5\x6d\x76\x34\xe0\xc6\xdf\xed\xcc\xa5\x8e\xb1\x16\x10\x70\x9f\x3d\xfc\x36\x78\x17\x88\x4f\xba\xbe\x12\xe8\x54\x8b\x8a\xe7\x40\x41\xbd\x66\x6d\x0b\xbc\xd8\x6c\x77\xde\xb7\xb9\x22\x15\x7b\x78\x5f\x41\xa4\xbe\x09\x9c\x5e\x77\x67\x84\x1b\x80\xdc\x40\xf5\x6c\x85\xf1\xb1\x90\xd8\x86\x4a\x0c\x4b\xe7\xa3\x21\xca\x83\x55\x0d\x55\xf1\xda\x15\xe6\x92\x16\xc7\x6e\x41\x2e\x62\x4b\x1b\x45\xa4\x1e\x32\x4d\x20\x4a\x4d\x8b\x48\x55\x64\x2d\x9e\x03\xca\x1e\xb3\x1a\xdb\x1d\xe7\x9f\x90\x1b\xfd\xf3\xc1\x39\x3f\x0e\x29\x3c\x41\xe5\xe6\xa5\x6a\x00\xef\x4d\x96\xad\xb1\x65\x1a\xa1\xdb\xb0\xb5\xd2\x33\x18\x26\x9a\xca\xa5\xe0\xc6\xac\x5d\x9e\x62\x78\xcf\x13\xa6\xa2\x85\x25\xca\x3b\xfb\xc8\x60\x19\x82\xc5\x61\xb6\x76\x50\xf9\x0b\x79\xa2\x02\xd6\x49\xa5\x98\x7f\xce\x4a\x92\x6c\xf3\x20\x88\x83\x22\x65\xd1\x38\xbd\x86\xa1\x2c\x58\xe0\xbb\x94\x44\x83\x72\x9b\x38\x54\x95\xc4\xc3\xcc\x6c\xfc\xb3\xca\x47\xe4\xc9\x44\x3c\x9f\x38\x87\xf4\x8c\xdf\x93\x78\xea\xf9\xed\xd5\xb6\xc7\x67\x9c\x9a\xd6\xb7\x7b\x1f\xe2\xce\xdb\xb3\x9e\x67\xc1\xff\xc5\xc5\x2f\x4d\x7f\x47\x53\xd7\xb3\xad\xb3\xca\x93\xc3\x93\x15\x56\x84\xcc\x41\xb1\x1b\x94\x4e\x42\xab\x5c\xa3\x9c\x27\xd7\xfd\x84\x84\xe0\x92\x92\xf2\xa2\x98\x13\x02\x67\xbd\x64\xd1\xe3\x08\xf7\x76\xd4\xce\x51\x20\xb2\x23\xa3\xa1\x81\x21\xc5\x39\x92\x21\xa1\x5c\xb4\xe3\x74\xca\x02\x69\x05\xe7\xad\x46\x99\xef\x27\xee\x11\xac\x13\x3f\x91\xa8\x53\x76\x4d\xcb\x99\x98\x23\x41\x5c\x7a\xfe\xde\x2e\x1f\x4e\xe4\xc6\x00\xb6\x69\xab\x3f\xc5\x2e\xab\xce\x12\x5c\xaa\x58\xa7\xed\x38\x01\x12\xe8\x8e\x57\x51\x29\xd5\x6a\x6e\xd5\xff\xc4\xc9\x56\xa8\x7f\xde\xc4\x98\x5a\xbe\xf8\x72\x9c\x64\xce\xb9\x25\xbd\x75\xd8\x2b\x7a\x34\x53\x6f\xde\xd9\x72\xa1\xa8\x03\xc4\x61\x0a\x68\xf8\x50\xae\x4b\x6b\x17\x4e\xad\xfb\x44\xd1\xdd\x7e\xb8\xdc\xc8\xb3\xf5\x74\xd2\x8e\x13\xfe\x8e\xcd\x79\x90\x1f\xe5\x1c\x29\xdd\x54\x5f\xa1\xf0\x8b\xd1\x57\xce\xdc\xd1\x9c\xb9\xf3\xc8\x55\xf3\x46\xe1\x38\x21\x8d\xb1\xfe\xcf\xfb\x4c\xb1\x95\x2f\x33\x6a\x53\x4d\xdb\xf2\xbf\x15\x00\xf2\xc8\x5e\xb1\xdf\x3a\xdf\xf7\x97\xc7\x58\xf6\xbe\x2b\xf2\xb6\x7e\x6f\xba\x5c\x4f\xa3\x23\x85\x83\x49\x77\xdd\xb0\x0f\x3b\x1d\x04\x18\x7b\x45\x47\x67\xe1\xb5\x16\x0f\xe5\xd2\x2b\xfc\xf6\x97\x0f\x07\xbf\xae\x31\x2f\x73\x5a\xb3\x3e\x61\xd0\xfa\xa1\x02\x48\xee\x22\xce\xdc\x50\x97\xcf\xa8\xd3\x67\x75\x94\x35\x6e\x77\xfd\xe6\x7e\x4b\xb5\x7f\x0f\xe1\x71\x2b\xb0\x72\xa2\x1f\x9c\xf7\xcd\x16\x30\xbd\xf5\xf8\xd4\x43\x31\x34\x88\x6c\x69\xac\x32\xd1\xa8\x97\x55\x6a\x49\xa3\x51\xac\x5f\xb0\x16\x53\xf6\xca\x38\x13\xf2\x66\x42\x4d\x8e\xf1\xcd\x98\x73\x40\xc8\x87\x7f\x23\x3e\x1f\x1b\x6c\x1d\xb3\xf2\x43\x62\x1f\x69\x11\x5a\x5c\xe8\x3d\xec\x27\xc9\x67\x93\x3e\x5e\xed\xef\x0c\xb4\x70\x23\x04\xd9\x07\xcb\x68\xd3\x59\x4d\x26\xf8\xd8\xa7\x11\x39\xca\x1d\x4c\x4c\x77\xac\xc1\x7c\x3f\x18\x12\xac\x79\x23\x0f\x14\x59\x2f\x87\xa4\x34\x8a\x2c\xcf\x52\x8d\x08\x63\x22\x68\x2d\x46\xc7\xbe\x34\x96\x8b\x98\x76\x37\x75\x47\xa2\xbb\xa8\xd7\xe7\x5e\xba\xb2\x10\x2b\xf9\x45\x14\xeb\x15\xec\x05\x1c\xf8\x99\x24\xd8\x49\xaa\x13\x43\x88\x0d\xc5\x3b\x1d\x38\x82\x4b\xd8\x12\x55\xdd\x4f\xf3\x58\x2b\x2a\x9c\xe8\x37\x52\x03\x6b\x5c\x23\x4c\xa4\xa1\x88\x27\xac\x53\x08\x06\xcb\x46\x61\xd9\x51\x82\xf7\x46\x92\x28\x11\xd1\x37\x7b\x57\x00\xa8\xe1\xa6\x71\xfd\x71\xd0\xc0\xfd\x45\xfc\xcd\x62\x73\x66\x01\x24\x10\x62\xa0\x89\x15\xae\x0a\x7d\xbf\x98\x41\x56\x5d\x7c\xf5\xd3\xa4\x8b\xd1\x2e\xf3\xf9\xec\xd9\xdb\xf2\xae\xf3\x12\xc3\x07\x4d\x6b\xc3\x81\xfb\x73\x8d\x11\x49\x32\x91\x96\x9f\x64\xf2\x25\x88\x57\xdb\xc6\xf6\xdf\x43\x89\xfd\xf7\x1b\x37\x3e\x21\x7a\x58\x79\xbf\x71\xa6\x51\xeb\x28\x9c\xf3\xa2\x70\x09\x5b\xab\xeb\x1f\xec\xce\xf3\x41\x5e\x08\x64\x47\xa2\xf1\xe0\x4b\xda\x34\x95\xae\x70\x05\x6b\x78\x26\xdd\xa4\xdf\xda\x62\xac\x01\x37\xb1\x7f\xae\x7b\x5a\x14\xf6\xf3\x9b\xac\xaa\xfd\xa6\x1d\xed\xeb\xbd\x7a\xb0\x51\x2b\x81\x1b\xaf\xe4\x71\x77\x90\x9d\xc6\x5e\xb7\x02\x0f\x0f\xbb\xa8\xb2\x7e\xef\xf2\xfd\x6b\x82\xbf\x9a\xc2\xda\xaf\x8e\x6b\xe3\x9f\x3a\x69\x64\x7f\x0c\x22\x33\xbd\xcb\x00\x56\x37\x0d\xb9\xe3\x2f\x38\xf5\xdb\x80\xbf\xbd\xe9\xd7\xc4\xee\xaa\xb7\x18\x62\x22\x08\xf8\x8e\x59\x4a\x5d\x26\x44\xc6\xe4\x69\x3f\xd8\x28\x07\xf2\x4f\xab\x02\x4e\xa0\xf8\xab\xa0\xb9\xd0\xa1\x43\xfd\x7c\x2f\x75\x0a\x2f\x92\x0e\x24\xe9\x98\xb4\x9a\x8f\x40\xa8\x73\xb5\x08\x89\xf7\x96\xa9\x72\x2d\xcf\x06\x8a\x48\x27\x16\x83\x8d\x66\xb8\x74\x8a\x70\xa7\xc5\x76\xa2\x39\xbb\x06\x8a\x47\xc7\xdd\xf7\xe5\x24\x4a\x01\xba\xc5\xf9\x14\xfc\xa5\x42\xf4\x2e\x86\xb5\xc0\xc3\x19\x77\x4e\x58\xc0\x5a\x82\x4e\xd4\x05\xd6\x5a\x75\x29\x62\xee\xfb\x16\x59\x71\x90\x3b\x5e\x9d\x24\x57\x34\x19\xef\xe7\xc1\xc0\x7c\x5d\xca\x62\x3d\x92\x99\x21\x85\xd1\x2e\xb1\x94\x68\xfb\xc9\x51\xf2\x02\x11\x72\xce\x91\xdd\x11\x13\x44\x96\x4d\x1f\x42\xb0\x26\xd3\x71\xec\xfa\xfe\xfd\xac\x8b\x6e\xa8\x6c\xad\x86\xd3\x77\x79\x13\xec\x0a\x6d\x63\xda\x36\x78\xbe\x05\xf2\x06\x12\x74\x0e\x95\xbf\xad\xf8\x23\x81\x50\xc8\x6d\x36\x1e\x7b\x45\xaf\x9e\xa7\xa7\x45\xfd\x49\x34\x70\x5c\xe6\x47\xb6\x21\xfb\x7e\x29\x0f\x60\x0f\x03\x6f\x5c\x61\x59\x3b\x0f\x25\x92\x9f\x7f\x32\xd0\xee\x7b\x4f\xf6\x60\xa0\xc7\x5b\xe1\x34\xfa\x09\x3e\x8e\x59\x79\xd5\x53\x1b\xf1\xf0\x60\x14\xfb\x47\x77\xc0\xa7\x94\x35\xc8\x2a\x23\x61\x7f\x51\x9d\xb8\x54\xc8\x4e\x84\x89\x89\xf0\xf7\x5c\xba\x49\x54\x06\x26\x6b\xb4\xae\xbe\x65\x8d\x5b\xab\xc9\x1a\x8e\xd5\x2e\x79\xb3\x75\x7b\xbd\xfb\x9f\xa6\x60\xbf\xaa\xf6\x19\x9b\x30\x46\xcf\xe9\x77\x84\x8e\x18\xd9\x7c\xe8\x60\xc8\x14\x96\xe5\xac\xe8\xf7\x9b\x7c\xe9\x9b\xc4\x42\x8e\x64\xab\x24\xef\x42\xcc\x29\xe6\xa4\x0e\x40\xfe\x47\x3b\x68\x7b\xf1\x78\x1e\x37\xbc\x45\x08\x70\x6a\x37\xfb\x5e\xed\x2c\x66\x4b\xa5\xba\x4d\xaf\x8b\xae\x62\x21\x2e\x0c\xcc\xe1\xf7\xfb\xb5\x61\xb3\x59\x1a\x95\xd7\xfc\x49\x96\xb6\x93\x17\x7b\x6b\xdf\x5d\x77\x9f\x84\x9b\xc2\x89\xe8\xb5\x6a\x90\xd7\x2f\x60\x94\x31\x18\xb2\x9d\xf2\x1b\x94\xb0\x9e\x04\xd2\x28\xb1\x11\xa2\xd4\x28\x62\x86\x76\x3b\x7a\xe5\x90\x1b\x67\xf3\x89\x35\x08\x82\xe0\x32\x10\x22\x63\x86\x9f\xde\xcc\x33\x90\x26\xf3\xcc\x5c\xd9\x01\xc6\xee\xb5\x58\x87\x38\x2e\x19\x67\x52\xb8\x5a\x96\x6c\x9b\xd4\x1d\x71\x24\xf5\x99\xb5\xc2\x6c\x00\x8f\x48\xd2\x00\xdc\x40\x25\x22\x83\xa5\x8b\x18\x00\x86\xab\x52\xd6\xb3\x38\x6c\xa3\xa1\x55\x4d\xcb\xfd\xf1\xd0\x89\x57\xdb\x09\xe3\x3e\xdb\x25\x27\xaa\xdb\x85\x82\x39\x29\xf8\xeb\x97\x78\x72\xf5\xa9\xf1\x3e\xe1\xd4\xda\x38\xb4\x3c\xd1\x80\x7f\x0c\x96\x3f\x1a\xf2\xa2\x8b\x33\x28\x02\x5f\xae\x3d\xc1\x9f\x1f\xc7\xf1\x0f\x19\x18\xf3\x64\x8b\x85\x78\x75\x95\x0a\x19\x82\x70\x77\xb1\xf9\x2e\xee\xd4\x18\x32\x04\xe7\x96\xd3\x7f\xce\xae\xa5\x91\x58\xa2\x49\x1c\x06\x1d\xe2\x2e\xc9\x53\xca\x2d\x07\x5c\xf4\xd5\x4e\x19\x5f\xef\x76\x74\x10\x79\x79\xfd\xde\x2e\x27\x6b\x30\x97\x95\x5c\x11\xe2\x96\x7a\x2a\x1f\x68\x5f\x4f\xbb\x96\x96\x7a\xde\x65\xe0\x86\x35\x19\x77\xa8\xd5\xee\xb4\xcc\xe0\xe5\x23\x8e\x08\x51\x0e\x7e\x48\x08\x8e\xdc\xce\xfe\xf2\xc1\x2e\x0f\xbd\xa7\xa4\x48\x19\xbf\xab\xd7\x4f\xb4\xa7\xff\x51\x5b\xb3\x37\xf4\x4c\x75\xbf\x44\x1a\xc6\xfe\x13\x8b\x75\x49\x67\x80\xcb\xb4\x37\xbf\xe1\x55\x16\x3a\x18\x32\x4c\xb5\xf2\x66\x89\x85\x27\xf1\x90\x51\x90\x31\x9f\xf4\xba\xf8\x4a\xd3\xa4\x53\x84\x41\x9f\x37\x96\x34\xb5\x7a\x0e\x32\xd7\xd3\xda\xd6\xee\x9b\x80\x8c\x40\x92\x89\x71\x96\x31\x6b\xab\x78\xb5\x2c\xe6\xd8\xa9\x08\xb9\x66\x2e\x68\xef\xe0\xd7\xd4\x89\xc2\x23\x3b\x76\x91\xde\xfb\xf4\x48\x09\xa4\xe5\xa4\x59\xa3\xb8\x3f\x5d\x1a\x9b\xa2\xcf\xd0\xdb\x12\x45\x22\x9f\xfd\xbd\x79\xf7\x5b\x07\x2d\x27\xc3\x13\x93\x06\x4e\xad\xea\xbd\xd2\x2b\x3a\xe5\x64\xf2\x34\x31\x8e\x66\xc8\x1f\x8e\x51\x24\xfe\x23\x5f\xc3\x98\x9a\xd4\x11\xbe\xe5\xb6\xea\x06\x69\x5e\x93\x97\x5f\xd8\x82\xd4\x2a\x9f\xfd\x7a\xdd\x01\xac\x24\x89\xa2\x7a\xc6\x37\x0c\x90\xcb\xf5\x10\xbf\x8b\x1d\xd4\xe1\x89\x94\x7a\x59\x0a\xd1\xcd\xe3\x90\xad\xd6\x6a\xba\xd7\x9c\x39\x7c\x87\x45\x38\x4f\x4d\x6b\xa7\x1d\x7f\xdd\xee\x43\x77\xd2\xc5\xef\x0b\xb1\x07\xf6\x9b\xd6\xec\xcf\x36\xd6\x2f\xf8\xda\x31\x19\x7f\xff\x1b\x95\x26\x94\x85\xe6\x65\x04\x64\x00\x1d\xd5\x09\xc0\x4a\x4d\xda\xeb\x8c\x4d\x9e\x57\x1a\x19\x8b\xe3\x7f\x52\x83\x3c\x6c\x94\x6b\xc4\x4a\x61\xa5\x0a\x2f\x9d\xbd\x01\xd3\x2f\xf4\x38\x6a\xb4\x15\x89\x06\x4e\x1c\xf7\xd8\x88\x2d\xd3\x6b\xe3\xa4\xac\x57\xd9\xa0\x81\xa5\xb1\xcc\x74\x96\xf2\xe6\xd3\xca\x80\xe4\x85\xc5\x19\xba\x37\x98\xc4\x6d\xd6\xa9\xb8\xee\x90\x53\x47\xc0\x74\x72\xbf\x1f\x8d\xb7\x5e\x07\xf0\xd0\x8a\xdc\x89\x2b\x1f\x60\x85\xaa\x4d\x1e\x46\x8c\xcf\x2c\xd8\x5f\xfd\xd9\x6a\x6e\xdd\x52\x76\x7a\x5c\x15\x32\xee\x4e\x51\x4b\x3a\x2c\x76\xa2\xe4\x18\xfb\xa0\xca\x0d\x42\xf7\x74\xe6\xe9\x13\x88\xed\xa3\x92\xdc\xcd\xdf\x86\xfe\xbe\x9d\x47\x28\xb0\xc9\x84\xdc\x9e\x6e\x7d\x63\x4a\xb4\xe8\x7b\xa9\xd3\xa8\x4b\xbb\x49\xee\x00\xc5\x1a\x60\xa5\xf6\x0d\x66\x5a\xb6\x38\x20\x22\x94\x03\xda\x4f\x14\xee\xd4\x1e\xe5\x32\x58\x78\x3d\xa6\x32\xb4\xe6\x66\xfc\xde\x5d\xb9\xc3\x0c\x3a\x54\x49\xa9\x67\x3c\xe9\x90\xc3\xe3\x86\xe0\xac\x5f\xa3\x98\xd4\x22\xf2\x59\x90\x44\x44\xae\xa6\x68\xa3\xf4\xea\x18\xf4\x98\x06\x0f\x34\xdd\x94\x25\x8b\x11\x90\xa0\x12\x68\x83\xb2\x10\x1f\x8a\xc4\xd3\x57\xb9\x83\x51\x68\xc1\x1b\xb3\x77\xb6\xe0\x77\x90\x7f\x10\x46\xad\x22\xfc\x24\x07\xac\xbf\xa1\xdb\xb2\xa4\xd7\xe1\x3c\xfd\xe0\x1d\xcf\xaf\x3d\x7e\xba\x21\xd4\x2e\x0b\x79\x44\xa4\xe4\x38\xe3\x8f\x69\xa5\x36\x27\x8d\x19\xd8\x7c\xfb\xae\xbc\xc1\x41\x2b\x3a\xd9\x7f\x98\x63\x4d\x4f\x73\x86\xe0\x82\x49\xec\x34\x02\xc8\xd2\x2a\x05\xa2\x03\xef\x3f\xa3\x72\x78\x1d\x0e\xe1\x1a\x01\x65\xd0\x2d\x24\x31\xc7\xeb\xc1\x2e\x11\x32\x90\x20\x52\x93\x47\xb1\xcb\x61\xdd\xc3\xfb\x25\xa7\x97\xd6\x54\x01\xbf\x61\xe1\x5a\x93\xd3\x84\x6d\x41\x46\x6f\xc0\x09\x08\x12\xc0\x80\x91\x8f\x77\xdc\x97\xc5\xfc\xec\xa2\xae\x9b\x3f\xe9\xa6\x56\x4d\xc7\xc7\x65\x61\x2d\xf1\x0c\x27\x75\xbf\x94\x26\x13\xc1\x2c\x48\x1f\x90\xcf\x27\x48\xf0\x3d\xea\x8e\xfb\xe5\xa9\x1a\xa0\xee\xea\x8c\xd6\x18\x0b\xf6\xc0\x2c\x48\xc0\xe2\x48\x20\xee\x84\x6e\x6d\x13\x1d\x41\xf7\xbc\xf8\xe6\x7c\x31\x70\x46\x33\xb3\x08\x20\x10\x08\x09\xd2\xa0\x86\x84\x96\xa6\x21\x4b\xd7\xad\xd1\x7b\x7d\xfa\x56\x55\x83\x1b\xaf\x79\x57\x6d\x76\xdf\xa3\x52\x5c\xcd\xdc\x28\x9f\xe8\x9a\xe9\x9a\x37\xff\x3a\xb9\x91\x23\x26\x69\x82\xb1\xeb\x48\x93\x3c\xd8\x8c\xee\x30\xd9\xab\x42\x31\x19\x45\xfb\x0c\xa7\x8b\x30\x58\xa0\x28\x93\x32\x9e\xc2\x01\xe1\x26\x03\x1f\x9e\x44\x9f\x97\x64\x24\xdf\xc7\xb3\x77\x56\x99\x69\xbc\x97\x8e\xba\x6b\x51\xa0\xa2\xc3\x76\xa2\x8b\x1c\xff\xd4\xaf\xc4\x94\x35\x42\x05\x28\x68\xf6\x9a\xd5\xb7\x8b\xd5\xc1\xaa\x0e\xa4\xb4\x33\xd0\xa2\xf8\xa3\xdc\xa8\x21\x88\x74\x0e\xa5\x97\x31\x56\x56\x73\x5f\x0c\x00\xc9\x7a\xe5\x2a\x7f\x51\xff\x7c\xa3\xde\x73\x87\xde\xc6\xbd\x60\x3e\x85\x79\xe6\xab\xb9\xc2\x4a\x04\xe3\xc0\xcc\x17\x5e\x19\xda\xfc\x54\x59\x02\xd2\x3f\x66\x33\x6c\x6c\xb7\xc2\x58\xc6\x40\x82\x76\x56\xe1\x83\xd0\x9d\x54\xa0\x73\x24\x70\xc7\xfa\x3b\xcd\x4c\x02\x72\xb0\xb5\xc0\x33\x04\x6e\xdc\xf6\x6e\x80\xbf\x5c\x2b\x2a\xd2\x12\xfd\x8c\xec\x88\xfc\x8b\xc4\x80\xdf\x83\xb7\xd9\xc6\x1d\xe4\xa6\xad\x9f\xea\x69\x92\x85\x01\x15\xaa\x3b\xbe\x8e\xef\x51\xd3\xb1\xc0\xd8\xb7\xa3\x46\xf2\x80\x3e\x4a\x6f\xae\x92\xc0\x94\xb9\xb7\x2c\x64\x16\x4d\x83\x54\x27\xaf\x57\xd4\x25\x42\xbd\x23\x7c\x56\x5d\x3e\xc9\xd4\x9c\x9d\x12\x72\xe7\x69\xe0\x9a\x54\x13\xb5\x8f\x78\xe7\x4e\x60\x62\x03\x6b\xa7\xf7\x81\x8a\x3b\xd2\x7e\xd5\x8a\x3a\xff\xe6\xcf\xb7\x2f\x3c\x57\x6b\x0f\x0d\xec\xab\x3c\xd6\x0b\xb9\xa0\xa8\x5f\xb1\xab\x86\xc9\xd9\xc5\x03\x79\xfe\xf2\xfe\x03\xaf\x7a\xbf\xa2\x05\x79\x6d\x00\x8b\xde\x0b\x3e\x1b\x0e\x19\x26\xe7\x9b\xef\x0c\x35\x2b\xe2\xb2\x2b\x32\x15\xab\x4b\x13\xef\xff\x63\xb9\x03\xec\x87\x85\xcf\xbe\x43\x1e\xd7\xef\xd5\x8c\x48\xd8\xd9\x37\x2c\x7f\xf2\xd1\xc3\x78\xce\xb9\xa0\x8e\x88\x1e\xfa\xf7\x7f\x7d\xf5\x53\x0f\x25\x48\xf2\xe0\xdd\x93\x8e\xb0\xcf\xca\xc4\x24\x6c\x89\x1d\x9c\xd6\xc3\xe9\x8d\xfb\x6c\x69\xf6\x63\xa7\x86\x84\x1b\xf3\x88\x08\xdd\xfe\x87\x8c\x46\x54\x0e\x09\xfb\xa6\x33\xf1\x2d\xe2\xbd\x66\x24\xf0\x23\x24\x7a\x4b\xc3\xa8\xb8\x82\x4f\xcd\x65\xe5\x28\xb6\x58\xd7\x64\xc4\xad\x2d\x8e\x39\x85\xb9\x28\xfb\x47\x37\x65\x96\x45\xaa\x9e\x4a\x62\x52\xb9\x2c\x88\x18\x34\x64\x0c\x79\x43\x37\x94\x2c\xc4\x51\x4f\x0a\xdd\xe4\xb0\xa1\x9b\x52\xfa\x15\xa9\x5f\x6c\x20\x2c\x11\x6b\xaa\x07\xdf\xa5\x67\x1d\x62\xd1\x5b\xb7\xfa\xf2\xc2\xd8\x4d\xb6\x38\x3a\xeb\xe0\x05\xf1\x6a\x6a\xd0\x81\xcf\x88\x5f\x71\xe0\x75\x7d\xa0\x81\x10\xe6\xa9\x9a\xd5\x42\x01\x5b\x72\x20\xe8\xcf\x68\x69\x12\xcb\xf8\x31\xb2\x97\xbb\x9f\x63\x0a\x61\x39\x03\x27\x72\x31\xc5\x5a\x78\xbc\x93\x66\xb3\xd2\x30\x3d\x7a\xb0\x74\x6b\x9c\x00\x39\xd4\x8a\xa6\xa8\xa9\xb6\xb3\x5a\xf1\x14\x96\xa6\xa0\x71\x85\x24\x7a\x32\x92\x7a\xba\xea\x2e\x86\x8a\xee\x42\x88\xef\x6e\x6e\x4a\x34\x08\xbb\xa8\xf2\xe3\x87\x9b\x3c\xf3\xcc\xcc\x38\x09\x21\xdc\xb8\xad\xe4\x77\x97\x85\x8b\x82\x34\xf1\x0a\x2b\xe8\x61\xe1\x93\x3f\x64\x33\x95\x4f\xf4\x9e\x86\x7f\x2b\x16\x11\xea\xac\x06\xcd\xad\x4b\x80\x24\x33\xc7\x07\x72\x37\x76\xd3\xef\x61\xed\x9b\xb4\x44\xeb\x4a\x3c\x66\x54\x46\xfc\x47\x87\x54\x01\x8a\x00\xf9\xa7\xf2\xd5\x0b\x8e\x47\x66\x71\x9a\xa0\xf3\x57\xab\x31\xd2\xa9\xc3\xa4\x50\x9a\x35\x61\x54\x98\x80\x4a\x9d\x43\xf1\x97\x39\x6b\x74\xe5\xb3\x6c\xc9\xbb\xc4\xd4\x1d\x0f\x85\x25\x72\x3b\x84\xc9\x5a\xcc\x6b\x86\x04\x77\xfa\x73\xe9\x21\x41\x10\x72\x48\xd0\x1e\x9b\x81\x78\x4a\xb4\x44\xc4\x87\x4b\x1b\x11\xf9\xe3\xc2\x3f\x6a\xa7\xcf\xa4\x40\x92\x02\x92\x48\x61\x63\xb8\x23\xdb\x7c\x8d\x2c\xa8\xaf\x1a\xf9\x3b\x66\x37\x61\x8d\x2b\x01\x36\xf9\x6c\xc4\x36\x15\xb2\x56\x35\x5a\x07\x75\x33\xef\x19\x71\xdb\xca\xbc\x22\xdf\x2b\xec\xad\xfa\xbb\x27\x80\xb6\xaf\xb9\xd7\xbf\xf8\x5d\xa6\x68\x52\xbd\xca\x88\xbb\xf3\x66\xcf\x10\xb4\x1e\x2a\x52\xe2\x9d\x42\xa3\xad\xfb\x75\xd9\x9f\x4b\xb7\x32\xbb\x38\xe3\x66\xe0\x28\xa6\xf4\x64\xa5\xcb\x21\x0d\x40\xfe\xfd\x1f\x3e\x62\xfb\x60\x8c\x34\x79\x44\x5c\x47\x7e\xeb\x26\x6e\x6d\xb0\x0f\xfb\xe2\x31\x5c\x0a\xfe\x8f\xfc\xd3\x9f\xfc\x9e\x5f\xfc\x00\x7d\xc5\x66\x33\xba\x94\x48\x30\xb2\x75\xac\x76\x10\xbf\xe7\xce\xba\x61\xfb\x45\xd9\xc2\x67\x3b\xc9\xed\xa2\xfa\xcc\x57\xaa\x52\xbc\xca\xd3\xde\x08\x85\x29\xd7\x22\xb6\xb1\x04\x6a\xde\x14\xe2\x62\x29\xca\x63\x97\x94\x0e\x63\x41\x92\x67\xaa\x72\xdf\xb7\x71\xe2\xe7\x73\x6e\x25\x86\xd5\xf9\x90\x5e\xf7\xb0\xa4\xd5\x64\x87\x05\x42\xff\xfc\xff\xae\x0d\xa9\xec\xcf\x4f\x73\x9c\x3d\xcd\x75\x3b\xe3\xc5\xb0\x6b\x55\x5e\xad\x06\xa3\x5b\x4b\x1f\x7a\x88\x57\x44\x99\xbe\x26\x37\x74\xc5\x44\x0a\x2d\xf8\xf6\xb3\x26\xb9\x8b\xb9\x19\x52\x3b\x37\xae\x25\xe6\x6c\xcb\xfd\x90\x5b\x8b\x46\x43\x42\x4a\xd8\x64\x9b\xd8\x96\xda\x8a\x9e\x3a\x36\xcc\x7b\xa2\xd7\x8a\x22\xff\xc7\xd0\xfe\x79\xd7\x49\x1c\xcc\x64\xd5\x84\x11\xac\x7f\x27\x99\xa8\xae\xe3\xe8\x09\x49\x2f\x3c\x1b\x4a\xb6\x74\x8b\x4a\xac\x63\x37\xe9\xfd\xa3\x2e\x90\xa2\xbe\x87\x7d\xba\xf6\x38\x08\xa6\xd7\x06\x59\x35\x8c\xb1\x10\x35\xfe\xe5\x31\xa9\x67\xb3\x99\x1a\x44\xd7\xf9\x2a\x7c\xec\xde\x60\x55\x10\x24\x34\x7d\x70\xac\x98\x5c\xb8\x59\xeb\x34\x7a\xef\x9a\xb6\xfd\x82\x04\x7d\x1c\x40\xe0\x3d\x06\x68\xff\x51\xa0\x26\x40\x99\x28\xd4\x54\x23\x3b\x81\x00\xa3\x9c\x8c\x60\x0d\x53\x7f\x60\x7e\x79\xeb\xd9\xac\x91\xaa\x8f\x2e\x49\xa5\x7b\x42\xe2\x87\x5f\xe4\xa6\x53\x3f\x14\x7b\xee\xa8\x88\xdf\xfc\x19\x37\xe9\x53\x25\xf3\x6c\x69\xfd\xcd\xb3\xa4\x04\xe8\x9b\x19\x74\xc7\x23\x89\x04\x72\x0d\x1a\xf4\x00\xc2\x0e\x79\x09\xda\xbe\x8a\x3c\x21\xf3\xee\x4d\xd8\xef\x4b\x77\x79\x5b\x24\x93\x78\x51\xd7\x09\xfb\x26\xc5\x81\x0c\xb4\x3f\xcf\x1c\x35\x24\xff\x0f\x66\x00\xf5\x55\xe8\x56\x39\xed\x96\x8b\x5f\xae\xc6\x45\x64\x33\xec\xc8\xe5\x7d\x40\xe7\x5d\x4d\xb9\xa3\x7e\x7e\xb1\x27\xff\x0d\x5c\x87\x11\x2e\xd5\x7e\xb1\x4c\x15\x38\x7d\x08\x89\xf0\x8c\x5f\xc0\xae\x0b\xe9\x68\x14\xa8\xac\x1d\xf3\x12\x02\xf3\x18\xcd\xa0\x43\x53\x30\xfd\x5e\x18\x25\xec\xcf\x2f\x69\x9d\x74\x01\x2b\x85\xf1\xad\xc9\xa6\xc0\x22\x9c\x78\x1a\xf3\x51\xce\x15\x8a\x4c\x0a\x3b\xe2\xf9\xff\x5e\x30\x72\xc1\x9b\x3b\x73\x5f\x9a\x9c\xfd\x97\x4d\xf0\x9a\x5d\xd9\x4e\xff\xe1\xc9\x3b\x5b\xd6\x65\xfa\x4c\x22\x4f\x71\x5c\xd3\xfd\xca\xb8\xf5\x9a\x0f\xd9\xdf\xc6\x0f\xd8\xc7\xdf\xa7\x32\x58\x32\xd9\xb7\xde\x5f\xfa\xf2\x5a\x67\xbe\x43\x24\xd4\xeb\x6f\x13\x8e\x34\x91\x45\xdd\x60\x1e\x49\x16\x07\x41\x3e\x68\x92\x10\x2e\x19\x95\x2e\xee\xe7\xc9\xf4\x7e\xae\xa1\xe3\x72\x48\x68\x51\xe9\x9b\x1c\x49\x8c\xd3\xab\xd3\xf9\x76\x69\x7a\x04\x46\xc7\xb7\x02\x40\xfe\x20\x58\xd6\xa9\xe5\x38\x99\x50\xf6\x1f\xf9\x05\x67\x6a\xe2\x93\x1d\x86\x01\x2a\x9c\x09\xff\x78\xae\x9e\x76\x5a\xcc\x0b\x82\xf8\xca\x37\x7a\x59\xc5\xd9\xf5\x5c\x0d\xa7\x74\x49\xc2\x81\xfd\xb7\xc6\xcf\xdd\xb8\xee\x04\xd8\x6f\xac\x6d\x6c\xec\xb7\x9d\xcd\xda\x89\x66\xca\xec\xd9\x9b\x46\xd9\x99\x1a\xa4\x20\x99\x89\x21\xf7\x2d\x9b\xe9\x10\xca\x1d\x19\xae\x0f\xb1\x75\x35\xd6\x43\xef\xf5\xf3\xca\x19\x93\xfa\xf4\x54\xfe\x06\x2c\x02\x2e\xef\x2f\xb9\x26\x4b\x3b\xce\x12\xea\x74\x4a\xc7\xa1\x90\x71\xcd\x86\x4f\x3b\xe0\xaf\xad\x8e\x80\x3a\xf4\x49\x2a\xb7\x54\xbe\x0f\xf4\x3c\xeb\x07\x8b\x3a\x37\x1d\xcb\xf7\xfd\x94\xba\xb9\x29\x1d\xac\x12\x73\xb7\x72\xf3\x7c\x8e\xf2\x75\x39\x84\x89\x06\xe0\x0e\xa6\xaa\xad\x7f\x2b\x7a\x74\x5d\x5f\x80\xb1\x4b\xa4\x59\x78\x02\x05\xa3\xef\x77\xdb\x19\x9f\xa4\x6e\x4f\x54\x20\x4e\x3f\x5f\x5a\x3d\x46\x4a\x46\x4d\xe5\xdc\x4d\xce\x26\xef\x9b\x69\x2e\x85\xe5\x4b\x8b\x56\x7b\xce\x99\x76\xed\x6d\x86\x43\x33\x0b\x87\x85\x31\x14\x93\x2f\xe7\xdb\xed\x6f\x69\xe2\x0b\x81\xc8\x3b\xea\x37\x9c\x26\xbe\xae\x7f\xeb\xd6\x10\x61\x5e\xa4\xf5\x7b\xb7\x03\x30\x2c\x95\x4a\xfa\xaa\xf9\xe9\x03\x4e\x7c\x99\x02\xd5\xcb\x5d\x8e\x05\x55\x46\xb2\x95\x72\x21\xab\xd1\x9f\x29\x13\x60\x1c\xae\x43\x94\xdb\x23\xd2\xe2\xb6\x5e\x56\x5c\x42\x50\x79\x2c\x93\x92\x33\xf1\xd2\x58\xab\x11\xe8\xdf\x39\xab\x9d\xaa\x0e\x32\x11\xca\x43\x70\xdb\x51\xa4\x13\x57\x5c\x96\x0f\x27\x7a\xac\x90\x4d\x4a\xdd\xeb\xb7\x9a\x5c\x59\x1c\x6d\x6c\x83\xda\x4d\x5a\xa2\x29\xfa\xab\xaf\x1c\x6b\xf4\x69\xfd\x71\x2e\x3c\x6e\xc1\xa8\xe4\xd1\xfa\xe2\x1b\xf4\xdf\x35\xff\xed\x14\x9b\x4c\x9f\x17\xd1\x95\xf7\x62\x94\x90\xd8\xfb\x58\xd5\x19\x34\x90\xef\x8e\x20\x89\x2c\x88\x8f\x98\x01\xde\x87\xa2\x79\x64\x10\x6c\x33\xee\x2b\xd0\xf3\x72\x72\x49\x66\x20\xa9\x79\x6a\x93\x8d\x55\xc4\x62\x7a\x94\x52\xac\xa2\xc4\x89\xd6\x7f\x53\x1a\xfc\x8d\xde\x49\x09\xfd\xac\x20\xfd\x58\xdd\xbf\x2d\x29\x1c\x89\xbd\x07\x99\x5e\xd6\x20\x59\x79\xe5\xcc\x16\x24\xb5\xa9\x41\xff\x97\x48\x78\xe5\xd7\x1c\xda\xd3\x53\xd0\xa3\x10\xef\x79\x28\xec\xb2\xbf\x88\xb7\xed\xc2\xbc\x91\xcd\x74\xeb\x76\xbe\x2f\xd6\x32\x84\x63\x09\x7d\x3e\xef\xb5\xf8\x52\x70\xda\x34\x34\x7f\xbc\xd0\x03\x8f\x94\x51\xbf\x2c\x3b\xc0\x79\xd0\xbf\x2b\x5e\xbf\x45\xce\xf5\x77\xb9\xa0\x30\xfc\x9c\x9d\x2f\x13\x20\xae\x48\xe4\x76\x9b\x75\x40\x0d\xd9\x76\x05\xac\xa4\x85\x2f\xfd\xb6\x88\x12\xc9\xdf\xd6\xaa\xad\x36\x3a\xc4\x4a\xec\x55\x13\xcf\x3e\x1d\x81\xda\x66\x37\x3b\x21\xcc\x7b\x80\x03\x96\x3b\x15\x1d\x2e\xf0\xb6\x57\x2a\x44\x05\xaa\x59\xc6\x8c\xce\x1d\xc4\x71\xc3\xaf\x76\x4a\xe9\x52\x94\x9b\x02\x8a\x00\x48\xa0\xba\x78\x3e\x7d\xd2\xe9\x65\xa7\x36\x34\xd0\x30\xe0\x74\x62\x22\x41\xc7\xb8\xff\xa4\xf0\xb1\xfa\x11\xe7\x04\x8c\x23\x78\xce\xe9\x94\x97\xd0\x10\xb3\xf7\x0f\xa5\x6b\x18\x59\xb3\x72\x3a\xa6\xc9\xc4\x92\x12\x17\xeb\x0e\x70\xce\x75\xfa\x24\x31\xc0\x98\xcf\xe0\x2e\x24\xc5\x99\xb9\x6d\x53\x56\xe7\xf0\x46\xc8\x60\xa2\x05\x4a\x1e\x00\xc7\xe9\xf2\x18\xe0\x49\x10\x9e\x47\x3d\xb4\x30\x77\xb4\x43\xbd\x87\x58\x9e\xfe\xd4\xfe\x9f\x88\xa0\x2e\x12\x76\xb5\x38\x22\x81\x55\xdd\xb1\x21\xd7\xc6\x64\x71\x2a\x89\x8b\xa5\x7c\xb4\x76\xd9\xd5\xb9\x64\xa6\x62\x4a\x3a\x04\x29\x13\xb3\x6f\x5a\xdc\xc1\x44\xfa\x18\x98\x06\xd8\x23\x27\x54\x31\xce\xb5\x93\x07\x8f\x44\x83\x6d\xdc\x22\x34\xa6\x34\x4a\x76\x00\xe5\xaa\x44\x45\x57\xe7\xdf\x00\xd3\x35\x16\x34\xa2\xba\xbf\xc6\x3e\x2a\xd7\x86\xbd\x4b\xe5\x73\xe7\xdb\x67\xdd\x63\xed\x29\xa6\x1d\x38\xf5\x6f\x4b\x4e\x37\x54\xfc\xf1\xea\x31\x78\x0a\x98\x2e\xe8\xf0\xb8\x5f\x14\x2e\x86\x8a\x4e\x8c\x93\x6a\xb8\xe9\xc4\xba\xcf\x09\x34\x52\xa8\x77\xf9\x0c\xd3\xe6\x70\xa0\x9e\xac\xdb\xf2\x66\x61\xa5\x56\xe4\xfe\xfa\xd8\x79\xc5\x44\x3b\x33\x81\x37\x75\x08\x98\x6f\xf4\x2c\xab\x80\xf0\xa0\x73\x81\x8b\x01\x0c\x2b\xbf\x8d\x4a\x4a\x88\x8e\x25\x0a\x14\x64\x8f\xa7\x4a\x80\x87\x1f\x09\x99\xa1\xb6\x99\x8e\x61\xad\xe4\xc4\xee\x01\x0d\x5c\xf2\xf1\x04\x53\x13\xf6\x0e\x87\x94\x26\x62\xee\x92\xd4\x8c\x62\xd6\x05\x9a\xe9\x6d\x7e\x3a\xa6\xc8\xeb\x11\xef\x0e\x6d\x2c\x79\x8f\xfa\x67\xec\x9e\xd2\x44\xb4\xa3\x29\x52\x12\xf9\xa3\xcb\xe0\xe6\x0f\x9a\x30\xd4\xf4\xc4\x13\x13\x37\x5c\x5a\x98\x77\x32\x57\xa3\xcb\x31\xae\xd8\x6c\xb4\x24\x9f\x3d\xf9\x77\x4e\x53\xc0\x74\x79\xfc\x48\x5a\x21\xe0\x5e\x09\x09\xb5\x87\x35\x3a\xbd\x42\xf4\xa0\x69\xa7\x5c\x30\x6b\x16\x6b\x7b\x0f\x24\xc9\x33\xfe\x83\x97\x37\x06\xe8\xb9\x73\x56\x54\x56\x37\xbb\xbd\x5c\xdf\x76\x5a\xa6\xd5\x44\x92\x36\x22\x13\xcd\xd4\xf8\x77\xb7\x9a\x30\x7e\x5a\x7d\xb1\x47\xa8\x0d\xab\xf8\xa5\xb9\x0c\x66\xc5\x7c\x72\xcb\x53\xe8\x1d\x8b\xdf\x8e\xe9\xb0\x40\x1c\xab\x4d\x56\x80\x27\x0a\x3d\xf2\x77\x73\xb2\x14\xf9\x67\xf4\x9e\xd2\x8a\x1c\xa8\xc1\xad\xae\x20\x05\xed\xd8\xec\xe0\x28\xe8\x58\xfb\x93\x05\xe5\x4f\x09\xbb\x0c\xc5\x87\x65\x11\xfd\x3e\xc2\xbe\xcc\x6c\x3a\xe7\xa8\x5c\x25\x4b\x26\xe2\xf1\xd9\x71\xb7\x35\xf8\x16\xc5\x71\x9f\x9a\x28\x9c\xf1\xc1\x41\xbb\x7a\x02\xa7\x34\x87\xc7\xf9\x9c\xcf\x46\x86\xd1\x88\x39\x42\xb1\x76\xd6\x7d\x5c\x39\xe5\x76\xc2\xc3\xde\x91\x23\x57\x60\x38\x33\xde\xab\x26\x0e\x3b\x19\x42\x72\x57\x70\x84\xf8\x06\xbc\xe8\x36\x22\xee\x84\x79\xe5\x04\x4d\x77\x7e\x7d\xba\x14\x9a\x9e\x8e\x6e\x4e\xdc\xe9\xd5\x02\xe3\x0e\xc3\x4a\xf6\xea\x17\x71\x97\x73\x6d\x2f\x2c\x70\x6f\x56\xd6\xbb\x95\x09\x28\x92\x0e\x34\x05\x3c\xfa\xc0\x6f\x9f\x4e\x31\x76\xb4\x4a\xc8\x4b\x49\x1c\xd0\x0f\x95\xca\xae\xc7\xa4\xd2\x51\xc4\xe9\xa7\x89\xf5\x2d\xac\xa1\xbd\x0e\xfb\x73\x8d\xfe\xa3\xd9\x63\xd7\x92\x21\x71\xb0\x42\x6c\xd5\xfe\x16\x6e\x4d\xe9\x70\x99\x6c\x69\xe5\xaa\x31\xe6\x4d\xd5\x33\x3e\x41\xc0\x2e\xd0\x9e\x9c\x9d\xaf\x0e\xc0\x7a\x44\x6f\x1c\x9b\x73\xf7\x8b\x09\x7f\x43\x3d\x2d\x8d\xe4\x43\x02\xef\x7b\x16\x3b\xb6\xdf\x78\x7a\x7e\x6f\x99\x2a\x6a\xa8\xec\xb2\xd2\x05\x75\xa8\x78\xc5\xb7\x44\x39\x36\x1c\x40\x55\xda\xb6\x8b\x4b\x28\xe5\xcf\xe7\xef\x8b\xf0\x1d\xd6\xc2\x27\xb5\xe7\x1d\xea\xe7\x8b\x87\x04\xd1\x11\xdd\x65\x7f\x77\x94\x8e\xe3\xcf\xd0\xf7\x84\xe6\xde\x66\xf8\x1f\xf7\x4f\x5c\x6e\x64\x99\x58\xb5\x40\xbc\x08\x90\x1d\x78\x02\x3a\xa1\xd6\xc4\xdd\x69\x22\xda\x02\xfd\x99\x75\x92\x7a\xd4\x75\x86\x31\xb9\xd9\xd6\x59\x6c\xda\xc8\xf4\xd3\x4e\x13\x71\x5b\x2f\x84\xa6\xed\x60\xb5\x48\x99\xbc\x99\x4d\x1e\xe5\xaf\xf5\xf8\x5b\x2c\x79\x77\x4c\xd5\x29\x10\x5d\xce\x2f\x32\x04\xf6\x5d\x31\x21\x8e\x49\x27\x60\xa0\x2e\x95\x7a\x4c\xcb\xef\xfc\x8e\xb1\x67\x84\xe9\xee\x5e\xee\x95\xe4\x8b\x05\x16\x36\xc5\x5e\xb8\xdc\xe6\x14\x95\x68\xea\x90\xef\x2b\xc4\x12\x92\xb2\x3f\x1a\x4b\x78\x47\xcb\x5f\x01\x2c\xc4\x62\x04\xf3\x83\xda\x28\xb9\xdf\xb8\xde\xbc\x04\x49\x7e\x6a\x39\x87\xcb\x34\x8b\x38\x7e\x2e\xbe\x2b\x6a\x5c\xcc\x67\xc3\x86\x48\xc9\xf8\x13\x0f\x71\x68\x86\x7a\xf6\xe1\xd1\x07\x95\x5d\x94\x35\xb8\x3f\x05\xb0\x35\xeb\xeb\xbd\xef\xe3\x2a\x25\x04\x52\xac\x88\x9d\xbe\xf7\x3b\x44\x01\x0c\x67\x96\x06\x49\x72\xc0\x20\x59\xbb\x58\x38\xce\xb9\x26\x32\xa3\x63\x5c\xe4\xf6\x21\xcc\x95\x6b\x29\x4c\xe2\xbc\x71\xc4\xe3\x85\x16\xb2\x15\x12\xe4\x7e\x73\x6c\x9c\x5c\x45\x30\x9e\x8a\x2c\x1e\x44\x1d\xeb\x02\xf8\x6e\xad\xa1\x50\xe5\xab\xad\xdc\xb8\x86\x83\x05\xe0\x41\x34\xa1\x97\xd0\x77\xc8\x96\x0f\xb7\x4d\xf6\xf5\xdd\xc5\xe6\x6c\x09\xb6\xda\x4e\x80\x11\x5c\xcf\x0f\x03\xf2\x88\x8f\x1d\xe8\xe7\xb1\x33\x64\x4d\xe6\xf0\xee\x47\x63\xc3\xca\x14\x10\x79\xc7\xfd\x76\xec\xf1\xc0\xf3\xcd\xb6\x58\xa2\xfc\x58\xfb\x63\x63\xe8\x28\x84\xce\xb2\x09\xf2\x01\x12\x44\x30\xbf\x3e\xf5\xc6\xd5\x57\xf6\xbb\xd9\x5b\xf9\xe2\xbc\x55\x6e\xe6\xda\xdf\xe2\x64\x85\xc0\x0f\x19\x96\x54\xc7\x84\x62\x1a\xc4\xa5\x68\xfe\xb5\x8d\x77\x6c\x77\x04\x4d\x47\xf4\x4e\x7e\xed\xa0\x8a\x82\xe1\xbc\x41\x1d\x9f\xe0\x68\x79\xcc\x60\x4f\x66\x09\xc3\xb7\x4a\x5f\x95\x6d\x33\x03\xc3\xb4\x9a\xd0\xb4\x0c\x6d\xc4\xb0\xc8\x86\x26\xa1\xbc\x82\xd1\xc4\x64\x60\xe9\xba\xbb\x21\x0e\x1f\xb6\x4d\x72\x1c\x3a\x3e\x9b\x9a\x12\xe6\x1d\xa1\xca\xdb\xe7\x61\x2c\xc8\xff\x68\x9b\x7e\xdc\x5d\xc5\x89\x27\x55\x27\x37\x5d\x6d\x3f\xc0\xe1\x0a\xfe\xee\x27\x4e\x08\xb5\x5f\x2a\x73\xb8\x6d\x40\xe5\x3d\x5d\xfb\xc6\xe7\x6c\xdc\x7e\x74\xfd\xac\xfd\x22\x82\x1f\xa1\x16\xbd\x18\xa8\xf2\x49\x59\x2f\x2e\x1a\x9c\x80\x12\x3c\x1f\x92\xa9\xc9\xe8\x37\x31\xbd\xac\x1e\x9e\xf3\xb8\x4b\x25\x65\x9b\x01\x65\xeb\x31\x3e\x5d\x35\x4e\x32\xa8\x92\x55\x4c\x85\xc9\x39\xb6\x49\x72\xfa\x67\xd6\xfe\x46\xe1\x43\x79\xdf\x57\x92\x29\x6b\xf4\xbc\xe7\x41\xeb\xb9\xa3\x9b\x36\xee\xf8\xbb\x96\x8e\x51\x82\xf4\xad\x44\xb8\xce\xf1\xf7\x39\xd1\x5a\xad\x73\xa7\x3d\xef\xae\xeb\x13\xb1\x3a\x12\x42\xd9\x80\xd5\x91\xd6\xf1\x97\xb4\x4b\x55\x3e\xfb\x15\x2e\xc9\xcb\x41\x94\xa1\x34\x35\xc5\x5e\xf9\x82\x9c\xe1\xcf\xd5\x54\x3b\x62\xbb\x79\xf1\x66\xff\xab\x45\xb6\x30\xe5\x67\x61\x02\x82\x57\xea\x42\xab\xa4\xcc\x6f\xba\xbf\x43\xec\xdd\x4e\xbe\x90\x36\xce\x18\xb1\x04\xcf\xf9\x9f\x0c\xf9\x64\x55\x24\xb2\xd3\x48\x3a\xc6\x01\xcf\x8a\xc9\x93\xd5\x5a\xe7\xe4\x85\x15\x40\xf2\xdf\x26\x95\x59\x88\x21\xf2\xec\x45\x3c\x02\x96\x61\x8b\x85\x2b\x71\xcd\x0e\x16\x5c\x87\xa1\xdf\xa3\xa0\x7a\x8a\x57\x93\x2a\xac\xce\x65\x37\x67\xe8\x8a\x65\xef\xfd\xd6\xf3\x05\x0e\x1a\xc9\xba\x82\x6b\x90\x26\xf6\x02\x24\x49\x93\xcc\x0b\xb0\xa7\x16\xb3\x26\xf4\x2f\x25\xc1\xba\x45\x25\x4f\x58\xc9\xdd\xd9\x63\xc0\xaf\x2f\x3a\x9e\xf2\xce\xbb\x0e\x70\x37\x46\x7f\x88\xb0\x00\x90\xfd\x0c\x15\xba\x39\xab\x6f\x1f\xca\xbe\xee\xcd\xd3\x1f\xcb\x87\x16\x5e\x8d\x92\xb4\xc8\x86\x40\x62\xf0\xd7\x97\x74\xbf\xbe\x6c\x53\x63\xd8\x31\xcf\xba\xe6\xac\xd2\x88\x00\xb0\xab\x99\x11\xc4\x99\x3d\x82\xa5\x21\xe7\xf4\x75\xbd\xd3\x6c\xea\xf5\x6e\xcd\x88\x40\x49\xe9\x6b\x62\xff\x51\xed\xe6\x75\x66\x32\xf0\x84\x44\xd4\xcb\x35\x41\x38\x73\x18\xf1\xe1\xc7\xec\x1c\xdb\x0d\x0f\x41\x92\x44\xdf\x60\x04\xe2\x82\xf7\xb4\x01\xc0\xfe\x16\x5a\xa9\xd2\xd4\xba\x70\x11\xe5\xd5\x62\x11\xcb\xf8\xb0\x3d\xb6\x69\xf6\x7d\xc9\xe8\x1b\xc5\xd7\xbd\x52\x47\x0c\xb4\x1e\x56\x88\x73\xc1\xd0\x7d\xf4\xdf\xe3\x0a\xb2\xaf\x75\xe8\x31\x6c\x47\x06\x9c\x52\xa0\x3e\xe3\x24\x8e\x6c\xe5\x60\x15\x20\xd3\xe5\x49\x16\x1e\x1b\x97\x9e\x5d\xd5\x17\x4e\xbf\x17\x61\x7f\x73\x3a\x55\xe1\x8a\x7c\x3b\x51\x7a\x2d\xa4\x75\xc8\x81\xa2\x13\x28\xbe\x06\xa5\xef\x4a\xd5\x1f\x74\x91\x35\x05\xa7\x65\x60\x63\xc3\x08\xd5\xe5\x57\x14\x81\x83\x45\x15\x24\xe4\x82\xf7\x49\x28\x03\xaf\xd1\x8b\x6d\xfe\xc8\x8e\x4f\xa2\x03\x04\x74\x4f\x7e\x16\x73\x11\x7b\x07\x19\x3f\x34\xe0\x71\xc0\x94\x29\xe4\x64\xec\xe8\x39\x55\x37\x63\x9d\xc6\x81\x02\x20\xb1\xb1\x40\x75\xdb\xd5\x5d\xc5\x6f\x9a\xc4\x7e\x3e\x5c\xa6\xb2\x76\x06\x9f\x81\x51\x6b\x20\xee\x0c\xc1\xe2\x71\x52\x46\xf7\xb7\x5d\x49\x26\xee\xa3\x6f\xb4\x10\x5e\x0d\x0c\x8a\xfa\x81\xc7\xa4\xf5\x03\x70\xc9\x7b\x26\x3b\x19\x84\x72\x56\x1d\x44\xb6\xd4\x27\x2b\x61\x61\x7e\xf7\x85\x52\x21\xdb\x12\x17\x78\xb4\xf0\xba\x7e\xd0\x86\x9b\x78\xf5\x91\x38\x92\x1f\xf9\x12\xd9\x81\xdf\x76\xa1\x89\xc1\x50\x14\x5d\xc5\x62\x63\x29\xd4\x49\xb8\xe0\x36\x8e\xb3\x89\x92\xc1\x88\x76\x18\xcf\x70\xc5\xf7\xc1\xd6\x3f\x63\x8a\xa2\xdf\xae\xa7\x51\x12\xda\x43\xc9\xfc\x39\xcb\x76\x3b\x2a\xb8\xec\x59\xb9\x89\xe8\x6d\xb0\x56\x87\xa7\x0a\x6e\xf3\xdc\xd5\x46\xd0\x9e\x9c\x6b\xe1\xef\x51\x8f\xf3\x2b\xb7\x7d\xda\x68\x8b\x20\xe3\x38\xa0\x13\x1a\x6b\xde\xfb\x74\xbe\xdd\x8a\x64\x92\xa7\x50\x71\x05\xe5\x87\x5c\x8d\x16\xae\x4f\xdb\x56\x30\xc6\xbb\x62\xba\xe3\xd6\x12\x0d\x1c\xdf\xef\x33\x13\x6e\xa3\xab\x96\x1a\x12\x3c\xc7\x33\x3e\xa4\x9c\x06\xc8\x01\x99\xa6\xed\x5f\x24\x49\xa5\x66\xac\x65\x62\xc8\x4b\xb2\xb0\xab\xd0\xf4\x5d\xe9\x73\x02\xe9\xbb\xcc\x81\x51\x78\xf1\xcc\x25\xfb\xa5\x58\x15\xe0\xfb\x2b\xe3\x01\x3b\x07\x72\xf6\x7f\x88\xa9\x25\x85\x6e\x91\x5c\xf1\x1e\x06\xcf\xba\x78\x2b\x97\x80\x18\x48\x93\x65\xc7\x50\x7e\xd5\xee\xb7\x4c\x12\xb6\x7f\x72\xbb\x7f\xf4\x40\x3f\x0a\x44\x84\x26\xb8\x38\xc5\x67\xef\x24\xad\x46\x8f\xdd\x85\x9b\x89\xb0\x08\x0b\x39\x28\x26\x77\xe8\x42\x5e\x43\x6f\x2e\x50\x30\xc4\xd1\x56\x41\xd6\xfe\xcc\x77\x15\xf1\xd3\xdf\x1c\x53\x77\x72\x67\xe5\x8e\x75\x9a\x02\xe1\x80\x2c\x05\xe7\x63\x94\x3c\x99\x69\xa6\x39\x61\xcc\x6a\xff\x3d\x93\xe6\xee\xaf\x44\xe8\xb7\x86\xb3\xc5\xe6\x68\xe1\x95\x7f\x13\x2d\x8a\x15\x89\xb6\x00\x3c\x64\xcc\xf5\xd1\xd7\xc3\x5e\x6d\xe3\xc7\xb5\x7b\x12\x7f\xb1\x4d\x47\xe4\xb6\x4b\xab\x58\x16\x8f\xa9\x0a\x70\xf9\xac\xa9\x16\xed\x0e\x7b\x6e\x44\x78\x34\x9a\x86\x83\xa2\x82\xcc\xab\xb5\x40\x2c\x2a\xf0\xc5\x4d\xc8\xf3\xd9\xfb\x93\x1c\x8b\x9a\xf8\xcd\x7d\xd6\xfb\xb9\x57\x72\x42\x7c\x13\x30\xde\x1b\x10\x81\x62\xe6\x09\x14\xe3\xc9\xb9\xb9\x43\xf3\x8e\xdb\x79\x89\xc9\x6f\x97\xdf\x61\x5c\x59\xc8\xe1\xb2\x14\xfb\xe0\x61\xb4\x06\xb1\x25\x54\x9f\x50\xb4\x6d\xd2\x09\x29\x2c\xfa\x7f\xd3\x66\xc6\x28\x27\xed\xf2\x79\xd6\x25\xc5\xc1\xe5\x10\x88\x01\x8f\xe2\x0f\x7e\x6e\x27\xdd\x14\xa2\x48\x22\x20\xe4\x40\x1e\xa1\xc0\x1c\xb6\xcb\x28\x5a\x4c\xfb\xc2\xef\xf8\xef\x2a\xb4\x91\xdb\xd0\x4b\x43\xcc\x41\x27\x90\x06\x16\x75\xc3\x52\x08\xac\x1b\xbf\x49\x2b\x98\x79\xf0\xd6\x14\x42\x48\xdc\xb1\x1f\xfc\x0d\x83\xf7\x81\x8f\x8d\xaf\x9d\x2e\xd8\x0d\x34\x89\xb2\x86\x0e\xca\x11\x7b\x07\x78\xdc\x41\x58\x7b\x6e\x0e\x1e\x86\x3b\x8a\xd6\x11\x61\x8a\x75\x34\x69\x0a\xdc\xba\xbd\xfe\x63\x1c\xd8\xcf\x6c\xd4\xc5\x4a\xf6\xb7\xdf\x1c\xee\xa6\xfb\x11\x7c\x77\x3e\x4e\xfa\xe1\x2c\x42\xaa\x06\x3a\x99\x81\x95\xaa\xfe\x3b\x46\x4c\xb6\x28\xd7\x5f\x2f\x89\xef\xbc\x1f\x6e\x20\x8c\x91\x5a\x42\x2b\x2a\xee\x15\x7b\x75\xa2\x24\x4d\x7d\x79\x9c\xaf\xf3\x65\xa7\x70\xf4\x12\x7b\xfa\x00\xf9\x9e\x68\x72\x02\xd6\xf2\xe0\x03\x79\xb5\x3a\x7c\x5a\x1b\xf8\xdc\x11\x1e\x5f\xaf\xa6\x02\x6f\x74\xd8\xda\xad\x42\x80\x31\x32\xce\x39\xdd\xb7\xc9\x28\x65\xd2\xd3\xf9\xc8\x28\x42\x1c\x34\x01\x10\x6b\xa8\xa7\x01\x89\xff\x7a\x8a\x4d\xb1\x3b\x6b\xdc\xd2\xbd\x03\x9c\xc3\xf9\x6a\xb4\xda\xd0\x77\x55\x6d\x53\xdb\x87\x7c\xc4\x86\xa9\x53\x6d\x03\x0b\x4e\xc1\x5d\x8b\x67\x75\xf5\xdc\x30\x9f\xe3\xd5\xfb\xd1\x91\xc5\x3b\x80\x75\x71\x3d\x42\xf3\x61\x55\xbd\xa6\x0b\xf0\xfb\x07\xb8\x72\x5f\x02\x70\x36\x97\x23\x0e\xc7\xdd\xb2\x08\x36\x27\xb2\xee\x74\x0d\x0c\x18\xa1\x67\x13\x2c\xbb\x77\x99\x15\xf7\x5a\xe1\x38\x25\x8d\x80\xb2\x20\x61\x5c\xbc\x86\x0a\x9d\x3f\xee\x72\x43\x74\x41\x56\x95\x90\x1e\xb7\x09\xe1\x24\x7b\x5b\xce\x8b\x17\x0e\x36\x6a\xcf\xc4\xde\x27\x74\x5b\x2b\xe1\xaa\xbb\xb5\x85\x18\x8d\x25\x5e\x00\x77\xc5\xcd\x79\x52\xc1\x5a\x50\xcb\x4b\x61\x88\x13\xfb\x51\x49\x05\x11\x92\xe8\x18\xe6\x22\x1b\xf5\xe5\xea\x4d\xd4\x32\x3d\x7f\xca\xa0\x9b\x9c\xfd\x5f\xb3\x2f\xe9\xa2\x82\xa5\x63\x70\x9e\x0a\x6f\x49\xe6\x20\x9d\x55\xc1\x9a\xb7\x8a\x80\x63\x8c\xbb\x6b\x66\x21\xa6\x76\x06\x4b\x7f\x0f\xbf\xad\x0a\x35\xfa\x2b\xa2\xd0\xef\xca\x42\x84\x61\x1a\xab\x84\x11\x7c\x33\x10\x83\x17\xbf\x64\x21\x20\xb6\x2f\x2b\x81\xfe\x38\xe1\xa8\x9c\x8e\x1f\x00\xb4\xd3\xf7\x51\x59\xf4\x50\xea\xcb\x92\xb2\xf7\x7d\xc7\x03\xbc\x26\x65\x95\x3f\xc8\x77\x85\x7d\x54\x13\xcd\x8a\x88\x04\xac\x79\xfc\x8e\xa6\xf0\xc7\xbc\x78\xd7\x45\x02\x7f\xa1\xf0\x46\xb5\xe9\x29\x86\x19\xce\x3f\xc8\x26\x16\xfc\x0e\x94\x85\x0e\xed\x40\xe9\xf7\x95\x00\x0d\x6f\x42\xde\x33\xe3\x55\xe1\xf1\x35\xa1\xa2\x0b\x45\x36\x0c\x75\x79\x72\xd9\x18\x3b\xc0\x02\x01\xd4\x26\x0e\x02\xf1\x7e\x3c\x47\xc7\x92\x2b\x45\xc0\x04\x58\x14\xde\xef\xa9\x14\xcd\xdc\xff\x02\xcf\xff\x77\x13\x35\x90\x3b\xf1\x90\xb0\xf3\x37\x5b\x9f\xf2\x38\xbf\xc7\x09\xbb\xe6\xda\xe1\xef\x91\x0e\xb0\xea\x37\x94\x40\x02\x06\xa5\xc3\x8f\x7f\x93\x9e\x5c\x17\xd2\xed\x5d\xd3\x52\x50\x50\xc4\x5f\xbf\x62\x42\xba\xe7\xeb\x6b\xb4\xe2\x4e\x2b\x36\x94\x96\x84\x20\xc6\xb1\x4b\xd3\x1d\x65\xd4\x69\xd0\xcd\xc7\x6c\x52\xab\x0d\x1d\x34\xd4\x83\x06\xfc\x3f\x87\x12\xcb\x20\x55\xdc\x59\x2f\x88\xc1\xf6\x5e\x97\xb2\xcf\x77\xac\xc4\x05\xf6\x11\x11\xed\x33\x2d\x11\x9e\xbe\x62\xed\xf6\xd7\xa7\x68\xb3\x9a\x23\xa1\x26\x3c\xb4\xfd\x72\xc8\x89\xa9\x8d\x60\x3c\xf4\xe2\x93\xc7\x6d\xb3\x79\x26\xf2\xb8\x8b\x35\x2a\x64\x5b\xb8\xfb\xb1\x5c\x15\xc3\xc0\xe9\xd4\xd8\x00\x29\xcd\xa6\x76\x90\x50\xb3\x72\x70\x8f\x5a\x90\x43\x52\x77\x04\xa3\x56\xc5\x63\xcc\x2a\xf0\x0e\x82\x29\x92\xe7\xed\x95\x55\xa3\xf5\xc2\xbb\x50\x9e\xdf\xf7\x2b\x6e\x92\x36\xb8\x7e\xbb\x96\x32\xe3\x6b\x94\xac\xe4\x06\xb1\xf1\x4b\xfc\xc5\xe1\xed\x8d\x78\xdc\x75\xf4\xac\xd7\x62\x40\x09\x81\xc6\xb2\xcb\xb6\x9f\xf2\xda\x4f\x6a\x9b\x04\x9c\x72\x88\xee\x6e\xdf\x35\xb9\xf8\xa1\x3f\xc0\xd3\xbc\xdb\xe6\xb3\x10\x45\x86\xec\x70\x00\x55\x28\xd9\xa7\x32\x4b\xad\x0e\x9a\x29\x55\x7e\xb5\x6b\x80\xf4\x5c\x50\x13\xe6\x7c\xe1\x63\xe4\x10\x25\xf7\xdb\x4f\xd4\xad\xcb\x43\x02\x7c\x07\x32\x15\x8f\x77\x28\x69\x5b\xf8\x3a\xa3\xcc\x8e\x30\x47\x88\xef\x02\x46\xc8\xb3\x51\xa5\xd0\x0d\x01\xa7\x52\xd1\xc9\x97\x3b\xd1\x3e\xbd\xb9\xf3\xe7\x33\x59\x27\xac\x6b\xf1\xa3\xb8\x90\xa1\xbb\xbc\x2d\xff\x03\xc8\x14\xa5\x71\x13\xdc\xbb\xfa\x2b\x9d\x49\xd6\xfd\xd6\xf7\x3d\xd4\xf4\x00\xaa\x5e\x27\x81\x47\x40\xf4\x0a\xe7\xaf\x01\xa1\xfe\x95\x92\xa5\x74\x5e\x1e\x00\x2b\xbf\x79\xf3\x9a\x74\x32\xe3\xdc\xdb\x99\x52\xb9\xbb\x1d\x2a\x51\x8c\x4c\xb4\x70\xb6\x2d\xb3\x57\xee\x6f\x46\x6f\x5e\xa5\xd6\xa1\x4c\x6e\x26\x4c\x94\xfd\x7a\x38\x8d\xf9\x53\xa7\x9c\x63\xad\x33\x47\x86\xf3\xb9\xa0\x27\x1a\xb8\x0d\x09\x90\xc4\xfa\xda\xe2\xfa\x70\x86\xe8\x5e\xa4\x93\x3f\x06\x66\xe8\x7f\xd4\x13\x0a\x5f\x69\x9a\xbd\xcf\x7a\x54\x82\xdb\xca\xc2\x85\xef\x2f\xad\xb9\x19\x2e\x95\x7b\x2d\xf8\x40\xe7\xdb\x75\x20\xf2\x80\xca\x06\xe8\x91\x69\x88\xa2\xcb\x52\xe7\x9a\xc8\x66\xb1\x85\xf1\x67\xe5\x94\x8d\x6a\xc7\x86\x8e\x3f\xf8\x84\x9a\xec\xe7\xc9\x31\x65\x83\xbc\x6d\xf6\x8a\x56\x1b\x12\xee\x7c\xd6\x54\xeb\x0d\x11\x59\x0e\x0f\xe4\x54\xca\xbf\x16\xf7\xbc\xea\x39\xbc\xd4\x77\x3e\xe3\x52\x73\x4a\x09\x14\x7d\xcf\x75\x17\x63\xa3\x27\xff\x62\xeb\x87\x93\x10\xd9\x46\x6d\x4b\xf9\x20\x27\x1a\x76\xd2\x7d\x1d\x7c\x99\xc7\x21\x27\x64\x9f\x32\xa5\x6d\x22\x11\x25\x7a\xd4\x4d\x0d\x3b\xcb\xe3\x0d\xb0\x75\x1e\xcb\x85\x3b\x38\xa4\x89\x0c\x64\xf0\xbe\xb8\x70\x5e\x21\x1d\xf7\x6b\x78\x98\x4e\x11\xa2\x35\x33\xec\x5a\x63\x66\x81\x1b\x68\xda\x5d\xdb\x00\x9c\x8c\x9c\x75\x7e\x1a\xac\xe3\xe7\xee\xc4\xc6\x5f\xae\x08\xf6\x6f\xc9\x9f\x24\x15\x4c\x3f\x48\xd6\x25\x54\x21\x6a\xbb\x00\x61\xa1\x21\x92\xe9\xaa\x84\xdc\x68\x2a\x8c\x32\xa8\x06\x44\x0a\xf1\x1d\x2d\x3c\x6e\xe5\xa8\x7a\x63\xbe\x01\x84\xab\x5c\x3a\x8f\x0c\xa9\xd5\xde\x73\x3b\x6a\x57\x6b\x0d\x82\xd6\x17\x12\x51\x2e\x86\x57\x77\x5d\x06\x56\xb4\x5d\x6d\x3f\x83\xbf\xc0\x1f\xfc\x3d\x40\x15\x4a\x6f\x5f\xd9\x79\xb8\x11\xe1\x7b\x45\x47\x4b\xbb\x54\xd6\x76\x9c\x50\x61\xef\x3d\xc8\x8f\x2a\xcd\x32\xf1\x6a\xe0\x64\x69\xe4\x77\xf1\x58\x09\xee\x96\x0e\xaf\x0e\x2d\x57\x05\xc0\x6f\xeb\xc2\x20\x22\xd3\x7b\x59\x96\x9e\x49\xe7\xad\x07\x01\x03\x88\xa3\x2f\x26\xab\x55\xe5\x1b\xdc\x98\x4d\x2d\x0b\xe3\x64\x1d\x89\x6b\xf9\x07\xf2\xf1\xf2\x48\xcf\xa2\x1e\x26\xba\xb3\x6f\x55\x91\x8e\xc9\xa6\xaf\x20\x82\x90\xb7\xf6\xed\x8f\x90\x62\x62\x19\xcc\xa3\x66\x7d\x16\x56\x7e\x9c\x64\xda\xfb\xef\x9b\x63\x2f\x2a\x95\xb8\xa8\xc6\x03\x34\x56\x02\x44\x86\xdd\xea\x09\xa1\x08\x91\x50\xa4\xf1\x6c\x41\xd0\xc9\x5f\xad\x91\x90\x48\x8c\x1b\x4d\x3b\x01\x48\x4f\x10\x62\x3d\xd8\x85\x40\x84\x17\x71\x11\xb1\xd8\x1a\x86\xf4\x08\xb5\xc9\x80\x9a\xe2\xb9\xd3\x73\xc2\xc4\xb7\xda\x48\x77\x1b\xb6\x5b\xc4\xae\x0b\x6d\x6c\xb1\xd4\x37\xd9\xe1\xcd\x3a\xbf\x27\xaf\x5b\x90\xe5\x4f\xd9\xbf\xf2\x79\xf7\x4e\x42\xcf\x13\xa9\x83\xd4\x5a\xf7\x19\xe9\x6e\x06\xcd\x0b\xd6\xd1\xd4\xd9\x4a\x90\x5e\xf8\xeb\xc2\x3a\x69\xc1\x11\x22\x12\xb1\xa7\xf2\x7c\xd1\x62\x05\x71\x1e\x49\x49\x33\x0f\x1e\x81\x09\xfe\x71\xe4\xe2\x55\x9c\x43\x6d\x2a\x6a\x25\xd7\xaa\xb5\x72\x2b\xfc\xd6\x94\xbe\x5d\x59\xe4\xf9\x9c\x9b\x6a\xb1\xf6\x84\x14\x29\x63\x7c\xf3\x2b\x27\xc3\x87\xac\xed\xbf\x3b\xc3\x5e\x8d\xc6\xf5\x0a\xcb\xa1\x1f\x6b\x12\xcd\x90\x09\x29\x66\x4e\xcf\x30\x78\xcc\x7b\x4c\xcd\x37\x13\xa6\x91\x3f\x2b\x4d\xbc\x95\xe7\x42\xca\x5c\x49\x73\xef\x4c\xdf\xdf\x1d\xed\x30\x04\x3f\x44\x2b\x3d\x72\x58\x18\x13\x11\xc9\x43\xa0\x91\x80\xb1\x32\xb9\x15\x01\x58\x67\x8c\x4c\xd0\xfb\x7f\x76\x3e\x54\xb3\xc2\xbb\xf2\xf5\xa0\x1a\x7f\x4f\x79\x6e\x67\x1c\xf8\xdb\x24\x0c\x2c\xc6\x7f\xa8\x9b\xc1\x6e\xb0\x72\x1b\x14\xf1\xa9\xe9\x2d\x7b\x86\x27\xf2\x3e\x5e\x96\x12\x83\xff\x48\x76\xe2\x10\xb1\x84\x42\x14\x9b\xd9\xe0\xa9\x48\x13\xfb\x65\xef\x3e\x8e\x84\x89\xb9\x09\x1f\x8b\x66\x31\x42\xba\xd1\xee\x23\xbb\x47\xff\xbf\x15\xaa\x0b\x42\x5c\x09\x70\xe0\x59\x8f\x44\x4b\x86\x58\x5e\x25\x02\x3f\x41\x84\x4f\xa5\x5c\x95\x5f\xff\xeb\x50\x92\x0b\xa5\x6a\x9f\xd3\x65\x43\x82\xab\x5b\xa8\x58\xad\x0f\x1a\x30\x51\xcf\x61\xd8\x65\x2a\x1d\xfb\xd0\xf1\xfc\xaa\xe4\x32\x03\x04\xaa\xfe\xe7\x4c\xf3\x00\x8e\x00\xda\x99\xa9\x76\x87\xca\x7f\xd7\x74\xeb\x15\x93\x76\x5b\x5a\xb6\x91\xdd\x73\xc0\x13\x95\xa7\xde\x81\xdf\x20\xa1\x10\x09\x48\x88\x7d\x58\xa4\xbe\x6b\x93\x05\x5a\x81\xe8\x6f\x64\xe7\x80\x0a\x56\xb5\xd8\x9b\x70\x05\x36\x43\x03\x63\x2e\xaa\x24\x44\xd2\x6e\x1c\xc2\xeb\x0b\x56\x1a\x0c\x16\xa8\x38\xc1\xde\x4b\xc1\x13\xe8\x45\xdc\xd9\xdc\x84\x5e\x0e\x07\xee\xe6\x20\xb0\xfd\x67\x52\xc9\x90\xdb\xbf\xdd\xd6\x23\x12\xd7\x5c\x03\x01\x06\x2b\x54\xe1\xcd\xd4\xc9\xce\x26\x74\xd5\x4c\xf0\x4d\xd1\x9b\xb1\xe4\xa4\xd9\x07\x8f\x0c\x82\x55\x60\xe9\x28\x4f\x9e\x8c\xd9\x31\xae\x8e\x30\xac\x09\xab\x44\xfd\x7a\xaa\xdd\x7e\x06\xcf\x4f\x78\xba\x61\x6c\x86\x61\x02\xd5\x35\xeb\xcf\x35\x65\x27\x5f\x8d\x84\x8c\x83\x74\xae\xdf\x7b\xe1\x69\xd4\x84\x39\xfd\x68\x7a\xb9\xd2\xc2\xfe\xf7\x90\x8c\x2b\xb6\x2e\x41\xc7\xf8\xf2\x02\xe2\x58\xe2\xff\x27\x07\xcc\xaa\xb6\xbd\x75\x7d\xf5\x35\x8c\xe9\xdb\xeb\x17\x9a\x8c\x83\x47\x61\x13\x98\x68\x98\x31\xeb\xd7\xa2\x06\xcf\x27\x64\xbd\xbe\x3b\x65\x3a\x7f\x33\xe2\xc4\x7a\x9a\x93\xb1\x8b\xa7\x4b\xfd\x1e\x18\x7f\x04\x2e\xe1\x0d\xbc\x1c\xf0\xb2\x14\x9b\x82\x4c\x6f\x19\x27\x34\x08\x76\x06\x40\x98\xf6\xd8\xe4\x7c\xb6\x5f\x47\xca\x84\x7f\x38\xf8\x77\x77\x69\xb1\x61\xca\xd8\xaf\xec\x35\xf2\xcf\x27\xa1\x96\xaf\xb3\x84\x77\xf1\x13\x38\xb8\xab\x5c\x76\x36\x12\xa7\x2a\xa1\xc7\x1a\x5e\xce\xd3\xb0\x14\xf2\x35\xce\x71\x2c\x40\xf5\x9b\xb3\x46\x88\xe5\x4a\x26\xb0\x7f\x22\xa0\x96\x99\xec\x69\x0a\x73\xec\xbb\xef\x6d\x6e\xe7\x03\xf7\xec\x05\x5a\xf0\xd0\x83\x8f\xd1\x0c\xfe\x87\x9d\x76\x88\x6d\x8d\xac\x62\x24\x51\x2b\xf6\x83\x8a\xa3\x18\xf6\xb0\x6b\xe2\xbc\xed\xbc\xf5\x7f\x99\xc7\x74\x52\xfc\xc4\xcb\x67\xa5\x17\x78\xd2\xeb\x5f\x2d\xbe\x90\x96\xad\x2e\xd7\x53\x3e\x7a\x95\xdc\x32\xe7\x3a\x30\x9f\xd4\x45\xc6\x56\xd1\xcc\xf3\xb1\x67\x9d\x5b\xcb\x9c\x52\xca\x74\xae\xf4\x20\x78\x36\x66\x2f\xf3\x9c\xb8\x9f\x59\xab\x7e\x39\xd2\x2a\x2b\xf3\x82\xef\xa8\x69\xaa\xd5\xb1\x95\x2a\x60\x53\x7a\x82\x98\x94\xe1\x97\xf6\x98\xa8\xbf\x65\x19\x4e\xf5\x58\xe7\x6f\xec\xa3\x99\x62\x57\x32\xc7\x9b\x23\x2d\x16\xd2\x92\xd8\xa6\x99\xe0\x9f\xf9\x9f\xfe\x6c\x62\x09\xde\x08\x78\xa7\xf1\xf7\x63\x39\x9b\x6f\x4d\xf3\x5a\xf6\x75\x1a\xd3\x7f\x9a\x7a\xca\x87\x8e\x10\x06\x46\x88\xe6\xb7\xc4\xe9\xc4\x7d\x01\xcf\xc7\x90\x20\x1b\x28\xee\xe5\x51\x66\x77\x09\x48\x53\x58\xdf\xaa\x19\x12\x8c\x86\xf2\xa8\xaa\x3f\x17\x76\x5a\x68\xe9\x99\x64\x6f\x07\xf2\x7b\x4f\x9b\x85\xd1\x84\x51\x57\x2b\xe1\x63\xbc\xed\xcc\xbf\x74\xaf\xca\x70\x86\x89\xcd\x7d\xdf\x4b\x90\xbb\x3d\xc6\xfa\xea\x62\xcf\x29\xd0\xcf\x19\x87\xbd\xc4\x58\xc7\x3b\x9e\x75\x46\xfb\xaf\xb2\x84\x16\x5d\x20\x85\x63\x75\x03\x38\x8f\xc2\xbb\x3b\xc9\x26\xcf\x1f\x93\x4d\x6e\xc9\xef\x65\xc0\x2d\xc1\x8a\x3a\xd7\x4b\xe9\x22\x6e\xbe\x6d\x62\x7f\x51\x86\x19\xbe\xd4\x4b\xef\x34\x78\xc5\x3c\x2a\xc2\x73\x74\xd9\xdd\x4c\x2d\xca\x2a\xdf\x21\x5f\x9c\x56\x6b\xe1\xd6\xda\x9f\x78\x92\xc0\x0b\x0a\x6d\x69\x7f\x1e\x02\x63\x45\x20\x85\x5f\x1d\x0d\x77\x73\x52\x97\xd5\x5d\xe7\xb8\x3e\xbc\xd6\x38\x08\x38\xf0\x23\xe5\x25\xe4\xf1\xc4\xf1\xcd\xb5\xd0\x22\x6d\xca\xf5\x90\xdd\x50\x11\xc3\x50\x0f\x2f\xe9\x15\xd1\xf0\x76\xd5\xca\xfd\x3a\x95\xef\xcd\xfb\xc7\x9a\x69\x00\x72\xf6\x6c\xc9\xe7\xc1\x19\xf5\x84\x3c\xd3\xe9\x8f\x31\xfe\x46\xdd\x5e\x1d\xaa\x40\x12\xc6\xf8\x64\x82\x04\xd4\xe1\xc4\x46\xa9\x6a\x1f\xf7\xae\x96\x65\xb0\x4b\x3a\x52\xe1\x19\xd9\x18\x6d\xb5\x9b\xef\xfe\xdf\x74\x65\xca\xe1\xf1\x25\x92\x5e\x54\x7b\x5d\x84\xe6\x4d\xb1\xb1\x66\xb2\xc2\xf1\xf9\xc2\xdc\xc2\xcb\x2a\xe3\x10\x5e\xa0\x26\xe2\x95\x2d\x74\xfd\xf1\xe7\x8f\xbe\xaf\x29\xc0\x7e\xbb\x55\x9d\x9a\xfd\xba\x1b\x30\x55\x7f\xd8\x51\x3b\xb5\x66\xb3\x0d\x24\x51\x35\xe2\x37\x8f\xf0\xc9\xe0\xf3\xeb\x36\x2e\x1f\xfc\xbd\xfc\xe2\x3e\x7f\xe3\x54\x66\x32\xac\xe5\x4a\x64\x78\x24\x35\x27\x34\xb5\x76\x6a\xfd\xe2\xf5\x7a\xc5\x96\x67\xb2\xa3\x4d\xef\xb5\xe6\xca\x8f\x6e\x38\x19\x68\xb9\x95\x7a\x07\xaa\xbb\x38\x34\x2b\x59\x3c\x16\x60\x72\x66\x4d\x5d\x98\x11\xac\x0a\xba\xa8\xe9\x3e\x07\x81\xe1\xbb\x99\xba\xd0\xd1\xe1\xa6\x81\x71\x7b\xf2\x88\x0a\xc9\x82\xc5\x7c\x94\x57\x79\xc6\xd5\xd8\xd3\x4a\x2e\x54\x8f\x15\x74\x66\x4b\x25\xb0\xca\x75\xa5\x21\x87\xe7\xb1\x68\x8e\x0d\x08\x0f\x85\x3a\xed\x22\x1d\x6b\xf1\x73\x96\x71\xca\xb7\xa3\x25\xae\x36\x39\xf0\x35\x71\x19\xce\x91\xe5\xab\x7f\x97\xb1\x26\x10\xd7\x7b\x3a\x79\xe4\x7f\x5e\xaf\xfb\xa8\x34\x31\xa8\x0e\x46\x30\xc5\x35\x70\xb9\x04\xac\x24\x02\xe7\xa3\xdc\x6f\xda\x92\x37\xdd\xf5\xd8\x6a\xdd\xc4\x0c\x7b\x61\xd6\x0e\x3f\xe3\x9f\xe5\xa1\x53\x8a\x47\x53\xc3\x9f\x91\x45\x49\xe7\x5d\x4d\x0d\xdb\x9c\xe4\xce\xf2\xf6\xad\x6e\xdd\xd8\xf9\xe0\x13\x30\x22\x29\x42\xeb\x09\x97\xab\x03\xb7\x27\x60\x82\xb3\xd6\x07\x23\x03\x8e\xcd\x7b\x75\x3b\xc7\x31\x17\xbd\x0b\x44\x44\x54\x6a\x7f\xbf\x0b\x80\x7e\x72\x88\xa9\xe2\x53\x06\xf6\x48\xb7\xf7\xf4\xed\xca\x28\xd0\x68\xa0\xf4\xc0\xda\x41\x9d\x92\xcc\xf6\x4c\x77\xf1\xa0\xa2\xb1\xc6\x50\x97\xc2\xc4\x4b\x39\xa9\xfe\xe0\xf9\x3a\xf0\xc1\xa3\x8f\x92\xa2\x60\x81\x9c\x47\x19\xaa\x5a\x92\xc1\x2a\x24\xc7\xa2\xed\x2d\xf1\x98\x0c\x85\x98\xdf\xf7\xf7\xd8\xc4\x35\x47\x32\x55\xe7\x92\x85\x05\x48\xcf\x0e\x62\xb3\xa2\x44\xfd\x7a\x23\xe8\x78\x87\x32\xc5\x9b\xaf\xba\xc0\xb8\x98\x66\x54\xfd\xbc\xff\xc9\x90\x16\x87\xcb\x44\xa9\x5e\x8d\xb0\xf6\x4e\x9e\xfb\xf2\xbc\x0f\x01\x9c\xb3\x32\x9e\xbf\xc5\x8b\xb8\xc9\xae\x9c\xa7\x01\xe7\x83\x75\xa0\x9b\x94\xed\xcf\x3b\x3b\xcf\x20\x40\x5d\xf7\x39\xf5\xa1\xad\x8b\xda\x00\xc4\x93\xe7\xb2\x63\x92\x87\x29\x73\x9e\x00\xfe\xde\x81\xee\x33\x2d\x58\xf4\xde\xa0\xcd\xb8\xc9\xed\xe5\xe9\x31\xc2\x58\xd8\x88\x38\x29\xef\xc6\x90\x21\x61\x21\xd4\x96\x99\x8a\xdf\xf5\x17\xa9\xad\xac\x12\x2f\xd9\x77\xca\x18\xe3\xb8\x52\x95\x83\x8f\x68\xdf\x6d\x08\xc7\x1d\x65\x29\x6e\x98\xda\xd2\xb0\x83\xcb\x15\x7b\x8e\x0b\x4a\x22\xd7\x2c\x37\xe4\x48\x3e\x3c\x81\x22\x9c\xa1\x94\x7b\xfa\x87\x62\x02\xf0\xe2\x60\x1d\x36\xca\x91\x2a\x43\x7a\xc6\x40\xec\xd1\x81\x62\x8b\xdd\x00\x77\xfd\x5c\xcc\x73\xc8\xf9\x62\x20\xfe\xeb\x73\x63\x1d\x43\x97\x1e\xb1\xbc\xc0\xc8\x38\x59\xd7\x88\xc9\x5f\x64\xff\xfc\x5d\x01\x4d\xf8\xfc\xcf\xc0\x0b\x5e\x17\x8d\x10\x72\xb0\xa8\x3f\x07\x0c\x42\x44\xa1\xe7\x18\x72\x09\xdd\x62\xf9\x6a\xe5\xa3\xcf\x7a\xcb\xc7\x7b\x75\xf2\x0b\xb5\xa6\x6c\xa6\x82\xdc\x88\x37\xa4\xc2\x7c\x66\x8b\x9c\x3b\x5c\xd5\x60\xc7\x2f\x22\x29\xfc\xd2\x02\x5d\x0a\xbb\x95\x7c\x69\xff\xb2\xa2\x60\x7d\xca\x3a\x4a\xc5\xeb\xfc\x73\x51\x2a\x77\x3c\x43\x86\xeb\xcf\x67\x13\x27\x23\xb0\x91\x7e\xea\xf4\xb7\x02\x30\xa9\xbc\xa4\xbd\xe0\xa3\xa4\x3d\x09\x21\xa8\xbb\xc5\x7c\xd5\x9e\xb9\x46\xdd\x04\x99\x78\xd7\x70\x3a\xe0\x8d\xe9\x57\x21\xcb\xc6\xf1\xaf\x00\x6a\x19\xd3\xae\xdd\x17\xb7\x3d\x17\x03\xce\xf3\xcc\x45\x6f\x30\x60\xbc\x6e\x1e\x87\xeb\xeb\x5d\x27\x55\x67\x86\xb9\xb2\xf0\x60\x20\x4d\xa5\xca\x08\x57\xee\x97\x28\x5e\x5f\x4b\x5f\xf7\xe5\xbd\x54\x6f\x0b\xf3\x68\xc3\xdc\xcb\x84\xaa\x8c\xef\x5d\x94\xa2\x9a\x22\x22\xdf\xcc\x1b\x7a\x1e\x1a\x68\x66\x78\xbe\xd1\x18\x6d\x3c\xfe\xc9\x59\xc2\x59\xde\xb0\xb2\xd6\x70\xbd\xdf\x0c\x7f\xc7\x24\x5f\xa9\x24\x04\x59\x67\x9b\xee\xac\x19\xe7\xea\x67\x12\x55\x05\x49\x83\x24\x32\x47\xa2\xe2\x81\x41\xb9\xd1\xe4\x6a\xbc\xb3\xf8\x19\x4f\xb4\x03\xac\x15\x77\xb5\x73\x6e\x6e\x28\x4e\x8f\xaa\x40\x9c\x96\xd8\xa8\xf7\x5c\x3b\xca\xca\x9b\xbf\x8c\x69\x60\x95\x7a\x13\xf1\x21\xa6\xbb\x5e\x4b\x94\x15\xb5\xde\xea\xc5\xd5\x54\xc7\xdb\xbc\x5b\xcd\x61\x43\x0a\x4e\x4b\xa1\x93\xff\xed\x94\x06\x91\x2c\xad\xd1\xde\xcb\x4d\x67\xc5\xe1\xb6\xc6\x0b\x78\xff\x30\xd4\xc5\x31\x28\x9e\x77\xcd\xf5\x0b\x4a\x01\xfa\x04\x5f\xcf\x65\x2d\xdd\x95\x2c\xc9\xc5\x53\x89\x2c\xdb\x4e\xa5\xdf\x64\x16\x44\x06\x28\xf6\xa4\x3e\x3f\x9b\x41\xb4\x67\x75\x98\x32\x77\x5e\xd7\x6a\x3e\x97\xd3\xf7\xf2\x29\x26\xf4\x34\x8d\x2e\x87\x67\x29\x46\x71\xf7\x77\xf3\x03\x6f\x0f\xe3\x76\x2d\xf3\xda\xee\xc7\xe1\x13\xd4\x0c\x0c\x6d\x10\xa6\xb6\x8e\x28\x4b\xe3\x90\x0c\x70\xfd\xcc\x7d\xf5\xfb\x4f\xa4\xb6\x3d\xc3\x5e\x3f\x95\x77\x9e\x9b\xfc\x4c\x76\x96\xbe\xc3\xe6\xa9\xf8\x00\x33\x84\xc4\x96\xc5\xf1\x60\xac\xac\x87\xd3\xf5\x62\xb9\xd6\x3c\x7b\x2a\x7c\x2a\x73\x8c\x6c\xf3\x12\x3e\x7d\x72\xd7\xda\x68\xf8\xf1\x79\x20\x6d\xce\x6d\x44\x7c\x82\xd1\x6b\x4e\xed\x1e\x05\x85\x66\xd1\x59\x6c\xae\xc0\x88\xa6\xc7\x66\x93\x15\x4a\xa5\xba\x03\x5b\xa3\x24\x73\xe3\x80\xe3\xd5\x22\xa0\x72\x62\x15\x24\xa0\xac\x58\xc1\x67\xb6\x90\x7e\x40\x9c\xb3\x4a\x3b\x04\x53\x37\x9d\x28\xff\xaf\x88\x69\xa0\x58\x7d\x9e\xa8\x40\x00\xc8\x12\x64\xca\x43\x1a\x0a\xde\x69\xcb\xe0\x39\xd0\x3a\x69\x69\x05\x59\xf9\x3c\xaa\x56\x82\x60\x5b\x8d\xd9\xda\x3e\x50\x40\x74\x6d\xe6\xac\x33\xd1\xf3\x82\x29\xaa\xbb\xfc\xd0\x64\xd5\x3a\x18\xa8\x30\x18\x48\xb6\x9a\x43\xd6\x1b\x2e\x25\x4a\xd3\x51\xe4\xb3\xc0\x14\x7e\xdc\xf8\x36\x31\xc3\xc1\xbf\xf4\xcc\xae\x45\xe7\x4b\xe7\xa1\xc7\x54\x09\x2f\x4f\xca\x07\xe5\x60\xdc\x9d\x88\x09\xfa\x3c\x98\xdb\x81\x6e\xd2\x6d\xe2\x3a\x35\xcf\xb1\xdd\x69\x85\xca\x50\x4c\x28\x02\x6e\x0f\x91\x84\xbc\x86\x10\xe5\x71\xc8\xba\xfe\x2e\x61\x24\x0f\x27\x0e\x7e\x22\x25\xa0\x1b\x92\x71\xbc\xda\x3d\xa8\x3f\xd8\xe7\xbc\x07\xdc\x87\xcc\x03\x47\xac\x8c\x0b\xc7\xaa\x30\xf9\x48\xd4\x70\x74\x86\x50\x38\x31\x9c\xb9\xd3\x82\xeb\x56\xa7\x43\xc8\xb5\x0f\x63\x17\xf2\xb0\xaa\x34\x22\xfa\x43\xc5\x14\xdc\x44\xb6\xf1\xad\x45\x9a\x19\x6e\xc0\xb3\xfb\xd8\x94\x2f\x33\x2b\x94\xcb\xed\x08\xd2\x68\x9e\x61\xf1\x51\x51\x94\xc9\x8a\x91\xf0\x30\xfa\x5c\x26\xed\xc8\x6c\xc7\xba\x21\xd9\x13\x3d\xa4\x66\x1d\x49\x62\xa3\xfd\x81\x3d\xcb\x9e\xfd\x41\x44\x9c\x47\x9f\x6b\xcd\x9e\x6b\x9a\x4b\xd4\xc4\x15\x43\xdb\xfe\x85\xab\xc6\xa4\x91\xe3\xec\x83\xe4\x69\x80\xc5\xa9\x6c\xca\x34\xf9\xf0\xbc\x0c\x2d\x81\x16\x0b\x7a\x49\x25\xba\xaa\x31\xba\x63\x75\x8b\xd5\x5d\x36\x1b\x3a\x9e\x75\x73\x8e\x36\x36\xc9\x05\x91\x4d\xcb\xba\xdf\x2e\x90\xf6\x18\xa5\x78\xb4\x95\xee\xee\x80\xca\xc3\xc0\xf5\x14\xf6\x13\xc5\x95\x1f\x94\xe9\x19\xbe\xf0\xe3\x9f\x31\x1b\xff\x58\xa5\xfc\x3c\x5e\x2f\xe7\xb7\xe3\x92\x0e\xa8\xa4\xc0\x34\x32\xd2\xc0\x3e\x4d\xd3\xe4\x8c\x4e\xc7\xaf\x99\x65\x4c\x54\xa4\x61\xd1\x35\xda\xeb\x05\x69\x0e\x35\xa3\xfe\x02\xa1\x56\x33\x03\x49\xc1\x9e\xf5\x5e\x93\x06\x74\xd0\x2e\x04\xb7\xe8\xc9\x74\x21\xff\xa2\x0c\xff\x96\xe5\xfc\xcb\xc7\x15\xe7\xcf\xf8\xd8\x00\x98\xcb\x4e\x21\xb6\xbb\x9b\x32\x89\xc2\xc1\xd5\x43\x89\xcb\xc8\xba\x20\x2b\x39\x83\x4f\x16\x8d\xfe\xa7\xa2\x6f\x1d\x85\xc8\xe7\x14\xe3\x7d\x38\x45\x3b\x91\xd3\x55\x2c\xab\x6d\x02\xab\x08\x1c\x38\xda\xea\xa7\x25\x7d\x5d\xf5\x4d\xbc\x59\x17\x34\x72\x34\xa3\x27\x8f\x42\x34\xc3\x5e\x2f\x51\x60\xd7\x5d\x6c\xc8\x58\x67\xbd\x82\x22\x4d\xdc\x29\xf7\x7a\x00\xcb\xcf\x85\xe1\xe8\x8a\x35\x46\xef\x1e\x5d\xb9\x08\xfa\xa9\xc0\xf6\x79\x4f\x8c\x3e\xdd\x24\x7f\xc2\x68\x66\xf3\x02\xd6\x94\xc7\x93\x62\x45\xd5\x49\x1e\xe8\x3e\x05\x5e\xa4\xb3\x77\x02\xee\xe1\xe7\xd8\x45\x61\x9f\x58\xbd\x33\xff\xfb\x42\x95\xff\xe4\x3f\xf9\x4f\xfe\x93\xff\xe4\x3f\xf9\x4f\xfe\x93\xff\xdb\x92\x1d\xdb\x81\xa7\x3a\x9d\xc2\xb8\x19\xb4\xed\x2f\x12\x11\x7e\xe0\x6f\x54\x64\xca\x5f\x9b\x86\xfc\x3f\x01\x00\x00\xff\xff\xd9\x58\x60\x8e\xa3\x4f\x00\x00")
+
+func imagesLgtm_maskPngBytes() ([]byte, error) {
+	return bindataRead(
+		_imagesLgtm_maskPng,
+		"images/lgtm_mask.png",
+	)
+}
+
+func imagesLgtm_maskPng() (*asset, error) {
+	bytes, err := imagesLgtm_maskPngBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "images/lgtm_mask.png", size: 20387, mode: os.FileMode(420), modTime: time.Unix(1459959922, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _imagesLgtm_outlinePng = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xed\x7b\x77\x34\x9c\xdd\xdb\xee\x33\x33\x7a\x2f\xd1\x3b\x83\xe8\x11\x25\x7a\x8b\x36\x04\x29\x6a\x08\x23\x18\x51\xa2\x45\xaf\x23\x91\x10\x42\x18\x51\x23\x08\x92\xe8\x84\xe8\x6d\x42\x10\x31\x08\x89\x20\xc4\x10\xbd\xf7\xce\xcc\x19\x79\xdf\xf7\x9c\xef\xfc\xfb\xfd\x71\x7e\xdf\x59\xeb\x5d\x7b\xaf\xe7\x59\x7b\xd6\xde\xf7\xbe\xef\xeb\xba\xef\x6b\x9e\x35\x7b\x9e\xa7\xd7\x8d\xf5\xa8\x29\xd8\x29\x00\x00\xa0\x86\xe9\x6b\xdf\x04\x00\x30\x12\x00\x40\x53\x64\x24\x84\x4f\xe4\x06\x27\x66\x01\x40\xad\x02\xa6\xad\x69\x1a\x98\xb5\xfa\x03\x44\x0d\x00\x54\xe0\xdc\x8d\xb3\xab\xd6\x68\x12\x2d\x0a\x1c\x00\xa4\xf3\x23\xc1\x17\xff\xed\xff\xaf\xfa\x28\x84\xd5\x7f\x05\x4e\x60\x06\x88\x4c\x6f\xf3\x7b\x7e\xc9\x83\x19\xa2\x01\x10\xfd\xdb\xff\x23\x1d\xad\x00\xd0\xfe\x8b\xc2\x7f\xae\x3f\x83\x6c\xfe\x8b\xc2\x7f\x36\xfd\xd1\xef\x1d\x48\x21\x04\x35\x22\xfb\x74\x5a\x16\x12\xe5\xfd\x8d\x15\x04\x45\x12\x25\x02\x0c\x1a\x60\x7d\x80\x9c\xf7\xdf\xc1\xff\xa0\x01\xba\x1e\xb0\xfd\x17\x91\xff\xb9\x83\x2e\xe2\x4d\xb0\xc2\x7e\xbf\xe0\xf7\x2f\xc5\xf1\x33\xf8\xee\x8d\x9d\x13\xd9\xea\x26\xb9\xcd\x27\x69\xb1\x1a\xef\x3f\xf0\xc7\x51\x50\xdd\x74\xbd\xe0\x1b\xf9\xe5\x06\xa3\xdb\x4d\x6a\x4e\x76\xcd\x64\x03\x8a\x57\x2f\x20\x2e\x89\xba\x16\x5e\xd7\x75\x75\xf5\x05\xef\x90\x7d\x6b\xd7\x28\xcc\xb7\xff\x89\x0e\xb9\xf2\xed\xc3\x69\x77\xec\xc2\xd3\xba\xce\xa6\x00\x67\x9e\x83\xb1\x93\x30\xec\x19\x5e\x55\x7c\xff\xb6\x5b\xc5\xcc\xd8\xea\x4e\xf0\xfe\x46\xa9\x73\xd9\x9e\x01\x20\x88\x64\xd0\x20\xcf\x25\xba\x0e\xbe\x08\x7a\x01\xfc\x3f\x1b\xd2\x98\x00\x78\x0e\x26\x3e\x6d\x3f\x3e\x45\x1a\xf4\x63\x40\x72\xf3\x66\x69\x0e\x04\x2e\x8e\xc4\x07\x89\x94\x30\xa2\x61\x40\x9b\xd3\x73\xa7\xb0\xad\x40\x4e\xb4\x3d\x10\x9d\x97\x3e\xf6\x3e\xd0\x48\x8b\x8c\x6f\x39\x4e\x47\x87\x6c\x50\x5d\x5f\x28\xe9\x25\x39\x5c\x77\x5a\x7a\x39\x19\xf4\xec\xf9\xfb\xb0\x79\x22\xe4\x23\x1d\x11\xf1\x17\xdf\xdb\x78\xd0\xe5\xa6\x98\xd5\xb4\x43\xfe\xff\x7b\x5b\x74\x24\xb3\x06\xea\x1b\x60\x73\xe7\xe2\x4e\x98\x6e\x85\x8b\x37\x80\xbe\x4e\xf6\x3a\x8d\x2f\xad\xe3\xd1\x7f\xf1\x68\xdf\x14\x80\x19\x93\x5e\x12\xf9\xa9\x7a\xb7\x2d\x63\x2d\xfe\x09\x2b\xa7\x7e\x3f\xc8\x4b\x19\xf9\xf2\xce\xa1\xd0\xde\x42\x8a\xa9\x68\xb8\x3f\xdf\x29\x09\xda\x1f\xb0\x06\x85\x7a\x20\xf4\xee\xa2\xef\x69\x6d\x53\x00\x8c\x79\x12\xae\x9d\xfd\x1e\x92\x3a\xbc\x8a\x1c\xe8\xa0\xc1\x72\xba\x77\x7d\xee\xd6\xb2\x99\x64\x3d\x86\xa0\x2c\x8e\xb5\xb7\x36\xb7\x18\xd0\xb1\xe4\x70\xa1\xb0\x5e\x40\x65\x6a\xcf\xb6\xd3\x15\x58\x11\x7c\xd4\x97\xa6\x89\x72\x06\x59\xce\x0e\x05\x70\x20\x5d\xc6\xe8\x06\x33\x3d\xef\x90\xe0\x19\xb4\xd2\xda\x79\x7e\xf7\x48\x1a\x60\xd9\x01\xca\xe6\xf2\x42\x0b\x71\xa2\xcd\x93\x8c\x50\x2f\xe7\xa1\x83\x7a\xbc\x5d\xd9\x5d\x9b\x6f\xb2\x6c\x98\x02\x4f\x08\x52\xc4\xb6\x62\x4f\x4e\x10\x54\x5a\x08\x34\x9e\x96\x2d\x3e\xda\xad\x89\x85\x3e\x1a\xd0\x07\xbf\x14\x88\x24\x5e\x6a\x62\x41\x73\x01\x5d\x4b\x77\x56\x77\xef\x88\x53\x1f\x5f\xb3\x27\x9b\x56\x92\x19\x57\xd7\x3a\xb2\x16\x5e\x2a\x29\x23\xcc\xdc\xfd\xd8\x92\x9f\x4c\xeb\x9e\xd6\x66\xc6\x94\xda\x6a\xfe\x3e\x77\x9d\x61\x71\xbb\xae\x81\x7f\x68\xa4\xc6\xcd\x00\x6c\x79\x03\x54\x9a\x7b\x0a\x03\x73\x40\x23\xa1\x9e\x44\x9b\x70\xda\x82\x8d\xa8\x8d\xcf\xaa\xbf\xdf\x58\xe2\xc9\xd1\x22\xd8\x86\x90\x2a\x9d\x5f\xa1\x57\x36\xf1\xd5\xdd\x55\xad\x81\x9c\x1b\x6e\x8c\x52\xa2\x2f\xc2\xdf\x19\x45\x2b\xa5\x68\xf6\xd8\x39\xcc\x55\xcc\xd0\xae\xb2\xf7\xda\xad\x09\x56\x0a\x6d\x8f\x6c\x9d\x7b\x58\x0b\x94\x97\xe5\x9e\xea\x94\xca\x57\xeb\xe5\xf1\x2d\x83\x7a\x9e\x02\xa9\x8d\x87\x7d\x3d\xd9\xdc\x22\xb0\x59\xff\x10\x0a\x59\xee\x7c\x5c\x4b\x06\xd7\x40\xd7\xc1\x8e\x42\x26\x8c\x98\x4f\x3c\xf5\x7b\x04\x39\xd2\xca\xb6\x3f\xa1\x82\x43\x30\x95\x56\x0f\x0c\xf3\x48\x39\x95\x33\xc7\x3d\x7c\x8c\xd8\x77\x91\xbd\xad\xb9\x5d\x46\x30\xff\x49\x5c\x66\xfc\xd5\x9e\x19\x37\x2a\xc4\x8d\x3f\x82\xeb\xce\x2d\x25\xd2\xeb\x0b\xc0\x32\x27\x1a\xa4\x40\x9a\x38\x6b\x03\xd0\x82\xea\xf1\x54\x73\xf3\xca\x39\x9d\xb1\x55\x7d\x18\x1a\x67\x07\xd1\xa7\x19\x6f\x32\x3e\xb7\xd8\xfe\xd4\xda\xbe\x0b\x54\x53\x6a\x29\x94\x7c\x5d\xef\xd2\x4d\x4f\xc1\x85\x3d\x91\x63\x2c\xbc\x2e\x0b\x65\x86\x04\xaa\xb1\xfb\x21\x67\x9b\x33\x7b\x72\x68\x1e\x96\x9f\x68\x7e\xcd\xc6\x49\xc4\xa9\x86\x23\xac\xa4\x04\xfa\x9e\x5d\x8b\xd7\xd5\xf0\x29\x1e\x42\x0f\x1b\x43\x92\xaf\x45\xd4\xa8\x2d\xad\x9c\xe8\x5e\x76\xc3\x31\x21\xe3\x01\x35\xfd\xf6\x2b\x17\xf5\x72\xd3\xc7\x40\xea\x14\xf3\x33\x8b\xbd\x88\x1b\xc9\x64\x6f\xef\x20\x2d\x8e\xd6\x17\x89\xc3\x2b\xfd\x84\x4b\x77\x0f\x0e\x5e\x2d\x5e\x84\xe1\x53\x3b\x8d\x81\x78\xe3\x0d\x59\x05\x83\x00\xaf\xe4\xac\xaf\x92\x4e\x0b\x4b\xd8\x2a\xfd\x5e\x8a\xc6\xaa\xc1\xc2\xde\x24\x8f\x61\xa5\xb6\x59\xf3\x32\xc3\x65\x21\x64\x2b\x5b\xb9\xf8\x90\x08\xa0\xae\x9b\xef\x31\xf2\xa2\xf0\x12\x74\xa4\x2a\x2b\xfb\x5d\x14\x4c\x8d\x13\xab\xe2\x60\x26\x6f\x6a\x4a\x84\x9a\x81\x95\xf3\x06\x89\x87\x5b\x76\x40\xe3\x23\x92\x67\x2f\xab\xef\x98\x48\x63\x78\x15\x1f\xcd\xde\x81\xe6\xf0\x1f\x71\xf7\xe4\x6f\xbd\xad\xb5\x87\xed\x0f\x9c\x1a\x19\xe0\xbb\x72\xa9\xde\x44\xb9\x65\x32\x08\x1d\xc1\x4e\xe9\x64\xca\xe3\xa4\x9d\x0a\x76\x3d\xa9\x30\x85\xee\x06\xe9\xb3\xe5\x81\x49\x6c\x32\xd5\xaf\x53\x84\xde\x44\xcc\x3c\x76\xbe\x56\xb4\x23\xf7\x86\x0d\x4d\x31\x00\x88\x5e\x10\xa0\x46\x05\x40\x02\xd9\xd1\xbb\xfb\x02\x78\x7a\xeb\x53\xa9\x1d\xea\x6c\x51\x33\x5f\x56\x04\xb3\x1b\x8f\xdd\x41\xce\xa3\xa3\x6b\xf6\x18\x3a\xc9\xbd\xb1\x5d\xfe\x07\xfa\x1e\x16\xec\x96\x2e\x53\x24\x6f\x23\xaf\x1c\xdd\x9e\xf9\xf9\x36\x72\xbc\x20\xa2\x4d\xb6\x3a\x6c\x90\xae\xc5\xf9\xda\x0f\xe6\xbb\xb9\x7c\x41\x6c\x3d\xb4\xa0\x66\xa5\x6b\x47\x86\xa4\xec\xea\xa4\xf0\xf2\x8c\x5f\x8e\x08\x29\x43\x9c\xae\x50\x84\x53\x3a\x5b\x9f\xfb\x67\x8c\xad\x74\xf5\x5c\xf8\x3d\xdf\x7e\x03\x13\x01\xca\x41\x59\x83\x34\xf9\x40\xf6\xfa\xf5\x25\x6c\x5d\x7e\x9e\x1c\xf2\x36\x64\x74\x4f\x0e\x49\xa1\x23\xac\xb9\x6d\x0c\x48\x01\xe9\xe9\xaa\x66\x5d\x34\x2c\x6d\x7b\x8d\x0b\x65\xeb\x41\x25\x21\x93\xcb\xd9\x75\xf9\xbe\x03\xe3\x7d\xe1\x2f\xbe\x8d\x2e\x7a\x31\x27\x71\xba\x21\xde\x55\xe1\x44\x87\x44\x3b\x5b\xdf\x63\x45\xfc\xfb\x00\x57\x92\xeb\x32\x48\x88\x1a\x24\x3d\x3c\x09\x15\xfa\xe3\x5b\x02\x5e\x28\xe2\x24\x94\x65\x53\x45\xc6\x5a\xe0\x28\xf9\x14\x86\x83\x46\xe4\x43\x23\x36\xb1\x52\x3c\x3a\x4d\x1a\x4a\x77\x97\x9e\xdc\x33\x65\x42\x2d\x40\x2e\x5a\xeb\x05\x5c\xd0\x48\x49\xb0\x74\xa9\x69\x78\x7e\x5f\xfe\x00\xf6\x47\x3e\x94\x28\x79\x7b\x7a\x80\x49\xd2\xcd\x7b\xbb\x35\xbc\xaf\x3a\x0e\x88\x90\xbd\x7d\xab\x6f\x5e\x64\x3e\x13\x8e\xa2\xab\x41\x72\x85\x1f\x5a\x3c\x36\xb2\x52\xb6\xcc\xaa\x29\xd1\x0f\x8e\xc7\x3f\xd5\x8d\x34\x42\xec\xeb\xc9\xca\x42\x0a\xd9\x34\xce\x1d\xa9\x7c\x27\x83\x84\x7a\x6e\xc9\x39\x8d\xe8\xd9\x51\xda\x1a\x76\x13\xa5\x8f\x39\x20\xf6\xdd\x7c\x29\xf8\xb4\x69\x0b\xab\x01\xd1\x33\xeb\xc1\xdd\x0a\x82\x0a\x29\x54\x82\xf5\xb7\xd5\xf0\x9d\x3f\xd7\x35\x7e\x57\x80\x0a\xe2\xb9\x0e\xd2\xc7\xb8\xef\xaf\x2e\xf6\x77\xd1\xbc\x71\x55\x32\x19\x8e\x89\x9c\xa7\x04\x9c\x94\x27\x2a\xa0\x19\x1b\x31\x15\x54\xd0\xee\x0f\xa3\xa5\x3e\x8d\x7d\xf3\xcc\x7f\x6b\x18\xa7\x2a\x08\x9a\xf1\x63\xb3\x65\x94\x5f\x8e\x7e\x4b\x57\x29\x6b\x14\x53\xeb\x78\xa6\x45\x36\x28\x13\xda\xd0\xd3\x34\x28\x99\x78\x73\xa4\x4e\x37\xff\xa1\xcd\x4a\x5d\x0c\x66\x46\x46\x46\xf9\x02\x35\x61\x5d\xe5\x24\xf8\x50\x38\xc4\x99\xd9\xcd\xf9\x8e\xce\x56\x0d\xdd\x9b\xa1\x51\x06\x28\xbf\x66\x25\x91\xe9\xc3\x1f\x85\x82\x37\xae\x7a\x03\x94\xf4\xa4\x59\x8b\x8f\x84\x98\xa3\x49\x8b\x62\x2f\x0a\xb5\xea\x6b\x3d\x94\x52\x83\x2f\xa3\x13\x63\x62\xbd\x03\x81\xfc\xa4\x8b\xe6\xa2\x0f\x91\x14\x30\x6b\x46\xf2\x1a\x85\x4f\x06\xf7\xb3\xf8\x93\x5e\x13\xdf\xed\xbd\x3c\xbe\xaa\x7e\x74\xba\x1d\xb6\x60\xbb\xd1\xb2\x10\xde\x61\xe5\x76\x90\x13\xb2\x8f\xe7\xcc\x1c\x9a\x9c\xd8\x51\x3e\x69\xdb\xc7\x9d\x0c\xc2\xb9\x61\xb1\x8a\x3e\x76\x39\x9b\xd3\xc2\x8d\xcb\x6a\x9a\xdb\x60\xd4\xf3\xdd\x0f\x25\xfd\x55\xa5\x63\x43\x74\xdd\x1f\x03\x4f\x0d\x4d\xba\xdb\x02\xa9\x9e\x75\xa3\x31\x13\xac\xac\xb0\x97\x3e\x18\x43\x8e\xd3\x35\x48\x65\xd2\xac\xd4\xed\xec\x3a\xf1\xde\x65\xad\xba\xd9\x41\x97\x33\xe7\x11\x45\xef\xef\x06\xdb\x3d\x4f\xf2\xcd\x4f\xf0\xab\x78\xb7\x3d\x7c\x03\x78\x91\x62\x0a\x63\x0a\x08\xde\x51\x20\x3d\xfd\xc5\xde\x11\x45\x1d\xf1\x44\xbd\x23\x67\xba\xc2\x79\x33\x5a\xe5\xf2\x2f\xab\xed\xd8\x04\x97\x06\xa9\xfa\x6f\x89\xbb\x13\xa9\xd3\xab\x11\xf7\xd9\xe6\x3d\x00\x59\x80\x1d\xa0\x46\x1a\xd2\x22\xc9\x23\xec\x32\x36\xcf\x70\x12\xc0\x02\xea\x54\x6f\xac\x92\xb0\x4d\xc6\x93\xef\xd4\xa1\xb6\x86\x1d\x3f\xfa\xb8\x5f\x0f\xe7\xd0\xdf\x8e\x66\xc3\x51\x45\xc8\xd0\x44\x60\x89\x36\x6d\x07\x0a\x96\xc3\x85\x52\xc2\x90\xfb\xa0\x9f\xa4\x0a\xec\x3c\xb9\x67\x11\xaa\x07\x78\x36\x82\xa5\x7c\x12\x0d\x18\xc7\x02\xb2\xcd\x13\x18\xfd\x7e\x0b\xff\xac\x63\xda\xcb\x54\xc0\xa3\x3f\xb0\x10\xca\xd4\x62\x30\xb4\x8c\xf2\x2d\xab\xb7\x22\x47\x3f\x41\x59\x5c\xa0\xf6\x1f\x37\xf8\x2d\x44\x77\x25\xb2\x9e\xfc\x93\x11\xef\x2d\x17\x70\xd2\x07\x7f\x9a\x01\x3b\x5f\x70\x5b\x84\xf8\xa6\xf0\xd2\xe7\x5d\x88\x6a\x89\x93\xaa\x8c\xa7\xcc\x58\xce\x8a\x46\xc7\x08\x29\x0c\x57\x72\x9a\x9d\xea\xb4\xa7\x07\xa5\x35\xa0\xa8\xd8\x56\xfe\x62\x2b\x3a\xd9\xed\x50\x78\x16\xd0\xab\xdb\x7d\x9b\x16\x6e\x06\x13\x6d\x8c\x9b\xed\x10\x94\xfe\x13\x0f\x09\x5a\x90\x54\x03\xa6\x07\x3a\xe4\x46\xa6\x38\x55\xbc\x5d\x01\x85\xc2\x63\x36\xd3\x72\x03\x44\x91\x2c\x23\x3f\x32\x99\x07\xfa\xf0\x4c\x28\x6e\xe2\x4d\x00\xd9\x72\xdf\x0e\xa6\x76\xc3\xd6\xd3\xea\x47\xd5\x72\x37\x35\x60\x19\x8a\x0c\x03\x69\x04\xe0\xf7\x9b\x4f\x46\x80\x7b\x00\xa7\x20\x30\xbf\x7b\x74\x6f\x59\xa1\x61\x75\xd8\xb7\x23\x71\xca\xe4\x26\x6b\xd1\x08\x28\xd0\xad\xbc\x86\xb1\x38\xd3\xf0\x75\xbd\x23\xee\x83\x8d\xd1\x56\x41\x01\x16\x74\xe8\xe7\x97\x34\x71\xcb\x16\xce\x93\x7b\x8e\x49\xff\x07\xda\xaf\xb8\x36\xd0\x21\x9e\x8a\xe0\xc9\x20\x88\x17\xd9\x2b\x7c\x0a\x34\x06\x90\x75\xb7\xa2\x12\x00\x2f\x73\x30\xd6\x1a\xe0\xb4\xcb\x5e\x89\x04\xab\xd9\xbd\x58\x79\x58\x25\xb2\x34\x2d\x4a\x37\xbf\x67\xa6\x0c\xc3\x35\xf9\x87\xa5\x38\x59\x51\x5e\xe8\x21\x85\xae\xf5\xdf\x42\xba\x66\x5d\x5c\x32\x45\x35\xb9\x9d\xf4\x8a\xc2\x84\x3b\x5b\x96\x04\x2b\x16\x9f\x43\x23\x6e\x31\x40\x72\x41\x5f\x09\xfe\xd9\xdc\x04\x5e\xf8\xaa\x21\x4d\xd2\x9a\xdf\x6e\xd6\xb6\xbc\x9a\xc5\xb5\x06\x8f\x43\xb9\xa8\xa7\xf2\x01\x49\x12\xaf\x5d\x74\xf3\xfd\x92\xa1\x93\xea\xd6\xdf\x67\x41\xd7\x86\x54\x81\x75\xcc\x9e\x21\xea\x79\xaa\x70\x9a\x08\x0d\x58\xe1\xbb\xd7\x1a\x76\x1a\x25\x74\x87\x78\xb4\xa1\x71\xf1\xda\x00\x85\x52\xda\x62\xdd\xcb\xd4\x4c\x5c\x68\x34\x62\x51\x6d\xb1\x8c\x80\x44\x31\xb2\xe9\xce\xd9\x83\x3d\x4f\xb5\x61\x75\xc2\x6e\x5e\xb2\xc0\xc5\xa5\xa0\xa0\x36\xcf\x6f\x2f\xaf\x72\x1e\xb4\x46\xcf\xf2\x7c\xd1\xcd\x81\x2e\xd5\xd7\x87\xd3\xa0\xa3\xb7\xc9\xfc\xc5\xe9\xe3\x85\x2b\x7a\x99\xda\x68\x60\xc7\x66\xe9\x1f\x19\xc9\x3a\x2e\xe0\x0e\x42\x3d\x44\x35\x2f\x2b\x41\xeb\x16\xa4\x83\xe5\xd6\xcb\xe7\x5a\x92\x0b\xe4\xa0\x78\xd3\x6d\xa7\xa1\x03\x50\x73\x03\x14\x32\x14\x8c\x3a\xf8\xbe\xdf\x55\xbc\x09\xb2\xbd\x89\x8a\x6d\x27\xad\x7f\x03\xdd\xc7\x8f\xe1\xaf\xf4\xe0\x9d\x09\x59\x99\x40\x02\x80\x42\x5b\xdf\xec\x88\x87\xdf\x31\xae\x0a\xc8\xd1\x10\xe4\xca\x61\xea\x3d\x00\x29\xe4\x1d\x99\xed\x24\x2c\xfe\x8e\x1f\x07\x73\xbb\x58\x3d\x17\x4c\x2c\xc3\xf8\x39\x76\x8c\x14\x2a\x71\xcb\x75\x16\x3b\x98\x4e\x18\x0f\xf8\xc1\x70\x5c\x13\x39\x75\x95\x9f\xda\x06\xee\x43\x69\x77\xe7\xb0\xdd\xd3\x8b\x2e\xa7\x54\xe8\x19\xc0\xfc\x45\xfc\x77\x37\xc1\x32\xde\xa3\xc0\xfb\x11\x56\x50\x11\xb5\xcd\x96\xd6\x59\xa6\xce\x81\xec\x71\x83\xbb\xff\x94\x01\x09\x12\x35\xf9\x7d\x88\xcd\x42\x94\x3d\x47\x04\x66\x07\x5d\xfa\x05\x94\xdf\x06\xbc\xb8\x3e\x3b\x64\x9f\xb8\xba\x0d\xdc\xbc\x36\x9a\x76\xc1\x88\x57\x18\xac\x60\xdf\x3e\xd2\xd9\xee\x6a\xd6\x69\x52\xaf\x38\xf9\x1d\xfa\x2b\xfb\x52\xe0\x0e\x83\xb9\xfb\x78\x42\x64\x99\xf7\xbd\xd4\x84\x88\x99\xdb\xf5\x95\xe6\x13\xa0\xa9\xac\xaa\x34\x0a\x20\xc4\x68\x92\x63\x42\x27\x6b\x18\x78\x43\xaf\xbe\x46\x61\x40\x48\x4e\x0d\xc8\x54\x0c\xe8\x52\x77\x5e\x68\xd1\xac\xdc\x99\xa2\xe7\xd1\x62\xdf\xcb\x47\x05\xce\x90\xfa\xb7\x3f\x35\xa4\xa3\x7f\x0f\xb4\x42\xd0\x46\x0d\xeb\xaf\x10\x8c\x86\xc1\xbb\xfe\x73\xae\x3b\x31\x2d\x00\xda\xd0\xcd\xcd\x68\xed\x0d\xe5\x60\xec\xae\x74\xe7\xf1\xc3\x02\x2c\x33\x2b\x91\x57\xb7\x56\xf7\xc0\xac\xf4\xe6\x2f\xab\x20\x59\xa2\x04\x4a\x30\x04\xd7\x9f\xfe\x7a\x06\xcb\x60\xcd\x01\x54\x1e\x02\x9e\xef\xf1\x03\xb8\xb6\x86\xd3\x88\xf3\xdd\xa6\x40\x74\x68\x27\xc6\xa9\x19\x87\x30\x76\x15\x51\x91\x70\x0d\x5b\xd2\xa9\xfa\x79\x8b\x53\x13\x15\xb2\xa9\x38\xa0\xf5\x9a\x8f\xdd\xd8\xea\x6d\xf2\x9c\x83\x85\xc6\xf7\xab\x86\xb7\xcd\x22\x3f\x5a\x4a\xf5\x1a\x2e\xe7\xd8\xda\x56\x03\x2d\x97\x2c\x38\x59\x36\x06\xf8\xea\xdf\xbd\x10\x16\x8b\x9d\xcf\xc1\xa6\x39\x72\xd3\x59\xb6\x5f\x52\xb8\xc6\x5a\x2a\xdf\x8d\xe1\x3f\x65\xe2\x81\x63\xd7\x89\x6b\xf1\x9e\x1a\xb8\x3f\x79\xf2\x15\xb8\x28\xf2\x84\xcf\xee\xf6\x76\x66\xb8\x7e\x95\xd4\xa3\x1b\xf9\x3e\xfe\x9d\x51\x34\x73\xae\xa7\xc4\xe8\x05\xc0\x66\x5c\xb3\x7b\xf5\xa1\x80\xe6\x49\xb9\xcd\x1c\x5e\x31\xa1\xc0\x96\x7c\x0a\x63\x53\x61\xab\x9d\xdc\xfb\x85\x33\xee\x5b\x81\xf0\xfc\x03\x1b\x4e\x3a\x4b\xee\x2b\x68\xf8\xc8\xd6\x07\x90\xa6\xc5\x48\xa6\xd9\x62\x88\x01\x34\x00\xf6\xf3\x65\xea\xb3\x62\xe3\x64\x5f\x70\x4f\x4b\xcd\x59\x91\xc1\x4d\x0c\x4d\x58\x78\x8f\xd8\xcf\x61\x4d\x81\xe4\x3f\x85\xc0\xa9\x0c\xcc\xdf\x70\xbd\xf5\x58\x24\xd4\x87\x47\x04\x36\x82\xf2\x75\xf2\x3f\x32\x8e\x0d\x5b\x32\x3b\xfd\x69\xe9\xf1\x93\xac\x82\x01\x39\xfe\x80\xaf\xbb\xbc\x59\x45\xaf\x33\x2b\xc3\x2e\xe7\x96\x6d\x5b\x56\x71\x3b\x7c\x04\xf5\xe1\xdd\x5c\x5f\xb5\x49\x30\x47\x0f\xcf\x8b\x4f\xcb\x8f\xf3\x28\x0e\x3f\xa2\x4c\x7d\x3d\xb2\x65\xeb\x8f\x17\x96\xed\xdc\xf6\x2a\xa8\xfc\x6a\x4a\x3f\xc2\x86\xc6\xc8\xe9\x8d\x1a\xf3\x68\xaf\xdc\xfa\x24\xc1\x8d\x2a\xcf\x26\x3d\x0d\xe3\x40\x1b\xea\xdb\x2a\x33\x1f\xba\xc5\xa6\xbb\xe9\xf9\x28\xf7\x48\x40\xb1\xb0\xed\xd1\x2c\xe7\xfd\xe9\xd0\x7c\x4b\x7d\xd2\xcd\xac\xee\xb6\x56\xa6\x4f\x75\x0f\x0f\xcd\x13\x8b\xb0\x14\x77\xf1\x58\xf4\x69\x44\x39\xd0\x08\x56\x98\xa5\x98\x7a\xf7\xe5\x51\x66\x9e\xf2\xfe\x97\x9f\xdc\x4a\xec\x85\x21\x77\x12\x02\x5d\xbe\xa9\x83\x6c\xb4\xb7\xbb\x7e\xe2\x30\x31\x8e\x5b\x8c\xb6\x5c\x02\xe2\x3e\x88\xa9\xa0\x06\xe3\xec\x53\x3b\x3c\x0b\x6e\xfd\xe5\xc0\x56\x85\x53\xfc\x75\xf5\x1e\x9a\x83\xe3\x93\x43\x0e\x28\x97\xf1\xf6\x94\x4f\xf3\x73\xc3\xdb\xaf\x86\x4f\x83\x55\x4c\x6b\xa2\xea\x09\xc2\x9f\x2b\x86\x2c\x07\x24\x1b\xc1\x17\x97\x98\x00\xf9\x17\xcd\x6f\xe3\x82\x7d\xce\x46\x2d\x08\x4a\x7c\xe8\xd8\xec\x9f\x3a\x5d\x51\xce\xca\xa4\xe9\x23\xd6\x13\x07\xcb\x46\xcd\xd8\xab\xd1\xc0\x39\x90\x37\xd9\x54\xdb\xaa\xa8\x22\x9e\xb4\x59\x3d\x6e\x63\x25\x65\x44\x0e\xcf\x3e\xb6\x79\x35\x39\x3e\x37\xf4\x24\xf5\xee\x50\xed\xdc\xc3\xeb\x6e\xf6\x4a\xcd\x67\x22\x16\x78\x9b\x33\x0e\x9b\xbd\x0d\x73\x54\x40\x6a\x81\x18\x2c\x56\xa0\xcf\x48\xd0\xf3\xab\x13\x34\xe4\xca\xcb\x4a\x4f\xcd\xcb\x5d\xe8\x4f\x3f\xf9\x45\x32\x35\xfa\xe5\x77\xd9\x8e\x11\x6c\xc7\xec\xfc\xa1\x48\xe7\x17\x05\x79\x9b\x20\x5c\xf3\x9f\x82\xb6\x38\xf8\xeb\x8b\x69\x4a\x14\x89\xca\xbb\xbc\x5b\x8e\x1a\xd9\x1d\xdb\xce\x15\x83\x29\xa9\x36\x05\x8e\xfb\x16\x4d\x7b\x7a\x1c\x96\x88\xad\x7b\x09\x05\x25\x4f\x0f\x6c\x3a\x1f\x86\x50\xe0\x94\x0e\x40\x4f\x06\xd8\xd0\x1c\x86\xb6\x37\x30\x52\x67\x37\x60\x54\xe7\x25\x43\xc8\xad\x41\x80\x6c\x2a\x59\x02\x5b\xa7\x0c\xf3\x58\xd9\x3e\x31\xbf\x69\x93\xef\x0f\x21\xec\xd5\x92\x29\x9f\x9c\x45\x14\x9d\xea\x14\xaf\x4f\xae\xff\xed\x19\x57\x10\xb1\xe3\x9d\x15\x28\xd7\x2d\x94\x88\x2b\x17\xd2\xc1\x2d\x84\x5e\x59\x1f\x57\x06\xfd\x63\xe2\x2b\x50\x7f\x11\x78\x71\x41\xe0\xaa\xa4\x78\x10\xb6\x38\x02\x28\x27\xf5\xba\x09\x73\x6d\x8c\x9d\x95\xab\x51\xed\xad\xea\x2a\xf3\xab\x09\x65\x7f\x34\xf6\x52\xed\x90\x5b\xfe\xa3\xf0\xda\x3a\x96\x6d\x03\x27\x47\x50\x06\x52\x52\x24\x20\x5f\x3b\x71\x95\xc3\x41\xa3\xf5\x16\x4a\x12\xea\xf2\x40\x5e\x48\xd6\xe5\x44\x45\xf7\xa3\x02\x67\x02\xae\x2b\x62\x54\x39\xc7\xa2\xa4\xf8\x62\x42\x05\xd3\x77\xca\xb0\x47\x97\xb5\x8c\x0c\x65\x44\x05\xaa\x72\x6f\xda\xce\xad\x44\xcf\x85\xdc\x95\x29\x12\xa2\x1f\xb4\xfb\x5a\xfa\x5a\x96\x8a\xfd\x8a\x7b\xf6\x20\x2f\x59\xc1\x22\x8b\x8b\xd5\x8d\xeb\xf2\xfa\x0f\xbf\x87\xa7\x5b\x39\x32\xb0\x0b\x8e\xc5\xc6\x87\x74\x22\x02\x06\xde\x07\x6c\x28\x1e\x64\x85\x1c\x2c\x54\x0b\xaf\x84\x60\x67\x7e\xeb\xab\x79\x6e\xd4\x1c\xb0\xd7\xde\x4e\xdf\x2e\xe2\x2d\xdb\xc3\x67\x8c\x89\x56\xd0\x6b\x10\xd1\x81\xb4\x01\x9e\x1d\xfe\x99\xa3\xc3\x34\x2f\x61\xb1\x04\xcd\x90\x1f\x0f\xba\x8a\x78\xcb\x8b\x83\x7f\xa8\x03\xc8\xbb\xf5\x58\x8c\x64\x11\x3e\x25\x69\x64\xe5\xfd\x82\xa4\xf3\xeb\x3e\x81\x7b\x32\x4d\x55\x77\x33\xfb\x07\xb6\x9e\x4f\xbe\xb6\x50\x69\x6d\xfd\x26\x03\x8e\x01\xf8\x91\xc4\x94\xb9\x19\x65\x45\xf5\x6b\xfb\xb7\x54\x82\xd7\x7c\x1e\x5f\x21\x2f\x39\xbe\x9f\x6d\x3a\x2c\xc0\x0d\xe4\x5e\x44\x88\x4d\x7e\x76\x73\x2f\x5a\x7e\xce\xef\xf3\xf5\x41\x7b\xb1\x42\x5a\x93\x84\xbf\xa9\xc2\xa7\x49\x12\x1c\xaf\x9d\xa3\x79\xc0\x49\x75\x23\xc1\x0d\x2f\x7e\xa2\xeb\xda\xdc\xe4\xf8\x91\x20\xfd\xd1\x6f\x73\xbb\x54\x32\xd6\x12\xfc\x26\x21\xfd\x81\x9a\x66\x76\x7b\x00\xa0\x53\xa5\xf0\xa6\x2e\x72\x7c\x15\x3f\x6e\x85\x77\xf8\xe3\xb2\x21\x03\x5a\xaf\x7a\xb5\x79\x56\x47\x2f\x2a\x1f\xeb\xc4\xcd\x10\xce\x98\xf4\x8b\xb0\x99\xe5\xdb\x72\xda\x4b\xd7\xd8\x8b\x0e\xd7\xdb\x5c\x71\x12\xf8\x38\x05\x99\xd0\x26\xe7\x3f\x0b\x28\x99\x35\x64\x9a\x74\xb5\x49\xa8\x37\xd4\xe5\xbb\xba\x8d\xf1\xd5\x18\xf7\xfc\x18\x95\x39\xc5\x19\x45\x33\xae\x5d\x15\x08\xf0\xa2\x53\x48\xa6\xe9\xcb\x55\xc3\x55\x96\xa4\x6f\xdc\x0c\xeb\xd2\xd5\x63\xab\xd5\x42\xe9\x8c\x49\xbd\x55\x03\xed\x4a\xb9\xc5\xef\xaa\x06\x52\x0d\x0e\xd7\x99\xb8\xe9\xce\x21\x13\xb7\x04\xdc\x12\x22\xec\xcc\x87\x35\xfb\x06\x66\x7e\x67\xc4\xaa\xa5\xcc\xd8\x07\x3f\x26\x05\x2e\x66\x9b\x07\x7c\xf8\x7d\xd8\x1b\xa0\x22\x6c\xc3\x29\x99\x17\x98\x1e\x35\xde\x26\xa7\xd7\x4b\xc6\x4b\x00\x89\xf9\x22\xb2\xa9\xf9\xf3\x68\xf2\xdc\x7b\x7a\xbd\x64\x33\x23\xe8\x58\x59\x71\x5b\x08\x80\xfc\x9d\x51\xab\xa6\x71\x8c\x17\x6a\xc6\xd3\x12\xbc\x0c\x91\x42\xca\x75\xb7\x61\x63\x3f\x47\x73\x2b\xc1\x2d\x9a\xa7\xd3\x1e\xe8\x6b\xdb\xee\x02\xc0\xe3\x07\xd9\xd1\x98\xd5\x3c\x17\x3a\x42\x10\xb7\xb5\x3d\xb6\xb2\x36\x5f\x15\x87\x4f\xdb\x47\x8d\x70\xff\x6c\x6e\x78\x46\x20\x80\x6c\xaa\x80\x0c\xfe\xfc\x79\x84\x98\x30\xaa\x70\x59\x91\x16\xb8\xae\xb0\x60\x35\xb8\xdf\xe4\x5f\x1c\xac\xa0\x16\x9b\x53\x41\xed\xfd\x44\xa9\x29\x62\x15\x77\xd6\x74\x12\x4d\x98\x9d\xcc\x8b\xa4\x0d\x49\xda\xca\x64\x99\xe9\xc2\xfc\x78\xfa\x39\x2c\x9b\xb1\xdf\x57\x35\x89\x08\x08\xee\xd5\x8a\xc3\xaa\xf6\x8f\xa3\x77\xf1\x46\x9a\x78\x1e\x82\xd7\xfb\xb7\x81\x99\x5b\x90\x97\xaa\xc2\x51\xef\x48\xd4\xf6\xbf\x82\x4c\x4c\x57\x0d\x5a\xf3\x8a\x1f\x79\xfc\x06\x01\xe2\xb5\x07\xc3\x33\x8a\x24\xf2\xd5\x6c\xf4\x41\xe8\x07\xed\x39\xfc\xdb\xb5\xd1\xf6\x31\x8c\xb7\x6a\x83\x74\x01\x02\xa5\x95\x70\xd0\x85\x24\x6b\xb1\xc9\xd1\x93\xe6\x19\x43\xe6\xe7\x21\x49\x83\x7b\xda\x0c\xc0\xa2\xd4\xcb\xbe\x55\x1f\x5a\x09\x7a\x0f\x70\xce\x7d\x6f\xe8\x1d\x85\x23\xcb\x75\xbe\xe9\x3b\x66\xaa\xc9\x61\xb5\xa6\xeb\x65\x8b\x25\x7f\xad\xd5\x00\x65\xf5\x56\x3d\x40\xc7\x79\x3b\x20\xdc\x74\x66\x49\x06\xc8\xb1\xd1\x78\x22\x55\x00\x70\x8c\x12\xce\xe7\xde\xfd\x4a\x72\xa3\x7d\x9f\x36\xb1\x72\x8e\x3a\xbe\x20\x1f\xef\x69\x70\x8c\x27\xd0\x52\xb9\x01\x0e\x4c\x63\x61\xe3\x09\x1c\x04\xed\xd8\x3a\x36\x8f\x6c\xbd\xb9\x70\x0c\x2c\x41\x0a\x68\x13\xd5\xd0\xb5\x27\xc0\x12\xfe\x25\x0a\xa7\xee\x0f\x8c\x81\x1c\x49\xbd\x9e\x2a\x23\xf9\xab\x86\xe3\x9e\x85\xd9\xf2\x22\xff\xb0\xe3\x7f\x11\x59\xb6\x0b\xca\xb4\x52\x38\xaa\xef\x7f\xd0\x6e\x51\x7c\x5c\x43\x08\xa5\xee\x45\xc5\xc1\x1f\x70\xe1\x1f\xc1\xf7\x10\x25\x3a\x8e\x2d\x0b\x51\x33\xc5\xc1\xdf\xaf\xe6\xf8\x03\x2d\x00\x50\x08\x2f\xcb\xf8\xb5\xfa\x9a\xda\x74\x2f\x37\xe6\x74\xaa\xe1\x63\x92\xef\xfd\x75\xdc\x5a\x29\x5e\xfa\x4f\x49\x74\x92\xc1\x4d\x4e\xf0\xe5\x74\x49\x2f\x55\x84\x4f\xf9\xf9\xed\x82\x09\x0b\xec\xdf\x7f\xdc\x28\xfe\x4d\x2d\xc5\xce\x09\xd3\xc3\x63\x2f\xec\xe1\xf9\x08\xb6\x73\x88\x37\xfd\xa5\x2e\xab\xed\xe5\x41\x6a\xcd\x55\xf0\x55\xcf\x71\xea\xc7\xf8\x45\x7c\x3e\x7e\x03\x7c\x16\x31\xa3\x42\xe2\x41\x78\x04\xc4\x20\xc4\x8c\xd5\x8d\x9f\x07\x1f\xd3\x77\xbb\xf6\x8f\xe0\x99\xff\x6c\x20\x48\x3e\x55\x63\xeb\xc8\xb9\x95\x07\x59\xdf\xe7\x9f\x09\x93\x02\x00\x49\x74\x73\x86\x44\x09\xce\x5b\x40\x6c\x15\xc0\xad\xf1\xd9\x9f\x24\x0a\x2c\xb5\xaf\xcd\x69\x0e\x7c\x6e\x2e\x2a\x7e\x94\xfd\xca\x8a\x62\xba\xc9\xb9\x38\xe2\xaf\x90\x5c\xc0\x0a\x85\x78\xa9\x52\xc9\x13\xbc\x7c\x70\x92\xf7\xc5\x5e\x00\x30\xc3\x7c\xba\xb2\x71\xfb\xaa\x99\x49\xec\x67\xe1\x6c\xc6\x76\xfd\x46\x65\x15\x59\x2f\xed\xde\x35\x2c\x79\xf3\x59\x1c\x61\x4d\x18\x0b\x5a\x6d\x1d\xd8\xdf\xc2\x6a\xf3\xf0\x86\x21\x77\x41\x43\xa4\x0a\x85\xb4\x95\x3d\xe3\x68\x65\x42\x5e\xaf\x10\xff\x71\x8a\x6c\xea\x33\x01\xaa\xb4\xd8\xcf\x06\x03\xe4\x11\x31\x9f\xc9\x69\xff\xa0\xcb\x8f\x64\x14\x45\x32\x7e\x23\xbd\x87\xa8\x7b\xfa\x99\x7d\x80\x3c\xa7\x68\x59\x9a\xb6\x03\x8e\x78\xd9\xde\x77\x6f\xe0\xaf\xea\x90\x44\x6e\x1d\x06\x7c\x75\x8a\xcb\xbf\x73\xa7\xf6\x52\x75\x0a\x93\xc3\x92\xb1\xaf\x4a\x6a\x42\xe7\x5d\xa3\xc9\xc6\x41\xcc\x8f\x11\xfc\x97\xcf\x2b\x02\xf4\x47\xc3\x57\xdb\x53\x05\x13\x0a\x66\xf6\x37\x5e\xfc\xc2\xe9\x00\xf4\xe8\x56\x4a\x38\xf6\x18\x2f\x1f\x98\xe4\x3d\x4a\xe9\x0f\x1a\x23\x76\x64\x49\xbc\x83\x6e\x5d\x05\x17\x2f\x2b\xd2\x07\xf5\x3e\xd0\x62\x85\xd5\xf5\x75\x90\xa1\x74\xf1\x58\xa2\x83\x3f\x70\xdb\x11\x57\x3e\xd7\x2b\xe3\xc4\xfb\x9c\xf6\x6e\x49\x75\xb7\x19\x71\xe2\x13\x86\xe2\xae\xfc\x50\xd3\x90\xa1\x4c\xd3\xad\x0d\x34\x56\x60\x0b\x60\x8d\x16\x5a\xd7\xea\x1f\xfe\x1b\xe7\x37\xe4\x53\x4a\x52\x97\x5b\xb7\xf2\x24\x70\xf6\x1b\x36\xb7\xe7\x0a\x1f\x2a\xd8\xea\xfc\x16\xd0\xe7\x97\x0e\x24\xe7\x57\x7b\x28\xf9\xdd\xcc\x86\xac\x4b\x58\x7a\x32\xe4\x63\x8d\xdb\x0e\xf9\x29\x91\xe4\x19\x51\x38\x98\x38\xc0\x63\xa2\x68\x8b\x5d\x36\xef\x77\x57\xfd\xa5\x87\x17\x6f\x5c\xa5\xa3\xbf\x1b\x3a\x25\x90\x69\xe9\xd3\x2e\xa2\xa0\xe8\xeb\xfc\xd6\xb9\xf1\xdd\x40\x16\xee\xcc\xcd\x7e\x38\x70\x29\x43\xfc\xf2\x41\x5a\x31\x5e\x51\x87\x34\x91\x86\x97\x4b\x43\x09\x29\xb6\x0c\x70\xcb\x55\xff\xb2\xbd\xb0\x0c\x99\x48\xe0\xea\xb0\xf1\xec\xa6\x99\xb9\xfc\x7e\x78\xdb\x1d\xa0\x03\x88\x00\x61\x2a\x64\x5d\x30\x6a\xc9\xc2\x44\xf7\xeb\x9d\x6c\x47\xa5\x05\x72\x2d\x32\xbf\xaf\x6a\xf4\x8a\xbf\x3b\xe9\x6e\x0e\xef\xf0\xfb\x74\xad\x10\x9b\x0f\x4d\xe3\x4b\xbe\xe3\x49\x01\x30\xf2\x0d\x18\x3e\x2e\x58\xe4\xc0\x5d\x3b\xba\x02\x7b\x8b\x55\xfd\x48\x1a\x43\x4b\xc7\xc3\xab\x86\x46\x85\x22\x7b\x7d\x41\x33\x8f\x07\x8d\xf2\x7f\xe3\xcb\x65\x6e\x67\x1b\x6e\x8d\x95\x1c\x56\x43\x90\x00\x2f\x40\x06\x48\x52\x23\x05\xc3\x85\xa2\xbe\x06\xbb\x35\x75\x0c\x94\xff\x24\x65\x6d\x8e\x20\xbd\xbc\x61\x17\x63\x62\xb6\xa7\xb5\x7f\xdb\x42\x95\x9f\xbf\x2e\x04\x74\x7e\xbe\x7d\x3e\xf9\xcf\x4d\x41\x10\x50\x71\x47\x70\xd8\x52\x15\xdc\x92\xc0\xde\xaa\x36\x1f\xf2\x3e\xae\x1b\xfa\xd6\xc6\xdd\x64\xb1\x23\x4f\x34\x28\x62\x10\x55\xc6\xa0\xfe\xcd\xcf\x09\x11\x92\x3f\x50\x28\x74\x67\x76\x52\x67\xfd\x4e\xe9\xe2\x64\xa7\x17\x76\xe9\x6d\x4c\xf3\xba\xd4\xae\x84\x59\x8b\x23\x82\x47\xd6\x7d\x67\x33\xc5\xd7\xd3\x2f\x4a\xf6\x34\x82\xe9\x10\x0f\x68\x10\x22\x2e\xa5\x02\x64\x16\x95\x25\xf9\xa5\xba\x9b\x53\x15\x3c\x10\xca\x68\xde\x4b\x96\xf4\x54\x1e\xd4\xe4\x48\xe9\x49\x17\xab\x85\xda\xe1\x0f\xfd\x7d\x98\x75\xba\xa4\x0e\x5b\xe1\x63\x4d\x33\x93\x90\xf7\x31\x98\x55\x78\x6f\x35\x48\xe3\x0f\x5a\x20\xb4\x0e\x70\xa9\x1d\x1b\xc7\xa4\x65\xc7\x5e\x81\xb8\x74\x48\x42\x47\x46\x54\xae\x48\xe4\x91\x99\x41\xd3\x3c\x57\x58\x42\xeb\xb5\xf8\xab\xed\x27\xae\x6d\x0b\xf8\x0d\xfa\x04\x89\x21\xa5\xa3\x9d\x9a\xe0\x46\x3f\x45\xdb\x71\x83\x48\x72\x72\xa6\x1d\xf8\x63\x86\x1c\x1c\x16\x13\xd2\x8b\xc3\x87\xab\x60\x45\xf7\x05\xb9\x70\x6d\x5f\xce\x22\xfe\x84\x6d\x0e\x46\x32\x46\xa9\x3f\x2f\xa1\x5b\x24\xd6\x72\x2b\x5e\x3b\x0a\x77\x3f\x5c\x81\x63\x56\x75\xe9\x15\xe7\xfd\xf2\x22\xb4\x1d\x17\x7e\xa6\x35\x3e\xce\xf8\xc5\xcb\x3f\x10\x4c\xfd\x17\x46\xe9\x10\xf8\x38\xf5\x8e\xc3\x24\x3b\x56\xbe\x8a\xd5\xc7\xfd\x11\x5d\xe6\x93\x5a\x03\x90\x06\x7d\x50\xde\xdc\xe4\x37\x5a\x33\xbf\xb1\x10\x13\x9e\x8a\x4d\x38\x3b\xb7\x86\x0a\x32\x10\x08\xd9\x05\x70\xd3\xb0\xb7\xd6\x2a\x6c\x9a\xd3\x32\x6b\x07\xfc\x37\x0e\xaf\xb1\xb4\xb0\x7a\x9f\x8e\x5f\x98\xee\x3d\xbe\x22\x25\x3a\x88\x7b\xeb\xe1\xce\x0a\x99\x21\xfe\x44\x59\xdf\x65\x8b\x16\x40\xd4\x9a\x98\x45\x9f\x9e\x1c\x67\xc7\xd8\x78\x1c\xb6\xe0\xaf\x59\xc2\xde\x15\xa7\x39\x3f\xbb\xa7\x78\x66\xdd\xb3\x64\x47\x00\x43\x9c\x0d\xc0\xaf\x2a\x3c\x79\x25\xfa\xa2\x9f\x1d\x4f\x50\x71\xa9\xa0\x1b\x8f\x30\xe2\xcf\x42\xed\x36\x95\x94\x59\xb7\x6f\x9f\x4a\x69\x96\x5a\x47\x61\x3b\xf5\x74\x66\x8d\x22\x5e\x7b\x12\xd7\xcb\x56\x9b\xdc\x8d\x96\x8a\xf7\x32\xfb\x9e\xff\xba\xe3\x0a\x64\x68\x13\x6f\x36\x4b\xf8\xfe\xc5\x90\xd5\x7f\xa7\xf7\x2a\xe2\x42\x0f\xa9\x31\x6a\x15\x72\xc7\x13\x9e\x4f\x31\x34\x8f\x1f\xf1\xe1\xee\xe6\x55\x6f\xf1\xf6\xb4\xd3\xd3\xbe\x54\x99\xf2\xcb\x7b\xdb\x21\xf2\x07\x26\xe3\x0b\xc0\x12\xe0\xbf\x02\xfc\x86\x7c\xfa\x93\x7b\x70\x1e\x35\x34\x5f\x53\xc4\xfd\x01\xdc\x04\xe3\xdf\x0c\x31\xd8\x53\x9e\xdf\x35\x80\x4a\x29\xc0\x7d\xf9\xed\x2f\x07\x84\xd1\xaf\x3a\xb5\xbd\x8c\x98\xd3\x59\x9d\xbc\xe8\x19\x03\xa5\xd3\x08\xc6\x2d\xfc\x20\xa8\x00\xa2\xff\x4f\x89\xf8\x02\x16\x58\x50\xd6\x81\xb6\x47\x45\x6c\x9a\x51\xdb\x8a\x1f\x7f\xfc\x48\xb5\xca\x9a\x14\x94\x02\xdb\xbd\x71\x9e\x01\xee\x26\xbd\xd5\xf8\x6d\xa2\xbf\xcc\x5e\x02\xe0\x4f\x2b\x89\xff\xe2\x60\x94\x05\xf0\xff\x85\x79\x5f\x7c\xd0\x3a\x16\xa7\x2d\x6c\xb6\xe7\x29\xc5\x6c\xaa\x30\x55\xf5\x6e\x1a\xd9\x0b\x33\x01\xfe\xca\x19\x08\x90\x3a\x0a\x3a\x1d\x4b\xbe\x56\x17\xf4\x90\x4e\xeb\x81\x68\x68\x8e\xcd\x36\xff\xe0\x2d\x84\x7f\x32\x0b\x64\x49\xbb\xf7\xec\xcd\xcb\x6c\xaa\x29\x3c\x87\xcf\x07\xaa\x73\x9b\x70\x1d\x20\xf0\x63\xf2\x35\x52\x02\x4f\xd8\x40\xd4\x92\x9a\x09\x9d\x56\xe1\x23\xe3\x20\xd4\xc5\x53\x81\x03\x98\xd4\x93\xc4\x62\x12\xda\x29\x97\x0a\xc6\xfb\x8e\x7e\x39\x89\x24\x7f\x17\x0b\x0b\x90\xbd\x07\xfd\xf6\xba\x94\x04\xf8\x93\xa6\x36\x16\x00\x67\x27\xa9\xc2\x4c\xcb\xce\xa1\x96\xd9\x40\xd0\x79\x06\x6b\xd2\xbb\xfc\xbc\x72\xfa\xa6\x38\x9c\xf4\xef\xdc\x01\x4f\xd5\x65\x3b\x36\x8e\x24\xd3\xef\xd6\x08\x67\xac\x1f\x10\x11\x8a\x58\x23\x14\x79\x08\x8c\x4c\x90\x2a\x68\x47\xaf\x37\x80\xf4\x7a\xff\x4a\x4b\x12\xa4\xa0\x3f\xd0\xc6\x92\x14\xd1\xa2\xef\x1b\x60\xe3\x65\x4c\x47\x24\x1d\x50\xb8\xcc\x46\xaf\xb8\xe1\x97\x47\x78\x8a\x30\x96\x22\x19\xea\x7e\x50\xfe\xf4\x13\xde\xa2\x08\x4f\x4b\x88\x36\x8e\x48\xc3\xec\xec\xfd\x5c\x30\xf2\xd1\x96\x52\x64\x4a\x74\xb1\xad\xcb\xd2\xb5\x75\xf6\x06\x99\x1e\xd6\x74\xe9\x4b\x0e\xf0\x2e\xf4\xab\x2e\xb4\xf7\xc6\x46\x29\x63\x7d\x9b\xf6\x75\x1b\x85\xf6\x70\x07\xfa\x20\xcc\x8a\xb6\xfd\xc2\x96\x9e\x2d\xf0\x97\x02\xd8\x12\xa3\x1f\x76\xa3\x0f\x81\x2d\xd0\x39\xcb\x0a\x4c\x3c\x53\x4f\xc9\x62\x7b\xb5\x15\xac\x26\x64\xf9\xc8\x07\x3c\x32\x0b\xff\x9e\xc7\x4c\x8e\xfc\x67\xd2\xe8\x3b\x5a\x2f\x3d\xed\x72\xd9\x43\xe0\x79\xc6\x58\x79\x52\x18\x32\xe5\x18\x8f\x73\xde\x70\xbf\x8a\xe3\xc4\x2a\xe0\x54\x8f\x37\x06\xaa\x32\xe8\x7e\x1a\x04\xf1\xa1\xd2\xb7\x2b\x9f\xc9\x86\x14\xf7\xb8\x26\x69\x5f\xdd\x01\xc3\xcc\xf3\x3e\x6f\x6b\x31\x4a\x15\x08\x0d\x78\x46\xcd\xbd\x7e\xfd\x58\x3c\x04\x9e\xf1\xb2\xeb\x53\xe5\x6c\xbf\xf2\xfb\xeb\xa3\xe9\xf3\xf6\x27\xcb\xa5\x3b\xe9\x25\xdf\xfc\x70\xeb\x27\x07\x81\x77\x46\x36\x0e\xce\x38\x7f\xfd\x5a\xf0\x5e\x5f\x9f\x3c\x5b\x6e\x54\x3f\xf0\xe3\xee\xf6\xfe\xeb\x44\xa3\x91\x12\xce\xb5\x02\xa8\xef\xea\xb4\x07\xb5\xd2\x69\xc5\xf4\xdc\x45\xa8\x3b\x67\x8b\xe9\x1d\x9c\x34\x30\x28\x7b\x75\xab\x9a\xf0\x22\xa9\xb3\x7d\xc1\xb0\x5e\xd9\x0f\x95\xf1\x42\x17\xfe\xfc\x40\xa8\xf0\x10\x6c\xd9\x7d\x05\x69\x1d\x9a\x54\x89\x66\xd1\x22\x1b\x14\xf2\x5b\x25\x99\xa4\x5f\x20\xf6\x67\x74\x8e\x6e\xbc\x6f\x42\x11\xca\xb9\xd2\xfc\xf7\x19\x4d\xa5\x29\x10\x70\xcc\x6f\x5f\x2b\x45\x12\x9f\x11\x46\x0b\xb7\xa8\xe2\xfa\x4d\x84\x7a\x17\x92\xa7\xa4\x31\x0c\x2a\xa6\xb9\xee\x87\xa4\xae\x80\x98\x1e\x6c\xd0\x05\xe0\xcf\xbd\xf1\x63\x41\xaf\x01\x5f\x28\x12\x17\x12\xb3\x7e\x3f\x9d\x04\x2f\x4b\xe4\x9e\x5f\xe0\x1d\x58\x70\xf8\x8b\x06\xd7\x6d\x1b\xd4\x59\xab\xab\x8f\xdb\xbc\x81\xd2\x30\x9e\x3a\xe8\x2c\x87\xe0\x8a\xa5\x35\x48\xb6\x3a\x0c\x50\xdb\x6b\x46\xcb\x02\xce\x41\xfc\x7f\x1f\xd4\x18\x11\x1e\x74\x95\xc9\xe0\xc9\x0b\xc4\xed\x1b\x0b\x3a\xd9\xbb\x10\x19\xca\x83\x72\xcf\x1d\x15\x86\xf2\xd8\xf1\x69\x98\x70\xa2\x0a\x0d\x5a\x31\x9b\xe5\xf0\xfd\xf8\x86\x44\x70\xf7\x8b\xe5\x08\xa2\xc7\xfc\x1c\x0e\x19\xd1\x9f\x89\x99\xd4\xb4\x6b\xbb\xca\x5b\x3e\x5c\xf5\xb4\x4d\xbf\x71\x0f\xe1\xb4\x43\x79\xd2\x27\xb0\xb5\xb3\x7c\x38\x36\xc3\x8c\xea\x55\x5d\x82\x18\xf6\xad\x3e\x30\x89\xa2\xe3\x62\x74\xdd\x2a\xdf\x2d\xd0\x28\x84\x84\xf6\x24\xab\xd1\xe3\x36\x1e\x67\x47\xcd\xd3\xca\x38\xbc\xeb\xe3\x91\x4e\x75\xb4\xbd\x74\x3f\xa6\x5d\xec\x6f\x5f\x24\x88\x37\x55\x01\x31\x55\x11\x2f\x3e\xa4\x5c\x36\x15\x83\xcc\xb8\xe1\xe5\xcf\x0b\x9c\xd8\x38\x0c\xe0\x45\x8c\x74\x0e\x73\xb8\x9e\x3e\xc6\x5d\xb5\x1b\x14\x66\x7a\xba\x68\xc1\xb9\x4e\xc9\xa7\x6d\xe1\x4e\x20\xe3\x37\x74\x83\xd4\x9e\x7b\xea\x91\xaa\x5a\x9e\xd9\x99\x5d\xdf\x0a\xde\x97\xa8\x54\x60\xea\x27\x37\xa8\xfb\xb3\x51\xac\xd4\x4b\x71\x75\x7d\x5b\xc2\x2c\xa3\xbf\x29\xc3\x82\x0e\x31\x3b\xe0\x6a\xfd\xec\x25\x98\xfa\xa5\x6a\x29\x0a\x8b\xa1\xb3\x5f\x5a\xba\xc2\x3a\x59\x76\x96\x42\xe5\xa4\x8d\x67\x93\x7f\xc8\xf0\xd2\x26\xfa\x8a\xf1\x77\x9e\x07\xf5\x43\xad\xe6\x54\xd0\xce\x97\x2d\x86\x5f\x61\x37\xa2\xfd\x28\xdb\xa5\x37\x45\x4d\xbe\x53\x97\x7a\xc0\xfd\x20\x25\x1c\xf5\xf1\x1e\x70\x96\xea\xf7\x5e\x79\x74\x23\xaf\xba\x26\x14\xba\x2f\xc5\x1f\xcc\x4c\x5c\xfd\xfb\xa7\x61\x77\x09\xa4\x5f\x18\x25\x2b\xcf\xdd\x15\x15\x93\xfa\x41\x96\x47\x50\xb5\x0f\x82\x91\x28\x0d\x18\xfc\x81\x60\x25\xcd\x0e\x30\x7c\xa6\x0c\xfe\xd5\x46\xd8\x0d\x7e\x8b\xc8\xeb\x81\x2e\xc0\x82\x0b\x70\xcb\xdd\x8a\x92\x62\x28\x17\xb4\x92\x3f\xf5\x4d\x64\x08\xbc\x79\x9a\x48\x65\x40\x22\xf8\xa8\x2f\xe9\xe3\xf5\x9d\xe6\x8a\xd2\xf9\x8c\xd9\x0f\xa0\x3b\xb0\x77\xc7\x6d\xf4\x45\xf8\x5b\x8c\x31\x99\x1a\xcb\x93\x44\x0c\x77\x55\x91\x67\xc0\xdb\x63\x5d\x47\xb6\x9e\x22\x44\xad\xfa\x18\x0f\x19\x62\x1f\xd4\x73\xbd\x3a\x99\xfb\xc8\x98\xd4\x4b\x1d\xc9\xd4\x7a\x23\x92\x68\xfd\x69\x8d\x82\x26\xc9\xc8\x71\x9b\xdf\xc7\xde\x0c\x0f\xf7\xc7\x5a\x83\xf9\x97\xaa\xd5\x61\xad\x90\xa9\x9b\xd8\x38\x4e\x92\xa9\xbb\x80\xe7\xa1\x6e\x05\xf9\x3b\x76\xe8\x08\xa1\xba\x4e\x2d\x8c\x38\xa1\x26\x44\x5e\x4f\x16\x88\x5b\x07\x3f\x21\x8f\x8d\xa8\x12\x09\xfc\xb8\x49\x20\xdf\xaf\xc8\x9f\x9a\x05\x37\x6c\xe8\x20\x3e\xe5\x3f\xb1\x08\xcd\x07\x67\x13\x6d\x16\x0a\x13\x97\x0b\x1c\xd5\x67\xcc\xad\xd3\x70\xd7\x53\xf9\x33\x66\xd0\x38\x10\xcc\x9f\x04\x96\x68\x03\x2d\x34\x95\x9e\x60\xd6\x99\xfd\xab\x62\x2d\x67\x1c\xc7\x75\x11\x4a\xe0\x1f\xf4\x5e\x8c\x09\xc8\xab\xcd\xf3\x71\x51\x25\x61\x37\xe8\xed\x67\x0d\xc7\x5e\xa5\x4e\x33\xec\x46\xcf\x38\x2d\x52\x3c\xd6\x92\x1e\x2b\x2c\xa5\xf9\x8a\x69\xdd\xab\x59\xc6\x3f\x69\xc3\x99\x9c\xa3\x18\x0b\x1c\x4b\x7b\x7d\x11\x84\x3a\x43\x43\x20\x1d\x98\x70\x88\x57\xda\x02\xb9\xbd\xf1\x94\x5c\x36\x19\xdf\x27\xed\xf4\xb1\x91\x8c\x1e\x52\x61\x0b\x95\x23\x78\x3b\x2f\xdb\xfc\xc3\xfe\x83\x66\x34\x0f\x30\xb9\xaf\xfd\xf7\x41\xa4\xb0\x04\x72\x9a\x55\x61\x87\xc5\xb1\x90\xb2\x19\x93\x1c\x4b\x26\x60\xef\xa3\xd7\x42\x9b\xa8\x32\xc4\xd8\x42\xfc\xdd\x5a\xe3\xfc\x82\x56\x6a\x23\x5e\x45\x05\x6d\x15\xf7\xcb\xd6\x76\x95\xa8\xfe\x53\x8d\xaa\xa0\x67\x2a\x1b\x04\x2f\x43\x6f\x10\x6f\x7d\x29\xb0\xee\xe0\x41\xb7\x5a\xc0\x85\x90\xef\xfc\x2e\x94\xe6\xc3\xb9\x88\x57\x2e\xd6\xc7\xe7\x4f\x89\x2e\x80\x8a\x71\x9e\xda\xcb\x78\x28\x41\x1e\x84\xc8\xe1\x81\x31\xab\x7b\x05\x68\x30\xaa\x3b\xe4\x26\x31\xa2\x96\x0b\xfd\x20\x5b\x38\x6a\x3e\xa5\x49\x95\x5e\x2b\x86\x9c\xac\xfb\x4d\x44\xd0\x4d\xb8\x5a\x0f\x3e\xe4\x9f\xda\x13\xe0\x43\x7a\x4f\xf4\x96\x10\xca\xcb\xce\xa8\x1c\xde\xc4\x88\xae\xc8\x86\x4e\x18\xfa\x14\x09\x6b\x91\x25\x09\xfc\x60\x4e\xa3\x46\xe7\xd8\xce\x01\xa7\xe6\x9a\xdb\x2f\xae\xbf\x5b\x0c\x8a\xdc\xb2\x1c\xf9\xe7\x7c\xf5\x15\x39\x7c\x96\xd8\x0e\x5a\x5a\xda\xf8\x43\xd2\xd6\xba\x8e\xff\xc1\x05\xce\xc6\x87\x23\x28\x95\xbe\x2b\xa6\xa4\x85\x7f\x49\x86\x57\xcc\x32\x21\xc9\xc6\x73\xd7\xed\xce\xe3\x79\x0a\xae\x27\x46\xd3\x66\x5f\x50\x20\x41\xf7\x65\x9b\x3e\xce\x78\x9c\x11\x75\x10\x94\x4d\xd7\x98\x82\x85\x06\x15\x7b\x16\x9e\x0b\x4c\x31\xc4\x94\x6b\x2a\xf1\x3b\x68\xf9\xb0\xce\xbe\xfb\x4f\x66\xe6\x13\xaa\x80\xe6\x43\xfd\xc3\x35\x79\x94\x5e\xe0\x4d\xbe\x03\x20\xf0\x57\x72\x94\x97\xce\x38\x6b\x8f\x62\x63\x9e\x91\xc5\x87\xa5\xd8\xda\x2e\x9b\x41\x73\x62\x65\x19\x19\x5d\x67\x0b\xbf\x61\x47\x1a\xd4\xf4\xbd\x63\xfe\x01\xca\x28\x69\xab\x8d\xe0\x7f\xc2\xe4\xa2\x84\x3f\x93\xcb\xb8\xdd\xf4\x95\xcc\xe7\x91\x30\x53\x00\xb1\x14\xe9\xe6\x9b\x0d\x41\x4e\x8e\xd8\x66\xd8\x7c\xc2\xb5\x28\x3a\x01\xe3\xd0\x04\xf7\xdd\x66\x8d\x8b\xc2\x56\xab\x30\x23\x4b\xd6\x8a\x12\xc3\x31\xce\xf2\xba\x9d\x7f\x48\x6c\x62\xd0\x90\x39\x21\x47\x3b\x64\x0b\x4f\xd0\x47\x27\xfd\xb0\xf7\x98\xbe\x0c\xb4\x1d\xda\x6a\x05\xde\x6a\xc8\x9e\x5d\x56\x3a\x6d\x3e\x77\x3c\x77\x19\x30\x23\x1d\xd5\x21\xdc\xf1\xec\x96\x78\x61\xc2\x42\x79\x63\xc0\x41\x85\x68\xea\xe0\xea\x58\x9b\x34\x25\x7a\x2f\xdb\xf4\x69\x46\x7c\xf7\xc7\xc2\xa6\x30\x29\x7b\x03\x4e\xda\x1d\xf2\x9e\x86\x56\x91\x28\xba\x24\x23\x80\x26\xf8\x26\x9c\xbe\x47\xe9\x6c\xdd\xf6\x77\x5c\x14\x75\x6b\xe2\xdf\x07\xd8\xd9\x64\xb9\x19\x8f\x75\x13\xdf\xa9\x98\x58\x9a\xcd\xed\x79\xe9\x60\xc4\xbf\x6f\x11\x35\x9c\x7c\x39\x30\x20\x7b\xf5\x71\x5c\xbf\x9c\x4f\x51\x02\xef\x14\x2b\x3f\xc3\xb3\xdf\x2f\xf7\x3d\xb6\x98\x76\x66\x00\x7b\x40\xd6\xda\xec\x79\xdb\x31\x37\x4b\x12\x6a\x3e\xda\x27\x99\x4b\x92\xc1\xf3\x81\xd2\x9c\x48\x90\xf9\x4e\xcc\x97\xf6\x87\xb9\x5a\x8d\xfa\x83\xaf\xf2\x9e\xe5\x92\x55\x7f\x3f\x85\xdb\xa3\xec\x28\x0c\xaf\xaa\xdf\xbb\x29\xca\x94\x72\x13\xc6\xef\x9a\xaa\x29\x92\x91\xa2\xf9\x9b\x31\xe2\x50\x2a\x53\x06\xb3\xfa\xe0\xc5\x83\x7d\xcc\xe4\xc9\x91\x2a\x95\x8c\xdb\x5a\xa4\xc0\x72\xc6\x19\xe6\xd8\x2e\x55\xd2\x77\x70\x88\x05\xb1\x4f\x89\x1c\x18\x52\xe8\xf0\x02\x16\xde\x69\xa6\x24\xaa\x90\x6a\x04\xb0\x3b\x1f\xe6\xe5\xec\x33\xa7\x82\x5e\xfc\x69\xa7\x23\x00\xc9\x40\x37\x77\x72\xd0\x91\xe8\xd4\x03\x97\xeb\x72\x88\x5a\xd5\xe0\x80\xfb\xf7\xbe\x36\xb9\x5d\x5f\x15\xaa\xca\x98\x1d\x96\x0b\x69\xb2\x90\xab\x2c\x8e\x34\xa3\x2e\x75\x9d\xaa\x72\x85\x9b\xe1\x6b\xe1\x7b\x15\x54\xb9\x54\x70\x43\x0e\x02\xd8\xf4\xe8\x76\xd9\x7e\x6f\xd2\x9e\xbc\xe2\x0f\x06\x97\xaa\x55\xdf\xc3\x82\x3d\x15\xa5\x6d\x05\x57\xcb\x1c\x5b\x05\x15\x93\x67\x23\xbe\x91\x7e\x81\x98\x72\xe7\x6e\x03\x29\xa4\x96\x1d\x2a\x1a\x73\xf8\x9a\x7b\x38\xbb\x2f\x57\x19\x86\x2f\xc7\xcf\x7e\x90\x5d\xbc\xde\x2a\x5b\xdd\xcb\x81\xbd\x59\xf8\x0e\x98\xa0\x53\xdd\xaf\xda\x36\x68\x38\x82\x0e\x24\x70\x69\x8b\xa6\x65\x9c\xc1\x86\x28\xaf\x72\x03\x9b\x64\x48\xdd\x4e\x9e\x77\x40\x3a\xe5\x14\x0c\x65\xbb\xb9\x6d\x10\xd5\x22\x08\x5b\x16\x0f\x9a\x85\xf3\x20\xed\x5d\xf8\x59\x4d\x7c\xd2\x33\xd6\x7a\xfb\xae\x50\xa3\x26\x23\x6a\x0a\xdf\x0c\x4f\xda\xf2\x73\x5f\x46\x9e\x37\x7b\xa0\xa9\x57\x7d\x99\x33\x69\xc2\xaf\x1e\xf1\xd5\x02\x04\x9b\xb4\x1b\xe2\xf8\x6a\xf4\x3d\x1f\xe0\x36\x60\x34\x73\x40\x90\xb6\xdc\x90\x85\x61\x87\x3c\xc2\x67\x49\xe5\x87\x36\x17\xc5\x14\x5e\xce\x76\xd3\x9b\x5b\x79\x20\x5e\x7e\xa3\xd6\x8a\xd9\xef\xcc\x61\x83\x5a\x74\x66\xff\xb6\xf2\xd3\x39\x49\x71\x22\x5e\xb3\xc8\xec\x74\x7a\xcf\xe4\x6c\x2b\x96\xe7\x5c\xa2\x85\xa6\x00\x89\x11\x95\xd2\x79\x55\xf0\x4d\xad\x63\xa6\xe3\x8d\x69\x85\x43\x37\x6f\x3f\x42\xe4\x57\xf3\xc3\x1a\xcc\x2f\xcb\x6c\xd0\x2b\x0c\xd6\x55\x42\x36\xf5\x32\x3d\x35\x2c\x64\x6f\x87\x31\xa3\x85\x50\xca\xfa\xad\x46\xdb\xd2\xa8\x91\x63\x03\xe1\x1e\xbe\x25\xc4\x1c\x48\x94\xf6\x7a\x10\xf2\x0b\xc4\x5d\x8b\x00\x0f\xfe\xb6\x02\x5e\x2a\xec\x3d\xd9\x0d\xe1\x46\x07\x1d\xaf\x20\x3b\x73\x70\x8b\xbd\x68\x0f\x1b\xd0\x0a\x4e\xbc\xa2\x89\xc3\x87\x80\x7a\xc8\x11\xfb\x01\x99\xa5\x10\xc4\xfc\x1a\x97\x2d\xff\x91\x24\x92\x25\xcf\x13\x6e\x67\xf7\xd9\xf8\x99\x40\xc9\x55\x68\x9d\x1e\xc4\x55\x1d\xcf\xfc\xea\xf0\x80\x25\x8b\x56\x97\x2c\x58\x7f\xfe\x14\x7f\xef\x66\x98\xbb\x7b\x59\x5a\xf9\x73\x43\xbc\xd0\xff\xe6\x60\x31\x08\x79\xa3\xd0\x7c\x77\x87\x56\xee\x70\xe7\x0b\xf0\x57\x93\x97\x45\xf6\x10\xe1\x4c\x71\xde\x26\x19\x63\x01\x50\x1e\xd2\xcd\x6b\x99\xbd\x5e\xb6\xb2\x09\xad\xaa\x46\xdf\x9f\x16\x32\xb4\x3e\x29\x88\x2e\xdd\x80\x78\xc9\x20\x05\x44\x70\x5e\x3b\x0a\xa2\x34\x30\xd4\x4f\x6d\xc4\xbe\x6a\x8f\x67\x9e\x93\x69\x2a\xa3\x7e\xc2\xd3\x18\xad\x6a\x39\x35\x5b\xba\x1d\xf7\x98\xbc\x2b\x17\x09\xb0\x54\x9e\xe3\x73\x20\x48\x34\xc1\x93\x39\xd3\xf2\x6e\x88\xb7\x9b\x0a\x75\x50\xa0\xc9\xe5\xcd\xe8\x86\x08\xe8\xfa\x92\xbd\x20\x59\xbc\x78\x55\x87\x47\xf4\x78\xb1\x39\xef\xb9\xb9\x15\xb1\xe9\x9e\x27\xa5\x05\xde\x95\xb8\xf2\x48\x0c\x49\x09\x34\x27\x93\xe4\x91\x3a\x50\x72\x73\x0a\x1c\xb3\x93\xd0\x40\xfc\x12\x67\xef\x03\x3f\x20\xac\x59\xf3\x32\x18\xbe\x86\x4e\x8c\xda\xbd\xc8\xf9\x0a\x93\xc4\x5d\xdb\x27\xb6\x5c\xe1\x3a\xb3\xc7\x9c\xaf\xd6\xe9\xb5\x7c\x39\x27\x69\x37\x27\x65\x3f\x4f\x9d\x01\x76\xd7\x20\x73\x78\x11\x7c\x45\xc5\x51\xc4\x65\xa4\x91\x2c\x12\xb1\x1f\x32\x0b\x7f\xca\x09\xd5\x2b\x58\x11\xa0\x86\x74\x63\xb7\x16\xa0\x1b\xe0\xcd\xe0\xcc\xde\x4d\x1e\xc0\x50\xdf\x16\x8e\xb0\x7b\xd7\xd3\xc8\xb0\xe6\xc6\x7c\xa2\x59\x25\x26\xfa\xe4\x5d\xd7\xcb\x83\x8c\x25\x35\x3e\x6d\xbd\x4a\x12\x74\xe4\xc3\xdd\x44\xd8\xa4\xd4\x77\x0c\x8d\x6c\x89\xdd\xc5\x9e\x24\x20\x36\x76\xc5\xff\x4a\x4c\xfb\x67\x02\x6a\xfe\xe5\xa0\x0b\x7d\x1f\x31\xcd\x78\x2c\x1b\x10\xab\xd5\x7a\x15\xd4\xac\xe7\x63\x23\x36\x57\xa4\x7d\x92\x5e\xe3\xad\xa2\x3f\x14\xa7\x21\xa4\x6d\x7c\x5d\xc9\x38\xfe\x6e\x42\x24\x6a\xa3\x65\xb5\xaf\x2e\xe2\x33\x67\x53\xf0\x24\x3f\x6c\x48\xe3\xb3\x1d\x19\xac\x3c\x9e\xa9\x4d\xfc\x6a\xde\x55\x0d\xab\x37\x9b\x1a\x97\xe4\x82\xcb\xe6\x83\xb0\x71\xdb\x43\x1a\x24\xd7\xff\xb4\xca\x22\x92\x4b\xb2\x94\x68\x17\xc1\x97\x2f\x42\x5e\x7f\xba\x8c\x4a\xcb\xb4\x1a\x34\xf7\xa9\xe9\xc6\xf8\x83\x47\x33\x42\xc4\x78\x2e\x28\x3f\x7d\xf6\x74\xe7\x6b\xe1\xaa\x6a\xf1\xd4\x04\xd3\xf8\xe2\x59\x8a\xe2\xc9\xf2\x6e\xb6\xc2\x3e\xa2\x3c\xc2\xbf\xda\x19\xd1\xf6\x36\x47\x30\xaa\x30\x8a\x89\x98\xe4\xd2\x1c\xa8\xdc\x14\x97\xb3\x8d\xa7\x11\xc6\x9b\x9c\x5b\x96\xa0\xce\xbd\xc2\x27\x18\xc4\x85\x34\x01\xb2\xb2\xe7\x65\xae\x6d\x67\x5d\x45\x59\x64\x58\xd9\x1d\x6f\x0e\x67\xdf\x13\xab\x96\x38\xe7\xd7\xc4\x49\xa8\xe2\xf3\x05\x1a\x32\x28\xa9\x1e\x64\x59\x65\x12\xe1\x66\x76\xe7\xa7\x7b\x11\x85\xa5\x19\xba\x18\xbc\x5e\x2a\x3a\xc5\x9d\x9e\x71\xf0\xb1\xe9\xb2\xf9\xfb\x58\xd9\x31\x1e\x4b\xe8\x73\x18\xa3\x16\xd9\x0d\x8b\xbc\x5f\xbd\xfd\x0f\x9f\x77\x69\xdf\xd6\x97\xf1\xbd\xa1\x4d\xbf\x5d\x2a\x57\x7d\xd6\xa0\xc8\xa6\xf1\xa7\xa1\xe7\x39\xd0\x81\x70\x8f\x38\x89\x17\x5f\xb4\x5a\xb2\xf5\xea\xde\x16\x90\xe9\xa0\xa0\x45\x1e\xc5\xc1\x4c\xd7\x87\xdb\xee\x5f\x3f\x13\x83\x5c\x84\x8c\xee\x93\x5c\xba\x36\x95\xf9\xd9\x4a\x44\x26\xd2\x19\x74\x18\x33\x0b\x73\xd1\x22\xe3\x73\x35\x18\x3e\x03\x52\xf0\x23\x42\xe7\x9c\x5e\x46\xde\xf7\x04\x3a\x88\xca\xd7\x3b\x89\x12\x48\x2a\x13\x1c\x6f\xb4\xe6\xd2\x69\x7d\x7f\x3c\xfc\x3e\xc5\x21\xbb\x67\x50\xd2\x15\xad\xed\x63\x7a\x61\x64\x6e\x0c\x81\xb0\xfe\x3f\x68\xca\x93\x5c\x72\x06\xf7\xc6\xaa\x25\x90\x56\x26\xcd\x4a\x9c\xdb\x5c\x8e\xca\x58\x8b\x43\x1c\x04\x51\xc7\xbd\x60\x2e\xc3\xb9\xec\x55\x98\x94\x44\x14\x83\xcd\x99\xbc\xac\xcd\x41\x69\x3d\x46\xbc\x3d\x22\x49\x15\x37\x4d\x4f\xb7\xd4\x01\x4a\x98\x2d\x18\x8e\x50\x71\x17\x84\xa1\x96\x0b\xd2\x40\x36\xe9\x24\x6c\xeb\x42\x8a\x4a\x57\x46\x72\x26\xd2\x25\x7d\xc4\xfb\xe3\x9d\x80\xbf\xda\x8a\xd3\xb9\x5f\xc2\x4b\xf7\xe1\x6a\xbb\x6b\xf3\x43\xa1\x34\x9e\x7c\x32\xd9\xb3\x7b\xe1\x11\x99\x17\xb4\x62\xd8\x2b\x2f\xb7\xa3\x8f\x36\x36\x96\xbc\x65\x6f\x71\xe3\x7e\xa5\x93\xfc\xa5\x20\x84\x52\xa9\xa1\x04\x39\x3b\x21\x5e\x02\xd7\x88\x2a\x05\xfa\x98\xbc\x7c\x2e\x44\x63\x12\x84\x7a\x29\x20\x62\x54\xa5\x97\x2a\xdb\xd1\x7e\x34\x10\x8b\xc8\xf6\x36\x09\x76\x5c\x0a\x41\xaf\x57\xf5\x21\xac\xb4\x83\x65\xf4\xba\x2e\x1f\xa4\x2a\xdb\xec\xd5\xa9\xa7\x6e\xa1\x58\xde\xc1\xf8\xa2\x0a\x59\x69\x21\x15\x86\x8f\x62\x2d\x0b\x36\x3d\x57\x9e\x44\x9c\xfb\xa3\xfc\x03\x78\x42\xbe\xdb\xe2\xde\x0d\x0a\x14\xe8\x4b\xf1\x2a\x8f\x7e\x06\x2b\x87\x2a\xca\x1b\x51\xf0\x69\x33\xa5\x8f\x0d\xc5\x1a\xe5\xbe\x6c\x6b\xa0\xc0\xd7\x7a\x1c\x5a\x28\x22\xbf\x80\x56\xeb\x39\xd1\xcd\x77\xc5\xea\xad\x61\xc1\xc6\x53\x25\x37\x8c\x2c\x84\xa4\x68\x32\xb4\xb6\x8d\x00\xda\xee\xec\x66\x14\x14\x66\x94\xf6\x52\x03\x15\xde\x22\xf7\x56\x37\xed\x42\xf4\x2e\x4b\xdc\xd0\x63\x8c\xdd\xca\x4b\xf5\x73\x6a\x13\xae\x20\x61\x88\xda\xa8\x59\x12\xc0\x73\x6f\xc8\xf8\xa3\x45\x9f\xe7\xb6\xa3\x1b\x6a\x07\xc0\xc8\x54\xeb\xda\x3b\x0e\x12\xca\xd5\x5c\x55\xe0\x9b\xc2\xa3\x8a\xd9\x75\x63\x48\x19\x91\xab\x60\x65\x87\x39\x38\xad\x6b\xa3\x7b\xf7\x19\x1b\x27\x99\xe3\xf1\x4f\xf4\x6e\x4d\x0a\xe8\x34\xba\x25\x84\xbc\xa8\x8c\xaa\x43\x01\xd7\xec\x62\x21\x2b\xee\x7e\xf3\x1e\x1d\x19\x4a\x93\x61\x48\xc7\x99\x38\x9e\x22\x53\xee\xde\xbd\xc1\x52\x2b\x5e\x59\x8a\xcc\xef\x17\xd9\xc7\x0b\x61\x3a\x5f\x73\x75\x99\x8c\xda\xdb\x35\x9d\x12\x79\xc9\x7e\x15\x08\xf5\x6c\x79\x25\xce\x34\x4c\x05\xd1\xf2\x34\xe7\xb8\xa7\x7e\xf9\x69\xfd\x2b\xa4\xa2\x17\x1f\x74\x34\xea\x66\x17\xf5\x60\x22\x7c\x7b\x27\x64\xa4\x4e\x9e\x94\xd3\xe6\x7b\x64\xc6\xd9\xd2\xba\xcb\x83\xcf\x4d\x28\x73\x03\xe3\xa4\x7a\xeb\x21\x6b\x97\xfa\x93\xe7\x28\xb5\xd4\x5d\xab\xd2\x8b\x37\x17\xc4\x03\x78\x92\x5e\xfc\x5c\x8b\x43\xa5\xde\xbd\x36\xb7\x40\x09\x2b\x79\x8f\xfd\xca\xfc\x56\x62\x62\xbb\xd6\xcb\x95\x32\xc2\x36\x30\x3d\xf1\x16\x04\x00\xf1\x02\x54\x40\x97\xd4\xa0\x6f\xd0\xe3\x80\x89\x33\x4a\xd8\x86\xe1\x32\x62\xa7\xfb\xee\x33\xda\x5f\x67\xb7\x60\x25\xa7\x6f\x26\xc6\xed\xbe\xac\x67\x4b\x39\x36\x5d\xc0\xf6\x3a\x43\xe3\xe7\x16\xc8\xf9\xa2\x6c\xdc\x75\x33\x58\x30\x94\xf3\x9d\xef\x83\x92\x70\x99\x38\xb3\xa1\x38\xef\x1b\x8b\xb4\x77\xe5\x8a\x4b\xbb\x04\x5b\x92\x9e\x34\xe1\xf9\x96\x94\x61\x58\x83\xe3\x49\x8c\xe4\x1c\x4b\x8d\xea\x7a\xb6\xb9\x89\xf3\x4c\xc8\xb1\x4d\xae\x67\xb9\x63\xe7\xde\x87\xbc\x33\xc3\x93\xba\x94\x35\xbb\x58\x25\x4f\x2b\xb5\x7b\xae\x13\x61\x66\x43\x3e\xad\x26\xae\xc9\x39\x7d\xee\x7c\x58\x36\xc4\x6f\x25\x64\x05\x2c\xd8\x64\xc8\x37\xbc\x39\x7a\x02\xb5\x6b\x88\xdc\x24\x85\x71\x20\xf6\xed\xc2\xab\xa6\xef\x4f\xb0\xc1\x4c\x3e\xa5\xc7\x8d\xef\x8e\xea\x4f\xcf\x39\xc3\xda\xb1\x3e\x8c\x3f\xdb\xd6\x71\x69\x05\x7a\x3b\x67\xb1\x73\x4e\x32\x27\x4a\xd8\xa2\x07\x13\x67\x62\x30\xa9\xb9\x93\xab\x5f\xaa\x11\xb5\xb6\x81\xa5\xc3\xde\x07\x52\x82\x2d\xa8\x0d\x0b\x68\x7c\x44\x8e\x25\xc8\x91\x38\x91\x09\xcd\x68\x0e\x28\xf7\x62\x18\xa6\xd4\xbc\x0f\x7c\xc3\xfb\x5e\x4a\x73\x0f\x0c\x04\x24\x7d\xf0\x76\xe4\xeb\x32\x38\x6d\x1f\x4f\xd9\xb5\x7e\x16\x5f\xff\x4b\xbe\xfa\xd1\xa9\x8e\xdf\xd3\xa6\xe7\x9d\xce\x22\x03\x17\x72\xaf\x70\x2c\x18\xec\x0b\xc4\x7f\xe4\x91\x80\xdf\x46\x7f\x40\xba\x80\xbe\x81\xdf\x12\x5f\xa3\xac\xe7\x97\x98\x4a\xfe\x82\x29\x37\x3c\xbe\x48\x1d\x60\xf7\x2c\x65\xce\x62\x46\x26\x2a\xa4\x0c\x06\x93\xfa\xa4\x20\x1a\x80\xb7\x1b\x52\x97\xc6\xad\x2b\x4c\x26\x7d\x60\x99\xf1\xc9\x2a\x19\x3d\xf0\x39\xe8\x41\xff\x12\x78\x3c\xde\x3f\x73\xb2\xb3\x72\x92\x15\xd5\x5a\x27\x58\x96\x31\x46\xb3\x08\x09\xcb\xa5\xdd\x8d\x6c\x9d\xfc\x3a\x52\xcc\x7c\xe9\xfe\xd4\xed\xda\x48\xb1\x6f\x38\x09\xb1\x00\x9e\xe7\x03\x34\xe1\xaa\x92\xa2\xaa\x85\x3f\x4d\xe8\x30\xbc\xcf\xbc\x8d\x86\xfa\xda\xeb\x07\xbd\xb3\xd4\xd5\x77\x5b\x59\x0f\x52\x39\xd5\xbf\x76\x33\x35\x57\x29\x3d\x33\x1b\xfc\xd0\x11\x5c\x46\xbd\x58\x72\x8f\xa3\x5e\x7a\x0d\x1b\xfb\x21\xd2\x05\xcf\xfc\xb3\x8d\xe9\x3e\xa4\xee\x77\x58\x15\xf3\x99\x57\x24\x3a\x98\xb7\x52\xfe\x50\x2c\xb5\x49\x3d\x55\xf2\x02\x97\xba\xb3\x09\x2d\xce\xee\xfa\xca\x97\x1c\xcf\x46\xe6\x45\xeb\x2b\xd5\x67\xed\x6e\xec\x39\xb1\x7c\x95\xde\x87\x1c\x97\xc1\x1f\xb1\x22\x87\x25\xac\x60\xeb\x1d\xb5\x1a\x12\x24\xbc\x72\x88\xb5\x54\xe2\xb8\x11\x0d\x6f\x7c\x86\xd9\x41\x71\x63\x06\x6a\x54\x44\x8a\x52\x5a\x99\xc4\x42\xd5\x89\x8f\xe5\x67\x6c\x0c\x45\x5d\xf5\x59\xdd\xc5\x8f\x9f\x44\x69\x7e\xb5\x78\xd8\x1a\x0b\xaf\x4c\xd9\xb8\x25\x06\xd2\xbf\xf1\xa8\x74\x54\xe7\xd4\x14\x79\xe9\xce\xbd\x60\xdb\xa1\x8e\xa3\x23\x12\xe1\x1a\xef\x3a\xc1\x33\x6e\x07\x19\x8f\x6f\x88\xb4\x8e\x4c\x5c\x0e\x19\x5f\x01\xbd\xf4\x3c\xfb\x83\xf8\x06\xf9\xc4\x06\xff\xa1\xf9\x58\x61\x07\x4c\xf7\xda\xb5\xac\x08\x8b\xba\xd5\x6e\x92\xf8\x35\xf0\x6e\xed\xdd\x19\x2a\x9d\x1b\x17\xcc\xde\x5f\x9e\xe7\xd9\x79\x7e\x74\xe5\xc3\x51\x80\xf8\x3a\xee\xba\x8d\x9b\x97\xd2\xcf\x88\xa3\x40\x51\x79\xa7\x02\x69\x69\x34\xd7\x69\x53\x64\x53\x04\x65\x9d\x5e\x37\xc2\x5f\xb0\x2d\x9d\x4e\x4b\x5f\xf5\x2e\xc6\x70\x5e\x70\xa7\xca\x67\xa7\x6a\xdc\x6d\xc3\xc8\x17\x79\x29\x3e\x04\xfa\xcb\x8a\x35\xc0\x8f\xf3\x79\x17\x41\x19\xa4\x69\x0c\x12\x0e\x2c\x3f\x3a\x10\x3f\x67\x52\x31\xdf\xb4\xfc\x58\x32\x4f\xc1\xcd\x17\x45\x47\xd0\x54\xd5\xb9\x84\xea\x08\x11\x80\x08\x09\xd0\x01\x60\x0d\x80\xec\xbc\x4a\xfe\x7f\x1a\x54\xfa\x00\xcd\x7d\x1e\x5c\x54\x00\x00\xf0\xd2\xed\xd4\xad\xd3\x77\xf5\xdd\x05\xc8\x90\xff\xf6\xff\x4c\x67\x25\x9d\x02\x29\xf8\xaf\xfe\xd7\x57\xa9\xd8\x05\xfe\xfd\x2b\xfa\xff\xd4\x01\x2b\x0b\x9a\xf2\x5f\x44\xfe\x67\xbf\x5b\xd2\xfd\xaf\xba\xfd\xab\x6e\xff\x0e\xfe\x9b\xea\x36\x35\xfa\xde\x01\x74\xce\x97\x3d\xee\x87\x95\xaf\x91\xc3\xbf\xe8\xfc\xf7\x06\x6d\x80\x3c\x50\x6e\xa7\xc2\x26\x7a\x1d\x1d\x78\x0e\x27\x4c\xc7\x58\xbb\x5c\x0b\xfe\xf0\x7f\x01\x60\x08\x8a\x7b\xe8\x3e\x00\x00")
+
+func imagesLgtm_outlinePngBytes() ([]byte, error) {
+	return bindataRead(
+		_imagesLgtm_outlinePng,
+		"images/lgtm_outline.png",
+	)
+}
+
+func imagesLgtm_outlinePng() (*asset, error) {
+	bytes, err := imagesLgtm_outlinePngBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "images/lgtm_outline.png", size: 16104, mode: os.FileMode(420), modTime: time.Unix(1792052089, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _imagesLgtm_stampPng = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xec\xb7\xf5\x57\x94\x5f\xdf\x3d\x7e\xcd\xd0\xad\x08\x48\x48\x88\x74\xa7\x20\x1d\xd2\xdd\x1d\x82\x94\x94\x80\x74\x0d\x22\xa0\x34\xd2\x8d\x74\x4a\x83\xc4\xc0\x08\x0a\x48\x77\x0f\x43\x37\x0c\x3d\x84\xc0\xc7\xfb\x7e\xbf\xef\x78\xbe\x7f\xc0\xf7\xf9\xe5\x59\xeb\xac\x39\xeb\x9a\x99\x75\xce\xb9\xf6\xde\x67\xbf\xf6\xeb\x93\xa6\xba\x02\x01\x2e\x25\x2e\x00\x00\x04\x4a\x8a\x2f\xb5\x01\x00\x0c\x01\x00\xd0\x32\x36\xe6\x9f\x6f\x04\xc7\x16\xd7\x01\x00\x02\x28\xbd\x94\xd6\xf5\xc9\xda\x9f\x06\x11\x00\x00\x3e\x38\x0f\x79\x2b\x6b\x02\xc3\x94\xc1\xbd\x03\x80\x34\x7a\x08\x98\xe5\xff\xc6\xff\x5f\x63\x16\x8d\xdc\x6b\xcf\xf2\x0f\x33\x40\x48\x5a\xa7\x67\x1c\x8f\x0b\x19\x9a\x14\x80\xfe\x7f\xe3\x7f\x65\xc0\x84\x01\xa2\xff\x43\xe1\x7f\x6f\x44\xa3\x1d\xfd\x1f\x0a\xff\xbb\xf2\x87\xd5\x7a\x73\xb5\xb8\xe9\x6a\x91\xdf\x55\x8c\x7b\x81\x23\x65\xb0\xab\xe9\xb0\xb0\x98\x7b\x7c\x1f\x5a\x59\xc2\x20\x7a\x3c\x56\x0f\x55\x67\x41\x7b\x96\xb4\x8a\x73\x20\x76\x29\xec\x42\x7c\x18\xf6\x87\xd7\x38\x4c\xe8\x73\x5f\x5a\xf1\xcc\x76\x24\xc7\x8e\xd5\x8a\x26\x94\x78\xa6\x0e\x03\xee\x37\xca\x69\xbe\xb2\xcd\x27\x14\xdd\x9d\x65\x4f\x34\xf3\x42\xc8\xa5\xf0\xe9\x30\x1f\xa0\x7d\x04\xfd\x00\xac\x80\xff\xd5\xc7\x65\x36\x2a\x98\xd2\xfe\x03\x19\xec\xa7\xaf\x7d\x3d\x5c\x3d\xf8\xbd\x68\x6d\xfd\x5d\xb9\xbd\x40\x52\xc0\x03\x00\x1d\xf8\xd7\x04\xd3\x03\xa4\x64\x2d\x1e\xf5\xaa\xd3\x8a\x7e\x10\x73\xcc\x58\x99\x00\x68\x61\x58\x47\xa0\x80\x7f\x4e\x31\xd8\x01\x55\xcd\xfc\x23\x5f\xb4\xca\x7d\x26\x0e\xa0\xd0\x8e\xc5\x08\x82\x1f\x98\x12\x31\x04\x59\x40\xc0\x7f\xfe\x26\xc4\x76\x84\xb1\xdf\x18\x0e\x2d\xb3\x8b\x16\x54\xda\x62\x80\xd2\xef\x04\x2a\x79\x33\x04\xd2\xef\x74\x14\x8e\xac\xd1\x2b\xc3\x92\x20\xac\x20\x1d\x70\x31\xba\x2a\x4e\x1a\x31\x0f\xa3\xa5\x32\x4c\x45\x1b\xfc\x7c\x7d\x63\xac\xb0\x0c\xdd\xbf\x96\x33\xaf\xf9\xe9\xce\xe7\x42\xc7\xcf\x2e\x7e\x4b\xf1\xef\xe4\xb5\xed\x0c\x17\x53\xcf\xea\x0a\x3b\x03\x69\x01\xec\x7f\x94\x73\xba\x7f\x4d\xc2\x98\x00\xe5\x25\xf8\x90\x12\xb5\xbf\xd1\x01\xbd\xf6\xe9\xce\xf3\xee\x5e\x18\x70\x20\x83\x89\x9e\x05\x2c\x53\x34\xd5\x28\x66\x99\x29\x21\xae\x2f\xdd\x8a\xac\x64\x68\x94\x3c\xce\xe2\xbf\x5b\xf2\x90\xe3\xf7\xd0\x3f\xbb\x3e\x24\x3e\xc2\x48\x3b\xff\xb5\x39\xc5\xba\xb1\x48\x1d\x6a\xc2\xba\xb1\x94\x72\x96\x51\x88\x97\xc3\x4d\x71\x02\xbe\xb6\x0d\xb2\x15\x13\x2d\x79\x12\xcc\xb8\xe3\xa8\x54\xb2\x48\x44\x2e\xd9\xfd\xff\xc5\xf2\xce\x00\x93\x07\x64\xe1\xff\x07\x3e\x18\x92\x36\xd4\xc2\xf0\xdb\x74\xd1\xe2\xe9\xe7\xaf\xe4\x97\x5e\x04\x01\x8f\xf1\xdf\xef\x05\xd1\x1e\x8a\xa9\xb0\xa2\xaa\x71\x99\x34\xae\x7c\x3d\x6d\x9b\x42\xbd\x1f\x88\x60\x67\xdb\x3d\x14\x79\x54\x49\xa3\x4f\x54\x11\x31\xd5\xbd\xfb\xea\xbc\xc6\x2f\x54\xdf\xb5\xd4\x8a\xec\xad\x7e\xb0\xe6\x5a\xb4\xff\x05\x2e\xb5\xb9\xe7\x0b\xa1\xcd\x3d\xcc\xf6\x6f\x76\xb6\x86\x62\x47\xcf\x73\x16\xfc\xb8\x3e\x2f\xa1\x4c\x05\x15\xbd\x24\x0e\x69\xa1\x24\xff\xc5\xcf\x3f\xa6\x00\x6c\x08\x9a\x8d\xe9\xdc\x6e\x98\x4d\xd4\xd9\xd4\x45\xc0\xb5\x65\x8f\xa0\x49\x87\xca\xe1\x82\x7f\xf7\xdb\xc7\x36\x8b\x92\xb7\xfb\xf8\x09\xe5\xc3\xe2\xce\xc6\xa9\x91\x78\xa6\x2e\x4a\xd7\xbc\xfd\x30\x37\xd0\xd3\xd3\x71\x31\xc4\x01\x3e\x66\xe8\x77\xcb\xac\xf6\x04\x08\xf7\x88\x03\xb8\x3d\x4b\xfb\x6b\xa0\xc9\xb8\x02\x57\x4b\x10\xdd\x0e\x35\xf7\xd0\x49\x79\xb3\x19\xb8\xa3\xc6\xed\xc0\x43\x66\xa9\xfd\x0c\x07\xdc\x19\x7f\x16\x53\xa4\xae\x40\x4b\x17\x08\x89\x3a\x03\xa9\xd7\x04\x18\x4a\x70\xd0\xa6\x10\x0d\x44\x6c\xf8\x4b\x73\x58\xe8\x84\x18\x19\x18\x75\x18\xbe\x2f\x92\x82\x9b\xe0\xfc\xe3\xf5\x63\x5e\xbd\xb5\x02\xa2\x96\xa0\x81\xf7\x1c\x9b\xc6\xe7\x83\x6b\x8a\xed\x77\x56\x00\x42\x65\xeb\xe5\xbf\x78\xfe\x17\xf1\xed\x27\x8c\xcb\xe5\xd1\x99\x0a\x80\x96\xf5\x25\x5e\x77\xea\x17\x64\xdd\xcd\xc5\x52\xf2\x3b\xf8\x69\xf1\x88\x1c\xc2\x1c\x62\xe5\xba\xe6\x65\x28\x1e\x5c\x89\x1a\xdf\x46\x5b\xe4\x9d\x54\xff\x9f\x02\x35\xa0\x01\x0a\x92\x1c\x61\x78\x2c\x91\x7c\x2e\x50\x4e\xdb\x43\xfd\x5d\xf8\xc2\x05\x65\xc0\xcf\x43\xb3\x33\x4f\xad\x9e\xdb\x67\x20\xf3\xd3\xb4\xdb\x7b\xb4\xc3\xcd\xa2\x73\x47\xf8\x89\x36\xdf\x8d\x84\x10\x55\xc7\x93\xff\x01\xe0\x11\x3a\x68\x59\x8a\xa2\xb9\xac\x83\xbd\x59\xd2\x2f\x62\xfa\x56\x3a\x66\x7b\xee\x66\x36\x37\x4d\x7c\xef\x74\x0b\x5f\x05\xab\xc9\xef\xe6\xd7\xee\x90\x49\xd0\xc6\xb7\x71\x03\x2f\xf7\xa1\xde\xd3\x71\x72\xa0\xfa\x9b\x02\xaf\xf2\xd8\x07\x31\xde\xdf\x5f\x8e\x22\xea\x46\x69\x71\xff\x12\x3b\x42\x11\xf6\xb8\xb8\xe2\xee\xeb\x62\x56\xfd\x1f\xf4\x27\xc2\xab\x01\x44\xc4\x61\x8c\x09\xff\x60\xd8\x51\x9f\x0d\xfc\x92\x4e\x0e\xb6\x6e\x08\x9b\xa1\x32\x9c\x81\x66\xa9\x5a\xa3\xb5\xf4\x7d\xe6\xc9\x0e\x46\x8e\x30\xc0\x6d\xa4\xed\xc9\x3e\x98\xbf\x93\xde\xc6\x89\xc7\x5a\x07\xf9\x4a\xd1\xfc\x99\x38\x41\xeb\x1b\xeb\x1b\x86\x75\xa4\x78\x47\x6d\xdb\x34\x50\x4e\x54\xa7\x19\x4a\x9b\xa3\x96\x69\x09\x2d\x54\xeb\xec\xb7\xa7\xc3\x97\x4d\x00\xca\x8b\x6f\xb1\x94\x82\x42\x4c\xe4\x60\x9c\x05\xa5\x9e\x85\x16\xca\xb8\x48\x2a\x5d\xab\x8f\xa7\xde\xc1\x09\xc7\xf7\x68\xfa\x27\xf1\x54\x11\xe7\x12\x4f\x35\x06\xd1\x97\xd1\x2e\x01\x09\x08\xd1\x9f\x49\x1e\xe8\xe8\x81\xfa\x83\xe4\x40\xf2\xb2\x35\x0d\x6b\x1d\xd0\x85\x26\x3f\x2a\x87\x9c\x0b\x21\x7b\x92\xed\x36\x8b\xc0\x44\xf9\xf0\xe2\xeb\x4b\x61\xed\xce\xc3\x9a\x14\xd9\x53\x21\xd9\x25\x54\x8e\xa4\xca\xb5\xde\x38\x77\x47\xf3\x6f\x54\x99\x3f\xe9\x63\x23\xa2\xa3\x94\x7b\x4e\xc2\xdb\xfb\x83\x38\xf3\x1e\xc3\xfc\x63\x6e\x7f\xbb\x99\xc5\x1e\xbd\x88\xe3\xfa\x3a\xa7\xa9\xc0\x10\xb0\xda\x78\x3c\xd3\xf7\x5a\x25\x2a\xca\x19\xe7\xf4\x15\x34\x9e\x6e\xc6\xc7\x01\x18\x87\x2d\x9b\x13\x6f\x07\x04\x73\x21\x78\x87\x69\xac\x6e\xc4\x89\xa3\xd3\x38\x45\x6c\x89\xf1\xea\x91\xb2\xe6\xc6\x0b\x75\x0d\x83\xe9\x57\xe2\x19\x6b\x89\xf0\x64\x13\x13\xee\xd8\xdc\xfb\xe1\xf4\xe4\xe9\x8a\xfa\xf1\xaf\xf9\xb7\x1b\x57\x92\x5b\xc1\xf8\x10\x4c\x29\xb4\x3c\xd0\x28\x60\x0f\x04\x1c\x83\x6a\xac\x76\x05\x86\x92\x33\x65\x95\x75\x91\xe6\xd2\xe7\x7e\xb9\x02\x87\xa4\xc5\xea\xfe\xc9\xc4\x9c\xa7\xc0\x08\x17\x46\xca\x84\xc0\xa1\xb4\xdd\x18\xb9\x02\xa5\x41\xf9\xaa\x98\x9a\x65\xd3\x90\x5d\x0c\x2e\x02\x21\x83\x2d\x24\x83\xbd\x07\x5b\xb2\x86\x08\x5a\x88\xb4\xdb\x86\x4a\x64\xc0\x1a\x2d\x73\xf5\x86\xe1\xc3\x77\x92\x54\x00\xc1\x7f\x36\x10\x00\xd4\x01\x40\x67\xd9\x3a\xdb\x0f\x49\x42\x2d\xff\x35\xc7\x4a\xe0\x90\xf2\x80\xad\xc6\x00\xd0\x65\x43\x8c\x63\xa5\x60\x2a\x75\x1e\xb0\x82\x72\xf3\xaa\x63\xec\xaa\xd1\xce\xc4\x6d\xe9\x7a\xc8\x20\x8f\x41\x87\x4c\x30\xd4\xcf\x53\x78\x58\x7c\x51\xc1\x14\xea\x50\xb9\xd9\x64\xa9\xe5\xed\xab\xec\x35\x01\x56\xbf\x72\x2c\xf2\x89\x15\x3b\xfd\x56\xf8\xbd\xfb\x1d\xd4\xc7\xc1\x34\x72\xac\x8d\xfc\x04\xdf\x37\x5c\x4c\x36\xe7\xe9\x48\xbc\x01\x8e\xaf\x1e\x8a\x58\x3c\x85\xad\xf8\x52\xa2\x40\x2f\x15\xe8\x68\x56\xda\x92\x0f\x43\x0a\x26\xf1\x91\xea\x4f\x3b\x7a\x6c\x2b\xc5\xf1\x7f\x52\x8c\xe3\x2e\xd2\xaa\x69\xee\x93\x8a\x69\x2b\x74\x5d\xbb\xd6\x31\x3e\x3b\x11\x1f\xc7\x53\x08\x08\x9f\x45\xf2\x08\xe6\x14\xf6\xa1\x45\x2e\x29\xb0\xe4\xa9\x2d\x75\x10\x3f\xb8\x35\xc3\x5e\x89\x7b\x52\x70\x26\xf5\x80\x08\xdd\x81\x12\x7b\xfc\xf9\x11\xc5\x25\x7e\x00\xa6\x04\x5a\x6e\x08\x12\x02\x4b\xbd\xd9\x2e\x97\x52\x8b\x1b\xf9\xb2\x59\x76\xb8\x64\x92\xc4\xf6\x02\xeb\xa8\x2c\xcf\x5d\x35\x21\x28\xb1\xd4\xcf\xe1\x7e\x67\xcd\x2d\x3c\xa9\x99\xef\x74\xa7\xac\x8c\x63\x80\xec\xcd\x92\x73\x12\x34\xf8\xcb\x19\x7c\xcd\x43\xa0\xcc\xcf\x86\xfa\xa8\x36\x10\x61\x9d\x52\xad\xf5\x30\x18\x87\x3c\x4e\x84\xd6\x11\xc8\xeb\xe6\xe5\xfb\x2f\x5a\x2a\xd1\xa5\x7e\xfb\xf9\x52\x1a\xd0\x15\x18\x4a\x91\x9a\x74\xd5\x37\xf7\x88\x14\x7b\x59\x60\x5e\x90\x69\xd5\xa0\x07\x8c\x6c\xeb\x04\x6a\x5c\x9b\x17\x8e\xb3\x87\x0e\x36\xcf\xd8\x36\x1c\x09\x35\x70\x62\xbe\x31\x25\x7a\x6a\x1a\x55\xc2\x70\x7c\x90\x2c\x88\xd2\x39\x17\xad\x23\x51\x81\x23\x1e\x91\xd6\xa0\x0b\x04\xff\x5c\x63\x02\x38\x5e\x20\x09\x7b\x43\xf2\xc5\xb3\xbb\x29\xff\xf5\x0a\xae\xf2\x21\xdc\x18\xf5\xe6\x29\x7e\x1c\x40\x9b\x8e\x6a\x76\x93\xf2\x10\x54\xb0\xa9\xa8\x9a\x16\x88\xa6\xc4\x7e\xc8\x24\x66\xe0\x42\xf9\x9e\x4f\x5a\x17\xeb\xad\x19\xfa\x59\x07\xfe\x53\xb1\x3f\x03\xfb\x69\x00\xf8\x08\x4e\x97\xbd\x20\x99\xe7\xea\x5a\xad\x3c\x25\xfa\xb2\x0f\xe5\x9d\x8d\xba\x6e\x08\x9a\x59\xb9\x11\x1e\x59\x84\x11\x25\xce\x88\x3e\x49\xd6\x8a\x5e\xfa\xe9\x60\x5a\xe2\x96\xdd\xe3\x49\x39\xe3\xb0\xf8\x98\x25\x66\xe1\xec\x26\x17\xad\x1b\xdc\x78\xce\xb5\xb2\xd0\x16\xed\x8a\xe4\xfb\x19\x71\x23\xb5\xca\x05\xd1\x9b\xea\x65\x40\xf2\x5f\xd5\x7a\x06\xb0\x06\x70\x83\x02\x78\x3d\x6f\x34\xe6\x99\x46\x9b\x95\xd8\x9a\xb9\x22\x99\x10\x39\x77\x6f\x75\xdd\xb6\xba\x30\xa4\xdf\x89\xcd\x04\xaf\x98\xce\x64\x0f\x10\xd3\xc5\xe4\xdd\x2e\x3c\xe8\xca\xc5\x58\x59\xf3\x71\xd5\x07\xaa\x00\x63\x50\x03\xd8\x1e\x63\x14\x37\x8f\x14\x46\xa1\x0f\x80\x3a\xe8\x75\x10\x85\x1f\xf4\xbe\x8f\xef\xb1\xf8\x21\x57\x11\x6d\x8a\x63\x1b\xf5\x2c\x2a\xfe\x4a\x94\x06\x6c\x3a\xf3\xe3\x6b\x31\xa2\x67\x14\x85\xbb\x43\x63\xc6\x73\xfe\x91\x6f\xfd\xa2\x74\x0f\x11\x14\x79\x6e\x71\x75\x1c\x71\xf5\x12\x5b\x94\xc3\x63\x0f\xae\x1b\xbe\xec\x5e\x3d\x0c\x8f\xbd\xfd\x88\xea\xec\xf1\xad\x66\xf3\x2c\x40\xb5\x7f\x64\x13\x45\x97\x3a\xb0\xfc\x23\x64\xca\x7f\xe9\xfa\x08\x03\x13\x16\x29\x70\x68\x8e\x31\xd8\x10\x12\xc6\x3f\xb9\x60\x62\x1d\x98\x90\x50\x52\xad\x19\x69\x03\x48\x1d\x80\x55\x1d\x10\x07\xac\xc5\x6e\x82\x2c\xd6\x21\xe7\x80\x0d\x92\x62\x2f\x8e\xa2\xb2\x96\xf0\x4c\x7c\xd5\xd5\xbf\x5d\xff\xa9\xa5\x6e\xe7\xad\x5b\xb9\x1f\xc2\x6d\x4e\xe6\xcb\x6f\x8c\x50\x19\x63\x82\xfa\x90\x76\x5c\x40\xe4\xc9\xb3\x02\x67\xbd\x91\xf2\x1c\x3d\xf1\x0a\x3f\xfd\xf3\xd8\xec\xf2\x54\x85\x72\xbf\x73\x10\x65\xd1\x6b\x34\x9b\x97\xea\x05\xeb\x55\xe3\x4b\xd6\xcb\xf2\xec\xa7\x59\x65\x9b\x4b\xd3\xf8\x97\x17\xe8\x81\xca\x0a\x9d\xaa\x94\xde\x52\x50\x28\xf7\x11\x12\xfe\xaa\xe6\x03\xa6\xd3\xfe\x49\x86\x18\x7a\xf5\xae\x28\xd8\x42\x12\xab\x83\x22\x44\xb2\xc8\xd3\x1f\x70\x8e\x70\x56\xe3\xb5\x8c\x14\xf3\xa5\x3a\x40\x6b\x20\xaa\x99\xc6\x9a\xfd\xb7\x8a\x11\xb5\xa0\x8e\x7c\x69\x0a\x15\x6b\x1c\xe4\x12\xdf\xf9\x85\xbe\xbd\xca\x7e\x1b\x9e\xa1\x6e\xcc\xee\x94\xdb\xe6\x85\x2b\xe5\x82\x36\x16\x8b\x73\x9e\x2b\xc8\xfe\xe6\xc9\xa8\x0e\x14\x2a\xfa\xeb\x54\x6c\x77\x78\x42\x73\x23\x53\x93\x12\x55\x13\x25\xd1\xcb\xb4\xb3\x5b\xec\x38\xbe\x9a\x91\xb3\x69\xec\xca\x33\x95\xb3\x07\x6a\xa5\xb1\xb4\x11\x87\x35\x7d\x19\x19\xb5\xdb\xe6\x3f\xa3\xb8\xb6\x25\xbe\x2e\x6c\x9f\xf2\x1d\x27\xc1\x63\xf1\x33\x30\xba\x27\xc1\xf6\x49\xf4\xc4\x43\xce\x12\x96\x1b\x7d\x25\xd7\xff\x3e\xfe\x2d\x7f\x50\xa2\xd7\x74\x13\x3e\x21\x81\x81\x26\xf0\x5c\xf3\xdc\x8a\x35\xe9\xa6\xf0\x4b\xa7\x55\xe7\x13\xfd\xa9\xea\xe1\x29\x1e\x5b\x9e\x2a\xff\x51\x39\x4b\x44\x51\xf6\x96\xc8\xae\xe9\x46\x02\x5a\x5e\x20\xe4\x02\x34\x7d\xfd\x1f\x77\x11\x66\x04\x48\x92\x80\x80\xf7\x7c\xf0\x86\xd3\x16\x77\x13\x9f\x53\xf3\xee\xfa\x12\x3f\x7f\x83\xd2\xe6\x92\xe7\xa9\x2c\x01\x16\x36\x65\x23\x4a\xf7\x9b\x5b\x9a\xcf\x73\xcb\xfc\x2c\x98\x0f\x3c\xc6\x9b\x33\xd8\x51\xa0\x05\x7f\xa3\x5c\x8c\x5c\xeb\x59\xa4\x72\x73\xa6\xff\x4d\x4e\xd4\x1a\x66\x70\x00\xf7\xbb\xdb\x36\x92\x2c\xf3\xaf\x2c\xc2\x55\x9a\x73\x39\xf5\xd1\x4f\xa6\x9b\xfe\x07\xe3\x6f\x71\x01\xec\xe5\x0d\x15\xe9\x48\xf5\x1c\x81\xa9\xcc\x89\x6b\x41\x4c\x87\x97\x8c\x48\xea\x49\xa8\x1e\xc4\xf5\x69\x28\xc7\xd5\x9d\x6e\x9e\x61\xff\x52\x03\x44\x43\xcd\x63\xa3\xe3\x87\x69\x0b\xd1\xf1\x81\x78\x1e\x49\x94\xfc\xd6\xd2\x6b\xe3\xc6\xf2\x07\xed\xb8\xe1\xab\xad\xde\xf8\x53\xed\xc8\xba\xed\x27\x12\x20\xf0\xd6\x1c\x99\xbc\xd4\x32\xdf\xfd\xc3\xd1\xfd\xa7\x6a\xd5\xbc\xff\xd9\x67\x14\xf0\x31\x04\x06\x75\xc5\xe1\x49\x13\xa3\x43\x86\xf1\x85\x24\xf0\xcc\xc2\x43\xae\x09\x2e\xb7\x67\x9d\x1f\xca\x82\x99\xb5\x2e\x9a\xa8\xa4\x1c\x88\xc7\xd6\xa9\x26\xfb\x16\x7d\xa5\x90\xcf\xcd\xa1\x6a\xf3\x66\xc6\x77\x14\xe5\x93\xe5\xc6\x88\x25\x1c\xe3\x77\xe6\x77\xdf\x15\x6d\xcb\x72\xf5\xb6\x54\x76\xee\x6c\xb4\xbf\xa3\x1c\x2f\xf8\xff\xc7\xea\xe4\xc0\x20\x44\xa3\xdc\x3c\xa1\x48\xa2\x62\xd5\x59\x7e\xf5\xeb\x97\xa3\xec\x95\xe2\xc0\x0c\xdd\xaf\x01\xfe\x1a\x5a\x10\x74\x9f\x89\x8b\x83\x46\x3e\x44\x4e\x53\xaa\x0f\xbe\xeb\xaa\xe4\x82\xd4\xc6\xcd\xe7\x14\xff\x31\xdd\x8e\x22\x86\x18\x93\xc3\xfb\xfa\xc7\x1d\x06\x22\xae\x0f\x81\xfb\x08\x3e\xd3\xd4\x13\xae\xcb\xe1\xca\x23\xf1\xd6\x11\xc1\x5d\x24\x62\xe8\x9f\x56\xff\xf7\x4e\xcb\xcd\x60\x18\xee\xb2\x23\x28\x40\xcf\x63\x83\xec\x93\xab\x2b\xc5\xc8\x12\x16\xfe\xec\xe5\xd3\xb2\x31\x39\xdd\x7a\x9a\x03\x8a\x67\x12\x26\x8f\x68\x62\x32\xd1\x72\x97\x55\xcb\x97\xc0\xa8\x68\xe7\xbc\x33\x0a\x9a\x6e\xcf\x76\xff\xda\xc0\x70\xd7\xea\x72\xfc\x81\x80\x69\x94\x92\xd2\x1a\xcd\x73\x74\x48\xb3\x30\xb2\x3a\x0e\x80\xd1\xdc\xa7\x1b\x76\x69\x18\xed\x8a\x3a\xfe\x37\x2b\x35\x20\x80\xbf\xaf\xe2\xe4\x40\x29\x6c\x61\x60\x32\x99\x90\xe9\x77\x7d\xa1\x4b\xf5\xf8\xea\x20\xa5\xa4\xb2\xcb\x62\x99\xab\x2b\x95\xbd\x76\x2e\xe7\x3c\xc3\x33\x8c\x0e\x32\xcb\x79\x90\x6a\x6e\x3e\x03\xdc\x8e\xae\x8b\x73\x1e\x33\xa8\xa3\x55\x51\xb2\x5b\xe9\xe4\x80\xda\x73\x3b\xe3\x37\x16\xe3\x39\xfd\x42\xe4\xc3\x0d\xcb\x7c\x8b\x33\x0d\xd3\x19\xcb\x7f\xda\x89\x14\x3b\xe4\x2f\x7f\x19\x24\xf9\xf3\x2e\xec\x7c\x97\xec\xfc\x9e\xaa\xc7\xe2\xda\x2d\x19\xcf\xbc\x29\x36\x6c\xc2\xca\x26\x04\x22\x3d\x85\x94\xd6\x8a\xb5\x54\x35\x37\xd8\xd9\x63\xab\x1a\x03\xfc\xf1\x58\xfc\x73\x35\x1d\x35\x3a\xc4\x87\x32\x2b\x56\x47\x23\x05\x30\xb2\x68\x7b\x4f\x47\x69\x6e\xc5\x1a\xb4\x9c\x6f\xe3\x4f\x69\xe4\x81\x65\x3d\xba\xa0\x08\x47\x53\x7d\xa4\xcb\x7f\xa1\x85\x83\x06\xc3\x45\xe4\xc8\xaf\x4c\xc3\x39\x46\x12\xba\xf4\xe0\xd0\x66\xc5\x8d\x1c\x10\xa6\xff\xe2\x5b\x6f\xcd\x01\xed\x11\xf3\x09\x35\xff\x27\x4f\x0d\x93\x8d\x05\x7a\x5d\x3b\x96\x14\x72\x0f\x1e\x5e\x4a\xc8\x8c\xb5\x11\x1e\x89\xff\x18\x69\x36\xf3\x18\x61\x01\x89\x55\xa8\x58\xeb\xa7\x5e\xc6\x29\xfd\xba\xc6\x9c\xa9\x62\x15\xb8\x77\x3b\x4b\xe5\xfa\xfa\xae\x4d\x74\x2e\xd8\xf6\xbf\x8a\x8a\xd8\x0b\x20\xad\xf4\x1f\x9c\x6c\xd2\x15\xb4\xbc\xb5\x66\x43\x6d\xe1\x4f\x5e\xc6\x6f\x9d\xfe\x61\x08\xe2\x5a\xdd\xfb\x71\xa6\x01\xa1\xa4\x91\x5d\x4c\x72\x08\x59\xf3\xf6\x21\xa2\x5e\x42\x58\x6f\xfe\x80\x5b\x49\x85\xb4\xfb\x37\x87\xc0\xb2\x2b\x25\x49\xdf\xa4\xf8\x91\x2c\xe4\xe8\x41\x9f\x19\x9c\x77\x0c\xd5\xe5\x98\x22\x98\xe4\xb8\x65\x56\x59\x9d\xb0\x67\x1a\xc6\x56\x14\x0b\x66\xef\x27\x21\x76\x30\xfe\x65\x8a\xa3\x58\xf1\x65\x07\x1d\x4a\x6d\xa8\x31\x60\x41\xa3\x35\x77\xc3\x7b\x5a\xda\xa1\x62\x62\x73\x43\xe7\x60\x73\x21\x76\x4b\x9d\x7d\x86\x2c\xcb\x53\xa5\xa3\x1b\x59\xa2\xcf\x3d\x46\x3c\xfd\xe0\xdf\xa0\x46\xd2\xb1\x00\xb3\x30\x6b\x38\xb9\x23\x08\xd0\xc1\xe8\x58\xf1\xce\x8c\x18\xcb\xbc\x32\x50\xc6\x14\x64\x0d\x61\x44\x44\x20\x22\x1e\xdc\xc1\xbc\x27\x74\xba\x7c\x95\xbf\x05\xea\x74\x19\xfd\xed\xf9\xcb\xb5\xfa\x00\xd1\xf2\x82\x8a\x82\x36\xa1\x66\xe3\xd7\xe5\x05\x7f\x2c\xc4\x92\xdc\xb4\xdd\x9c\x82\xc3\x22\x33\xc5\x22\xa9\x60\x8d\xc0\x57\x45\x49\xd9\x3b\x89\xcd\x2b\x02\x0b\xe0\xf2\x90\x69\x09\xaa\x41\x91\x70\x43\x11\x2a\xd4\x85\x8d\xcc\xa1\x21\x42\x1f\x0b\x0f\x4b\xff\x10\x46\x53\x7e\x50\xed\xbb\x78\x46\xcd\xa1\xf9\x95\x3a\xb6\xdf\xde\x64\xa3\xbe\xdf\x2e\x79\x84\x0d\xc1\xab\x7b\x95\xd4\xa3\xe7\x78\x72\x70\x0b\xa1\x5a\xf1\xbf\x44\x05\x75\x35\x09\x1d\x21\x31\xda\x5d\x44\xa3\xeb\x48\xe8\x58\xb3\x45\x09\xf4\x34\x1c\x3a\x9e\xc3\xca\x11\xbb\x12\x9c\xef\x61\xbd\x30\x98\x9a\xfc\x86\x44\x1e\xc7\x80\xce\x05\x81\x11\xbc\xe3\xcb\xfb\x96\xf1\x03\xc2\x0c\xba\x91\x23\x21\x93\x23\x66\x96\xad\x79\x05\x46\x94\x90\xe9\x80\xd6\x00\xd6\x9c\xc7\xbf\xa3\x11\x25\x60\x47\x0b\x54\x40\xe2\xca\x26\x74\x65\x18\x63\xe4\x76\x0f\xa0\xd2\xd7\x17\x81\x01\xfe\xa9\x8a\x11\x65\x4a\x4a\x73\xd6\x35\xb2\xb8\x01\x89\x77\x6a\x24\xfb\x3b\xb6\x5f\x13\xbe\x51\x37\xfd\x6a\x46\xa1\x0b\x22\x29\x47\x9b\x41\xfb\x07\x4c\xd5\x55\x68\x1d\x69\xd7\x7b\x1b\x30\xbb\xbe\x4c\xce\x3e\x51\x29\xfb\xd8\x6d\x2a\x53\x44\x9d\x5f\xf3\x0a\xdf\x32\xa2\xcb\xb7\xff\x3c\xfa\x84\x40\x44\xdd\x90\x64\xc4\xf5\x64\xf5\x67\x54\xea\x2f\x6e\xa3\xeb\xe7\xaf\x71\x2d\x70\x95\x22\x75\x3e\xb9\x72\x17\xbb\x07\x87\x7b\xbb\x52\xc4\xeb\x68\x6d\x06\x6d\xc2\x55\x82\x6f\x7a\xae\xa9\x2e\x83\x2f\x86\xab\x84\x22\x4f\xef\xd6\xf8\xdb\xf0\xc8\x2f\xd1\x70\xb4\x21\x52\x3c\xe8\xd1\xb7\x11\x52\x79\x5a\x45\xe5\x42\x3b\x72\x45\x1d\x1f\x3b\x3e\x98\xa3\x30\xce\xd4\xce\xf4\x74\x77\x6d\x8a\x96\x1a\x76\xe5\xca\x95\xf5\xb4\x09\xbf\x08\x13\x7a\x86\xca\x12\xab\xe3\x08\xa2\x07\x18\x22\x5d\x04\xc7\xb7\xda\x6e\x8f\x7d\x6c\x9d\x1f\x21\xbf\x2f\x21\x91\x63\xf6\xb3\xd9\xdd\xc3\x69\x5b\x47\xbe\x41\x25\x25\x11\xe1\xdc\x18\x1d\xb7\x01\x41\xe4\x01\xa8\x4d\x23\x66\x14\x21\xcc\x20\xc5\xd4\x08\x19\x5f\x12\xe4\xcf\x7a\x64\xe4\xd5\xc4\x73\xec\x90\xe8\xb2\x1d\x37\x49\xe1\x10\xa9\xa3\x05\x46\xca\xd4\xdf\x56\x70\x0c\xdc\xd1\x41\xde\x65\xcf\x8a\x7b\x76\x51\x4a\x39\x02\x55\xa0\x06\xcc\xb7\x0a\x6f\x00\x90\x04\x81\xb9\x2f\x58\xde\x2c\x6d\x0c\x0b\xe6\x82\x79\x0f\x65\x55\x6d\xb1\x9b\x8d\xec\x70\xc2\x51\x34\x06\x7f\x5e\x85\x32\x86\xf2\xed\xf4\xa0\x46\xf9\x15\xbb\x4b\xab\x08\xf7\xe1\xdf\xbd\x5d\xc9\x38\x11\xe0\xa3\x54\x65\xce\x16\xef\xc5\x6a\x8f\x23\x94\x54\xe7\x1e\xa6\xdf\x6d\xb0\x64\x51\xc7\xef\x0f\xdb\x07\x75\x4f\x3e\xbb\x47\x09\x2b\xb6\xe6\x73\x01\xbb\xe8\xff\x45\x10\x16\x18\x02\xba\x7c\xed\x12\x43\xf9\x82\xc0\xe5\x33\x9a\xfd\xd9\xd7\xd5\x5e\x81\x98\x17\x7b\x6a\xfa\x93\xb3\xa1\x5e\xd6\xa4\x3a\xa5\xfa\x2a\xe4\xd3\x6c\x94\x6e\x89\x23\x39\xee\x87\xbf\xec\xb8\xd1\x0e\x25\xbe\xbb\x22\x45\x32\x3a\x4a\x9c\xb4\xd0\xa3\xcc\x1e\x1d\xcb\x07\xf9\xa7\xd4\x26\x20\xb1\x4f\xae\x6a\x7e\x20\xf8\x53\x9e\x1b\x77\xa1\xd8\xe6\xd3\xfe\xbd\x3e\x01\x64\x81\x08\x82\x73\xc4\xba\x2b\x60\x26\x52\x05\xb4\x3c\x73\x7a\x7c\xbc\xd0\x16\x3e\xbd\x4a\xd7\x8b\x72\xbf\xa5\x9c\xe1\x76\xaf\x2e\xd5\xa3\x2a\xc4\x3e\x7b\x9d\xe2\x12\x9f\x23\xeb\xb8\x11\x44\x34\x2a\xce\x4c\x71\x46\xd0\xa4\x01\x74\x30\xcd\x14\x8f\x2b\x4a\xb9\xc3\xc7\x1d\xc2\x3b\x6b\xc3\x25\xa7\x1a\x05\x01\xcd\xf3\xa2\xd4\xca\x84\xfe\x6d\xb5\xdd\xc2\x5b\xe0\x00\x6b\xf6\x1c\xcd\xe9\xef\x7c\xbc\x44\xf4\xa1\x9d\x4f\xc7\x2e\xe7\x65\x15\xee\x35\x61\xbd\x5f\x8f\x43\x40\x3a\x17\x28\x28\x75\xf4\x1a\xe7\x41\x01\x62\x89\xf8\x7e\x5a\x1c\xab\xd6\xc9\x98\xed\xe1\xe0\x2e\x90\xfb\x5e\xb9\xe3\xe7\xeb\x1b\x52\xa6\x45\xa3\x93\x03\xb8\xed\x68\xd5\xe7\xc9\xc2\x36\x5b\x04\x14\xbe\xd5\x36\x53\x72\xf2\x82\xbd\x71\x5f\x69\x5e\x7e\x2e\x92\xae\x17\xe7\xc3\xf5\xb7\x34\x45\x78\x76\x96\x53\x4b\x50\x4b\x10\xe9\x79\xc5\xab\x65\xfe\x7f\xa6\x0b\x13\xf4\x8e\xaa\xcb\x83\x6c\xe0\x88\x8b\xb8\xd4\x2d\x3c\x2f\x46\x49\x7f\xde\xf0\x55\xbc\xf1\xd3\x79\x41\x8c\xbe\xca\x75\x8f\xce\x97\x23\x70\xa5\x9c\x02\x79\x0b\x23\x85\x01\xd4\x87\xbc\xe8\x11\x48\x3b\xf8\xfa\xf0\xd5\x52\xf2\xfd\x2b\xd2\x75\x07\x8b\x74\x8f\x91\xd0\x7a\xac\x2d\xab\xb7\x5f\x1f\x38\xc4\x76\x4a\x65\x06\xc4\x36\xe9\x30\x09\xce\x4f\x2b\x4d\x97\xdc\xa5\x21\x8d\xf7\x34\x04\xff\x53\x03\x78\x00\x4b\x40\x22\x24\x7e\x50\x99\x75\xb0\x2f\x4b\x34\x0c\x07\xc5\x76\xd3\xe5\x8a\x24\x68\x0a\x05\x5d\xa4\xf0\x24\xf7\xd3\x6a\x96\xa9\x60\x32\x82\xf5\x9c\xdf\xb7\x8b\x8b\x45\xfc\xbe\x30\xb6\x37\x96\xf8\x69\x87\x14\x99\xef\x28\x01\x1c\x2b\xf2\x35\x92\x2f\xdc\xbb\x4b\x55\xfe\xa4\xe1\x6a\xac\x23\x4f\xbc\x44\x8c\xc6\x16\x6e\xc5\xa3\xc0\x84\x26\xdd\x29\x2f\x63\xd5\x86\xe2\x47\x90\xff\x8e\x7d\xc1\x80\x21\x71\x69\x02\x9a\x0c\x98\x92\x3a\x18\xad\x33\xbf\x1e\x74\xf1\x15\x1c\xe0\x29\x55\x0e\x52\xac\x02\x05\x72\xb7\x3c\xa7\x0d\x69\x74\x77\xac\x4c\x6e\x7b\xec\x71\xa8\x92\x77\xc8\xca\x04\x5f\x80\x2d\x49\xf2\x34\x57\xbd\x75\x9e\x32\x6e\x69\x6d\x1e\xfa\xf6\x7c\xcd\x65\x3a\xfa\x46\x64\xfc\x0b\xbc\x12\x1c\xb2\x13\xc9\xa5\x67\xbb\xec\xb0\x7b\x83\x8b\x38\x6f\xed\xf9\xb7\x1c\xda\x70\x00\x0c\x46\x29\x73\x0e\xbe\x6a\xdc\xc1\xfc\x11\x73\x4c\x06\x35\xab\x8e\x40\xa1\x34\x8f\xa8\x5b\xde\xe1\x64\x64\x0e\xbd\xbf\xc1\xc7\xf1\x18\x14\xcd\xbb\xe0\xc4\x37\x97\xeb\xf5\x42\xd2\xd7\xd1\x18\xc4\xeb\x5c\x96\xc3\xb7\xcc\x77\xfe\x16\x4c\xa8\x4f\x9f\x99\xd1\xec\x2b\xf0\x2f\xf1\x83\xc3\x3b\x25\x60\xb9\x91\xbb\x4b\xce\xe0\x18\xbf\xc6\x0a\x4a\x67\xb7\xc8\xf6\x74\x54\xe2\xb5\x23\x85\xb5\x03\xfe\x96\x5d\xd5\x54\xbd\xfe\x88\x31\xf4\xa9\xfd\x79\x43\xbe\xfc\x87\x02\xe1\x3c\xfb\xe0\xb4\xbc\x68\x15\xc8\x52\xcb\x83\x6f\x6e\x1c\xc7\x0b\x3a\xaf\x5f\xa1\x43\x39\xae\xbe\xe6\x12\xe7\x8a\x6b\x34\xa4\x9e\xd7\x54\x01\xde\xde\x32\x4b\x50\x6e\x8a\x07\x13\x52\x04\x66\xd5\x9d\x09\x41\xcf\x9e\x51\xab\x94\x0c\x8a\x95\x04\x9b\x70\x04\xa6\x78\x81\x21\x11\xe9\x90\xe4\xc6\x66\xca\x47\xbc\x45\x5a\xc4\x80\xed\xbf\xcb\xce\xa2\x0d\x40\x0e\xe1\x76\xa9\x22\x4e\x4b\xf0\x42\xbe\xe4\x59\x31\x47\x3b\x4b\xca\xd8\xe0\x99\xc1\xbd\xee\xe0\x0a\xa9\x94\x8e\x54\xfd\x06\x96\xb8\x3a\x10\xbc\xec\xf8\xe5\xf1\x95\x29\xc8\x3f\xb2\xcf\x6c\xdd\x30\x49\xde\xaa\x87\x93\x60\xf8\xad\x1b\x85\xd7\x17\xf3\x72\x82\xe0\x48\xda\x03\x2d\xa0\x46\x79\x9e\x23\x41\x58\xcd\xc4\xbe\xd3\x6f\xf6\xdf\x70\x89\x12\x02\x2a\xd3\xfd\xa4\x69\x55\xc9\xef\xce\x14\xc8\x39\x62\x13\x4c\xaf\x02\x21\x48\x7c\xba\x12\xf4\x9e\xfe\x99\x92\x46\xf7\x88\x9d\xe6\xc7\x21\x1a\x30\x3f\x40\xc7\x25\xaa\xf0\x1b\xd1\x6c\xb0\x7a\x07\x74\x8f\xc1\x38\xe8\xbb\x60\xd9\x8f\xf6\x0f\x66\x9d\xe2\xd4\x49\xe4\x77\xf8\xc1\x05\xe8\xc1\xd2\xeb\xe3\x35\x1f\xc9\x6e\x54\xdd\xdb\xfb\x89\x6a\xd2\x96\x6f\xbf\x7e\x5b\x4a\xa4\xd2\x70\x89\xfc\xa5\xd6\x6d\x2c\xee\x78\x5e\x39\xb5\xc0\x6b\x0c\x65\xe1\x5b\x5b\x7f\xb2\xf7\xa6\xfa\x52\x42\x36\xd1\x3b\xc5\x99\xe9\xf2\x00\x4a\x05\x70\x9f\xc3\xd9\x27\x62\x09\xb4\x45\x7e\x05\x11\xd5\x34\xc7\x8d\xe0\x15\x9a\xb6\x60\x1d\x0d\x59\x0d\xdf\x46\xaf\xd9\xd0\xfb\x4c\x89\x0b\x91\x66\xef\x79\x6d\x79\x95\x78\x09\x75\x79\x16\x61\x6b\x5f\x97\x49\xe8\x7e\x04\xb7\x7c\xe5\x09\x31\xde\x09\x6c\xfd\xb7\x15\x44\xe0\x42\xf0\x25\xde\x3f\x96\x4e\xa0\x63\x82\xcf\x6c\x2f\x6f\xb4\x09\xc9\xdd\x55\x4f\xa4\xf9\x78\x88\xdc\x6d\x10\x57\x99\xe7\xe0\x93\xdd\xa6\xd4\xd9\x69\x4c\x7c\x9f\x7c\x21\x11\x77\xa5\xff\x29\x19\x99\xf8\xf6\xea\xe9\x4c\xf9\xfa\x27\xfd\xea\xc4\xdf\x4b\xad\x8d\xee\xf0\xc3\xc5\x43\xbf\x86\x36\x2d\xee\x72\x84\x50\x29\x02\x3a\xef\xcc\x09\x2c\x4b\x03\x3c\xea\x1f\xff\x9d\x02\x84\xf1\x01\x7c\x5b\x53\xcf\xe9\xf7\x7c\x0e\x1b\x56\x0a\xd9\xfc\x51\x92\x1f\x94\x4a\x66\x25\x60\x9d\x5b\xf7\x47\x73\xa2\xf8\x52\x0e\x55\x8b\xcc\x2b\xc6\x1b\x6d\x7f\x2c\xa7\xef\xf5\xe7\x14\x44\xce\xe0\x2f\x5c\x16\x23\xf9\x7a\xef\xec\x90\x03\xcc\x3c\x54\xb4\xa9\x5d\x2d\x36\x89\xe7\x1f\x1f\x1d\xcf\xfa\x67\x0b\xa1\x0f\xe1\x0e\x2b\xc5\x56\xaa\x25\x20\xd4\x9b\x34\xa8\x41\x54\x6a\x58\x44\xdc\xad\xdc\x97\x90\x91\xdd\x51\x2e\xcd\xd9\x44\xab\x74\x1c\xb3\xfe\xd5\x6c\x02\x30\x03\xb4\x4b\xed\x8f\x2f\xc8\x7f\x5f\x70\x61\x74\x42\x29\x06\xf3\xc1\x4f\x19\xed\xf5\x95\x53\xd4\xc1\x89\xdd\x60\x89\x0d\x4e\x9d\xeb\x64\xca\xef\xe4\x88\x3a\x4e\xc0\x4f\x68\x4e\x72\x5c\x8d\x58\x0b\xf6\xe9\xd0\x8c\x32\x66\x6f\xb5\x4e\x0b\xe7\x48\x67\x8a\x6b\xdc\x2f\x39\xce\xd8\x0c\x0f\xa9\x79\xd9\x7d\xf7\x49\xf1\xd6\x36\xce\xfb\xb3\xe8\x4f\xd7\x3a\x8e\x1b\xce\x2d\x23\x76\x89\x4d\xe3\xe3\xf0\xa7\x23\xf1\x76\x7d\x7b\xa2\xeb\x55\x54\x6f\xb1\xe7\x1f\x30\xb0\x5a\x07\x63\x6e\x67\x17\xc9\x76\x02\xdb\x6a\xd6\xe3\xd9\x52\x3a\x64\x81\x28\x63\xfb\xd1\x7c\xfc\x67\x7c\x06\xf9\x8d\xee\x1b\xcd\x06\x58\xbb\x9b\x8a\xa6\x37\xae\xe3\x35\x83\x2b\x9d\xcd\x95\x78\x89\x9f\x09\x5f\x27\x7f\xa7\xe4\xe2\x72\x50\x9a\x37\x33\x82\x8e\xbf\xcc\x57\xc5\x9d\xf9\x74\x7a\xd9\x4f\x26\x74\x7d\x0a\xff\xf5\x2b\x4e\xbe\xe1\xb0\x8a\xc7\x37\xe3\x0b\x6d\xdf\x80\xc6\x5e\xc6\xa7\xea\x64\x7b\xa1\x7f\x47\xeb\xcb\x56\x20\xed\x4f\xdc\x79\xcf\x4f\xf2\x2e\x4f\x5d\x58\xe5\xce\x0c\x02\xb7\x69\xc2\x5b\x5f\xf0\xcf\xbc\x45\x2f\xd6\xa8\xca\x3f\xa6\xaf\xcc\x7f\x28\x7a\x5d\xae\x1e\x2c\x5a\x94\xb8\x5f\xd4\x18\x9d\xd9\xe1\xdf\x67\xdc\x72\xb2\x30\xd1\x5a\x64\x11\xd6\x19\xf6\x52\xab\xd7\x6d\xef\x2e\x36\x64\x04\xd6\x4c\xb1\x1c\x45\x3c\x6f\xc9\x51\x3d\xc9\xbe\xbb\x23\xb1\x8b\xf8\xc5\xd0\x72\xc5\xda\x70\xd8\x3b\x56\x43\x9a\x51\x1f\x67\x25\x4a\xfb\x50\xcb\xaf\x04\x0c\x04\x2b\x2d\x94\x43\xfd\xfa\xd9\x19\x7b\x23\xe9\x2c\x8c\x64\x97\xd3\x64\x2d\xbb\x61\x76\x7f\x0c\xd8\x19\xef\x68\x6a\x74\xf7\x17\x49\xa6\x57\xa5\x3f\xef\x96\x39\xcc\x0e\xa9\xf0\xae\x47\xe1\xea\x0e\x7b\x8b\x25\x02\x4e\x5f\x3c\x8e\xad\x1f\x9a\x84\xfd\xfa\xe0\x87\x6b\x75\xec\x1f\xe3\xbf\x61\xe6\x10\x08\x4e\x2c\xfd\xe1\xbf\xce\x7c\x23\xd1\x52\x32\xa8\x09\xbf\x51\xe7\xe4\x38\xeb\xb7\xb0\xbd\xe4\xff\x0d\x25\xfc\x2d\x37\x9a\xb8\x6f\x7d\xd5\x25\x8b\x2e\x67\x06\xbe\xe0\x42\x83\xdc\xcc\x11\xbe\x75\x4e\x3c\xc0\x98\xbf\x4c\x78\xcf\x78\x9d\x43\x09\xf8\x38\x26\x5a\xfb\x46\x6d\x50\x8d\x3c\x92\xe6\x7e\xf0\x9f\x54\x2b\xff\x8f\x5b\x5f\x82\x16\xdb\xe1\xfd\x19\xfa\x1a\x2e\xd9\xb3\xec\x46\x8a\x80\xb2\x43\x72\x06\x0b\x33\xbe\x37\x93\xdb\xe7\x30\xe3\xa4\x60\x39\xf7\x27\x8f\x98\x8b\xf2\xae\x1d\x62\x6c\xd2\xac\x34\xa3\x04\x95\xec\x5d\x31\x96\x2b\x88\xa1\xa1\x79\xd5\x0e\x55\x39\x8d\x7e\x26\x53\xbb\xce\x5f\x9c\xcb\x03\x0f\x15\x12\xbd\xb3\x14\x2f\x1d\x9a\x28\x3a\x0c\x6e\x1e\xcd\xb2\x92\x8e\xd7\xbf\x25\x0a\x59\x73\x38\x3c\x85\x20\xe5\xe5\xd0\x5d\x11\x0f\x38\x83\x93\x50\xa3\xf5\x25\xe3\x56\x75\xbe\x18\xc0\xa0\x82\x6a\x81\xc3\xdf\xfe\xd0\x0d\x82\xbd\xac\x48\x65\x44\x93\x4e\xfa\x8a\x19\x35\x12\x91\xa6\x97\x6b\x2d\x19\xea\x80\xa2\x37\x8e\xb0\xa1\xd3\x32\x0e\xe3\xd8\x67\xe4\x2b\x20\x74\x5f\x56\x7c\x34\xa0\x0e\xf8\x3f\xc2\xff\xe0\x4d\x61\x78\xf7\xb3\x73\xab\xaf\x19\x65\x16\x17\xe0\xaf\x25\x62\xcc\x6e\x60\x61\xc8\x11\x48\x06\x82\x57\xd7\x73\xdc\x18\xbd\x2d\x19\x7d\x59\x95\x8e\xae\x9c\x8c\x41\xa9\x84\x1e\x2e\x77\x93\xd3\x22\xfa\xe2\x0a\x50\xbb\x2f\xdd\x88\x0f\xc0\x1f\x0d\xec\x7b\xc9\x87\x7c\xc5\xc5\xc5\x87\xde\x9e\x6a\x33\x87\x74\x38\xad\x3a\x2f\xf9\x17\x1e\x69\x80\x65\xfe\xd4\xde\x46\x33\xf3\x8b\x10\x79\x24\x5f\x71\xa1\xf7\xe5\x21\x03\xa5\x44\x91\xa0\xd1\x77\x5a\x75\xa6\xc5\x59\xde\x54\xcf\xf1\xdb\x07\xdf\xcb\xe9\x34\xb4\xba\xfd\x68\x08\xfc\xc2\x2e\x8e\x91\xd2\xf5\x42\x71\xc5\x48\x2a\x55\x28\xb4\xc1\x6f\xef\xf7\x45\xa5\x11\xb9\xeb\x50\x57\xfc\x4a\x75\x1a\xec\xb7\xd8\x40\x1d\x2d\x7f\x82\x11\x7e\x6f\xe3\xce\xa9\xed\xa1\x37\xfa\xc1\x8a\xc5\x3b\xcb\x97\x85\x43\xfc\x77\x58\xc0\x6f\x0c\xa4\x89\x60\x82\xee\xcb\x3b\x81\xdc\x22\x4e\x8e\xbd\xb5\xbf\x2f\xff\x5b\x6f\x90\xe5\xe8\x89\x03\x5d\xa9\xf3\x72\x73\x33\x2d\xec\x86\x6e\x7d\x51\x74\x18\x15\x31\xeb\x58\xb5\xc8\x64\x9b\x8f\x1d\x10\xf3\x34\xc3\xcd\xb1\x3d\x34\x00\x95\x81\x9e\xd8\xfe\x5a\xb3\x3d\x06\x07\x25\xf2\xb0\x65\x84\xf7\xdc\x8b\xed\x1a\x07\xee\x2d\x5b\x95\xb3\xf2\x27\xcc\x5a\x5e\x46\x6d\x50\x37\x4c\x8e\xd8\x67\x9e\x9f\x97\x38\xb5\xda\x53\xc3\xf1\x32\x3f\xf4\x48\xab\x84\x7a\x89\xab\xdc\x0c\x0a\xd5\xd2\xf7\xb1\x2e\xea\xd0\x8c\x29\xbc\xeb\xd7\xf0\x9e\x3b\x28\xe2\xcd\xca\x15\xfc\x93\x08\xc2\x31\x03\x4e\xe0\x8e\xe3\xc7\x3f\x4b\x27\xc1\xfb\x4b\xf4\xe7\xdd\xa4\x02\x01\x33\xa3\x87\x36\x4a\xef\x6d\x8b\x2c\x8c\xd4\x63\x09\x3c\xaa\x16\xc5\x2e\xf7\x0f\x88\x28\xfb\x7a\x21\x3b\x4f\x5a\x2e\x5c\x12\x22\x8b\xfa\x7f\x3f\xf3\x49\xf6\x72\x1d\xfa\x87\xfb\x86\x17\x4e\x2d\x44\x96\x0e\x4f\x83\xb2\x44\x9c\xd7\x22\x94\xa9\x4b\xc5\x7e\x30\x28\x75\xf2\xe3\xe6\x6f\xa5\x95\x54\x69\x1c\x0d\xeb\xd1\x7d\x8b\x34\xfa\xfd\x04\xb0\x97\x84\xf1\x5e\xa0\x39\xcd\x46\x3b\xf2\x1f\x72\xf1\xad\x29\x9f\x7c\xb6\x1e\xd3\x9d\xcb\x3f\xd4\x58\xa9\xf7\x0e\x39\x10\xdb\x4c\x68\x1e\xc3\x7e\xbe\xc3\x83\x11\x35\x87\x29\x21\x7b\x9b\x54\x8e\x48\x0d\x77\x7d\xff\x27\xef\x2f\x89\xd9\x7e\x79\xf1\x1b\x75\xc8\x3c\x93\x72\x57\x75\x99\xed\x45\x71\xe4\x1b\x4f\x3e\x22\x6d\x85\x77\xe0\x62\x46\x71\xf4\xe3\x4b\x37\xb2\x8a\xf8\x20\x23\x35\xb7\x69\x63\xdc\xca\xdd\x07\xf3\xf1\x27\x70\x46\x78\xce\xcb\x74\x6f\xbd\x4e\x9e\x11\x06\x57\xca\xd4\x98\x3a\xef\xea\x31\x85\x2c\xa6\xde\x15\xc4\x3e\x96\xf0\x52\xd0\x3f\x29\x8f\xc5\x90\x6a\xf0\x2b\xb3\xe5\x7a\x6f\x9e\xf4\x4c\xba\x02\x25\x91\x7d\xc5\xdb\xe0\xef\xea\x76\xc0\x67\x5c\xdf\xbb\x92\xe3\x46\x95\xb4\x4d\x2d\xf2\xeb\xc4\x39\xd2\x34\xa9\xef\xcc\xe3\x51\xd5\x12\x47\x90\x3f\x51\x28\x22\xb1\xd0\xfc\x58\x68\xf7\xae\x6a\x1c\xe4\x3c\x8b\x96\x15\xe2\xaa\x00\x58\x18\xb5\x6d\x33\xe4\x62\x55\x81\xc4\x30\xb6\x9d\x1e\xcf\x36\x6c\x33\x33\x81\xa4\xaa\xe6\xf9\x95\x83\x20\x28\x10\x6c\xe0\x9b\x8f\xae\x52\xa6\x52\xc1\x2d\xd0\xbb\x48\x54\x73\x84\x26\x00\xb8\x60\x01\x77\xa9\x50\x8f\xbd\x84\x35\x96\x1b\x9f\xf3\x41\xbf\x99\x92\x18\xa8\xc4\x4f\xf3\x4e\x9a\xde\x8b\xf9\xb6\x4f\xd4\xbd\x41\xa7\x1d\x9f\xf2\x51\xa4\xc4\x2b\xe4\x0f\x64\x20\xc2\x66\x1b\x91\x71\x90\x4b\xdc\xc5\xb7\x21\xe8\x8a\x1f\xf1\x3e\xfc\x20\x95\xc1\x7e\x80\x0d\x6a\x01\x48\x3e\x42\x96\x7d\x66\xbc\x6a\xe6\x3c\xb3\xa2\x3f\x65\x57\x66\xff\x58\x6a\x7c\x8c\x7c\x55\xac\xa5\xd2\x79\x7f\xf2\x6d\x5c\x9c\x59\x63\xa4\x01\x7a\x9e\x64\x5c\x2f\x0a\x9f\x88\xd8\x2d\x2f\x09\x3a\x17\xd3\xd0\xc3\x58\xde\x63\x3c\x72\xd0\x19\xd1\x93\x7a\x46\x61\x59\x1e\x4a\xfd\x63\x3f\x9b\xce\xc5\x55\x12\xe1\xba\xce\x5f\x9c\x60\x08\x4e\xdb\xf7\xc5\xba\xde\xee\x99\x32\xf5\x35\x96\xa8\x8a\x95\xc9\xc5\xd2\x2c\x49\xf7\xd0\x18\x28\x81\xf6\x06\x05\x4b\x88\x13\x9c\x8d\xfa\x3a\x8a\x37\xf5\xa0\x6f\xfb\x1f\xe5\x71\xf7\x43\x24\x69\xc3\x9b\x34\x2f\x5c\x86\x75\xd9\xa0\xe8\x8c\xf0\x11\x32\x8e\x55\xf8\x87\xfe\x6c\xc3\xa1\x48\x26\xca\xd9\xda\x68\x17\x9f\xe9\x75\x59\x63\xb6\x57\x4f\xc5\xde\xd3\xb4\xc3\xd7\x2f\x01\x08\x08\x40\x03\x30\x81\x41\x5a\x40\xa7\x12\x4f\xdb\xf0\x7d\x7d\xab\x83\xd3\x64\x1b\xed\x77\x71\xfe\xfe\x33\xcc\xb8\x7a\xea\xc2\x80\xde\x25\x57\xc7\xe8\x8c\x3d\xef\xc5\x1c\xf8\xf4\xc3\xfa\xe6\x90\xfb\xea\x5a\xf9\x43\xc1\xc5\x16\x1d\x43\x1a\xa6\x10\x98\xc0\xc3\xf6\xdd\x2c\x9c\xa3\xa9\x80\x65\xbc\xa3\xc7\xcb\x23\x11\x9d\xd1\x7e\x25\x7c\x8c\x5a\x2d\x75\xf9\xf7\x28\x4b\x4f\x2a\x1b\x8a\x6e\xb7\xfe\x33\x83\xda\x97\x5b\x87\x7c\x1b\x14\xbb\x9b\x46\xe1\xed\x76\x3b\xe1\x62\x5d\x1e\x0e\x43\x70\xe1\xdb\x9a\x1c\xe6\x9d\x1f\xf5\x80\xd9\x34\x8b\x25\x74\x42\x0e\x78\x9b\x09\x82\xe5\x8b\xa9\x00\xb0\x7f\x9c\x6a\x06\x17\xa0\x0c\xa3\x16\xac\x89\x36\xf2\xfd\xf1\xe3\x80\xfa\xa9\x0b\x92\xee\x38\xc0\x98\xca\x03\x27\x6a\x56\x19\xb4\x76\xa1\x8b\x24\x69\x4b\x5d\x1c\xb0\xa9\xf4\x7b\xfc\x69\xae\xad\xef\xf9\xc1\xed\x57\x2d\x89\x2a\xe3\xab\x33\x1b\xa0\x03\x08\x70\x80\xa5\x6a\xdd\x1b\x83\x24\x3c\x53\x2e\xd2\xd3\xe6\xba\x7f\x77\xa6\x8e\x3c\xdc\xb5\x04\x3f\xed\x74\x1f\xbe\x35\xf5\xfc\x86\x35\x6d\xe7\x8b\xe1\xe5\x97\xdd\xe9\xa2\x18\x7d\x38\xa7\x94\x26\x97\xf0\x03\xbc\xcc\xb9\x49\x43\x5a\x78\xad\xbd\x7a\xfb\xa4\x78\x2d\x60\x6e\x81\x16\xf8\x47\x48\xfe\x73\x94\x2b\x80\x8e\x20\x96\xc3\x55\x2b\xf2\x66\xa2\x5f\xc4\xca\x38\x76\x65\x58\x5c\x75\x71\x89\x2b\xc9\x80\xc2\xa6\xf8\x9b\x38\xab\xb0\xff\x9d\xb9\xd2\xbe\x60\x86\xcd\x6e\xea\xe6\xd2\x44\x07\x31\xbb\x9d\xbd\xc0\x21\x89\xc7\xc1\x50\x86\xdd\xd1\x2b\xc1\xe1\xfe\x92\xe5\x69\x02\x17\xb8\x3a\xbc\xb5\x78\xf0\xa5\xf9\x9c\x1e\xdc\x44\xa3\xef\x47\x70\x7a\xd1\x04\xd3\xc7\x08\xaa\x57\xfe\xd8\xb3\x9a\xc2\x3f\x3d\x83\x72\x7f\x5f\x0c\x24\x1d\x53\x78\xc4\x88\xc9\x9d\x07\x7e\xb8\x41\x89\xc6\xa1\xe8\x05\x45\x32\xc0\xb0\xd7\x32\xdb\xc5\x13\x4f\x37\xb2\x4b\xef\xfb\x67\xea\xbf\x79\x37\x9f\xaa\xd7\x69\xab\x62\x09\xf8\x96\x70\xa3\x96\xa1\xe8\x00\x3e\x40\x0e\xbc\xe5\x04\xd3\xc1\x98\x59\x74\x54\x8d\x87\x74\x44\x7e\xa1\x32\xe7\x62\x6b\xd5\xbf\x4e\xf7\xc3\x5f\x2f\x16\xd8\x90\x2c\xf0\xd5\xba\xee\xae\x28\x04\xb2\x0c\x48\x24\x11\x2c\x7b\x51\xae\x4b\xb8\xd6\x73\x3e\xc8\x0b\xcc\x38\x20\xe5\x6f\xa9\xab\x2e\x3c\x7f\x52\xb1\xe5\x6c\x53\xb5\x18\x24\x24\x23\x7d\xe8\xff\xa9\xcc\x6e\x01\x52\x02\xfd\xa0\xa3\x7a\xd4\x12\xe5\x5e\x75\x62\x56\xb2\xff\x15\x08\xd0\x52\x16\x57\xdd\xbd\xbc\x17\xd9\x92\x38\xcc\xba\x21\xbb\x59\x7d\x22\xca\xfe\x10\x55\xc0\x48\x22\xf8\xed\x4b\x66\x1d\x1e\xfb\x16\x83\xaf\x3e\xc3\x27\xe0\x08\x0f\xf0\x7f\x96\x40\x7a\xe4\x43\xb4\xa4\x12\x0f\x1e\x90\x0a\xe6\xa2\x02\x90\x20\x34\x31\xc9\x39\x4c\xe9\x98\x90\xdb\xc5\x68\xd2\x57\x42\x31\xd1\x95\x01\x71\x20\x3f\x4f\xa2\x9a\x5d\x58\x12\x46\x87\x79\x8b\x5b\xd5\xec\x0d\x25\xac\xff\xcb\xb9\x7b\xf5\x9a\xba\x2f\x15\x1e\xe2\x85\xdf\xba\xdd\x6c\x2a\xe3\x74\xd7\x56\x97\x71\xcb\xab\xaa\x45\xfd\xe9\xfe\xa1\x52\x6f\x87\xe9\x2f\x83\xe1\xd9\x1e\x0c\x68\x4c\x39\x1e\xc3\xe2\x61\x61\x2a\x13\x74\x45\x53\xdf\x49\xe9\x64\x3d\xdb\xb5\xa8\xf2\x71\x92\x24\x0f\xb2\x6e\x58\xb3\xe9\x3c\x81\xd3\x24\x3e\xba\x4c\x2c\x74\xf9\x75\x0d\xee\x9c\x02\x9d\x17\x3f\xa5\xe2\xf5\x2d\xaf\xd0\x91\xd5\x09\x8d\x7f\x93\x09\x02\xee\xd1\x20\xc5\xc7\xc7\xc9\x15\x74\x3d\xe0\x18\x26\xc3\x69\x53\xff\x35\x01\x01\xac\xcb\xc5\x97\x05\xf9\xce\x5f\xbe\x03\x11\xe6\x8a\x59\xee\xce\xe7\x19\x11\x6f\x39\x09\x56\xbf\x8a\xab\x65\x2d\x09\xb8\x70\x0d\x75\x36\xc7\xbb\xc5\x36\xec\x7d\x18\x6b\x79\xf5\xce\x45\xa5\xc0\x43\x0d\x3a\xc0\x66\x12\x34\xe7\xcc\xe0\xfe\x8b\x89\xac\x73\x2b\xdb\x83\xb6\xa7\xfc\xab\xd6\x96\xd1\x0c\x6b\xa3\x43\x82\xb2\x88\x67\xae\xdb\x70\x60\xe6\xde\xc6\x0b\xba\x97\xa7\x8b\xce\x05\x1e\x5e\x03\x8b\x28\xe8\xc7\x93\x72\x74\xc0\x25\x4f\xb6\x1c\x79\xc3\xa6\x1f\x3c\xa8\xcb\x96\xc8\xb9\x4d\x54\x85\x17\x90\x86\x92\x34\x49\xb2\xc3\xf9\x43\xe4\x9f\x23\x4a\x03\xa3\x2c\xc9\x1c\x15\xe6\x29\x5d\xc2\xd1\xfe\x2b\x38\x1c\xfe\x6d\x1f\xb3\xe7\xfe\xf4\xb9\x2e\x48\x0b\xf9\x04\xdc\x93\xd6\x2f\x35\x26\xa4\x21\xe5\x39\xb2\x7b\xeb\x6f\xea\xf5\xe3\xbd\x6b\x36\xb3\xbf\x63\xbc\xc3\xc1\x6e\x97\x37\x37\x34\xea\x75\xd5\x0f\x46\x54\x7e\x1f\x28\x93\xc6\x87\xa1\x96\x81\x5c\x0b\x6e\x81\x3a\xf8\xf4\x77\x3e\x5e\x7f\xc1\xdd\x14\xf8\x56\xd4\xc5\x10\x71\xca\x11\x7e\x27\x85\x62\x8b\x7e\x89\x36\xd6\x0f\x89\x8d\xac\x1b\xce\x53\x5d\xaf\xbd\x45\x07\xe2\x35\x33\xcd\x52\xc5\xe6\x6a\xbd\x7c\xb4\xb6\x57\x95\xc6\xd0\x03\x68\x6e\x7e\xe1\x2f\xb2\x5c\xa8\x7a\xc4\xc5\xa5\x61\xe3\xb5\x8c\x74\x2e\xe3\x5f\xf0\x41\x31\xa5\x92\xa4\x5f\xe6\xd5\x2f\x73\x38\x0b\x46\x0f\x7d\xf3\x25\x24\xae\xf7\x06\x1f\x2d\xc4\xba\x27\x44\xe9\xba\xf9\x67\x8c\x7c\x4d\x22\xe6\x9f\x64\xd7\x2a\x30\x2a\xf9\x42\xfe\xda\xa4\x97\x84\xdd\x98\x08\x65\x96\x54\xb6\x7a\xc1\x45\xdc\xdc\xfc\x0e\xb5\x73\x58\xae\x20\xe2\xfa\x76\x0d\x53\xc8\x45\x47\xc8\x45\x1e\xaa\x03\x3f\x95\x23\xcb\x98\xc5\x5b\x47\x84\x90\xda\x09\x4d\x97\xad\x49\xc1\x85\x0c\xf1\x9a\x13\x6c\x98\xf3\xd5\xf5\x43\xe4\x83\xf3\x8f\xfd\x49\x77\x62\x9e\x19\x82\x3a\x0f\xbd\xb9\xda\x58\xc0\xab\xaf\xce\xb4\xcd\xb9\xe6\xcd\x54\xb1\x03\xa1\x57\x5f\x02\x59\x25\x85\x40\x07\xd6\xdb\xe5\xf2\x48\x7e\x5f\x84\xc6\x1f\x48\xb9\xcf\xac\xca\x73\xe8\x7e\x26\x86\xdc\x99\x3e\x37\x3c\xc7\xb5\x9a\xaa\x57\x92\x62\x0a\xd3\xb2\x4e\xac\xb4\xce\xa9\x86\xaa\x46\xbc\x8d\x06\x8e\x0b\x0c\x2f\xde\x98\xb7\x6b\xc2\x0d\xaf\xde\x99\xc7\x7c\x3c\x28\x2a\x1d\xab\xf4\x70\xfe\xb9\x4f\xf0\x2a\xb0\xbd\x2b\x2f\x7a\xfd\x54\x6f\xf5\x62\x98\xb8\x37\x95\xf1\x82\x42\x7f\xa6\x7f\xea\xb1\xf4\xfb\xd7\x4a\xca\x50\x9d\xc8\xf6\x93\x5d\x95\xe8\x3d\x37\x49\xe9\xbb\x9b\xf6\x22\x7c\xf6\x2c\xeb\x8f\x09\x1b\x88\xec\x32\xdd\x4d\xa7\xec\xe6\xd5\x1d\xe0\xe7\x57\x97\x02\x9a\x3d\xf3\x56\x0c\x1b\x25\x85\x23\xbc\xe1\x16\x5a\xf7\x2a\x79\xcd\xf9\xb2\xed\x00\x95\x1f\x82\x84\xc7\xde\xcd\xd6\x61\x89\xa7\x12\x22\x3a\x65\x59\xfd\xfd\x0b\x12\x8c\xe2\xf7\xd4\xed\x24\xcc\x63\x9d\x46\x48\x6d\x47\xa6\xdc\xf5\x7b\x17\xe8\xe9\x5f\x46\x4c\x86\x01\x14\xe5\x45\xd7\xc5\x0d\x22\xf4\xdd\x2e\x09\x46\x6c\x31\xed\x9f\xbc\xa2\x42\xfb\x2d\x40\xfa\x7e\xf1\x9b\x8a\xe1\x50\x25\xf8\x53\xbb\x83\xea\x09\x99\x3f\xe2\xee\x1c\x8b\x75\x2b\x14\xb6\x2d\x39\xcb\x75\x94\xfe\xcd\xdd\xa2\xf7\x67\x26\xa6\xaf\x5b\xbf\xe7\x2e\x86\xd6\xb5\x34\x2e\x97\xb9\xd9\xe9\x40\x09\xa7\x4e\xad\xc1\x05\x7d\xb1\xa1\xd6\xad\xa8\x2a\x7c\x41\x56\xc1\x89\x28\xbb\x4e\xae\xd2\x49\x05\xa6\x9f\xdd\xea\xff\x51\x5d\x8d\x5d\xdb\x86\xa2\x36\xe2\x85\x81\xd0\x5e\xe5\xea\x10\xb1\xfd\x6d\xbe\xc7\xa1\xa8\x21\x75\x1b\xa1\xc6\x76\x8b\x9a\x94\x31\x0d\x9a\xd4\xfa\xf3\x6c\x45\x5f\x3b\xb9\x07\x1b\xe7\x24\xa3\xe2\x76\x67\x86\x29\xd1\x6e\xfb\xf9\xa8\xc0\xbf\xee\x11\xd2\x00\x28\x5c\xf9\x04\x74\xf4\x79\x04\x17\xff\xa2\x59\xeb\x12\x8f\x0d\x7b\x45\xc5\x55\x5c\x15\x4d\x39\x61\xdc\x62\x60\xc8\x6e\x40\xa0\xc7\xcf\x38\xe1\xfc\xb0\xf2\x8d\x6e\xcd\xe6\xe2\xc4\x47\x63\xc1\x0f\xde\xe4\xa4\x42\x61\xa2\x28\x24\x64\x49\x14\x66\x69\x6c\x66\x78\x1b\x08\x69\x07\x7c\xab\xa4\xcc\xff\x00\x7c\x02\x7a\x99\xc2\x6d\x82\xf5\x6c\xd5\x6a\xc6\xba\x45\xa4\x0b\xf6\xa9\x00\x40\xe8\x77\xcc\x2c\x7a\x79\x43\x1a\x7c\x87\x0b\x44\x4a\xfd\x58\x84\xec\xc1\x10\x6e\x77\x55\xfe\x28\x13\x52\xbc\x65\x3a\xfd\x63\x31\x06\x50\xa2\x86\xf0\x96\xf9\x1f\x4f\xd7\x42\xcd\xcb\x61\x4f\x32\x8c\x2e\x7c\x0c\x75\x22\xc3\x9a\xe1\xd0\xa0\x1c\xca\x15\x72\x43\xcd\xdc\x6a\xc0\xaa\x4f\x96\xc5\x36\x5e\x8e\xa4\x25\x13\x09\x4e\xfc\x4a\x1b\x17\x1d\x1b\xfe\x0b\xea\x25\x2c\x80\x85\xf1\xcd\xea\xf9\x24\x4b\x65\xa1\xee\xb6\x9a\xee\xf5\x8f\x48\x14\x03\x9a\xe0\x1d\xd5\xf2\x84\xa9\x97\xec\x92\xa9\x45\xfd\xef\xa6\xc9\x92\xa2\x09\xa7\xc5\x9c\x4a\xbf\x8c\x54\xa7\xb0\x9c\xc3\xb6\x1f\x9d\xa6\x74\xb6\x48\x81\xaf\x8b\x26\x86\x0a\x01\xac\xfe\x04\xee\xff\x94\xb2\x8a\xcb\x22\xe6\xe8\x96\x50\x43\x53\x00\x0e\xdd\x13\x4f\x57\xac\x85\xa7\x10\xa4\x56\x65\x40\x26\x4b\x66\x86\x86\x36\xfb\xfc\x25\xcc\xc0\x85\x07\xda\xcb\x13\x4a\xfa\xee\xd6\x96\x2f\x82\xd1\x94\xdd\x67\xdd\x26\x5d\xa4\xa7\x81\x27\x78\xb5\xcd\x85\x49\xdc\x0e\xe7\xb6\xb0\x7d\xa0\x75\x98\xd8\x77\x51\xf9\xce\xf0\x93\x4f\xf0\x4d\x6f\xf9\x94\xd0\x68\xcb\x41\xce\x33\xee\xb1\xc0\xf6\x9f\x37\x4f\x9c\xc1\x5d\xec\x0d\x18\x0a\x20\x58\x69\x94\x14\xcd\x5f\x65\xc9\x05\x9c\x67\x67\xa6\x59\xa7\x2f\x61\xd4\xc1\x71\xc6\xea\x52\x2d\xf3\xac\xe0\xdc\x9d\x9d\xc5\xe6\x26\xd3\x6b\xeb\xba\x5f\x65\xd2\x5e\xf9\x0b\xce\xc5\xf4\xa9\x19\x71\xfd\x19\xd3\x82\x9c\x95\xb9\xf9\xcf\xae\x12\xc4\x25\xbd\xb0\x97\xd1\x40\x31\x06\x0c\x0f\x32\x83\x7e\xf4\xfb\xf5\x40\xe2\x6d\x92\x7d\x47\x5f\xa0\xed\x44\x5d\x58\xc4\xec\xeb\xaa\x48\x68\xec\x30\x7d\xf0\x1e\x65\x55\xab\xcd\xde\x0f\x5c\x1a\x80\x69\x0b\x7a\xeb\x40\xcc\x9f\x3c\x26\xd6\x79\xd0\x74\xa3\x3b\xa3\x98\xb3\x22\xfb\x23\x3b\x9b\xfe\x90\x43\x5d\xb2\xfd\x00\xf5\xe8\xf4\xc5\x22\xfa\xbe\x00\xcf\x06\xf8\xfa\x6d\xde\x90\x7f\xa9\x75\x02\x75\x6e\x99\xee\xd2\xe8\x39\x5e\xb1\xca\x92\x95\x71\x79\xa8\x7c\xaa\x9d\x27\x59\xd3\x38\x13\x76\xce\x2d\x05\x01\xf8\x4f\xca\xc0\x07\x66\x35\x80\x33\x92\xe8\x6a\x01\x4e\x16\x39\xdd\x4d\xb7\xe7\x18\x11\xb4\x00\xba\x35\x65\x8a\x9c\xdf\xed\x75\xcc\x6d\x12\x7d\x9f\xc9\x3e\xff\x25\xea\x0b\x4d\xbb\x3e\xb5\x0f\x41\x73\x0f\x46\xd3\xcc\xdc\xb8\x55\x96\xf2\xd2\x95\x81\x64\x6f\xb9\xde\x2d\x35\x83\x62\x31\x40\xcc\x55\x10\x50\x9c\xcc\x79\x30\xb3\xbe\x8c\xa5\x7a\x4b\x55\x70\x78\xf0\xe5\x59\x53\x82\xbc\xef\x0b\xc6\xa0\xe7\xfd\xdb\x35\x0d\xcf\x8c\x37\x5e\x31\xa3\xc9\x0f\xfb\xdd\x54\x61\xf3\x58\x8b\x68\x9f\xfe\x20\x7a\x5e\x97\xe5\xc9\xd6\x5a\x0d\xe7\x78\xe8\x9d\x63\x08\x73\x89\x91\x20\x6d\xc7\x5f\xe3\xd2\xf0\xc7\x94\xde\x16\x14\x49\xf0\x27\x9f\x5a\xd8\x3d\x2d\x4f\x84\x86\x79\xdf\x50\x79\xc0\xe9\x19\x08\xde\xb5\xfd\xbc\x51\xed\x5f\x25\xc8\x79\xc1\xf9\x2b\x3f\x00\x3b\xca\x1d\xd2\x29\x88\x1e\xfc\x47\xf3\x8f\x80\x33\x4a\xc6\x85\x6d\xa7\x9f\x61\x37\xc2\x2e\x8b\xb2\x2c\x25\x07\x2c\xdf\x89\x6b\xe9\xbc\xaa\x4c\x5b\x3e\xfa\x83\x7c\xb9\x30\x74\x6f\x1b\xdd\xf5\x30\x1d\x59\x56\x49\x31\xed\xb2\x7a\x38\xdd\x52\x68\x0e\xda\x04\x34\xf5\x05\x5c\x11\xe5\x1b\xaf\x2b\x8d\xcf\xcd\x96\xb8\xa4\x2c\x20\x1d\xcf\xf3\x46\xb0\x42\xfa\xd8\x9b\x3f\x13\xc0\x16\xe5\x39\x38\x15\xdc\x36\xd4\x35\xef\xc3\xcb\xa2\xf6\x58\x27\x06\x26\x7f\x62\x0c\x3b\xc8\x0a\xb6\xb2\xd6\xed\x8b\x2d\x82\x81\xf9\xcb\x5a\x07\x91\xb0\x9b\xd1\xc7\xbe\x1f\x39\xf2\x69\x3d\x87\x6f\xbb\x5e\xa0\xfc\x0f\xb6\xf3\x2b\x15\x68\x5f\x4b\x6b\xe0\x98\xb9\xe7\x78\x37\x2e\x2c\x20\x54\xad\x16\x8b\x23\x06\xef\xe5\x88\x77\xba\xf1\x92\x84\xaa\x64\x69\x04\x21\xee\x9e\x9a\x7e\x8d\xc6\x7f\xc7\xa9\x05\x90\x14\x43\x6d\x74\xe0\xe0\x0b\xd4\x3d\xfe\xa4\x70\x74\x97\x8e\x6a\xd6\x22\x15\xbb\x6d\x95\xa9\x20\x1f\x34\x68\xa6\x76\x6b\x7a\x7c\xf5\x44\x76\x71\x44\x0d\xd3\x7a\x90\x77\x23\x72\xf5\xa2\x18\x32\xd5\xe0\x8b\x75\xfe\x44\xc4\xce\xea\x05\xf6\x00\x79\xd5\x98\x4d\xb4\x3b\x58\x62\x4b\x70\xff\xfa\x09\xaf\x9b\xff\x8d\x1b\xad\x3a\x10\xf8\xcc\x7c\x3f\x9d\xa0\xa8\x66\x82\xee\xbc\x9c\xe6\x4f\x8f\x9b\x8c\xcf\xfd\x1e\x43\xf2\x56\x34\x6e\x4a\xb1\x36\xd4\xf7\xe3\xf2\xbb\x24\x87\x57\xe6\xf4\x71\xda\xc8\xea\x50\xf2\x6e\x62\xa4\x15\x86\xb8\x8e\x63\xcd\xe1\x31\x6d\x96\x07\xad\x13\x58\xd1\x88\x75\x93\xa2\xa9\xa0\x7b\x52\x89\xe5\x3d\xf6\x12\x17\x5f\xcd\xd6\x97\xe8\x00\xcd\x0e\x4e\xf0\x6d\x1c\x00\x79\x4a\xf8\x96\xe0\x27\x88\xf0\x6c\xab\xee\x69\x63\x7a\x61\x16\x71\xd1\x85\xe0\xd6\xe2\x04\x90\x93\xa4\x35\x14\xbd\x34\x51\x6b\x24\xbe\xdc\xce\x70\x01\xd3\x40\x53\x9e\x2e\x78\x6d\xe7\xb6\xd1\xf1\xe9\xe6\xe2\x89\x9b\xff\x96\xb6\xfc\xba\xf2\xa4\x7d\x2b\x10\x00\xba\x9c\xc7\x90\x40\x13\xe2\xea\x2e\x2f\x71\x4f\x66\x6a\x73\x20\x61\x5f\x1a\x0f\xb3\x8e\x16\xd0\xfb\x5d\xb9\xd9\x19\x1d\x42\x81\xf7\x08\x0a\x96\xb0\x78\x33\x09\xbd\x9d\xec\x14\x5d\xba\x6b\x08\x30\xee\x61\xbb\x78\xe8\x71\xef\x5b\x44\xa9\x32\x22\x1e\x4a\x5a\x43\x05\x30\x3e\xde\xe4\xb1\x2c\xc6\xd9\xaa\xcf\xdb\xc2\x35\xa9\x38\xb4\x60\x17\xe4\xb0\x25\x05\x59\x3e\x64\xd2\xc2\xdc\x4e\x8e\x9b\xd8\x5e\xa4\xee\xd9\xcd\x22\x25\x6a\xc9\x3c\xb9\xb5\xea\x71\xfb\x2b\x44\xef\x3f\x03\x70\x4b\x5d\x56\xc6\x7d\xb3\x7a\x7a\x64\xcc\xab\x4d\x5b\xdf\xec\x17\x4a\xbd\x33\x6b\xf1\x68\x8c\x36\xe6\x6b\x39\xc9\x75\x93\xcb\xb2\xd3\x35\x26\xbd\x3c\x35\xc3\xb7\x41\xb6\x5f\xd4\x23\x23\x05\x0b\x6f\x44\xe6\x30\x87\xb8\x2d\x70\x9f\xb2\x58\x63\x68\x2d\x2e\xa1\x1f\xfb\x11\x4d\x8c\xc6\x8d\x6e\xb3\x7b\xb9\x4a\x14\x85\x7e\xbe\x08\xce\x21\xf6\x9f\xf1\x5c\x4a\x52\x0c\x55\x45\xd6\x26\xfa\x34\x73\xf4\x8d\x7b\xab\x4c\x4e\x50\xa6\xa8\x76\xc6\x7a\xf9\xbf\x7c\x0b\x76\xc8\x2d\x78\xe4\xa3\x46\x37\xff\xca\xac\xb9\xd5\x5a\xdc\x37\xde\x76\x2a\x91\x26\x30\x3b\x90\xff\x9d\x84\xe0\x5e\x48\x70\x40\xca\x24\xeb\xe3\x9b\x23\x53\x92\x5f\xce\x6c\x28\x94\x89\x21\xa0\x21\x90\x3e\xaf\x70\x25\xa0\x2e\xe1\x00\xc4\x9f\xf0\xff\x55\xd5\x03\x31\xa5\xf4\x74\x23\x7e\x3a\x25\x28\xe7\x34\x2b\xd6\xbe\xa9\x77\xf3\xc7\x0d\x4a\xa9\x78\xee\x46\xfb\x9b\xe0\xf1\x21\x43\xcc\x30\x81\x75\xd9\xa0\x29\xbb\x77\xa5\x71\x15\x46\x73\xf7\xbc\x9b\xe8\x59\xd9\x92\xbd\xc0\x95\xed\xea\x85\xfc\xa7\x85\x89\xbe\x4b\xf1\xfd\x7a\x95\x4f\x38\x97\xeb\x9b\x51\xed\xea\x1a\xd5\x2f\xcf\x47\x7c\x97\x8d\x4f\x2e\x66\x4a\x23\x5c\x8f\xd2\x61\xbf\x9c\x47\xf8\x92\x52\x26\x97\x47\x00\xbc\x67\x14\x35\xa3\x9c\x09\x1b\x3b\x46\x5b\x8b\xc8\x2c\x0f\xc9\x15\x5f\x91\x29\x34\xca\xac\xda\x90\x6f\xdb\x2f\xde\x25\x11\x85\xbc\x1f\xe1\x53\x69\xc1\x01\x84\x00\x48\xd5\xc7\x93\x37\x78\x64\x56\xd2\x06\x09\x2f\x5b\xaf\xda\xdd\x3c\xec\x3e\xad\xfe\xe8\x65\xbd\xb9\x2b\xbc\xfb\x1b\x67\x66\xc0\x0f\xf7\x37\xbe\xb2\x9f\x35\x61\x24\xf3\x85\x15\xaa\xff\x2c\x36\x82\x85\xbd\x19\x27\x2f\xa7\xbb\x8b\x79\x56\x34\xcc\xd3\x35\x0c\x71\xcd\xb7\x9c\x7d\x13\xe8\xb8\xe1\x95\x77\x26\x8b\xcb\x2a\x48\xf1\x71\xf6\x03\x86\xd3\x63\x98\xd0\xab\x26\x2d\x11\xfa\x3a\x00\x37\xef\xcd\x3b\xd3\xc9\x59\x54\x77\x8c\xe3\x5e\x24\xc7\x7a\xab\x01\x27\xaa\x11\xc8\x33\x07\xbd\xe6\xaa\x33\xfb\x99\xff\xdc\x31\xa7\x40\xcf\x37\xce\x43\x6c\xaa\xd8\x51\xb7\xb7\x68\xea\x96\xa3\xec\x95\xf4\x4d\xa5\xc7\x61\x2a\xf0\x4a\x68\x9c\xa7\xf5\xd8\x0b\xf3\xb2\xef\x73\x9b\x54\x07\xa7\x65\xcc\xd9\xab\x5a\xd2\x17\xb0\xc6\x75\x2b\xe7\xad\xab\x53\x92\x46\x3c\x9d\xc5\xcc\xfe\x66\x47\x9e\x07\xeb\x5a\xb0\xe0\x0e\xcb\x11\xe2\x3f\x7e\xbd\x3c\x8f\x29\xf5\x92\xd4\x3d\x12\x57\x34\x9a\xcf\xd1\xc0\x41\xf0\x8d\x93\xf2\x37\x4d\x92\x36\x6f\x34\xef\x5f\x36\x12\xdc\x3c\x33\x4b\x15\x6a\x64\xb2\xdc\xbe\xa8\x76\xf0\x0f\xaf\xf1\x36\x22\x37\x3f\x47\x7e\xf5\xea\x2f\x0d\x86\xb1\xff\x48\xbb\x23\x1f\x87\xa0\x34\xeb\x0e\x74\x09\xf2\xdb\xc6\x3b\x61\x9e\xe2\x15\x41\x26\x2a\x6d\x1c\x1b\x1e\x93\xcd\x99\xdf\x2e\x8a\x43\x52\x1d\x16\x03\x3c\x9c\xf0\xb2\x0a\xe0\xc3\x45\x77\xd0\x21\xec\xdf\x25\xe7\x72\x78\x4a\x13\xb1\x49\x0b\x0d\x85\x2f\xce\x66\x95\xf4\xb1\xaf\xe6\xa0\x10\xac\x91\x2f\x8d\x1d\x1b\x5d\x17\xa0\x1a\xc2\x1a\x87\xeb\x52\xc7\x1f\x0c\x71\x5e\x79\x0a\xd6\x56\x32\xcd\x89\xc8\x30\x08\xd3\x89\x34\xca\x2d\x7c\xb8\x4b\x36\xe9\xb8\x34\x44\xd0\x4b\xb2\x16\x22\x2d\x5d\xfd\x14\x83\x1a\x38\xba\x03\xc3\x3e\x9a\xfb\x91\x0e\xc8\xb7\xc5\x6f\x89\x09\xa5\x78\x32\x12\x80\x03\xaa\x01\xdf\x2d\xd5\x45\x73\xc1\x22\xb7\x5a\xa8\xb2\xff\xdd\x54\xa3\x7b\xe0\xb2\x63\xed\x18\x21\x04\xb5\x8c\x66\xae\x6f\x4d\xe5\x6b\xa3\xd4\xfb\xd3\x61\x4b\x8d\xdd\x7a\xc9\x97\x58\x08\x7e\xff\xea\x38\x7d\xed\xa6\x4f\xb4\xf9\xde\x5b\xf2\xc7\xb4\x6c\x0b\x73\x35\xd8\xdb\x80\x5c\xcc\xf3\xd3\x08\xbb\xc0\x6e\x14\xac\x62\xc0\x27\xb6\xed\xa4\x52\x09\x3e\x51\xeb\x01\xe4\xed\x7a\xd5\x07\x94\x4d\x68\x05\x3c\xf2\x09\xa8\xfb\x89\xd8\x49\x4f\xad\x15\x09\xfc\xb4\xb1\x62\x2b\xfa\x44\xf3\x0b\x0a\x3a\x86\xef\xc7\x62\xf3\xa4\x59\x75\xe9\x05\xec\x9c\x5e\xc7\xbe\x51\x94\xf2\x9b\x0b\x26\xba\xd9\x66\x47\x54\xfe\x1a\xc9\x88\x70\x8c\x3a\x62\xf7\x60\xa4\xbf\x93\xc1\xfc\x88\xfb\xb7\xc4\x5f\xd2\xc6\x00\x4b\x15\x7d\x34\x6c\xbb\x1f\x7b\xcc\xf9\x71\xf5\x02\xd3\x5e\x20\xe5\xb2\x5d\x9a\xdb\x8d\xf8\x28\x73\xa5\x8d\xca\x50\x69\xbc\x3f\x72\xb1\x5b\xcd\x60\x9c\xe5\x23\x1f\x3e\x6c\x86\x76\x39\xaa\xbd\x47\x2e\x98\x9d\xb0\xe2\xe1\xc7\xd7\xb7\x88\xf2\xed\x0c\xee\xb5\x71\x62\x53\xb9\x83\xec\x62\x66\x56\xf0\x25\xd7\xc3\xb6\x12\xc2\x19\xc9\xb9\xaf\x60\x4e\x87\x98\xba\x13\x9d\xab\x77\xdc\x68\xc5\x67\xe6\x5c\x20\x8f\xa2\x7d\x59\x44\x2a\x7d\x97\x94\x97\xb5\x5a\x45\xf3\x55\x8e\x04\xec\xc2\xfa\xc0\xfe\x51\xf1\xd9\x6f\x59\x29\xcb\x79\xe8\x40\x9d\x08\x16\x20\x9f\x14\x33\xf3\xcd\x62\xe1\x8b\xb3\x5e\x93\x25\x46\x96\xd3\x5a\xba\x3f\xeb\xc0\xbb\x75\x22\x1f\x44\xc9\x2f\x5c\x9d\x28\xcf\xcf\xf7\x7f\xf7\xe1\x8f\x80\x98\xc6\x67\x46\x27\xf6\xe3\x0b\x70\xd5\xcd\x90\xd6\x3d\x43\x14\x7a\x40\x06\xc7\xa4\xa0\x21\xbb\xf7\x94\xde\x34\x6c\x95\x78\x9c\x52\x9d\x43\xb6\x14\x13\x9e\x42\xb1\x20\xbc\xd0\xf9\x0c\xd3\x18\xff\x41\x89\x90\xcc\x9a\x59\x4f\x7e\x5d\xa8\x35\x59\x8d\xaa\xab\x0d\x82\x73\xd1\x48\xa9\xe0\x02\xbc\xf5\x06\x3d\x1f\x09\xe8\x58\x2f\xea\x8c\x15\x7e\x30\x41\xf9\x0f\xb7\x58\x58\xc3\x73\xcf\x4f\x2a\x29\x8e\x6c\xa8\x17\x9a\x5c\x56\x10\xcd\xa0\xb1\x67\xef\x48\xa8\xa4\x3d\x7e\xf0\x16\x40\xde\x9c\xe0\x5b\xac\xb4\xe9\xeb\x0c\x8a\xde\x89\x39\xa2\x5b\xfc\x92\x68\x70\x18\x67\x63\x32\xe2\xfa\x4d\xd9\x6b\xba\xd1\xca\x0b\x94\x7a\x86\x88\x26\xb8\x2d\x59\x3f\x1c\x48\x95\x1d\x09\xd6\xbe\x17\x48\xcf\x3d\x66\xf0\x74\x67\xbc\xad\xf9\x2b\x50\x5d\x60\x00\xd0\x77\xad\x6a\x61\xa7\x6a\x56\x4e\x94\x09\x99\x88\x6f\x93\xe3\x10\x6e\x18\x62\x3e\x54\x12\x0d\xeb\xb9\xd1\xb6\x8e\x51\x03\x4f\xdd\x27\x79\xcf\xf8\xcb\x36\x2d\x29\xd7\x0f\x3b\x7a\x4d\x68\xfe\x54\x1f\xe7\xe6\x7b\xda\xdf\x84\x42\x4c\x06\x79\xf7\x80\x02\x11\xb7\xea\x5f\x6e\x08\xef\xe1\x5b\x52\x9f\x3a\x9b\x69\x5f\x94\x1b\x5a\xea\xe6\xb7\x96\x5e\x17\xe5\xfd\x0d\xb9\x92\xce\x6c\xbb\x1f\xb6\xfa\x34\x7b\x97\xe6\xaa\x51\xef\x62\x7c\x10\xbd\xd1\x45\x1c\x2a\xeb\xda\x70\x9c\xb4\x7b\xec\xf8\x09\x96\x5d\xfd\x97\x0f\xae\x48\xd8\x3f\xfc\x86\x20\xd7\x9e\x8b\x64\xb2\xa1\xa1\x45\x6f\x9a\xbf\x79\xab\x9a\x1e\x1b\xb6\xce\x96\x32\x8d\x13\x98\x90\x70\x37\xb4\x94\xfe\x73\xda\xb3\xca\x33\xe0\xe0\x60\x4d\x18\x4a\x91\xbd\xd9\xf3\xb4\xfb\xd3\x44\x66\x0b\x54\xe9\xdd\x43\xce\x22\xec\x7e\x53\x96\x86\x52\x99\x8f\xd2\x32\xd8\x0f\xc0\xbc\xd0\x23\x74\x62\x99\x76\xc8\x8f\x23\x5d\x16\xec\x10\x1f\xa9\xfc\x8f\x64\x2c\x0d\x61\xf4\xc6\x21\x0f\xca\x8d\x75\x29\xb7\x0b\x4b\x87\xd7\x99\x9c\x89\xc7\x19\xd4\x9e\x2c\xd4\x06\x0e\xcb\x57\x5c\xdf\x56\x6d\x24\x8b\x2f\x1c\xcf\x6d\xbd\x0b\x54\x31\xd9\xda\xf7\xad\x3e\xbe\xa9\x76\x35\x76\x38\x27\xf6\x40\x18\xe7\x94\x79\x9f\x25\x2d\xba\xf9\xe7\xc3\x25\x12\xd0\x3e\x06\xee\x54\x27\x93\xc4\x1e\x64\x66\xb5\xc7\x8f\x6d\xa1\xfd\xc9\x44\x3c\x00\xcc\x1c\x2c\x25\xb2\x29\x67\xbb\xd3\x96\x5e\x65\x43\x30\x5e\x77\xde\x46\x78\x79\x0a\x75\x83\x7f\x67\x4b\x6e\x49\x7a\x02\x91\xa5\xac\x59\xe7\x05\x1a\xb9\x3b\xa1\xdb\xcd\x18\xb0\x05\x5c\xa9\x05\xc2\x34\xc7\x09\x79\x05\x10\xb6\x88\xa4\xda\x25\xc1\x5c\x0e\x5b\xde\x89\xd8\xd6\xf4\xe9\x30\xc5\x45\xc4\x5c\xe7\xcf\x9b\xfd\x36\x2f\xd5\x86\x85\xdc\x63\x02\x89\x5f\x29\x2a\xea\x04\x9c\x52\xd2\xfa\x92\xbf\x0a\x18\x8a\x34\x1c\x12\xcb\x6b\x63\x7f\xbc\x84\xcd\xbd\x9f\xc7\x12\xde\x54\xf9\xfc\x59\x6f\x3f\xf4\x13\x33\xc8\x14\x8d\x47\x4d\xa7\xe4\x9a\x04\x44\x53\x79\x76\x48\xfb\x09\x59\x52\xcb\xc4\x66\x44\xd8\x34\x9c\xfb\x3a\x2c\x76\x82\xd5\x46\x2b\x3c\xdc\x57\xdd\x58\xb5\x93\xcb\xf7\xba\xaf\x82\xfb\x4f\xcb\xca\xb2\x91\x23\xa6\xdd\x26\x29\x45\xad\x5e\x62\x31\x7d\x2a\x11\x40\xdc\x88\xca\x88\x76\x3f\xde\xbb\x77\x9c\x7d\xd7\xf3\xe8\xc0\x4b\x28\xfa\x3e\x54\xfc\xae\x59\x06\x7a\x6e\x03\x1d\xb2\x6c\x43\x91\x92\x9b\x4c\xe3\x6b\x84\xe6\x08\x7e\xb8\x57\x11\xf1\x65\x06\xda\xb8\xb9\x6a\x2a\xe2\xd1\xdd\x7f\x7f\x7a\xff\x7d\x58\xb9\xc2\xca\x70\x67\x9e\xab\xd0\x73\x21\x1e\x56\x59\xa0\x46\x38\x71\xb7\xee\xdc\x06\xf9\x21\x47\xa8\x18\xd9\xf8\xd8\xf7\x11\x19\x6f\x50\x08\x51\xa0\x6c\xd4\x45\x1b\x16\xeb\x2a\x56\x96\x46\xc1\xd3\x25\xd4\x53\x98\xf6\xc8\x2f\x2b\x48\x8f\x7f\x6a\xfb\x2a\xbc\x6a\x20\x05\x88\x1d\x3e\xb9\x25\xa2\xc3\x28\xc7\x35\x6c\x78\xb9\xaa\x0e\xd4\xd9\x4c\x8e\x29\x97\x7a\x3a\x7e\x66\xf0\xb3\xd3\x6c\x4e\x75\xc3\x4c\x40\xaf\x26\x80\x05\x93\xd9\xc5\x3f\x4b\x4b\x7d\xc9\x88\x70\xcc\x3d\x7e\x90\xb3\x10\x5c\x7a\xa3\xac\x0c\xd2\x3b\xb4\x9e\x54\xc9\x40\xd7\x76\x9e\xdb\xdc\x61\x1b\xaf\xc5\x4e\xbe\x29\x5c\x32\xe0\xfd\x35\xb6\xdd\x11\x98\x98\x98\xda\xae\xa4\xa3\x33\x4e\xa0\xca\x44\xc3\x51\x4a\x54\xb6\x1a\xa7\xde\xb0\xc0\xbd\x6b\x19\x0f\x60\xe2\xfc\xf9\x78\xae\x00\x66\xe9\xe1\xe9\x8d\xa4\x93\xec\x82\xa4\xca\x51\x1e\x11\x4e\x1c\x1b\xe8\xe4\x3f\x28\x8d\xc3\xde\xdc\x50\x6e\x22\x61\x93\xab\x60\xc7\xde\xa7\x7e\xb9\x5b\x4b\x1d\xde\x46\x2a\xe8\xd2\xeb\x58\x90\xe1\xa4\xc8\x47\xdf\xc3\xbf\xcf\x9b\xd3\xf0\x4b\x25\xa9\xbe\xba\xf1\xb5\xf7\xb6\x14\xa6\xe2\xa8\x77\xc0\x87\x97\xd6\x11\xa7\x31\x46\x10\xee\x13\xfd\x86\x84\x97\x27\xcf\x21\x8a\xb5\x17\x4c\xf1\x9f\xcd\x0a\xf1\xef\x80\x51\x13\x66\x0e\x9d\xc7\xa4\xbb\xe6\x4e\x1e\x8f\x6f\x76\xde\x30\x01\x09\x5e\x23\x4f\x71\x16\xd5\x29\x92\x6a\x69\x17\x64\x29\xee\xd9\x17\xc6\x22\xaf\x14\xed\x15\xa6\xcd\x0f\xe4\x3a\xbe\xbc\x31\xaa\x44\x53\xf4\x9e\xd0\x08\x3e\x84\x8b\x44\xef\x40\x2e\x11\xce\x4b\x9e\x99\xb7\x6c\x49\x96\x6d\x7e\x3e\xf9\xf0\x20\xb8\xe8\x8c\xa3\x76\xcb\x10\x8c\x9d\x66\x25\x98\x66\xee\x79\x70\xb4\xff\x80\x62\xd0\xdf\x22\xd4\x43\x87\xec\x16\x52\xdb\x7a\xc2\x7f\xab\x3c\xc6\xb2\x93\xab\x92\x34\xb3\xd6\x24\xc8\x73\xad\x91\xb6\xa0\x24\xff\x5c\xbe\x2d\x1c\xbe\x62\x41\x74\x74\xc3\xb7\x6b\xaa\x64\x58\xd8\x0a\x85\x9e\xf1\x46\x39\x6b\x2c\xc0\xf3\xd0\x14\xe9\x4b\xf8\x61\xd1\xf3\x97\x18\x4e\xed\xaf\xb9\x56\x12\x81\x5c\x18\x4c\xcf\x89\xb0\x1a\x84\x4f\xe2\x81\x24\x81\xbd\x8a\x54\x4a\x85\xad\x93\x5e\x4f\xd1\xd3\x55\xa5\x11\x4b\x9d\x09\x03\x35\x95\x93\x7b\x2e\xe0\xef\x4a\xc0\x32\x17\x53\xa6\xd6\x27\x5e\x5b\x2a\x1b\xa7\xd3\x53\x22\xa0\xe5\xb9\x73\x61\x24\xc9\x68\x53\x3d\xad\x40\x8f\x58\x77\x50\xfa\xc9\xc7\x52\x24\xfa\x23\x34\xdf\xa0\x92\xb0\xca\xab\xb4\xbd\x75\x92\x7c\xa5\x2e\x9b\x13\x13\x81\xe2\xc8\xa4\x92\xc6\xd2\xb8\x8a\x79\xce\xaf\x98\x4a\x6f\x6e\x09\x03\x37\xfc\x94\x5b\x3e\xea\x32\xf9\x03\xe3\xcd\x7d\x05\xb1\x58\x00\xf9\x1f\xef\x87\x10\x00\x90\xdd\xf8\x2d\x8a\x6f\xcf\xf9\x01\xbb\xa1\x59\xb2\x6f\x9b\xd8\xd1\xfd\xf3\x5e\x24\xe1\x6f\x71\x1c\x78\x12\xc9\x49\x96\x47\x68\x97\x91\x61\xe9\x46\x7a\x8b\x42\x79\x9b\xf0\x58\x5d\x46\x69\xca\x49\xe9\xcf\x0f\x7c\xa5\x5c\x1b\x15\x31\xf7\x6e\x1c\x66\x8c\x7a\x50\x60\x9a\x54\x20\x84\xb3\xa3\xf3\xe7\xf1\x53\xa0\x07\x63\x72\x3c\x37\x0f\x57\x2c\xf4\x0d\x0a\x1f\xdf\x23\x67\xef\xf7\x16\xd1\x71\xec\x02\xe4\x87\xeb\x6a\x8b\xe7\x3e\xc0\x08\x9a\xac\x35\xd7\xc0\x65\x80\x27\x51\x5b\x3c\x2c\x23\xd8\xc7\x10\x9b\x95\x3a\x0e\xb6\x8b\xbe\x62\x8c\x35\x28\x33\xc0\x2b\x0d\x9a\x24\x3f\x45\x05\x44\x48\xa9\xd2\xec\x50\x35\x83\x4c\xc7\xad\xae\x77\xa8\xec\x40\x1f\xb9\x70\x80\xa6\xab\xf0\x3a\xc4\xa2\x1c\xf8\x1b\xd1\xb9\x03\x29\x72\x6b\x8a\x6e\xc3\xfa\xfd\x2f\x95\x1c\x65\x0b\xa9\xa0\x67\x2a\xcf\x3a\x28\x7c\x7e\x2e\x9f\x46\x02\xc0\x0d\x36\x20\x12\x6d\x12\x4b\xa7\xcf\x76\xcc\xa3\xda\xbf\xfe\x6e\x77\xa8\x34\xc4\xe2\xf5\xdb\x7d\x0e\x28\x47\x21\xb7\xb6\xd5\x4f\x74\x5e\xd3\xb0\xd7\x57\x94\xda\x99\x11\xf1\x5d\x95\x68\x8b\x83\xcf\xce\x89\xd1\x3c\x9d\x4f\xfd\xe4\xf0\x00\x18\x81\x44\xcd\x54\x87\x1d\x96\x4f\x64\xfb\x20\xf8\x12\xfd\x68\x98\xfe\x61\x2b\xcc\x4b\x1c\xcd\x49\x91\x93\xab\xa6\xd6\x5e\x45\x65\x2e\xa0\xaf\x56\x84\x61\xa1\xe7\xb9\xa5\x4c\x4e\xb3\x67\x6e\x33\xb1\x40\xf7\x6b\xb7\x26\x19\x33\xef\x1a\xa5\x8e\xc7\x74\xce\xd9\xd2\x34\xd6\xab\xe9\x8d\xb8\x72\x64\x74\x4d\xd6\x53\x44\xe4\xd6\x77\x1f\xae\x07\xca\x2d\x72\x9b\x4e\xa8\x36\x14\xc9\x6d\xbc\x77\x63\xcb\xb4\x7d\x53\x87\x2a\x54\x4e\xf4\x11\x6d\xae\xee\x27\x0c\x5e\x61\x4a\xb2\x3e\x61\x1d\xb2\x15\xbd\xfb\x25\xea\x05\xe0\x57\xdf\x5f\x01\x96\xd3\x9e\xa0\x3f\xd5\x8d\x1c\x58\x7e\x83\x26\xc5\x72\xfc\xbe\x37\x48\x21\xbc\xbf\xdb\x1b\xfd\x45\x5f\xa0\x5c\xfd\x8e\x14\x9a\xbc\x55\x0e\xa1\x87\x36\x55\xcd\xe7\x28\x64\xc4\x5c\xd0\x14\x9a\xd0\x8c\x03\xf7\xe7\x14\xc1\xb0\xc9\x70\xef\x95\xb7\xee\xe3\xa2\xc8\xcf\x27\x57\x6c\xc4\xf1\xa7\x3c\xdd\x15\x67\xb9\x26\x59\xa8\x5f\x76\x01\xde\x3e\x7d\xfc\x0f\x13\x27\x7e\x38\x40\x32\x9f\x98\x97\x6b\x3f\x3e\xdf\x21\x5f\x2e\x9b\xa1\x93\xa9\x50\x5c\x7c\x4d\xaf\x29\xcf\x96\xe2\x93\xf7\x1b\xad\xd0\x27\x62\x8e\x5b\x05\xfc\x66\xfa\xd9\xa5\xe7\xfb\xa5\x54\x56\x22\xa5\xe6\xe9\x53\xb8\xd1\x00\xcc\xcc\x63\x10\x93\x78\xf7\x98\x49\x1b\x96\x5f\x23\x64\xe4\x3c\xf6\xbe\xf8\xf6\xd1\xc1\x85\x52\x59\x81\x0a\x64\x6a\x4a\x91\xad\x3e\xea\x28\xf2\x06\x84\xf2\x25\x9e\xe5\x8c\x55\x16\x1d\x85\xe8\x98\xde\x4f\xc7\xfb\x25\x31\xf0\xf2\xe0\x8f\xcb\x3d\x7f\x4e\xa9\xe9\x3d\xd6\x32\x80\xbf\x19\x82\xf9\xc1\xf2\x2d\xd7\x25\x15\xf8\x72\x0e\x02\xed\x74\x8d\xbe\x92\x23\xef\x06\x3b\xcb\x1e\x14\xfc\xaa\xb0\xeb\x53\xbc\xe5\x91\xbf\xf5\x86\xa0\x91\xd7\x74\x19\xfe\xe6\xae\x45\xbb\xff\x04\xfb\xb6\x05\x04\x83\xbf\xcd\x07\xc0\x4a\xaa\x14\xaf\x0b\x7f\xa5\x49\x29\xea\x29\xd7\x40\x29\xad\x25\xb5\xa1\xe7\xf6\xee\xe9\x0f\x24\xec\x22\x6b\x8b\x59\x34\xc0\x44\x0b\xa0\x0d\xb0\x69\xb0\x4a\x40\xf7\x17\xca\x9c\x3e\xb2\x4a\xb1\x95\xa5\x4c\x7d\xa6\xd8\xd7\x6f\xb1\x71\x2c\x33\xb2\x4b\x4a\xc3\x0c\xf6\x9d\xfa\xeb\xd5\x79\x77\x12\xae\xbf\x71\x78\x52\x18\xa0\x6a\x11\x0d\x77\xe3\xe0\x33\x35\xba\x57\xc3\x03\x99\x4c\x11\x28\xde\x74\x9c\x76\x2b\x98\xaf\x1c\x00\xb9\xc5\x02\x04\xb7\x59\x7a\x26\x6c\xac\xc4\x6c\x3c\xf7\xd3\xd2\x0f\x5c\x26\x55\x31\x17\x08\xef\xc2\x05\x4a\x3f\x89\xc4\xb6\x37\x6a\x07\xf7\x91\x9f\xfa\xf7\xa4\x21\x9e\xf7\x88\xed\x2a\xfa\x0c\x9a\x59\x5a\x7e\x66\x69\xcd\xa4\x52\xfe\xb9\xbe\xdf\x86\xff\xab\xd3\x20\x31\x07\xad\xe1\x36\x1f\x79\x08\xe0\xeb\x92\xb5\xea\x8f\x98\x25\x1d\xb5\x98\xd0\xf8\x6e\x49\xca\x65\xb7\x3f\x01\x84\xe5\x85\xc9\x0f\x50\x19\xc3\x4f\x59\x3d\x07\x2a\x3e\x5a\x09\x10\x94\x27\xc6\xd5\x60\x24\xe1\x48\x6b\xf3\x8a\x0d\xaf\x01\xd2\x6a\xa6\xda\xd2\x74\x65\x51\x04\x96\xfa\xa6\x93\x69\xc8\x23\x68\xa0\xe7\x01\x2b\x6c\x45\x53\x0d\xb9\xe1\x96\xa3\x27\xf0\x14\x89\x91\x27\x04\x5c\x67\x62\x5c\x79\xf8\xfe\xe4\xfa\x8a\x47\xfe\x45\x92\x57\x06\x80\x84\x62\x03\xaf\xc4\x76\x61\xb4\xc4\x7d\x02\xae\x29\x34\x63\x65\xa4\x69\x33\xd4\x67\xe0\x23\x2a\xd3\xe5\x12\x3f\x32\x58\xed\x85\x51\xe7\xcf\x8b\x07\xda\x21\x54\xec\x83\x35\xe8\xc3\x24\x32\x4c\xe1\x2b\x8a\x19\xc2\xf8\xd8\xb7\xc4\xce\xcf\x37\x3b\x53\xc2\xa2\x40\x85\x7b\x57\xd5\xb0\x8e\x67\x59\x9d\x58\xcc\x21\x4f\xa5\xc2\x31\xb0\x1c\xc5\x79\x36\x51\xa4\x5a\x49\x4c\x53\x1e\xcd\x94\x85\x12\xa8\xb2\x67\xa0\x4a\x15\xce\x42\xc5\x63\xdc\x19\x25\x87\x93\xfe\x65\xb2\xb5\x28\xd1\x17\x2c\x05\xe9\xc2\xdb\x53\xbf\xf0\x62\x1f\x97\x43\x02\x8d\x04\x6b\x30\xe8\x25\x55\x45\x59\xd8\x12\x63\x20\x9d\x02\x9f\x6a\x2e\xac\x75\x61\xa7\xac\x57\x29\xa9\xde\xd4\x39\xb3\x18\xed\x71\xa0\x25\x03\xe8\xa1\xfb\xe7\xfe\xdf\x24\x5d\x60\x49\x7c\x1d\xe3\x16\x76\x48\x9b\x64\x0e\x3a\x25\xcf\x1b\xb8\x2f\x4b\xa1\x84\x22\xe5\xeb\x61\x30\x40\x64\xb9\xe8\x88\xfb\x3e\x80\xc6\x4a\x8a\x4b\xb4\x14\xeb\x68\xa6\x64\x99\x28\xb9\x7a\x5a\xa1\xd1\x2b\xa8\x33\xba\xff\x1d\x59\x71\xcd\x93\x1a\x7a\xe3\xa4\x9c\x31\x9a\x88\xb9\xf5\x57\xa2\x04\xf5\x7a\xa5\x69\x7f\xdc\x4e\xab\x59\x4d\x14\xc1\x8b\x71\x0a\x87\x9d\xba\x8c\xdb\x0a\x1d\xef\x3f\xbc\xc3\xc3\x7e\x43\x85\x06\xb1\x21\xc6\x97\x50\x92\x6c\xa3\x77\x08\xef\x28\x9d\x52\x2b\x8f\x97\xf2\x43\x07\x16\x81\x07\x3b\x82\x43\x5f\x3a\x86\x3e\x97\xda\x80\xa4\x44\xc9\xaa\xb2\xed\x9b\x19\xd5\xea\x61\x97\x8c\xab\xa3\xc9\xbe\xb1\xd5\x9b\xf7\x5c\xd6\x83\x03\x95\x8f\x9e\x6c\x24\x21\x44\x4a\x0e\x5e\xbf\xe8\x76\x2e\xcc\x1e\x3a\x75\xa6\x56\x80\x07\x51\x97\x6d\x69\x15\xe6\xe8\x95\xaa\x90\xe7\xc1\x67\x17\xe4\x62\xdf\xb4\xb2\xc6\xd2\x31\xf3\xea\x8d\x6a\xfb\x1a\xd9\xbc\xd0\x30\xaf\x54\x40\x75\xee\xbd\xfc\x47\xba\xc3\x04\x66\x39\x80\x52\xb9\x36\x8d\x87\xef\xe9\xe3\xac\xc8\x3b\xa7\xab\x92\x98\x0d\x3f\x07\xaa\x00\xc1\xb7\xb8\x77\x59\xad\x8d\x9e\xbf\xf9\x7b\xd2\x72\x7b\x74\xb4\x30\x97\xf5\x28\xb2\x34\xfc\x2e\x1e\x1c\xbc\x45\x2f\x76\x07\xb1\x76\x0b\x06\x6d\x98\xf9\xa7\x7b\x23\x2c\xbb\xf8\x7a\xc5\xf1\xf6\x47\xac\x15\x3b\xc3\x1d\x49\xc7\x0b\x42\x29\xdd\x93\x34\x59\x3d\x20\x1d\x55\xa6\x54\xa2\xcb\xd5\x33\xce\x57\xd6\x4d\xf0\xf5\x92\x37\x14\xbd\x90\x92\xf3\x3f\x81\xe8\x5c\x03\xea\x41\xa3\x40\x08\xaa\x2d\x5c\x7f\x2d\x55\xbe\x3f\x10\xcb\x76\x23\x98\xe2\xe3\x8f\x5e\xa3\x5b\xec\x64\xd5\xf1\x8a\x79\x0f\xef\xbe\xb9\xa7\x0a\x9f\x39\xca\x44\xf1\x6b\x1d\xe0\x18\xe5\x3e\x35\xbb\x15\xdc\xfb\x84\x78\xc4\x68\xde\x70\x0e\x49\x02\x2c\xe2\x00\xbf\xab\x71\x51\x55\x98\x0e\x75\xde\xc9\x17\xbe\x8b\x65\xa9\x02\x91\xac\x51\xcf\x7e\x23\xd1\xff\x2a\x0f\x43\x60\xc8\xee\x2c\xdf\x5b\xee\xcf\x62\x7d\x4d\x6b\xd8\xd1\x17\xf9\xde\x12\x90\xce\xef\x68\xc1\x4d\x39\xc1\x53\xe4\xa7\x6b\x8a\x0a\xbb\x10\xe2\xd4\x1f\x02\x85\x19\x07\x5d\xeb\x23\x56\x16\x67\x34\x89\x09\xec\x1f\x26\x47\x5b\xa1\xb8\xd0\x04\x05\xe3\xea\x79\x8c\x12\xf0\xfb\x87\x83\x7b\x6c\x37\x31\x4f\x47\xea\xaf\xc5\x6a\xd1\x51\xe3\x33\xbb\x8a\x86\xbd\xf1\x1d\xa6\xcb\xe9\x50\xfa\x0d\x87\xcf\xee\x23\xf5\x55\xa7\x45\x4e\x16\x5d\x54\x97\xe8\xe7\x34\x4f\x8d\x6e\xa8\xac\x54\x62\x01\xb6\x93\x9c\x0d\x15\x50\xee\x6b\xd6\x81\xe6\xf6\x53\x2e\xe7\x2f\x55\x45\x09\x4e\xd8\x25\xd9\x5e\x49\x85\xc2\xb5\xaa\x9a\xde\x7b\xca\x0d\x46\x9d\x95\xd3\x5a\x28\xca\x4c\xcf\x76\x56\x92\x31\x85\xce\x44\x3e\x41\x0c\x26\xd9\x88\x27\x0e\xef\x26\x4e\x88\xed\xc3\x58\xb8\x3a\x18\x0a\x8b\x19\x87\x82\xae\x6e\x69\x45\x9c\xd2\xd5\x9d\x0f\x3c\xe4\x5b\xaa\x07\x81\x27\x30\x8b\xfb\x10\x49\xb9\xd3\x7b\xb5\xc2\x60\x94\x26\xca\x73\xcf\x64\xf8\x31\x27\x04\xf1\x84\xb7\x2b\xe7\x79\xcf\xef\x70\xde\xcd\x19\x9f\x99\xdc\x02\x3e\x3f\xa2\x07\xc2\x6b\x65\x99\x8d\xbf\xca\xa4\xf6\xf6\x5a\xc7\xc9\x51\x54\xf0\x40\xd4\xb5\xf0\x90\x6c\xfa\x73\x78\xe2\x8f\x36\x52\xcd\xd6\xc3\x42\x91\x63\x83\xe4\xe5\xe6\xc7\xbe\x0f\x03\x31\xbf\x71\x0f\x30\xb7\x42\xc2\x6f\x69\xec\x6b\x96\x77\x73\x13\x56\xf5\x40\x3d\x6f\xa8\x0a\x66\xc1\x2e\x4a\x0e\xa7\xf9\x07\xed\xdc\x27\xb1\x37\x2b\x35\xb6\xdf\x86\x6f\x09\xe1\x1e\xb8\xf1\x86\x58\x47\xef\x8e\x72\xe0\xb0\xb6\x8c\x97\x38\x7b\x2a\x9d\xb2\x77\xfd\x47\xb8\x38\x78\x8b\x27\x38\xc4\x30\x3a\x10\x3e\x31\x2c\x5c\x11\x20\xed\xde\x0d\x2c\xe4\xb4\x59\xf5\x9c\x8b\x0d\x76\xca\x87\xa7\xbc\x61\xd6\xcc\xd5\xc1\x7d\xd6\xe0\xa5\x2e\xe9\x18\xed\x8b\x2b\xcf\x00\xe8\xa7\xa2\x2f\x7e\x2d\x58\xaa\x8b\xfe\x8d\x88\x27\x6c\x61\xad\x86\x7b\xc6\xa7\x38\x20\x60\xf1\xa6\xca\xca\x4d\xde\x73\xcf\x77\x7c\x46\x3e\xb9\xb6\xb4\xe0\x8b\x4a\x6d\x04\x93\x0b\xd6\x40\x3b\x17\x00\xd1\x3a\x2a\xc5\x9f\x34\xe7\xb1\x5b\x61\xef\xe7\x3e\x76\x8f\x61\xdb\x7c\xf1\x20\x53\x95\xf8\xb3\x5c\x1e\xbd\x22\x31\x5d\xed\xe7\x8a\xfb\xd7\xe9\x2d\x7d\xce\x44\x85\x46\xba\x35\x46\xd6\x79\x0d\x74\x91\xb7\xdf\xbe\x71\xb5\x9e\x66\x55\xcc\x72\x1c\x0e\xfa\xc8\xd3\xfc\x1a\x7b\x93\xd4\xa4\x89\xf5\xf2\xab\x70\x82\x64\xf2\x14\x8e\x00\xc8\x31\x61\x7a\x53\xc9\xbd\x33\xf6\xd3\xfa\x0b\x64\xc0\xdf\xfa\x47\x07\xfa\x90\x24\xf5\x67\x07\x18\xf5\x67\x69\xb1\x81\xd1\x21\xdf\x1c\xf6\xf4\x31\xcb\x15\x09\xd7\x88\xeb\xeb\xf0\xb7\x98\x8d\x94\x55\xdc\xda\x57\x6d\x1a\xb8\xcb\x6c\xef\xbb\xc9\x4f\x9f\xb0\x7d\x63\x8f\xc5\x1d\x0e\x44\x12\xdb\xa6\x4e\x7f\x4d\x59\x98\x5e\xbc\x49\xbd\xa9\x6c\x1b\x25\x22\x00\x7d\xa9\x4e\x36\xcc\x19\x63\x4e\x5e\xc3\x8e\x15\xa9\x7a\xe2\xb9\xf9\x26\x9e\xc1\x41\x47\x98\x08\x1f\x6d\x33\xbf\x28\xd5\x46\x79\xdf\x0b\xc8\x67\x12\x7b\x70\xce\x46\xa8\x84\xd0\xf9\x12\x80\xce\x81\xa2\xc3\x08\x48\xbf\x95\x83\xc8\x49\x1a\xe6\x6a\xd3\x76\x0c\x35\x60\x34\xcc\x60\x44\x66\x3d\x2f\xf4\x77\xf9\xf3\xcb\x6c\x02\x98\x6c\x63\xae\xc8\xcd\x49\x8f\xf9\xdc\x8c\xc0\xa1\xa4\x1f\x61\x74\x8d\x2c\x7e\xdf\xfe\x8b\x62\x5d\xb8\x29\x53\x2d\x2d\x1a\xbd\x15\x8f\xc4\xa0\x4d\xac\x30\x9c\xd1\x73\xb9\xf9\x8a\x53\x99\x1a\xb0\x4c\x0a\x36\xb2\x47\x0b\x9c\xc5\xdc\x87\xdc\x3d\xea\x49\xb3\x60\x65\x25\xe0\xb2\x22\x43\x59\xb2\x5f\x0b\xbc\x59\x32\xb0\xa4\x71\x23\x3b\x32\xb1\x55\x4c\x0e\x52\x07\xe3\x95\x24\xbc\x6b\x4c\x01\x7d\x3b\x78\xe9\xc2\x6c\x63\x58\x72\xa7\xff\xb9\xf5\xc7\xc9\x0d\x09\xeb\xdb\xd5\x6c\x8d\x02\x0c\xa9\x51\x1a\x6e\x57\x63\xe8\xf6\x15\xf1\x97\x8a\xf1\xcf\xb7\x29\x58\x31\xbc\x88\x14\x37\x0b\x51\xac\x42\xdc\x97\x6c\x34\x85\x23\x37\x67\x81\x3a\x93\xaf\xb2\x3b\x9e\x89\x4a\x61\x7c\xf8\xfa\xb9\x79\xaa\xb0\x59\x65\x5d\x6d\xc3\x73\x50\x94\xe6\x1f\x37\x10\x04\x3c\x07\x01\x91\xbf\x4e\xfc\xf3\x7f\x00\x55\x73\x2d\x8d\xbc\xf3\x95\x37\x68\x04\xe8\x8c\x8a\x9c\x41\x20\x25\x45\xb3\x08\x22\xde\x2e\xa3\xc2\x1f\x5e\x5a\x77\x92\xef\x5f\x0d\xdc\x7d\x6d\x7b\xef\x76\x76\x9d\xd3\x1e\x90\x2b\x07\x36\x24\x5d\xfc\xd8\x0a\xf9\x18\x1a\xfb\xae\x79\xc6\xb7\x35\xda\x58\xc0\xf3\x67\x32\xfe\xaf\xb1\xe7\x93\xb5\x6f\x74\xe8\x17\x9a\xee\x7e\x92\x9f\x62\x0e\xd3\xdb\x88\xab\x9e\x9b\x56\x98\xdf\xf1\x02\xc5\xf3\xe5\xf6\x7d\x09\x4e\x36\x63\x02\x74\x63\xf7\x5c\xc7\x5d\x1f\x44\xb2\x4e\x86\x93\x8b\x3e\x1e\x37\xba\xdc\x22\x86\xae\x75\x8d\xb2\xdf\xde\x6d\x69\x45\xc4\x0b\x4b\xab\xcc\x76\x1a\x77\x01\x00\x16\x29\x10\x69\x49\x82\xcc\xe7\x63\xdb\x9d\x8d\xc4\xf2\x49\xba\xf1\x6b\x5c\xd7\xcd\xb3\xf9\xbc\x1b\x27\x02\x0f\x3f\x73\x25\x8a\xe6\x63\xbb\x2f\x14\x65\xd0\x9c\x56\xdf\x1d\x89\xe6\xed\x54\x7f\x22\xfc\xc2\xbe\x2f\x87\x47\xf3\xb9\xda\x17\x99\xac\x0a\xde\xa6\xf2\x68\x21\x11\xed\x42\xa6\x59\x11\xf3\x97\x45\x46\x64\x80\x57\x4d\x28\x15\x56\xd2\xd5\x81\x23\x25\xeb\xf2\x9f\x8c\x26\x95\xd9\x31\x47\x7e\xca\xc9\x3a\x42\x7d\xbc\xb4\xa5\xdd\xe5\xcb\x7e\xdb\xf6\x32\xdd\x16\x8a\xa2\x27\x14\xd7\x22\xc0\x39\x7a\x00\x68\x7e\x4f\xd9\x3b\xeb\x6e\x23\x40\x47\x14\xb7\x7f\x26\x76\x1e\xf3\x2b\x42\x9e\x03\xaf\xff\xd6\x21\x09\x80\x6d\x16\x66\x54\xfd\x65\xcd\x56\x2b\xf5\xfb\xce\x2c\xdd\x74\x27\xf5\xec\xad\xf3\x03\x56\x46\x8b\xe9\xe2\x5d\x5e\x3b\xcd\xb8\x37\x3d\xc9\xfb\x75\xb6\x87\xfc\x8a\x75\x67\x59\x72\xfe\xac\xb7\x98\xca\x45\xbe\xcc\x29\xa6\x0f\x13\xd5\x0a\xb8\x88\x17\x6f\x97\x8e\xcd\xe3\x19\x9c\x0c\xde\xa2\xb3\x54\x80\x53\x28\x7d\x9e\x00\x2b\x4d\x0a\x31\x6a\xae\xf4\x38\x67\xe2\x9d\x6a\x9f\x78\xf8\x33\xe3\x55\xe3\x4e\xc4\x65\xc5\xbc\xbd\x87\x51\x38\x69\xea\x6e\xd0\xd8\x16\x67\x63\x84\x17\x4e\x23\xea\xb3\xa3\x72\x71\x9a\x26\x4f\xcc\x64\x0d\x8f\x4b\x56\xb1\x7a\xc4\x49\xb5\xf9\xd9\x6f\xc9\xaf\xc7\xf2\x9d\x7f\x65\x3b\xcb\x7a\x60\x99\xb3\x3d\xe4\x74\x1a\x5d\x1d\x0d\x12\xa8\x41\x33\xfb\x48\xd6\x68\x51\xc0\x7e\xc7\x1b\xa2\xa8\x8d\x35\xf0\x36\x68\xf8\xb8\x32\xfa\x0d\xdf\x35\xeb\x34\xf6\x9d\x19\x13\x49\x82\x98\xe7\xcf\xc1\x8c\xbe\x49\x4a\x19\xb6\x6e\xdf\x27\x75\x91\x5d\x7c\x1b\x5a\xd1\x8f\x7e\x42\x87\x3d\xfc\xd3\x45\x4a\xe5\x6c\x16\xd2\x92\x2e\xef\x30\x9c\x4e\x9a\x76\xe8\x9c\x15\x1e\xe1\xe0\x49\xdd\xe1\xba\xce\xb1\xfd\xe9\xfc\x82\xc8\x4d\xa4\x62\x1c\x1e\x5a\xe7\xee\xd2\xc7\x6c\xd6\xda\x44\xf3\x7f\xd5\x31\xba\xa7\x05\x6a\xd4\x6f\x19\xcf\xba\x65\x55\x88\x84\xd8\x86\x1e\x88\x7a\x7d\xa8\x63\x9f\x24\xab\xb5\x28\x11\x03\x0b\x66\xc9\x14\x5d\x78\x17\xbd\x19\xcd\x60\xee\x99\xc0\xee\x3d\x61\x61\x32\x95\x8e\xfa\x0e\x9d\xc1\x2f\xe6\x5a\x8e\xc2\xee\xaa\x50\x1b\xc6\x71\x57\x1d\x7d\xae\xb9\x0e\x32\x1a\x10\x0b\xc6\x4d\x5e\x71\xb7\xbe\x15\x8f\x58\x1f\xe1\xfb\x38\x8b\x15\x1c\x82\xab\xe4\xec\xfa\xc2\xb1\x1f\x77\x34\x20\x3d\xcd\x5d\x9c\x7f\xe2\x68\xf7\x96\xe3\x73\x6d\x1d\xdb\x82\xef\xe0\xc3\x26\x9b\x86\x05\x74\x51\xd8\x4c\x9f\x6b\xb2\x17\xbb\xe7\xa1\x4e\xb4\x35\x66\xb5\x63\xb2\x77\x92\x87\x06\x56\x66\x4f\xa9\x94\xb6\xf0\xce\x07\xf7\xb2\x47\x03\xc7\x74\xd6\x3a\x89\x4c\x39\xe5\xcd\xac\x0a\x86\x9a\xe8\x40\x55\xcd\x72\x85\xc8\x03\xfb\x23\x16\x72\x9f\x8b\xce\x90\xad\x07\xf5\xab\x44\xe4\x3e\xa7\x98\x9c\x21\x3f\xb5\x56\x63\xa0\x44\xa1\x46\xba\xc7\x82\x8c\x6a\xfb\xf3\x36\xaa\x01\x58\x44\x7a\x4b\xe3\xb0\xec\x0b\xa1\x02\x42\xca\x5e\xb2\xbd\xe4\xc8\x26\xc3\x5b\x29\xde\xd0\xe6\xe4\xf5\xa0\x36\x42\xae\x67\x17\x8b\x26\xb3\xb7\x43\xe3\x39\x5d\x27\x1a\x53\xa9\xc1\xda\x37\x6d\x38\xd8\x95\xdd\x57\x66\x0b\x15\xcf\xdd\x1e\xeb\xcd\x40\x0b\x3d\x14\x0f\x0e\x0b\x34\x92\x7f\x75\x93\x5d\xbf\x9a\x47\xe1\x71\xdc\x0b\x3a\xfb\xd0\x8e\x0c\x51\xb5\xfd\xe6\xdd\xa7\xfa\x73\x59\xa3\x52\x5b\xad\x00\xe5\x03\x1a\x9b\xf6\x91\xe4\xc1\xcd\xe4\x9d\x17\x0c\x21\xfe\x14\x26\x03\x41\x06\xef\x51\xbf\x57\xa2\x91\xdb\x82\x65\x5c\x4b\x45\x08\x66\x15\x0d\x44\x82\xc8\x0f\xb4\x45\xe5\x5b\xf6\xb8\x3f\x89\x77\x35\x7a\x2d\xe4\x3a\x42\x20\xde\xec\xc3\x31\xc9\xa7\x8b\xc7\xe4\x59\x44\x42\xad\x04\x0d\x64\x5b\x4c\x87\x74\x19\x85\x1c\x4a\xb6\xdd\xb6\x01\xec\x7b\xf0\xbb\x58\xde\xcd\xb8\x57\xb8\x23\xcb\x7d\x35\x45\x63\x57\x95\x52\xb1\x02\xd2\xdf\xb3\x3b\xa9\x8a\x4d\x85\x9c\xc2\xc0\x62\xf7\xac\xe5\x2a\x4a\x52\x60\x3d\x3a\xd7\x93\x77\x51\x97\xde\x9e\x20\x88\x7e\x07\xb9\x5d\x27\x8e\x94\xcc\xde\x13\x03\x10\x40\xed\x44\x21\x64\x39\x40\xfe\x62\xa3\xd0\xae\x5d\xdd\x09\x56\x0e\x93\x01\x4e\x95\x82\x44\x67\xd5\x6d\xb5\xa2\x5f\x9b\xc4\x32\x7b\x2b\x52\x8b\x93\x9e\x33\x00\x35\xbb\x69\x4f\x1b\x77\x84\xcd\x87\xf5\x4e\x1a\x28\x0b\xc5\x72\x9f\x06\x57\x11\x4b\xf9\x4f\xae\x90\x7e\x77\xa8\xd1\xc8\xc6\xac\xd7\x16\xa3\xbb\xba\x94\xf9\x8c\xc9\xe9\xc5\xcd\xe3\x31\xa4\x83\x56\xa1\xe3\x2a\x0d\x2e\xb5\x1e\x2a\x60\x38\x0e\xe9\xc7\xd4\xb5\xc6\xd2\xba\x9f\x9d\x7d\x17\x1c\xa2\xb0\x32\xc1\xa1\x70\x71\xda\x93\xd6\x1b\xc3\x8b\xe2\x7f\x10\x27\x8c\x79\x1e\xca\xe7\x53\x36\x62\x7c\x3e\x83\x7a\x81\x5f\xc5\xc5\xef\xf3\x9d\xfc\x94\xf8\x3e\x74\xbe\x39\x63\x7e\x86\x51\x70\x36\x53\xf8\x3d\x47\x04\xbd\xd4\x87\xd5\x17\x17\x19\x9b\x7d\x13\x02\x81\xbc\xc7\xc3\x25\x5a\x32\x88\x85\x42\x34\xf3\xfb\xa8\x84\x45\xf1\x91\x6f\x7a\x85\x9f\xcf\x1c\x62\xb7\x24\xa5\x2d\xbe\x65\x7b\x74\x8a\x85\xb9\xf2\xdf\xd9\xbc\xd8\xde\x9e\x7d\xa1\xfb\xd3\xf3\xb2\xa0\x3d\x0f\xe5\x7b\xdb\xa8\xd4\xbe\xfa\xe8\x03\x86\xa3\xc7\x7c\x6a\x96\x8b\x3c\x85\x47\x91\x33\xae\x80\xd4\x24\xdb\x9f\xfa\x2e\xa1\xc8\xeb\xa5\x60\x17\x8f\xcb\xa8\xa2\x51\xc2\x54\x35\xc3\x4a\xaa\x90\xee\xaf\x1f\x26\x61\x47\x02\x77\x79\x9d\x6f\x2f\x84\xf0\x05\xba\x77\x6f\xb4\x06\x2a\xa5\xaf\x85\x54\x97\xb6\x28\xfa\x5c\x84\xd1\x8c\xa2\xd9\x0e\xd8\xff\xb4\x30\x54\xeb\xb5\xf1\x77\xb9\x50\xfe\x4f\xb5\x7b\xa5\x4b\x3e\xf0\x99\x43\x1f\x45\x7f\xc5\xbb\xc3\x1f\xe9\xf3\xce\xde\x22\xce\xa2\xa7\xb4\x72\x56\xd8\x8a\x8a\x1c\x86\x74\xe8\xd8\x0c\x64\x8a\x1c\x0f\xa8\x9e\x3b\xcd\xd6\x7d\x20\xb0\xea\x26\xab\xb5\xc4\x92\xa6\xc7\x8b\x27\xff\x30\xfb\x00\x13\x43\xda\x8a\xd9\xc7\x8b\x78\xc1\x39\xd3\x67\xfb\xdd\x62\xed\x1a\xcd\x52\xdb\x36\x63\xe7\x48\x79\x47\x07\x12\x5e\xee\xd7\x5d\x35\x53\x56\x7d\xf6\x0e\xf1\x2e\x22\x43\x3d\xd0\x73\xb8\x4c\xe7\xd9\x91\x7c\xe8\xa2\x1d\x6b\xd4\x14\x4d\x43\xb0\x73\xe7\xd7\xc7\xe4\xe4\x05\x6f\xd0\x9e\x65\xbc\xbe\x13\x53\x09\x5e\xa4\x31\x2c\x7e\xc1\x34\x74\x63\xd4\x68\x64\x07\xdf\x5e\x62\x3e\xa6\x5d\x58\x0a\x13\x98\xb5\x97\x1e\xf4\x22\xe9\x10\xc2\x18\xb2\xf5\x97\xee\x72\x35\xec\x5b\x9f\x8f\x2d\x2a\x73\xc9\x34\xe9\x0c\xf7\x75\x17\x0d\xec\x78\x94\x71\x46\x9a\xf0\x5d\x5b\x4a\x3b\xf5\xd8\xbf\x3d\x04\x51\xe0\x6a\x04\x57\x59\x8a\xdb\xce\x48\x50\x2d\x9f\x0c\xe7\x1a\x93\x97\x94\xa7\x11\x2d\x12\x24\x7b\x46\xcd\x91\x95\x5b\x23\x3d\x5a\x7a\xa7\xa2\x9c\xc0\x4c\xb0\x19\xee\x9c\x99\x42\x2d\x74\xb3\x14\x75\x25\x80\xf9\x68\x22\xbb\xaf\x0e\x93\x29\xc7\x82\xc2\x3a\xc8\x3e\xfa\x0a\xf3\x4e\xe6\x11\xf6\x06\x19\xe5\x07\x2a\x6c\xaf\x74\xb9\xf3\xfe\x31\x89\xc1\xca\xce\x23\x45\xe6\x29\x9d\x85\x91\xe3\x17\xb6\x47\x1d\xea\x67\x6f\xf8\x9d\x4f\x7e\x6b\xbb\xd9\x41\x14\x31\xcb\xb5\x34\x70\xb3\x57\x5b\xed\xf3\xb1\xab\x0e\x97\x86\xb6\xe4\xa5\xfd\x9f\x57\x16\xda\x8d\x24\x70\x22\x13\x99\x0d\xe8\xdf\x11\xb9\xdb\x9a\xdd\x14\xc6\x7c\xfa\x28\x38\x87\x40\x31\xe4\x06\xf6\x57\xe0\x7c\xba\x7a\x52\x58\x11\xc9\x63\xce\xa1\x9a\xdf\xba\x82\xff\x48\xd9\x20\xc6\x6e\x4c\x8c\x93\x60\xb9\xb5\x7d\x26\x6b\x12\x69\xf0\xcb\x22\x59\x9e\x5b\x76\xa2\xf5\x71\x62\x53\x85\xe4\x6b\x7a\xc8\x27\x80\x09\x2c\xcc\x00\xb6\xc7\x6b\xd0\xc1\x97\x80\x63\xd2\xa4\xcb\x5c\xd4\x59\x94\x88\x9f\xc6\xa8\x25\xf2\x69\x48\x2c\x85\xee\x57\xd1\xf5\x25\x0b\xf9\x9f\xbb\xa6\x5b\xbe\xe1\x8d\x77\x91\xf3\x89\x71\xf2\xe7\x9e\x1c\xe9\xee\x49\xbb\x9f\xc1\xd2\x60\x21\x19\x25\xc5\x34\x77\x8e\x21\x85\xcb\xe4\x69\x29\xd3\x0b\x3e\xa9\xce\x97\x3e\x61\x24\x92\x89\xdd\xdc\x48\x90\xb9\xff\xfa\xe8\xf1\x28\x79\x9b\x8c\xdb\xfa\xe6\xe8\x6c\xaa\x9a\x40\x72\x75\xc1\x37\xfa\x52\x0d\x2a\xa2\xcf\xe9\xb5\x9d\x47\x2e\x1f\xd5\x8e\x03\x6e\x4b\x6e\x17\xd4\x76\x7b\x2f\x14\xda\x09\x6e\x7b\x7a\xf9\xd3\x4e\x03\x95\xca\x0e\xb0\x84\x5d\x36\x78\x9f\xff\x3f\xa6\xbe\xfa\xab\xca\xa8\xdb\xfa\x39\x87\xee\xee\xee\x96\x12\x50\x3a\xa4\xbb\x41\x1a\xa4\xa5\xdb\x43\x1c\x69\xe9\xee\x6e\x01\x69\xa4\xe1\x48\x37\x48\x37\x28\xdd\x48\x37\x9f\xbe\xef\xbd\x77\x7c\xfb\x0f\xd8\x63\x8f\x15\x73\xce\xf5\xc3\x5e\x33\x0b\xf6\x32\xef\x51\xe9\xfd\xc6\x25\x14\xa3\x8f\x44\xc3\xd2\x31\x78\xf8\x9d\xcd\x57\xb8\x5c\xea\x50\x83\x26\x02\xe3\x8c\x59\xdb\xe2\x8f\x95\xc7\xe2\x90\x8a\x57\x7a\x90\x0a\xc2\x10\x88\xc0\x60\xbb\x48\x9f\xc2\xf7\x68\x60\x76\xdf\x0d\x79\x2b\x8e\x82\x49\x8b\xff\xc5\x6c\x43\xf9\x6b\x2c\x4a\x81\x3f\x02\x78\xc1\xc1\xd9\xe3\x47\x96\x34\x2d\xcf\xcb\xf4\x03\xad\x41\x52\xca\x97\xd7\x1b\xad\x1b\x72\x3f\xfa\xa8\x82\xb0\x93\x8d\xda\x9a\xaf\x36\x10\x90\xbe\x69\xe9\x53\xe5\x96\xc5\xf9\xa0\x06\x24\xf8\x6c\x19\xd4\x31\xc1\x2b\x9b\xbf\x1d\xee\xc6\x0d\xf1\x65\x30\xe1\x59\xcf\x6d\x48\x42\x28\x31\xaf\x4c\xfb\x9e\x84\x60\x4b\x33\x88\xf8\x1e\x79\x2a\x7c\x4f\x1f\xb7\xfb\x67\xc1\xab\x89\xcd\xf6\xf6\x21\x09\x67\x7e\x8e\x75\x4c\xa2\xdc\x1c\xc1\x9a\x70\x9d\x7a\x85\x66\x2f\x49\xbc\x5f\x57\x30\x44\x5c\x63\x03\xfd\xab\x15\xb6\x98\x7a\x57\xe1\x7a\xc4\xb0\xe0\x67\x53\x0c\xbc\x33\x73\x28\xa1\x5a\xc6\x37\xc4\xe7\x6a\x2f\x22\xff\x6a\xb4\x91\xb8\xd0\x94\xd2\x16\x17\xc2\x0b\xb3\xd2\xe7\xd2\x3a\xdb\xc4\x2e\x22\x8e\x82\x9d\xf7\x56\x09\x96\xab\xcf\x9c\x68\x3f\x42\xce\x73\xe7\x99\x72\xfe\x20\xd2\x73\x7d\x48\x98\x0d\xe4\xea\x93\xcb\x78\xd7\xaa\x5a\xb7\xac\xda\x34\x06\xf7\xae\xc6\x4b\x23\xd6\xd3\x8f\x01\x9c\x86\x6c\x4a\x0b\x8d\xc7\x16\x6f\x7b\x65\x64\xb5\x7f\x0e\x27\x1c\xee\x9c\xa2\x16\x8e\x80\xcb\x73\x8a\xad\x51\x18\x31\xe9\x29\x89\xa6\x99\x41\xa1\x61\xa1\x95\x7f\xbf\xe8\x94\x6e\x21\x49\xed\xb6\xe0\x12\xd3\xc9\x58\x15\x8c\xc9\x1c\xb7\xe7\x93\x75\x2c\xb5\xec\x08\x77\xbc\x78\x7d\x65\x82\x25\xf2\xd5\xa1\xf2\x93\x1d\xc0\x07\x6a\x4b\xe5\x87\xc8\xbe\xb0\xa5\xbb\xb7\x87\x5b\xb5\xb2\x26\x69\x97\x76\xaa\x13\xbc\x9c\xac\xd6\xed\xee\xc5\xa5\xa4\xfb\xae\xfd\xbe\x7f\xb5\x8b\xfc\x96\x14\x26\x4b\x9e\x5b\x8d\x99\xfb\xe7\x46\x8d\x58\x4b\x24\xb3\x3f\x42\x2a\x8b\xa8\xa2\x8e\x3a\x3e\x94\x52\x4e\x4a\xa1\x30\x97\x9e\x2b\x96\xba\xa1\x75\xd6\x21\x28\xfd\x78\xd3\x48\x67\x7f\x36\x1a\x9d\x2a\x0b\x0f\x04\x17\x38\x9e\xca\xbf\x03\xc0\xb9\x70\x94\x34\x18\x6b\x4a\x40\x6a\x30\x72\xb3\xe2\xda\x1b\x98\x47\x9a\x16\xdf\xca\xc0\x49\x4a\xbe\xe4\x09\xa6\x97\x66\x49\x3d\xc1\x78\x18\x39\x35\x43\x0e\xe7\xd2\x99\xee\x40\xad\x4a\xa4\x45\xe9\x99\xa6\x12\xc1\x72\x5a\x73\x9f\xdf\x58\x2b\x29\xf8\x8f\xcc\x77\x16\x55\x90\xb9\x36\xac\xbe\x5a\xd2\x12\xb1\xc0\x03\x37\xff\x4e\x33\xd1\xf4\x3e\x71\x21\xcc\xb3\xcd\x57\x9c\xfb\xf5\xe2\x21\x7e\xfc\x47\x7b\xd0\x68\x56\x8f\x06\x5c\x56\x4b\x5b\xd2\x4e\x7f\x09\x95\xb9\x32\xd1\x88\x10\x21\x20\x9f\xc4\x32\xc8\xc8\x66\xe5\x50\xcb\x94\x13\x1e\xf4\xf9\x49\x7f\xe8\xe3\xaa\x64\x8b\x06\x2a\x79\xbd\x5d\x8f\x00\x8a\xea\x4b\x22\x44\x45\x59\x7d\x2b\x67\x48\xe9\x1d\xcd\xf8\x2b\x90\x50\x61\x58\x28\x3f\x20\xcd\x7a\xd6\x96\x73\xe9\x50\xb9\x61\xd5\xd3\x6e\xd6\x4c\x86\xbd\x21\x9a\x41\x29\xcc\x82\xd2\xc1\x01\xe9\x1d\x73\x45\x73\xe7\xfd\x01\xd5\x02\xa3\xa3\xa1\xd8\x25\x8d\x7c\x64\xe3\xc6\xe2\xfc\xb8\x79\x08\x33\x43\x15\x01\x0b\xd3\xba\x90\x37\x15\x82\xd6\x1a\xb9\x49\x3d\xb2\x63\x72\x5d\x9e\x32\xd0\x74\xf8\x18\x1c\xfd\xf9\xdf\x43\x48\xe3\x93\x78\xc5\xc9\x1b\x1f\x6d\x0c\xcf\x92\xd6\xde\x45\x44\xbe\xdb\x39\xdd\xa9\x98\xc2\xe0\x90\xd3\xac\xcc\x50\xa1\xfa\x50\x69\x87\x79\xf5\x45\x8b\x6b\x5e\x9a\x1c\xf6\xf0\xd5\x52\x5e\x2f\xd6\x32\xdc\x73\x50\x41\x63\x76\x84\x10\x39\x8f\xf3\x6e\x4f\xe0\xf2\x28\x32\x45\x31\x54\xd1\x3f\xc3\xa6\xd2\xfa\x4d\xab\xcd\xda\x38\x4e\xe6\xb9\x8c\x78\x8b\x87\xa4\x4c\x6e\x8b\x52\xee\xbe\xf7\x18\xf8\x87\xfe\x07\x6e\xd5\x41\xde\x2d\x1b\x51\x02\xe4\xb6\x13\xc1\x86\xfc\x47\xf2\x92\x6c\x1d\x11\x03\x34\xdd\x60\xb8\xc3\x2e\x15\x78\x2e\x1c\xd8\x3b\xc0\x82\x06\xda\x4d\x5a\xfe\xc4\x1a\x9d\x6e\xb5\xa7\x2a\x45\x2e\x4d\x6d\xd1\x23\xcc\xb9\x9e\x0d\x86\xef\xa3\x5b\xd6\xbd\xf5\x4f\xa2\x3e\x1e\xc4\x0c\x84\x0b\xbb\x36\xe0\x78\x65\x53\x5b\xd1\x13\x4a\x0b\x3d\xbe\xb6\xef\xb2\x0b\x34\xca\xb1\xa6\xc6\xa1\x79\x2f\x1e\x82\x97\x1e\x82\xdc\x0f\x40\x32\x85\x72\xb5\x5b\x97\x96\x17\x42\x80\xef\xb1\x01\xf4\xa2\xc5\x13\x11\x1b\x8e\x83\xe9\x72\x27\x79\x46\x1f\x62\x3c\x9a\x07\x25\xe3\xd4\xf0\xaa\x02\x7d\x52\x5b\x5c\x7b\xf3\xd8\x9b\x83\xa9\xf2\xd0\x7e\x3a\xa6\xfe\xe0\x1d\x0e\x8a\xf9\x07\x3b\x7f\x6b\x57\x18\x5e\x4d\xf2\x80\xeb\xb4\x42\xd0\x23\x13\x59\xb8\x02\x0b\x87\x56\x4a\xd3\xe3\x95\x33\xb6\xb6\x4f\xc3\xf2\x1d\x1e\xc2\x2f\x81\x08\x5b\x8c\x7a\xbf\x32\x93\xf4\x47\x9f\xa3\x6f\x4d\x75\x57\x01\x24\xdf\xb4\x24\x6f\x05\xfb\x82\xc9\x19\x67\x6e\xd6\xa8\xd3\x9c\x6d\xde\xa4\x45\xdd\x7a\x4e\xdb\x94\x1c\x8c\xea\xfe\x6c\xfa\xd9\xc7\x20\xb5\x23\x45\x04\x66\xf6\x49\x92\x98\xf3\xff\xb5\x31\xfc\x3b\x08\x21\xa7\x8f\xd1\x5b\x1b\xaf\x38\x73\x26\xff\xdc\xc4\x23\x07\xe3\x0e\xb4\xc6\xff\xcb\x2d\x8a\xa3\xe3\xb7\x5a\xff\xf0\xc3\x74\xcc\x76\xea\x54\x78\x91\x4c\x00\x67\xaa\x86\x91\x7c\x09\x5a\xcc\x14\xd0\x6a\x93\xbc\x54\x3f\x83\x44\x4b\x6c\x2a\x68\x0b\xff\xde\x86\xe2\x13\x89\xdc\x00\x3b\xf8\x4d\x42\x9e\x3f\xbf\x81\xe5\xa4\xa2\x11\x87\x04\xa3\x1f\x52\x63\x19\xf2\xfa\xfe\x57\x96\x78\x11\x04\xf8\x54\xc6\x4d\xcf\x9c\x50\xe5\x6b\xb4\xa7\x3d\x72\x6e\x90\x54\xbe\x34\x7e\xfd\xf0\x40\xcd\x85\xc5\xb6\xfc\x7e\x97\x12\xb5\xe1\x0a\x76\x11\xa8\x7d\xe2\x7d\x8b\xb1\x20\xa8\xfe\x24\xff\xde\xfe\x01\xfc\xac\x69\x72\x0d\xe8\x0b\xf2\xd7\x26\x7e\xb1\xef\xaa\x3d\x74\xfe\xb1\x29\xfc\xb0\xc2\xbd\xa2\x2a\xc9\x58\x1d\x54\xa5\x8a\xd0\x23\x3a\xea\xb6\x83\x69\x79\xab\xf4\xd1\x47\xfe\x5f\xae\x62\xdf\x80\x1e\xe3\x00\xc2\x29\x97\x91\x9b\x32\x32\x57\xb9\xbe\x69\x84\x1f\x04\x68\xf4\x49\xe3\xd4\xc1\x03\x9f\xb8\x20\x94\x1f\x26\x49\xa5\xcb\x8c\x3f\x3c\xcf\x9e\x50\xd0\xd8\x10\x9a\xcb\xca\x60\x64\x1e\x11\x20\x9b\xe8\x50\xa3\x73\x18\x0b\xa1\xfc\xa8\x4d\xc6\x26\xf7\xf8\xbe\x6c\x30\xa7\xa0\x21\x52\xcd\xf6\x6e\xdc\x4b\x9f\x7b\xc1\xb7\x9a\x1b\xd9\xb0\xce\x90\xe7\xfc\x4a\x2c\xb9\x84\x90\xde\x93\x54\x7f\x33\xe2\xf0\x23\x9c\x4f\x88\x67\x46\x5b\xee\xfb\xa5\x17\xce\xea\x97\x56\x9e\x7d\x7f\xa7\x44\x13\x13\x66\xec\x6b\x1a\x36\x6c\x4f\xf4\x2b\xdd\xde\xf8\xee\x5d\x95\xc1\xaf\x03\x52\xb4\x71\xf7\x34\x66\xee\x3f\x89\xe3\x77\x95\x9c\xe1\xeb\x0f\x19\x08\x83\xb4\xee\xc6\x1a\x6f\x27\x12\x33\x92\x0f\x89\xd1\xb0\xf9\x4b\xcf\x06\x50\x8c\x1e\xf3\x30\x96\xac\x65\x0d\xbd\xf9\xf4\xc7\x56\x14\xbb\x92\xb1\xf2\x86\x19\x08\x03\x75\xaf\x59\xe9\x3e\xf5\xcd\x3b\x7a\xca\xdc\x95\x73\xef\x40\xfa\xd3\xa8\xea\xbd\x2e\xf3\x91\x97\x2e\x23\xa4\x01\xa9\x03\xf6\xd2\xce\x1b\x37\xff\xec\xab\x62\x08\x67\x1e\xe8\x75\x8e\x3f\x74\xda\x06\x5c\x71\xdf\xba\x2a\xd6\x48\x7b\xeb\x58\x31\x16\x6a\x8a\x0e\x4e\xd3\xff\x89\x5b\x9e\x43\xc7\x89\x6d\xdc\xc6\xd1\x5a\xf0\x39\xc4\xb2\xee\x20\xc7\x03\xea\xef\xf2\x53\xbd\xb0\x82\x83\x7e\x9b\x97\x52\xd3\xf9\x30\x58\x9c\xde\xe3\x04\x51\x63\xf7\x3d\xc5\xad\x20\xfc\x19\xde\x6d\xed\x6b\xd6\xdf\xdd\x22\x9a\x92\x98\x76\xd2\x06\xa6\x11\xc8\xd8\x01\x47\xb8\xf5\xb6\x26\x83\x51\xed\x18\x1a\xce\xf8\xbf\xb4\xdf\x62\xbf\x4b\x2b\xaa\x25\x20\x41\x9e\x65\xb5\xd5\x2e\xba\x56\x19\xab\x50\x3d\x36\x61\x25\x79\x53\x87\x79\xac\x37\x80\xae\x3f\xeb\xaf\x3c\x85\xb9\xe3\xad\x2c\xc1\x7e\x8d\x2d\x50\x1d\xf2\xb5\x9a\x42\xeb\xd6\x24\xab\xe2\x10\x1d\x8d\x75\x93\xa0\xbb\xa4\xb2\x2e\xae\xc7\x8b\xd7\xef\xaf\xce\x6a\x7f\x35\xb7\x22\xab\x9d\x82\x4a\x57\x74\x9b\x95\x6e\x31\xf8\x55\x1e\x81\xea\x64\xe6\x65\xef\x06\x55\xc7\x37\xf9\xd3\xa3\x6f\xfe\xf1\x7d\xd1\x68\x44\x44\x1c\xa4\x01\xe9\xc9\xeb\x07\x2d\xb4\xd3\x46\xfd\x14\x49\x55\x55\x39\xfb\x4a\xcc\x8f\x79\x5f\xf0\x47\x53\xa9\x4d\x75\x76\x25\x3b\x47\xc3\x81\x6a\x1f\xbf\x0e\xfd\x7f\x51\x9d\x0a\x57\x7c\xcc\x33\x13\x5b\x3c\xd5\xdb\xe5\x6d\xfd\x0c\x8a\xce\x5d\x0b\xea\xa0\x99\xa4\x90\xae\x2f\xe9\x0f\xbc\x72\xf9\x9f\x73\x5e\x34\xdd\xed\x2a\x7b\x3e\xa7\xbf\xfc\x16\x4b\x6e\x44\x77\x6a\x50\x22\xa3\xb6\xa4\x18\x7d\xf5\x87\x1e\x7e\x8b\xd7\x7d\xa9\xc1\xf3\x92\x22\xcd\x81\x5e\x8a\x8c\x8b\x37\x46\x0b\x33\x7f\xdf\x64\x0d\x9a\xe7\xab\x6a\x84\x8b\x4e\x94\x73\xa4\x50\x5b\x1c\xb7\xe8\x47\xc7\x90\x9a\xe9\xbe\x14\x43\x00\x3d\xe6\x09\x87\x89\xf8\xfc\x0c\x46\x6f\x97\xce\x29\xa1\xe8\x0e\x7f\x63\x0f\x08\xb1\x23\xfb\xfc\x9d\x0d\x85\x2c\x46\x89\x2e\xa5\x60\x55\x44\x23\x7a\xd8\x92\x4c\xb1\x4b\x73\x4e\x48\xb9\x7e\x07\x01\x64\x42\xad\x8f\x9d\x55\xad\x15\xd4\xcd\x02\x8b\xbf\xb6\x15\xf5\x62\xca\x87\xcf\x4d\xc3\x44\x33\xff\xbd\xfa\x0b\x20\x23\x0e\x30\x44\xa9\xd6\x5e\xa9\x37\x49\xc3\x4d\x69\xac\x04\xc5\xdd\x0b\xc0\xd3\x10\x82\xcb\x76\x07\x69\xa0\x4d\x5c\xfa\x0f\xa2\x26\xe3\x47\x1e\xaf\xea\x1e\x43\xf2\x88\x24\x0b\x27\x69\x13\xdb\xa9\xf6\xe6\x54\x08\xcd\x06\x15\x10\x65\x3a\x1a\x9a\x2d\xb1\xe0\x7f\x81\x0e\xf6\x81\xa0\xb1\xd7\x1e\x25\xa9\x4a\xbf\x94\x1b\x88\x5a\x4b\x1a\x82\xcf\x91\xb2\xfa\xa8\x6a\xaa\xbc\xc3\x5a\xd7\x71\xfd\x5c\x42\x27\x44\x27\xdd\x4e\x25\x82\xae\xb1\x3a\x2b\x38\x24\xe0\x27\xc4\xbf\x5b\xef\x27\x56\x3d\x39\x75\xa1\xb1\xec\x52\x26\xe8\x1f\xdf\x3f\x14\x73\xef\x24\x36\x18\x50\x71\x94\x13\x72\x36\x6c\x2f\xfb\x54\x55\xac\xfb\x1e\x9c\x71\x28\x0d\x0a\xdd\x55\x1d\x3c\x7f\x57\x3d\xb0\x62\x7c\xc5\x6d\x1e\x75\x2e\xdc\xff\xe0\xfb\x34\xe1\x45\xcf\xb1\xe7\xd3\x8b\x4a\x95\x18\xbe\x81\x8c\xf7\x43\xe6\xf6\x2b\x4b\x5f\x4a\x9a\x7e\x39\x9e\x89\xb8\xb3\x36\x4e\x09\xdf\x63\x19\xe5\xe6\x45\x5a\x69\x1c\xb9\x7b\x42\x8d\x1f\x85\x19\xe5\x92\x55\x26\x72\xf5\x03\xce\xfb\xf4\x65\x5b\x75\x7a\x94\x45\xb6\x19\xcb\xbd\x5e\xe5\x22\xfb\xc8\x42\xd8\x29\x91\xbb\x09\x46\x94\xc3\xb7\xc1\xc4\xfe\x34\x4e\x52\x8b\x4e\xfb\xa8\xbb\x78\xee\x1d\xa6\xfe\xb4\xdc\xbf\xda\xcf\x31\xea\xae\x93\x7b\x67\x64\x8e\xd0\x9c\x63\x69\x9f\x87\x93\x23\x90\x64\x73\x96\x79\xc6\x1a\x73\x51\x0d\xdb\x78\xfd\x73\x17\xd5\x93\x5a\xf3\x38\x6d\x89\x9a\x5c\xe1\xd7\x37\x29\x3d\xe5\xf5\x1a\x6a\x31\xa9\x3f\x3f\xb6\xc6\xe5\x9b\x9b\x6e\xa1\x4c\xc0\x06\x8f\x5f\x9e\x66\x89\x2f\x12\xac\xdb\x74\x21\xf6\x35\x71\x16\xc2\x45\x98\x6f\x73\x42\xce\x62\xb4\x57\x14\x8b\x54\x49\x8f\xe4\x5f\x2f\xb4\x4d\xea\x3c\x24\xcd\x73\x6d\x0f\x1b\x60\xb7\x3a\x5a\x84\xb0\x63\xdd\xf0\x48\x28\xfa\x43\xc9\x60\x58\xce\x9a\x4e\xa5\xa5\xf8\x66\x1a\xa5\x39\xb2\xce\x63\xbf\xf0\x66\xf7\xcc\x73\xd2\x1b\x04\x3d\x2a\x9b\xc5\x91\x23\xf4\x73\x16\x75\xe3\xf8\x87\x76\xd1\x9f\x83\xa3\xa7\x35\x35\x95\x51\xee\x71\x7e\x08\x6d\xf9\xb1\x3b\x73\xd9\x52\xf7\x9e\x4a\xc6\xd9\x87\x58\xda\x3f\xad\xdf\xcb\xe0\xf3\x9c\x34\x46\x5e\x7b\x00\x9a\x27\xfe\x8c\x14\x89\x71\xe7\x2e\xaf\xe5\x2b\x9a\x69\x98\x9d\x3e\xa1\xbc\xba\x23\x30\xf3\xc5\x1f\x53\x35\x52\xa3\x74\xcc\x03\xb1\xef\xf4\x21\x71\xbc\x86\x0a\xfd\x3c\x98\xff\xe6\x33\xb4\x58\xb8\xbc\xf6\xad\x90\xd5\xe9\x6d\xe2\x28\xca\x52\x6d\xe4\x5b\x2d\xf8\x13\x42\x17\x50\x6e\xe9\xea\xc2\xe7\x74\xe9\x4e\xad\x9b\x0c\x74\xb9\x35\x07\x09\xcc\x50\x9b\x71\x8f\x55\x9f\x0f\xaa\x89\xae\x13\xa8\xef\x6d\x3b\x2e\x95\xac\xd3\x6a\x28\xa5\xdb\x3c\x97\x5a\xbb\xaa\xf4\xd9\x50\x97\x26\xd1\x92\x7e\xd6\xcf\xec\xeb\xa5\xd6\x9a\x0b\xf0\xdc\xaa\x6e\xf9\x98\x12\x06\xbf\x03\xf0\x40\x4a\xf0\x93\xb9\x80\x82\x94\x7b\xa2\x8a\x51\x31\x08\xff\x6d\xc6\x57\x37\x33\x68\x50\x5c\x6b\x48\x7d\xdd\xeb\x64\xd1\x38\x16\x1b\xdc\xf3\x3f\xb8\xeb\xd1\xa8\x5a\x54\x99\x7f\xa4\x51\x69\x60\xd7\x1b\x6e\xb9\xd2\x00\xd2\x7e\xea\x4c\xe7\xba\xc0\xdf\xb9\x53\x26\xb2\x91\x00\xcc\xe5\x68\x7b\x5e\x75\x21\x06\x51\x3b\x26\xe0\x78\xfd\x8a\xb7\x99\x6f\x46\x39\xe5\x55\x1e\xdf\x83\xd8\x52\xbe\xd3\xea\x36\x36\x4c\x1e\xd9\xd4\x48\x4d\x84\x4f\x7f\xe5\x5c\xf4\xea\x80\x34\x45\xae\xe8\xb1\x5d\x76\x5d\x77\xf5\x29\x9a\x42\xb2\x73\xdc\xcb\xd5\x6c\xa1\x57\xfd\x57\xbc\x8e\x4f\x67\x45\xdc\xcc\x74\xc3\x67\x8b\x10\xd1\x8d\x20\x3e\xc2\xce\xca\xb8\x75\xc2\x53\x3e\x5b\x9f\x20\x08\x39\x4d\xcd\x39\xdf\xd2\xd5\x37\x4c\x9f\x08\x84\x72\xd5\x3e\xcf\xc7\x37\x68\x56\x4a\x7d\x05\x12\x2c\x68\x33\x29\x52\xc3\xaf\xff\xb1\x31\x1e\x88\x1c\x00\xe1\x9b\x71\x21\x77\x14\x47\x7c\x9a\x93\xd9\x87\xfc\xe9\x12\x24\xd2\x2f\x9d\x73\x5b\xa1\x15\x33\xf9\xe5\x56\xc6\x72\xac\xae\x64\xeb\x91\x00\x76\xe2\x72\x5e\x41\x00\x21\xd3\xe0\x48\x86\xaa\x2e\x7a\x1e\x5e\xe4\xd5\xa6\x6e\x92\xe2\xe1\x61\x08\x93\x04\x79\x75\xd1\x8c\xfd\x24\x27\xcd\xcb\x77\x82\xe8\x12\x1f\x55\x8b\x53\x9f\x14\x9e\x48\x52\x83\xbd\xdf\x97\x34\x81\xe2\x3d\xe7\xdd\x03\x5d\x57\xb5\x6f\x3b\x33\x75\x3c\xd1\x9e\x4e\x3d\x52\x9f\x75\x99\x7b\xf8\x7f\xdd\x37\x72\xef\x68\xb2\xa3\xda\x49\x8a\x57\xd3\x6d\xbe\xbb\x55\xa1\x66\x1a\x3f\x7f\x82\xd8\x34\xb6\xab\x33\xaf\x6f\x26\xf9\x05\x6d\xda\x63\x77\xea\xea\xb3\x18\x22\x36\x3a\xa5\x87\x5a\x04\x79\xb2\xe9\x4b\x2b\x1a\x21\xdc\x45\x9d\xd6\x37\xfc\xec\x89\xa3\xfa\x2f\x85\xe7\x21\x81\x66\xa8\xdd\xc0\x6e\x23\x2a\x88\xeb\x75\xe3\x82\x88\x2d\x19\xb5\x28\xa6\xf0\x70\x4a\xdc\xdf\xcc\x35\x50\x42\x7c\xf5\xae\x25\xfd\x53\x42\x95\x51\x3f\xc9\x7d\xea\x67\x40\xac\x37\x0e\x76\x44\xc4\x99\x8b\x18\x95\xc0\x50\xe2\xd1\xec\x04\xab\xab\x65\xd5\xca\xbc\x38\xfe\xe4\x8b\xce\xe7\x58\x1b\x7d\x42\xfa\xba\x5f\x49\xcb\xad\xbb\xa7\x3b\x31\x3d\xef\x2b\xef\xa4\x92\xdd\x88\xb4\xd6\x85\x55\x33\xee\x3b\x45\xf0\xda\x07\x4b\xf0\x51\xd7\x87\x38\x2c\x69\x5d\xfa\x6d\xf3\xb5\x58\xe3\x4d\x2a\xcd\x67\xb6\xc1\xef\x02\x34\xaf\x4a\xbd\xbb\x94\xa8\xbc\x66\xe5\xee\x67\x7e\x99\x50\x7f\x6e\x55\x6d\xda\xc5\x5f\xf2\x31\xa8\x5f\x5e\xa9\x59\xce\x0d\xfb\xf8\x16\x25\x7e\x96\x3f\x51\x3f\xec\x51\x5e\xff\x2c\x0e\x79\x23\x3c\x74\xe6\x3e\xbc\x14\x4b\xd3\xa2\xb9\x49\xd1\x76\xf2\x4b\x4c\x99\x8a\x1f\x3a\x58\x8f\x60\x4b\xd3\x1f\xaa\xfe\x26\xc3\x0b\xa7\xf2\x1c\x0e\x5d\x7f\x88\x8d\x2a\xc5\xf6\xb5\x69\xe1\xcc\xb3\x74\xd6\x9a\xb4\x5c\xd8\x96\x49\x5c\xe2\xbd\x14\x79\x71\x34\x19\xfe\x2b\x7d\x0f\xfe\xc1\x87\xbf\x53\x63\xdd\xf5\x5b\xbb\x32\x44\x04\x07\xc8\x77\x68\xa1\x1f\xe7\x20\x75\xc3\xa6\x54\x59\xbd\x63\x9d\xb4\x9e\x44\xa7\x3b\x2d\x5a\x76\xe0\xf8\x44\x3c\x52\x2e\x64\x78\xa2\xda\x6f\x22\xf7\x2f\x12\x58\x46\xdd\xc5\x70\xef\x20\xf5\xa7\x11\xa5\x7d\x60\x2f\xc0\xc1\xbf\x63\xbb\x6a\x61\xdf\xfa\xc1\x64\x14\x04\xbc\x22\x1a\x1d\xbf\x16\x71\x05\x2b\x1f\xea\xb1\xd7\x13\xe7\x0f\xf5\x72\x2f\xed\x33\x86\x75\xf4\x5a\xcb\xa8\x85\x32\x62\x98\x4e\x01\xfe\x1f\x5e\x43\xcf\x63\x5b\x86\x01\x5f\xd3\xa7\x09\x8a\x46\x0b\x55\xfc\x60\xc9\x63\xd3\xeb\x6f\xb1\x91\xa3\x5a\x62\x41\x35\x5d\x52\x30\xdd\x22\xc6\x22\x53\xac\xf7\x41\xef\x1b\x24\x3f\xcb\x5b\x35\x28\xa6\xc8\x70\x5c\x92\x1a\x7c\x61\x2b\xe5\xc2\x0e\x82\x22\x1f\xe0\xc2\xed\x8a\x9e\x88\xa0\x0f\xaa\x3a\x30\xdd\xf8\xb9\xc9\xdd\x1c\x07\x33\x2e\xd5\xd9\x57\x42\x3d\x19\x94\x87\x23\x19\x81\xfa\x5b\xfe\xd0\xde\x86\x43\xaf\x8e\xce\x58\xc2\x71\x5d\x46\x65\xa9\xe1\x54\xf0\xc3\x3a\x98\x33\x59\x99\xe4\x5d\xf7\x3d\x0a\xce\xb0\x50\xc3\x27\xcb\x21\x5e\x55\x8f\x61\x9d\xe4\x69\xf9\x24\x04\x08\x8d\x0f\xc5\x08\xe1\xe2\x34\xe6\xcf\x4b\xeb\x25\x4f\x05\xba\x0c\x5c\x08\x95\xd9\xf5\x49\x9a\x71\xd0\x8a\x25\xc1\xb1\x69\x08\x41\x46\xa7\x19\x69\x28\x08\xe5\xab\x7d\x65\x4d\xc5\x94\x8d\x0e\x42\x70\x4e\x74\xf4\xde\xa7\xa4\x1f\x99\x69\x9f\xa5\x1e\xaa\x7c\x6b\x77\x98\x23\x25\x2c\xbe\xfc\x60\x78\x67\xbf\xff\xf0\x52\x27\x62\x43\x15\xe4\x8d\x6e\x64\xaf\x43\x0d\xda\xfd\x19\xac\x70\x78\x10\x89\xf8\xec\x26\x44\x47\x43\x64\x3f\x53\x31\x21\x3a\x40\xbb\xfd\x80\xb6\x2e\x94\x4c\x27\x8b\x53\xfb\x9b\x75\x03\x4b\x8e\x8a\xd3\xff\xc0\xe4\x77\xeb\x37\x09\x23\x31\xb0\xe5\x0f\xd2\xba\x46\x05\xc6\x61\xf4\xc7\x22\x86\x30\xa3\x43\xb1\xff\x69\x26\x5c\x90\x96\xac\xe8\x6f\xea\xa2\xe2\xa5\x00\xd4\x63\xb5\x28\x9b\xbc\x3b\x0d\x39\x0c\x93\xbd\x2c\x46\xd2\x9f\x93\x9f\xbd\xf7\x4c\x61\x3f\x61\x6e\x19\xad\x63\xdd\xd5\xb7\x9f\xb1\x16\x7b\x08\x71\xbe\x37\x56\x3e\x51\xea\xbd\x9f\xe3\x3d\x7c\x50\x69\x16\x49\x4b\xec\x52\xbb\xa3\x77\x7b\x09\xce\x1e\xc8\xd0\x90\xe9\xc8\xb6\x60\xa8\x19\x29\x7f\xe4\xf9\x70\x5b\x35\x8e\x9e\x57\x25\xf9\x30\x65\x79\xce\x10\x78\x5c\x90\x3c\x2b\xc9\x6e\x3b\x9d\x25\x4e\x52\x65\x4f\x47\x6b\x41\x67\x6e\x47\x11\xe2\x4e\x6c\x29\xd1\x63\x46\x33\x98\x4f\xa3\x4c\x8d\x04\x7d\xa9\xf5\xba\xa6\xf1\x34\xdc\xa6\x0a\xd8\x39\x29\x91\x37\x99\x72\x7c\x50\x0c\x38\xae\xee\xda\x61\x5c\xfe\x68\xc3\xef\x90\xa1\xc5\xa1\x91\x1f\xfe\x3d\x80\x05\x35\xa2\x2c\x8f\x39\xe2\x37\x9a\x96\x1a\xbc\xad\x7a\x1c\x6d\x60\x40\x19\x35\x6d\x78\x01\xad\x56\x4d\x59\x40\xd9\xb7\x08\x02\x83\x9f\x76\xdf\xbe\xd7\xda\x4c\x37\x90\x99\xf3\x3b\x18\xbf\x97\xcb\x28\xb1\x9d\x3e\x5a\x15\x72\x3d\xbb\x30\x52\x61\xbc\x59\x4b\x87\x58\x79\x5a\x33\xbe\x9d\x48\xdc\xde\x59\xcb\x6e\x81\x8c\x67\xb9\xe5\xec\x42\x72\x9a\xf0\x5e\x0a\x5f\xdc\x87\x57\x29\x5b\xbe\xb0\x9b\x0d\x1e\xaa\x24\x99\xad\xe9\x71\x6a\x6c\xee\x93\xae\xae\x73\x25\xd4\xa5\x31\x01\x22\xf0\xb0\x21\x04\xc1\x43\x94\x6a\x1e\xf1\xf2\x18\xef\x3a\x91\xe6\x75\xc9\x4d\x00\x21\xe6\xd5\x66\x70\x60\x2d\xbe\x0b\xb5\x5a\x5a\x29\xb1\x13\x53\x45\x6f\x07\x15\x83\x63\x25\x98\x3e\xbb\x7b\x8a\x04\xd5\x33\xd9\xbd\x26\x7f\xe7\x8d\xf8\xe7\xc3\xdd\x70\x33\x8c\xe3\x58\x2a\xa9\xdd\x89\x30\x02\x86\x0f\x41\xfd\x87\xc4\x1b\x9d\xcc\xc0\x11\x37\x21\x18\xfa\x4c\xce\xf2\x0e\xe7\x4c\x1b\xf5\xb6\xae\x3f\x90\x16\x50\x5b\x2b\xe0\x18\x8c\x93\xe3\x28\x51\x79\x66\x88\x37\x18\xd6\xc0\x86\x88\x6c\x67\x12\x02\xb2\x02\x73\xf9\x7a\xa8\xcc\x14\x7f\x40\xa8\xf5\xa1\xef\x35\xb4\xe2\x3c\x1d\x14\x93\x07\xc1\xa0\xe5\xfc\x22\x17\xcf\xb9\xa2\x63\x28\xbd\x9f\xf1\x55\x9a\x7c\x2d\x79\x1e\x7b\x58\x9d\x89\xe5\xaa\x26\x45\xd3\x45\x8d\x78\x1b\xc8\xa7\x77\xf4\x84\x61\xe1\x85\xac\x17\x12\x02\x35\x02\x61\xa6\x1e\xb7\xd7\x82\x2c\x3a\x00\x6f\x31\x4a\x60\x0d\x61\x6a\xc2\xfa\x62\x0a\x5f\x10\x15\x30\xe4\x63\x64\xf6\x02\x5b\x95\x83\x2c\x5c\xc7\xdd\xfa\xdb\xd4\x7a\x0d\x8a\x5c\xdc\xed\xaf\x3f\x9d\x44\x02\x5c\x60\x18\x32\x40\x86\x08\xf4\x89\x10\x7a\xc6\x4f\xe1\x39\x9a\x9d\x0e\x02\x83\x25\x7f\x44\x01\x69\x12\x60\xcf\x7c\xe3\x3b\x7f\x43\x80\xf4\x6d\x75\x94\x3d\xf3\x50\x46\xc3\x6c\x53\x0c\x47\xcf\x85\x9a\xef\x24\x4d\xbf\x12\x03\xa3\x06\x34\x8e\xed\xc8\x2f\x37\xdd\x6f\x79\xb4\xf3\xa3\x95\x57\xda\x62\xe9\xe8\x11\x9d\xd5\x35\x03\xef\xc3\x2b\xb2\xb4\xe7\x7d\x13\x21\x9d\x45\xaa\xd4\xd5\x35\xed\xf5\x94\xfc\x58\x9f\xca\xb7\x4b\xa5\xa6\x67\x91\xd0\xb5\x49\xb7\x2c\x23\xc4\x9d\x10\x0c\xb5\x76\x94\x62\xc3\xeb\x0d\x11\x66\x05\x34\xe8\x79\x05\x6f\xae\x6c\x79\xbc\x1e\x11\x67\x5c\x4d\x72\x93\x44\xbc\x50\x40\xd8\x5c\xa2\x31\x1d\x10\xf5\xef\x75\x56\x20\xf1\xf4\xeb\xc8\x66\x5b\x8d\x18\x38\x3b\x56\x0f\x6c\x8d\x07\xd4\x4b\x4b\x6c\x5b\x6b\xa1\x5f\x96\xc2\x4d\x92\xde\xf9\x67\x04\xaa\xd7\xcc\x84\xf5\x2a\x43\xa4\x7e\x55\xf8\xa3\xf6\x97\xbf\x78\x10\xd3\x72\xcd\x31\x5b\xa7\xc9\xfd\x3f\x58\x79\xa5\x2f\x1e\x5b\x5d\xdb\xf2\x3e\x64\xa5\xfb\x51\x46\xae\x9f\x02\xf8\xe5\xfa\x8b\x52\x34\x21\x84\x9d\x27\x2e\xd7\x17\x88\x6b\x61\xa3\xe9\xac\x72\x84\xed\xa5\x2c\x4a\x86\x1f\x38\x30\x0b\x44\xe5\xf1\x33\x79\x2f\x5a\x62\x5a\x98\x05\xa1\xdb\x9e\x6a\xe6\x3a\x73\x63\x9c\x6a\x9d\x66\x2b\xef\xe2\x06\xd9\xba\xbf\x96\xb2\x45\xc4\xb9\x4b\x0f\x1a\xc6\xfc\x37\x66\xa9\x68\x00\x6d\x11\x5f\xa7\x50\x18\xf2\x9e\x44\xd5\x0f\x8f\x24\xbb\x32\xe5\x1f\xe8\x66\x2c\x38\x52\xf1\x94\x6c\xf9\x72\x4c\x22\x00\xb1\x6b\x80\x03\xbc\x5e\x36\x5d\x4e\xa5\xd2\x83\x47\xd5\xee\x37\xe1\x58\xf7\x74\x58\x4d\xa5\x94\x97\x1b\xfd\x8a\x91\xde\xa3\x7f\x90\xe5\xfd\xdb\x9d\xc5\xbc\x56\x3b\xd6\xdb\x65\xac\xbd\x8a\x9d\x0b\xd6\x1d\x37\x3c\xf0\x1c\x16\x5c\x1a\x7c\xa8\xa4\x78\x11\x1c\x94\x37\x4a\x13\x0f\x73\x53\x4c\x4e\xae\x9f\xde\x6d\x58\x8c\xd3\x2b\x41\x72\xc5\xde\x5b\x24\x77\x9d\x02\x11\x59\xd6\xe8\x27\xb9\x51\xfe\xdd\x2d\x56\x3f\xc7\xc4\xc1\x1a\x53\x52\xc9\x17\x6d\x3a\xda\xbf\xf1\x3a\x5b\x41\x84\x92\x2c\x85\xe5\x8d\x2b\x66\xe9\xbd\x33\x3b\x89\x53\xb3\x81\x06\x92\xd0\x57\xee\xd4\xc0\x87\xb6\x4e\x31\xd6\x14\x18\xc6\xdd\x19\x19\xec\xbd\x09\x14\x7c\x9d\x0f\x2b\x35\x70\x66\xd3\x79\xd5\xcb\xfb\x47\x7a\x20\x2d\x48\x70\xe8\x43\x60\x2e\xe6\x3c\x7b\xf9\xa8\x4c\x09\x87\x2a\x88\x5d\x72\xa9\x04\x7a\x75\x75\x92\x44\xb6\x84\x73\x2e\xf2\xa3\x77\x1d\xbf\x4b\x21\xe0\x7c\x98\x07\xa3\x50\xf4\xd5\x83\x72\x69\x80\x21\x1b\x55\x51\xef\x18\xa9\x7f\x49\x38\x67\x72\xe6\xfa\xe7\x9e\xf5\xcc\xc5\xf9\xd1\xa3\xa7\x2c\x81\x07\x42\x51\x32\xa2\x19\xd9\xf7\xa2\x09\x5e\x12\xae\x7d\xa8\xcd\xd0\xd5\x12\x3b\x7b\x8a\x43\xd6\x7f\x11\xa3\x03\x46\x91\xa0\xb8\x55\x9c\xce\x51\x4b\x07\xe4\x9f\x97\x91\xf0\x1d\x32\x0f\x3d\x9f\xde\x1b\x28\x17\xae\x02\x11\xc2\xe2\x99\x5e\xaf\xce\x52\x4a\xc0\xab\x34\x45\x9d\x39\xef\xd4\x2a\x4c\xed\x07\x79\xe3\xb0\xbd\x28\x02\x0a\x39\xd1\x52\xf6\xf5\x99\xff\x48\x1d\x1b\x5d\x8a\xaf\x33\x4f\xba\x23\x13\x59\x20\x0f\x45\x1f\x09\xba\x87\xdc\x37\x2a\x3d\xb1\x75\x33\x81\xa0\xd2\xe0\x63\xa4\x78\x5c\x89\x12\xc5\xd1\x27\x56\x16\x9a\x92\x3f\xfa\xc6\xab\xfd\x47\xfe\xbc\x0f\x39\xe9\xab\xb6\x56\xa7\x7c\xf5\x99\x63\x47\x46\xbd\xde\xcf\x11\xb5\xc2\xe3\x2b\x91\xf2\x41\x63\x8a\xa4\xc2\x11\xbe\x1c\x05\x6c\x2b\x7c\xcb\x8d\x39\x97\xa4\x5f\x45\x5e\x93\xf8\xdb\x6f\x11\x3a\x5f\xbf\x0e\x35\xa8\x85\x6d\x31\x20\x19\xa6\xd6\xdf\x1e\x96\xbf\xe7\xa4\x71\x23\x1e\xe9\xf6\x48\x1f\xe9\x77\xc1\x10\x9c\xef\x3b\x32\x6c\x7a\x60\x86\x15\x71\xa0\xca\xff\x81\xc4\xc6\xd1\x64\xea\xb6\x74\x0d\xc6\xdb\x3a\x31\x5e\x5c\xeb\x9f\x3e\xb0\xd1\x7c\x7e\xcb\x0e\xd9\x1c\x65\x31\x82\x24\x6d\xa1\x24\x8b\xa1\x80\x5a\x0e\x3b\x0a\x2b\xfa\x6d\xa5\x66\x65\xef\xbe\x7a\x9f\x83\xe7\xea\xb0\x47\x76\x68\xfd\x5a\x32\x36\xb7\x59\x29\xaa\xa1\x7f\x5c\x8e\x5f\x58\xac\xf1\x4f\x19\x42\x79\x15\x87\xc2\x1f\xe8\xf8\x22\x3f\xb0\x2f\x04\x1a\xa6\x0f\xb2\x34\xf8\xc3\x9d\x1e\x66\x84\xfd\x04\x13\x73\x40\x7f\x82\xdf\xf4\x82\xcb\x82\xc3\x44\x23\xef\xdb\xb3\xa4\x59\x43\x7c\x08\xf8\x72\x08\xc9\x04\x65\x71\x79\xe4\xa4\xf0\x68\xee\x9b\x39\xbf\xe3\xb7\xb5\x93\xa0\xa7\x25\xfc\xf6\x8d\x58\x50\x11\x89\x55\x73\x9d\xf8\xed\x01\x15\xf7\x0b\xd0\x64\x2f\xf8\x82\x04\x48\xed\xe6\xd7\x59\x97\xd6\xea\xcd\x48\x93\x36\xed\x86\xc3\xa1\x53\xf0\xde\x88\xbc\x20\x95\x39\x04\x5e\x09\x4a\x7f\x5f\xc1\xba\x55\x44\xa6\x8b\x87\x04\xa0\x3c\x6a\x36\xeb\x8b\x3d\x67\xc5\x68\x89\xb1\xbc\xba\xa2\xef\x0f\xe4\x1b\x0c\x55\x4f\xc2\x8e\xe1\xfa\x5c\xc5\x4c\x17\x46\x3b\x2b\x9e\xed\xda\x24\xeb\x88\x53\x41\xb9\x51\xf6\x01\xdb\x72\xff\xdb\x05\xfb\x2b\x65\x10\x1c\xae\x46\x5d\xe0\x51\xc1\x37\x79\x14\x35\x67\x0c\xfb\xbc\x08\xbd\xd6\x64\xc2\x19\xa6\x37\x91\x17\x6f\xc0\x6f\x28\xfc\x96\xe5\xec\xcb\x14\xd9\x6f\x01\x1d\x06\xbf\x80\xd5\x22\x3d\xe5\x64\xd5\x7d\xcb\x1d\xd8\x9b\x19\xa6\x9d\x0b\x5c\x73\x51\xdd\x86\x00\x92\xb8\x0b\x5a\x79\xa5\x7c\x87\xbd\xc4\xc5\x76\x78\xc0\xc7\x48\x7d\x1c\xdf\xe2\x64\x43\x19\x77\xc3\x00\xd6\xd7\xb5\xb5\x76\x53\x15\xbd\x62\x98\xd2\x78\x3a\x14\x68\x86\xca\x5a\xdf\x10\xef\x41\x22\x58\xfa\x3f\x88\x50\x0e\x32\x95\xd9\x3b\x61\x7a\x66\xac\xf6\xcf\x39\x70\x77\xb0\xdb\x23\x8f\x8f\x5b\xd4\xee\x06\x33\x2b\xee\x26\xf7\x86\xf1\x0d\x1d\x68\x07\xe3\xcb\xde\x20\xf5\x37\x2a\x31\x87\x8d\x5f\xbb\x6f\x9b\xae\xed\x3b\x61\x93\x9e\x09\xbf\x56\xbd\xb1\xe0\x23\xdb\x89\x61\x45\x05\x38\x11\x81\xb9\xc5\x26\x22\x11\x45\xfd\xbd\xe6\xea\x3f\xfc\x9c\xca\xc8\x46\xce\xe2\xd5\x48\x1b\x8a\x74\x1b\xf6\x18\x1b\x64\xdf\xd7\x76\xee\x48\x08\x90\xca\x6e\x97\x46\xf4\x0d\x94\x79\x09\xdf\xb1\x8a\x67\x5b\xf1\xb3\xb9\x86\xb0\x1f\xce\xf1\x32\x4f\xcd\xfa\xf2\xa3\x12\x7f\x63\xe8\x33\x91\xa4\x79\xa7\xcf\x21\xb0\x4f\xae\xc1\xd2\xe7\x22\x5f\xfa\x4e\xa9\xc9\x99\xe3\xd2\xf6\x94\x17\x3f\xe4\x84\xa8\xcd\x81\xda\xfe\xe3\x2a\xaf\x2f\x92\x7e\x86\x32\x89\xdb\xd5\x61\x3a\xae\xa3\xa6\xfe\xdb\x36\x66\xb6\x2b\x8f\x62\xf9\x89\x4b\xcb\x04\x06\x49\xde\x4c\x69\xb2\xa1\x66\x6d\xdf\x7c\x3e\x18\x2e\xf1\xd7\xe2\x5e\x62\xbb\x8e\x31\xa2\x19\x82\xd2\xb8\xfc\xf9\xef\x6a\xa1\x39\x9b\x97\x26\x11\x70\x73\x75\x20\xb6\x5f\x0a\x42\x21\x6c\x1c\x06\xf6\x0e\x69\xd2\x36\xa1\x51\x3d\x3c\x4c\x2a\xec\x61\x71\x09\xe7\xa2\xf2\x47\xea\x24\x68\x1b\x04\x94\x00\x7b\x61\x94\xee\x3d\x49\x59\x07\x8a\x0e\xcc\x9a\x64\x53\xcd\xb4\xd0\x95\x05\xf3\x32\x20\xe1\x76\x82\x01\x5b\x6f\xa0\x4a\x74\x7a\xe2\x29\x89\x0c\xa5\x11\x18\xb6\xe3\xdf\xf4\x93\xcd\xde\xf4\x9b\xac\xb7\x89\x11\xb2\x62\x51\xf0\x11\xd9\xc4\x67\xd1\xd0\xa6\x37\x72\x09\x56\xd6\xcd\x69\x92\xcb\xc4\xd2\x07\x46\x1c\xed\x68\xff\x1b\xe6\x08\x50\xde\x84\xea\xef\x40\xd2\x62\xef\x86\xfa\xcc\x7e\xe9\xf7\x4d\x75\xe4\x32\xd5\x1b\x88\x98\x08\x08\xa1\x6d\x6e\x78\x4f\xf6\x99\x67\x53\xf1\x76\x01\x20\x78\x1d\x75\x42\x7a\x1a\xcd\x47\x5e\xa0\xa4\x75\x15\xa6\xc1\x25\x62\xa7\x1f\x6a\x25\xa4\x58\x6b\x54\xe1\x3f\x8b\x6c\xb3\xda\xb5\xbb\xaf\x1a\xcf\x8d\xb7\x61\x40\x2f\x2c\xb5\xbb\xdf\x2a\xb9\x73\x78\xb7\xc6\x3f\xb6\x2c\x37\xc6\x48\x01\xd7\x44\x26\xc2\x85\x4a\xe7\x5e\x63\xa3\x8a\x27\xd0\xd9\x60\x1b\xf5\x5f\x60\xcd\x84\x03\x8a\x3f\x78\xc6\xef\x88\xe9\x6f\x44\x4d\x8c\x89\x02\x95\x24\xc8\xe8\x91\x62\x8b\x63\xf6\xcc\x57\xe1\xc5\xad\x33\x10\x99\x68\xeb\xa4\xce\xa5\x07\x61\xcb\x37\x31\x00\xfa\x14\xaa\x8a\x81\xcb\xd5\xb2\x68\x38\x02\xf5\x57\x9f\xc7\x59\x2a\x82\x17\xcc\x78\x7d\xb0\xb2\x81\xf7\x37\x36\x4c\x35\xbf\xb7\xe1\x99\x0e\x5f\x39\xa4\xc4\x73\x77\xae\xed\x70\xf5\xaa\x0a\x54\x4a\xdb\x5e\xb8\xc5\xf3\x4f\x5b\x23\xf1\xbf\xb2\x5f\x21\xed\xa1\x8e\x2d\x9b\xf5\x07\x2a\xe7\xd4\xe6\x9d\x28\x5b\xc0\x43\x71\x00\x19\x80\x19\x40\xd6\x9b\x2c\x78\x26\x22\x84\xb5\x4b\x77\x84\x77\xa7\xff\xaa\x37\x9b\x9f\x9b\xa5\xfa\x49\xb3\x12\x24\x1d\xf3\x8a\x14\x91\xb6\xf4\x8b\x85\xed\xe6\xa7\x49\xc7\x07\x33\xde\x07\x0e\x9d\x2a\x97\xc3\x63\x8c\x61\xb0\xd7\x55\x87\xa8\x95\xd5\xe9\x9f\xce\x6a\x7e\xec\xc1\xb4\xd3\x3f\xc0\x46\xf3\x53\xa2\xa5\xb9\x48\x0a\xea\xcb\xe2\xac\xc4\x00\x00\x45\x1b\x35\x08\x38\xf1\x04\x7c\x89\x7e\xce\x69\x7f\x74\xf7\xef\x4e\x1f\xa2\x42\xc8\xea\xc2\x1a\x71\x2a\x9c\xb3\xde\xba\xe6\x39\x54\x72\xfb\x83\x82\x05\xec\x84\xc4\x54\x96\x06\x8a\xaf\xfe\x2f\x5e\x5e\x82\x4c\xfb\x11\x3c\xc1\xf3\x9a\x6b\x92\xc9\xb6\x01\x64\x2f\xa7\xb9\x3e\xf2\x74\xb7\xc8\x29\xf9\x6a\x23\xf3\xb4\x3f\x2e\xbf\x2d\x77\xd0\x56\x6e\xd3\x7a\x54\xfd\x84\x00\x3d\x78\x04\x83\xc1\xa2\x8d\x3b\x27\x5c\xf5\xcb\xe9\x6c\xe0\xcf\x6c\x88\xf0\xc5\x71\x6f\x92\xad\xb0\x60\x5f\x35\xbb\xcd\x7d\x71\x5c\x62\x14\xd0\xbf\x5a\x8c\xbe\x7b\xf8\xea\xe2\x8d\xe2\x68\x9b\xac\xee\x62\x7f\x49\xc3\xfb\xc0\x28\x7e\xca\xcf\x0b\x11\xa8\x4d\x37\xe1\xae\xcf\x35\x7b\x12\x92\x11\xa3\x95\x2e\x4f\xa0\xd7\x7c\x1e\x23\x27\xb3\x64\xc7\xf6\x68\x2f\x78\x54\x1e\x54\x88\xd5\x8a\x63\xbc\xe0\xfc\xe2\x9b\x52\x53\x49\x7d\x82\x5c\x2b\xb0\xdf\x43\xae\x38\x79\x45\x1f\xb8\xf7\x38\x87\x1d\xd7\x48\x5e\xe4\x49\x0e\x22\x6e\x4f\xe6\x8f\xe9\x39\x98\xa0\xaf\xfa\x81\x74\x00\x20\x10\xc4\x1c\xae\x8f\x1d\x89\xf2\x3e\x60\x9f\x8e\xb8\xe6\xd2\x07\x4d\x68\x88\x6e\x3f\xd0\xbf\x6a\x0e\xc8\x6e\x71\x6c\xaa\x9e\xd6\xe7\x81\x95\xe0\x22\x7e\x28\xa7\x62\x19\x1e\x9c\x9d\x58\xc6\x59\xe8\x12\x5d\x3c\x8e\xb8\x53\xb2\xcf\x98\xbe\xcf\x84\x88\x08\x2c\x5b\xe0\x5f\xad\x7d\x49\x7e\x86\x3f\xe4\x54\xc4\x5b\x7b\x72\xc5\x93\x8d\xd0\x42\xf1\x21\x13\x72\x88\xf3\xcc\x87\x4b\x00\xd3\x48\xcd\xe3\xf1\xb8\x96\x97\x78\x10\x80\x4c\xff\xa5\x2d\x06\x80\x8e\x83\x7f\x8d\x43\x7c\xc9\xb7\x13\x9a\xca\x88\x16\x66\x8d\xc8\x81\x2a\x9d\x40\x41\xe4\x1c\x11\x06\xfc\xfc\x95\xd5\xfa\x9c\x33\x3b\x36\xc0\x77\x68\xb4\xd3\xc2\xca\x09\xe6\x4a\x96\xc1\x15\x9f\x0d\x37\x4e\x71\x87\x32\x5c\xb6\xe2\x60\x15\xb0\xe5\x33\x82\x5b\xc6\xfb\x4e\x56\xbf\x4d\x85\x8e\xea\x35\x66\xf3\xbc\x58\xd0\x7b\xf1\x5d\x61\x76\x4b\x4e\xb3\xa1\x4c\xf4\x06\xa0\x8a\xe1\x7b\xa3\x4a\x85\x27\xaa\xb3\x3f\xb8\x62\xed\x79\xf4\x54\x56\x24\xb2\x4f\x62\xc2\x63\xd2\x3a\x9e\x16\xa3\x50\xe2\x70\x36\xd3\xfe\xde\xe5\xe2\x58\x35\x29\x94\x94\xc0\x93\x46\xe0\x8c\x3f\x0a\xc1\x21\x29\xff\x5f\x31\x79\x5f\x83\xc5\xf1\x63\xf0\xd7\x0a\x99\xdb\xd5\xdb\x5e\x1f\x03\x14\xb7\x6d\x9e\x56\xfe\xcf\x1b\xd8\xf9\xea\x4c\x15\xc7\x0f\xcc\x41\x3c\x21\x09\x54\xf3\xd2\x69\x60\x80\xa1\x5d\x46\x24\x95\x31\xa6\xe2\x3e\x6e\xe3\xfb\x5d\x4a\xba\xdf\xcf\xd1\x67\x24\xe7\xcc\x2c\xff\x79\xbd\x4a\xc9\x9e\xf1\xf3\xcb\xbf\x49\xfa\x96\xfe\xb4\x34\xea\x3f\xa8\xee\x2e\xfa\x10\xc0\xd4\xe7\x20\x74\xf4\xd6\x6a\x75\xf0\xd9\xc3\x76\xa7\x83\x4d\xcd\xaf\x3e\x7d\xb5\x77\x3d\x97\x99\x04\xf1\xd7\xef\xe4\xb9\xb1\xd1\x09\xaa\xe1\x06\xb3\xe2\x3b\x78\xb1\xce\x6e\x6d\x21\x69\x41\x49\xe5\x53\x3a\x69\xd2\xc8\x86\x45\xf3\x2a\xfb\x68\xbb\xce\x6c\xee\xf9\xcb\x50\x8c\x75\xfc\xc3\xae\xc1\x9d\xe1\x6c\x79\xff\xed\xd1\xc7\xc7\xda\x8c\x79\xed\xff\x76\xc0\x1e\x9c\x38\x0e\xda\x05\xd7\x49\xe9\x98\x9e\x77\x02\x6f\xb5\xa1\x79\xd3\xad\xd7\xa8\x47\x1b\xd6\x6d\xf7\xbb\xae\x8f\xc0\x99\x7a\xfc\x46\x10\xfe\x1d\xc5\x16\xff\x48\x61\xd2\xbb\xb8\x91\x65\xe6\x76\x44\x28\x0c\xe2\x32\xe0\x4b\x0c\x94\x4e\x48\xc9\x67\x82\xe8\x26\x1f\x36\xad\x3c\xd1\x6a\x6d\x02\xf8\xeb\x03\x70\x39\x33\x30\xbd\xab\x50\x1c\xf6\xac\xd7\xb0\x34\x59\x51\x6a\xc3\x96\xc7\x8e\xae\xa0\x9d\x15\x8b\xe1\x59\x13\x1d\xf9\xfa\xeb\x75\x5a\xcd\x3e\x75\x66\x55\xcb\x22\xf8\xd7\x7c\xdb\x0b\x06\x62\xa4\x89\xf8\x23\xbe\x74\x73\x3a\x5b\x9e\xf5\x3b\x38\x3c\x12\x24\x8f\x09\x70\xef\xbd\x16\x1b\x5b\x43\x71\xe5\x05\xea\xae\xad\xe4\xc5\x33\x86\x0d\x05\x22\x04\x83\xad\x8f\x30\x91\x27\xc2\xff\x47\x89\x91\x01\x92\x22\xe6\x31\x56\x22\x91\xf3\x5c\x0a\xd8\xa8\xca\x8c\x14\x78\x9e\x78\xe2\x1f\xc8\x35\xd6\xb3\x23\x5d\xd8\x50\x18\xbe\x59\xa0\xf8\x42\x83\x21\x88\x62\x79\xed\x63\xcb\x1d\xad\xb1\x96\x7a\x13\x5f\xc3\x70\x17\x15\xd8\x57\x41\x39\x8b\x41\xc0\x0f\xbf\xde\x83\x8e\xac\xaf\x26\xa5\xb7\x8d\xd2\xa7\x1d\x8a\x83\xcd\xd8\x8a\xdb\x8c\x09\xbc\xdf\x7f\x9e\xb0\x31\x8f\xd5\x47\x1c\x5e\x79\x8d\xe8\xbd\xde\x9e\x7a\x36\xa9\x24\xc3\xb2\xf6\xef\x2a\x28\xa2\x9b\x46\x04\x7a\x06\xe3\x07\xac\xb0\xce\x3c\x6a\x3a\xed\x3b\xee\x6b\x0b\xf0\xa0\xad\x2c\xac\xc2\xc8\x67\x0e\x9b\xf5\x28\x1f\x7e\xd1\xdf\xd8\xad\x12\x14\x1f\xbb\xad\x9e\x88\x88\x62\xcf\x72\xfe\x0f\x9e\x92\x82\xf2\x9a\xd8\xdc\x5f\x28\x77\xcf\x5e\x84\x15\xbe\xc0\x19\x86\x70\xd9\x90\x7a\x84\xa3\x2d\x47\xba\x62\x81\x90\x14\xe0\x5b\x1c\xab\x96\x9f\xee\xf4\xa9\xdb\x05\x85\x63\xab\xe1\xca\xf9\x93\xe0\x55\x44\x87\x3a\xaa\xc0\xb5\x46\x95\xa5\x4d\x3e\x1b\xa7\x5c\x0c\x2a\x31\x6a\x25\xa5\x48\x93\x99\x45\x14\x14\x4b\x0d\x38\x5e\x95\x2a\x14\x2d\x00\xdb\x3a\x3c\x6a\x45\xea\x62\x9e\xd6\xcf\x67\x4a\x92\x75\xa3\x4e\x4e\x0a\x5f\xfd\xad\xc1\x31\xb3\x21\x3a\x55\xff\xc8\xc4\xd1\xfb\x33\x01\xbd\xfe\x8c\x83\x73\xde\x68\xf6\x5c\x4b\x38\x81\xbe\xfb\x29\x46\xe5\xed\x70\x95\x4f\x87\xdd\x2f\xcf\xc2\x0a\x28\x3f\xe3\x8a\xc5\xde\x03\x85\x08\x5c\xef\x01\x32\x2b\x50\x92\x90\xa3\xb5\x04\xc2\x82\xac\xda\xc7\xeb\x18\xa3\xc3\x5c\x7f\x79\x87\xa3\xa6\x27\x16\x58\xd8\x78\x95\x12\x18\xd8\xe0\x3f\xf5\x3b\x1c\x84\xab\x14\x64\x5a\xe8\xfb\xe4\xff\x79\xc9\xc5\x40\x01\xd8\x92\x28\x3a\xfd\xc3\xe1\x4b\x76\x6b\xe8\x71\x64\xbc\xe1\x95\x0a\x9a\xfa\xcb\x88\x2c\xfc\x7f\x08\x00\x7d\x7e\xc4\x21\xa7\xdd\xe6\x3f\x59\x3d\x3b\x83\xf0\x99\x69\xba\xb5\x8f\xc7\xbf\x9d\x1a\x5c\x2b\xe9\x36\x84\x4a\x81\xdc\x18\x8b\xcb\x79\x43\xcf\xb1\xf0\x39\xd0\x89\x95\xcb\xbe\x5d\x62\xb1\x5b\xa1\x6a\x1d\xa2\xb1\x65\x10\x23\x34\xf5\xc3\xdc\xf7\x97\x4e\xe6\x62\x60\x4c\x91\xc5\x09\x7f\x5e\x17\x6d\x4e\x17\x1e\xba\x4b\x08\xe0\x53\x74\x9b\xd7\x63\x5a\x66\x45\x0d\xc6\x19\xc1\xbd\xf9\x24\xf9\xe3\x41\xb9\x2e\x86\x86\xd9\xc8\x27\x6e\x53\x2f\x43\xb2\x6e\x52\xd0\x3a\xfd\x6c\xea\xd5\xa3\x7f\xff\xa9\x8a\xa1\x68\x35\x10\x5a\x4e\x3f\x46\x41\xa1\x59\x02\x8f\x34\xdb\x28\x34\xbc\x6e\x18\x79\x2e\x38\x2a\x12\x34\x5e\x84\xa9\x2d\xca\x2f\xce\xc2\xd8\x40\x93\x8f\x11\xc0\x5b\x6f\xa2\x21\xbc\x38\xf6\xe2\x9f\xb8\xe3\xb2\x35\x48\xb9\xa1\x08\xf8\x2a\xd4\x4c\xb6\x13\xda\x3a\x7a\xc9\x27\xb8\x3a\xae\x90\x85\xe7\x76\xa4\x29\xcf\x87\xac\xb0\xc1\xbc\x60\x06\x6e\xf2\x7d\xae\xe2\xad\xe6\x7c\xc7\xde\x71\x13\x53\xbc\x9c\xd1\xff\x2d\xbd\x43\x30\xf4\x7d\x9c\x6c\x6d\xe0\x4a\xa8\xa5\x55\x1a\xd7\x9e\x45\xa3\x4b\xe4\xfd\x00\x72\x5c\x1f\x2f\x67\x45\x76\x6e\x89\xea\x8b\x2a\x55\x22\x8c\xd4\xa5\x9e\xf7\xe2\x02\x8b\xc6\x77\xba\xde\x34\x6b\xf5\x90\xf3\xf6\x45\x97\xa5\x58\xa3\x98\x61\xb2\x94\xde\xa2\x45\x93\x4c\x4b\x4e\xe7\xc2\x6a\xe1\x8f\xb5\x0e\xcd\x99\x81\x83\xd5\x8b\xd9\xa9\x92\xff\x66\x73\x15\x9f\xf1\x6c\x90\xdc\xf0\x5c\xa2\x83\x7e\x90\x65\x24\xa9\x9c\x42\x1d\x87\xbe\xff\x04\x27\x26\x36\xfc\x20\x0f\x32\x36\xfc\xe2\x87\x74\x23\x1d\x89\x3a\xa5\x14\x7d\xcb\x78\xe9\x67\x28\x7b\xdd\xa8\x49\x9c\x32\x21\x77\x9d\x1c\x39\xdd\x20\xb5\x41\xa2\x83\x42\x11\xfb\x74\x50\xff\x9b\x39\x39\x8d\xb1\x13\x54\xd4\x5b\xbf\xee\x5f\x2e\xda\x89\xab\xe6\x5e\xa9\x83\x6e\xaf\x50\x67\xa5\x66\x8f\xcb\x0f\x20\x13\x77\x27\x5e\xe3\x22\x02\x85\xca\x8f\xc9\x5f\xb8\x9d\xe0\x44\x83\xb6\xd1\x52\xaa\x15\xd8\x4d\x0e\x10\xdf\x79\x52\x33\xf8\x10\x22\x9e\x42\x41\x10\x27\xec\xf1\xb8\xa9\x52\xdf\xbd\x5e\xda\x75\x5b\x32\xad\xae\x44\x77\x20\x9b\x09\x01\xa8\x90\x21\xfc\xb7\xde\xa2\x79\x16\x94\x87\x7e\x65\x64\x65\xef\xe7\x15\x2d\x0f\xa6\x08\x0c\x8c\x75\x33\x2e\x35\x1f\xcd\x95\x19\x6b\x69\xfb\xc3\x73\x12\xdb\x30\xa1\x5f\x75\x37\x78\xb7\xb0\xb2\xb5\x25\xa4\x23\x71\x98\xc7\x24\x7d\x69\xb8\x80\x36\xf8\x9c\xf3\x34\x91\xfe\x81\x3b\x2a\x21\xa5\x7d\xcd\x26\xbe\x56\x0b\x78\xe0\x69\xa1\xb9\x20\x19\x0e\x90\x4b\xd8\x3a\x6d\xc0\x82\x50\xac\xc1\x10\x2b\x88\x2a\xd7\xa8\xf5\x3b\x8a\xf2\x38\xc3\xb7\xed\xa7\x29\x8b\x0d\xec\x9b\x3f\x12\x34\x79\x91\x7b\x9d\x54\x70\xf9\x3a\x08\x70\xb3\xe6\x8f\x55\x28\xa3\x9d\x61\x2c\x7f\x6f\xa2\x41\x33\xf6\xb8\x21\xf9\xef\xcf\xeb\x08\x40\xbc\xaf\xed\x1b\x00\xcc\x9b\x8f\x34\x2c\xdc\x18\x13\x3e\x74\x46\x04\x96\xa3\x62\x27\x4d\x8d\x98\xbe\xee\xea\xe8\x7f\xaa\x1c\x02\xce\x3b\x15\x6e\xf4\xec\x94\x86\x81\xf2\x3b\xc3\x17\x27\x54\x3c\x6f\x5e\xf9\x84\xdc\x1a\xdc\xa8\x93\xda\xdc\x5d\x3e\xbb\xda\x1b\x86\xd0\x3f\x84\x0e\x1b\x13\x5c\xd8\xf5\xbd\xc2\xfa\x16\x49\x1e\x10\x47\xd0\x3d\xf8\xd2\x81\x7a\x4f\xd1\xa8\xad\xbe\x75\xf8\x84\x09\x50\xae\xae\x11\x0b\x66\xb2\x48\x20\x39\xcc\x48\xab\x45\x2f\x74\x38\x6c\x84\x74\x50\x16\xdd\xda\x57\xd7\x0e\x15\xb7\x67\xe3\x6b\xa9\x66\x92\xa7\x0a\xf2\xff\xf7\x8b\x1b\x1d\x22\xf4\xfb\x85\xff\x3b\xf6\x99\x77\x44\xd4\xd4\x40\x73\xd8\x17\x6a\x5d\x36\x85\xe3\x26\x7d\x49\x45\xf9\xc8\x7a\xd1\xf9\xae\x2c\x7a\x2b\x95\x0a\x9b\xcb\xf7\x13\xd4\xd1\xf2\xbf\xab\x58\xa6\x6f\xec\x1f\x89\x78\x44\xef\x5a\x02\x36\xc5\x1e\xb9\xeb\x61\x99\xcd\x59\x47\x4e\x5a\xea\xee\x58\xf7\xb9\x47\x01\xbe\x89\x96\x8f\x6b\x3c\x37\xa9\x6f\x74\xdd\xe9\x35\x63\x3e\x0c\xfb\x31\x69\x1a\x57\xc0\xb6\x6c\x35\xab\x14\x1a\x75\xbf\xac\xd4\xb4\xb9\xfc\xea\x54\x21\x4d\x09\xf2\xa6\xf2\xac\xb3\xdc\xcb\xdd\x0e\xf4\x5e\xe4\x51\x1d\xcb\x7b\x77\x43\xfd\x30\x69\x3a\xbd\x74\x88\xb5\x98\x82\xdc\xf3\x56\xb1\x2c\xc4\x92\x3e\xf1\xbf\xbf\xc2\x74\x00\xe0\x37\xa1\xd6\x40\xbb\x24\x66\x47\xe2\x96\x03\x1b\x1c\xc0\x41\x0c\x63\x59\x48\x2e\xef\x03\xa1\xe2\x8f\xe1\xde\xd4\x15\x62\x6d\x17\x9a\x1e\x76\x40\x61\x91\x2b\xd6\xd5\x57\xe8\xa8\x67\xfb\x82\x75\xa2\xcf\x4e\x13\x19\xdb\x89\x01\xfe\x9f\xbb\x9f\x71\xbe\xa4\xf4\xa1\x8e\x84\x6b\x4b\x54\xfe\xf9\x8a\x67\x5a\x95\xde\xee\x46\x9c\xe9\x2b\x73\x6a\x62\x33\x72\x0c\xde\x20\xc2\x13\x31\x2f\x18\x08\x55\xbd\xcc\x53\x45\x49\x92\xa7\x1a\xb6\xc8\xf3\x6c\x0e\x1a\x22\x7e\x30\xed\x83\xbf\x8f\x99\x5c\x27\x45\x9c\x64\xd9\x5d\x23\xda\x5a\x21\xd3\x59\xc9\x21\xba\x12\xd8\x4f\x67\x7c\x72\xcc\x9a\x78\x81\x63\xee\x50\x4e\x93\xce\xb1\xdd\x20\x3f\x1c\x61\x1b\xa5\xdd\x42\x49\x00\xa0\x06\xc8\x40\xeb\x6b\x1a\xdd\x74\x92\xeb\x17\x25\xf8\xaf\x1e\x5f\x62\x35\x4b\xbc\x2b\x36\xca\xc1\x36\x57\xd7\xfc\x79\x3b\xf6\x86\x16\xd5\xe2\x25\xca\x51\x63\x12\xc0\x86\x78\x9a\x8a\x5b\x47\x67\x11\xe6\xed\x5e\x23\xae\x76\xec\x8f\x39\x4a\xc6\xe4\xe1\x65\xb7\xc4\x44\x2f\x2d\xeb\x45\x8f\x03\x92\x5a\x22\xe4\x51\x89\x19\x27\xb3\x51\xff\x34\xfa\x21\x2f\x20\x70\xe4\x71\xfd\xfa\x53\x28\xc3\x75\x8e\x3d\x31\x95\x83\x17\xd7\x1b\xa2\xb4\x31\x08\x22\xe2\x03\xe2\x8b\xc1\x6f\xc0\x0d\xe8\x02\xfc\x63\x11\x1e\x0d\xc5\x94\x57\x8d\xdf\x5e\x54\x8a\xae\xc4\x3e\x5c\x63\xb7\x9e\x13\xff\x81\xc8\xda\x4c\x60\xbd\xd5\x39\x32\x8d\x9c\xa8\x9c\x91\x5d\xd8\x0d\x46\xfa\x5e\x68\xaf\x71\xd4\x83\x70\xfe\xc9\xd8\x3c\x4e\x44\x51\x06\x39\x7b\x93\x81\x60\x73\x4d\x7a\x1e\xe1\xbf\x4b\x60\xde\x01\x6a\x11\x25\x21\xea\x1c\x6e\x2f\x0a\x76\x6a\x8f\x9a\xbd\x9e\xc8\xec\x9e\x3a\x54\xfc\x75\xb4\xd2\x97\x50\xe2\x9a\xea\x0b\xfd\x26\x08\xab\x05\x70\x93\x57\x73\xb5\x28\x43\x96\xd8\xb0\xd1\x1a\xb9\xf6\x82\xb5\x3c\xcd\x95\x20\x2b\xbf\xc9\x13\x92\x3f\xf4\xd2\x96\xcb\x28\x7f\x8e\x75\x70\xe9\xf1\x0d\xf7\x33\x0d\x1c\xd8\x83\xe3\x8e\xc7\xb1\xab\xd7\xef\x3e\x88\x5e\xbe\xf3\x73\xe4\x30\xd9\xdb\x80\xe3\xa7\x88\x2e\x95\x91\x37\x73\x3e\xab\x5b\x5d\xed\xe8\x06\x6f\x27\x5e\x3e\x61\xf6\x6c\x46\x97\x74\xbc\x38\x5f\x85\x56\x34\x71\xea\x72\xfa\xbd\x36\xb2\xa0\x2e\xf1\x53\x68\x3f\x63\xf3\x56\x2b\x09\x5f\x86\xc4\xa7\xb7\xb2\x08\xba\xa5\x7f\xfe\xfa\xcd\xe0\x8a\xe9\xe4\x2a\xaf\xd7\xa4\xcd\xf2\xd5\xb1\x0e\xd2\x5e\x36\xdc\xa5\x1f\xec\x61\x02\x08\xd4\xd9\x8a\x99\xe3\x1d\x46\xf5\x77\xea\x41\x9a\xf8\xef\x17\xc8\x15\x62\x80\xb9\xc7\x1f\x84\x30\xbb\x51\x4f\x1b\x42\x6f\xc9\x1f\x2d\x54\x58\x3e\x52\x8c\x02\x5c\x2f\x97\x14\x67\xf1\x99\x13\x45\xa6\xf9\x5d\x4d\x7a\x90\xe1\x1d\x47\xe2\x17\xd3\x23\xb8\x1f\xd3\x70\x6e\x82\xd0\x87\x5f\xbb\xa4\xb6\x35\x97\xba\xc0\xd4\x31\x64\x8e\x2c\x0d\xb9\x44\x8c\x4a\x49\xd2\x23\x6d\x47\xac\x83\x5f\x70\x6e\xfc\x73\xde\xff\x7e\x50\xc2\x8d\xb7\xd7\x33\x9f\x1e\x28\xfb\xb6\xb0\xde\x58\xcd\x7b\xd3\xa1\x67\xdd\xd2\xd1\xcb\x6e\x8f\xb8\x95\x60\x56\xc4\xd9\xc3\x3f\xba\xb9\xf4\x94\xb4\x9e\x62\x33\x25\x8b\x04\x2b\x5d\x47\x47\xd1\x6f\x7a\x23\x50\xb5\xf4\x75\xa9\x30\xd9\x01\xbd\x57\x25\xad\xb9\xc3\xa2\xf7\xd0\x36\x6f\x0e\x38\x12\xa1\x67\xe4\xab\x90\x5b\x31\xfe\xfd\xfb\xda\xce\x70\x97\x07\x6e\x4d\x75\x92\xe6\x6d\xe1\x33\x67\xd5\x13\x26\xde\x9b\xca\x22\x84\xa7\xaa\xd5\xb1\x0b\xec\xf3\x0f\x72\x9b\x7f\x54\x62\xcf\x8b\x08\x06\xc0\x46\x79\x3d\xa7\xaf\x4c\x75\x1e\xdf\xc5\xf5\x20\x02\x25\x15\x58\x24\x3e\x38\x79\xcd\xf0\x69\x7b\x37\xf7\xef\xa1\xd5\x46\xcc\x3d\x0d\x48\x16\x05\x16\x96\x4a\x97\x51\x8d\xf3\x65\x6d\x3f\xdc\x84\x91\x07\x33\xb6\xae\xe8\x4f\x0a\x8d\xc8\x2b\xee\x8b\xdc\x85\x7a\xdf\xb0\xf2\x5b\x87\xb6\x56\xa2\xb9\x1b\xd1\x16\x8b\x08\xbc\xb5\x95\x08\x70\xc4\x5f\xfb\x41\xbd\x35\xb1\xa1\xdc\x0d\x67\x05\x14\x4f\xb8\x71\x90\x98\x6a\x9d\x98\x93\x84\x12\x56\x67\xce\x2c\xfe\x5e\xca\xd8\x42\x32\xa0\xed\xb7\xc5\x21\xbc\xc3\x5b\x69\x36\x27\x79\x1e\x2b\x2a\x52\x05\x5d\xe8\xb8\x47\xfb\xe8\xa3\x14\x5e\x7c\x20\xcb\xc6\x01\x8b\x5e\x49\x34\x32\xb5\xe1\x46\x5b\x6d\x9d\x98\x43\x16\xb7\x56\x84\x24\x32\x89\x0c\x4a\x2e\x39\xd8\xd0\xf3\x8e\xa0\xd6\x0b\xfc\x7c\x6e\xb5\x10\x52\xf6\x98\x8b\x7b\x45\x49\x86\xce\xbc\x89\x6c\x4b\x48\xac\x84\x6e\xfc\xde\xdb\xc5\x7b\x83\xce\x75\x69\x31\xdf\xf3\x07\x33\x00\xc6\x81\x08\x50\x04\xb9\xe1\x00\xcd\x13\x54\xa6\x11\xc0\xd8\x1b\x6e\x8e\x3b\xf6\x6a\x15\x6d\x99\x80\xd0\x9f\x0a\x72\x08\x3c\x58\x36\xb5\x87\x83\x55\xb2\x45\x33\xa5\xdc\x80\x80\xbe\x5e\xc7\xb1\x7f\x0f\xa1\x41\x17\x92\xaf\x72\xce\x22\xbf\x39\x5a\xa9\x19\x51\xa9\x4f\x57\xd8\xee\x33\xf9\x84\x91\x4f\x87\x60\x15\x65\x75\xd2\x32\x71\x79\xe1\x4c\xc7\x51\xcc\xe1\xd5\xa2\xe5\x89\x71\x0a\x31\xf1\xd6\xf2\x29\x10\x14\xb6\x60\x4f\xb1\x6a\xc4\x45\x8d\x47\x2e\x86\x89\x57\x87\x1c\xa7\x99\x35\x6d\x84\x3e\xc1\x11\x35\xe6\xf1\xbd\x02\x0a\x93\x8e\xf9\x13\xba\x60\x1c\x8b\xae\x46\x9c\xa5\x6c\xea\x8c\xa8\x6f\x48\xa5\x8c\x66\xbc\x5c\x8c\x86\xb7\xf4\x87\x06\xb1\x49\xd6\xec\x3a\xa6\x4e\x39\x4f\x4a\x67\xd4\xb4\x24\x9c\x86\x3d\x22\x9b\xb2\x68\x61\x7d\xc2\x94\x98\x80\x4e\x38\x74\xed\x1e\xfb\xda\x17\x93\x22\x20\x7e\xe2\x64\xff\x9d\xee\x54\xe0\xba\x77\xd1\x4d\xc1\xba\xc3\x9a\x3f\x11\xe0\x6c\x0f\x16\x67\xa9\x62\x48\xea\x55\xbf\x31\x5a\xac\xad\xce\x77\xfe\x64\x95\x1d\xaa\xe5\xf8\x4b\xd2\x37\xe4\xf0\x78\xf6\x69\x0a\x71\x8b\x5c\xdc\x8c\xd5\xac\x42\x37\x46\xba\xba\x89\xac\x69\xd2\x38\x67\xff\xf5\x83\x9f\x6f\x95\xf2\xc7\xcd\x08\x97\xa6\x22\x86\x07\x1e\xf1\x9c\xa0\xc1\xa7\x69\x34\xb4\x7a\x85\xf0\xd5\xd8\x02\x7e\x05\x51\xde\x1b\x16\xfe\xc2\x3e\xb7\xc9\xfa\xb2\x5a\xda\x1f\xc4\x8e\xb5\x88\xfd\xd6\xdd\xdf\x4e\xdb\x7a\x1f\xf8\xd1\x83\xad\x2f\x13\x70\xe9\xee\x91\x8f\xd2\xfd\xfe\x01\x42\x08\x2c\xf6\xba\xfc\x3b\x2b\x0b\xa9\xf8\xfe\x95\xc4\x42\x3b\xde\x5e\xf8\xd3\x81\x6c\xcd\x8c\x6b\xec\xab\x29\x4e\x7b\x84\xab\xe5\xf9\x20\xfc\xab\x90\xd1\xbe\x83\xd9\x2c\xc8\x1d\xe3\xd0\x38\x27\xf6\x34\xa4\xaf\xf7\xb8\x11\xd5\xbc\xcb\x6f\xf5\x93\x93\x87\x44\x8d\x6f\x66\xf8\x36\xda\x5f\x50\xa8\x41\x06\xca\x2f\xba\xda\x62\xe0\x87\xc1\x70\x4c\x66\x90\xf2\x94\x2d\xcd\x57\xc4\x20\x98\x83\x9f\x61\xe6\x49\x57\xa8\x8b\x37\x9a\x85\x86\xce\x04\x2f\x20\xc4\xed\xa3\x69\x05\x89\xb7\x9f\xdc\x31\x92\xb9\x17\x39\xb4\xdb\xf7\xe8\x56\x78\x7e\xd0\xfc\x0b\x69\xf5\x27\x0f\x39\xd2\x0a\xc6\x10\x3d\x89\xc3\xb9\x04\x1c\xbb\x9e\xbd\xab\x0a\xeb\xa5\x13\xfd\xc3\xc5\xfe\xca\xd5\xb4\xc6\xb6\x9d\x4a\x23\xb8\xdb\x76\xb2\xf9\x1b\x75\x0c\x85\x92\xf0\x45\x08\x85\xd7\x89\x1b\xba\xa1\x67\xde\xc0\x6b\xbc\x1b\xd0\x93\xc6\x15\xea\x07\x0c\x45\x9e\xdd\xe1\x6f\x6d\xfa\x67\x23\xe8\xe5\x94\xb0\x9a\x91\x90\x87\x57\xf7\x27\x8e\x28\x6d\xf3\xee\x57\xdf\xe7\x0f\xac\x7f\x62\x35\xe9\x88\xfd\x25\x79\x04\x10\x35\x9d\x88\xf2\xac\x98\x05\xfa\xc5\x7b\x80\xcb\x0b\x74\x9a\x65\xac\xd7\x1c\xcb\x53\xa5\x81\x33\xd8\x1a\x55\x70\xa4\xfb\xd9\x7a\xc5\x9a\x62\x73\x2a\xaf\x6a\xd7\x14\x1b\x73\x03\xb1\xb1\x16\xf2\xf0\x56\x25\x74\x90\xd5\xa8\x8b\x54\xba\xba\xf2\x43\x7c\xa5\xb3\x48\x98\xcf\x29\xc6\x86\xc5\x17\x6e\x70\xba\x0b\x8e\x7d\x9d\xfc\xef\x13\x26\xb7\x96\x9b\xba\xc0\xd3\x8e\x48\x01\x34\x18\x11\xb4\x1a\x09\x66\x2d\x91\xbb\x52\x9b\x20\xc8\x3d\xda\xb6\x8f\x55\x4a\x5c\x96\xd3\xdb\x67\x3f\x41\xc4\xc6\xc1\xc5\x37\x9c\x05\xa1\x18\x38\x1b\x0a\x11\xea\x07\x1e\x18\x97\x31\xd2\x3a\x78\x81\x2e\xc5\xaa\x1c\x47\x74\x13\x34\x0a\x2a\xa5\x35\x46\xeb\xf3\x7f\xbb\x4b\x0c\x05\x00\x77\xd4\x6b\x76\xba\x51\xee\x24\x8d\xf4\x0f\x51\x95\xaa\xbf\x8c\xcb\x32\x90\xbd\x7d\x95\xa2\x16\x29\xa1\x61\xd0\xc1\x4f\x0c\x3d\xb3\x4f\xa4\xd4\x87\xc4\x4a\xdd\xa0\x83\x99\x39\xae\xfe\x32\x8c\xc4\x0d\xe9\x50\x6b\x6c\x71\x2f\x26\xc4\x09\x46\x97\x71\x1d\xaa\xfb\xdb\x3e\x92\x18\xd9\x8a\x58\xe4\xde\x3f\x4b\x75\x10\x41\xa7\x9e\x6a\x86\xe1\x1d\x16\x30\x43\xc9\x53\x99\x03\x04\x27\x2d\xde\x71\x49\x85\xfd\x5b\x36\x8b\x98\x54\x59\x08\x63\x7d\xe3\xc2\xeb\xb3\x33\x07\xf5\x93\x69\x33\x39\x75\x31\x39\x33\xea\x6d\x9a\xe6\x09\xd8\x15\xdd\x75\x3d\xd0\xa1\xd8\x3b\x4a\x28\x6f\xbb\x92\x23\xca\xbc\xdf\xa3\x5e\x20\xcc\x6a\x7b\xa7\xab\xce\xef\xc6\x1d\xd1\x34\x54\x70\x57\xaa\x96\x63\xe9\x40\x0b\x47\xff\x2c\x28\x9f\x60\xe2\x62\x68\x99\x46\x9e\x62\x31\xcc\x85\xde\xc5\x8d\x52\xf5\xa0\x7e\x49\x61\x55\xa8\x82\x5d\xdf\xd4\x03\x70\x38\x7c\xb1\xc0\x0e\x65\x61\x33\xd4\x7c\xee\x7a\x8a\x4a\xcb\x7d\x2d\xae\x0b\xad\x72\xcc\xab\x90\xfc\xc4\xe4\xe1\x67\x3d\xbb\xf1\x23\x79\xd7\xec\x42\xa5\x46\xe7\x44\xc2\xfc\xa3\xc9\x46\x9b\x65\xea\x12\x3d\xc5\x24\xcc\x5e\x84\xb6\xa6\xfc\xec\x8b\xe0\xbc\xba\xdb\x7c\xa3\x9c\xca\xc8\xc5\x47\x2a\x1e\xb8\xe7\xf7\x9f\xd9\x9a\x8c\xd5\xbc\x80\x76\xc0\xfb\x10\xec\xfd\x03\x40\x84\xd3\x1b\x06\x34\x74\xc2\x99\x7c\xe0\x18\x96\x30\x46\xb7\xd6\x9f\x72\xd7\xb4\xd4\x34\x34\x28\x0c\x0b\x1d\x6e\xd0\xc2\xed\x6a\x50\x29\x72\x26\x70\x3d\xa2\x87\x9b\xf5\xb6\x9d\x34\x9b\xb6\x2a\x07\x7e\xed\x87\x84\x4c\xa0\xe7\xc5\xe2\x16\x6b\xf4\x55\x26\xb3\x51\x37\x68\x00\x56\x19\xe8\xcd\xc6\x5c\x2e\x90\xea\x44\x56\x5b\xa1\xc4\xd7\x39\x60\x76\x3b\x8c\xa5\x09\xa3\x8e\x68\x9c\x56\x17\x4d\xa2\xa6\x52\x79\xf5\x1b\xd3\xdb\x35\x26\xab\x53\x23\xf1\x75\x11\xfe\x53\x0f\x1c\x9b\x23\x77\x8b\xab\x82\x77\x8b\xe1\xb8\x80\x73\x24\x58\x7c\x85\xd3\x7c\x6c\xbc\xfb\x84\x05\xbb\x35\x93\xbc\xc7\xe1\x4f\xcd\x83\x19\x1d\x1b\x56\xfa\xe2\xca\x04\xbb\x29\xdb\x70\x0b\x13\x5c\x62\xd4\x64\xf3\x02\x71\xbe\x4b\x82\x97\x09\x9d\x68\x59\x87\xa7\xb6\x7a\xc1\x47\x5f\x16\x97\xcd\xf2\x19\xf2\x9e\x5d\xdd\x14\xf5\xf3\x0b\x22\xe4\xa6\x37\x5f\x88\x7e\x53\x0f\xec\x1b\x66\xcf\x5b\x71\x2c\xa9\xf7\x1f\x7f\x15\x3b\x10\x9d\x61\x1a\x68\xee\x3c\xbc\x1c\x22\x6b\x76\x9a\x00\xae\xc7\x37\x13\x4e\xae\xb3\xb4\xbc\x30\x6f\x0b\x54\x65\x73\x29\x15\x72\x1d\x7b\x5f\xba\x8a\x5f\x02\x58\x28\x39\x6a\x67\xa3\x9f\x34\xb3\xe6\xe1\x4f\xd2\xa6\x8b\xe4\xbe\x46\x90\xe9\x15\x9e\x05\x13\x67\xb4\x71\x21\xf9\x9f\x77\x7f\xb2\xd4\xcb\xab\x0e\x2f\xae\x6c\x21\xce\x17\x82\x2e\x8d\x1c\x71\x9a\x9b\xee\xa4\x13\xe8\xe9\xa8\xca\x0d\x86\x46\x22\xf8\xa2\xd3\xfa\xd5\x52\xcb\xf3\x48\x79\xf7\xe3\x8f\x36\x5e\x23\xed\x15\x66\xc4\xb7\x35\xdc\x37\x28\xf4\x1d\xf3\x7d\x59\x26\xdb\xe4\xbd\x1a\xf2\x99\x67\xaa\x9e\x64\xfa\x96\x24\x5a\x6a\x7a\x1b\xf8\x8b\x0c\xbf\x87\xe8\x9f\x3a\x15\x00\x98\x5b\x62\xce\x05\xc3\x71\xab\x3c\xe4\xc5\x0a\x2c\xe3\x55\xdc\x0c\x09\x64\x2b\x88\x5c\xaa\x8f\xca\x16\xae\x2c\xc7\x2d\xb5\x4a\xb1\xde\x13\x52\x6f\x2f\x6d\xb3\x0a\x0e\x3c\x31\x7f\x39\xff\x7d\xc0\x4a\x95\x4d\xc4\x6e\x52\xe3\x1b\x64\x75\xa7\x31\x30\xfa\x95\x03\x42\x12\x36\x26\x04\x6a\x13\x40\xd8\x6c\x8a\xf5\x53\x10\x32\xb6\x2a\xa9\xf8\xd6\x84\xcc\x8c\xa1\xc1\xe3\x45\xf5\x5d\xd5\xce\xaa\xcd\x7d\x5d\xf8\x49\xfd\x70\xdb\x5f\x25\x17\x9f\x97\xa5\x76\x17\x15\xbd\xfa\x93\xca\x3f\xf5\x1a\x8d\x7a\xf9\x4f\x89\x30\x03\xcc\xc5\x97\x08\xd4\x9b\xcc\x0a\x2d\x4a\x82\xd0\x72\x3c\x68\xf9\x30\x20\x4e\xc2\x72\x9d\x2a\x27\xfe\x88\x7b\x93\x16\xb3\x53\x9e\x10\x63\x80\xa6\x8b\x2e\x89\x17\xe4\x4a\x55\x72\x4e\x69\xaa\xca\x2d\x79\x7a\xb1\x0a\x37\xce\x79\x50\x3f\xd7\xfc\x8e\xda\x1b\x3e\xf0\x86\x02\x79\x0d\xe1\x6c\xd6\xf4\x8e\x20\x71\x47\xb7\xdb\x06\x56\x27\x86\x36\x29\x24\xcd\xfe\x07\x9d\xfa\x8c\xa5\x73\x9e\xb4\x81\xc3\xd5\xe3\xbe\x18\x27\x8c\xe5\x80\xf7\x40\x4c\x99\x58\x64\xfb\xb0\xdd\xe9\x7b\x2c\xc9\x5e\x67\x98\xd0\x0d\x61\xee\xb2\xbc\xe9\x27\x15\x0f\x83\xd3\xcb\xee\x40\x31\x46\xfa\x22\xd7\x29\xe2\xb1\x09\x99\xaf\x6a\x5f\xf3\x67\x5e\xfd\x5e\x7b\xc2\xb5\xd4\xa1\x8f\x90\xcd\x24\x71\xbf\x8f\xf7\x47\x0b\x65\x13\xe0\xa7\xaa\xc9\xd9\xe5\xfa\xb1\xce\xaa\xb6\xf3\xfb\xfa\x7d\xef\xb1\x51\x10\x5f\x9a\xc7\x47\xc9\xba\x71\xe2\x7c\x3d\x34\x81\xb9\xee\x63\x1f\x8e\x95\x04\xff\xdf\x8a\x76\x80\x09\xe2\x46\x3d\x8b\xbf\x27\x0f\x9c\x97\x58\x0b\x8f\x2a\xf8\xd1\x0a\xd0\xc0\xcd\x5e\xb6\x82\x16\x4a\xe8\x09\x82\x33\x0b\xfd\x0b\x95\x13\x95\x1b\xbe\x9f\x2a\x4f\x55\xaf\x00\x67\xd9\x7c\xa3\x0f\x0e\xba\xba\xbf\x61\x87\x85\x9f\x4a\x89\x08\x87\xcd\x1f\xff\xbb\xfc\xe8\x2f\x22\x27\x00\x6a\x91\x61\x5e\x00\xd4\xf2\xc4\x44\x7e\x98\xfb\xc1\x74\xbe\x0a\x20\x5c\xae\xc0\x22\xd3\xc7\xc1\x36\xfa\x2c\xfd\x90\xa3\x3e\x13\x74\x4b\xc1\xf6\xd5\xec\xe6\xfb\x1f\xa5\xdd\x51\xfe\x48\x34\xdb\x9c\x06\x0e\x63\x5a\x19\x66\xf3\x60\x44\x5c\xba\x2d\xbd\x8e\x82\xef\x25\xd7\x47\x48\x5c\x9c\xe9\xdb\xe8\xd6\x4a\xcf\x1d\x74\x0d\x7f\x18\x10\xf9\xbf\x25\x2c\x88\x80\x8a\xcd\xe4\xf4\x73\x67\xde\xb7\x28\xbb\xaf\x6a\x2f\xb4\xfb\x74\x1b\xe3\x37\x09\x84\xad\xe9\xa9\xe6\x46\x2d\xb6\x70\x0b\x79\xe5\xd5\xbb\xfd\x53\x57\xe4\x88\x40\x52\x26\x8f\x19\xfe\x23\xca\x50\xac\x01\x43\xc4\x32\x18\x46\x07\xc0\xb4\x10\x65\x90\xb4\xc5\x05\x01\xf1\x16\x63\x2a\xd9\xa2\x33\xbf\x28\xb5\xec\xdd\xfc\x09\x83\xc7\x9d\xb7\x3d\xb4\x36\x32\x1b\x14\x31\x2c\xd1\x38\x11\xe4\xfe\xf1\x3d\x4e\x85\xae\xb7\x6f\x61\x4c\x3a\x9c\x1e\x27\xaa\x9c\x1e\xc4\x6e\x63\xfd\xb3\xa4\x73\x6d\x66\x4f\xfd\x7f\xb5\x1e\x32\x2f\xa3\x8e\x2a\xba\x07\x8e\x1e\x69\x52\xd9\xfa\x87\x71\x84\x0a\x7c\xd6\xeb\x36\xfb\x66\xe7\xbe\x03\x0e\xd8\x3d\x01\x83\x9e\x63\xc6\x6e\xa2\xad\xdf\x11\xf0\x09\x74\x99\xe3\x9e\xf7\xaf\x5e\x37\x74\x01\x6a\x19\xc9\x88\x73\x9e\xc2\x4d\x3f\x68\xba\xa3\x62\xf7\x31\x02\xf0\x6c\x3b\x18\x11\x20\x22\x1d\xea\x48\x29\xd5\x58\x21\x1b\x3a\x45\x2a\xff\xcd\xbf\x80\xa3\xc7\xc8\x3f\xac\xb9\x63\x84\xd1\xf1\x94\xbd\x36\x29\x32\x8b\x8a\x5b\x12\x87\xee\xf5\x0c\x31\x0d\xdc\xad\x9d\x95\x67\x95\x09\x9b\x00\xdf\xe1\x2f\x4a\xa6\xf9\xb5\x97\x84\x0f\x3e\x4c\xe2\x4e\x42\xa6\x72\x47\x6b\x0e\x4b\x8e\x0e\x83\xf5\x2e\x0c\xe9\x87\xa9\x18\xf7\xdb\xc4\xf3\x75\xe1\x15\x3e\x38\xa6\x5e\xa4\x29\xa7\xaf\x51\x56\x11\x0c\x47\x8e\x4c\xc2\x44\x17\xcf\xa1\x9c\x30\x13\x68\xd7\x18\xea\x33\x18\x70\x74\x01\xf6\x7e\xdf\x3b\x6e\x64\xf8\x26\x4e\x0c\x47\xc8\x3c\x0f\x90\xdc\x55\x6b\xa1\x4f\x1e\x1c\x8b\xac\x54\xad\x6f\x91\x4e\xf3\x45\x45\xc6\xe2\x64\x6c\x64\x1d\xf6\x22\x1a\x85\xfc\x11\x46\xca\x74\x5e\xab\x64\x7a\xbf\x73\x1f\x41\x9c\x1f\x8e\xb3\x14\x47\x4c\x03\xc2\x9b\xee\xd4\xee\xed\xfc\xea\xc7\x76\xad\x52\xb0\x35\xfc\x40\xd2\x60\xab\x1d\x8d\x07\x85\x7d\x87\xd3\xa3\x00\xa6\xfd\x34\xab\x17\x1a\xcd\x22\x10\x7c\x3d\xcc\xaf\xfb\x01\xff\xa6\xf4\xcf\x10\x0c\x68\xa9\x36\xdf\xf8\xb9\x7e\xba\x9e\x93\xb8\x99\xb0\xc3\xbf\xcd\x95\x99\x24\x67\xff\xfa\x48\x0f\x1e\x70\x20\x37\x45\x2b\x36\x61\xf4\xf8\xed\x77\x9e\x96\x67\x2d\xf7\x39\x4f\x3f\x50\x1b\xfb\xf3\x3c\x84\xbd\xf6\x92\xe3\x22\x9d\xa3\x75\x00\x31\xcf\x89\xec\x26\x9e\x2c\x29\x00\x17\xcf\x3d\xfe\x49\xf6\x68\xe4\xa0\x06\xf7\x5f\x60\x1a\xcc\xb8\x25\x11\xcf\x8a\x31\x1f\x2d\xab\x3a\xe4\x1b\xfc\xaf\xd4\x7f\xdf\xe0\xd7\x0b\x8c\xd5\x85\x2a\xd6\x98\x61\xed\xd3\x75\x62\x18\x4c\x0a\xd8\x0c\xa1\xfa\x1b\x88\x2b\x73\x43\x36\x95\x94\x84\x4e\xcd\x6d\x93\x03\x4e\x5f\xed\xde\x0a\x46\xf8\x97\xd2\x61\x53\xf8\xf1\xde\xef\x6c\x5e\x8f\x01\x50\x02\x00\xfa\x62\x79\xea\x9b\x52\xd8\x5c\xc5\xb5\xc6\x9b\xc0\xce\x62\xaa\x8b\x8c\x19\x51\x8b\x83\x03\x8a\xfa\x81\x7c\xc6\xda\xc7\xa8\xad\x6f\x95\xec\x91\x43\x1d\x1b\xef\x35\x08\x29\x62\x5d\xfe\x90\x1c\x92\x1a\x13\xcc\x9d\x50\x60\x4a\xd2\x4b\xc5\xa4\xb7\x21\x17\x02\x26\xb5\x2d\xd1\x54\xf6\x5f\x9f\xf9\x59\x0c\x91\xd9\x8e\xb7\x92\x99\xf2\x72\x5c\xcd\x10\x5b\x9d\x9e\xa6\xf3\xb8\x79\xba\x7a\xbb\xfe\x76\x5e\xf5\x7a\xfb\xcb\xc6\x31\xe5\x2f\x65\x06\xee\x24\x23\x25\xfb\x0f\x8b\xc6\x92\x54\x49\x73\x30\x52\x44\xcd\xec\x5a\x69\xc4\x44\x1d\x64\xa2\xfd\xc6\x7b\xbc\xd7\x92\xc3\x83\x7e\xef\x5a\xc2\x76\xdb\x04\x16\xc7\x4d\x93\x74\x69\x9b\x1c\x27\x5a\x3b\x9e\xa6\x8e\x19\x60\x4c\xc8\xd3\x77\xa9\x29\x26\x5f\x3c\x73\xb0\xab\x84\x21\xd6\xf1\x22\x59\x34\x33\xcb\x1b\xbe\x7e\xd1\xba\xaa\x66\x86\xfb\x6a\x0f\xb6\x81\xda\x21\x89\xc5\xc5\x14\x12\x8a\x15\x05\xc7\x87\x59\x91\x26\xb8\xc3\x46\x34\x92\xb5\xfd\xa7\x2c\x64\x3d\xbf\x7d\x86\x0c\xb6\xc7\xce\xac\x6f\x85\x76\xce\x6e\x10\x9f\xd1\xa1\x03\xe4\x00\x1a\xb0\x15\xa3\x17\x34\x62\x84\xf8\x45\xfe\x55\x99\x7e\xd8\xbb\x38\xb4\xe0\x94\x14\x1f\xa7\x34\xff\x58\xc4\x4c\x6d\x44\x02\xe5\xdc\xeb\x08\xb5\x13\x22\x74\x76\xb7\xe0\x04\xe3\xd4\xe0\x3a\x04\xdf\x63\xb5\xa4\x9e\x66\xcc\xcd\x6b\xdc\xfe\x6f\x3c\x97\x9f\xc0\xbc\xfb\x1f\x35\x37\x84\xe4\x89\xce\xc6\x34\xdc\x4e\xfd\x1c\x17\x18\x7d\x66\x2a\x49\x0d\x50\xce\x30\xcc\x0f\x1a\x74\xc0\xbe\xea\xaa\x15\x1f\x12\x94\x1b\xea\x33\xd8\x6e\x4e\x3f\xec\x38\xd6\xa9\xcc\xa6\x15\x1f\x9f\xec\x65\x09\x2c\x90\x1c\xa1\x7b\xbe\x20\x09\xfd\xdb\x3a\xac\x0a\xa4\xed\xdd\x77\xf6\x17\x0a\xf4\x51\x4f\x0d\x79\x9e\x09\x3e\x7e\x6b\xc6\xc6\xda\x47\x68\x5e\xc8\x17\x3b\xcf\x4c\x42\xb9\x6d\xf7\x9d\x35\x22\xa5\xc1\xe8\x5c\xa6\xd4\x12\x2e\xbb\x14\x2c\x73\x96\xc2\xcd\x84\x2a\xf9\x21\x39\x27\x12\xd4\x5c\xd2\xac\xb5\xe7\x26\xe6\xb9\x24\x5f\x38\xb0\x82\xd8\x3c\x7c\xee\x1a\xfc\x2c\x1b\x31\xbb\x7b\x4a\x0f\xbf\xe0\xf2\x31\x2e\x36\x0d\xac\x40\x45\x10\xcf\x8b\x8a\x3b\x4c\x3a\x86\x5c\x57\x8d\x3f\x75\xfc\xf1\x39\xb8\x68\x98\xbf\x98\xf9\xe7\x76\x32\x0e\x94\x4d\xee\x81\x49\x0b\x54\x74\x90\xb0\xad\x51\xd6\x5f\xaf\x1b\x5b\xe6\x71\x58\xc7\x31\x4b\xca\x06\x4c\x66\x0e\x41\x13\x98\x62\x1d\xa2\xe3\x8c\x89\x6f\xd1\x7d\x45\xc0\x26\x07\x24\xb1\x03\x86\xc2\x27\x8c\x72\xe5\xad\x4a\x2c\x29\x24\xb0\x53\x70\xfe\x0a\x23\xb4\x49\xbb\x60\x79\xda\xed\xc7\x96\x84\xc1\xca\xe9\xe5\x43\xf1\x32\x19\xd2\x6d\x51\xe1\x13\x12\xac\x49\x92\x76\x96\x57\x34\x91\xe1\x21\x27\xd5\x57\x75\xe4\x3f\x4b\xb7\x8f\xb1\xce\x48\x00\x0c\xa8\x25\x40\xcc\xd9\x1c\x52\x7a\xd4\x64\x18\x57\xff\x6a\xdb\xd4\xaa\x65\x41\xd7\xb2\x07\x73\x9a\xbb\x8a\xcb\x51\xdb\x66\xd9\xcc\x30\xcc\x05\x82\xb1\xd9\xbf\x26\xdf\xe4\x15\xfa\x1e\x92\x21\xea\x40\xba\xba\x66\x5b\xa2\x6d\x0f\x52\x33\xf8\x90\x3f\x80\xed\xf2\x74\x3c\x46\x61\x83\x57\xa9\x80\xc7\xbe\x9a\x33\x5e\xc1\x38\xf2\xfd\x6c\x67\xac\x70\xd5\x00\xb8\x34\xc6\x1e\x8f\x26\x6c\x6c\x3e\x16\x4c\x63\xd9\x92\xfe\xaf\xc7\x42\x07\x9c\x89\x8e\xcb\x8d\x95\xd0\x8e\xce\x5e\x5c\x33\xf2\xfa\x78\xdd\xd1\x04\x60\xdc\xa4\x63\xd8\xeb\x64\x62\x68\x64\xf4\x73\xb7\xd1\x34\x4d\x98\x9b\x52\x58\xd8\xfb\xe8\x66\x03\x07\xaa\x6a\xca\x88\x1d\xc1\x2f\xbf\x73\x8c\x9f\xe7\x15\xd4\x0a\xd9\x4d\xac\x63\x64\xa5\xef\x54\x7e\xa3\xb7\x9b\xd2\x59\x98\xcb\xb8\x30\xdd\x52\x89\x7e\x87\xe2\xbf\x89\xf8\xc4\xb0\x26\x32\xbb\xd2\x26\x25\xe3\x48\xe7\xfb\x5d\xf3\xd7\x45\x53\xfb\x27\x47\x47\xc7\x27\x07\x44\x71\x38\x53\x32\xa0\xe5\xcd\x4b\x30\xfd\x17\x14\x63\xaf\xba\x6e\xb4\xb3\x07\xf6\x91\xe7\xde\xda\x19\x3e\xb8\x89\xb1\x63\xae\x06\xec\xfe\x68\x65\x71\x1d\x45\x6a\x79\xfe\x10\xcf\xd3\xc2\xbc\xe5\x36\x19\x66\xdd\x38\x66\x35\x7c\x9b\xc6\x04\x7b\x17\x07\x71\x4a\x51\x8f\x1f\x6b\x6f\xaa\x5f\xa4\xad\x1e\x35\x25\x5b\xd1\x2e\xdb\xcc\xd6\x2b\x9e\x55\x8e\x8f\x1f\x5a\x37\x0e\xbb\xd5\x9b\x5f\x91\xf3\x91\xad\xfe\x6d\x02\x62\x01\x0c\xab\x4c\xc3\x15\x27\xbc\xd0\x72\x2f\x79\xf2\x16\x1c\x90\x1a\x1a\x86\x2f\x62\xa7\x14\xaa\x2f\x98\x88\x62\x02\xe7\x7c\x6a\x9e\x6a\xba\x5a\x8d\x70\x5f\xd8\x88\x2c\xb2\xb5\xc3\x7d\xb9\xce\x3f\xd8\x7d\x53\xb4\xf4\x81\x3b\xe3\xd2\x89\x39\xb7\x21\xd8\xf3\x14\xf4\x84\x93\x3b\xa4\x70\x2d\xc2\xe9\x73\x39\x96\xe7\x84\x18\xdb\x3b\xf6\x1f\xe7\x23\x72\x40\x6d\x0e\x00\x02\xa4\x17\x93\x54\xf8\x25\xe5\x51\xa5\x72\xf3\x4f\x6b\x24\x4d\xd0\x1b\x65\x62\xb3\x4c\x65\x3c\xc1\x63\x6b\xca\x8e\x9f\x5e\xfd\xe2\xd0\xa5\x42\x81\xdd\x18\x01\x9d\x12\x73\xcb\x9a\xb1\x50\xa2\xfa\x98\x4c\x20\xd7\x1d\xe2\x26\xd1\xd4\x94\xb3\x77\x0d\x1f\x79\x8c\xfd\xa7\x6f\x76\xd6\x6c\xec\x59\xe0\xff\x16\x9d\x1b\x83\xb0\x95\x63\xda\x29\xbd\x90\x11\xb6\xb7\xed\x9b\x0c\x6a\x1f\xc9\xdd\xc2\xab\x78\xe5\xdc\x1f\xa2\xc6\xe6\x74\xf1\x8a\xe8\x61\x4c\xca\xef\xdf\xbf\xfc\xa1\x88\xd9\xd5\x0c\x76\x72\x9a\x12\xb3\x5c\x14\xda\xfb\x7d\xe0\xeb\x99\x99\xce\xba\x85\xa3\xfb\x79\xd3\x3f\x43\x5c\x35\x7f\x9b\x5b\xb2\x99\xd5\xbf\x53\x82\xa5\xc8\x43\xd8\xe7\x22\x6b\xf3\x22\xd8\x7b\xe4\xe0\xd8\x96\x1f\xdb\x41\x88\x93\x2d\xf2\xc3\x2d\xef\x23\xc9\x33\x26\xd3\xe3\xbf\x12\x73\x00\x8a\x66\xff\xa0\xb2\xd3\xed\x9d\xec\xb4\x2c\xe4\xbf\x71\xe0\x7e\xe3\x3f\xee\xae\x8d\xf9\xf4\xe6\x92\xb7\x66\x12\x2b\x8e\xc8\x20\x7a\x53\x43\x80\xe9\xfc\xec\xab\xfb\x4f\x96\xd7\x90\x5d\xe4\x5d\x7f\xc9\x2e\xb0\xf8\x90\x66\x49\xe5\x1a\xe3\x40\x07\x54\x35\xe7\xcc\x3f\xc3\xb1\x0e\xde\x8a\xb5\xa3\xa0\x15\x32\x31\x79\x28\x52\x72\x39\xac\x31\x79\xe2\x70\xb6\xe8\xd8\xda\xa1\xc4\x77\xe3\x7d\xb2\xb3\xe3\xd9\x9b\xf1\x37\x66\xc4\x20\x35\xa2\x67\x3e\xea\x33\x05\x24\x8a\xbc\x77\xc7\xa7\xbf\x59\xee\xb2\xae\xde\xb7\xe9\xd6\xeb\x4e\x18\x77\xc0\xdd\x1c\xa0\x18\xc2\xfb\xf8\xb8\x2a\x0d\xcc\xef\xbe\xa9\xaa\x06\xfb\x4e\xb7\x0b\x28\xa7\xe9\x6e\xeb\x92\x0a\x57\x33\x38\x27\x25\xb7\x8b\x32\x4f\xfb\xa3\xf1\x6f\x9b\x55\xc2\x65\xb3\x0e\x9d\x47\x7d\x5c\x61\xb8\x84\x48\xeb\xdd\xd0\x84\x4c\x6a\x9e\x1a\x5f\x1e\x14\xaf\xc7\x0b\x09\xcd\x2f\xb6\x8c\x8f\x53\xf8\x7a\x56\xfd\xb3\x26\x90\x03\x1e\x31\xb1\xba\x31\xec\x1e\xb5\x7d\xc0\x5e\x65\x46\x9e\xd1\xf3\x4f\xc4\xf8\xff\x92\x0f\xbe\x45\xad\xce\xe9\x6e\x1e\xdb\xba\x9d\x15\xdc\xb4\xb9\x72\xc4\xe9\xef\x20\xb4\x3b\x26\x78\x92\x15\x37\x0e\x7a\x17\x8d\x11\xe3\xf1\x59\x47\x4c\x04\x12\xc8\xe3\x01\xca\xa1\x56\xfd\xb5\x43\xe1\x38\x89\xdf\xdd\x21\x95\xd0\x7f\x43\x83\x4f\xe1\xc0\x8e\x82\x23\xd0\xf6\x23\xfb\xd7\xc4\x3f\x68\xbc\x3f\xb3\x06\x5e\x03\x29\xa0\xbc\xde\xa3\x91\xe0\xa4\xf3\x73\xed\xe5\xa0\x8f\x91\xfa\x68\xaf\xbb\x4a\xdb\xb9\xf8\x0d\x26\x08\xa1\xd5\x2a\xc4\xba\x02\x94\xdc\x59\x06\xe4\xf5\x62\x88\x07\xaf\xe8\x5b\x3b\xa6\xd9\xa9\x36\xd5\xdd\xb6\x9f\x89\xf6\x2a\x30\x90\x6f\xa7\x5a\x5c\x65\xd3\xd8\xbc\xb2\x5a\x5c\x05\x40\xad\x2f\x80\xaf\x7a\x93\x57\xee\xfa\xe4\x7e\xc6\x5a\xf9\xd4\x71\xcb\xdf\x43\xf0\xcf\x08\x2b\x0a\x48\x3b\x00\x4c\x3c\x49\xf0\x0f\x61\xbf\x91\xc4\x37\xea\x3c\xa0\x73\xa0\x13\x6d\xa4\x34\xef\x2b\xf3\x0a\x86\x58\x8e\x18\xf9\x45\x7a\x9a\xe5\x0b\xa5\x4c\x4f\x8e\xbd\x9b\xb5\x5d\x99\x77\xef\x45\x2c\xaf\x3b\x19\xeb\x75\x97\xc9\xdd\x3d\x1d\xb3\x3f\x41\x02\x3b\x53\x83\x3f\xea\xa2\xac\xb3\x66\xb5\xc9\x15\xc8\x24\xcf\x26\x64\xbe\x3a\xbc\xae\x5c\xae\x77\x3c\x45\x3e\xbc\x46\x87\x22\xc2\x82\xe0\xc5\x7d\xb4\x3b\xf3\x6f\x0f\xf1\xe1\xec\x66\x4d\x17\x91\x13\xe7\x38\xeb\x8d\xed\x6f\x58\xff\x39\x32\x18\xc0\x74\x37\x3b\x90\x7d\xea\x84\x7d\xb4\x64\x4e\xb4\x2f\x21\xbc\xf8\x86\x73\xf8\x76\xac\x5d\x1a\x97\xbb\x1f\x0d\x8c\x52\x3a\x45\x99\x23\x1f\xaa\x4e\xb5\xf4\x7c\x82\x6b\xce\x99\x29\x07\xdb\x80\x35\xb0\xdb\xb6\x28\xad\x0b\x9d\xf2\x0c\x31\x0b\x48\x4e\x5f\xf4\x23\xef\x19\xf1\xff\x59\x9e\xc4\x81\xa8\x83\xea\x11\x7f\x13\xec\xd0\x88\x0c\xc0\x96\x6c\x55\x36\x72\xf3\x1b\xef\x23\x09\x17\x3e\xd6\x01\xbe\xc3\xfd\xda\x1c\x82\x12\xd1\x95\x2a\x65\xdf\x9b\xf3\x6f\xd1\x31\x0e\x30\x4c\xff\xce\x30\x67\xf4\xb9\x0e\xce\x86\xa2\x1c\x9f\x2b\x25\x82\x5d\x10\x7c\x2d\x0c\x75\x65\xf5\xe7\x36\xc8\x17\xd1\xdd\xb6\xc7\xe1\xfc\x0f\x2f\x5f\x67\x09\x65\xcc\x30\x1c\x95\x2f\xff\x7f\xc6\x64\x67\x48\x70\xa6\x05\x70\xec\xef\xb6\x55\xeb\xd8\x1f\x2c\x21\xf2\x3e\xd3\xa6\xfe\xe9\x12\xde\x61\x37\xae\x90\xbb\x98\xc6\x54\xce\x2b\x7f\x16\x72\x32\xe5\x21\x62\xeb\x9f\x47\x63\x60\x7f\xaa\x3a\xc3\xd6\x0e\xc2\xdc\x75\x19\xac\x52\x07\xb4\xe6\x7b\xdb\xa9\xa3\xc6\x5b\x7f\xdb\x6b\xc6\x85\xae\xdd\x2c\xa1\xe1\xa2\x5c\xa9\xb6\x26\xe8\x7c\xf5\x28\x7e\xcc\xdc\x77\x8a\x7e\x0f\xe2\xf9\x7f\xa8\x7b\x83\xdf\xff\xcf\x34\xe6\x06\x0f\x40\xf0\x90\x6e\x4b\xb5\x5c\x74\x22\xce\x2a\x7f\x8f\xa1\x3a\x6a\x69\xf5\x4e\x4f\x31\x65\xb9\xc2\xcb\xbd\xe3\x93\xe5\x80\x52\xb4\xe5\x79\x94\xf4\x5e\x9b\xf8\x82\x67\xa8\x5a\x95\x3b\x84\x0a\x61\x2c\xc7\xea\xeb\xe0\xdb\xaa\x61\xab\xc2\xbd\xd2\xed\xa1\xc2\x08\x09\xd5\xba\x6a\xac\x7d\x2c\xb9\x71\xbd\x0f\xa8\x8d\xd5\xfa\x5d\x95\x28\xc6\x8d\x63\x3a\xdc\x15\x88\x13\xab\x2b\x2b\x2b\xf7\x53\xff\xd2\xc3\x03\xcc\x98\x5e\x12\xe4\x57\xa0\x20\x5a\x62\x9c\x5b\x7e\xa1\xa6\xda\x1c\xd3\x57\x44\x14\x80\x5c\xc5\xc4\xe8\x72\x76\x47\xe6\xc9\xbd\xec\x5e\xd6\x42\x8b\x0e\x81\xb5\x52\xd8\xba\xbe\xa9\x53\x84\x87\x31\x0e\xad\x5f\x3c\x34\xfe\x7b\xf5\x40\xd0\x77\xf7\xf5\x43\x79\x39\xfd\x8d\x16\xb8\x85\xd5\x9e\xc2\xa6\x77\x53\xfd\xd3\x5c\x4f\x99\x4a\x6b\xaa\x4f\xd1\xff\x4a\x60\xa2\x55\xe4\xf0\xfa\xd3\xdc\xf1\x3f\x24\x40\x42\x84\xa2\x5f\x92\x66\x9d\xf0\xc8\xe5\x02\x80\xd0\x3e\x50\x2a\x07\x7f\x67\x5d\xb7\x02\xf3\xf2\xfe\x5c\x9d\x32\xb3\x62\xef\xea\x86\xfd\xab\x18\xf1\x28\x1b\xf1\xd6\x11\xb7\x3f\x5b\xa2\xe9\x86\xd2\x28\xe7\xb7\x0c\x94\x41\x42\xa3\xcb\x03\x02\xe6\x59\xdd\x93\x6b\x2a\x75\x76\x55\x62\xd5\xcf\x1f\x3c\x17\xde\xed\x21\xad\xdf\x2a\xba\xf8\x70\xe8\xb5\x77\xaa\x29\x6e\xac\x19\xa0\x98\x39\x66\x45\xc6\x4f\x76\xe5\xf2\x6b\xb3\x60\xab\x39\x2e\xef\x09\x07\x6e\x8d\x84\xa3\x8c\x6b\x0c\x55\x03\xf9\xfa\x85\x14\x51\x07\x6e\xfd\x17\x58\xf4\xb3\x12\xaa\xaf\xcb\x41\x43\x22\x6c\x0d\x3f\xbd\xa5\x5e\x7c\x5d\x1a\x50\x48\xaf\x2a\x9f\x9f\x87\xef\x9f\x0b\x05\x00\x02\x31\xf9\x77\x12\x5a\x45\x29\xd9\x0a\xb9\x83\x99\xfc\x36\x1b\x64\x03\xf5\xd9\xbf\x3e\x3f\x0a\x2c\x75\x20\xed\x5d\x77\xbe\x6b\xbb\x11\x39\x2c\x62\x3c\xcd\xfb\x6e\x04\xac\x7b\xa2\xfa\x86\x8a\xf5\xe4\xfe\xf3\x18\x88\xfa\x4f\x97\xea\x80\x4c\x1b\xde\x0e\x3b\x1d\x31\xe0\x6c\x09\x06\x15\x88\x50\x49\xed\xdc\xc9\x51\x73\x2a\x4a\x55\x7a\x3f\xbc\x67\x80\xdd\x80\xd6\xda\x49\xdc\x73\x52\x51\x6e\x5e\x02\x0f\x65\x83\x5e\xfc\x2a\x5b\x74\xf9\x9f\xf4\xc4\x38\x54\x29\xf9\x74\x4f\xde\x60\xdf\x73\x98\xad\x84\x05\xbb\xa7\xca\x2d\xe8\xa1\x77\x09\x35\xbe\x26\xcf\x4e\x65\xb0\xbf\x4d\xa3\xca\xb1\x58\xfb\x3f\xef\x98\x0e\x90\x69\xfc\x8f\x5b\xa1\x8e\xb2\x1a\x41\xb3\x8d\x4c\x6f\xdd\x8c\x6c\x5d\xaf\x43\x5d\x45\x85\x9a\xbd\x63\x1d\xb0\xdc\x9f\x3b\x26\xe0\xc5\x43\xe1\x21\x0b\x63\xa9\x43\x5d\x32\x37\x5a\xad\xa2\x8a\x82\x71\xc7\x23\x45\x58\x8c\x79\x24\x71\xe0\x53\xc1\x6a\x64\x75\x2e\x76\x67\x87\x6c\xaa\xe3\xa9\x7c\x1f\x5f\x6d\xf2\x63\xf6\xd2\x3a\x5d\x38\xff\x1e\xc9\x25\xfa\x27\xc4\x8e\x1e\xf0\xa7\x45\xca\x53\x3b\x87\xef\x02\x3a\xde\xc1\x11\xe0\xfa\x9b\xd2\x52\xb1\xc2\xce\x75\x9d\xd4\x4d\xe9\x11\x7f\x3f\x17\x0f\x0f\xf5\xdc\x5d\x11\x38\x93\x80\xcf\xd5\x39\x1f\x32\x52\x40\x77\x6f\x96\xc6\x2b\xa0\x0f\x1c\x1b\xa7\xf8\xa7\x5d\x48\xa3\x4e\xa0\x1b\x83\x8f\x6c\xe0\xb9\xbc\xee\xe6\xb7\x79\xfc\x32\xd5\x70\xc5\xd5\x0b\xf3\x4e\x3f\x8f\x09\x04\x38\x4d\xce\xee\x19\x50\x94\x7f\xda\x7d\x2c\xed\x30\x8a\xf1\x81\xcb\x01\x4d\x40\xad\x61\x42\x97\x50\x6b\xac\x3c\x11\x68\xc7\x27\x40\x17\xfb\x38\xd7\x3c\xee\x48\x47\xec\x8c\x30\xff\xb5\x41\x6e\xd4\xd9\x37\x98\xd2\x52\x9b\x4d\xc8\xdc\x71\xa9\x52\xa2\x27\xca\x46\x9b\x8a\x18\xce\xb3\xd7\xf9\xa7\x63\x40\x69\x2a\x70\x0e\xd1\xf9\x9b\x8d\xfd\xe1\x42\xa9\xba\xff\xc0\x9b\xc9\xa6\xcf\x8e\xa7\x08\xf5\xd6\xbe\x21\xa9\x4e\x55\xdd\xf5\xa9\xa1\x85\x17\xe5\x2d\xae\x18\x01\x3d\x88\x01\x2f\x16\xa7\x1f\x73\x96\x5b\x87\xb9\xfc\x2f\xcd\xfa\x1c\x04\xb1\x45\x97\x42\xbd\x9d\xf1\xcc\x33\xa6\x7c\x93\x60\x5c\xee\x7d\x31\x08\xe8\x47\x1b\x4e\xbf\xaa\xcd\x7a\x32\x48\xf8\x47\x6b\xcc\x47\x50\xe5\xf0\x98\x6c\x99\x15\xa6\x88\x79\x94\xc9\x51\x1b\xe4\x0f\x16\x22\xdc\xd3\xc9\xfc\x63\x77\xaf\xf6\x3b\x74\x5d\xed\xe4\x3d\x1f\x85\x99\xac\xe9\x4d\xe4\x65\x5b\x75\xe7\x3b\xbb\x84\x4f\x21\x6b\x9b\x90\xce\x5d\xc8\x74\xf3\xfc\xd2\x5d\x61\xe6\xf2\x0b\x99\x9a\xba\xf3\x8f\x27\x65\x85\xfd\x81\x37\xec\xca\xbb\x4a\x6f\xc9\x2e\xe7\x59\x8a\x4d\x40\xf4\x74\x26\x4a\x5d\xb7\x7d\xaf\x83\x1d\x2b\xfc\xad\x93\xdb\x88\xae\x08\x86\xab\x7c\xd1\x36\x1e\x3c\x07\x57\x85\x22\x0c\xf8\x61\x1c\x89\x8a\x99\x6d\x8d\xca\xeb\x17\xc6\x15\x65\x77\x13\xef\xb0\x16\x20\xe9\x43\x72\x7e\x29\xcc\xed\x43\x1f\x2f\xb0\xca\x5a\x52\x39\xb3\x75\x9a\xb0\x30\x37\x05\xa3\x0e\xb9\xaa\x7a\x9a\x39\xb5\x88\xe7\x31\xf3\x28\x60\xb6\x6f\xa0\xad\xcc\x4e\xbf\xab\x1e\x93\xb0\x33\xc8\x93\x0c\xe9\x28\xdb\xf4\xfe\xa4\x7d\x76\xba\x81\x31\xed\x2a\x93\x5a\xae\x47\x6a\xd5\x18\xae\xfb\x58\x6d\xe7\x58\x32\x44\x3b\x4d\x9a\x80\x6e\x87\x4f\xff\xa0\x54\xa8\x6f\xc5\x98\x11\xb0\x2b\x2b\xba\xae\xe1\xab\xe8\xdc\xe1\xcf\x2e\x4f\xaf\x17\xbb\x4a\x47\x8b\xb9\x9d\x18\xf5\x7d\x79\x0e\xcf\x21\x0a\x3f\xb1\xda\x24\xdc\x84\xfe\x63\x9d\xff\x70\x37\xef\xe3\x3e\x56\xa9\xe5\xec\x57\x0c\xef\xca\xdf\xbd\xc8\xe7\x19\x0a\x3f\xda\xfb\x97\x60\x37\x0b\x3a\x5a\x62\xab\x30\x5d\x90\x6f\x20\xcf\xac\xce\x68\xac\x11\x96\xc0\x11\xbe\x99\xe1\xf6\x89\x1f\x86\xf5\x29\x41\x77\xd1\x7b\x36\xaf\x20\x35\x47\x72\xc8\x5c\xd7\xc5\xf0\xe2\x74\x4b\x61\x7b\x47\x48\x5c\xd3\x4d\x9a\xda\x89\xb6\x64\x73\xaa\xf3\x1d\xe5\xb9\x9e\xfa\x85\x97\xe2\xa6\x28\xc5\xee\xc1\xff\x9a\x7a\x9a\x21\x75\x6a\x5d\x84\xcd\xd4\x79\x86\xa3\x95\x93\xa7\x2f\x6f\x19\x70\x38\xff\x9c\xbd\x36\x94\xa2\xde\x62\x07\xca\xde\xbb\x62\xd6\xea\x86\x2d\xcc\xf2\x45\x0a\x52\x56\xf0\x73\x3d\x91\x22\x7c\x4f\xae\xf7\xfa\xec\xdb\xa9\x43\x08\xa6\xfc\x1d\xf5\x55\xfc\x4f\xfb\xfc\xf0\xac\x4f\xc1\x39\x5b\x64\x73\xec\xe1\xe5\x43\x92\x49\x8b\xd2\x58\x47\x97\xe5\xe6\x3b\x14\xe3\xed\x13\x6d\x70\xee\x7f\x2c\x76\x78\xb7\x48\xce\x3d\x25\x73\xfe\x72\x76\xb3\x3d\x30\xda\x1d\x73\xb8\x65\xe2\x47\x04\xb3\x01\xc3\x38\x79\x33\x2f\x63\x8b\x0f\x67\x4c\x8c\x29\x29\xfb\xd1\x78\xab\x72\xde\xcf\xfe\x89\xc8\x1f\xa6\x81\xba\x67\x54\xb5\x77\x10\x38\x9a\x20\x61\x23\x35\x5b\x69\x9f\xe4\xad\x95\x8e\xe7\xaf\xda\xcf\x76\x74\xcd\x0d\x8b\x74\xb7\xb8\xa2\x09\x9c\x12\xbe\x85\x50\x39\xbf\x5a\x1d\x31\x36\xf0\xaa\x44\xdd\x4e\xf8\x3f\x97\xda\xd3\xbb\xcf\x13\xff\x4c\xa4\x30\x80\x83\xc0\xf6\x71\xe9\x47\x23\x49\xd7\xe3\x50\xf3\xc9\x01\x00\x26\x28\xe7\x1d\xff\x59\xb5\x2a\xf3\x64\x04\xa5\x42\x10\xd3\xa4\x6f\x4c\x28\xcc\xe2\x13\xee\xa4\xd0\xf8\xc7\x55\xd0\x5b\x48\x26\x79\x40\x34\xc5\x43\x65\x91\x5f\x5c\xc5\xfc\xd7\xc1\x65\x45\xd8\xbe\xdd\x9e\x00\xd0\xf1\x10\xec\x56\x28\xc8\x37\x50\x56\x06\x27\xfc\xf7\xf2\x89\xff\x48\x77\x11\xb1\xff\x48\xf7\x36\x10\x2c\x10\xfb\x37\x00\x68\x21\xbf\x89\xd6\x27\xf2\x4e\xf7\x30\xa5\xaa\xfb\xb6\xcf\xc7\x29\x49\xac\x4b\xa3\xf0\x2d\x14\xa7\x58\xbf\xb2\x3a\xb1\xfa\x06\x53\xe5\x80\xec\xa3\xee\x3c\x2a\x69\xb9\x05\xd1\xc0\xf1\x38\x77\x54\xc5\x4a\x0f\x82\x63\x58\xb0\x2b\x95\xeb\x83\xe8\x5b\x48\xea\xfe\xf0\xf2\x48\xac\x54\xd8\xd3\xcf\xe6\x1e\x56\xdd\x89\xb1\xbf\xc7\x81\xff\x9f\x28\xf8\x08\x16\xff\x21\x4e\xeb\x7e\xc3\x29\x2e\xae\xa2\x56\x45\x88\x5d\x0f\x0f\x6f\x6c\x81\x10\xf1\x9b\xda\x68\x0b\x81\x41\x9f\x58\x57\x59\xad\x44\x9e\xd3\xe5\xae\xa1\x37\x38\x6a\x73\x14\x96\xdc\xe3\x95\x93\xc0\xfe\xa7\x99\xc5\x49\xd5\x7e\x7b\x5c\xc8\x49\x75\x92\x88\x29\x93\xbd\xce\x21\x5b\x78\xd2\x63\xb8\xba\x92\xc7\xcc\x22\xf9\xb4\x4d\x01\xac\x17\x6d\x04\x5e\xdf\x3d\x97\xc0\x08\xf8\x5f\xec\x84\xdc\xfe\xe3\x55\x26\x90\xb8\x84\x04\x15\xa0\x67\xd7\xbc\xd7\x84\xa2\xff\x0a\x09\x48\x3a\x74\x53\xeb\x6e\x6e\x5d\xb7\x73\xee\x2f\x50\x49\xac\x68\x92\xfc\x26\xf2\xf4\xf8\x97\xb3\x0a\xc4\x86\x8c\x9b\x72\xcf\xaf\x5f\xb3\x35\x0d\xdc\x78\x64\x9e\xb6\x4d\x6c\x45\x65\x5e\x2e\x2f\xda\x5f\xdd\x0b\xe6\x09\x27\xdd\xe5\xfe\x7f\x46\xce\x94\x36\x40\x2f\x02\x1b\x0f\x20\x8c\x32\x3a\x23\x31\x12\x67\xd9\x3b\x3c\x84\x16\x00\xc0\x5f\xb7\x8d\x30\xed\x3a\x18\xae\x19\xc7\x9e\x1d\xbf\x5d\xe9\x48\xe6\xd2\xbc\xd1\xde\x90\xf7\x81\x60\xb1\x92\xf7\xe8\x97\x0f\x5e\x17\xf6\x9c\xb6\xb9\x9e\x36\xf8\xc1\x2f\x18\xfd\xf8\x69\xc0\x00\xdf\xa1\x62\x9b\xc2\xaa\x37\xdb\x2c\xa8\xe3\x79\x79\xb4\xb7\xf8\xb3\xbe\xd0\x5a\xd6\x69\x3c\xe6\x3f\xe5\x64\x0d\xc8\x4d\xb7\x56\x90\xb1\x17\xbc\x38\x3e\x07\x57\x36\x02\x79\x4d\xce\xc9\x88\x24\xce\x47\x48\x1b\xf6\x78\x19\x29\x42\x6f\x47\x45\x4d\x52\x5b\x3f\x0d\x40\x3b\x22\x2f\x5d\x73\x8d\x51\x8d\xb6\x0d\xbe\x33\x1b\x56\x3e\x39\xf1\xf6\xb3\x13\x7c\x56\x6a\xc9\x94\x0d\xac\xb8\x09\x37\xde\xd9\x5d\x95\x67\xe9\x90\x1d\x5f\x83\x28\x4d\x2d\x50\x87\x71\x2b\xdc\x85\x2d\x5b\x54\x57\x7e\xdb\x9e\xa8\x65\x1f\xa9\xc3\xcc\x6b\x15\xd5\xf6\x2d\x57\xf2\x2c\x17\x7e\xd0\x6d\x95\x12\x1b\x40\x50\xb0\xe8\x86\x59\xeb\x25\x7c\x14\x81\xf8\x96\x83\xcf\xce\xe7\x50\x61\xba\x80\xaf\x2b\x1b\x5e\x8c\x65\x7a\x93\x17\x78\x50\x48\x36\x00\x0d\x8a\xab\x85\x12\x2c\x03\x4b\x72\xbd\x87\x57\xa8\xd7\x7a\x54\x0a\xbc\x2c\x14\x37\x49\xf5\x1d\xc3\x4a\x0f\x81\x84\x65\x75\xd8\xbf\xf1\x7b\x59\x7d\xab\xa5\x40\xd2\x6e\x37\xaa\xd5\xa8\x53\xf5\xff\xd9\xfb\x21\xc2\x7a\x40\xd0\x61\x88\x41\x1f\xcb\x7b\xf8\x9c\xf7\x18\x80\x37\x21\x92\x32\x83\x52\xc4\x84\x98\x87\xaa\x91\x08\x28\x0a\x7f\xbd\x7a\xf0\xfc\x46\x05\xbb\x40\xb4\xe7\xdb\xdc\x98\xc8\x97\xfa\x1b\x78\x6b\x27\xd8\xf3\xdc\xe7\x29\xf6\x24\x2a\x81\x63\x0b\x43\xb6\xa6\x1e\x97\x4e\xd2\xb9\xc1\xe3\x30\x52\x5f\x03\x3e\x8b\xa2\x08\x8b\xad\x76\x91\xdd\xe3\xb7\x4e\x13\x3e\x90\xf1\xe5\xe5\x52\x1f\xc8\xbf\x21\x64\x14\xc0\x86\xe1\x06\x1b\x82\xc2\x63\x10\x6f\x2a\x15\xe8\x36\x4e\x95\x4d\x8b\xca\xfc\x97\x88\xc0\xa2\x2d\x9f\x02\xd3\x85\xa3\xd8\x3c\x6e\xc9\x59\xbb\x5c\x43\x13\xd8\x4d\x98\xbc\x4e\x19\xae\x6d\xd1\x74\x55\x8b\xed\x77\xe2\xdb\x98\x90\xa7\xfd\x6d\xb2\x57\x5e\xdd\x58\x84\xdf\x80\x68\xfe\x21\xcf\xe0\x77\x24\x61\xca\xc6\xb8\x10\x97\x8b\x01\x8e\x91\xba\x4a\xa9\x92\xaa\x09\xbc\x5c\x26\xbc\x2c\x26\x54\x8f\x14\xb3\xbe\x00\xae\x5f\xf9\x5f\xbf\x92\x2b\xa1\x18\x76\xed\x5e\x4d\xac\x82\xcf\x1a\xe1\xce\x44\xc6\xa0\xd5\x7d\x3c\xee\x26\xe6\xae\xfd\x37\xde\x2f\x6e\xc1\x05\x10\xd8\x1a\xb8\x9a\x02\xf0\xcd\x05\x74\xeb\xb3\xa2\xd9\x08\xf4\x4f\x72\x0c\x3b\xe8\x9a\x3f\x91\x01\xfb\xd6\xd0\xec\x83\x63\x23\xf3\xdf\xfd\x8c\x03\x2d\x27\x37\xef\x2b\x6c\xd0\x6f\x17\xeb\xfe\x66\x25\xe7\xeb\xe1\x74\x5f\xe9\xc8\x56\xe9\x48\x7d\x00\x83\xaf\xaf\x89\xd8\x8c\x1c\xdf\xf9\x2b\xe7\xae\x38\x88\x40\xff\x6c\xe4\xb7\xe4\xb7\xce\xe5\xe9\x4c\x1c\xb8\xc3\x46\xb4\xee\x06\x9d\xf3\xcb\xa0\x5b\x78\x91\xe1\x45\x32\x9c\xa5\x27\xf6\xa8\xe5\x92\x09\xb4\xfb\xa5\xa3\x30\x46\xfb\xa4\x1a\xaf\x54\xfd\xe6\x17\x25\x16\x23\x26\xa7\x53\xd6\xe5\x43\xfc\x1b\x33\xc8\x37\x43\x87\x9a\xbf\xf7\x57\x1a\xfe\x0b\x93\x23\xe8\xcc\xf5\xda\x0d\x0f\xc8\x29\xcd\x3b\xdb\x44\xdc\xb0\xb7\x3c\xd3\xfd\x90\x47\x4f\x61\xa1\xd1\x9f\x79\x39\x3d\xb3\x24\x34\x56\x67\x47\x26\x6e\xb2\x1f\xe1\x37\x6c\xad\x66\x6f\x69\xa9\x54\xf2\xed\xb6\x65\xf3\xc1\xde\xd3\x99\xe8\x0c\x82\xbc\xf5\xf4\x57\x9b\xfb\x65\x75\x18\xbc\x49\xd4\x8e\xd3\xd5\x6a\x9f\x18\xfc\x0f\x7c\xfc\x07\x78\x3b\x19\x37\x14\xf9\x88\x18\x47\xec\xb4\x48\x3e\x19\xe9\x1b\xb0\x65\xb7\x9f\x16\x25\x3d\xe3\x67\x35\xb4\x96\x7c\xa1\x12\x33\xd0\x37\x78\xb0\x47\x5e\xdd\xa9\x32\xce\xee\x94\xdf\x4f\x46\x83\x6e\xe1\x8e\xaf\x55\x04\xfb\x20\x40\x32\x83\xdd\x2d\xc6\x8d\xed\x77\xd5\x78\xbd\xae\xb4\x9c\x54\xf9\x2e\x92\x72\x6f\x91\x05\x54\x47\x4a\xae\x50\x8e\xfd\x47\xfd\xb5\x9e\x76\x95\x36\x29\xcb\x66\xb5\x8e\x80\x25\x50\x03\x9c\x0d\xd2\xe4\x5f\xc6\x12\x17\x82\x72\x86\xc3\x85\x6b\x7a\x51\xa5\xda\xa1\x9c\x95\x07\x7d\x03\x13\xb7\x2e\x98\x18\x77\x82\xd1\x1d\xea\x44\x14\x7c\x20\x8a\xc0\xba\x50\x70\x5a\xec\x45\x86\x74\x77\x21\x5d\x9e\x13\x2c\x4a\xb7\xc5\xfc\x42\x4f\x50\xf9\x2d\x84\x6e\xcd\x84\x66\x85\x32\xf7\x14\xcf\xf4\x74\xdd\xec\xdb\x4a\xdd\xda\x1a\xc6\xca\x64\x79\x7b\x87\x5e\x9e\xcf\xd6\x3f\x4a\xfc\x3f\x6e\xdc\xec\x6e\xfe\x17\x2e\x25\x20\xee\x3a\x4d\xbf\xb4\x14\x9a\xcd\x08\x1c\xfd\x5a\x13\x29\x1e\x59\x34\xa0\xf5\xfd\xcb\xb7\xcb\x42\xf1\xdb\x87\x5f\x9d\x10\xbc\x2a\xf9\x4a\x2f\x74\x30\x73\x19\x7b\x90\xaf\x8d\x77\xea\x57\x31\x8c\xec\xb7\x8d\xad\x11\xdd\xb3\x0b\x34\x5b\x8c\xfc\xa5\x69\x5c\x47\xfe\xe6\x36\xa3\xe4\x9f\x28\x96\x07\xa9\x8d\xaf\x32\xe2\xe1\xf9\x4b\x60\x1a\x64\x41\xe3\x2a\xfe\xdf\x80\x79\x27\xc8\x6b\x3f\xcb\x27\xbf\x4d\x6e\x1f\x34\x65\xf6\x09\x3e\xce\x35\x0a\x6b\xd9\x53\xb1\xfa\xf7\xe6\x1b\xf6\x8e\x71\x6f\xb4\x8e\x5e\x62\xf6\xc8\x9f\xb4\xb9\x9c\xf9\xc4\xee\xb3\x5a\x9a\xdf\x25\x9c\x7d\xd7\xf7\x27\x7b\xb3\x2a\x7e\x95\x3d\x00\xa9\x52\x79\x40\x65\xde\xfd\x2d\x0f\xfd\xc5\x35\xda\xeb\x65\xff\xcd\x35\xf9\x73\x5b\x71\xfe\x5b\x75\xcd\xa5\xf7\x05\xbb\xe6\x3e\xb1\x17\x54\x56\xcb\x12\x39\x73\xe4\xee\xa2\xf3\xab\xef\xcd\x4b\xd0\x69\x2b\x9c\xba\x7d\xf9\xdf\xca\x53\xfb\xf8\x0a\xbe\xea\x05\x16\xbf\x97\xfa\xfd\x57\xfe\xd0\x9e\x8d\xa5\x37\xe6\xbf\x15\xd8\x18\x6b\xbb\xbb\xaa\xec\xeb\x9b\x57\x37\xaf\x6e\x5e\x6d\xae\x1d\x0e\xae\x70\x8a\x19\x58\x98\x58\x1b\x9e\x1e\xe9\xb9\x3f\x69\x77\xf8\x76\x25\x8f\x10\xee\x09\x5d\x95\xd5\x9b\xed\xbe\xb3\x54\x17\x3d\x14\x2b\xf8\x22\x76\xf3\x9f\xa6\xf7\x12\xdb\x43\x0f\x7f\x73\x1f\x28\x9f\xf9\x36\xb4\xb2\x5a\x4b\x59\x79\xc6\x9a\x92\xaf\x77\xc0\xe0\x5b\xf4\x6b\xbf\x7f\x21\xdb\x9d\xef\x9d\x52\xab\xf8\xd5\x11\xa4\x6a\xaf\xa2\x5e\x20\x73\xa6\xf7\x59\xc1\x3a\xce\x53\x25\xf5\x8d\x73\xf6\x2a\xd7\xdc\xab\xb6\xa8\x2e\x9f\xd9\xfb\xdb\xae\xb2\xfa\x3a\xd3\x9a\x67\x5d\x0f\xb4\xda\xfd\x4f\x9f\xce\x3d\xfd\x01\xee\xbf\xfd\x7f\x79\xea\x21\xc9\x36\x20\xd5\xcf\x4e\xed\xfd\x5b\x77\xe1\x27\x8c\x5c\x73\x96\x71\x2e\xd9\xd5\xab\xf8\x34\xa2\x6f\xa9\x77\xdf\xbd\xd2\x4f\xdf\xf2\x36\x94\x44\xed\x7c\xcb\xb7\xbe\xd7\xf7\x69\xf4\xa7\x8f\x4f\x3f\x59\xae\x79\xbd\x61\xdd\xe1\x5d\x7c\xaf\xad\x80\x8c\x77\xfb\xb8\x5f\x83\xca\xa1\xcb\xc0\xfa\xe7\x62\xc3\x49\xfe\x59\x06\x1b\xc5\x7f\xc9\x57\x97\x6f\xb8\x15\x22\x7e\x84\xf1\x8f\x49\xbc\x5d\xd7\x0f\xbe\x48\xd1\x1a\x96\x83\xc7\x0b\x5e\x7f\x7e\xb9\x7b\x87\xe6\xc4\xae\x20\x1d\x0e\xc7\xf0\xa6\x69\x7a\xab\x22\x66\x7b\x3d\xb4\x37\x8d\x59\xb7\xd7\xc4\xd7\x39\xa9\x2e\x7d\xc7\x02\x4d\xcb\xb7\x8f\xf4\xea\xce\x9c\x99\x59\x3c\x7d\x4d\x9c\xad\x69\x48\xe5\x9c\xfd\x8b\xd7\x55\xcc\x66\xaf\xb8\x3c\xa5\x80\xdf\x78\xdf\xb3\xe8\xf3\x0d\x4f\x5f\xfd\xdf\xad\x29\x28\x7f\x26\x6d\xba\xf3\x81\x66\xfb\x1f\x10\x74\x3b\xec\x0f\xa3\x02\x83\x1e\xf3\x81\x53\x6d\xb5\x27\xee\x1e\x30\x5d\xfc\x5e\x51\xa1\xe2\xea\xcb\xbb\xab\xa5\x4d\xbe\x7d\x7e\xbf\xf9\xcc\x2d\xeb\xd3\xb2\x47\xaf\x6e\x2e\x9f\x61\x54\x1a\xaf\xb9\xdd\xef\xeb\xb5\x7b\xd7\xcf\xbb\x8a\x97\x34\xb0\x57\x40\xd0\x6d\x9e\x3d\x0b\xb7\x6e\xff\x17\xff\x52\xe1\x84\x7d\x91\xc0\x13\xcf\xec\xb7\xa1\xc7\x76\x5d\xe9\xbc\x17\xfa\x70\x53\xc2\xb7\xf8\x0d\xdb\xe5\x7f\xd4\x7a\x9e\xfc\x16\x5c\x25\xd0\x2f\x5e\xf5\xdd\xef\xf3\xdd\x7d\xa6\xaf\xde\xad\xef\x59\x0d\x87\xbb\xdf\xa6\xc6\x48\x3a\x48\x1e\x28\x4d\x63\x58\xcd\xff\x21\xd9\xa8\x81\x5d\xf2\x6d\xd1\x74\xeb\x32\xee\x8a\xda\xda\xea\xd2\xd6\x5b\x16\xbf\xf7\x73\x7e\xba\xf7\xbc\xe4\x9d\x0e\xc3\x9f\x89\xef\xf9\xbf\xbe\xbd\x7b\x67\xea\x6d\x56\xf9\x3f\x07\x21\xe8\xbe\xde\x23\x7e\x06\xc6\x02\x49\x86\xb0\xb4\x75\x6c\x4a\xf6\xbc\xf7\xda\x36\x84\x9f\xd7\x50\x2f\x5a\x5d\xfe\x3e\xfc\xdb\xaf\x99\x45\xef\x02\xab\xbe\x2f\x3b\xf9\x69\xee\xb2\xfc\xf5\xd5\xb7\xbe\xfd\x6f\xb5\x7d\x5e\x3a\xd9\xed\xef\x86\x7c\x46\xf9\x0f\x10\xf4\x56\xeb\x07\x83\x00\xc3\x7b\xee\x03\x4e\xb1\xde\x65\xef\x2a\x03\xb6\x4b\x5c\xdd\x1c\x7e\xf3\x90\xd6\xe3\xb8\xef\xdc\x75\xfc\x5f\xf6\x3d\x33\xf9\x18\xf8\x6d\xe6\xb3\x84\x93\xf7\xdd\x36\x5c\x89\xdd\xb9\xf5\x7b\xab\xd1\x3a\x38\x7c\xa7\x37\xbd\xf0\xbd\x70\xf5\xea\xdb\x5e\x6f\xbc\xe6\xf8\xa8\x2d\x6e\xbe\x99\x53\x67\x34\xd3\x26\x7b\xed\x5e\x9f\xfa\x7b\x7b\x8a\xc3\xf3\x16\xbe\xb7\xa9\xad\xfe\x1a\x72\xff\x5e\x61\xda\x87\xad\x8d\x33\xab\x5b\xcf\x6a\x5f\x60\xb6\x85\xa2\xdd\xd2\x5f\x52\x18\x52\x19\xc2\x67\x32\xae\x29\x7a\x63\xa2\x7a\xc7\xfd\x67\xce\x9c\x7b\x7b\xca\xb9\xcf\x17\x56\x7d\xb3\xfb\xde\x93\xb0\x72\xdf\x41\xad\xa7\x62\xdf\xb8\x6a\xba\xff\x6f\x07\x16\x94\x3a\xd9\xae\xd9\x6f\xbe\x7e\xfc\xa5\xf3\xff\xd7\x96\xcf\x3d\x31\x95\x77\xa2\xaa\xe7\x06\xbd\xbb\xed\xf0\x7e\xcd\xf2\x39\xb7\xf7\x6a\x97\x6f\xc8\x07\x86\x84\x00\x02\x99\x73\x37\x5c\x57\xbd\x25\x7e\x92\x69\x5b\xe3\xee\xa2\xd7\x0b\x8e\x3d\xcd\x65\x37\x6d\x11\x70\xe2\xf8\xf8\x61\xe3\xf3\xbb\x1f\xdf\xe9\xfc\x7c\x17\x62\xff\x20\xf7\xfd\xe4\x7d\xb6\xb5\xd5\xbf\xbe\x7d\x79\xfb\xf2\xf6\xe5\xed\xcb\xf3\xf3\x5d\x6b\xab\x8d\x1a\x8c\x1a\x66\x4f\x62\x7c\xb3\x4e\xfb\x71\x58\x4f\xb4\x61\xde\xd1\x1d\xa2\x10\xc6\xd3\xf3\x3b\x44\x15\x41\x66\x28\x9a\x29\xb9\xfd\x95\x67\x56\x60\x60\x1a\xba\xe8\xc1\x2d\x86\x1f\xb3\x65\xad\x25\x18\x18\x18\x1c\x14\x9e\xe5\xdf\x55\xee\x99\x7d\x8c\x43\x81\x51\xa5\x81\x65\x02\x83\x90\x03\x93\x07\x03\xe7\x28\x67\x10\x71\x8e\xb1\x7e\x60\xb2\x28\x7b\x9d\x00\x8c\x2e\x86\xc6\x19\xfb\x4b\xfb\x0d\xf2\xc4\x98\x1d\x18\x58\x46\xd1\x80\xa0\x03\x16\x0c\xfc\x07\x36\x26\xb3\x33\x03\xa3\x83\xe3\xc8\x9f\xb5\xd5\x2d\x85\x97\x25\x46\x53\xeb\x60\xe5\x1c\xd8\xc1\x10\xc7\x70\xbc\x4f\x96\x1b\x94\x7d\x38\x3e\x6f\x3f\xb7\x28\x5b\x4a\x79\x34\x74\xc8\xe2\xfc\x61\xdd\xc0\xc8\x10\xe3\xe9\x23\x70\x6c\x6a\xf0\x21\x50\x78\x7a\xba\xfa\xb9\xac\x73\x4a\x68\x02\x00\x9a\x24\x37\xda\x84\x95\x00\x00")
+
+func imagesLgtm_stampPngBytes() ([]byte, error) {
+	return bindataRead(
+		_imagesLgtm_stampPng,
+		"images/lgtm_stamp.png",
+	)
+}
+
+func imagesLgtm_stampPng() (*asset, error) {
+	bytes, err := imagesLgtm_stampPngBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "images/lgtm_stamp.png", size: 38276, mode: os.FileMode(420), modTime: time.Unix(1792052089, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _imagesLgtm_wipPng = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\x03\xed\xbc\x77\x54\x53\xdd\xd3\x36\x7c\x52\xe8\x55\x8a\x80\xf4\x26\x08\x28\x28\x02\xd2\x43\x93\x26\x82\x52\xa5\x46\xa9\x82\x34\xa9\xd2\x12\x04\x14\xa5\xf7\x5e\x94\x26\x52\xa5\x23\x25\x11\x14\x14\x69\x82\xf4\xaa\x08\xa1\x17\xe9\x25\x39\x1f\x78\xdf\xbf\xf2\xfd\xfb\x7e\x6b\xbd\xcf\xb7\x9e\x75\xaf\x99\x95\x9d\x7d\x72\xf6\x3e\x33\xd7\xcc\x35\x7b\xfe\x39\x79\xa1\x77\x5b\x9d\x8a\xfc\x02\x39\x00\x00\x54\x9a\x1a\xaa\x77\x01\x00\x8a\x06\x00\xc8\x2c\x29\xf1\xe9\x15\x89\x6f\x93\xbf\x00\x40\x33\x51\x53\x55\xc9\xc0\x37\x73\x75\x18\x42\x05\x00\x94\xd0\xdc\x0d\xbc\x8a\x19\x96\x58\x99\x9c\x00\x00\xa9\x3c\x68\xa8\xe0\x3f\xfa\x7f\x4b\x47\x61\xcc\xde\x2b\xc8\xd3\xc8\x00\xc1\xa9\x18\xaf\x18\x31\x97\xf3\x30\x04\x00\xff\x47\xff\x47\x14\x7b\x03\xa0\xf9\x07\x85\xff\x39\x8d\x84\x6d\xfe\x83\xc2\xff\x6c\xfa\x63\xdf\x59\x93\xc0\x4e\xab\x11\xe9\xc7\x93\x32\xff\x50\xf7\x41\x66\x08\x1f\x1a\x1e\x0b\xd0\x21\xa0\x1a\x00\x19\xd7\x3f\x93\xff\x1f\x4d\xb0\x0d\x80\x25\xd0\x19\xcd\x9e\x2f\x79\xee\xd9\x7d\x36\xf0\xb9\x2f\xd1\xb9\x5d\x5a\x8e\xae\xa8\x59\xaf\x49\x5f\xc1\x98\x57\xe1\xee\xbc\xa3\x3a\x13\x35\xb6\x5c\x9c\x89\xdc\x11\x7a\xe9\x64\x59\x04\x0e\xe6\xdf\x2d\x33\x17\x37\x2f\x52\xd2\x28\xfe\x03\xdf\xff\xf4\x64\xf3\x13\xc5\x6c\x86\x7b\x6c\xc4\x82\xfe\xae\x35\x25\x05\xae\xfc\xc8\x64\xad\xe3\x53\x57\x75\x71\x90\xa1\x6b\xe7\xa7\xb4\xed\x7a\x3f\xab\xa9\x98\x88\x24\x9c\xd9\xae\x2d\x65\x5f\xe6\x92\xe2\xd1\x0f\xb2\xa6\x13\xcb\x40\x8e\x90\xcc\xcc\x87\xae\x56\x9d\x95\x7a\xd3\x86\x8a\x9d\x9b\x5a\xe2\x2d\xc2\x7b\xcf\x71\x7b\x9f\x0f\x0b\xdf\xbf\x25\x70\x1e\x9e\x18\xe3\xa5\xe5\x9a\xd4\x71\x33\x43\xbb\x07\xd0\xb8\x4b\x19\x63\xa5\x3b\x16\x75\x3b\xce\x9d\xf7\x7f\xac\x1a\x5b\x12\xe3\x0a\x5c\xfd\x5f\xe1\xcb\x6b\x29\x7b\xb5\x67\xf6\xba\xfd\xe5\x0a\xce\xbe\x0c\x60\x9e\x18\xd2\xac\x66\x99\x3d\x62\x18\x61\xe9\x44\x75\x70\x4f\x76\xf3\x43\x56\x11\xb4\x57\x3a\x5c\x74\xe8\x6d\xf8\x0d\xbc\x68\x93\x8b\x8d\x1b\xbf\x3f\xa4\x2f\x7e\x38\x5b\x6c\x68\xb6\x85\x28\x68\xd6\x23\xbb\x74\x37\xdd\x32\x4f\x23\xce\xf7\x33\x45\xd2\xd3\xc4\x66\x75\x0d\xa5\x3b\x05\x5a\xfc\x2f\xe3\x79\x2c\xd3\x11\x9c\x17\xf2\x11\x61\x59\xf0\x8c\xe1\x58\x80\xe8\x1c\x6d\x7d\xd8\xf2\xe8\x76\xd2\xae\xaf\xf7\xf0\xca\x54\x9a\xf4\x1c\x1e\xec\xe5\x58\x3b\xec\x15\x59\xed\xed\x5e\x3f\x40\xb5\x6e\x64\xba\x3e\x9e\x16\x2c\xe4\x23\x6e\x7f\xad\x8b\x7b\x7b\xe4\x61\x48\xe6\xe3\xac\x48\x45\x49\xe2\x45\x7a\x83\x8c\x8d\xe8\xe6\xaa\x9a\x72\xb8\xcd\x12\xd9\xcd\xc7\x46\x1b\xbe\x46\xae\x44\xbc\x9f\x92\x96\x38\x92\x55\x5f\xd2\x07\x59\x67\xb3\xe3\xcc\x3e\x38\x04\x48\x52\xb6\xee\xd9\x1d\xc8\xd7\xed\xb3\x53\xb0\x7f\x0d\xe4\x9f\x78\x4d\xc8\x5a\xee\x7e\xcb\x3e\xa8\x38\x16\x5c\x0e\x73\xe6\x07\x5d\xbf\xfb\xbf\x2d\xd4\x9f\xda\x5d\xec\xc3\x7e\xc5\xfa\xac\xfe\xd2\x38\xd1\x3c\x15\x7e\x01\xc2\xeb\xa0\x70\x85\xa1\xe6\x08\x04\x3d\xea\xce\xf1\x82\x4a\x4f\x13\xe9\xf5\x2d\xa6\x5a\xd7\x87\xc3\x77\xcf\x39\xfc\x14\x5d\xf6\x7a\xbb\x5b\xbc\x4b\x17\x7c\x91\xf0\x7a\x5a\x45\x62\xe2\x88\x68\x53\xa6\xbf\xc8\x0b\x5a\xed\x9f\x85\xbe\xed\x22\x29\x20\x45\xc3\x1d\xdd\x88\x45\xb3\x48\xdb\xf0\x6e\xa2\x59\x38\x7e\x12\x00\xef\x8c\x38\x15\x62\xf9\x43\x8e\x94\xa0\x67\x0b\xb1\x97\x97\x75\x5b\xa2\xdd\xf5\xb3\x08\x9c\x77\x50\xe3\xc1\x16\xd8\xfb\x48\x83\x07\x95\x57\x3f\xe6\x36\xa3\x7e\x13\xd2\xf6\x45\x37\x2c\x11\x33\x2d\xa8\x27\xfb\xd0\xf5\xa8\x51\x26\xce\x66\xf4\x6f\xc1\x82\xb9\x3b\x6f\x87\x28\x04\x37\x72\x30\xd3\x98\x86\xe5\x85\x87\xeb\xc4\xe7\xc7\x5e\x24\x92\xe8\x71\x20\x9e\x00\x25\xdb\xc0\xc2\xc3\xef\x19\xc6\xa2\xb9\x1b\xd3\x98\x66\x7f\x08\x89\x40\xc6\xf4\x49\xe1\xef\x9d\x09\x47\x1b\xd1\xd3\x1d\x09\x7e\xe0\x4f\xb7\x88\xf2\x3d\x20\x07\x26\x0a\xbd\x5c\xa7\x04\xd5\x3b\x15\x37\x3f\xa8\x98\x2b\x43\xe9\xab\xbe\xcc\xcf\xf6\xeb\x19\x8f\xfc\xac\x86\x61\xec\x76\xa4\xdc\x17\xa8\x99\x18\xfd\xa8\x6e\xf0\x73\xa7\xba\x72\x8f\x26\x82\x16\x9f\x2d\xba\x52\x2e\xf1\xce\x64\x1b\x6e\xc6\x58\xa5\x25\x5d\xa9\xdf\xc0\x86\xa0\x42\x2b\xca\x43\x25\x27\xe7\xed\x7a\xef\xb6\x9b\x74\xa5\x68\xd0\xcc\x1e\x74\xcc\x7c\xe8\x4a\x7c\xca\x8c\x2b\xc7\xbe\x55\xfc\xb8\x10\x28\x9d\x51\xfd\x5d\xcc\x7f\x6e\x20\x78\x15\x7f\xee\xe4\x71\xf5\xbd\xdd\x4f\x81\xea\xc6\x29\x8a\xc9\x9f\x1e\x5b\x16\x04\xf6\xb5\x47\x6f\xa4\xf3\x17\x7e\x6d\xc5\xb4\x53\x90\x74\xa7\x2b\xbb\x3f\x2b\xbe\x2f\x32\xaf\xfd\xab\xd1\xd1\x29\x18\x05\x0e\x97\x76\x7c\xb4\x10\x7e\x59\xff\xca\x97\xc4\x85\x51\x20\x3b\x2b\xf0\xeb\xee\xd4\xb3\x0c\xb5\x86\xc7\xb0\xd6\xd6\x73\x72\x56\x98\xe3\x80\xa5\x0b\x01\x87\xbb\xaa\x78\x21\x5f\xef\x35\xf1\x94\x1f\x37\xdd\x97\xfd\x87\x8a\xcd\x01\x4b\xf2\x56\x1a\x2d\x78\x99\x6a\xbf\x16\x9c\x5d\x0b\x8e\x27\x73\x3b\x67\xe2\xc2\x5e\x10\x70\x8d\x98\xa9\xe2\xc3\xef\xb0\xe1\x04\x8e\x75\x21\xb9\x7e\xb2\x63\xf0\xa1\xbc\x6d\x3b\xf6\xb8\x8c\xb7\xef\xc7\x4c\x54\xc9\x6c\xf0\x27\x3b\x7e\xdd\x89\x07\x8b\x2e\x61\x73\x56\xcf\x24\x6f\x51\xc1\x38\xeb\xa3\xe5\x9e\xa3\x48\x9a\x5e\x49\xbf\x6a\x68\x9b\x79\x66\x6d\xf8\x38\x3f\xd7\x2d\x66\xa1\xf0\x97\xc2\xe0\xa3\xc2\xc6\x4b\x71\x62\x6e\x8f\x0e\xea\x35\x71\x8e\x27\xe6\x53\xd6\x9d\x48\x97\xc0\xe8\x60\xd6\xc5\x9c\x56\x78\x3b\x0e\x79\x20\x47\x99\x15\x92\x80\x9b\x5d\x14\x8c\x34\x17\x09\x9c\x8f\xec\xc2\x63\x59\xb1\xd5\x4a\xeb\x3d\xe2\x09\x52\xda\x80\x16\x20\x59\x07\x78\xa2\xea\xa9\x21\x7d\xf7\xd4\x2f\xcd\xb1\x3e\xff\xd1\x18\x75\x8e\x55\x3f\x97\x56\x14\xb8\x9c\xff\x1e\x25\x19\x8a\xa2\xc8\x7a\x15\xcd\xa0\x35\xf3\xde\x04\xe2\x70\x7f\x76\xee\x09\x0d\x58\x6e\x45\xcd\x1e\xa7\x92\x79\x94\x18\x22\x50\xca\x1f\xfa\xe6\x99\xb4\x4e\xa5\xf7\x98\x2a\x3b\xa9\x05\xac\xcf\xf2\x59\x8c\xfa\x8f\xb9\x73\x07\x2b\x64\x37\xfc\xfc\x3a\x46\xb0\x45\xc1\xd6\xd8\xdb\xb3\x88\x02\xa6\x12\x1b\xab\x85\x45\x0d\xb8\x3a\xcf\x13\x85\x9a\x9b\x0c\x9c\xd4\x61\x32\x77\xf3\xfb\x6d\xcb\xd2\x5e\xbc\x35\x27\xc3\x76\x1d\x9b\xfa\x1c\x0d\x87\xc9\xfc\x96\xa5\xd9\xbc\xe4\xa4\xba\xf5\xbd\xbe\x34\x89\x92\x04\xb4\xbd\xcc\xad\xda\xe2\x76\xe7\xa5\x7d\x2b\x55\x45\x00\x67\xf4\xea\x53\xd1\x20\xaa\x0a\x45\x59\xf7\xa9\x74\x7d\x3c\xdf\x3b\x46\xac\xec\x87\x07\xe7\xc8\x10\xa7\x82\x45\x00\x41\xc8\x28\x41\x85\xcf\x7a\x80\x77\x19\x5a\x42\xbb\xe7\xe5\xc7\x2f\x85\x12\x76\xbe\x91\x14\x97\x77\x33\xce\x75\x84\xd5\x1c\xc9\xf2\x01\x4e\xb4\x68\x5d\x53\xde\x97\x02\xf4\x0c\xe8\xce\x0e\xe4\x6a\xd5\x3d\xe5\xf0\x7c\x07\x0c\x8d\xf7\x6e\xfb\x0f\x26\xe4\x8e\x54\xa5\xbf\xb0\xc8\xfb\x91\xba\xdb\xac\x29\x57\x16\x74\x1e\x46\x1a\x48\x3c\x83\xe7\x76\x42\x37\x07\x91\x6d\x28\x9b\xc3\x9d\xd5\x50\x61\x05\x61\xf2\xac\xda\x27\xc3\x72\xf7\xe9\xf5\x8c\xba\x5c\x4c\x61\xda\xb8\x8c\x24\x8e\x2b\x22\xef\x85\x82\xa6\xef\xab\x57\xd2\x7e\xb4\x5f\x7f\xa1\x4d\xf6\x6b\x41\x84\xaa\x94\xc6\xab\xeb\x58\x60\x23\xa7\x19\x65\xe9\x0f\x0a\x25\x0d\x69\x23\xfb\xc4\x37\x0f\xb5\x37\x7f\x02\x35\x06\x02\xf0\xa9\x96\x86\x19\xe2\xb7\x29\x31\x74\x68\x3a\x74\xf5\x2d\xc0\x88\x1a\x46\x23\x4c\x69\x6e\xcd\xdc\xa0\x37\xa7\xd0\x45\x61\x32\x4d\xb1\x38\xbc\x77\xe5\x2a\x6f\xf7\x82\xb7\xc9\x35\xab\xbf\xf8\x20\x49\x86\x10\x16\x36\x9b\xad\xe5\xc4\x16\xa7\xdc\xc7\x59\x0f\xcb\x5e\xae\x83\x6c\x68\xc1\x8f\x2e\x41\xf9\x81\x40\x3a\x34\xcb\xb3\x60\x12\x19\x6b\x49\x62\xd1\x43\x94\x70\x05\xb4\xf2\xb7\x82\x16\x90\xcc\x84\x4e\xeb\x01\x9b\xfc\xe8\xa9\x51\x76\x46\x7c\xc6\x65\xdc\xa1\x1a\xd1\xc7\x4c\x6d\xac\x7d\xed\x56\xde\x06\x49\x3a\xf7\xc5\x68\x83\x4d\x3d\xd3\x7b\x5f\xeb\x93\x21\x74\x89\x90\x27\x72\xd8\x84\x61\x2d\xce\x7a\x99\x52\xf1\x20\x22\x9c\x29\x97\x0a\x99\xe1\xbb\x06\xb1\xf6\xf3\xe2\x6d\x93\x13\x94\x66\x42\x11\x62\x64\xd8\xdf\x50\xb1\xea\x43\xd4\xf3\x67\x3f\xfc\x02\xa8\xab\x55\x24\x7e\x89\xd1\x2b\x1b\x7c\x19\x64\x9d\x1d\x5d\x7f\x7b\xcd\x98\xd2\xf1\x7e\x38\x8a\x42\x85\x0f\x18\x64\x44\xab\x7c\xe8\xa9\xe5\x65\x91\xa1\xc9\xab\xc5\xd9\x38\x7e\x17\xab\xa4\xb8\x95\xd8\x93\x7f\x60\x22\x2b\x52\xaa\x7f\xbb\x2c\x2d\x31\x7b\x88\x11\xd7\xb7\xe3\x9b\x79\x07\xf5\x44\x0c\x73\x8d\x2e\x05\xf3\x78\x30\xfd\x56\x3b\x06\xbc\x73\x60\xfc\xc3\x2a\x0c\x95\x40\x06\x26\x0b\x0b\x9f\xff\x20\xab\x18\x22\xaf\x58\x93\xfd\x72\x15\x5a\x97\xc1\x0d\x11\x84\x8c\x3a\x40\xfb\x57\xc4\xdc\x7a\x99\x9e\x32\x38\x4e\xd6\xab\xba\xbf\x08\x97\x42\xb5\x97\x6a\xa4\x87\x3c\xf1\x56\xf8\xed\x3b\x59\xfb\x73\xab\x9c\xa5\x20\xe2\x4d\x11\xc0\xa0\x6c\xf3\x71\x7d\xe3\x43\xc6\x44\x61\x20\x95\x2f\xc5\x28\xf2\x34\x88\x67\x22\x00\x15\xc3\x6d\x03\x2d\xe7\xd1\xd2\x34\xb0\xce\x3e\x70\xe5\xb6\x0b\x61\xa6\x18\x6c\x7b\x71\xc0\x37\x5e\xa4\x5c\x47\xcb\x63\xeb\xbd\xd3\x36\xa1\x98\x96\xea\xa8\x1f\x9c\xcc\xa5\xb9\xb3\x63\x4f\xab\x75\x4a\x8c\x5e\x1e\xc8\xa2\xb3\x02\x76\xf8\xbc\x6f\xc3\xaa\xce\xf7\x0e\xce\x32\x6c\xbb\x9d\x6f\x28\x05\x7e\x2f\xfd\x34\xa7\x82\xfc\x27\x7a\xe2\x81\xcc\x60\x20\xa0\xed\xc2\x1d\xb9\x2d\x1d\xa7\xa1\x9c\x7d\x21\x7f\x70\xba\xfa\x19\x2d\x8f\x6c\xdd\x2e\xb6\x09\x23\xd8\x18\x65\x71\xf8\xf3\x59\x64\x45\xfa\x1f\x17\x34\xa0\x6e\x70\xc1\xa6\xc2\x6f\x53\x29\x5b\xd5\xdb\x76\x05\xba\xaf\xaa\x25\x75\xcd\x6e\xee\x7c\x2a\x60\x59\x34\x93\x2a\x8b\x7b\xf8\xb3\xc0\xc7\xf9\x53\x84\x75\xc5\x73\xe1\x79\x8e\x97\xda\x50\x82\x9e\xd9\x27\xbe\x9f\x9a\xcf\x51\x34\xa6\x2a\xa4\x71\x97\x43\xa6\x06\xb3\xe9\x17\x5d\x98\x70\x1f\x2a\xcd\x4f\x73\x42\xcc\x02\xb2\x58\xcb\x24\x56\x44\xa1\x44\x2e\xf1\x72\xdc\xc0\x6d\x6c\x87\xf3\x49\x7f\xa9\xc6\x79\x48\x4c\xea\xb7\xc5\x12\x54\x44\xfd\x0f\xfc\x41\xd2\x96\x8b\xf3\xe6\xf1\x69\xe5\xac\xea\x9c\x52\xb3\xf1\xd7\x7d\xe3\x21\xe4\x82\x25\xf8\x8b\xf3\x01\xfc\x00\xab\x26\xe0\x27\x4b\xe3\xe6\xa4\xfe\xe9\x4b\xb3\x99\xfc\xc9\x34\x83\x23\x43\x2d\x39\x2c\x85\x06\x66\x9f\x0c\x6e\xe4\x9d\x6b\xf0\xec\x29\xd5\x50\x80\x5e\x49\xe3\x0e\xdd\x0c\xa9\xaf\x6b\x7f\x36\xac\x87\x33\x08\xba\x10\x1a\xc2\x7f\xb6\x56\x0b\xf0\x5b\x81\x98\xd7\x01\x1c\x51\xfb\x77\x2f\xc3\x38\x24\xda\x61\xc9\x1d\x81\xd9\x05\xdb\xc2\xeb\x31\x70\xe4\x13\xc8\x0d\xd1\xf5\xe9\xae\xed\xf4\x84\x96\xfa\xaa\x36\x0c\x39\x28\x74\x92\x91\xb3\xe9\x7a\xe9\xf2\xf6\x2c\xb6\xef\xfe\x56\x73\xff\xb3\x2f\x0d\xdd\x24\x15\xa9\x7f\x30\x11\x84\x6e\x52\xba\xb5\x45\x8a\xd9\x5f\x31\x36\x3f\x7c\x23\xb6\x39\x25\xb6\x39\x3c\xab\x4c\xf7\x62\xdb\x41\xd2\x7f\xe7\xf2\x0c\x5d\x0e\xf9\xb3\x06\x9b\x61\x96\xc4\x20\x64\x30\xcb\xfd\xa3\x91\xf6\xa7\x8d\x67\xb4\x39\x93\x04\xa2\x5c\x7f\xcf\xfa\xb6\x92\x90\x57\xbd\xc5\x27\x26\x96\xd8\xd5\xe6\xd3\x8b\x63\xa4\x08\x0c\x0e\xb2\xe0\xc4\xad\xf1\xea\xe6\xd5\x0c\x21\xe7\x88\x57\x4e\x61\xf7\xe2\x6a\x20\x16\x8d\xa9\x60\xca\xec\xf2\x0c\x85\xf1\x0f\x8d\xb1\xc4\xa5\xb9\x18\x0a\xec\x39\xf1\x50\x5a\xc2\x3b\x0f\xf1\x9a\x19\x7b\x23\x3e\x9d\xdf\x50\x3b\x1c\x51\x22\x50\xfa\x1c\x38\xe1\xf4\x1f\x61\x35\x1d\x7a\xd2\x81\x92\x6a\xbc\x5a\x6f\xf7\x22\x2d\xfe\x48\xe0\xdb\xa8\xda\xca\x23\xcd\x8f\x87\xa2\xce\x82\xa9\xaa\x1d\x0a\xe7\x17\x8e\x5e\x55\xd2\x58\x47\x06\x99\xb0\x30\x76\x20\xf3\xfa\x95\x58\x48\x27\x48\xee\x27\x94\xf5\x5f\x15\xe4\xf1\x8c\x7c\x61\xfb\x94\xbd\x2c\xed\x66\xe2\xb8\x63\xd9\x2d\x67\xd9\x92\xb5\xef\x04\xa5\xaa\xf4\x77\xa9\x0f\x1e\xb1\xc5\xe0\x02\xd3\x33\x3c\xfa\x8e\x5b\x05\x1a\x71\x99\xfb\x2d\x38\x05\x8c\x40\x4a\x36\x6e\x32\x82\xea\xc7\xb4\x07\x0a\xf7\x24\xa8\x6f\xe3\x4b\x1a\x75\x6c\xa1\x5d\x46\xf7\x06\xa5\xa3\xbe\xcc\x64\xc9\x55\x12\xe8\xe2\x17\x40\x23\xaa\x2e\x38\x6b\x09\x74\x38\xb0\x3e\xba\x62\x1f\x97\xfb\x23\xe6\xe1\x6d\x38\x34\x18\xc5\xb5\x1f\x66\x15\x24\x6b\xc4\x28\xf6\xa9\xe4\x05\x2d\x82\x1b\x1d\x44\x87\x58\x33\x9e\xfc\xcc\x3f\x5b\x7e\x48\x21\x26\x51\x23\x52\x79\xb4\x50\x2b\xf8\x95\x6e\x64\x51\xec\x90\xce\xbb\xc6\x24\x90\x2a\x0c\x58\x26\x9b\x7d\x0c\x8c\xeb\xe4\x7c\x35\x0b\x9e\x9e\x4f\xc2\x25\xbc\x6c\xdf\x30\xb5\x04\xd8\x16\x60\xc1\x88\x59\xd1\x56\xcc\x25\x07\x12\x56\xbd\x58\x46\x77\x17\xa3\x8f\x8c\xf8\x93\xda\x65\x9e\xcf\x6b\x1b\x99\xe3\x10\xb3\x73\xd6\xf5\x68\x8f\x1c\xb8\xac\x8d\x05\xb2\x92\xe4\x46\x50\x58\xb5\x06\x25\xf4\x46\xef\x0b\xde\x47\xc6\x4c\x31\x9f\x47\xa7\x61\x0c\xdd\xf4\x3d\xb7\xc5\x36\x11\x3a\x8b\x40\x56\x9a\x62\x04\xa4\x86\x19\xd1\x74\x1d\xdd\x2a\x49\x1d\xa9\xfb\x38\x99\x6d\xd1\xd4\x40\x08\x5a\x50\x38\x06\x3a\x0b\x76\xe3\x67\xde\xf2\xc7\x12\x1f\x1f\x6e\x00\x33\xfe\x2f\xfa\x54\x1f\x14\xd5\x3c\xad\x76\x25\xa2\x80\x78\xaa\x03\x8d\xe4\x6f\xbc\x6e\x5d\x68\xfc\x15\x0d\x50\x57\x10\x65\x4b\xc9\x96\x52\xf9\x6f\x06\xb3\x4b\x06\x22\x9f\xcb\x0f\x82\x57\x87\xbd\xe0\x43\x5f\x58\xe9\x03\x0a\xa1\x2b\x06\x5e\xdb\xc4\x39\x6f\x8a\xf6\x36\x0e\x75\x83\x57\xc0\x79\x11\x3a\x1c\xc4\x3c\xa6\x7f\x90\xa0\x87\xf7\x31\x62\x6b\x84\x07\xb8\x3f\xaf\xfe\x85\x58\x2b\xe3\xdc\xf5\x58\xa0\x46\xdd\x60\xf1\xbd\x78\x31\xea\x4e\x99\x05\xbe\x25\x87\xe9\xfb\xae\xb0\xcf\xd6\xb6\x0a\xb6\x55\x3c\xb3\x80\x26\x7e\x22\xf6\x58\x19\xf7\x1a\x6b\xd0\xa2\x2f\xff\x7e\x3c\xa4\x8b\xe5\xc9\xf3\x64\xc7\x93\x8c\xa3\xa6\x96\x0f\x5e\x1b\xf2\x78\xc6\x32\x5d\x8b\xfd\xd5\xfa\x20\x65\x20\x0c\x58\x08\x07\x14\xda\x35\x99\x55\x81\x9c\x6f\xe9\x6e\xf9\x11\x04\x97\xd9\x56\x1e\xb1\x62\xf0\xe7\xaa\x31\xfd\xeb\x4b\x93\xc2\x02\xf9\xe0\x76\x22\xf0\x98\xf5\x00\xab\x28\x1d\x01\xaf\x0d\xa6\x71\x8b\xbf\x4a\x5e\x48\x8e\xe4\x9e\x27\x9f\x95\x2b\x44\xed\x67\x4c\x53\x1c\xcf\x50\x73\x68\xe6\xb5\xfc\x22\x81\x4c\x7a\x89\xb1\x3f\x65\x85\x89\x6e\x07\x23\x09\xa9\xe9\xd4\x2e\xc4\x4b\xee\x7b\xd6\x0a\xb2\xb0\x71\x0e\xd5\xbb\xc9\x3d\xc2\xc1\x97\x85\x61\x16\xdb\x17\xb6\xb9\xd2\x7c\x72\x97\x71\xf4\xac\xa5\x6a\x61\x02\x72\x82\x42\xe1\xc4\x7a\xd7\xd1\x69\x0e\xc0\x12\xee\xcd\xb8\x1d\x2d\x8b\xba\x68\x91\x87\x3d\x27\x49\x8f\x9a\x53\x49\xd5\x98\x7b\x49\xd5\xd7\xeb\x97\x27\x6a\x3f\xcb\x38\x0b\x3b\x23\x03\x59\x7d\xf3\xde\x22\x6e\x3e\x15\xa2\x63\xb7\xd2\xae\xe0\x71\x3f\x90\xff\x06\xbe\x20\xe8\xee\xa0\x4a\xc1\x26\x71\x76\xda\x76\xa6\x19\x20\xfb\x88\xa2\xd2\x5b\xaa\xf2\x09\xcd\xe6\x0a\x0a\xe0\x0c\xec\xad\xf8\xcd\x29\x2b\x65\x62\x09\xac\xd6\xb4\x20\x48\x73\x69\xb1\x72\xe7\x10\xe3\x0f\x72\x9d\x25\xfb\xcf\x87\x8a\x8f\x08\xf7\xd9\x33\x86\xec\x91\x8d\x7f\x28\x15\x70\x98\x23\x60\xac\x4f\xd6\xaf\x91\xbe\xe6\x84\x5f\x9d\xc0\xe4\x8f\x12\xb2\x32\xab\xea\x38\x8a\x7f\xca\x75\x69\xb2\x7c\x36\x5a\x8a\xc9\x24\xbd\xab\xe6\x32\x81\xa9\xa5\x2b\xaa\x80\x28\x1e\xb3\x56\x1e\x19\x63\xfb\x37\x82\xe9\x39\x7f\x8b\x13\x5e\xbb\x36\xee\x16\x36\xf7\xf1\x52\x88\x70\xa3\x95\x01\x7d\x01\xf4\x24\xf1\xde\x00\x75\x87\x87\x22\xf6\x95\x10\xca\xa7\x28\x77\x8d\x8f\xa2\xe7\xa6\x4c\xcd\x94\x0d\xb7\xc0\xee\x6b\x50\xd6\x7b\x6c\xbc\x57\x8e\x98\xcb\x51\x69\x92\xb0\x49\xf6\xc2\x60\xce\xf6\xd0\xec\xe2\x5e\x7d\x29\x39\x2f\x27\x00\xc3\xc0\x38\x40\x7a\xb7\x1f\xe2\x0a\x2b\x02\xde\x7b\x61\xb7\x35\x18\xfb\x1f\x32\xea\x9d\xda\x8d\xb8\x0c\xe1\xeb\xd1\xcc\x78\x92\xd4\x67\x07\x38\x67\xfa\xaa\x01\xf2\x98\x98\x47\x8e\xf0\xba\xb2\x9f\x22\x74\x3a\xd1\xa5\xde\x9f\x7f\xe2\xee\xdc\x6e\xe3\x8a\xc8\x55\x5f\x9b\xb8\x65\x3e\x86\xd1\x60\x14\xc3\x0b\xe5\x1a\x80\x6c\xc6\xa0\xa7\xfb\xa6\x5c\xff\x3e\x2b\xb2\xef\x5d\x3f\xfd\x59\xc0\xc9\x21\x3b\xc9\xc0\x5d\x59\xa7\x97\x09\x62\x37\x2b\x00\x7d\xd3\x24\xe4\x7b\xa5\x9f\x73\x9d\xdd\x4d\x96\x11\x00\xc7\x1c\x9f\xca\x0e\xd0\x3f\x50\x94\xcd\x8f\x7d\xbb\xd7\xaf\xf9\x0d\xd2\x31\x04\xb9\x6b\x86\x82\x08\xdc\x8f\x95\xef\x87\xae\xfd\x94\x17\x80\x71\xcd\xbf\xb3\x8a\xc3\xb6\x28\xc3\xe9\x05\x9e\x8a\xbe\xc1\xac\x84\x1b\x80\x27\xc5\x84\xb0\xb9\xaf\x4d\xd8\x72\x63\x74\x11\xb8\x7c\x5b\xd1\xa5\xad\xfe\x3d\xda\xb6\x2c\x53\xed\xa9\x0c\x27\xc7\xfb\xfa\x2e\x86\xca\x5b\x10\x01\x45\xa2\x5b\x4a\x76\x5c\x45\x9e\x56\x7b\xf4\xf5\xc1\xfa\xc6\xd6\x6b\xb1\x3a\xfc\x6f\xa8\x02\x16\x79\x4e\x69\x6f\xb4\xca\x7d\xbb\xf9\x0d\xe1\xc9\x34\x61\xd7\x9c\x64\xf1\x16\xe8\xb3\x7b\xd1\xed\x5b\x33\x2c\x23\xb9\x3d\x6d\x26\x0a\x42\x96\xdf\x99\x60\x5b\xf6\x33\xbe\x97\xe7\x26\x12\x1e\xa7\xe0\xf2\x39\x86\x7c\xeb\xf8\xe5\x41\x56\x8e\xc0\x96\xf0\x9b\xfd\x07\x07\x71\xbe\xdc\x76\x1b\x2f\x66\xb7\x3d\x67\x49\x08\x87\x94\xf1\xb5\x63\x45\x0b\xac\x2d\x54\x8e\x56\xad\x67\x95\xa3\xf1\x2c\x1c\xc3\x46\xfe\x37\xaf\x34\xf2\xa2\xdb\x9d\x4a\xb6\x7f\xd2\x83\x18\x16\x10\x23\xc6\xb1\x44\x7c\x81\xe2\x83\x45\x13\x71\x0c\xe0\x70\x5d\xe7\x25\x4e\x13\x4c\xa9\x3c\x14\xa7\x57\x0a\xfd\x3c\x6b\xbe\x65\xad\xf0\xe6\xfe\x2a\xd5\xbc\x3b\x77\xbe\xeb\xb3\x9d\x24\x6b\x29\x74\x60\x26\x1f\x10\xef\xaf\xd7\xf7\x5a\xbf\x91\xd5\x33\x99\x1d\xa5\xaf\x33\x9f\x22\x02\xde\x24\xb2\x6c\x04\x56\x15\xa0\x27\x6d\x9f\x18\xc7\xd1\xd3\xb6\xc3\x5a\xa8\x8a\x82\x01\x51\xb8\x1e\x29\x72\x9d\x74\xb6\x3a\x33\x54\x09\xbe\x39\xc6\x9e\x69\x4b\x91\x3e\x69\x5a\x45\xcb\xdc\xc4\xe8\xf7\xe0\xa2\xc6\xd0\x3b\x55\x67\xc9\x38\xb2\x59\x66\x7a\x2c\x9b\x33\x7f\xae\x2b\xd1\x66\x5d\xd0\x40\x93\x0e\x3e\x50\xdf\xed\x5d\x44\xa4\x0b\x60\xfa\x5c\xd1\x74\x99\x90\x3b\xcc\xd6\xe1\x74\x9c\xf2\xf8\xda\x0d\xa6\x1a\x5c\x28\x40\xa8\x21\x87\x8a\xc4\x05\xdd\xba\x34\xe8\xe3\x97\x39\x9f\x60\x2b\xf8\x36\x29\x25\xbf\x71\xfa\xb5\xcb\x40\xf7\x57\x19\xc6\x6c\x7f\x89\x06\xee\xe9\x3a\xa1\xde\x92\x6a\xa5\x48\xc6\xf8\x96\x64\x9c\x1a\x4c\xf0\x02\x76\xf2\x8c\x54\xa1\x4b\xd0\xc0\x9f\x2a\x2b\x36\x36\x8e\x4a\xc4\xd9\x13\xfe\xca\x02\xf6\xea\x4a\x65\x36\x5f\xc9\x69\x60\xfd\x2e\xc9\x01\xbe\x97\xdc\x50\x2f\x39\xbc\x34\xd5\x23\xbb\xd6\xd1\xf3\x87\xe6\x06\xa0\x46\xc5\xce\x11\xe6\x46\x04\xdc\x50\xc9\x0a\xa7\xca\xf5\x86\x77\xf2\xce\xc7\x4d\x35\x40\x17\x92\x5b\xd2\x31\x07\x5c\xd9\xa4\xf0\xe4\xa2\xf9\x20\xb0\x0f\xa9\x67\x5f\xfa\x7c\x96\x4c\x0c\x50\x07\x01\xf4\xf7\x6c\x99\x2f\xa7\x35\x84\x70\x5b\x66\xe7\xc9\x05\x53\xb3\xac\x8b\xa2\xfa\x78\xab\xa8\x1a\x94\xfc\x10\x24\x7d\x17\x75\xc9\x05\xe4\xca\x5a\xc1\x2d\x64\x5d\xcb\x4f\x28\xe3\x9e\x30\x3b\x4b\xfa\xdc\x65\xea\xd5\x1f\xf4\x32\xe6\x04\x9a\x4d\x5c\xf5\x11\xae\x3a\x7a\x57\x1b\xb6\x14\x01\x59\x49\xda\x51\x3b\xa3\xe9\x7b\xae\xbf\x48\x64\xe7\xa0\x43\x75\x0e\x7b\xe1\x21\xe8\xa7\x01\xb0\xea\x8c\x77\x75\x28\xbf\x1f\xef\x32\x9e\x29\x73\x3c\x32\xe6\x7a\xa3\xae\x67\xe1\xca\x84\x2e\x82\x30\xdb\x07\xa1\xfc\x33\x63\x26\xf3\x0c\x6a\xca\xab\x78\x5b\xac\xa3\x2c\xbe\x9c\x59\x56\x1a\x0d\x70\xa4\x65\xf5\x46\xf3\x52\x93\x71\x5d\xe6\xb3\xba\xef\x7d\xcc\xfb\xea\x37\xf9\xed\xbb\xaa\x21\xc5\x53\x93\x48\x57\x63\x7f\xa6\x9a\x9d\xc0\xdd\x11\xe9\x08\xd5\x22\xc7\xc3\xfc\xa8\x32\xad\xed\xf3\x2c\x66\xc6\x44\x14\x2b\xf2\xb5\xa8\xb8\xd8\x37\xb8\xd1\x12\x62\xfd\x9a\x5c\xf2\xe3\x62\x94\xc7\x02\x87\x45\x84\xb2\x80\x50\x9e\x70\x22\xc5\xa9\xc3\xa9\x4e\x50\x5f\xad\x7b\x6d\xaa\x4f\x15\x9a\xe9\xeb\x55\x04\xc4\x23\x3f\x48\x0c\x51\xd9\xf1\x85\x46\x5d\x8c\xaf\x7a\x27\x6e\x11\x1f\xbf\x2d\x94\x2b\x8e\xe7\xac\x0d\x9a\x69\x7e\xeb\x8c\x57\x3e\x1d\x7f\xa9\x72\xd1\x1a\xcc\x4a\x03\xae\x6e\x74\x61\x29\xd3\x3f\x79\xf7\x1d\x2e\x87\xdd\x36\x68\x5b\x3d\x2f\x78\x96\x2a\xbd\xa4\x5c\x3a\x4f\x1f\x52\xec\xea\x0f\x62\xb7\x84\x33\x87\x9c\x59\x6f\x18\xaf\x59\xf5\x91\xe6\x8a\x83\x98\x91\x69\x29\xd3\x1f\xfd\x7d\x4e\x6f\xa7\xf8\xb9\xb9\xcf\xb1\x5f\x17\xfc\x4d\x7f\xfb\xb5\xdb\x6f\x5e\x85\xc7\x34\x5f\x9f\x5d\xf2\x46\xd0\x42\x05\xe1\x95\x2e\xa4\xb9\x8b\xc0\x9a\x0c\xd6\xfc\x00\x0c\x6b\xaf\x72\x26\x62\x79\x64\xf6\xd0\xf8\xf2\xac\x46\x4d\x0a\xba\x4c\x58\x5a\x9b\x03\x85\xca\x89\xa1\xdb\x02\x13\x62\xdf\x8c\xdc\x80\x21\x9c\x46\xbd\xd6\xe9\x67\x93\xbd\x71\x06\x75\xdf\xc6\x45\x18\x11\xc5\x80\x16\x51\x65\x33\x74\x51\x02\x62\xde\xc2\x7c\xd0\xf1\xa0\x97\x47\x49\x46\x42\x36\x29\x4d\x31\xfd\x56\x52\x65\xe1\x33\x93\xa4\xeb\xa2\xd6\x78\x3c\xf6\x81\xc7\x4a\x7a\xe1\xcf\x6e\x14\x7e\xb9\xb2\xfd\xba\x57\xfd\x9c\x52\xee\x3b\x25\x62\x84\x0d\xa0\x4a\x8c\x90\xbb\x00\xbc\x80\x1a\x42\x74\x3d\xe8\x88\xef\xb5\x65\x4a\x6a\x5f\xbe\xa6\x62\x76\xc9\x53\x59\x08\x7d\x17\x52\xba\x4d\xce\x65\x08\x2c\xb5\xcb\xf6\x6a\x32\x9f\x16\xce\x39\xab\xef\x65\xec\xdd\x92\xc0\xe5\xcc\xd0\x77\x65\xac\x0e\xdf\x5c\x2a\x77\x65\xc9\x89\x56\x2c\x67\x95\xbb\xfc\x83\xb3\xc6\x30\xe6\x27\x94\x0d\x6f\x03\x3b\x67\x8e\x1b\xdf\x18\x61\xc9\xe0\x50\xd9\x48\x2d\x4c\x7d\xf9\x56\x9c\x01\x50\x00\x15\x64\x44\xcc\x08\xa1\xad\xde\xee\x6a\xc7\x71\x65\x7d\x5f\xb4\xed\x5d\x2e\xa7\x92\x33\x98\xdf\xb8\x28\x83\x68\xa1\xee\x4f\x23\xe8\x16\xa2\x6c\x8f\x8c\x0b\x51\x38\x79\x3c\x2b\xa3\x94\xf2\xa1\xc8\xe4\x01\x27\xa7\x26\x91\xde\x99\x19\x3b\x65\xd0\xc5\x5a\xf6\xc8\x39\x4d\x24\x37\x7a\x89\x16\xeb\x55\x58\x7b\x77\x45\x06\xed\x52\xad\x13\x75\x56\x22\x94\x01\x3c\x37\x5a\xea\x90\x8b\x5c\xaa\xc7\x6e\x25\xed\xd5\x58\x6b\xe8\xb0\x25\x2f\xc3\x29\xb2\x62\xcf\xe1\xfd\x66\xec\xb3\x55\x46\xb3\xaf\x0a\x32\xd5\x1e\xb0\xa0\x9f\xdf\xd0\xa4\xcd\x8f\x5d\xc1\xf1\x45\x1e\xbf\x44\xa0\x4a\x7b\x52\x09\x1d\x85\xe5\x58\xae\x21\xb4\xce\xf6\x3d\xd2\x8d\x6a\x3c\x85\x73\x83\xf4\x11\xc4\x3e\x8e\xeb\x43\xfb\x9d\xdb\xa1\x7f\xd8\x61\xc2\x0f\xf1\x14\xe4\x16\x8a\x61\x4e\xb6\xa3\x4f\xc6\x9d\xe5\x65\x37\x3f\x24\x80\x04\xcb\x2c\x70\xbf\x79\xfb\xaa\x58\x61\xd6\xb6\x96\xe2\xb3\x61\x03\xe3\xe2\x0c\x01\xe2\x5b\x3e\xa1\x30\x9a\x8f\xef\xf3\x76\x64\x16\xf7\x67\x1e\x64\xd7\x38\x1b\x45\xcc\x7a\x0c\x12\xf8\xa5\xdb\xf3\x9f\x74\x4a\x7c\xe1\x96\x2b\xbe\xa7\xf1\xfd\xc9\x49\x4e\x4b\xc9\x61\xf1\x6b\xc5\x3c\x75\x44\xe0\x2b\x63\x03\x3d\xf8\xbb\x44\x9a\xcb\x8e\x86\xe1\x12\xaa\xb9\x5c\x96\x05\x3a\xef\x97\x0b\x8b\x75\x26\x3c\xed\xd3\xaf\x44\xbe\xff\x4d\xe3\x60\x3e\x9e\xef\x64\xec\xfd\xa1\x50\x73\x30\xcc\x88\xb7\x90\xcb\x51\xcb\xb4\xd6\xf0\xa7\xba\x5a\x19\x11\x63\xd4\x03\x3d\xed\x92\xdf\x5b\x0b\x24\xdb\x70\x16\xfb\x20\xcc\x8f\xde\xee\xac\x46\x8f\xbe\xc3\xc3\x8b\xdb\x94\xad\x4f\x39\xf7\x1f\x2f\x3c\xe9\xf5\xb8\x7d\x8d\x14\x79\xcb\x52\x67\x43\xf1\x73\x92\xf0\x83\x6b\xa5\x17\x21\x7c\x90\xee\x2b\x90\xcc\x21\x2f\x79\xbb\xfb\xab\xe9\x7c\x71\x40\x61\x60\x90\x0e\x1b\x4c\x36\x26\xd8\x78\x31\xde\xd0\x88\xe1\x59\x46\x69\xdb\x96\x68\x27\x86\x0d\x24\x96\x21\x48\xd0\x09\xd0\x7c\x14\x16\xc6\xe1\xd5\x51\x18\x1a\x3a\x04\x1d\x36\x9f\x0e\x51\x4d\xbd\x19\x6c\x6e\xcb\x79\x7c\x01\x0d\x0d\x8c\xdd\x6f\xa5\x25\x58\x14\x04\xba\x08\x24\x00\x32\xf4\x58\xef\x29\x3b\x2f\x7f\xe7\x86\x8c\x21\x3e\xf4\x99\x04\xd3\x63\x0d\x15\xea\x5d\xc7\x20\x36\x24\xb1\xec\x58\x52\x59\xb4\x1d\x3b\x32\x94\x1d\x5b\x45\x97\x7b\x2f\xe7\xa8\xe4\x64\x39\xbd\x80\x14\x28\x48\x26\xf4\x9c\xeb\xba\x43\x68\x22\xd3\x8c\x7a\x54\x42\xb4\x33\x79\xc1\x39\x99\xff\x56\xef\x0a\x98\x7d\x1b\x50\xb1\xbe\x2b\xfd\xf3\x12\x9a\xfb\x12\xe9\xd3\x4d\x78\x99\x95\xc3\xbd\x17\x6b\xc4\x64\x5c\xa7\x82\x5c\x25\x9d\x65\x07\xca\x67\x38\x36\x9a\x81\x8e\x69\xcd\xa4\x83\xab\x6e\x02\x80\x71\x98\x95\xbd\xbc\x5e\x62\x2c\xea\x93\x50\xa7\xaf\x7a\x81\x64\xa7\x09\xbf\xf7\x6a\x2c\x4f\xca\x2e\xea\x16\x88\x3c\x22\x19\x54\x84\x6a\x82\x9f\x75\xa0\x9f\xcf\x89\xa9\x63\xd9\x20\x4d\xd1\xe8\xa1\x18\x85\x46\x37\xe5\x99\xf6\xe6\xab\xa1\xb7\x8f\x4f\x4c\x6f\x10\x7b\x73\xc0\x8c\x17\x65\x6a\x19\x2f\x59\x17\x8d\x8c\x48\xbb\xde\x06\x6f\x88\x32\xfd\xe5\x09\x19\x2f\x7a\x15\xa8\x82\x7d\xa6\xce\x75\x22\x11\x1b\x96\xc1\x46\xcb\x58\x8f\x94\xa9\x8c\xd4\x9a\xfd\x1c\xdb\x18\xcc\x10\xe3\x35\x7f\xb9\xd8\x57\xdf\xd2\x9b\xe0\x22\x1a\x3e\x58\xc6\x2a\xe0\x83\xf2\x57\x78\xc5\x47\xd9\xdd\x0d\x6d\x39\xa2\x9e\xa5\x44\x5b\x5e\xd2\x00\x34\x4f\x25\x41\x0d\xb0\x4a\x5f\x9a\xd2\x6e\xe4\x3a\x8b\x82\x3a\x94\xd9\x4c\x11\xfb\x5d\x2b\x57\xcd\xf5\x5e\x3c\xb4\x9f\x27\x8c\x26\x11\xe6\x10\xdf\x16\xfd\x9b\x2e\xb7\x58\xeb\xed\x6c\x2e\x69\x97\x2b\xcf\x6b\x4e\xf6\x85\xeb\xf3\xd1\x91\x83\x89\xd6\xaf\xc1\xe3\x63\x52\xb1\xf2\xfa\x3c\x3d\x77\xa3\xb9\xbb\xb4\xf0\x1f\x2c\xe3\xc6\x2e\x73\x7e\x13\x51\xa4\xbd\xab\x22\x6e\x56\x19\xc6\xd7\xec\xbd\xef\x5b\x9e\xe3\x3d\xdd\xfa\x96\x18\x84\x6d\xd8\x36\x97\x1a\x43\x7c\xb3\x26\x5a\xf7\x85\x06\xd1\xd2\x80\x57\xf1\xfb\x0b\x6d\x4b\x77\xa7\x98\x89\xae\x28\xbe\xbc\xe8\xd3\x68\x47\xb5\xd9\x33\x74\x57\xfa\x35\x31\x3a\x3b\xc8\x5f\xa5\xbe\xcc\x32\x74\x9d\x76\x1b\xd5\x37\x83\x3b\x48\x99\xb3\x8a\x46\xa9\x30\x83\xe7\xa1\x0e\x49\x6d\xd1\x6d\xb6\x08\xa7\x32\x83\x6f\xf7\x3d\xdb\x1c\xe0\xf2\x5a\xbc\x0e\x17\x94\x70\x25\xe7\x6a\xf6\xaf\x6e\xba\xfd\xf4\x42\xc7\x2d\x82\xdf\x0f\x8c\xbd\x20\x15\x78\xb3\x75\x3e\x17\xca\xe6\xaf\x5a\xce\xee\x72\x4d\x6f\xd1\x5d\xb4\x79\xa3\xa2\x93\x79\x78\x8a\x35\x2b\x07\x90\xae\x12\x4f\x21\xa2\x53\xb1\x22\x5c\xdf\x28\xc3\x21\x29\xe6\x63\x75\x9a\x88\xeb\x5d\x44\x67\x61\x54\xa0\xc7\x8e\x46\x07\x4f\x38\x46\x7d\xb3\x75\x86\xa8\xa6\x2c\x91\x21\x35\x8e\x14\xb9\x87\x8d\x39\xbe\x66\x7d\xbc\xfc\x54\x55\x92\x60\x68\x31\xf4\x01\xd4\x98\x0f\x27\x03\x42\xfe\x78\x4c\xf2\xab\x8d\x8c\x7d\x61\x3f\xa9\x34\xd3\xdf\xce\x2b\xe7\xf0\x5c\x1c\x3c\x16\x2e\x46\x43\x8a\x7c\xc4\xa4\xc4\x70\xe8\x34\x84\xdb\x08\x2a\x60\x55\xf7\xbd\xd1\xe5\xec\x56\xc2\xe0\xfd\x48\x84\xa8\xce\x68\xd4\x85\x18\x1d\x33\x48\xf1\xd1\x75\x36\x6e\x2d\xe4\x0b\x37\x3a\x6e\x0c\xf3\x66\x3e\x66\xe9\xde\xc8\x3c\x8d\xc9\x57\xce\xc1\xb3\x5d\xc9\x84\x86\x70\xe1\x0c\x2c\xcb\x11\xd5\x8f\xd7\x5b\xcf\x52\x15\xe1\x0d\xb4\x78\x02\x49\xc4\xfd\x43\x9b\xb6\x36\x50\xdf\xc5\xf4\x0b\x99\xdb\xd6\xb3\x1a\x75\x28\xf0\x08\x9e\x7a\x72\xdd\xe7\x05\x26\x0a\xe0\xe4\xcd\xe5\x91\x2d\x41\x0f\x1d\x4d\x2f\xdd\x06\xfc\x0a\x72\x65\x5f\x7a\x45\xf0\x7d\x52\xfb\xe1\x4d\x9e\x4a\xf8\xb0\x43\x53\x88\x82\x67\x13\x5e\x05\x65\xc2\xf5\x2e\x87\xb1\x3b\xb5\x42\x5b\x39\x5f\x97\xa7\x9c\x6d\x3c\x06\xa1\x6c\x85\x31\xaf\x5c\x41\x7e\x7e\xd6\xff\x30\x27\x67\xa2\x1e\xff\x15\x2c\x5a\xd0\x44\xd6\xe9\x7e\xb8\x66\xed\x53\x8b\x55\xf7\x31\x54\xdb\x6d\x14\x0a\x36\xf8\x3e\xd3\x95\x4b\x19\xe0\x00\xe8\xc0\x1c\x2e\x20\xec\xdb\x01\x53\x4d\x99\x68\xed\x1f\xa1\xed\x65\x08\x21\xe2\x23\x45\xb2\x6b\x62\xb3\xd1\x65\x7f\xc8\x6c\x06\x7d\x33\x02\xb9\xe9\x40\x3a\x75\x17\x96\xbf\x73\xef\xdd\xe5\x33\xfa\xd0\x22\x8e\x1f\xf4\x24\xfd\x8a\x0a\xe6\x1d\x04\x8b\x05\x40\x01\xd7\xfc\xc7\xa1\x03\x04\xb9\xb8\xa7\x5c\xa4\xbc\x92\xc0\x8d\x3c\x0f\xed\xd3\x86\x9a\x6d\x4b\xf8\x02\x19\xa9\x8a\xf5\x4d\x1a\x2c\xb7\x1f\x60\xd7\x09\x3b\xc5\x92\x0c\xee\x36\xba\xe5\x6d\xa4\x67\x80\xfc\x9e\xc4\x60\xbb\x68\x56\xb4\x18\x53\x72\x5f\xc9\x82\x45\xaf\xa4\x56\xb6\x67\x55\xd0\xe9\xa9\x12\x2b\x9e\x59\x63\x9a\x47\xc3\xe6\x48\x31\x1a\x25\x4d\x98\x3f\x90\xea\xdf\x72\x2d\xd8\x4d\xff\xf6\xf6\x15\x6a\x80\xd2\xc0\x5e\x88\x99\x8c\x19\xd7\xbd\xdc\xea\xf1\x58\x09\xaa\x01\x4d\xc5\xc1\xdd\xa2\x81\xe8\x25\xd5\x77\xd6\x1b\x72\x65\x43\xcf\xbd\x07\x32\xf0\x5e\xbf\xaf\x1d\xa2\xda\x96\x09\xc5\x05\x31\xba\xe2\x11\x29\x0a\x89\x8c\xdd\xa5\x41\x0f\xb9\x13\xf3\xde\x97\x08\x93\x47\x4d\x19\x06\x82\xbb\xda\x8f\xa0\x95\x16\xe4\xb2\x92\x65\xc4\x75\xae\x95\x53\x7c\x90\x33\x89\xe4\x85\x18\x5c\x34\x19\xf1\xbc\x79\xb8\xfc\x66\x3f\x96\x3e\xad\x19\x96\x00\xc4\x9f\x4a\xc7\x53\x20\xdd\x0b\xdd\xce\x87\x56\xa2\x45\x14\x1d\xd4\x4b\x81\xb0\x67\x1d\x8f\xa4\xaa\xdd\x5e\xa6\xc0\x9a\x4a\xa2\xbe\xb2\x35\x15\x84\xfb\xef\x6f\xb4\x33\xf4\x57\xa4\x08\x07\x00\xfd\xa2\x44\x4a\xb4\xe5\x7a\xde\xa2\xc6\x65\x9a\x2f\xf6\x32\x4f\xb8\x37\xb3\xb4\xda\x75\xbb\xe8\x12\x89\xbe\x1b\xb6\xd4\x2f\x87\xee\x1e\xf3\xfc\x79\xda\x49\x24\xd0\xe1\x4d\x59\xda\xad\xf6\xf5\x65\xed\xc2\xeb\x9e\xc2\x80\x2e\x7e\x7a\xc4\xf6\x9e\x3b\xd7\x91\x22\x97\x7b\x10\x3a\x6e\x09\x24\xe6\x10\x7d\x64\xdc\x84\x04\xb0\xf9\x0c\x70\xa5\x00\x08\x63\xc0\x98\xf5\x3d\x7e\x16\x95\xa9\xa0\x7e\x52\xb6\x8d\x44\x21\x3f\xf3\x13\x26\x31\x89\xb9\x03\xee\x0f\x6a\x80\xfe\xdd\xd8\x3f\xa6\xf6\xd2\x63\x15\x84\x39\xbf\x6d\xed\xc8\x47\xda\xdd\xba\xc4\x5a\x72\x7c\xe1\x91\x14\x53\x0e\xd4\x69\xfb\xbb\x70\xdd\xd2\x5d\xa1\x3a\xc3\xd0\x84\xbb\x57\x15\xc8\x4e\xe6\xd2\x0c\xae\x7e\x83\x0c\x93\x68\x01\x43\x33\xed\x4a\x97\x56\xf5\x50\x31\x24\xf5\x62\xf8\x90\x25\xce\x5b\x60\xf8\xfd\xa3\x4e\x53\x40\xbd\x2c\x60\x52\xe5\xb1\xfe\x73\xaa\x4b\xb3\xdd\xba\xed\x2f\xba\x7e\x1d\x48\xb1\xcc\x59\x1d\x16\x22\x3e\x72\x76\xad\x6d\x24\x33\x78\x37\x67\xc3\x5d\xe4\x53\x84\x70\x78\x80\x97\x05\x14\xa2\x93\x9f\x53\x7e\x9d\x37\x0e\x77\xa9\x18\x2a\xdd\xbb\xac\xda\xf6\x24\x30\x62\x26\x6f\xe5\xe3\xfa\x18\x3c\x31\x49\x43\x0d\xcf\xb9\x8b\xfa\x0e\xaa\x95\x82\xfa\xfa\x09\x9c\xf7\x58\x5b\x97\xbf\xed\xd4\xbf\x4f\xad\xef\x7b\x25\xa7\x0a\x6e\x6a\xe7\xf2\xe8\x83\x61\xb4\x9b\x0f\x2f\xdc\x28\x11\x40\x77\x05\xee\x96\x32\xfc\x55\x12\x29\xe0\x62\x39\x9e\xe8\x87\x39\x36\xe2\x62\xf8\x9c\xcc\x38\xb2\xbc\x40\x4d\x41\xfb\x29\x29\xf4\xcd\xc0\xf1\x2f\x32\x40\x94\xfb\x08\x28\x8f\xa2\x98\x1c\x5e\x11\x1c\x9a\xfb\xea\x7c\x89\x94\xa7\x2f\xb6\xaa\x7b\x0a\x45\x39\xeb\x33\xdc\x4e\x10\x06\x50\xf0\xcd\x88\x02\x55\xc7\xb7\x4b\x1a\x60\x85\xfc\x17\xf5\x2a\x3f\x3f\x97\x0b\x6b\x56\x04\xd5\xe3\x94\xd5\x31\x0c\xe9\x4b\x65\x90\x52\x55\x4f\x4f\x06\x6b\x7d\x77\x74\x2b\xe5\xfe\xac\x89\xaa\x1f\x4d\xad\xf4\xdb\x69\xdf\x8e\xe9\x31\x85\x4a\xb7\x3a\x33\x6b\x8b\x41\x99\x30\x3f\xae\xa5\xf8\x36\x6a\x89\xc2\xd5\x81\x98\x0c\xd8\x08\xf8\xe5\x8e\x0c\xd6\xb5\x2c\xea\x26\x89\x83\x5b\x2f\x98\xad\x8e\x3b\xf9\xbc\x83\x5a\x25\xbc\x3b\x4e\xc1\xae\xe7\xb8\xa8\x39\x49\xa3\xe9\x52\x40\x86\xb1\x92\x77\xe7\x6f\x24\x8e\xe9\xb7\x30\xc9\xe7\xa4\x54\xdb\xb2\xbb\xfd\x10\xd3\x33\x5e\xc0\x19\x93\x91\x14\x1d\x49\x13\xa2\x26\x0e\x52\xe0\x62\xf5\xa4\xb3\xb6\xc2\xbc\x43\xdf\xd8\x7e\xfd\xe6\x45\xfa\x11\x0b\x46\xbe\x29\x6a\x42\x89\xf7\x7b\x0c\x7a\x7b\x6c\x8d\xb9\x92\xa5\x7a\x39\x0c\x44\x5d\xca\x90\x7c\x26\x5e\x18\xb0\x37\xcc\xa6\x9b\x2f\xf2\x20\xa8\xee\x7b\xd6\x04\x90\x9b\xfd\x4b\x97\x46\x6b\x9e\xf4\xb4\xd5\xd6\xfe\xf1\xf4\x2e\xe0\x5f\x50\x99\xe7\xc9\x5f\x69\x9c\x23\xa7\x7c\x4a\x05\x0d\x68\x03\x02\x40\x89\x32\xb9\x7f\x80\xec\x12\x8e\x3d\x90\x81\xe4\xee\xc7\x03\x0b\x85\xc2\x60\x40\xef\x96\xeb\x64\x07\x86\x0d\x82\x1b\x26\x35\x29\xfb\x3c\x13\xdb\x46\x5d\xfb\x00\x35\x57\x6c\x20\x2d\x4b\x7c\x7a\x2e\xb1\xb1\x7c\xd0\xce\xb4\x8c\x76\x85\x16\x79\xd1\xf8\x6b\xb5\xdf\x63\x18\x90\x0d\x1e\x30\xdc\x5b\x06\x03\xec\x4b\xcb\x6e\x3e\xe5\x4a\x0e\x7f\x6b\xb8\xfd\xb1\x62\xdf\x78\x8b\x0f\xc5\xd1\x84\x92\x67\x03\xed\x50\x27\xda\xd0\x54\x33\x78\x7f\x29\x6c\x07\x61\xa3\x01\x60\xf8\xd0\x29\x1c\xa9\xa8\xd7\x5d\x15\x6c\x31\xd8\xfc\xfc\x57\x6c\x6f\x06\xb4\x31\xac\x22\xfe\xe1\xdd\xbb\x28\xee\x67\x1f\xad\x38\x89\x2c\xf1\xc6\x42\xea\xe6\xf5\x3b\xb7\xe5\xe4\xe4\x38\x74\x7f\x5c\x9b\x75\x20\x71\x03\xe1\x6e\x6f\xc6\xf6\x3e\x30\x2b\x85\x31\x9c\x8c\x89\xe8\x49\xf3\xff\x7d\x14\xae\xc3\xc5\xea\x1a\xb1\xbb\xf3\x88\x32\x4b\x5e\xe3\x8a\x08\x9f\x6a\x19\x83\x72\xa1\x9b\x43\xaf\x96\xaf\x4f\xbc\x3e\x18\x06\x03\x7b\xa6\x77\x09\x46\x86\x33\x3f\xec\xfb\x1f\x98\xe5\xce\xa9\x03\x65\x21\x5a\x66\xe7\xe6\x0a\x6d\xc9\xab\x53\xcf\x9b\x8d\x56\x31\x5d\xf4\x68\xdd\xfe\x74\xfd\xe3\x01\x0d\x73\x4a\x2a\xff\xb3\x8f\x6b\x9f\x94\xdb\xc2\x45\x3c\x2a\xce\x7b\xf6\x5e\xad\x96\xfa\xda\xcd\xf3\x2a\xe4\xc1\xa1\x61\x71\x8d\x07\x33\x55\x41\xd2\x9d\x20\x7d\xb1\xc4\xbb\xc3\x77\x97\x2d\x14\x64\x71\x11\xf5\x45\xb8\x00\x0c\x6e\xd2\xbf\xef\x30\x5b\x25\x07\xa7\xdf\x8e\x0f\x70\x75\xe5\x4d\x99\xf6\xcf\xd9\x3e\xdc\x92\x53\x92\x7a\x2c\xf6\x32\xb5\xb0\x91\xfc\xf3\xa1\x62\x2f\xe8\x4e\xa8\xf0\xdc\x46\x0d\x57\x56\x0e\x6c\xed\xe2\x57\x71\xd8\xbd\x1c\x60\x7b\xe3\xce\x7e\x3e\xbd\x99\xec\xf5\x23\xdb\xf5\x5d\x29\xb4\xee\x86\xcd\xdf\x4d\x0a\x7c\x33\x57\x56\xf4\x07\xeb\x40\x61\x66\xea\x0e\xdb\x45\xe6\xb2\x05\x72\x8b\x92\x42\xe5\xfa\x61\xf6\xd8\xbd\xa8\xda\xaf\xc1\x4f\xae\x36\x8f\xed\x5a\x41\x88\x2b\x8a\x6f\xc5\xc0\xea\x7e\x21\xbb\xe1\x9b\x97\xa8\x20\xa5\x3e\x5f\xea\x45\xf3\xdb\x4c\xea\x47\x3d\x34\xfe\x04\x37\x55\x08\x2a\x98\xc7\xf5\x7a\xc5\x27\x88\x62\x73\xa1\x1a\xbb\x5c\x70\x9f\xc3\x56\xea\x17\xfe\x77\x96\xae\x41\xc7\xb2\xd5\xf8\x5b\x94\x39\xf4\xcb\x30\x5d\x78\xe8\xf5\xc1\x68\xe2\xc0\xd9\x98\x69\x68\x74\xb6\xfb\xce\xdc\x23\x84\x69\x8d\x6c\x93\xe1\x02\x45\x9a\xda\x44\x71\xfc\xf5\x1a\x54\xde\x67\x9c\x8d\x27\x75\xbf\xe9\xee\xc6\x11\x73\xbf\x97\xa9\x6d\x6b\x03\x75\xae\x36\x3f\x5c\xe9\x06\xad\x4f\x41\xb2\x1f\x52\x85\x7d\x73\x6e\x68\x8c\x2b\xe0\x90\x76\xa5\x33\x05\x74\x2c\x25\xbc\xb8\x36\xfb\x5c\x88\xfb\x4f\xc9\xfa\x4e\x8f\x90\x20\x61\x36\xd9\x1a\xf3\x19\xe0\x81\x88\xdc\xa6\x7d\x5f\xf6\x39\x87\x7d\xe2\x24\x87\x3c\xcd\xfe\x78\x03\xae\x77\x6f\xbd\x91\x77\xc1\xa1\x14\xb2\xd0\x03\x71\x99\xbc\x2b\x30\xd8\xbf\x68\xa4\xeb\xaa\x9a\x0d\x46\xc4\x01\xeb\xf2\x0f\x6b\x82\xc6\xb5\x57\x8a\x6c\xca\x72\xc2\x77\x94\x2c\x27\x78\xa6\x78\xf8\x2a\x1a\x7b\xe3\xde\x1f\xad\xb7\x2c\x3d\xde\x0a\x7f\x29\x35\x1e\x4c\xaf\x22\x44\xf1\xd1\x28\xab\x9d\xf1\x4f\x07\x36\xeb\x09\x15\xf4\x40\x57\x94\x7c\xa4\xf8\x06\x0b\xc0\x3f\x17\xd2\x3e\x2f\x31\x84\x55\xd1\xae\x11\x3d\xb9\x60\xdc\x30\x3a\xc1\x5c\x0a\x54\x5a\xf2\x5b\x1a\x24\x42\x56\xac\xb9\x6a\x1b\xcd\xaf\x73\xce\x9b\x25\x60\xbf\x29\xe5\xed\x5a\x7c\x51\xc9\x0f\x4c\xe7\x70\x6b\xbe\x0e\xe3\x1a\x40\xe8\x94\xa7\xd8\x05\x25\x2e\x85\x7b\x8d\x95\x1e\x08\x95\x3c\x02\xab\xf0\xac\xfd\x84\xa1\xa0\xd9\x89\xaa\x9a\xee\xce\x36\xa1\x59\x82\x63\xdf\x7d\x78\x62\x1f\xf1\xdb\x05\xc0\x70\x7e\x10\x4c\xbc\x9a\xcc\x48\x81\x4e\x8a\x60\x1f\x30\xae\x17\x6c\x99\x68\x0a\x53\x36\x2f\xae\x73\x2c\x1a\xda\x30\x00\xa7\xef\x90\xfd\xd5\x1a\xde\x80\xfa\xc2\xb1\x26\xb1\x32\x27\x71\x83\xd8\x51\xe7\xe7\xd7\xc7\x10\x66\x21\xc9\xc0\x5b\xad\x9c\x94\x0b\xd7\x89\xf0\x81\xbf\x4a\x8a\x25\xb3\x31\x7d\x5b\x11\xd8\x7e\x87\xd4\x8d\x93\x8b\xd4\x99\x5a\xd9\x80\x90\x59\xc3\x9e\xc2\x1c\x5b\x6e\x0a\x20\xb9\xb4\x9a\x97\xee\x38\xc7\xce\x33\x93\x07\x82\x49\xa3\x79\x2e\xb4\xaa\xa6\x95\x73\xf7\x70\x8a\x3c\x32\xaa\x11\x7b\x05\xfb\x51\x49\x28\x23\xf0\xae\x1e\x7e\x8e\x4e\x63\x2f\x19\x31\x1b\x8f\xe1\x0d\xfa\xa5\xdb\x1a\x7a\xc2\xfe\xae\xa6\xcc\x54\x95\xb5\xe1\x14\x73\x96\x0c\x82\x55\x07\x0e\x28\xeb\x01\x77\xa9\x20\x45\xad\x77\xd3\x44\x43\x39\x4c\xfd\x24\x59\x28\x6f\xb2\x6d\x75\xcd\x04\xeb\xf6\xbe\x15\xa1\x25\x31\xc1\x57\x5b\xe1\x79\x21\xdd\x17\x21\xd7\x57\x4e\x0f\x6f\xa7\x64\x19\x1b\xfa\x51\xb1\xf7\x03\x2f\x97\x66\xaa\xee\x4c\x6d\xb6\x77\x34\x90\x22\x21\x7d\x83\x3f\xc0\x1b\xc6\x8d\x9a\x73\x5f\x4c\xf3\x4c\xb6\x13\x14\x18\x03\x9d\xb6\x51\x67\x20\xad\x0c\x12\x6c\x27\x3f\x71\x3b\xcf\xb0\x93\xd4\x3a\x5b\xbd\x70\x07\x44\xab\x8f\xec\x31\x47\xea\xd0\x54\x8b\xd3\x66\x00\x56\xa3\x01\x30\x19\x43\x62\x96\x6d\x5f\xa8\x79\xfe\xba\x93\xd4\xf0\x95\xc2\xc9\x0b\x36\xe0\x94\xe2\x4f\xad\x4d\xab\xfc\x53\x41\x08\x21\xe5\x6e\x33\x99\xe6\xae\x79\xc1\x03\x07\x38\x79\xae\xe7\x61\xcd\x01\x27\xb9\xbe\xed\x39\xa1\x2d\x65\x42\x16\x07\x18\xdd\xf5\x57\xb7\xaa\x09\x50\x08\xa0\x37\x76\x81\x8a\x30\x71\x0f\xff\x76\x7b\x78\xf8\x69\xb3\x75\xda\x22\x40\x21\x72\xcb\x5a\x6d\x07\xca\xe7\x19\x6f\x86\x48\xdd\x1c\x1c\x26\x76\x19\xee\x79\x2d\x39\xc2\x22\x6c\x45\xa4\xb6\xcf\x32\x7b\xd4\xd0\xf5\xfa\x43\x3a\x47\xee\x93\x73\x17\x51\xb2\x01\x09\xeb\xbb\x2d\x4b\x4a\xc8\xe4\x57\x06\x66\x0e\x9f\xab\xba\x83\xe4\x1b\x93\x67\x7a\xf1\x42\xdc\xa4\x3c\x4d\xb4\x25\x9d\x3d\x28\x32\xc3\x81\xe3\x7a\xd9\xe3\x4e\x0d\xcd\xee\x97\x6b\x02\xf4\xee\x0f\xda\x18\xc3\xf6\x3e\x7d\x1c\xd9\xb3\x1b\x52\x76\xdc\xab\x25\x9a\xbc\xf2\xcb\x9e\x7f\x98\xd0\x33\xca\x96\x1b\x44\x70\xad\x7d\x6f\xe8\xc7\x26\x67\xdb\x0c\x48\xbf\x69\x42\xdf\x17\x63\x1e\x0b\x9f\xf6\xc0\x7a\xb8\xc2\xe8\x17\xde\x31\xbf\x13\xf4\x7d\x51\xa5\xbb\x14\x1f\xc8\x73\x86\xea\xad\x5d\x36\x64\x44\xa3\xe3\x48\xbc\xf1\x6e\xb5\x38\xe8\x53\x35\xfb\xdc\x2e\x0c\x30\xdd\x06\xa7\x3c\x9e\x4e\x60\x26\x09\x3d\xd3\x7b\xa2\xbc\xa6\xe1\x9d\xae\x2b\x1b\xc1\x71\x6e\x59\x89\xc6\x40\x6a\xa0\xd2\x5f\x5e\xab\x9c\xc3\x7e\xef\x85\x18\x19\x03\xba\x8b\xb8\xaf\xaa\xb6\x2b\x64\x40\x04\x20\x57\x48\xdd\x42\x2b\x71\xd2\xe9\x28\xf7\x9c\x1f\x7b\xe0\x9c\x57\x51\xd1\x5c\x40\x24\x79\x55\x34\x12\x80\x19\x1b\x26\xb3\x25\xf7\x90\x5c\xd8\xa9\x73\xfe\x0a\x68\xf4\xec\x9c\x03\x82\xe9\x43\x62\x44\x60\xeb\x60\x5d\x97\x56\xcc\x94\xdd\xf6\xfe\xd4\x32\xb1\x12\xed\x58\x7b\x5d\x0c\xda\x9e\x00\x50\xc6\x3c\x59\xdf\x7c\xae\xd1\xba\xaa\xf7\x77\xba\x6e\xc1\x2b\x49\xb0\x95\x5a\xcd\x8b\xd2\xac\x47\x9f\x1e\x0e\x32\xf9\x86\xac\xfb\xb8\xa9\x04\xca\x79\x38\x2a\x13\x3a\x76\xa2\xc2\x55\xca\x0d\x94\x96\x17\x00\x15\x23\x48\x99\x33\x68\xc9\x76\xf5\xca\x17\x73\x4f\xfb\x60\x66\x71\xf1\x9a\x8a\x45\x91\x26\xab\x6e\xf8\xe7\x4f\xdb\x11\x4d\x68\x63\x45\xa0\x95\xba\xbe\xf3\x98\x03\x3d\x62\xf0\xd7\x69\x63\x72\x01\x2a\x28\xce\x98\x25\xb4\xf5\xe0\x1a\x89\xa7\x29\xcf\x25\x6e\xb3\x7a\x56\xfd\x49\xc2\x54\x51\xc6\xbb\x9a\x31\x62\x97\xf2\x6d\xb6\x5b\x31\x76\x19\x47\xa8\xb5\xc0\x8e\xfb\x9b\xde\x5a\x88\x7c\x15\xe8\xd3\x0f\xe1\x74\x8f\xdd\xf7\x77\xcc\xb0\x9c\x31\xc5\xf4\xbc\x04\x32\xa4\xb8\xd5\xc6\x52\x60\x4b\x02\x60\x97\x02\x14\xbe\xa7\x12\x19\x76\xde\xef\x1e\x36\xad\x2f\xd3\xdd\x82\x6a\x38\x92\x21\x3f\x93\x23\xb5\xf5\x88\xf4\xd8\x0c\x4d\xbb\xf9\xe2\x7f\x8b\x26\xa2\x5e\x9d\x50\xcc\x5b\xad\x7f\xc6\x34\xfb\x53\x3a\x4c\x12\x7b\x52\xbd\xa5\xe7\xf5\x83\xc8\xe1\xae\x88\x3c\xfd\x93\x3a\x95\x3b\x70\x37\xab\xb8\xa4\xf2\xd1\xd3\x62\x74\x30\xe2\xa8\x47\x36\x7b\x3f\xc9\xd7\x7d\x23\x60\xf6\x87\x6d\x76\x3b\x1d\x82\x38\xb9\xa9\xcf\x97\xba\xa8\x23\xfc\xcd\xc7\x06\x53\xc3\x94\xa1\x57\x4c\x0f\x7c\x7c\x0e\x9f\x75\xc5\xb7\xbf\xe5\xc7\xc6\x37\x66\xb7\x17\x9b\xcc\x2b\xd4\xb7\xc6\x92\x3f\xb0\x6d\xc4\x55\xaa\xc6\x7d\x65\x61\x42\x3c\x36\x07\x7e\x40\x49\x99\x10\x55\xd5\xc0\xe3\x13\x68\xff\x6f\xd9\xd9\x30\x0b\x6c\xc1\xe6\x86\x4f\xcb\x7a\x78\x5f\x38\x2e\xf3\xaf\xe7\x86\xc2\xf5\x3c\x93\xa0\x23\xe7\x6c\xce\xaf\x2c\xeb\x24\xf1\xc7\xd4\x11\xcb\xc6\x0c\xbd\xda\xd6\xce\xd3\xb5\xb5\xcf\x36\xf8\xc0\x9d\x65\xf0\xe1\xd5\xce\x29\xc2\x35\x28\xff\x3e\x96\x96\xa4\xf9\x10\xa5\x0d\x06\x1d\xda\x50\xaf\xcf\x61\x55\xa3\xef\x03\x51\x4d\x73\x28\x0e\x08\xe7\xfb\x5f\xae\xa3\x9b\x81\x7f\x1d\x1a\x6a\x74\xd8\xcc\xdb\x7a\xfb\xa0\xcf\x5e\x91\x29\xad\x8c\x3b\xb4\x61\xc4\x9c\x71\x6a\x65\xab\x94\x32\x32\xdc\x35\xb2\x98\x0b\xad\x68\xfa\x69\x4f\x74\x02\xf3\x87\xab\x33\x84\xac\x54\xf8\xf4\x3a\x31\xc7\x91\x44\x65\xcb\xa0\xef\xbb\xd5\x28\x67\x20\x9b\xfb\xf0\x2b\xf1\xe1\x29\xff\x15\xce\x32\x75\xf6\xfd\x7c\x74\xd8\xbd\x81\x5f\xef\x3e\xd7\xf9\x06\x99\xc9\x97\x78\xa0\x5b\x1b\x5c\x15\x36\x5b\x8e\xdb\x02\x04\xfe\xaa\xb6\xb7\x78\xd0\x2a\x52\x68\xab\x3a\x58\x2d\x06\x2a\x89\xa1\x31\x0f\x1f\x6e\x66\x27\xd9\xe4\x41\x1b\x43\x54\xf3\x22\x43\x0d\x13\x59\xd6\x71\x6c\xc8\x61\x1c\x70\x99\x0e\xfb\x7b\x0c\xb1\xad\x85\xdc\x7f\x51\x19\xe3\xd6\xca\x61\x42\x5a\x6a\x7e\x7d\xad\xc1\xc1\x9c\x45\x42\x2b\xd1\x7c\x4b\x23\x71\xe7\x7d\x34\x63\xab\xbe\xcf\xc0\x6f\xb6\x16\xc3\x1b\x5f\x2e\x33\xfc\x24\x33\x37\xa4\x9e\x8c\xbc\x61\xc9\x5e\x74\x12\x51\x13\x49\xbd\x52\xcb\x38\xcf\x3b\x5f\xa9\x45\xfa\xb4\x32\x46\xb7\x0f\xe6\xca\x01\x10\x26\x61\x91\xba\x7e\xeb\x94\x24\x71\x5e\x47\x97\xd0\x57\xbc\x10\x36\x24\xa5\x80\x1d\x06\x7a\x10\x76\xcc\xb3\x13\x25\x7a\x45\x76\xe5\xf8\x9e\x23\xac\xd3\x10\xbf\xb2\x28\xb5\x7c\xce\xb3\x21\xcb\x23\x6c\xe2\xbd\x94\xe4\xc3\x18\xcf\x2c\xa3\x7b\x8f\x69\x1f\xe0\x43\x18\x85\xb4\x3b\x4f\xac\xd4\x31\xdf\xa8\xdf\x86\xb0\x06\x2f\x4d\x93\xda\x74\x47\x1e\x2c\x5f\xdc\x2c\xb2\x8e\x8e\x50\x9f\x73\x45\x67\x4d\x11\x25\x60\x7c\x20\xd7\xe3\x4f\x6c\x13\x4e\xe2\x80\x69\x76\xb8\x5e\x60\x43\x61\x15\xd5\xe4\x70\x6c\xb7\xf5\x83\x54\x90\x42\x8a\x24\xd5\x52\x69\xf1\x12\x6d\xe0\x94\x0e\xcd\x00\x3e\x64\x9d\xd3\x13\x4c\x7d\xb1\xce\x19\xa8\x12\xed\xdf\xf9\xac\x1e\x5d\x60\x86\xce\x59\x83\xee\xd1\x30\x73\x30\x44\xce\x95\x7f\xff\x4e\x01\x5c\x29\x56\xe1\xf1\x47\x52\xdc\x38\xbe\x8c\x1c\x22\x0e\x3a\x3d\x88\x56\x57\x48\xb2\xad\xf2\xaa\x6c\xb8\xd7\xba\x88\x18\xb1\xb5\xef\xe2\xdf\x87\xe7\x3f\x07\x4c\xd3\x61\x01\x42\x3c\x90\x44\xd4\x73\xfe\x6d\x03\x23\xbf\xd5\x25\xfd\xfa\x45\x50\x1b\xb4\x3a\x02\xc7\xf7\xc0\xa4\x6f\x0c\x71\x4d\x47\x9d\x38\x2c\x49\x8e\x6c\x95\x21\x3f\x3a\xd2\xfd\x11\x64\x73\x42\xae\x00\xfe\x57\x2f\x3f\xce\x8f\x2e\x2e\x03\x5e\x26\x4d\x0c\x52\x61\x67\x14\xf4\x05\xfc\x4a\x2d\xc9\xbf\x1b\x9c\x56\x58\xb4\xf8\x08\x3a\xa8\x05\x55\xf1\x06\x76\x24\xaf\xcf\xd9\xe4\xea\xdd\x5a\x91\x14\x43\x92\xa5\xd3\x58\x15\xea\xb4\xa4\x53\x8b\x5d\x75\x95\x8e\x16\xad\x9b\xc0\xc0\x8c\xa3\xa6\x3f\xc3\x64\x5c\x6d\x89\x43\xee\xf8\x86\xcf\x17\x75\xf3\x46\xe0\x71\xef\xaa\x11\x7d\xd2\xb9\xae\x82\x35\x86\x66\xa9\xa9\x6e\x97\xa7\x82\xb8\x8d\x16\x97\x63\xff\xaa\xbb\xa7\xb0\x28\x98\xab\xe5\x4d\xf9\x5c\xbb\xa5\x90\x49\x52\x01\x11\x3e\xff\x26\xc1\xe6\x9b\x29\x67\x36\x50\x37\xec\x4f\xbd\x6a\x04\xce\x5e\x09\x0e\xdf\xf8\x2d\xe3\x15\x4b\xd3\x38\x90\x2c\x35\xed\x45\xd4\xfd\x8b\x91\x7e\x88\xb1\xf7\xe6\x06\xa6\xb7\x84\xde\x9f\x82\xeb\x2c\x1d\xbf\xee\xb9\x06\xac\x59\x71\x3f\x4e\xb9\x89\xae\x6e\xc7\x36\xfe\x70\x25\xb0\x35\xfc\x8c\x6d\x11\xad\xb8\x60\x9f\xa1\x79\x52\x48\x8b\x01\xdf\xf9\xb8\xb4\xdc\x49\xb2\x62\x46\x3d\x23\x5c\xda\xa1\x21\xd6\x8b\x36\x95\xae\xcb\xdd\x65\xfe\xa4\xf2\xc6\xe4\xda\x45\xbf\x1f\xf2\x96\x2c\x0a\x80\x0f\x33\xe6\xf9\xc5\x8b\x7e\xf6\xb7\xc2\x8d\xa7\x53\x7d\x87\x2e\x46\xc5\x26\x3f\x23\x26\xe5\x87\x14\x24\x5f\xab\x53\x7b\xf3\x30\x62\x30\x44\xfe\x56\x91\x44\x44\x8b\xb1\xcc\xea\xea\x93\xfd\x9a\x6a\x9a\x8d\xd5\x27\xd9\xb6\xd7\xaa\xeb\x3a\x16\x05\xe7\xa5\x36\x8e\x4b\xed\xcf\x01\x64\xe8\x25\x28\x42\x9e\x48\xce\x5e\x86\x48\xce\xe7\xae\xac\x3f\x89\x52\x55\xd9\x8b\x72\x94\xb0\xb1\xfc\x3c\xa0\x37\x87\x9c\x96\x4f\x8d\xa4\xa9\xa4\x03\x26\xa7\x49\x6b\x32\xea\xab\xc4\x08\x61\x5b\xa2\x91\x28\xf7\x39\xab\x5f\x0c\xce\x22\xaf\xf9\x9a\x92\x18\x91\xf7\x59\x52\x45\x6f\x54\x50\x84\xd5\xee\xeb\x5a\x16\x58\x11\xff\xde\x76\xaa\xdc\x77\xb0\x9b\x7a\x12\x30\x94\x1c\x5a\x8b\x22\x99\x35\xcb\x3e\x28\x58\xf9\x18\x03\xa4\x5a\x02\xab\x92\x10\xe4\x3e\xe7\x17\xc8\x6c\xc9\x71\x70\xcb\x44\x88\x71\x91\x2d\xcf\x0e\x58\x26\x0e\xf6\x99\xfd\x84\x35\xe7\x46\x19\xd3\x91\x06\xbe\x52\x69\x3a\xe0\x03\x0e\xa2\xdc\x8d\x5f\x6c\x77\xa6\x59\xf7\xfe\x72\x63\x10\x62\x80\x5d\x26\x0e\xe3\x8a\x80\x46\xd8\xea\x07\x7a\xb1\x34\xf8\xb6\x6f\x57\x2a\x5c\x07\x66\x9d\x00\xc5\xf7\x63\x41\xa5\x89\x23\x17\xb3\x81\xc2\x65\x78\xbf\x16\x27\xb2\xc0\xb8\xa9\x82\x13\xc8\x3e\xc6\x78\xff\xd0\xa4\xec\xa7\x29\xac\xdd\x4e\xca\xbc\x2f\xdf\xdf\xa1\x2e\xd1\x42\xb7\x9e\x90\xb2\x63\x71\xb0\xdc\x03\xa9\xd6\x1e\x3f\xd4\x52\x68\xea\xaa\x0f\xbf\xcb\xc0\x58\x54\x08\x98\x7b\x8e\xd4\x6b\xe6\x7f\xdd\x0f\xc5\x1c\xa1\x15\xa1\xc8\xf7\x40\xe5\x24\x6d\x7b\xc2\x85\x1e\x98\x0a\x7d\xf6\xf3\x64\x0b\x98\xbd\x59\xd9\x36\xf8\xf0\x4b\x92\xc6\xbd\xa2\x8c\x55\x0c\x1e\xef\xba\xf6\x73\x90\x42\x36\xb3\xff\x51\x39\x6a\xee\x48\xea\xec\xa3\xde\xab\x14\x25\x1c\xd6\xda\x02\x28\x48\x95\x31\xf0\x03\x74\xa7\xa8\xee\xc1\x90\x3d\x38\x88\xdd\x5a\x33\xa1\x6c\xa9\xd6\x0d\x1a\xde\xb9\xef\xa0\x0e\xf0\x9d\xfe\xb4\xc2\x00\x90\x4c\x59\xb4\xb0\x73\x2c\x0a\x1b\x6d\x4b\xfd\x7d\x33\x80\xf4\x49\x0a\xa1\x8d\x2b\xb6\xad\x7f\x42\x7e\xef\x81\x81\x82\xdc\x5b\x0b\x5f\x53\x0b\x2a\x4a\x39\x8b\xb2\xcd\x09\x57\x1d\x62\x65\x75\x69\xbc\x70\x76\x6f\x46\x61\x84\x07\xa2\x57\xcd\xd5\x51\xe5\x8e\xc1\xa3\x74\x0f\x54\x79\x20\x19\xe2\x16\x97\xc0\xa4\x45\x4b\x0a\x59\xe0\x2e\x55\x20\x1b\x54\x13\x47\x1b\x8e\x57\xc0\x9e\x9f\x7d\xee\x34\x2e\x5f\x56\x9f\x91\xe5\x90\x50\xc9\xaf\x5f\x95\x00\x3a\xe2\x84\x21\x89\x5f\x6a\xb2\x81\x9b\x69\xe0\xc8\x13\x29\xb1\xa0\xd7\x83\xd5\xa6\x31\x9f\x1e\x84\x59\x3d\xf1\x71\x6d\x8c\x2c\x37\xf7\xb1\x9a\xff\xe8\xca\xff\x02\xc5\x0c\xe5\x82\xc4\x02\xa9\x94\x40\x97\x69\xb6\x2c\x30\xbe\xa3\x54\xdc\x61\x1f\x2c\x3b\x87\x98\x50\x08\xa4\xab\xa8\x22\x06\xba\xeb\x4c\x56\xc0\xc1\x78\x71\x05\x32\x8b\x27\x96\x9c\x89\x8e\xbe\xe4\x07\x4e\x32\xe9\xb4\x4e\x09\xbf\x30\xf3\xd5\x3a\xd8\xef\xcd\x0a\xf7\xf4\x55\x2e\xbe\xef\x4b\x1a\xc1\x34\x13\xe6\x77\x5d\x1f\x8d\x60\x84\xd7\xfb\xf6\xae\xfa\x98\xe8\x58\x6d\x34\x56\x4a\x75\x8b\x9a\x9e\xbc\xae\x8d\x13\x35\x85\x63\x3d\x21\xb9\xd9\xd0\xba\xb4\xdb\x90\x89\xbd\x31\x29\xd2\x07\x0f\x09\x39\xf5\xbf\x18\x36\x4b\x25\x2d\x35\x39\x66\xc7\xa4\x6a\x22\xd4\xd3\x28\xdd\x96\xc5\x36\xbf\xba\x1e\xd9\xe8\x6c\xb0\xc3\xbd\x25\x1f\x32\x95\xff\x72\x6c\xf9\xda\x4c\xd0\x09\x52\x59\x1e\x78\xf6\xfa\x6e\x7e\xce\x6e\x0f\x31\x9f\x98\x40\xc4\x55\xdc\xd4\xc8\xc0\x7e\x41\xc5\x21\xdd\xa8\xff\xc7\x21\xa7\x25\x93\x96\x9b\xa7\x68\x77\x40\xd0\xb8\xda\x2d\xad\x6b\x13\xbd\xf5\x4e\x4c\x15\xe9\x3e\x40\xa7\x0f\x2c\x24\xfb\xe1\xaf\xcb\x54\xdf\xe0\x8c\x4c\x2f\x83\xa7\x9b\x95\x63\xeb\xfb\xbc\x81\x44\x99\xc0\xef\x9c\x40\x5e\x24\x8a\xc5\x77\x31\x53\x90\xf5\xfa\x6c\xb6\xa9\x9e\xc1\xf8\x4e\x82\xac\xda\xd7\x07\x43\x9e\xee\xa4\x7c\x16\x2a\x5c\x6e\x51\x18\xdb\x0a\x98\x0d\xd7\x03\x89\x0f\x5f\xa1\x98\x5c\xdc\x3e\x0d\x44\x27\xa3\x96\x42\x17\x9f\x17\xcc\xe2\x85\x5f\xe9\x7e\x53\xfd\x98\x36\x1f\xaf\x71\xb2\x25\x6a\x0e\x76\xec\xba\x9a\x83\xe7\xa7\x8e\xa4\xfe\x60\xa9\x01\x4c\x92\x02\xb7\xdf\x17\x89\xb6\xc6\x07\x87\x07\xc3\x53\x8e\x6d\xcc\x84\xfd\xed\xd2\x40\xdd\x95\xf3\xe8\xaa\xb8\x81\x7a\x0e\xe8\x20\x45\xf6\xf3\xab\x8b\x36\xe2\x95\x43\x1e\xc9\x98\x12\x32\x5f\x9c\xda\xda\x22\x98\x07\xc6\x10\x30\x17\x76\xc1\x33\x8e\xc2\x11\x50\xe4\x27\xe0\xcd\x08\xd6\xe8\x36\xec\xeb\xcd\xd8\x5b\xb7\x60\x5c\xdc\xf3\x60\x52\xf2\x81\x4f\xb9\xc3\x5c\x92\x88\x33\x26\x7e\xf0\x8a\x82\xf3\x21\xa7\xdb\x00\xcc\x9f\xb3\x16\x4a\x75\x0e\xbf\x9c\x6d\x41\x79\xb2\x9f\x29\x11\x78\xfb\x74\xed\x5f\x56\xfc\xc9\x3e\x4a\x60\xff\x11\x89\xa5\x6c\x2e\x7b\x65\xd9\x37\x16\x23\x52\xb3\x2a\x93\x96\x0d\xdd\xb5\x00\xfe\x9b\x2b\x0a\xce\xc3\xdc\xc2\x73\xc8\xdb\x9c\x2f\x44\x09\x6a\x8f\xe0\xda\x0b\x7c\x57\xdf\x71\xa7\x8a\xce\xfc\x79\xfa\xbf\x76\x78\x46\x8d\x4e\xb9\x75\x3f\x27\x65\x1d\xf2\x09\x4e\xff\x5a\x4b\xf7\x04\x6e\x2e\x29\xe5\xc0\x94\xed\xb7\x4e\xa2\xac\xd5\x47\xcc\xfd\x69\x15\x56\xc3\x47\xb3\x39\x47\xbf\x03\x7e\x03\xd5\x41\x4e\x3c\xea\xec\x0b\x6e\x03\xe4\x05\x0a\x99\x80\xa2\x29\x84\x9e\x95\x8e\x28\x74\xa4\x53\x8e\x86\xfe\x02\x7c\x8b\x95\xfc\xdd\x77\xb0\x68\xc8\x15\xda\x49\x88\x43\x6c\x46\xe6\xdf\x66\xb7\x6c\x32\x55\x7c\xd7\xd9\xec\x15\x46\x50\x3c\x02\xff\xc6\xe0\x68\xef\x14\x83\x41\x0a\xb4\x91\x15\xcc\x87\xcd\x43\xf9\x25\xef\x2e\x48\x15\xb6\x0e\xea\x7f\x49\x55\x4f\xbb\xe1\x74\x5a\x7f\x1a\x24\xdf\x5a\x04\xc4\x37\x63\x8c\xf7\xd4\x51\x78\x59\x39\x32\xca\xb4\xfd\xc2\xcf\x22\x9e\x4f\x41\x9f\xbf\xb1\x3b\xb3\xde\x64\x06\x18\xb5\xb0\x45\x3c\xb6\x19\x7f\xc7\xe8\x5b\xc2\x32\xba\xd4\xfa\xcc\xe2\x90\x68\xf6\xd9\x61\xa8\xf8\xc6\xc5\x45\x57\x03\xa3\x63\x20\x0f\x8e\xbd\xed\x71\x50\x5d\x8e\x82\xfd\x1b\xaf\xd3\xe5\x58\x1b\xa0\x72\x84\x4a\x66\xaf\x6c\x3a\x93\x3e\x31\x33\xf1\xd7\x2f\xdd\xdb\x50\x29\xdb\xec\x20\x8e\x9b\x5f\x62\x95\xbf\x65\x7a\x1d\x38\x25\x51\x7c\x2b\xeb\x81\x3d\x7a\xe4\xa6\x60\xfc\x2f\xa3\xff\x58\x2f\x8c\x3b\x73\x9b\x15\xc0\x01\xe6\x43\x1d\x25\x8d\xfc\xb3\xcb\xe1\xde\x5b\xc2\x13\x74\x53\xbb\xae\xa1\x04\xc5\x32\x4b\xfd\x51\x6f\xd7\x2b\x69\x95\x6b\x34\xd1\x37\x58\x0d\xeb\x24\xfc\x08\xaa\x1a\xb5\xcd\x74\xbe\x8f\x9b\xcd\x3f\x0d\xc9\xfc\xbf\x4c\xa0\x05\xfa\x1d\x39\x73\x4b\x45\x8f\x57\x75\xd6\x4a\xfb\xc0\xed\x61\x6b\xa0\xbe\x76\x6b\x79\x4b\xce\x4d\x36\x98\xdf\xe1\xb9\x37\xc4\x2a\xf3\xdb\x95\x00\xcd\xbd\x99\xee\xd7\xde\xad\xf1\xab\x18\x16\x67\xd2\xff\x8e\xda\x45\x18\x7a\xf8\x08\xb0\xdd\x9d\x68\xb5\xaf\xa1\xd0\x9d\x13\x0f\xde\x94\x70\x79\x5a\x6f\xba\x0f\x09\x3a\xaa\x6a\xa5\xb0\xca\xf2\x59\xfa\x8f\xd1\xda\x21\xe0\x69\xad\x0e\x02\x56\x3d\x8a\x8c\x02\x87\x52\x3c\x0a\x8d\x06\xf3\xd7\x5f\x2d\xe7\x36\x0c\x07\xa2\x81\x39\x2b\x66\xb0\xed\x80\xa9\x03\xf3\xe7\x20\x98\x9f\xb3\x22\x45\xef\x42\xd0\x11\x57\xe0\x47\x8e\xef\x48\x77\xf8\x2b\xdf\x47\x02\x26\xfe\xc0\x8d\xab\x4c\xa9\xec\xd2\xf7\xbb\xb2\xff\x36\xff\x2f\x43\x98\x39\x01\x62\xa8\x2e\x11\xd6\x40\x9f\xb1\x1d\x08\x4f\xce\xc7\x27\xb1\xe7\xb1\xf4\xc0\x78\x41\x07\xe3\xe3\xe5\x0b\xd7\x6f\xb5\x34\xa0\x5e\x3c\x14\x7b\xf6\x1f\xaf\x4f\x73\xbe\x19\x70\x58\xe3\xd2\x18\x4d\xca\x80\xae\xeb\x3e\xc4\x09\x4b\x6f\x50\x0e\x66\xd9\xef\x41\xa8\xb7\x8f\xa4\x3e\xac\xcc\x80\xa3\xb2\x21\xc3\x6f\xca\x05\xa3\x41\xa6\x60\xe2\x71\x0b\x3c\xf0\x49\x8d\xc9\xa2\xc6\xd3\x50\xd5\x4d\xdf\xdc\x8e\x9f\xbf\xc1\xfb\x47\x61\x7f\xc5\x9d\x45\xf5\x2f\x02\x6f\xc2\x6f\xdd\x15\xd1\x3c\x97\x84\xcc\xab\xbd\xfd\x92\x5b\xe2\x85\xe4\x2b\xcd\x1e\x0d\xbb\x6b\xe2\xf3\xe9\x3e\xc8\x80\xe6\xc6\x8d\xa8\xde\x5f\x53\xfe\x33\x1b\x28\xc9\x7d\xf3\xa9\x83\xf3\x0b\x29\x1e\x04\x9c\x39\x40\x0b\xc0\x01\x08\xe2\x74\x78\x74\x1d\x20\x24\x97\x85\x5e\x4a\xf4\x7f\xbf\x7a\x10\x68\xf9\xd1\xd9\x7b\xb7\xc2\x94\xb5\xa1\x50\x89\xf6\x04\x75\x00\x6e\x82\x3f\xc0\x76\xf0\x39\xd8\x87\x03\x61\x40\x06\x04\x2d\x6f\x55\xf0\x56\xea\x8d\xeb\x03\x89\x07\xa0\x55\x2d\x39\x92\x67\xc6\x2b\x50\x7d\x7c\x57\xd4\xe7\x5e\x4a\x8d\x13\x19\x40\x7a\xf6\x96\x1a\xd7\x9f\x61\x17\x86\xb4\x0e\x40\x4b\x00\xb9\x66\x02\xe2\x75\x4b\x27\xc7\xe5\x9e\xfd\xef\x22\x97\x39\x87\x14\xdf\xa2\x0c\xc0\x37\x84\x3b\x78\x8a\xbc\x23\x12\x40\x92\x04\x48\xd8\x87\xb8\xac\x26\x19\x15\x4b\xda\xe9\x15\x5c\xb0\xfb\x1a\x43\x21\xd1\x9d\x2c\xd0\x59\x9e\x93\x03\xfc\x6b\xab\xd3\x81\x99\x17\x98\x4e\xf1\x74\xd0\xb4\xdc\xaf\x2f\x68\x7f\x37\x01\xdd\x02\xa3\xb6\x40\x89\x36\x92\xd1\xa5\x24\x02\xab\x8c\xed\x0c\xf0\x2f\x6f\x4e\x07\xac\x1f\x50\x29\xfa\xf0\x53\x7c\xf3\x91\xca\x43\xdb\x84\xbd\x8d\xd0\xb5\x19\x47\x05\xec\x67\x8c\xd3\x4a\xda\xf7\x35\x7f\x8c\x93\xf6\x5d\x1e\x6b\x55\xb8\x12\x6d\xcf\x0b\x69\xd9\xdc\x5f\xf3\x80\xc3\x87\xf8\xe6\xc9\xe3\x46\x0a\x2b\x93\xf3\x29\xc4\x2a\xc5\xf3\x24\x58\x25\x62\xa1\xea\x70\xff\x31\x27\xe1\x6d\xef\xab\x6f\xfd\x27\xec\x2b\xca\xba\xfb\x7e\x80\x25\xdf\xc0\x7a\xc1\xa5\x78\x7e\xaa\xef\x31\xde\xa9\x7a\x3e\xfc\x97\xef\x41\xff\xe5\x6e\x07\x19\xfa\xcb\x11\x60\x41\x71\x6c\x85\xa2\x98\xa8\xc5\xda\xd7\x26\x37\x66\x94\x8d\x37\x3e\x60\xca\x7b\x3c\x24\x5e\xef\x88\xeb\x52\x6b\x82\x00\x7d\x64\x80\xfa\xab\x0d\x7a\x4d\xa9\x2a\x0f\xfa\x39\x12\xd9\x5b\x82\xfb\xda\x3d\xb6\x48\x5a\x66\x38\xf1\x60\xa8\x12\x50\x29\x0d\x34\x64\xcb\x8f\x78\x7d\xb8\x72\x73\xde\x28\xb0\x01\xd7\x7a\x25\xe0\x91\xd4\x01\x28\x74\x00\x8a\x04\xb1\x90\xa0\xf7\x44\xcc\x23\x81\xc5\xf3\x4e\xe0\x56\x57\x95\x72\xe3\xa7\xf1\xf9\x70\x13\x9a\x03\x09\xf6\xa3\xc2\x57\x19\x44\x62\xa4\xa4\xd0\xa7\x68\x24\x0f\x17\xe9\x19\x9e\x53\x7a\xcb\x55\xf9\x4e\xa7\x86\xd7\xa3\x46\x93\xcb\x03\x1f\xfe\xb5\xc9\xdb\x43\x80\x97\x1d\xde\xb8\xfb\xfd\xb6\xb5\xd7\x57\xea\xe0\xcf\x1f\x66\x0c\xb1\x1b\x21\x1f\xfa\x48\x42\xec\xbc\x4c\x1e\x90\x86\x72\xd3\x3e\x43\x30\x43\xef\x93\xf2\x9d\x70\xc7\x6a\x71\x19\x40\xf2\x9f\x46\xb8\x60\x18\x2a\xdf\xdb\xa2\x25\xc3\x0e\x24\xb6\x59\xce\x77\x95\x3d\x65\xa1\x7c\x08\x66\xab\x1c\x33\x1d\x64\x50\x9d\x44\x75\x05\xcf\xd6\x27\xff\x3b\x44\xee\x00\xf2\xb1\x02\x76\x08\x77\xb7\x28\xa5\x29\x40\xca\xa8\xda\x90\xbf\x3a\x32\xaf\xf4\x93\x25\xb3\xba\x80\xfd\xe5\xbd\x54\x4f\x19\x41\xe7\xb2\x9c\x6c\x15\xa7\xc0\x78\x93\xee\x79\x87\xba\xa3\x5e\xea\x7f\xc5\x16\x79\x00\x1c\x98\x0c\x98\x30\x3c\x39\xb9\x3b\x2e\x4d\x4e\xa5\xf6\x74\x1f\x34\x9c\x20\x61\x1e\x93\x49\xad\x2d\x1b\x98\x8a\x75\x6a\x9d\x8a\x37\x00\xe3\x4b\x09\x77\x88\xf2\xc9\x35\x18\xc5\x5e\x09\x71\x01\xa3\x22\x40\x4d\x8b\xb3\xf5\x90\xe3\x04\x19\x68\xa5\x06\x5a\xc5\x2b\x20\x2c\xc1\xd9\x5d\x9a\xbf\x3f\x34\x36\x76\x69\x9e\x21\xd2\xa5\xe1\x0f\x4e\x4d\x5a\x49\xfe\x35\x8f\x5e\x3e\x1a\x49\x0e\x2b\x9b\x2b\xaf\xd9\x9b\xac\x52\x66\xb7\x4f\x12\x12\x42\xd3\x1b\x01\xbc\xdb\xe0\x93\x09\x4c\x84\x95\x66\x8a\x42\xf5\x23\xbf\x23\xc1\x58\x50\x1e\x65\x1a\x7b\x14\x12\x8f\xd9\xe1\x78\x7f\x9c\x52\x53\x63\x39\x4e\x6c\x09\xfd\x57\x5a\xcd\x01\x58\x9b\x00\xb4\x4b\x8b\x56\x1f\x18\xd2\xa7\x94\x36\xc3\x7d\x43\xbe\x71\xf8\x7d\x7b\x73\x5b\x98\xa8\x2c\xa8\x99\x71\x3c\xf0\x49\x77\xed\xa2\xf9\x9a\x4f\xef\x79\x13\xff\x9a\x9c\xcb\xd8\xe8\xd7\x78\xff\x5d\x8f\xa5\x0e\xa9\xe4\x82\xa2\x0d\x51\x4c\x86\xae\xf0\x02\xae\x19\xf0\x4d\x78\x88\x2c\x0c\x4e\x23\x8e\xf6\xbf\x90\x7a\xde\x6d\xdb\x14\x5b\xfe\xa5\xc9\x56\xd3\x72\x2d\xf0\xa1\x93\x91\x70\xc2\xe0\x2e\x68\x58\x02\xbd\x39\x40\xdc\x8a\xaf\xf1\x74\x3c\xac\x73\x5d\x66\xe1\x0e\xd5\x3b\x80\x02\x19\x24\xe8\x2f\x06\x10\xd8\x6f\x90\x7f\x1e\x16\xe9\x86\xa7\xf8\x6f\xa2\x31\x02\xfd\x14\x37\x7d\xba\x84\x9d\x6a\x3b\x95\xd4\xfd\x0e\xda\x4e\x3d\xd4\xf0\x73\xd0\xa4\x65\xc4\xea\xfc\x87\xe4\x67\xb4\xd0\x83\x88\x3d\x25\x47\x96\x58\x8c\x17\x3e\x1a\xea\x2f\xf4\x5c\xbc\xbe\xb7\x75\x7d\xbf\x74\xbc\xe4\x14\x9b\x62\xf5\xa6\x01\x0a\x50\x28\x57\x3d\xfe\x64\x91\xea\x3f\x5b\x03\xc8\x58\xa0\xe1\xf2\x43\x91\x05\x67\x4e\xad\x3e\x02\x19\x64\xfb\xf5\xa9\x05\xdf\x88\x0b\x8f\xd4\x77\x63\xfe\xa2\xf2\x92\x31\x08\x60\x17\xa0\xc8\x1d\x05\x2c\x80\x47\xfd\x87\xaf\x67\xc3\x07\x18\xb2\xc6\x19\x39\xf2\x3a\xe0\xf7\xc0\x69\xca\x9e\xb7\x3d\x14\x9a\x93\x09\x40\x43\x60\xb3\x19\xd9\x35\x3f\xad\xe0\x84\x9b\x03\x2f\xf9\xff\x7b\x45\x07\x09\xfa\x9b\x11\xb0\x71\x72\x78\xdf\x4d\xd6\x5a\xfe\xf3\x79\x3d\xa6\x8e\x10\x4c\xdf\x79\x93\xe7\x6f\xe6\xeb\x3c\x07\xea\x4b\xf5\xbe\xc6\xdf\x16\x81\xfc\xdb\x1f\x04\x20\xa6\x00\x4c\x26\xd4\xdc\xd7\xb4\xf4\xaf\x28\x88\x6a\x99\xe4\x27\x60\xae\x81\x39\x11\xfa\x9a\x25\xc9\xbf\xbc\x64\x62\x8d\xf0\x15\x29\xfd\x84\x02\xbc\xf6\x71\xca\x9e\xe8\x9a\xd5\x38\x46\xdc\x19\xbc\x08\x41\x83\xd5\x3e\x24\xd2\xef\x18\xee\xb3\x61\x31\x0d\x3b\x70\x91\xd1\x27\xf4\xe7\xb3\xdb\x3e\x86\xd9\x6f\x51\x57\x6f\xc2\xa7\x11\xd0\x8e\x59\xe5\x60\x5a\x15\x93\x5c\xe2\xeb\x10\x46\x7a\x03\xca\x17\xb9\xbf\x91\x96\xcb\x3d\x06\xca\xe9\xd9\xe9\x9d\xd6\xcc\x6f\xed\x82\xac\xbe\xbd\xe8\x69\xdb\xd9\xa9\x3c\x68\x01\xf7\xbd\x6a\x19\xdc\x0e\xef\x62\x5f\xe2\xc0\xf4\x6c\xd8\x7f\xbd\x82\x35\x0f\xcc\xa6\xe8\x86\x0e\x4f\xae\x2c\xc6\x14\xbb\x0a\x49\x0b\xb5\x2c\xe5\xbf\x94\xc6\x92\xef\xc3\x6e\xed\xef\x54\xca\x19\xa7\xad\x2f\xdd\x57\x80\xfc\xe7\x76\x6f\x48\xea\x5e\x57\x46\x43\xc6\xb4\xb1\xfa\xb9\xf6\xdf\x96\xfe\x03\x98\xe7\x4b\x56\x91\x66\xd8\x8a\x23\x53\xec\x12\x6e\x22\xe1\x8e\x70\x43\xa1\x69\x4e\x36\xa1\x0c\x07\x02\xff\x59\x55\x07\x49\x9d\x4e\x7e\x97\xa3\xfb\x7e\x77\xc4\xeb\x23\xe1\x9e\x82\x38\xb8\x7f\x7e\x1b\xf5\x04\x2f\x1e\xc4\x9c\xef\x53\xf0\x1c\xd1\x7a\x5c\xbb\xad\xbd\x66\xf5\x6f\x99\x91\xae\x45\x41\x68\x81\x8c\x74\x18\x1b\x75\x91\x65\x9f\xdb\x38\xee\x2a\xc9\x81\x1b\xf5\xe6\xde\x95\x07\x73\x9d\x01\xc3\x66\x4b\x63\xb3\x51\x01\xa7\xb1\x20\xfd\x5b\xd7\x60\x95\x72\x24\x37\xe4\xdd\x33\xcc\xd8\x4f\x38\xdc\x15\xdc\x43\x92\xa4\x14\x49\x0a\x8c\xdf\xa5\xc1\x56\x73\x60\x93\xd2\x51\x07\xc7\x33\xa7\xab\x64\xcf\xdd\xc9\x6e\xfe\x2f\xd7\x0b\x81\x06\x89\xdb\xbe\x7e\x4e\x2b\x45\x77\x94\x6d\x40\x4c\xf1\x31\x28\x91\x01\x04\x6d\xb5\xec\x55\xfc\x2d\x1b\xd7\xfe\x98\x41\x0f\x0c\x96\x80\xfb\xbf\x35\xeb\xaf\x80\x11\xa3\xc7\x05\xdb\xda\xdb\xa8\x82\x43\x8c\x39\xee\xdc\x55\xf7\xaa\x2b\xfc\x69\x8a\x99\x19\xa0\x38\x68\x4f\xe8\x3b\xc9\x39\x54\xdc\x46\x6d\xe7\x80\x30\x04\x70\x0b\x78\xf4\x80\x5a\xac\x20\xd6\x6c\xbe\x6e\x69\xed\x46\x4f\xa4\xdd\x5d\x2c\xf9\x38\x96\x69\x24\xef\xbc\xdb\xab\x2b\x1d\x97\x91\xff\x79\x0b\xce\x5c\x0c\x48\xc6\x01\xc2\xc0\xec\xfa\x92\x60\x94\xc8\xf7\xf5\x1b\x3d\xa5\x0f\x93\x4e\x2f\x38\xc0\x8a\x9e\x2b\xae\x77\x99\xe1\xf3\x9f\x2b\x0a\x4d\xd4\x10\x03\xa7\x96\xfc\xa5\xf6\x10\x6c\xa2\xc1\xd4\xaf\x04\x97\x02\x01\x23\xd7\xae\x8d\xf0\x5a\x70\xc6\x01\xc4\x74\xf2\xa3\x15\x9d\x40\xc7\x7f\x4b\x2f\x06\x4f\x81\x06\x52\x21\xbe\x8c\xe7\x26\x0e\xf3\xcd\x8c\xa5\xce\x4d\x08\x2c\x80\x1c\x57\xc1\x94\x95\x1b\x3c\x17\xd7\xf3\x7d\x4f\xec\x3f\x8e\xf1\x03\x50\xae\x3f\x3a\x7b\x02\x71\xb8\xf7\x24\xf0\xc6\xb4\x7a\xc5\xa7\xb9\x8c\x13\x6e\xa1\xd2\x18\xff\xf2\xfa\xa4\xb9\xed\x13\xee\x0b\x6f\xfa\xfc\xca\x67\xb6\x02\x3a\xb9\x66\x0e\x7c\xde\x24\x65\x83\x56\xc2\x9d\xea\x87\x2a\x1a\x00\x3f\x9a\x0e\x41\x36\xcb\xc3\x87\xd6\x9f\xc0\x5e\xee\x13\x1b\x9e\x24\x2e\x6f\xce\x47\x0d\x28\x2e\xe5\xec\xe8\x1e\xd5\xe7\x2e\x71\x02\x50\x24\x31\xf2\x1d\x95\xf9\xe1\x78\xe9\xfe\x40\x97\xf3\xbf\x9d\xc6\xa6\x00\xb9\x77\xf7\x57\xf0\xe1\x07\x98\xa2\xb5\x3b\xdb\x5b\x0f\x5b\x0f\x5e\xae\x84\x64\xaf\x94\xe3\x0f\xf8\xac\x9a\x25\x2b\x25\xdd\x8a\xa8\xe4\x7c\x8f\x8c\x19\x85\xc7\x31\x67\x22\x3a\x42\x48\x02\xb4\x80\x0d\x01\x74\x59\x21\x3a\x63\xd7\xea\x41\xc2\xa5\xdc\xd3\x47\x08\xe0\x13\x08\x9a\xe0\xe9\x13\x31\xfc\x1a\xe0\x7a\x45\x85\x3f\x61\xd7\x6c\xee\x5d\xb2\x68\x43\xdc\x51\x19\x4e\xfc\xbb\x6f\xe9\x02\x45\x32\x54\x0b\x22\x7b\x69\x16\xe9\x6d\x65\x58\xaa\xf1\xe7\xd6\x14\x4d\x10\x41\x0b\x37\x0b\x9b\x35\xcd\xa8\xaf\xf6\x23\xad\xa3\x54\xa4\x0d\xb0\x24\xa5\xb6\x03\x19\x25\x20\x4a\x9b\x30\xc5\x4d\x18\xa6\xe0\xa9\x55\x14\xb3\xb9\x59\xe4\x84\x09\x3b\xb1\x31\xd3\x46\x72\xdb\x6c\xa4\xd8\x0d\x5f\x42\x45\xa1\x16\xa5\x32\xe7\x8e\x12\xe7\xce\x65\xf9\x23\x91\xaf\x55\x22\x5f\x4d\xb1\xbb\xd9\x8a\x14\xa8\xf3\x34\xa0\x08\x40\xfb\x9f\xf8\xfc\x9f\x69\x0e\x14\x41\x96\x0b\xd7\x83\x0a\x42\x12\x4f\x7d\xfd\x83\xed\x3f\xd3\xff\x2d\xd3\x2b\x4f\xa0\x37\xfe\x41\xe4\x7f\xef\xb4\xe1\x17\xd9\xec\x3f\x88\xfc\xef\x9d\xce\xc6\x0b\xa0\xf3\xe0\xff\x9f\x6b\xfc\x3f\xfa\x7f\xac\x29\x6c\x68\xde\x7f\x92\xf1\x7f\xed\xd4\xed\x0b\x3d\xf6\xce\x3f\x88\xfc\x5f\x9a\xde\x00\xe1\x9b\xbb\x00\xd9\xe7\x1e\xd3\x97\x3c\x2c\x1b\x67\x7f\x06\xa4\xa9\x76\x5b\xb5\x5c\x19\xf9\xf4\xff\x01\x5b\xa7\x54\x66\xcd\x49\x00\x00")
+
+func imagesLgtm_wipPngBytes() ([]byte, error) {
+	return bindataRead(
+		_imagesLgtm_wipPng,
+		"images/lgtm_wip.png",
+	)
+}
+
+func imagesLgtm_wipPng() (*asset, error) {
+	bytes, err := imagesLgtm_wipPngBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "images/lgtm_wip.png", size: 18893, mode: os.FileMode(420), modTime: time.Unix(1792052089, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"images/lgtm_approved.png": imagesLgtm_approvedPng,
+	"images/lgtm_comic.png":    imagesLgtm_comicPng,
+	"images/lgtm_mask.png":     imagesLgtm_maskPng,
+	"images/lgtm_outline.png":  imagesLgtm_outlinePng,
+	"images/lgtm_stamp.png":    imagesLgtm_stampPng,
+	"images/lgtm_wip.png":      imagesLgtm_wipPng,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//
+//	data/
+//	  foo.txt
+//	  img/
+//	    a.png
+//	    b.png
+//
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func     func() (*asset, error)
+	Children map[string]*bintree
+}
+
+var _bintree = &bintree{nil, map[string]*bintree{
+	"images": &bintree{nil, map[string]*bintree{
+		"lgtm_approved.png": &bintree{imagesLgtm_approvedPng, map[string]*bintree{}},
+		"lgtm_comic.png":    &bintree{imagesLgtm_comicPng, map[string]*bintree{}},
+		"lgtm_mask.png":     &bintree{imagesLgtm_maskPng, map[string]*bintree{}},
+		"lgtm_outline.png":  &bintree{imagesLgtm_outlinePng, map[string]*bintree{}},
+		"lgtm_stamp.png":    &bintree{imagesLgtm_stampPng, map[string]*bintree{}},
+		"lgtm_wip.png":      &bintree{imagesLgtm_wipPng, map[string]*bintree{}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	if err != nil {
+		return err
+	}
+	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+	children, err := AssetDir(name)
+	// File
+	if err != nil {
+		return RestoreAsset(dir, name)
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _filePath(dir, name string) string {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
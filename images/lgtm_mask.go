@@ -1,237 +0,0 @@
-// Code generated by go-bindata.
-// sources:
-// images/lgtm_mask.png
-// DO NOT EDIT!
-
-package images
-
-import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data []byte, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(bytes.NewBuffer(data))
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes []byte
-	info  os.FileInfo
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _imagesLgtm_maskPng = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\xbc\xe7\x57\x53\xdb\x1f\xf5\x0b\x31\x88\x20\xc2\x31\x88\x88\x94\xa0\x04\x82\x74\x50\x29\x52\x02\x82\x40\xa8\xd2\x7b\x6f\x22\xbd\xf7\xd0\x54\x0e\x4a\x0d\xbd\xa3\xf4\xde\xa4\x77\x29\x01\x69\x02\x52\x43\x0f\xbd\x17\xe9\x21\xb9\xf1\x77\xc7\x1d\xf7\xf9\x0f\x9e\x37\x87\x17\x93\x01\x23\x23\x7b\xef\x55\xbe\xf3\x33\xd7\x5a\x49\xf8\x5b\x15\xb9\x7b\xe4\x8f\xc9\x89\x88\x88\xee\xc1\xe5\x65\xd4\x89\x88\x00\x41\x44\x44\xc4\x4b\x77\x6e\x13\xfe\xf3\x72\x74\x6e\x95\xf0\x8b\xd8\x4d\x5d\xee\x35\xf1\x4c\x3f\x57\x20\x11\x91\x24\x11\x5c\x46\x4a\xd3\x9b\x78\x6f\x9e\x34\xd9\xd2\x2a\x05\x7f\xd3\x15\x63\x66\x1a\x44\xf7\x29\xf8\x89\x22\x28\x96\x59\xaa\xe3\x8d\xd9\x9d\xa7\xff\x02\xd8\x1e\xd0\x58\x9d\x55\x5c\x6f\xe3\xe7\xa7\xde\x4c\x35\xec\x5c\xa5\xe2\x4a\x9b\xec\xf4\x8d\xca\x75\x04\x36\x9f\xde\xbd\x2d\x23\x13\xf2\xa3\xfc\xe9\x1d\x79\xeb\x3b\xb7\xc6\x57\x9f\x6c\x30\x9b\x5d\xdd\x04\xf8\xed\x74\xa6\x66\x16\x6f\x6f\x93\xcf\x8b\x3f\x5f\xdc\x68\xda\x97\x98\xa0\x51\xb9\x48\xd7\xf0\xd8\x6f\xca\x72\x53\xd1\x4a\x50\x26\x26\x79\xf0\x56\xf2\x3f\xf9\x4f\xfe\x93\xff\xe4\x3f\xf9\x4f\xfe\x93\xff\xe4\x3f\xf9\xbf\x20\xbd\x5a\xa6\x29\x2e\x19\x4d\xd4\x3a\x92\xc1\x3d\x52\x41\x23\xe1\xa0\xc1\x83\xc3\x91\xc8\xce\xa6\x8f\xec\xf5\x74\x89\x68\x0f\x2a\x3f\x8d\xbf\x2f\x34\x7d\x7a\x87\x02\xcf\x9c\x75\xe7\x64\x55\x8c\xfd\x97\x42\xb6\x7d\x8d\xd5\xf4\x76\xe3\x8d\x6f\x29\x44\xd0\x6a\xba\x62\x2b\xe2\xd4\x9b\x3f\x23\x9e\x5d\xda\x91\x2a\xfa\x58\xf7\xa6\x25\xc1\xb2\xc2\x9b\xab\x2f\xf4\x54\x8d\x69\x43\xe2\x71\x97\xeb\xe2\x2f\x46\x46\xdc\x33\xb4\x24\x9d\xa7\xf0\x63\x93\xc9\xaf\x92\x7a\x8b\x56\xd3\xcb\xef\xae\xce\xaa\xab\x8d\xe2\xcd\x60\x6c\x9a\x89\xad\x14\xd7\xfc\xf7\x50\x45\x92\x26\xc0\xab\xcb\xde\xcd\x01\x0a\xd1\xb1\x3c\xf5\xa0\x3d\xf7\x99\x6e\xd7\xda\x27\x70\x32\x07\xb1\x3a\x13\x89\x4d\x32\x64\x32\x2d\x35\xef\xc4\xd3\xd0\x9e\xf2\x65\x1d\x90\x84\xfd\x8e\xc5\x25\xd6\x04\xbc\x56\x85\xfc\xe7\xe1\xb5\x6f\xf4\x8a\xba\xd2\xe1\x33\xb5\x7d\xce\x2e\xd7\xc0\x80\x6f\xba\xa0\xee\x96\x52\x5b\xfa\x36\xee\xc3\xc0\xf0\x7c\x5e\xfd\x10\x70\x47\x8b\x1a\xe7\x4e\xc1\xa8\xc5\xfc\x82\x4e\x7e\x7f\x25\x4e\xfc\xd7\xf0\xd5\x8e\xe7\xec\x5e\x4b\xa6\x43\xfc\xc8\x97\xe3\x14\x35\xae\x9f\x95\x19\x2e\xdf\x4f\x18\x12\xdb\x81\x68\x8f\x2a\x76\x89\xbd\x88\xae\x0d\x76\x3e\x47\x40\x2b\x42\x3a\x02\x1d\x22\xbc\xcc\xaa\x1d\xe4\x10\x2f\x63\x99\xd0\xc4\x1e\xbe\xe4\xcd\xf5\x02\xbc\xef\x3f\x8d\x17\x39\x72\xe7\xce\x1c\x89\xa1\xc2\xf7\xe4\x55\x59\x58\x42\xc3\x31\xe4\xb5\xba\xcf\xba\x12\xa8\x4b\xd8\x04\x95\x4a\xb3\x92\x53\x92\x33\x97\x24\x98\x30\x22\xa5\x54\x89\xa7\x1f\x81\x03\x46\x80\x68\x14\x88\x8a\x95\x49\x45\x2c\x6e\x49\x64\xcd\x67\x4d\x70\xa4\x24\x7a\xe3\x38\x90\x8e\x14\xc3\xaa\xa0\x9e\x9c\x76\xb7\xb6\x9a\x3b\xfe\xd2\xb2\xaf\xae\xf3\x98\xab\xac\x4c\xaf\x7a\xf4\x60\xc4\x73\xf2\xfe\xce\xe7\x53\xd4\x75\x76\xa9\xd3\x02\x3d\x66\x66\xfa\xe1\x36\x8c\x77\x5a\x76\x7a\x78\x66\xc1\x6f\xbe\x12\x15\xec\x90\xb6\x86\x5a\x9e\x01\xf2\x0c\x4d\x2a\x32\x55\x3f\xe3\xe3\x07\x7a\x5b\x4c\x3f\x48\xee\x03\xa0\x2d\xd7\xeb\x14\x4a\x13\x75\x59\xaa\x7c\x43\x64\x72\xc1\xe0\x4d\x3a\x87\x81\xde\x61\x07\x95\x95\xa2\x6e\xea\x70\xd2\xf7\x52\x2b\x5d\x16\xd4\x97\xd2\x59\x1f\xb2\x35\x65\xe4\xaf\xf8\x20\x45\x0b\x3c\x47\x4d\xdd\x25\x20\x03\xa5\xd4\x17\xb6\x39\x1e\x53\x46\x5e\x97\xf7\x84\xe6\x2a\x67\x4d\x43\xef\x15\xcf\x95\x6b\x1b\x79\x4c\xbc\x5f\x4b\xd0\x29\xd7\xd6\xb3\x4a\x78\xef\xec\x5c\xe9\x7b\x36\x63\x43\x1b\x6e\x93\xe9\x93\x9f\x0a\x0b\x44\xfc\x7d\xb9\xd6\xf9\x82\x9f\xd3\x29\xf6\x64\xa4\x46\xba\xfb\x0e\x3d\xe8\x87\x1f\xb5\x17\xe4\x36\x48\x95\xcd\xbb\x65\xf7\xc5\x11\x57\xff\x0b\xd1\x5f\xc8\x07\x89\x96\x7c\x5e\xf7\x6a\x75\xd6\x0f\x26\xc9\xea\x3e\xbe\xa4\xb6\xbf\xcb\xc9\x96\x38\x09\x8c\x91\x49\x05\x7e\xf0\x10\x69\x39\x7d\x4e\x6c\x19\x30\x8f\x34\x15\xaf\x42\xf8\x80\xc5\x92\x4d\x03\x9e\xc3\x30\xa7\x12\xa6\x29\x5e\x8a\xf6\x31\xc9\xea\x5f\xfa\xe9\x90\xff\x9c\x61\x4e\x56\xf6\x23\xce\xdb\x80\x68\x7e\x13\xff\x75\xa8\x2e\xc2\xbc\x85\x7a\x79\x30\x44\x39\x78\x12\xc0\x16\x54\x16\xe0\xef\xee\x4f\x22\x68\x68\x11\xf9\xb2\x7c\x26\xe0\x7c\xfe\xba\xf1\xb9\x9d\x1f\x19\xd8\x77\xf3\xeb\x76\x74\x20\x16\x73\xcf\xa7\x53\x95\x7a\xf7\xcf\xf4\x91\x7a\xeb\x53\xdb\xaf\xe4\x7c\xf7\x61\x7f\xaa\x38\xb5\xee\x23\xf3\x6a\x41\xa4\xac\x67\xca\xbb\x4d\x47\xbd\xf4\xaa\x19\x2f\xed\xb4\x6b\x31\x51\xa2\xda\xa2\xbb\x95\xd2\xb5\xf3\x9e\xe8\x7b\xac\xbe\x3e\xa5\x18\x59\xa4\x37\xfe\xe6\xbc\x02\x69\x19\x93\xec\x82\x76\xa8\xd7\x9a\x32\xf6\x73\xf0\x18\x7a\x39\xa5\xe9\xb6\x3e\xe8\xb3\xd5\x43\x6b\x62\x29\x27\x12\xd3\xc0\xef\xe2\xb6\x72\xa4\x1f\xa3\x24\x96\x6a\xda\x17\x00\xf9\x08\x11\x69\x18\xd6\x75\xa8\x9d\x28\x9f\xb7\x88\x16\x4f\x9c\xb4\xe4\x05\xde\xad\x0f\x0e\x0e\xde\xce\x4b\x32\x2e\x36\x3e\xfa\xac\xc3\x85\x95\xcc\xc2\x37\xe3\xae\x76\x4a\x44\x0f\x5a\x4e\x4a\x95\xd6\xb7\x81\x07\xa1\x5a\xa0\x52\x96\x85\xbb\x14\xf0\x39\x54\x0e\x23\x92\x97\xdb\xa6\xed\x6a\xa2\x82\x29\xce\xd6\xc4\xb9\x93\xe4\x32\xf3\x04\xeb\xf5\x9c\x1f\xd9\x2e\x0a\xe2\x12\xed\x37\x9d\xb1\x76\x1b\xcf\xef\xe8\xa6\xe1\x23\x4e\x6f\xd8\xe9\x15\x29\x29\xf6\xa8\x9f\x73\x1d\xb0\x42\x5c\x0e\x98\xa8\xdc\xf9\x72\x32\x02\x6b\x9f\x75\x6a\xbf\xc9\xb7\xb1\x16\x54\xfc\x55\xdb\x1d\x26\xde\x4f\x41\xf3\x89\x2c\x36\xd7\x5a\x62\xdb\x00\x8e\x95\xd7\x3c\x57\x62\xc8\x24\x5f\xc4\xc1\x2f\xd8\xc0\xae\x7d\x4f\xb8\x14\x6b\x67\xac\xb9\x14\x9c\xd0\x02\x03\x5c\x1a\x43\xc9\xfc\x16\xec\x9e\x0b\x3e\x1b\x1f\x65\x71\xbf\x35\x54\xb9\xfd\xfa\x59\x93\x06\x5b\x36\xbf\x7d\xb1\xc6\x61\x37\x60\x81\xf3\x91\x42\x0b\xf2\x48\xb9\x97\x5b\xd5\x40\x51\x35\x0e\xb8\xa6\x56\xe1\x23\x73\x45\x01\xf9\x37\x56\xef\x8b\x91\x03\x2a\xf1\x0e\x4f\x4e\x9e\xef\xef\x19\x2c\x5e\x4d\xbe\x65\x3d\x7b\x9e\x75\x39\x98\x3c\x3c\x60\x5b\x90\xa1\xdd\x7a\x3d\x7f\xb0\xc0\x4d\xbd\xf6\x85\x2a\x41\x10\x04\x2e\x0e\x04\x6a\x75\x1e\x29\x10\xe1\xd7\xb3\xf0\x97\x59\x29\xf5\x83\xaf\xf1\xe8\x3f\x5b\xe3\x3a\xde\x87\x9d\xa4\xf5\x3f\xe3\xf7\xe1\x95\x83\xf6\xcd\xf6\x35\x2d\x07\x17\xaf\xdf\x69\x98\xbe\xbe\x9b\x4c\xa4\xb2\x39\x51\xf3\x7b\x64\x4e\x46\xa9\x58\xfe\x6c\x13\x01\x71\xf9\x7a\x2c\xa5\x55\x06\x9e\x57\xa4\x73\x40\x45\x5e\x2e\xb7\x2a\xaf\xc8\xd5\x1e\x50\x1b\xd8\x57\xf5\x9c\xc9\x31\xf9\x58\x9e\x2e\x9c\xb7\xd5\xa7\x8a\xb8\x59\xef\x3f\xce\x12\xf4\x39\x5e\xfd\xda\x1d\xb7\x7a\x52\x62\x72\xf3\xde\x63\x5c\x59\xa8\xcc\x77\xce\x05\xb5\x17\x33\x8d\x1b\x95\x03\xb7\x3e\x73\xf3\x30\x06\x98\xa4\x17\xe5\xc6\xbc\xad\x7c\x1e\xf0\x84\xfb\x96\xc1\xfd\x47\xbc\xd1\xa1\x54\x12\x0d\xa9\x02\x56\x3f\xbf\xa3\xc0\x81\xfe\x37\xb3\x2d\x6f\xcb\x58\x8f\x1a\x61\x52\xe7\x12\x9c\x9f\x73\x03\xb8\xd0\xb2\x5b\xdc\xd3\xd7\xbf\xa4\xa9\xf2\xae\x7d\xb8\x60\x42\x93\x7d\x7f\x2e\xb8\xb2\x4b\x52\x46\x00\xe7\x45\x52\x95\xdc\x4c\x00\x6a\x07\x54\xad\x77\xa1\x48\x92\x62\x0d\x91\x28\x6d\x08\xe3\xbd\xce\x28\xc7\x96\x28\x9f\x9f\xb9\xb4\xb6\xbb\x41\xd3\xf7\xf7\x92\x2e\x8c\x9a\xba\x54\x94\x7b\xac\xaf\x21\xfa\xa1\x59\x57\xbf\x0b\x7a\x5f\x5e\x1a\x58\xaa\xb8\xa1\xb4\x0d\x1c\xb4\x02\x6e\x76\x4c\x0a\xbe\x3c\x1a\x1f\x02\xd1\x4f\x98\xcf\x53\x14\xf1\xb8\xb4\x3c\x8d\x16\xc7\x6f\xc0\xe2\xa8\x66\x3f\x4b\x36\x81\x07\x86\xf8\x19\xcb\xbf\x8f\x29\x4c\x98\x85\x31\xf6\xbe\x9a\x61\xee\x74\x49\xd6\xa0\x1e\x07\x95\xf2\xb6\xb9\x47\xa0\x10\x6b\xa1\x61\x60\xc4\x45\x2e\x8d\x8e\x61\xec\xe5\xe3\x01\xed\x7c\xd4\x46\x87\xcc\x35\xd7\x23\x45\xd2\x82\xda\x47\xe4\x11\x28\x7a\x0f\x7e\x4b\xd7\x44\x14\x7c\x29\xaf\x20\x0b\x91\x8a\x49\x67\x93\x09\x39\xed\x63\x89\xee\x90\xd4\xa1\xa2\xa1\x8a\xd2\xb2\x90\xff\xf8\x99\xa7\xf2\xc8\x71\xf7\x5f\x79\xdc\xa4\x22\x43\x3b\x61\x70\x66\x79\x5c\x6d\x15\xb4\xbf\x0a\x9f\x58\x6a\x5e\x38\x2d\xe7\x98\xaf\xb6\x92\x7b\xb9\x70\x32\xe3\x7c\xb5\x95\xba\x8e\x98\x7c\x4e\x46\x12\x0e\xa4\xa1\x41\x92\x35\x8d\x7b\x1f\xf6\xbb\x83\x6d\x4c\x39\x01\xde\x62\x47\xc0\xd6\x72\x8b\x45\x89\xc3\x60\x2a\x3e\x35\xca\x30\x53\x46\x35\x12\x16\xb2\x5c\x57\x45\x2d\xff\xd2\x12\x53\x7b\xb0\xdc\x60\xa6\xb8\x68\x68\x95\x6d\xfa\x1f\xcd\x92\x8e\x6a\xb5\xc4\x11\x00\x25\xd9\xd7\x2b\x91\x27\x12\x15\xbd\xb2\x3f\x8a\xb8\x86\x09\x53\xf9\xcb\xc9\xac\xc1\xf1\xc6\x4d\x13\x83\x33\x42\x85\xd2\xd5\x64\xfd\x7a\x11\x8f\x48\x20\xff\x95\x1c\x58\xa9\xee\x71\x35\x1a\x8d\xd0\xdc\x6b\xdc\xc8\xd4\x14\x67\x6f\x34\x4c\x7a\x24\x3f\x17\x9b\x8d\xfe\x22\xce\x77\x1e\xed\x91\xa4\xc3\x76\xe9\xca\xd2\x98\x33\xf0\xc7\x01\x9e\x5b\x7e\x85\x6e\xbf\x41\x77\xb9\x56\xbf\x39\x8d\xf2\x6a\x09\x85\x2a\x6d\x76\x47\xd3\xe5\xba\x58\xbb\x3c\xde\x49\xfa\x10\x0d\x0c\x59\xf2\x4c\xc1\x17\x64\xfa\x23\x17\xde\x18\xdc\x67\x26\x16\xbb\xda\xcc\x21\x1d\xdf\xd3\x5b\xd3\xfd\xe9\x7a\x9e\x4c\xe1\x35\xef\x31\xc5\x81\xda\x40\x62\x63\x2f\x62\x4b\x2b\xb4\x9a\x87\xe8\x66\x35\x1d\xf4\xbc\x2c\x26\xce\x86\xfb\x15\xe9\xdd\x40\x1a\xed\x06\x4d\xad\x25\x15\xfe\x6b\x9c\x13\xb2\xf4\x0e\x2f\x2e\xa6\x2d\xa2\x87\x06\xfe\x38\x2a\x1e\xea\x75\xb8\x04\x41\x42\xa8\xd7\xee\x9a\x59\xf7\xe4\x4b\xda\x05\x57\xe1\xf2\x27\x7d\x1d\xfd\x35\x83\x3f\x92\x5c\xf0\x05\x0c\x30\x7a\xcd\x1d\x35\x45\x23\xfe\xe4\x64\xdd\x8c\x25\xb5\x7d\x5f\x44\x49\x9c\x55\x6d\x97\x39\x05\xec\xbc\x9d\x5a\xfe\xa1\xba\x37\x1f\xe8\x5e\x46\x28\xb5\x5a\x13\x7f\x0b\xd6\x76\x6e\x9c\x4a\xb1\xfa\x25\x4b\x7f\x33\xdb\x11\x0a\x08\x07\xa3\x5e\xb9\x20\x01\xe0\x2b\x4d\x62\x38\x11\x4a\x4c\x64\xe8\x85\xad\x7f\x78\xce\xc0\xb9\x62\xd1\x15\x63\x42\xf1\xa2\x40\xf3\x5e\x1d\xfd\x79\x52\x77\x38\xda\xe8\xe5\xf0\x52\x86\x90\xd3\xc2\xaf\x35\xa3\xfd\xe6\x83\xb6\xcf\xd9\xd9\x0d\x57\xdb\x25\x6e\x5c\xd3\xbf\x9c\xcb\x6a\x27\x8b\x5d\xd3\xcd\x6a\x4f\x27\x0d\x74\x44\x0c\xbc\x7e\x8f\x97\x68\x55\x2b\xb0\xd6\xba\x17\x24\xf0\xbc\xb4\x17\xc1\x1c\x7f\xec\x3e\xe6\x2c\x95\x44\x98\x95\xb0\x76\xdf\x82\xdd\x6c\xa9\xd2\x42\xac\xd7\xed\x2f\x13\xa2\x84\x57\xfe\xdd\x0e\xba\x45\x59\x58\xcd\xfd\x87\x71\x33\x14\x8c\x35\x3d\xdd\x52\x0d\xf4\x95\x65\xeb\xf6\xfb\xa6\x49\x5c\xb4\xe0\xaa\xcd\x45\x17\xa0\x97\xd5\x38\x33\xe5\xb9\x6a\x82\x3f\x35\x39\xf7\x00\xa4\x5f\x0e\xd2\x76\xfa\xdf\x67\x54\x02\xc9\xaf\x9c\x87\x01\xfb\xc1\x59\x24\xfe\x94\x73\x19\xec\x03\xe2\x99\x26\xb7\x9f\xd3\x0b\x92\xcc\x52\x6f\xce\xa8\x7c\x6b\xed\xbf\xe4\x22\x79\x05\x5a\xfa\x42\x3e\xf1\x29\x03\x04\x56\x6e\xe1\xb1\x49\x29\xbf\xfe\x24\xd4\x62\x56\x28\x70\xbe\x2e\xd4\xde\x58\xc2\xc8\x34\x55\x76\x3d\xe4\x84\xd0\x30\xf2\x9c\xb1\x32\x5c\xcf\x6b\x22\x3c\x4b\xbf\xa0\xf0\xf5\x9f\xb3\x6e\xad\xf0\x34\x6a\x88\xef\x1f\xe2\x13\x6e\xfc\x32\x30\xda\x0d\x2c\xda\x74\x54\x4c\x84\xc4\xaa\x05\x8b\x04\x4c\x96\xea\xf9\xb1\x99\x31\xa6\x0b\x3a\xe8\x4f\x1d\xd8\x36\xd5\x21\xae\xd1\x8b\xda\x22\x8a\xf5\xaf\x76\x4a\x6b\x8a\x09\x8d\x92\x06\x6c\xe0\x7a\x7e\x1b\x68\xb4\xac\xc9\xa8\xc7\x0b\xd4\x5b\x8c\xb0\x4a\x32\xf2\x9c\x37\x2c\xb6\xfa\x88\xc7\x8e\xe0\x19\x26\x5a\xf8\x5a\xb9\x6e\xb1\xb6\x71\x82\x76\x95\xe0\x0d\xbc\xaa\x73\x48\xd3\x59\xf7\xb2\xf9\xac\x55\xb7\x57\x36\x8f\xa2\xf3\x8d\xbc\x97\x3f\x4a\xf7\xdb\x35\x57\x3c\xf1\x99\xb5\xab\x68\x9d\x0d\xb8\x7a\x3a\x82\xd1\xff\x57\x64\xc0\x27\xf6\xda\x60\x5e\x8a\x26\x7a\x87\x3b\x2a\x9f\xd1\xfd\x97\xd4\xc4\x8c\x4d\xd6\x50\x1d\xc1\x19\x38\xa5\x26\x1c\x4a\xba\x4b\x04\x17\xfd\x4f\x26\x30\x7a\x7b\xad\xe7\x0b\x34\x07\x54\xe5\x98\xe9\x9d\xde\x6b\xb6\x24\x23\x1f\xdb\xce\x10\x92\x24\xcb\x39\xff\x27\x4d\x4f\x8e\x0a\xba\xd3\xbb\xb1\xc0\x83\x48\x44\x20\xbf\x3e\x27\xea\x66\x3e\x6e\xcf\xbc\xe7\xe5\x94\x61\xcd\x8e\x03\xec\xe4\xda\xf5\x8b\x43\x8f\xde\xc6\x46\xba\x1b\x65\x52\xeb\x57\x3f\x2f\xe6\xa3\x6b\x60\xd6\x5d\x88\xdf\x69\xe7\x06\x60\xbe\x93\xa1\xf0\xa7\x5f\x57\x7e\x10\x80\x9c\xfd\x17\x0b\xb1\xa7\x23\xe4\xb1\xe6\xe3\x49\xc5\xdd\x94\x8b\x82\xee\x7b\x59\x37\xbb\x36\x6e\x5b\x8d\xc3\x68\xda\xb6\x43\x12\x4f\x75\xff\x8e\x1a\x6a\x56\x62\x91\x67\x47\x8d\xdd\xbb\xdd\x9d\xe1\x7a\xe1\x19\xd4\xbb\x86\xcf\xca\x7e\xcd\xf7\x54\xa9\x40\x24\x21\x66\x98\xd1\xe9\x99\xb2\xb8\x06\x81\x89\xd1\x4e\x06\x68\x63\xb6\x5b\x30\xde\xc7\xaa\xd8\x39\xd4\x04\x77\x9c\x44\x5a\xa0\x92\xa1\x40\x12\x9a\x81\xb6\x0a\xc7\xf3\x1e\xf5\x03\x59\x1f\x5e\xc5\x9f\xab\xb6\x3c\xa9\xb2\x0b\xf9\x80\x7d\x19\xce\x08\x12\x9d\xc4\x2d\xb2\x74\x7d\x69\xbb\xd9\x73\x8a\x7e\xef\xe9\xfa\x8d\x7c\x27\x48\xe6\x20\xa4\xfb\x28\xbc\x9d\xd0\xbb\xfc\xe2\x9d\xb4\x44\xb3\xf7\x08\xb8\x30\x5f\xd8\x9b\x66\x51\x7b\x5b\x23\xcf\xd2\x87\x49\x3e\x46\x77\x58\xd9\x30\x8b\x59\xed\xeb\x37\x51\x31\x47\x91\xc3\x8e\x5b\x72\x2f\x23\x97\x55\x71\x33\x23\x88\xde\xea\x98\x3b\x7c\xca\xc5\xd2\x9a\x9f\x7f\xbe\x80\xbf\x0f\x53\x3d\xc1\x51\xab\x8a\xb9\x5c\xbc\x93\xc5\xc2\x12\x8c\xf3\xdd\x8c\x56\x76\xbb\x85\xa8\xc1\xf2\xa6\x75\xca\x2b\xbf\x80\xe7\xe2\x89\xbc\x82\x2c\x51\x25\xe3\xd0\xdd\x76\x3c\x6e\xf0\x16\x7c\x93\xf7\xfd\xb8\x5f\xe0\x27\x34\x74\x52\x29\xfe\x34\x31\x2d\xc0\x0e\x2f\x1a\x66\xed\xfe\xea\x5a\xd9\x24\xc9\x4e\xab\xda\xb0\x9b\x91\x0c\x34\x17\x47\x6d\x22\xef\xcf\x7c\xd4\x0b\x44\xe3\x1e\x92\xb5\x45\x96\x66\xea\xf2\x57\xd9\xff\xc8\xef\x30\x67\xe1\x7b\xe8\xf4\xab\xe7\x76\x17\x5d\xc6\x2f\x80\x3b\xb4\x8a\x65\x03\xfd\x32\xe1\x23\x27\xf5\xae\xe6\xb3\x34\xfb\x8c\xcd\x3a\x71\xe4\xfd\xb9\xd4\x06\x5e\x7e\x5e\xa9\x6f\x1d\x61\xb0\x73\x58\x86\x69\x98\x4a\x4b\x8c\x29\x53\x32\xd8\xf3\x1f\xcc\x84\x3c\x0e\x71\x73\x14\x1d\xe8\x8f\x76\xf2\xc7\xd0\x0f\xde\x22\x33\x43\x71\xc3\x15\x4f\x85\x02\x4f\x84\x0c\x3b\x52\x3e\xe4\x69\x2e\x54\x66\x23\xa5\x05\xe4\x7b\x4e\x8a\x80\x67\xc2\xf1\xa7\x9d\xaf\xbe\xf2\xdf\x80\x16\x15\xa7\x2e\xe9\x5d\xb9\x56\x7e\xdd\xda\xc3\xf6\xbb\xfb\x3c\x4e\xd0\x54\xa2\xf3\x72\xe1\xc1\x8c\x18\x4d\x74\xd9\x52\xa0\x1d\xb9\x74\x9a\x76\x0b\x7f\x23\xd9\xab\xc4\xea\x27\x42\xc8\xaa\x8d\x77\x50\x59\x81\x61\xa0\xc2\x0a\x97\x9c\x81\x5e\xda\xcf\x22\xe1\xa4\xd4\xf5\xd0\xe9\xba\x42\xa4\x9a\xb2\xd5\xe5\x36\xda\x18\x4e\xd4\xae\x74\xbe\xf7\xee\x31\x2d\x9b\x2a\xa7\x4a\x29\xf5\x24\x15\x9a\x73\x1c\x32\x73\x63\x14\xc2\xcb\x8b\x66\xbb\x1a\x13\xa6\x5b\x9d\x73\x1b\xb1\x32\x68\xe1\xec\x4c\xaa\x29\xaa\x7d\xd2\xcf\x7b\xbb\x45\xe7\x8a\x40\x15\x3e\x39\x12\x2f\xeb\xde\x2c\x50\x25\x83\xb8\x2c\xf4\xa2\x55\xd7\xcf\xdc\xc2\x57\x60\xfe\x19\xeb\x4f\xd2\x00\xd4\x06\x1d\x40\x67\xf3\x0a\xf0\x86\xa3\x19\xb1\x44\xcc\xcc\xd7\x7f\xfc\x0f\x91\x11\x95\x71\x5b\x5a\x18\xe9\x74\x97\xb1\x0c\xe9\x10\xc9\x1f\x1d\x7b\xb2\x64\xaa\xfb\x4c\xf5\x75\xfa\xf9\xfe\x2a\x3c\x44\x2d\xdc\x53\x63\x3b\xf9\xa3\xf3\xd7\xb1\xd1\xfe\xb9\xaf\xce\xa6\x2d\xf4\xf6\x39\x41\x42\x6c\xd3\xa3\xb1\xd8\xea\x13\x96\x14\xe6\xe0\x15\x17\x4f\xef\xe9\xb8\x17\x09\xdd\xe1\x1e\xea\xfa\xf0\xd7\x81\x47\x05\x84\xca\xb3\x40\xef\xd8\x60\xd6\xaa\xd3\xba\xad\xb5\x56\x95\xd8\xa5\x0f\xe8\x6b\xd0\x23\xb5\xba\x49\xb4\x4d\x98\x62\xdb\xa0\x53\xf0\xfd\x89\x67\xa1\x8c\xd3\x3d\x62\x40\xde\x5f\xcf\xda\x8d\xb5\x6a\xe6\xee\x6c\xb2\x42\xd3\xb5\x61\x16\x5e\x9c\x9f\x7e\x5b\x0e\xb9\x6f\x0d\x19\x51\x72\x32\xde\x13\x44\x3b\x06\x34\x63\x56\xbe\xf0\xe2\x2e\x3a\x3a\x3a\x52\x2f\xad\x12\xaf\xae\x0d\x16\xca\xf5\xe2\x73\xe9\x38\x1d\x6b\xca\xaa\x0d\x29\xf4\xc0\x73\x8b\xd5\x2e\x52\xb6\xa0\x46\x43\xb9\x8a\xb5\x93\xc7\xe2\x7d\x47\x0d\x3e\xf3\xf1\xe9\xff\x74\x0a\xc2\x63\x88\xd4\x6f\xd2\xd2\x99\x67\xac\xf4\x06\xea\x90\x69\xe2\x0e\xa2\xab\x96\x11\x7b\xe7\x33\x36\xe0\xd2\x81\xba\xa9\x71\x32\x4b\xcb\x41\xd1\x4f\xc9\x89\xab\xa9\x7b\xff\xe2\x7d\x4a\x4d\x06\x12\xd5\xd0\x0f\x36\x2b\x3a\x72\x71\x51\x0b\x55\x9c\xbf\x84\x82\x8f\x3b\xc5\x56\x28\x1d\x1f\xb8\xbd\xed\xd5\xf3\xfc\xa6\xf9\xaf\x3c\xc3\x45\xd6\x96\xd2\x07\xaa\x6b\xab\xe7\x60\xd4\x7a\xc8\xb0\xa8\x7b\x1a\xd6\x05\x44\x0c\xa5\x80\x5c\xb2\x94\xbc\xe2\x75\x51\x5e\x59\x07\x26\x4c\xd0\x53\xbb\x51\x6b\x22\xe5\x01\x70\xf3\xe9\xad\xd8\x5f\x31\xef\x96\xa4\x62\x24\x13\xd8\xcf\x8f\xd2\x35\x54\x25\x2e\x57\x29\x66\xed\x3c\x68\x3a\xaa\x29\x15\x08\x65\x4a\x11\x79\xd9\xf7\x8e\x23\x48\xc4\xb3\xea\xe7\x88\x07\xbf\x13\xe4\x44\x13\x35\xed\xa2\x31\xba\x1a\x98\x98\x82\x87\x1f\xee\x84\x6e\x9c\x16\x27\x58\x99\x5d\x9b\xb5\x40\xc6\x4e\xc7\x39\xea\x0f\x54\x4d\x56\xa3\xe5\x30\x3d\xaa\x1d\xac\x5b\x3f\x48\xa0\x89\x55\xd3\x6e\x69\x5b\x89\xd7\x0d\xf3\xd5\x2e\x8c\xbd\x2a\x81\x66\x1f\xed\x00\x93\x08\x1f\x8b\x79\x25\xcb\x04\x99\x22\x40\x79\x26\x9a\x93\xf5\xaa\x31\x3a\x65\x28\x9c\x7d\x88\xc7\xa5\xbb\x62\x7e\x0e\x71\xbd\x97\xf2\xb1\x9c\x3e\x51\xb8\x86\x69\xa7\x1e\x7f\xdf\xa1\x39\xb3\x9a\xe5\x6d\x76\x34\xe0\xc6\xdf\xed\xcc\xa5\x8e\xb1\x16\x10\x70\x9f\x3d\xfc\x36\x78\x17\x88\x4f\xba\xbe\x12\xe8\x54\x8b\x8a\xe7\x40\x41\xbd\x66\x6d\x0b\xbc\xd8\x6c\x77\xde\xb7\xb9\x22\x15\x7b\x78\x5f\x41\xa4\xbe\x09\x9c\x5e\x77\x67\x84\x1b\x80\xdc\x40\xf5\x6c\x85\xf1\xb1\x90\xd8\x86\x4a\x0c\x4b\xe7\xa3\x21\xca\x83\x55\x0d\x55\xf1\xda\x15\xe6\x92\x16\xc7\x6e\x41\x2e\x62\x4b\x1b\x45\xa4\x1e\x32\x4d\x20\x4a\x4d\x8b\x48\x55\x64\x2d\x9e\x03\xca\x1e\xb3\x1a\xdb\x1d\xe7\x9f\x90\x1b\xfd\xf3\xc1\x39\x3f\x0e\x29\x3c\x41\xe5\xe6\xa5\x6a\x00\xef\x4d\x96\xad\xb1\x65\x1a\xa1\xdb\xb0\xb5\xd2\x33\x18\x26\x9a\xca\xa5\xe0\xc6\xac\x5d\x9e\x62\x78\xcf\x13\xa6\xa2\x85\x25\xca\x3b\xfb\xc8\x60\x19\x82\xc5\x61\xb6\x76\x50\xf9\x0b\x79\xa2\x02\xd6\x49\xa5\x98\x7f\xce\x4a\x92\x6c\xf3\x20\x88\x83\x22\x65\xd1\x38\xbd\x86\xa1\x2c\x58\xe0\xbb\x94\x44\x83\x72\x9b\x38\x54\x95\xc4\xc3\xcc\x6c\xfc\xb3\xca\x47\xe4\xc9\x44\x3c\x9f\x38\x87\xf4\x8c\xdf\x93\x78\xea\xf9\xed\xd5\xb6\xc7\x67\x9c\x9a\xd6\xb7\x7b\x1f\xe2\xce\xdb\xb3\x9e\x67\xc1\xff\xc5\xc5\x2f\x4d\x7f\x47\x53\xd7\xb3\xad\xb3\xca\x93\xc3\x93\x15\x56\x84\xcc\x41\xb1\x1b\x94\x4e\x42\xab\x5c\xa3\x9c\x27\xd7\xfd\x84\x84\xe0\x92\x92\xf2\xa2\x98\x13\x02\x67\xbd\x64\xd1\xe3\x08\xf7\x76\xd4\xce\x51\x20\xb2\x23\xa3\xa1\x81\x21\xc5\x39\x92\x21\xa1\x5c\xb4\xe3\x74\xca\x02\x69\x05\xe7\xad\x46\x99\xef\x27\xee\x11\xac\x13\x3f\x91\xa8\x53\x76\x4d\xcb\x99\x98\x23\x41\x5c\x7a\xfe\xde\x2e\x1f\x4e\xe4\xc6\x00\xb6\x69\xab\x3f\xc5\x2e\xab\xce\x12\x5c\xaa\x58\xa7\xed\x38\x01\x12\xe8\x8e\x57\x51\x29\xd5\x6a\x6e\xd5\xff\xc4\xc9\x56\xa8\x7f\xde\xc4\x98\x5a\xbe\xf8\x72\x9c\x64\xce\xb9\x25\xbd\x75\xd8\x2b\x7a\x34\x53\x6f\xde\xd9\x72\xa1\xa8\x03\xc4\x61\x0a\x68\xf8\x50\xae\x4b\x6b\x17\x4e\xad\xfb\x44\xd1\xdd\x7e\xb8\xdc\xc8\xb3\xf5\x74\xd2\x8e\x13\xfe\x8e\xcd\x79\x90\x1f\xe5\x1c\x29\xdd\x54\x5f\xa1\xf0\x8b\xd1\x57\xce\xdc\xd1\x9c\xb9\xf3\xc8\x55\xf3\x46\xe1\x38\x21\x8d\xb1\xfe\xcf\xfb\x4c\xb1\x95\x2f\x33\x6a\x53\x4d\xdb\xf2\xbf\x15\x00\xf2\xc8\x5e\xb1\xdf\x3a\xdf\xf7\x97\xc7\x58\xf6\xbe\x2b\xf2\xb6\x7e\x6f\xba\x5c\x4f\xa3\x23\x85\x83\x49\x77\xdd\xb0\x0f\x3b\x1d\x04\x18\x7b\x45\x47\x67\xe1\xb5\x16\x0f\xe5\xd2\x2b\xfc\xf6\x97\x0f\x07\xbf\xae\x31\x2f\x73\x5a\xb3\x3e\x61\xd0\xfa\xa1\x02\x48\xee\x22\xce\xdc\x50\x97\xcf\xa8\xd3\x67\x75\x94\x35\x6e\x77\xfd\xe6\x7e\x4b\xb5\x7f\x0f\xe1\x71\x2b\xb0\x72\xa2\x1f\x9c\xf7\xcd\x16\x30\xbd\xf5\xf8\xd4\x43\x31\x34\x88\x6c\x69\xac\x32\xd1\xa8\x97\x55\x6a\x49\xa3\x51\xac\x5f\xb0\x16\x53\xf6\xca\x38\x13\xf2\x66\x42\x4d\x8e\xf1\xcd\x98\x73\x40\xc8\x87\x7f\x23\x3e\x1f\x1b\x6c\x1d\xb3\xf2\x43\x62\x1f\x69\x11\x5a\x5c\xe8\x3d\xec\x27\xc9\x67\x93\x3e\x5e\xed\xef\x0c\xb4\x70\x23\x04\xd9\x07\xcb\x68\xd3\x59\x4d\x26\xf8\xd8\xa7\x11\x39\xca\x1d\x4c\x4c\x77\xac\xc1\x7c\x3f\x18\x12\xac\x79\x23\x0f\x14\x59\x2f\x87\xa4\x34\x8a\x2c\xcf\x52\x8d\x08\x63\x22\x68\x2d\x46\xc7\xbe\x34\x96\x8b\x98\x76\x37\x75\x47\xa2\xbb\xa8\xd7\xe7\x5e\xba\xb2\x10\x2b\xf9\x45\x14\xeb\x15\xec\x05\x1c\xf8\x99\x24\xd8\x49\xaa\x13\x43\x88\x0d\xc5\x3b\x1d\x38\x82\x4b\xd8\x12\x55\xdd\x4f\xf3\x58\x2b\x2a\x9c\xe8\x37\x52\x03\x6b\x5c\x23\x4c\xa4\xa1\x88\x27\xac\x53\x08\x06\xcb\x46\x61\xd9\x51\x82\xf7\x46\x92\x28\x11\xd1\x37\x7b\x57\x00\xa8\xe1\xa6\x71\xfd\x71\xd0\xc0\xfd\x45\xfc\xcd\x62\x73\x66\x01\x24\x10\x62\xa0\x89\x15\xae\x0a\x7d\xbf\x98\x41\x56\x5d\x7c\xf5\xd3\xa4\x8b\xd1\x2e\xf3\xf9\xec\xd9\xdb\xf2\xae\xf3\x12\xc3\x07\x4d\x6b\xc3\x81\xfb\x73\x8d\x11\x49\x32\x91\x96\x9f\x64\xf2\x25\x88\x57\xdb\xc6\xf6\xdf\x43\x89\xfd\xf7\x1b\x37\x3e\x21\x7a\x58\x79\xbf\x71\xa6\x51\xeb\x28\x9c\xf3\xa2\x70\x09\x5b\xab\xeb\x1f\xec\xce\xf3\x41\x5e\x08\x64\x47\xa2\xf1\xe0\x4b\xda\x34\x95\xae\x70\x05\x6b\x78\x26\xdd\xa4\xdf\xda\x62\xac\x01\x37\xb1\x7f\xae\x7b\x5a\x14\xf6\xf3\x9b\xac\xaa\xfd\xa6\x1d\xed\xeb\xbd\x7a\xb0\x51\x2b\x81\x1b\xaf\xe4\x71\x77\x90\x9d\xc6\x5e\xb7\x02\x0f\x0f\xbb\xa8\xb2\x7e\xef\xf2\xfd\x6b\x82\xbf\x9a\xc2\xda\xaf\x8e\x6b\xe3\x9f\x3a\x69\x64\x7f\x0c\x22\x33\xbd\xcb\x00\x56\x37\x0d\xb9\xe3\x2f\x38\xf5\xdb\x80\xbf\xbd\xe9\xd7\xc4\xee\xaa\xb7\x18\x62\x22\x08\xf8\x8e\x59\x4a\x5d\x26\x44\xc6\xe4\x69\x3f\xd8\x28\x07\xf2\x4f\xab\x02\x4e\xa0\xf8\xab\xa0\xb9\xd0\xa1\x43\xfd\x7c\x2f\x75\x0a\x2f\x92\x0e\x24\xe9\x98\xb4\x9a\x8f\x40\xa8\x73\xb5\x08\x89\xf7\x96\xa9\x72\x2d\xcf\x06\x8a\x48\x27\x16\x83\x8d\x66\xb8\x74\x8a\x70\xa7\xc5\x76\xa2\x39\xbb\x06\x8a\x47\xc7\xdd\xf7\xe5\x24\x4a\x01\xba\xc5\xf9\x14\xfc\xa5\x42\xf4\x2e\x86\xb5\xc0\xc3\x19\x77\x4e\x58\xc0\x5a\x82\x4e\xd4\x05\xd6\x5a\x75\x29\x62\xee\xfb\x16\x59\x71\x90\x3b\x5e\x9d\x24\x57\x34\x19\xef\xe7\xc1\xc0\x7c\x5d\xca\x62\x3d\x92\x99\x21\x85\xd1\x2e\xb1\x94\x68\xfb\xc9\x51\xf2\x02\x11\x72\xce\x91\xdd\x11\x13\x44\x96\x4d\x1f\x42\xb0\x26\xd3\x71\xec\xfa\xfe\xfd\xac\x8b\x6e\xa8\x6c\xad\x86\xd3\x77\x79\x13\xec\x0a\x6d\x63\xda\x36\x78\xbe\x05\xf2\x06\x12\x74\x0e\x95\xbf\xad\xf8\x23\x81\x50\xc8\x6d\x36\x1e\x7b\x45\xaf\x9e\xa7\xa7\x45\xfd\x49\x34\x70\x5c\xe6\x47\xb6\x21\xfb\x7e\x29\x0f\x60\x0f\x03\x6f\x5c\x61\x59\x3b\x0f\x25\x92\x9f\x7f\x32\xd0\xee\x7b\x4f\xf6\x60\xa0\xc7\x5b\xe1\x34\xfa\x09\x3e\x8e\x59\x79\xd5\x53\x1b\xf1\xf0\x60\x14\xfb\x47\x77\xc0\xa7\x94\x35\xc8\x2a\x23\x61\x7f\x51\x9d\xb8\x54\xc8\x4e\x84\x89\x89\xf0\xf7\x5c\xba\x49\x54\x06\x26\x6b\xb4\xae\xbe\x65\x8d\x5b\xab\xc9\x1a\x8e\xd5\x2e\x79\xb3\x75\x7b\xbd\xfb\x9f\xa6\x60\xbf\xaa\xf6\x19\x9b\x30\x46\xcf\xe9\x77\x84\x8e\x18\xd9\x7c\xe8\x60\xc8\x14\x96\xe5\xac\xe8\xf7\x9b\x7c\xe9\x9b\xc4\x42\x8e\x64\xab\x24\xef\x42\xcc\x29\xe6\xa4\x0e\x40\xfe\x47\x3b\x68\x7b\xf1\x78\x1e\x37\xbc\x45\x08\x70\x6a\x37\xfb\x5e\xed\x2c\x66\x4b\xa5\xba\x4d\xaf\x8b\xae\x62\x21\x2e\x0c\xcc\xe1\xf7\xfb\xb5\x61\xb3\x59\x1a\x95\xd7\xfc\x49\x96\xb6\x93\x17\x7b\x6b\xdf\x5d\x77\x9f\x84\x9b\xc2\x89\xe8\xb5\x6a\x90\xd7\x2f\x60\x94\x31\x18\xb2\x9d\xf2\x1b\x94\xb0\x9e\x04\xd2\x28\xb1\x11\xa2\xd4\x28\x62\x86\x76\x3b\x7a\xe5\x90\x1b\x67\xf3\x89\x35\x08\x82\xe0\x32\x10\x22\x63\x86\x9f\xde\xcc\x33\x90\x26\xf3\xcc\x5c\xd9\x01\xc6\xee\xb5\x58\x87\x38\x2e\x19\x67\x52\xb8\x5a\x96\x6c\x9b\xd4\x1d\x71\x24\xf5\x99\xb5\xc2\x6c\x00\x8f\x48\xd2\x00\xdc\x40\x25\x22\x83\xa5\x8b\x18\x00\x86\xab\x52\xd6\xb3\x38\x6c\xa3\xa1\x55\x4d\xcb\xfd\xf1\xd0\x89\x57\xdb\x09\xe3\x3e\xdb\x25\x27\xaa\xdb\x85\x82\x39\x29\xf8\xeb\x97\x78\x72\xf5\xa9\xf1\x3e\xe1\xd4\xda\x38\xb4\x3c\xd1\x80\x7f\x0c\x96\x3f\x1a\xf2\xa2\x8b\x33\x28\x02\x5f\xae\x3d\xc1\x9f\x1f\xc7\xf1\x0f\x19\x18\xf3\x64\x8b\x85\x78\x75\x95\x0a\x19\x82\x70\x77\xb1\xf9\x2e\xee\xd4\x18\x32\x04\xe7\x96\xd3\x7f\xce\xae\xa5\x91\x58\xa2\x49\x1c\x06\x1d\xe2\x2e\xc9\x53\xca\x2d\x07\x5c\xf4\xd5\x4e\x19\x5f\xef\x76\x74\x10\x79\x79\xfd\xde\x2e\x27\x6b\x30\x97\x95\x5c\x11\xe2\x96\x7a\x2a\x1f\x68\x5f\x4f\xbb\x96\x96\x7a\xde\x65\xe0\x86\x35\x19\x77\xa8\xd5\xee\xb4\xcc\xe0\xe5\x23\x8e\x08\x51\x0e\x7e\x48\x08\x8e\xdc\xce\xfe\xf2\xc1\x2e\x0f\xbd\xa7\xa4\x48\x19\xbf\xab\xd7\x4f\xb4\xa7\xff\x51\x5b\xb3\x37\xf4\x4c\x75\xbf\x44\x1a\xc6\xfe\x13\x8b\x75\x49\x67\x80\xcb\xb4\x37\xbf\xe1\x55\x16\x3a\x18\x32\x4c\xb5\xf2\x66\x89\x85\x27\xf1\x90\x51\x90\x31\x9f\xf4\xba\xf8\x4a\xd3\xa4\x53\x84\x41\x9f\x37\x96\x34\xb5\x7a\x0e\x32\xd7\xd3\xda\xd6\xee\x9b\x80\x8c\x40\x92\x89\x71\x96\x31\x6b\xab\x78\xb5\x2c\xe6\xd8\xa9\x08\xb9\x66\x2e\x68\xef\xe0\xd7\xd4\x89\xc2\x23\x3b\x76\x91\xde\xfb\xf4\x48\x09\xa4\xe5\xa4\x59\xa3\xb8\x3f\x5d\x1a\x9b\xa2\xcf\xd0\xdb\x12\x45\x22\x9f\xfd\xbd\x79\xf7\x5b\x07\x2d\x27\xc3\x13\x93\x06\x4e\xad\xea\xbd\xd2\x2b\x3a\xe5\x64\xf2\x34\x31\x8e\x66\xc8\x1f\x8e\x51\x24\xfe\x23\x5f\xc3\x98\x9a\xd4\x11\xbe\xe5\xb6\xea\x06\x69\x5e\x93\x97\x5f\xd8\x82\xd4\x2a\x9f\xfd\x7a\xdd\x01\xac\x24\x89\xa2\x7a\xc6\x37\x0c\x90\xcb\xf5\x10\xbf\x8b\x1d\xd4\xe1\x89\x94\x7a\x59\x0a\xd1\xcd\xe3\x90\xad\xd6\x6a\xba\xd7\x9c\x39\x7c\x87\x45\x38\x4f\x4d\x6b\xa7\x1d\x7f\xdd\xee\x43\x77\xd2\xc5\xef\x0b\xb1\x07\xf6\x9b\xd6\xec\xcf\x36\xd6\x2f\xf8\xda\x31\x19\x7f\xff\x1b\x95\x26\x94\x85\xe6\x65\x04\x64\x00\x1d\xd5\x09\xc0\x4a\x4d\xda\xeb\x8c\x4d\x9e\x57\x1a\x19\x8b\xe3\x7f\x52\x83\x3c\x6c\x94\x6b\xc4\x4a\x61\xa5\x0a\x2f\x9d\xbd\x01\xd3\x2f\xf4\x38\x6a\xb4\x15\x89\x06\x4e\x1c\xf7\xd8\x88\x2d\xd3\x6b\xe3\xa4\xac\x57\xd9\xa0\x81\xa5\xb1\xcc\x74\x96\xf2\xe6\xd3\xca\x80\xe4\x85\xc5\x19\xba\x37\x98\xc4\x6d\xd6\xa9\xb8\xee\x90\x53\x47\xc0\x74\x72\xbf\x1f\x8d\xb7\x5e\x07\xf0\xd0\x8a\xdc\x89\x2b\x1f\x60\x85\xaa\x4d\x1e\x46\x8c\xcf\x2c\xd8\x5f\xfd\xd9\x6a\x6e\xdd\x52\x76\x7a\x5c\x15\x32\xee\x4e\x51\x4b\x3a\x2c\x76\xa2\xe4\x18\xfb\xa0\xca\x0d\x42\xf7\x74\xe6\xe9\x13\x88\xed\xa3\x92\xdc\xcd\xdf\x86\xfe\xbe\x9d\x47\x28\xb0\xc9\x84\xdc\x9e\x6e\x7d\x63\x4a\xb4\xe8\x7b\xa9\xd3\xa8\x4b\xbb\x49\xee\x00\xc5\x1a\x60\xa5\xf6\x0d\x66\x5a\xb6\x38\x20\x22\x94\x03\xda\x4f\x14\xee\xd4\x1e\xe5\x32\x58\x78\x3d\xa6\x32\xb4\xe6\x66\xfc\xde\x5d\xb9\xc3\x0c\x3a\x54\x49\xa9\x67\x3c\xe9\x90\xc3\xe3\x86\xe0\xac\x5f\xa3\x98\xd4\x22\xf2\x59\x90\x44\x44\xae\xa6\x68\xa3\xf4\xea\x18\xf4\x98\x06\x0f\x34\xdd\x94\x25\x8b\x11\x90\xa0\x12\x68\x83\xb2\x10\x1f\x8a\xc4\xd3\x57\xb9\x83\x51\x68\xc1\x1b\xb3\x77\xb6\xe0\x77\x90\x7f\x10\x46\xad\x22\xfc\x24\x07\xac\xbf\xa1\xdb\xb2\xa4\xd7\xe1\x3c\xfd\xe0\x1d\xcf\xaf\x3d\x7e\xba\x21\xd4\x2e\x0b\x79\x44\xa4\xe4\x38\xe3\x8f\x69\xa5\x36\x27\x8d\x19\xd8\x7c\xfb\xae\xbc\xc1\x41\x2b\x3a\xd9\x7f\x98\x63\x4d\x4f\x73\x86\xe0\x82\x49\xec\x34\x02\xc8\xd2\x2a\x05\xa2\x03\xef\x3f\xa3\x72\x78\x1d\x0e\xe1\x1a\x01\x65\xd0\x2d\x24\x31\xc7\xeb\xc1\x2e\x11\x32\x90\x20\x52\x93\x47\xb1\xcb\x61\xdd\xc3\xfb\x25\xa7\x97\xd6\x54\x01\xbf\x61\xe1\x5a\x93\xd3\x84\x6d\x41\x46\x6f\xc0\x09\x08\x12\xc0\x80\x91\x8f\x77\xdc\x97\xc5\xfc\xec\xa2\xae\x9b\x3f\xe9\xa6\x56\x4d\xc7\xc7\x65\x61\x2d\xf1\x0c\x27\x75\xbf\x94\x26\x13\xc1\x2c\x48\x1f\x90\xcf\x27\x48\xf0\x3d\xea\x8e\xfb\xe5\xa9\x1a\xa0\xee\xea\x8c\xd6\x18\x0b\xf6\xc0\x2c\x48\xc0\xe2\x48\x20\xee\x84\x6e\x6d\x13\x1d\x41\xf7\xbc\xf8\xe6\x7c\x31\x70\x46\x33\xb3\x08\x20\x10\x08\x09\xd2\xa0\x86\x84\x96\xa6\x21\x4b\xd7\xad\xd1\x7b\x7d\xfa\x56\x55\x83\x1b\xaf\x79\x57\x6d\x76\xdf\xa3\x52\x5c\xcd\xdc\x28\x9f\xe8\x9a\xe9\x9a\x37\xff\x3a\xb9\x91\x23\x26\x69\x82\xb1\xeb\x48\x93\x3c\xd8\x8c\xee\x30\xd9\xab\x42\x31\x19\x45\xfb\x0c\xa7\x8b\x30\x58\xa0\x28\x93\x32\x9e\xc2\x01\xe1\x26\x03\x1f\x9e\x44\x9f\x97\x64\x24\xdf\xc7\xb3\x77\x56\x99\x69\xbc\x97\x8e\xba\x6b\x51\xa0\xa2\xc3\x76\xa2\x8b\x1c\xff\xd4\xaf\xc4\x94\x35\x42\x05\x28\x68\xf6\x9a\xd5\xb7\x8b\xd5\xc1\xaa\x0e\xa4\xb4\x33\xd0\xa2\xf8\xa3\xdc\xa8\x21\x88\x74\x0e\xa5\x97\x31\x56\x56\x73\x5f\x0c\x00\xc9\x7a\xe5\x2a\x7f\x51\xff\x7c\xa3\xde\x73\x87\xde\xc6\xbd\x60\x3e\x85\x79\xe6\xab\xb9\xc2\x4a\x04\xe3\xc0\xcc\x17\x5e\x19\xda\xfc\x54\x59\x02\xd2\x3f\x66\x33\x6c\x6c\xb7\xc2\x58\xc6\x40\x82\x76\x56\xe1\x83\xd0\x9d\x54\xa0\x73\x24\x70\xc7\xfa\x3b\xcd\x4c\x02\x72\xb0\xb5\xc0\x33\x04\x6e\xdc\xf6\x6e\x80\xbf\x5c\x2b\x2a\xd2\x12\xfd\x8c\xec\x88\xfc\x8b\xc4\x80\xdf\x83\xb7\xd9\xc6\x1d\xe4\xa6\xad\x9f\xea\x69\x92\x85\x01\x15\xaa\x3b\xbe\x8e\xef\x51\xd3\xb1\xc0\xd8\xb7\xa3\x46\xf2\x80\x3e\x4a\x6f\xae\x92\xc0\x94\xb9\xb7\x2c\x64\x16\x4d\x83\x54\x27\xaf\x57\xd4\x25\x42\xbd\x23\x7c\x56\x5d\x3e\xc9\xd4\x9c\x9d\x12\x72\xe7\x69\xe0\x9a\x54\x13\xb5\x8f\x78\xe7\x4e\x60\x62\x03\x6b\xa7\xf7\x81\x8a\x3b\xd2\x7e\xd5\x8a\x3a\xff\xe6\xcf\xb7\x2f\x3c\x57\x6b\x0f\x0d\xec\xab\x3c\xd6\x0b\xb9\xa0\xa8\x5f\xb1\xab\x86\xc9\xd9\xc5\x03\x79\xfe\xf2\xfe\x03\xaf\x7a\xbf\xa2\x05\x79\x6d\x00\x8b\xde\x0b\x3e\x1b\x0e\x19\x26\xe7\x9b\xef\x0c\x35\x2b\xe2\xb2\x2b\x32\x15\xab\x4b\x13\xef\xff\x63\xb9\x03\xec\x87\x85\xcf\xbe\x43\x1e\xd7\xef\xd5\x8c\x48\xd8\xd9\x37\x2c\x7f\xf2\xd1\xc3\x78\xce\xb9\xa0\x8e\x88\x1e\xfa\xf7\x7f\x7d\xf5\x53\x0f\x25\x48\xf2\xe0\xdd\x93\x8e\xb0\xcf\xca\xc4\x24\x6c\x89\x1d\x9c\xd6\xc3\xe9\x8d\xfb\x6c\x69\xf6\x63\xa7\x86\x84\x1b\xf3\x88\x08\xdd\xfe\x87\x8c\x46\x54\x0e\x09\xfb\xa6\x33\xf1\x2d\xe2\xbd\x66\x24\xf0\x23\x24\x7a\x4b\xc3\xa8\xb8\x82\x4f\xcd\x65\xe5\x28\xb6\x58\xd7\x64\xc4\xad\x2d\x8e\x39\x85\xb9\x28\xfb\x47\x37\x65\x96\x45\xaa\x9e\x4a\x62\x52\xb9\x2c\x88\x18\x34\x64\x0c\x79\x43\x37\x94\x2c\xc4\x51\x4f\x0a\xdd\xe4\xb0\xa1\x9b\x52\xfa\x15\xa9\x5f\x6c\x20\x2c\x11\x6b\xaa\x07\xdf\xa5\x67\x1d\x62\xd1\x5b\xb7\xfa\xf2\xc2\xd8\x4d\xb6\x38\x3a\xeb\xe0\x05\xf1\x6a\x6a\xd0\x81\xcf\x88\x5f\x71\xe0\x75\x7d\xa0\x81\x10\xe6\xa9\x9a\xd5\x42\x01\x5b\x72\x20\xe8\xcf\x68\x69\x12\xcb\xf8\x31\xb2\x97\xbb\x9f\x63\x0a\x61\x39\x03\x27\x72\x31\xc5\x5a\x78\xbc\x93\x66\xb3\xd2\x30\x3d\x7a\xb0\x74\x6b\x9c\x00\x39\xd4\x8a\xa6\xa8\xa9\xb6\xb3\x5a\xf1\x14\x96\xa6\xa0\x71\x85\x24\x7a\x32\x92\x7a\xba\xea\x2e\x86\x8a\xee\x42\x88\xef\x6e\x6e\x4a\x34\x08\xbb\xa8\xf2\xe3\x87\x9b\x3c\xf3\xcc\xcc\x38\x09\x21\xdc\xb8\xad\xe4\x77\x97\x85\x8b\x82\x34\xf1\x0a\x2b\xe8\x61\xe1\x93\x3f\x64\x33\x95\x4f\xf4\x9e\x86\x7f\x2b\x16\x11\xea\xac\x06\xcd\xad\x4b\x80\x24\x33\xc7\x07\x72\x37\x76\xd3\xef\x61\xed\x9b\xb4\x44\xeb\x4a\x3c\x66\x54\x46\xfc\x47\x87\x54\x01\x8a\x00\xf9\xa7\xf2\xd5\x0b\x8e\x47\x66\x71\x9a\xa0\xf3\x57\xab\x31\xd2\xa9\xc3\xa4\x50\x9a\x35\x61\x54\x98\x80\x4a\x9d\x43\xf1\x97\x39\x6b\x74\xe5\xb3\x6c\xc9\xbb\xc4\xd4\x1d\x0f\x85\x25\x72\x3b\x84\xc9\x5a\xcc\x6b\x86\x04\x77\xfa\x73\xe9\x21\x41\x10\x72\x48\xd0\x1e\x9b\x81\x78\x4a\xb4\x44\xc4\x87\x4b\x1b\x11\xf9\xe3\xc2\x3f\x6a\xa7\xcf\xa4\x40\x92\x02\x92\x48\x61\x63\xb8\x23\xdb\x7c\x8d\x2c\xa8\xaf\x1a\xf9\x3b\x66\x37\x61\x8d\x2b\x01\x36\xf9\x6c\xc4\x36\x15\xb2\x56\x35\x5a\x07\x75\x33\xef\x19\x71\xdb\xca\xbc\x22\xdf\x2b\xec\xad\xfa\xbb\x27\x80\xb6\xaf\xb9\xd7\xbf\xf8\x5d\xa6\x68\x52\xbd\xca\x88\xbb\xf3\x66\xcf\x10\xb4\x1e\x2a\x52\xe2\x9d\x42\xa3\xad\xfb\x75\xd9\x9f\x4b\xb7\x32\xbb\x38\xe3\x66\xe0\x28\xa6\xf4\x64\xa5\xcb\x21\x0d\x40\xfe\xfd\x1f\x3e\x62\xfb\x60\x8c\x34\x79\x44\x5c\x47\x7e\xeb\x26\x6e\x6d\xb0\x0f\xfb\xe2\x31\x5c\x0a\xfe\x8f\xfc\xd3\x9f\xfc\x9e\x5f\xfc\x00\x7d\xc5\x66\x33\xba\x94\x48\x30\xb2\x75\xac\x76\x10\xbf\xe7\xce\xba\x61\xfb\x45\xd9\xc2\x67\x3b\xc9\xed\xa2\xfa\xcc\x57\xaa\x52\xbc\xca\xd3\xde\x08\x85\x29\xd7\x22\xb6\xb1\x04\x6a\xde\x14\xe2\x62\x29\xca\x63\x97\x94\x0e\x63\x41\x92\x67\xaa\x72\xdf\xb7\x71\xe2\xe7\x73\x6e\x25\x86\xd5\xf9\x90\x5e\xf7\xb0\xa4\xd5\x64\x87\x05\x42\xff\xfc\xff\xae\x0d\xa9\xec\xcf\x4f\x73\x9c\x3d\xcd\x75\x3b\xe3\xc5\xb0\x6b\x55\x5e\xad\x06\xa3\x5b\x4b\x1f\x7a\x88\x57\x44\x99\xbe\x26\x37\x74\xc5\x44\x0a\x2d\xf8\xf6\xb3\x26\xb9\x8b\xb9\x19\x52\x3b\x37\xae\x25\xe6\x6c\xcb\xfd\x90\x5b\x8b\x46\x43\x42\x4a\xd8\x64\x9b\xd8\x96\xda\x8a\x9e\x3a\x36\xcc\x7b\xa2\xd7\x8a\x22\xff\xc7\xd0\xfe\x79\xd7\x49\x1c\xcc\x64\xd5\x84\x11\xac\x7f\x27\x99\xa8\xae\xe3\xe8\x09\x49\x2f\x3c\x1b\x4a\xb6\x74\x8b\x4a\xac\x63\x37\xe9\xfd\xa3\x2e\x90\xa2\xbe\x87\x7d\xba\xf6\x38\x08\xa6\xd7\x06\x59\x35\x8c\xb1\x10\x35\xfe\xe5\x31\xa9\x67\xb3\x99\x1a\x44\xd7\xf9\x2a\x7c\xec\xde\x60\x55\x10\x24\x34\x7d\x70\xac\x98\x5c\xb8\x59\xeb\x34\x7a\xef\x9a\xb6\xfd\x82\x04\x7d\x1c\x40\xe0\x3d\x06\x68\xff\x51\xa0\x26\x40\x99\x28\xd4\x54\x23\x3b\x81\x00\xa3\x9c\x8c\x60\x0d\x53\x7f\x60\x7e\x79\xeb\xd9\xac\x91\xaa\x8f\x2e\x49\xa5\x7b\x42\xe2\x87\x5f\xe4\xa6\x53\x3f\x14\x7b\xee\xa8\x88\xdf\xfc\x19\x37\xe9\x53\x25\xf3\x6c\x69\xfd\xcd\xb3\xa4\x04\xe8\x9b\x19\x74\xc7\x23\x89\x04\x72\x0d\x1a\xf4\x00\xc2\x0e\x79\x09\xda\xbe\x8a\x3c\x21\xf3\xee\x4d\xd8\xef\x4b\x77\x79\x5b\x24\x93\x78\x51\xd7\x09\xfb\x26\xc5\x81\x0c\xb4\x3f\xcf\x1c\x35\x24\xff\x0f\x66\x00\xf5\x55\xe8\x56\x39\xed\x96\x8b\x5f\xae\xc6\x45\x64\x33\xec\xc8\xe5\x7d\x40\xe7\x5d\x4d\xb9\xa3\x7e\x7e\xb1\x27\xff\x0d\x5c\x87\x11\x2e\xd5\x7e\xb1\x4c\x15\x38\x7d\x08\x89\xf0\x8c\x5f\xc0\xae\x0b\xe9\x68\x14\xa8\xac\x1d\xf3\x12\x02\xf3\x18\xcd\xa0\x43\x53\x30\xfd\x5e\x18\x25\xec\xcf\x2f\x69\x9d\x74\x01\x2b\x85\xf1\xad\xc9\xa6\xc0\x22\x9c\x78\x1a\xf3\x51\xce\x15\x8a\x4c\x0a\x3b\xe2\xf9\xff\x5e\x30\x72\xc1\x9b\x3b\x73\x5f\x9a\x9c\xfd\x97\x4d\xf0\x9a\x5d\xd9\x4e\xff\xe1\xc9\x3b\x5b\xd6\x65\xfa\x4c\x22\x4f\x71\x5c\xd3\xfd\xca\xb8\xf5\x9a\x0f\xd9\xdf\xc6\x0f\xd8\xc7\xdf\xa7\x32\x58\x32\xd9\xb7\xde\x5f\xfa\xf2\x5a\x67\xbe\x43\x24\xd4\xeb\x6f\x13\x8e\x34\x91\x45\xdd\x60\x1e\x49\x16\x07\x41\x3e\x68\x92\x10\x2e\x19\x95\x2e\xee\xe7\xc9\xf4\x7e\xae\xa1\xe3\x72\x48\x68\x51\xe9\x9b\x1c\x49\x8c\xd3\xab\xd3\xf9\x76\x69\x7a\x04\x46\xc7\xb7\x02\x40\xfe\x20\x58\xd6\xa9\xe5\x38\x99\x50\xf6\x1f\xf9\x05\x67\x6a\xe2\x93\x1d\x86\x01\x2a\x9c\x09\xff\x78\xae\x9e\x76\x5a\xcc\x0b\x82\xf8\xca\x37\x7a\x59\xc5\xd9\xf5\x5c\x0d\xa7\x74\x49\xc2\x81\xfd\xb7\xc6\xcf\xdd\xb8\xee\x04\xd8\x6f\xac\x6d\x6c\xec\xb7\x9d\xcd\xda\x89\x66\xca\xec\xd9\x9b\x46\xd9\x99\x1a\xa4\x20\x99\x89\x21\xf7\x2d\x9b\xe9\x10\xca\x1d\x19\xae\x0f\xb1\x75\x35\xd6\x43\xef\xf5\xf3\xca\x19\x93\xfa\xf4\x54\xfe\x06\x2c\x02\x2e\xef\x2f\xb9\x26\x4b\x3b\xce\x12\xea\x74\x4a\xc7\xa1\x90\x71\xcd\x86\x4f\x3b\xe0\xaf\xad\x8e\x80\x3a\xf4\x49\x2a\xb7\x54\xbe\x0f\xf4\x3c\xeb\x07\x8b\x3a\x37\x1d\xcb\xf7\xfd\x94\xba\xb9\x29\x1d\xac\x12\x73\xb7\x72\xf3\x7c\x8e\xf2\x75\x39\x84\x89\x06\xe0\x0e\xa6\xaa\xad\x7f\x2b\x7a\x74\x5d\x5f\x80\xb1\x4b\xa4\x59\x78\x02\x05\xa3\xef\x77\xdb\x19\x9f\xa4\x6e\x4f\x54\x20\x4e\x3f\x5f\x5a\x3d\x46\x4a\x46\x4d\xe5\xdc\x4d\xce\x26\xef\x9b\x69\x2e\x85\xe5\x4b\x8b\x56\x7b\xce\x99\x76\xed\x6d\x86\x43\x33\x0b\x87\x85\x31\x14\x93\x2f\xe7\xdb\xed\x6f\x69\xe2\x0b\x81\xc8\x3b\xea\x37\x9c\x26\xbe\xae\x7f\xeb\xd6\x10\x61\x5e\xa4\xf5\x7b\xb7\x03\x30\x2c\x95\x4a\xfa\xaa\xf9\xe9\x03\x4e\x7c\x99\x02\xd5\xcb\x5d\x8e\x05\x55\x46\xb2\x95\x72\x21\xab\xd1\x9f\x29\x13\x60\x1c\xae\x43\x94\xdb\x23\xd2\xe2\xb6\x5e\x56\x5c\x42\x50\x79\x2c\x93\x92\x33\xf1\xd2\x58\xab\x11\xe8\xdf\x39\xab\x9d\xaa\x0e\x32\x11\xca\x43\x70\xdb\x51\xa4\x13\x57\x5c\x96\x0f\x27\x7a\xac\x90\x4d\x4a\xdd\xeb\xb7\x9a\x5c\x59\x1c\x6d\x6c\x83\xda\x4d\x5a\xa2\x29\xfa\xab\xaf\x1c\x6b\xf4\x69\xfd\x71\x2e\x3c\x6e\xc1\xa8\xe4\xd1\xfa\xe2\x1b\xf4\xdf\x35\xff\xed\x14\x9b\x4c\x9f\x17\xd1\x95\xf7\x62\x94\x90\xd8\xfb\x58\xd5\x19\x34\x90\xef\x8e\x20\x89\x2c\x88\x8f\x98\x01\xde\x87\xa2\x79\x64\x10\x6c\x33\xee\x2b\xd0\xf3\x72\x72\x49\x66\x20\xa9\x79\x6a\x93\x8d\x55\xc4\x62\x7a\x94\x52\xac\xa2\xc4\x89\xd6\x7f\x53\x1a\xfc\x8d\xde\x49\x09\xfd\xac\x20\xfd\x58\xdd\xbf\x2d\x29\x1c\x89\xbd\x07\x99\x5e\xd6\x20\x59\x79\xe5\xcc\x16\x24\xb5\xa9\x41\xff\x97\x48\x78\xe5\xd7\x1c\xda\xd3\x53\xd0\xa3\x10\xef\x79\x28\xec\xb2\xbf\x88\xb7\xed\xc2\xbc\x91\xcd\x74\xeb\x76\xbe\x2f\xd6\x32\x84\x63\x09\x7d\x3e\xef\xb5\xf8\x52\x70\xda\x34\x34\x7f\xbc\xd0\x03\x8f\x94\x51\xbf\x2c\x3b\xc0\x79\xd0\xbf\x2b\x5e\xbf\x45\xce\xf5\x77\xb9\xa0\x30\xfc\x9c\x9d\x2f\x13\x20\xae\x48\xe4\x76\x9b\x75\x40\x0d\xd9\x76\x05\xac\xa4\x85\x2f\xfd\xb6\x88\x12\xc9\xdf\xd6\xaa\xad\x36\x3a\xc4\x4a\xec\x55\x13\xcf\x3e\x1d\x81\xda\x66\x37\x3b\x21\xcc\x7b\x80\x03\x96\x3b\x15\x1d\x2e\xf0\xb6\x57\x2a\x44\x05\xaa\x59\xc6\x8c\xce\x1d\xc4\x71\xc3\xaf\x76\x4a\xe9\x52\x94\x9b\x02\x8a\x00\x48\xa0\xba\x78\x3e\x7d\xd2\xe9\x65\xa7\x36\x34\xd0\x30\xe0\x74\x62\x22\x41\xc7\xb8\xff\xa4\xf0\xb1\xfa\x11\xe7\x04\x8c\x23\x78\xce\xe9\x94\x97\xd0\x10\xb3\xf7\x0f\xa5\x6b\x18\x59\xb3\x72\x3a\xa6\xc9\xc4\x92\x12\x17\xeb\x0e\x70\xce\x75\xfa\x24\x31\xc0\x98\xcf\xe0\x2e\x24\xc5\x99\xb9\x6d\x53\x56\xe7\xf0\x46\xc8\x60\xa2\x05\x4a\x1e\x00\xc7\xe9\xf2\x18\xe0\x49\x10\x9e\x47\x3d\xb4\x30\x77\xb4\x43\xbd\x87\x58\x9e\xfe\xd4\xfe\x9f\x88\xa0\x2e\x12\x76\xb5\x38\x22\x81\x55\xdd\xb1\x21\xd7\xc6\x64\x71\x2a\x89\x8b\xa5\x7c\xb4\x76\xd9\xd5\xb9\x64\xa6\x62\x4a\x3a\x04\x29\x13\xb3\x6f\x5a\xdc\xc1\x44\xfa\x18\x98\x06\xd8\x23\x27\x54\x31\xce\xb5\x93\x07\x8f\x44\x83\x6d\xdc\x22\x34\xa6\x34\x4a\x76\x00\xe5\xaa\x44\x45\x57\xe7\xdf\x00\xd3\x35\x16\x34\xa2\xba\xbf\xc6\x3e\x2a\xd7\x86\xbd\x4b\xe5\x73\xe7\xdb\x67\xdd\x63\xed\x29\xa6\x1d\x38\xf5\x6f\x4b\x4e\x37\x54\xfc\xf1\xea\x31\x78\x0a\x98\x2e\xe8\xf0\xb8\x5f\x14\x2e\x86\x8a\x4e\x8c\x93\x6a\xb8\xe9\xc4\xba\xcf\x09\x34\x52\xa8\x77\xf9\x0c\xd3\xe6\x70\xa0\x9e\xac\xdb\xf2\x66\x61\xa5\x56\xe4\xfe\xfa\xd8\x79\xc5\x44\x3b\x33\x81\x37\x75\x08\x98\x6f\xf4\x2c\xab\x80\xf0\xa0\x73\x81\x8b\x01\x0c\x2b\xbf\x8d\x4a\x4a\x88\x8e\x25\x0a\x14\x64\x8f\xa7\x4a\x80\x87\x1f\x09\x99\xa1\xb6\x99\x8e\x61\xad\xe4\xc4\xee\x01\x0d\x5c\xf2\xf1\x04\x53\x13\xf6\x0e\x87\x94\x26\x62\xee\x92\xd4\x8c\x62\xd6\x05\x9a\xe9\x6d\x7e\x3a\xa6\xc8\xeb\x11\xef\x0e\x6d\x2c\x79\x8f\xfa\x67\xec\x9e\xd2\x44\xb4\xa3\x29\x52\x12\xf9\xa3\xcb\xe0\xe6\x0f\x9a\x30\xd4\xf4\xc4\x13\x13\x37\x5c\x5a\x98\x77\x32\x57\xa3\xcb\x31\xae\xd8\x6c\xb4\x24\x9f\x3d\xf9\x77\x4e\x53\xc0\x74\x79\xfc\x48\x5a\x21\xe0\x5e\x09\x09\xb5\x87\x35\x3a\xbd\x42\xf4\xa0\x69\xa7\x5c\x30\x6b\x16\x6b\x7b\x0f\x24\xc9\x33\xfe\x83\x97\x37\x06\xe8\xb9\x73\x56\x54\x56\x37\xbb\xbd\x5c\xdf\x76\x5a\xa6\xd5\x44\x92\x36\x22\x13\xcd\xd4\xf8\x77\xb7\x9a\x30\x7e\x5a\x7d\xb1\x47\xa8\x0d\xab\xf8\xa5\xb9\x0c\x66\xc5\x7c\x72\xcb\x53\xe8\x1d\x8b\xdf\x8e\xe9\xb0\x40\x1c\xab\x4d\x56\x80\x27\x0a\x3d\xf2\x77\x73\xb2\x14\xf9\x67\xf4\x9e\xd2\x8a\x1c\xa8\xc1\xad\xae\x20\x05\xed\xd8\xec\xe0\x28\xe8\x58\xfb\x93\x05\xe5\x4f\x09\xbb\x0c\xc5\x87\x65\x11\xfd\x3e\xc2\xbe\xcc\x6c\x3a\xe7\xa8\x5c\x25\x4b\x26\xe2\xf1\xd9\x71\xb7\x35\xf8\x16\xc5\x71\x9f\x9a\x28\x9c\xf1\xc1\x41\xbb\x7a\x02\xa7\x34\x87\xc7\xf9\x9c\xcf\x46\x86\xd1\x88\x39\x42\xb1\x76\xd6\x7d\x5c\x39\xe5\x76\xc2\xc3\xde\x91\x23\x57\x60\x38\x33\xde\xab\x26\x0e\x3b\x19\x42\x72\x57\x70\x84\xf8\x06\xbc\xe8\x36\x22\xee\x84\x79\xe5\x04\x4d\x77\x7e\x7d\xba\x14\x9a\x9e\x8e\x6e\x4e\xdc\xe9\xd5\x02\xe3\x0e\xc3\x4a\xf6\xea\x17\x71\x97\x73\x6d\x2f\x2c\x70\x6f\x56\xd6\xbb\x95\x09\x28\x92\x0e\x34\x05\x3c\xfa\xc0\x6f\x9f\x4e\x31\x76\xb4\x4a\xc8\x4b\x49\x1c\xd0\x0f\x95\xca\xae\xc7\xa4\xd2\x51\xc4\xe9\xa7\x89\xf5\x2d\xac\xa1\xbd\x0e\xfb\x73\x8d\xfe\xa3\xd9\x63\xd7\x92\x21\x71\xb0\x42\x6c\xd5\xfe\x16\x6e\x4d\xe9\x70\x99\x6c\x69\xe5\xaa\x31\xe6\x4d\xd5\x33\x3e\x41\xc0\x2e\xd0\x9e\x9c\x9d\xaf\x0e\xc0\x7a\x44\x6f\x1c\x9b\x73\xf7\x8b\x09\x7f\x43\x3d\x2d\x8d\xe4\x43\x02\xef\x7b\x16\x3b\xb6\xdf\x78\x7a\x7e\x6f\x99\x2a\x6a\xa8\xec\xb2\xd2\x05\x75\xa8\x78\xc5\xb7\x44\x39\x36\x1c\x40\x55\xda\xb6\x8b\x4b\x28\xe5\xcf\xe7\xef\x8b\xf0\x1d\xd6\xc2\x27\xb5\xe7\x1d\xea\xe7\x8b\x87\x04\xd1\x11\xdd\x65\x7f\x77\x94\x8e\xe3\xcf\xd0\xf7\x84\xe6\xde\x66\xf8\x1f\xf7\x4f\x5c\x6e\x64\x99\x58\xb5\x40\xbc\x08\x90\x1d\x78\x02\x3a\xa1\xd6\xc4\xdd\x69\x22\xda\x02\xfd\x99\x75\x92\x7a\xd4\x75\x86\x31\xb9\xd9\xd6\x59\x6c\xda\xc8\xf4\xd3\x4e\x13\x71\x5b\x2f\x84\xa6\xed\x60\xb5\x48\x99\xbc\x99\x4d\x1e\xe5\xaf\xf5\xf8\x5b\x2c\x79\x77\x4c\xd5\x29\x10\x5d\xce\x2f\x32\x04\xf6\x5d\x31\x21\x8e\x49\x27\x60\xa0\x2e\x95\x7a\x4c\xcb\xef\xfc\x8e\xb1\x67\x84\xe9\xee\x5e\xee\x95\xe4\x8b\x05\x16\x36\xc5\x5e\xb8\xdc\xe6\x14\x95\x68\xea\x90\xef\x2b\xc4\x12\x92\xb2\x3f\x1a\x4b\x78\x47\xcb\x5f\x01\x2c\xc4\x62\x04\xf3\x83\xda\x28\xb9\xdf\xb8\xde\xbc\x04\x49\x7e\x6a\x39\x87\xcb\x34\x8b\x38\x7e\x2e\xbe\x2b\x6a\x5c\xcc\x67\xc3\x86\x48\xc9\xf8\x13\x0f\x71\x68\x86\x7a\xf6\xe1\xd1\x07\x95\x5d\x94\x35\xb8\x3f\x05\xb0\x35\xeb\xeb\xbd\xef\xe3\x2a\x25\x04\x52\xac\x88\x9d\xbe\xf7\x3b\x44\x01\x0c\x67\x96\x06\x49\x72\xc0\x20\x59\xbb\x58\x38\xce\xb9\x26\x32\xa3\x63\x5c\xe4\xf6\x21\xcc\x95\x6b\x29\x4c\xe2\xbc\x71\xc4\xe3\x85\x16\xb2\x15\x12\xe4\x7e\x73\x6c\x9c\x5c\x45\x30\x9e\x8a\x2c\x1e\x44\x1d\xeb\x02\xf8\x6e\xad\xa1\x50\xe5\xab\xad\xdc\xb8\x86\x83\x05\xe0\x41\x34\xa1\x97\xd0\x77\xc8\x96\x0f\xb7\x4d\xf6\xf5\xdd\xc5\xe6\x6c\x09\xb6\xda\x4e\x80\x11\x5c\xcf\x0f\x03\xf2\x88\x8f\x1d\xe8\xe7\xb1\x33\x64\x4d\xe6\xf0\xee\x47\x63\xc3\xca\x14\x10\x79\xc7\xfd\x76\xec\xf1\xc0\xf3\xcd\xb6\x58\xa2\xfc\x58\xfb\x63\x63\xe8\x28\x84\xce\xb2\x09\xf2\x01\x12\x44\x30\xbf\x3e\xf5\xc6\xd5\x57\xf6\xbb\xd9\x5b\xf9\xe2\xbc\x55\x6e\xe6\xda\xdf\xe2\x64\x85\xc0\x0f\x19\x96\x54\xc7\x84\x62\x1a\xc4\xa5\x68\xfe\xb5\x8d\x77\x6c\x77\x04\x4d\x47\xf4\x4e\x7e\xed\xa0\x8a\x82\xe1\xbc\x41\x1d\x9f\xe0\x68\x79\xcc\x60\x4f\x66\x09\xc3\xb7\x4a\x5f\x95\x6d\x33\x03\xc3\xb4\x9a\xd0\xb4\x0c\x6d\xc4\xb0\xc8\x86\x26\xa1\xbc\x82\xd1\xc4\x64\x60\xe9\xba\xbb\x21\x0e\x1f\xb6\x4d\x72\x1c\x3a\x3e\x9b\x9a\x12\xe6\x1d\xa1\xca\xdb\xe7\x61\x2c\xc8\xff\x68\x9b\x7e\xdc\x5d\xc5\x89\x27\x55\x27\x37\x5d\x6d\x3f\xc0\xe1\x0a\xfe\xee\x27\x4e\x08\xb5\x5f\x2a\x73\xb8\x6d\x40\xe5\x3d\x5d\xfb\xc6\xe7\x6c\xdc\x7e\x74\xfd\xac\xfd\x22\x82\x1f\xa1\x16\xbd\x18\xa8\xf2\x49\x59\x2f\x2e\x1a\x9c\x80\x12\x3c\x1f\x92\xa9\xc9\xe8\x37\x31\xbd\xac\x1e\x9e\xf3\xb8\x4b\x25\x65\x9b\x01\x65\xeb\x31\x3e\x5d\x35\x4e\x32\xa8\x92\x55\x4c\x85\xc9\x39\xb6\x49\x72\xfa\x67\xd6\xfe\x46\xe1\x43\x79\xdf\x57\x92\x29\x6b\xf4\xbc\xe7\x41\xeb\xb9\xa3\x9b\x36\xee\xf8\xbb\x96\x8e\x51\x82\xf4\xad\x44\xb8\xce\xf1\xf7\x39\xd1\x5a\xad\x73\xa7\x3d\xef\xae\xeb\x13\xb1\x3a\x12\x42\xd9\x80\xd5\x91\xd6\xf1\x97\xb4\x4b\x55\x3e\xfb\x15\x2e\xc9\xcb\x41\x94\xa1\x34\x35\xc5\x5e\xf9\x82\x9c\xe1\xcf\xd5\x54\x3b\x62\xbb\x79\xf1\x66\xff\xab\x45\xb6\x30\xe5\x67\x61\x02\x82\x57\xea\x42\xab\xa4\xcc\x6f\xba\xbf\x43\xec\xdd\x4e\xbe\x90\x36\xce\x18\xb1\x04\xcf\xf9\x9f\x0c\xf9\x64\x55\x24\xb2\xd3\x48\x3a\xc6\x01\xcf\x8a\xc9\x93\xd5\x5a\xe7\xe4\x85\x15\x40\xf2\xdf\x26\x95\x59\x88\x21\xf2\xec\x45\x3c\x02\x96\x61\x8b\x85\x2b\x71\xcd\x0e\x16\x5c\x87\xa1\xdf\xa3\xa0\x7a\x8a\x57\x93\x2a\xac\xce\x65\x37\x67\xe8\x8a\x65\xef\xfd\xd6\xf3\x05\x0e\x1a\xc9\xba\x82\x6b\x90\x26\xf6\x02\x24\x49\x93\xcc\x0b\xb0\xa7\x16\xb3\x26\xf4\x2f\x25\xc1\xba\x45\x25\x4f\x58\xc9\xdd\xd9\x63\xc0\xaf\x2f\x3a\x9e\xf2\xce\xbb\x0e\x70\x37\x46\x7f\x88\xb0\x00\x90\xfd\x0c\x15\xba\x39\xab\x6f\x1f\xca\xbe\xee\xcd\xd3\x1f\xcb\x87\x16\x5e\x8d\x92\xb4\xc8\x86\x40\x62\xf0\xd7\x97\x74\xbf\xbe\x6c\x53\x63\xd8\x31\xcf\xba\xe6\xac\xd2\x88\x00\xb0\xab\x99\x11\xc4\x99\x3d\x82\xa5\x21\xe7\xf4\x75\xbd\xd3\x6c\xea\xf5\x6e\xcd\x88\x40\x49\xe9\x6b\x62\xff\x51\xed\xe6\x75\x66\x32\xf0\x84\x44\xd4\xcb\x35\x41\x38\x73\x18\xf1\xe1\xc7\xec\x1c\xdb\x0d\x0f\x41\x92\x44\xdf\x60\x04\xe2\x82\xf7\xb4\x01\xc0\xfe\x16\x5a\xa9\xd2\xd4\xba\x70\x11\xe5\xd5\x62\x11\xcb\xf8\xb0\x3d\xb6\x69\xf6\x7d\xc9\xe8\x1b\xc5\xd7\xbd\x52\x47\x0c\xb4\x1e\x56\x88\x73\xc1\xd0\x7d\xf4\xdf\xe3\x0a\xb2\xaf\x75\xe8\x31\x6c\x47\x06\x9c\x52\xa0\x3e\xe3\x24\x8e\x6c\xe5\x60\x15\x20\xd3\xe5\x49\x16\x1e\x1b\x97\x9e\x5d\xd5\x17\x4e\xbf\x17\x61\x7f\x73\x3a\x55\xe1\x8a\x7c\x3b\x51\x7a\x2d\xa4\x75\xc8\x81\xa2\x13\x28\xbe\x06\xa5\xef\x4a\xd5\x1f\x74\x91\x35\x05\xa7\x65\x60\x63\xc3\x08\xd5\xe5\x57\x14\x81\x83\x45\x15\x24\xe4\x82\xf7\x49\x28\x03\xaf\xd1\x8b\x6d\xfe\xc8\x8e\x4f\xa2\x03\x04\x74\x4f\x7e\x16\x73\x11\x7b\x07\x19\x3f\x34\xe0\x71\xc0\x94\x29\xe4\x64\xec\xe8\x39\x55\x37\x63\x9d\xc6\x81\x02\x20\xb1\xb1\x40\x75\xdb\xd5\x5d\xc5\x6f\x9a\xc4\x7e\x3e\x5c\xa6\xb2\x76\x06\x9f\x81\x51\x6b\x20\xee\x0c\xc1\xe2\x71\x52\x46\xf7\xb7\x5d\x49\x26\xee\xa3\x6f\xb4\x10\x5e\x0d\x0c\x8a\xfa\x81\xc7\xa4\xf5\x03\x70\xc9\x7b\x26\x3b\x19\x84\x72\x56\x1d\x44\xb6\xd4\x27\x2b\x61\x61\x7e\xf7\x85\x52\x21\xdb\x12\x17\x78\xb4\xf0\xba\x7e\xd0\x86\x9b\x78\xf5\x91\x38\x92\x1f\xf9\x12\xd9\x81\xdf\x76\xa1\x89\xc1\x50\x14\x5d\xc5\x62\x63\x29\xd4\x49\xb8\xe0\x36\x8e\xb3\x89\x92\xc1\x88\x76\x18\xcf\x70\xc5\xf7\xc1\xd6\x3f\x63\x8a\xa2\xdf\xae\xa7\x51\x12\xda\x43\xc9\xfc\x39\xcb\x76\x3b\x2a\xb8\xec\x59\xb9\x89\xe8\x6d\xb0\x56\x87\xa7\x0a\x6e\xf3\xdc\xd5\x46\xd0\x9e\x9c\x6b\xe1\xef\x51\x8f\xf3\x2b\xb7\x7d\xda\x68\x8b\x20\xe3\x38\xa0\x13\x1a\x6b\xde\xfb\x74\xbe\xdd\x8a\x64\x92\xa7\x50\x71\x05\xe5\x87\x5c\x8d\x16\xae\x4f\xdb\x56\x30\xc6\xbb\x62\xba\xe3\xd6\x12\x0d\x1c\xdf\xef\x33\x13\x6e\xa3\xab\x96\x1a\x12\x3c\xc7\x33\x3e\xa4\x9c\x06\xc8\x01\x99\xa6\xed\x5f\x24\x49\xa5\x66\xac\x65\x62\xc8\x4b\xb2\xb0\xab\xd0\xf4\x5d\xe9\x73\x02\xe9\xbb\xcc\x81\x51\x78\xf1\xcc\x25\xfb\xa5\x58\x15\xe0\xfb\x2b\xe3\x01\x3b\x07\x72\xf6\x7f\x88\xa9\x25\x85\x6e\x91\x5c\xf1\x1e\x06\xcf\xba\x78\x2b\x97\x80\x18\x48\x93\x65\xc7\x50\x7e\xd5\xee\xb7\x4c\x12\xb6\x7f\x72\xbb\x7f\xf4\x40\x3f\x0a\x44\x84\x26\xb8\x38\xc5\x67\xef\x24\xad\x46\x8f\xdd\x85\x9b\x89\xb0\x08\x0b\x39\x28\x26\x77\xe8\x42\x5e\x43\x6f\x2e\x50\x30\xc4\xd1\x56\x41\xd6\xfe\xcc\x77\x15\xf1\xd3\xdf\x1c\x53\x77\x72\x67\xe5\x8e\x75\x9a\x02\xe1\x80\x2c\x05\xe7\x63\x94\x3c\x99\x69\xa6\x39\x61\xcc\x6a\xff\x3d\x93\xe6\xee\xaf\x44\xe8\xb7\x86\xb3\xc5\xe6\x68\xe1\x95\x7f\x13\x2d\x8a\x15\x89\xb6\x00\x3c\x64\xcc\xf5\xd1\xd7\xc3\x5e\x6d\xe3\xc7\xb5\x7b\x12\x7f\xb1\x4d\x47\xe4\xb6\x4b\xab\x58\x16\x8f\xa9\x0a\x70\xf9\xac\xa9\x16\xed\x0e\x7b\x6e\x44\x78\x34\x9a\x86\x83\xa2\x82\xcc\xab\xb5\x40\x2c\x2a\xf0\xc5\x4d\xc8\xf3\xd9\xfb\x93\x1c\x8b\x9a\xf8\xcd\x7d\xd6\xfb\xb9\x57\x72\x42\x7c\x13\x30\xde\x1b\x10\x81\x62\xe6\x09\x14\xe3\xc9\xb9\xb9\x43\xf3\x8e\xdb\x79\x89\xc9\x6f\x97\xdf\x61\x5c\x59\xc8\xe1\xb2\x14\xfb\xe0\x61\xb4\x06\xb1\x25\x54\x9f\x50\xb4\x6d\xd2\x09\x29\x2c\xfa\x7f\xd3\x66\xc6\x28\x27\xed\xf2\x79\xd6\x25\xc5\xc1\xe5\x10\x88\x01\x8f\xe2\x0f\x7e\x6e\x27\xdd\x14\xa2\x48\x22\x20\xe4\x40\x1e\xa1\xc0\x1c\xb6\xcb\x28\x5a\x4c\xfb\xc2\xef\xf8\xef\x2a\xb4\x91\xdb\xd0\x4b\x43\xcc\x41\x27\x90\x06\x16\x75\xc3\x52\x08\xac\x1b\xbf\x49\x2b\x98\x79\xf0\xd6\x14\x42\x48\xdc\xb1\x1f\xfc\x0d\x83\xf7\x81\x8f\x8d\xaf\x9d\x2e\xd8\x0d\x34\x89\xb2\x86\x0e\xca\x11\x7b\x07\x78\xdc\x41\x58\x7b\x6e\x0e\x1e\x86\x3b\x8a\xd6\x11\x61\x8a\x75\x34\x69\x0a\xdc\xba\xbd\xfe\x63\x1c\xd8\xcf\x6c\xd4\xc5\x4a\xf6\xb7\xdf\x1c\xee\xa6\xfb\x11\x7c\x77\x3e\x4e\xfa\xe1\x2c\x42\xaa\x06\x3a\x99\x81\x95\xaa\xfe\x3b\x46\x4c\xb6\x28\xd7\x5f\x2f\x89\xef\xbc\x1f\x6e\x20\x8c\x91\x5a\x42\x2b\x2a\xee\x15\x7b\x75\xa2\x24\x4d\x7d\x79\x9c\xaf\xf3\x65\xa7\x70\xf4\x12\x7b\xfa\x00\xf9\x9e\x68\x72\x02\xd6\xf2\xe0\x03\x79\xb5\x3a\x7c\x5a\x1b\xf8\xdc\x11\x1e\x5f\xaf\xa6\x02\x6f\x74\xd8\xda\xad\x42\x80\x31\x32\xce\x39\xdd\xb7\xc9\x28\x65\xd2\xd3\xf9\xc8\x28\x42\x1c\x34\x01\x10\x6b\xa8\xa7\x01\x89\xff\x7a\x8a\x4d\xb1\x3b\x6b\xdc\xd2\xbd\x03\x9c\xc3\xf9\x6a\xb4\xda\xd0\x77\x55\x6d\x53\xdb\x87\x7c\xc4\x86\xa9\x53\x6d\x03\x0b\x4e\xc1\x5d\x8b\x67\x75\xf5\xdc\x30\x9f\xe3\xd5\xfb\xd1\x91\xc5\x3b\x80\x75\x71\x3d\x42\xf3\x61\x55\xbd\xa6\x0b\xf0\xfb\x07\xb8\x72\x5f\x02\x70\x36\x97\x23\x0e\xc7\xdd\xb2\x08\x36\x27\xb2\xee\x74\x0d\x0c\x18\xa1\x67\x13\x2c\xbb\x77\x99\x15\xf7\x5a\xe1\x38\x25\x8d\x80\xb2\x20\x61\x5c\xbc\x86\x0a\x9d\x3f\xee\x72\x43\x74\x41\x56\x95\x90\x1e\xb7\x09\xe1\x24\x7b\x5b\xce\x8b\x17\x0e\x36\x6a\xcf\xc4\xde\x27\x74\x5b\x2b\xe1\xaa\xbb\xb5\x85\x18\x8d\x25\x5e\x00\x77\xc5\xcd\x79\x52\xc1\x5a\x50\xcb\x4b\x61\x88\x13\xfb\x51\x49\x05\x11\x92\xe8\x18\xe6\x22\x1b\xf5\xe5\xea\x4d\xd4\x32\x3d\x7f\xca\xa0\x9b\x9c\xfd\x5f\xb3\x2f\xe9\xa2\x82\xa5\x63\x70\x9e\x0a\x6f\x49\xe6\x20\x9d\x55\xc1\x9a\xb7\x8a\x80\x63\x8c\xbb\x6b\x66\x21\xa6\x76\x06\x4b\x7f\x0f\xbf\xad\x0a\x35\xfa\x2b\xa2\xd0\xef\xca\x42\x84\x61\x1a\xab\x84\x11\x7c\x33\x10\x83\x17\xbf\x64\x21\x20\xb6\x2f\x2b\x81\xfe\x38\xe1\xa8\x9c\x8e\x1f\x00\xb4\xd3\xf7\x51\x59\xf4\x50\xea\xcb\x92\xb2\xf7\x7d\xc7\x03\xbc\x26\x65\x95\x3f\xc8\x77\x85\x7d\x54\x13\xcd\x8a\x88\x04\xac\x79\xfc\x8e\xa6\xf0\xc7\xbc\x78\xd7\x45\x02\x7f\xa1\xf0\x46\xb5\xe9\x29\x86\x19\xce\x3f\xc8\x26\x16\xfc\x0e\x94\x85\x0e\xed\x40\xe9\xf7\x95\x00\x0d\x6f\x42\xde\x33\xe3\x55\xe1\xf1\x35\xa1\xa2\x0b\x45\x36\x0c\x75\x79\x72\xd9\x18\x3b\xc0\x02\x01\xd4\x26\x0e\x02\xf1\x7e\x3c\x47\xc7\x92\x2b\x45\xc0\x04\x58\x14\xde\xef\xa9\x14\xcd\xdc\xff\x02\xcf\xff\x77\x13\x35\x90\x3b\xf1\x90\xb0\xf3\x37\x5b\x9f\xf2\x38\xbf\xc7\x09\xbb\xe6\xda\xe1\xef\x91\x0e\xb0\xea\x37\x94\x40\x02\x06\xa5\xc3\x8f\x7f\x93\x9e\x5c\x17\xd2\xed\x5d\xd3\x52\x50\x50\xc4\x5f\xbf\x62\x42\xba\xe7\xeb\x6b\xb4\xe2\x4e\x2b\x36\x94\x96\x84\x20\xc6\xb1\x4b\xd3\x1d\x65\xd4\x69\xd0\xcd\xc7\x6c\x52\xab\x0d\x1d\x34\xd4\x83\x06\xfc\x3f\x87\x12\xcb\x20\x55\xdc\x59\x2f\x88\xc1\xf6\x5e\x97\xb2\xcf\x77\xac\xc4\x05\xf6\x11\x11\xed\x33\x2d\x11\x9e\xbe\x62\xed\xf6\xd7\xa7\x68\xb3\x9a\x23\xa1\x26\x3c\xb4\xfd\x72\xc8\x89\xa9\x8d\x60\x3c\xf4\xe2\x93\xc7\x6d\xb3\x79\x26\xf2\xb8\x8b\x35\x2a\x64\x5b\xb8\xfb\xb1\x5c\x15\xc3\xc0\xe9\xd4\xd8\x00\x29\xcd\xa6\x76\x90\x50\xb3\x72\x70\x8f\x5a\x90\x43\x52\x77\x04\xa3\x56\xc5\x63\xcc\x2a\xf0\x0e\x82\x29\x92\xe7\xed\x95\x55\xa3\xf5\xc2\xbb\x50\x9e\xdf\xf7\x2b\x6e\x92\x36\xb8\x7e\xbb\x96\x32\xe3\x6b\x94\xac\xe4\x06\xb1\xf1\x4b\xfc\xc5\xe1\xed\x8d\x78\xdc\x75\xf4\xac\xd7\x62\x40\x09\x81\xc6\xb2\xcb\xb6\x9f\xf2\xda\x4f\x6a\x9b\x04\x9c\x72\x88\xee\x6e\xdf\x35\xb9\xf8\xa1\x3f\xc0\xd3\xbc\xdb\xe6\xb3\x10\x45\x86\xec\x70\x00\x55\x28\xd9\xa7\x32\x4b\xad\x0e\x9a\x29\x55\x7e\xb5\x6b\x80\xf4\x5c\x50\x13\xe6\x7c\xe1\x63\xe4\x10\x25\xf7\xdb\x4f\xd4\xad\xcb\x43\x02\x7c\x07\x32\x15\x8f\x77\x28\x69\x5b\xf8\x3a\xa3\xcc\x8e\x30\x47\x88\xef\x02\x46\xc8\xb3\x51\xa5\xd0\x0d\x01\xa7\x52\xd1\xc9\x97\x3b\xd1\x3e\xbd\xb9\xf3\xe7\x33\x59\x27\xac\x6b\xf1\xa3\xb8\x90\xa1\xbb\xbc\x2d\xff\x03\xc8\x14\xa5\x71\x13\xdc\xbb\xfa\x2b\x9d\x49\xd6\xfd\xd6\xf7\x3d\xd4\xf4\x00\xaa\x5e\x27\x81\x47\x40\xf4\x0a\xe7\xaf\x01\xa1\xfe\x95\x92\xa5\x74\x5e\x1e\x00\x2b\xbf\x79\xf3\x9a\x74\x32\xe3\xdc\xdb\x99\x52\xb9\xbb\x1d\x2a\x51\x8c\x4c\xb4\x70\xb6\x2d\xb3\x57\xee\x6f\x46\x6f\x5e\xa5\xd6\xa1\x4c\x6e\x26\x4c\x94\xfd\x7a\x38\x8d\xf9\x53\xa7\x9c\x63\xad\x33\x47\x86\xf3\xb9\xa0\x27\x1a\xb8\x0d\x09\x90\xc4\xfa\xda\xe2\xfa\x70\x86\xe8\x5e\xa4\x93\x3f\x06\x66\xe8\x7f\xd4\x13\x0a\x5f\x69\x9a\xbd\xcf\x7a\x54\x82\xdb\xca\xc2\x85\xef\x2f\xad\xb9\x19\x2e\x95\x7b\x2d\xf8\x40\xe7\xdb\x75\x20\xf2\x80\xca\x06\xe8\x91\x69\x88\xa2\xcb\x52\xe7\x9a\xc8\x66\xb1\x85\xf1\x67\xe5\x94\x8d\x6a\xc7\x86\x8e\x3f\xf8\x84\x9a\xec\xe7\xc9\x31\x65\x83\xbc\x6d\xf6\x8a\x56\x1b\x12\xee\x7c\xd6\x54\xeb\x0d\x11\x59\x0e\x0f\xe4\x54\xca\xbf\x16\xf7\xbc\xea\x39\xbc\xd4\x77\x3e\xe3\x52\x73\x4a\x09\x14\x7d\xcf\x75\x17\x63\xa3\x27\xff\x62\xeb\x87\x93\x10\xd9\x46\x6d\x4b\xf9\x20\x27\x1a\x76\xd2\x7d\x1d\x7c\x99\xc7\x21\x27\x64\x9f\x32\xa5\x6d\x22\x11\x25\x7a\xd4\x4d\x0d\x3b\xcb\xe3\x0d\xb0\x75\x1e\xcb\x85\x3b\x38\xa4\x89\x0c\x64\xf0\xbe\xb8\x70\x5e\x21\x1d\xf7\x6b\x78\x98\x4e\x11\xa2\x35\x33\xec\x5a\x63\x66\x81\x1b\x68\xda\x5d\xdb\x00\x9c\x8c\x9c\x75\x7e\x1a\xac\xe3\xe7\xee\xc4\xc6\x5f\xae\x08\xf6\x6f\xc9\x9f\x24\x15\x4c\x3f\x48\xd6\x25\x54\x21\x6a\xbb\x00\x61\xa1\x21\x92\xe9\xaa\x84\xdc\x68\x2a\x8c\x32\xa8\x06\x44\x0a\xf1\x1d\x2d\x3c\x6e\xe5\xa8\x7a\x63\xbe\x01\x84\xab\x5c\x3a\x8f\x0c\xa9\xd5\xde\x73\x3b\x6a\x57\x6b\x0d\x82\xd6\x17\x12\x51\x2e\x86\x57\x77\x5d\x06\x56\xb4\x5d\x6d\x3f\x83\xbf\xc0\x1f\xfc\x3d\x40\x15\x4a\x6f\x5f\xd9\x79\xb8\x11\xe1\x7b\x45\x47\x4b\xbb\x54\xd6\x76\x9c\x50\x61\xef\x3d\xc8\x8f\x2a\xcd\x32\xf1\x6a\xe0\x64\x69\xe4\x77\xf1\x58\x09\xee\x96\x0e\xaf\x0e\x2d\x57\x05\xc0\x6f\xeb\xc2\x20\x22\xd3\x7b\x59\x96\x9e\x49\xe7\xad\x07\x01\x03\x88\xa3\x2f\x26\xab\x55\xe5\x1b\xdc\x98\x4d\x2d\x0b\xe3\x64\x1d\x89\x6b\xf9\x07\xf2\xf1\xf2\x48\xcf\xa2\x1e\x26\xba\xb3\x6f\x55\x91\x8e\xc9\xa6\xaf\x20\x82\x90\xb7\xf6\xed\x8f\x90\x62\x62\x19\xcc\xa3\x66\x7d\x16\x56\x7e\x9c\x64\xda\xfb\xef\x9b\x63\x2f\x2a\x95\xb8\xa8\xc6\x03\x34\x56\x02\x44\x86\xdd\xea\x09\xa1\x08\x91\x50\xa4\xf1\x6c\x41\xd0\xc9\x5f\xad\x91\x90\x48\x8c\x1b\x4d\x3b\x01\x48\x4f\x10\x62\x3d\xd8\x85\x40\x84\x17\x71\x11\xb1\xd8\x1a\x86\xf4\x08\xb5\xc9\x80\x9a\xe2\xb9\xd3\x73\xc2\xc4\xb7\xda\x48\x77\x1b\xb6\x5b\xc4\xae\x0b\x6d\x6c\xb1\xd4\x37\xd9\xe1\xcd\x3a\xbf\x27\xaf\x5b\x90\xe5\x4f\xd9\xbf\xf2\x79\xf7\x4e\x42\xcf\x13\xa9\x83\xd4\x5a\xf7\x19\xe9\x6e\x06\xcd\x0b\xd6\xd1\xd4\xd9\x4a\x90\x5e\xf8\xeb\xc2\x3a\x69\xc1\x11\x22\x12\xb1\xa7\xf2\x7c\xd1\x62\x05\x71\x1e\x49\x49\x33\x0f\x1e\x81\x09\xfe\x71\xe4\xe2\x55\x9c\x43\x6d\x2a\x6a\x25\xd7\xaa\xb5\x72\x2b\xfc\xd6\x94\xbe\x5d\x59\xe4\xf9\x9c\x9b\x6a\xb1\xf6\x84\x14\x29\x63\x7c\xf3\x2b\x27\xc3\x87\xac\xed\xbf\x3b\xc3\x5e\x8d\xc6\xf5\x0a\xcb\xa1\x1f\x6b\x12\xcd\x90\x09\x29\x66\x4e\xcf\x30\x78\xcc\x7b\x4c\xcd\x37\x13\xa6\x91\x3f\x2b\x4d\xbc\x95\xe7\x42\xca\x5c\x49\x73\xef\x4c\xdf\xdf\x1d\xed\x30\x04\x3f\x44\x2b\x3d\x72\x58\x18\x13\x11\xc9\x43\xa0\x91\x80\xb1\x32\xb9\x15\x01\x58\x67\x8c\x4c\xd0\xfb\x7f\x76\x3e\x54\xb3\xc2\xbb\xf2\xf5\xa0\x1a\x7f\x4f\x79\x6e\x67\x1c\xf8\xdb\x24\x0c\x2c\xc6\x7f\xa8\x9b\xc1\x6e\xb0\x72\x1b\x14\xf1\xa9\xe9\x2d\x7b\x86\x27\xf2\x3e\x5e\x96\x12\x83\xff\x48\x76\xe2\x10\xb1\x84\x42\x14\x9b\xd9\xe0\xa9\x48\x13\xfb\x65\xef\x3e\x8e\x84\x89\xb9\x09\x1f\x8b\x66\x31\x42\xba\xd1\xee\x23\xbb\x47\xff\xbf\x15\xaa\x0b\x42\x5c\x09\x70\xe0\x59\x8f\x44\x4b\x86\x58\x5e\x25\x02\x3f\x41\x84\x4f\xa5\x5c\x95\x5f\xff\xeb\x50\x92\x0b\xa5\x6a\x9f\xd3\x65\x43\x82\xab\x5b\xa8\x58\xad\x0f\x1a\x30\x51\xcf\x61\xd8\x65\x2a\x1d\xfb\xd0\xf1\xfc\xaa\xe4\x32\x03\x04\xaa\xfe\xe7\x4c\xf3\x00\x8e\x00\xda\x99\xa9\x76\x87\xca\x7f\xd7\x74\xeb\x15\x93\x76\x5b\x5a\xb6\x91\xdd\x73\xc0\x13\x95\xa7\xde\x81\xdf\x20\xa1\x10\x09\x48\x88\x7d\x58\xa4\xbe\x6b\x93\x05\x5a\x81\xe8\x6f\x64\xe7\x80\x0a\x56\xb5\xd8\x9b\x70\x05\x36\x43\x03\x63\x2e\xaa\x24\x44\xd2\x6e\x1c\xc2\xeb\x0b\x56\x1a\x0c\x16\xa8\x38\xc1\xde\x4b\xc1\x13\xe8\x45\xdc\xd9\xdc\x84\x5e\x0e\x07\xee\xe6\x20\xb0\xfd\x67\x52\xc9\x90\xdb\xbf\xdd\xd6\x23\x12\xd7\x5c\x03\x01\x06\x2b\x54\xe1\xcd\xd4\xc9\xce\x26\x74\xd5\x4c\xf0\x4d\xd1\x9b\xb1\xe4\xa4\xd9\x07\x8f\x0c\x82\x55\x60\xe9\x28\x4f\x9e\x8c\xd9\x31\xae\x8e\x30\xac\x09\xab\x44\xfd\x7a\xaa\xdd\x7e\x06\xcf\x4f\x78\xba\x61\x6c\x86\x61\x02\xd5\x35\xeb\xcf\x35\x65\x27\x5f\x8d\x84\x8c\x83\x74\xae\xdf\x7b\xe1\x69\xd4\x84\x39\xfd\x68\x7a\xb9\xd2\xc2\xfe\xf7\x90\x8c\x2b\xb6\x2e\x41\xc7\xf8\xf2\x02\xe2\x58\xe2\xff\x27\x07\xcc\xaa\xb6\xbd\x75\x7d\xf5\x35\x8c\xe9\xdb\xeb\x17\x9a\x8c\x83\x47\x61\x13\x98\x68\x98\x31\xeb\xd7\xa2\x06\xcf\x27\x64\xbd\xbe\x3b\x65\x3a\x7f\x33\xe2\xc4\x7a\x9a\x93\xb1\x8b\xa7\x4b\xfd\x1e\x18\x7f\x04\x2e\xe1\x0d\xbc\x1c\xf0\xb2\x14\x9b\x82\x4c\x6f\x19\x27\x34\x08\x76\x06\x40\x98\xf6\xd8\xe4\x7c\xb6\x5f\x47\xca\x84\x7f\x38\xf8\x77\x77\x69\xb1\x61\xca\xd8\xaf\xec\x35\xf2\xcf\x27\xa1\x96\xaf\xb3\x84\x77\xf1\x13\x38\xb8\xab\x5c\x76\x36\x12\xa7\x2a\xa1\xc7\x1a\x5e\xce\xd3\xb0\x14\xf2\x35\xce\x71\x2c\x40\xf5\x9b\xb3\x46\x88\xe5\x4a\x26\xb0\x7f\x22\xa0\x96\x99\xec\x69\x0a\x73\xec\xbb\xef\x6d\x6e\xe7\x03\xf7\xec\x05\x5a\xf0\xd0\x83\x8f\xd1\x0c\xfe\x87\x9d\x76\x88\x6d\x8d\xac\x62\x24\x51\x2b\xf6\x83\x8a\xa3\x18\xf6\xb0\x6b\xe2\xbc\xed\xbc\xf5\x7f\x99\xc7\x74\x52\xfc\xc4\xcb\x67\xa5\x17\x78\xd2\xeb\x5f\x2d\xbe\x90\x96\xad\x2e\xd7\x53\x3e\x7a\x95\xdc\x32\xe7\x3a\x30\x9f\xd4\x45\xc6\x56\xd1\xcc\xf3\xb1\x67\x9d\x5b\xcb\x9c\x52\xca\x74\xae\xf4\x20\x78\x36\x66\x2f\xf3\x9c\xb8\x9f\x59\xab\x7e\x39\xd2\x2a\x2b\xf3\x82\xef\xa8\x69\xaa\xd5\xb1\x95\x2a\x60\x53\x7a\x82\x98\x94\xe1\x97\xf6\x98\xa8\xbf\x65\x19\x4e\xf5\x58\xe7\x6f\xec\xa3\x99\x62\x57\x32\xc7\x9b\x23\x2d\x16\xd2\x92\xd8\xa6\x99\xe0\x9f\xf9\x9f\xfe\x6c\x62\x09\xde\x08\x78\xa7\xf1\xf7\x63\x39\x9b\x6f\x4d\xf3\x5a\xf6\x75\x1a\xd3\x7f\x9a\x7a\xca\x87\x8e\x10\x06\x46\x88\xe6\xb7\xc4\xe9\xc4\x7d\x01\xcf\xc7\x90\x20\x1b\x28\xee\xe5\x51\x66\x77\x09\x48\x53\x58\xdf\xaa\x19\x12\x8c\x86\xf2\xa8\xaa\x3f\x17\x76\x5a\x68\xe9\x99\x64\x6f\x07\xf2\x7b\x4f\x9b\x85\xd1\x84\x51\x57\x2b\xe1\x63\xbc\xed\xcc\xbf\x74\xaf\xca\x70\x86\x89\xcd\x7d\xdf\x4b\x90\xbb\x3d\xc6\xfa\xea\x62\xcf\x29\xd0\xcf\x19\x87\xbd\xc4\x58\xc7\x3b\x9e\x75\x46\xfb\xaf\xb2\x84\x16\x5d\x20\x85\x63\x75\x03\x38\x8f\xc2\xbb\x3b\xc9\x26\xcf\x1f\x93\x4d\x6e\xc9\xef\x65\xc0\x2d\xc1\x8a\x3a\xd7\x4b\xe9\x22\x6e\xbe\x6d\x62\x7f\x51\x86\x19\xbe\xd4\x4b\xef\x34\x78\xc5\x3c\x2a\xc2\x73\x74\xd9\xdd\x4c\x2d\xca\x2a\xdf\x21\x5f\x9c\x56\x6b\xe1\xd6\xda\x9f\x78\x92\xc0\x0b\x0a\x6d\x69\x7f\x1e\x02\x63\x45\x20\x85\x5f\x1d\x0d\x77\x73\x52\x97\xd5\x5d\xe7\xb8\x3e\xbc\xd6\x38\x08\x38\xf0\x23\xe5\x25\xe4\xf1\xc4\xf1\xcd\xb5\xd0\x22\x6d\xca\xf5\x90\xdd\x50\x11\xc3\x50\x0f\x2f\xe9\x15\xd1\xf0\x76\xd5\xca\xfd\x3a\x95\xef\xcd\xfb\xc7\x9a\x69\x00\x72\xf6\x6c\xc9\xe7\xc1\x19\xf5\x84\x3c\xd3\xe9\x8f\x31\xfe\x46\xdd\x5e\x1d\xaa\x40\x12\xc6\xf8\x64\x82\x04\xd4\xe1\xc4\x46\xa9\x6a\x1f\xf7\xae\x96\x65\xb0\x4b\x3a\x52\xe1\x19\xd9\x18\x6d\xb5\x9b\xef\xfe\xdf\x74\x65\xca\xe1\xf1\x25\x92\x5e\x54\x7b\x5d\x84\xe6\x4d\xb1\xb1\x66\xb2\xc2\xf1\xf9\xc2\xdc\xc2\xcb\x2a\xe3\x10\x5e\xa0\x26\xe2\x95\x2d\x74\xfd\xf1\xe7\x8f\xbe\xaf\x29\xc0\x7e\xbb\x55\x9d\x9a\xfd\xba\x1b\x30\x55\x7f\xd8\x51\x3b\xb5\x66\xb3\x0d\x24\x51\x35\xe2\x37\x8f\xf0\xc9\xe0\xf3\xeb\x36\x2e\x1f\xfc\xbd\xfc\xe2\x3e\x7f\xe3\x54\x66\x32\xac\xe5\x4a\x64\x78\x24\x35\x27\x34\xb5\x76\x6a\xfd\xe2\xf5\x7a\xc5\x96\x67\xb2\xa3\x4d\xef\xb5\xe6\xca\x8f\x6e\x38\x19\x68\xb9\x95\x7a\x07\xaa\xbb\x38\x34\x2b\x59\x3c\x16\x60\x72\x66\x4d\x5d\x98\x11\xac\x0a\xba\xa8\xe9\x3e\x07\x81\xe1\xbb\x99\xba\xd0\xd1\xe1\xa6\x81\x71\x7b\xf2\x88\x0a\xc9\x82\xc5\x7c\x94\x57\x79\xc6\xd5\xd8\xd3\x4a\x2e\x54\x8f\x15\x74\x66\x4b\x25\xb0\xca\x75\xa5\x21\x87\xe7\xb1\x68\x8e\x0d\x08\x0f\x85\x3a\xed\x22\x1d\x6b\xf1\x73\x96\x71\xca\xb7\xa3\x25\xae\x36\x39\xf0\x35\x71\x19\xce\x91\xe5\xab\x7f\x97\xb1\x26\x10\xd7\x7b\x3a\x79\xe4\x7f\x5e\xaf\xfb\xa8\x34\x31\xa8\x0e\x46\x30\xc5\x35\x70\xb9\x04\xac\x24\x02\xe7\xa3\xdc\x6f\xda\x92\x37\xdd\xf5\xd8\x6a\xdd\xc4\x0c\x7b\x61\xd6\x0e\x3f\xe3\x9f\xe5\xa1\x53\x8a\x47\x53\xc3\x9f\x91\x45\x49\xe7\x5d\x4d\x0d\xdb\x9c\xe4\xce\xf2\xf6\xad\x6e\xdd\xd8\xf9\xe0\x13\x30\x22\x29\x42\xeb\x09\x97\xab\x03\xb7\x27\x60\x82\xb3\xd6\x07\x23\x03\x8e\xcd\x7b\x75\x3b\xc7\x31\x17\xbd\x0b\x44\x44\x54\x6a\x7f\xbf\x0b\x80\x7e\x72\x88\xa9\xe2\x53\x06\xf6\x48\xb7\xf7\xf4\xed\xca\x28\xd0\x68\xa0\xf4\xc0\xda\x41\x9d\x92\xcc\xf6\x4c\x77\xf1\xa0\xa2\xb1\xc6\x50\x97\xc2\xc4\x4b\x39\xa9\xfe\xe0\xf9\x3a\xf0\xc1\xa3\x8f\x92\xa2\x60\x81\x9c\x47\x19\xaa\x5a\x92\xc1\x2a\x24\xc7\xa2\xed\x2d\xf1\x98\x0c\x85\x98\xdf\xf7\xf7\xd8\xc4\x35\x47\x32\x55\xe7\x92\x85\x05\x48\xcf\x0e\x62\xb3\xa2\x44\xfd\x7a\x23\xe8\x78\x87\x32\xc5\x9b\xaf\xba\xc0\xb8\x98\x66\x54\xfd\xbc\xff\xc9\x90\x16\x87\xcb\x44\xa9\x5e\x8d\xb0\xf6\x4e\x9e\xfb\xf2\xbc\x0f\x01\x9c\xb3\x32\x9e\xbf\xc5\x8b\xb8\xc9\xae\x9c\xa7\x01\xe7\x83\x75\xa0\x9b\x94\xed\xcf\x3b\x3b\xcf\x20\x40\x5d\xf7\x39\xf5\xa1\xad\x8b\xda\x00\xc4\x93\xe7\xb2\x63\x92\x87\x29\x73\x9e\x00\xfe\xde\x81\xee\x33\x2d\x58\xf4\xde\xa0\xcd\xb8\xc9\xed\xe5\xe9\x31\xc2\x58\xd8\x88\x38\x29\xef\xc6\x90\x21\x61\x21\xd4\x96\x99\x8a\xdf\xf5\x17\xa9\xad\xac\x12\x2f\xd9\x77\xca\x18\xe3\xb8\x52\x95\x83\x8f\x68\xdf\x6d\x08\xc7\x1d\x65\x29\x6e\x98\xda\xd2\xb0\x83\xcb\x15\x7b\x8e\x0b\x4a\x22\xd7\x2c\x37\xe4\x48\x3e\x3c\x81\x22\x9c\xa1\x94\x7b\xfa\x87\x62\x02\xf0\xe2\x60\x1d\x36\xca\x91\x2a\x43\x7a\xc6\x40\xec\xd1\x81\x62\x8b\xdd\x00\x77\xfd\x5c\xcc\x73\xc8\xf9\x62\x20\xfe\xeb\x73\x63\x1d\x43\x97\x1e\xb1\xbc\xc0\xc8\x38\x59\xd7\x88\xc9\x5f\x64\xff\xfc\x5d\x01\x4d\xf8\xfc\xcf\xc0\x0b\x5e\x17\x8d\x10\x72\xb0\xa8\x3f\x07\x0c\x42\x44\xa1\xe7\x18\x72\x09\xdd\x62\xf9\x6a\xe5\xa3\xcf\x7a\xcb\xc7\x7b\x75\xf2\x0b\xb5\xa6\x6c\xa6\x82\xdc\x88\x37\xa4\xc2\x7c\x66\x8b\x9c\x3b\x5c\xd5\x60\xc7\x2f\x22\x29\xfc\xd2\x02\x5d\x0a\xbb\x95\x7c\x69\xff\xb2\xa2\x60\x7d\xca\x3a\x4a\xc5\xeb\xfc\x73\x51\x2a\x77\x3c\x43\x86\xeb\xcf\x67\x13\x27\x23\xb0\x91\x7e\xea\xf4\xb7\x02\x30\xa9\xbc\xa4\xbd\xe0\xa3\xa4\x3d\x09\x21\xa8\xbb\xc5\x7c\xd5\x9e\xb9\x46\xdd\x04\x99\x78\xd7\x70\x3a\xe0\x8d\xe9\x57\x21\xcb\xc6\xf1\xaf\x00\x6a\x19\xd3\xae\xdd\x17\xb7\x3d\x17\x03\xce\xf3\xcc\x45\x6f\x30\x60\xbc\x6e\x1e\x87\xeb\xeb\x5d\x27\x55\x67\x86\xb9\xb2\xf0\x60\x20\x4d\xa5\xca\x08\x57\xee\x97\x28\x5e\x5f\x4b\x5f\xf7\xe5\xbd\x54\x6f\x0b\xf3\x68\xc3\xdc\xcb\x84\xaa\x8c\xef\x5d\x94\xa2\x9a\x22\x22\xdf\xcc\x1b\x7a\x1e\x1a\x68\x66\x78\xbe\xd1\x18\x6d\x3c\xfe\xc9\x59\xc2\x59\xde\xb0\xb2\xd6\x70\xbd\xdf\x0c\x7f\xc7\x24\x5f\xa9\x24\x04\x59\x67\x9b\xee\xac\x19\xe7\xea\x67\x12\x55\x05\x49\x83\x24\x32\x47\xa2\xe2\x81\x41\xb9\xd1\xe4\x6a\xbc\xb3\xf8\x19\x4f\xb4\x03\xac\x15\x77\xb5\x73\x6e\x6e\x28\x4e\x8f\xaa\x40\x9c\x96\xd8\xa8\xf7\x5c\x3b\xca\xca\x9b\xbf\x8c\x69\x60\x95\x7a\x13\xf1\x21\xa6\xbb\x5e\x4b\x94\x15\xb5\xde\xea\xc5\xd5\x54\xc7\xdb\xbc\x5b\xcd\x61\x43\x0a\x4e\x4b\xa1\x93\xff\xed\x94\x06\x91\x2c\xad\xd1\xde\xcb\x4d\x67\xc5\xe1\xb6\xc6\x0b\x78\xff\x30\xd4\xc5\x31\x28\x9e\x77\xcd\xf5\x0b\x4a\x01\xfa\x04\x5f\xcf\x65\x2d\xdd\x95\x2c\xc9\xc5\x53\x89\x2c\xdb\x4e\xa5\xdf\x64\x16\x44\x06\x28\xf6\xa4\x3e\x3f\x9b\x41\xb4\x67\x75\x98\x32\x77\x5e\xd7\x6a\x3e\x97\xd3\xf7\xf2\x29\x26\xf4\x34\x8d\x2e\x87\x67\x29\x46\x71\xf7\x77\xf3\x03\x6f\x0f\xe3\x76\x2d\xf3\xda\xee\xc7\xe1\x13\xd4\x0c\x0c\x6d\x10\xa6\xb6\x8e\x28\x4b\xe3\x90\x0c\x70\xfd\xcc\x7d\xf5\xfb\x4f\xa4\xb6\x3d\xc3\x5e\x3f\x95\x77\x9e\x9b\xfc\x4c\x76\x96\xbe\xc3\xe6\xa9\xf8\x00\x33\x84\xc4\x96\xc5\xf1\x60\xac\xac\x87\xd3\xf5\x62\xb9\xd6\x3c\x7b\x2a\x7c\x2a\x73\x8c\x6c\xf3\x12\x3e\x7d\x72\xd7\xda\x68\xf8\xf1\x79\x20\x6d\xce\x6d\x44\x7c\x82\xd1\x6b\x4e\xed\x1e\x05\x85\x66\xd1\x59\x6c\xae\xc0\x88\xa6\xc7\x66\x93\x15\x4a\xa5\xba\x03\x5b\xa3\x24\x73\xe3\x80\xe3\xd5\x22\xa0\x72\x62\x15\x24\xa0\xac\x58\xc1\x67\xb6\x90\x7e\x40\x9c\xb3\x4a\x3b\x04\x53\x37\x9d\x28\xff\xaf\x88\x69\xa0\x58\x7d\x9e\xa8\x40\x00\xc8\x12\x64\xca\x43\x1a\x0a\xde\x69\xcb\xe0\x39\xd0\x3a\x69\x69\x05\x59\xf9\x3c\xaa\x56\x82\x60\x5b\x8d\xd9\xda\x3e\x50\x40\x74\x6d\xe6\xac\x33\xd1\xf3\x82\x29\xaa\xbb\xfc\xd0\x64\xd5\x3a\x18\xa8\x30\x18\x48\xb6\x9a\x43\xd6\x1b\x2e\x25\x4a\xd3\x51\xe4\xb3\xc0\x14\x7e\xdc\xf8\x36\x31\xc3\xc1\xbf\xf4\xcc\xae\x45\xe7\x4b\xe7\xa1\xc7\x54\x09\x2f\x4f\xca\x07\xe5\x60\xdc\x9d\x88\x09\xfa\x3c\x98\xdb\x81\x6e\xd2\x6d\xe2\x3a\x35\xcf\xb1\xdd\x69\x85\xca\x50\x4c\x28\x02\x6e\x0f\x91\x84\xbc\x86\x10\xe5\x71\xc8\xba\xfe\x2e\x61\x24\x0f\x27\x0e\x7e\x22\x25\xa0\x1b\x92\x71\xbc\xda\x3d\xa8\x3f\xd8\xe7\xbc\x07\xdc\x87\xcc\x03\x47\xac\x8c\x0b\xc7\xaa\x30\xf9\x48\xd4\x70\x74\x86\x50\x38\x31\x9c\xb9\xd3\x82\xeb\x56\xa7\x43\xc8\xb5\x0f\x63\x17\xf2\xb0\xaa\x34\x22\xfa\x43\xc5\x14\xdc\x44\xb6\xf1\xad\x45\x9a\x19\x6e\xc0\xb3\xfb\xd8\x94\x2f\x33\x2b\x94\xcb\xed\x08\xd2\x68\x9e\x61\xf1\x51\x51\x94\xc9\x8a\x91\xf0\x30\xfa\x5c\x26\xed\xc8\x6c\xc7\xba\x21\xd9\x13\x3d\xa4\x66\x1d\x49\x62\xa3\xfd\x81\x3d\xcb\x9e\xfd\x41\x44\x9c\x47\x9f\x6b\xcd\x9e\x6b\x9a\x4b\xd4\xc4\x15\x43\xdb\xfe\x85\xab\xc6\xa4\x91\xe3\xec\x83\xe4\x69\x80\xc5\xa9\x6c\xca\x34\xf9\xf0\xbc\x0c\x2d\x81\x16\x0b\x7a\x49\x25\xba\xaa\x31\xba\x63\x75\x8b\xd5\x5d\x36\x1b\x3a\x9e\x75\x73\x8e\x36\x36\xc9\x05\x91\x4d\xcb\xba\xdf\x2e\x90\xf6\x18\xa5\x78\xb4\x95\xee\xee\x80\xca\xc3\xc0\xf5\x14\xf6\x13\xc5\x95\x1f\x94\xe9\x19\xbe\xf0\xe3\x9f\x31\x1b\xff\x58\xa5\xfc\x3c\x5e\x2f\xe7\xb7\xe3\x92\x0e\xa8\xa4\xc0\x34\x32\xd2\xc0\x3e\x4d\xd3\xe4\x8c\x4e\xc7\xaf\x99\x65\x4c\x54\xa4\x61\xd1\x35\xda\xeb\x05\x69\x0e\x35\xa3\xfe\x02\xa1\x56\x33\x03\x49\xc1\x9e\xf5\x5e\x93\x06\x74\xd0\x2e\x04\xb7\xe8\xc9\x74\x21\xff\xa2\x0c\xff\x96\xe5\xfc\xcb\xc7\x15\xe7\xcf\xf8\xd8\x00\x98\xcb\x4e\x21\xb6\xbb\x9b\x32\x89\xc2\xc1\xd5\x43\x89\xcb\xc8\xba\x20\x2b\x39\x83\x4f\x16\x8d\xfe\xa7\xa2\x6f\x1d\x85\xc8\xe7\x14\xe3\x7d\x38\x45\x3b\x91\xd3\x55\x2c\xab\x6d\x02\xab\x08\x1c\x38\xda\xea\xa7\x25\x7d\x5d\xf5\x4d\xbc\x59\x17\x34\x72\x34\xa3\x27\x8f\x42\x34\xc3\x5e\x2f\x51\x60\xd7\x5d\x6c\xc8\x58\x67\xbd\x82\x22\x4d\xdc\x29\xf7\x7a\x00\xcb\xcf\x85\xe1\xe8\x8a\x35\x46\xef\x1e\x5d\xb9\x08\xfa\xa9\xc0\xf6\x79\x4f\x8c\x3e\xdd\x24\x7f\xc2\x68\x66\xf3\x02\xd6\x94\xc7\x93\x62\x45\xd5\x49\x1e\xe8\x3e\x05\x5e\xa4\xb3\x77\x02\xee\xe1\xe7\xd8\x45\x61\x9f\x58\xbd\x33\xff\xfb\x42\x95\xff\xe4\x3f\xf9\x4f\xfe\x93\xff\xe4\x3f\xf9\x4f\xfe\x93\xff\xdb\x92\x1d\xdb\x81\xa7\x3a\x9d\xc2\xb8\x19\xb4\xed\x2f\x12\x11\x7e\xe0\x6f\x54\x64\xca\x5f\x9b\x86\xfc\x3f\x01\x00\x00\xff\xff\xd9\x58\x60\x8e\xa3\x4f\x00\x00")
-
-func imagesLgtm_maskPngBytes() ([]byte, error) {
-	return bindataRead(
-		_imagesLgtm_maskPng,
-		"images/lgtm_mask.png",
-	)
-}
-
-func imagesLgtm_maskPng() (*asset, error) {
-	bytes, err := imagesLgtm_maskPngBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "images/lgtm_mask.png", size: 20387, mode: os.FileMode(420), modTime: time.Unix(1459959922, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"images/lgtm_mask.png": imagesLgtm_maskPng,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-var _bintree = &bintree{nil, map[string]*bintree{
-	"images": &bintree{nil, map[string]*bintree{
-		"lgtm_mask.png": &bintree{imagesLgtm_maskPng, map[string]*bintree{}},
-	}},
-}}
-
-// RestoreAsset restores an asset under the given directory
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// RestoreAssets restores an asset under the given directory recursively
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
-}
-
@@ -0,0 +1,171 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"context"
+	"errors"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/report"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// BackupExt is extension of backup saved next to input
+const BackupExt = ".bak"
+
+// ErrBackupExists is reported when input seems to be processed in place already
+var ErrBackupExists = errors.New("backup already exists")
+
+// backup keeps original images of in-place mode
+type backup struct {
+	// dir keeps relative directory structure of inputs
+	// originals are saved next to inputs with BackupExt if empty
+	dir string
+}
+
+// Get backup file path of input
+func (b *backup) path(input string, relativePath string) string {
+	if b.dir == "" {
+		return input + BackupExt
+	}
+
+	return filepath.Join(b.dir, relativePath)
+}
+
+// Back up image file and overwrite it with masked image
+// existing backup is never overwritten, so input is skipped
+func (cli *CLI) maskInPlace(ctx context.Context, input string, backupPath string, dryRun bool, opts []lgtm.Option) *report.Result {
+	if existFile(backupPath) {
+		return &report.Result{Input: input, Output: backupPath, Status: report.StatusSkipped, Error: ErrBackupExists}
+	}
+	if dryRun {
+		return cli.planFile(input, input, true)
+	}
+
+	result := &report.Result{Input: input, Output: input, Status: report.StatusSuccess}
+	start := time.Now()
+	defer func() { result.Duration = time.Since(start) }()
+
+	err := cli.withRetry(ctx, input, func() error {
+		return copyFile(input, backupPath)
+	})
+	if err != nil {
+		result.Status, result.Error = report.StatusFailed, err
+		return result
+	}
+	cli.debugImage(input)
+	result.InputBytes = fileSize(input)
+	if err := replaceFile(ctx, input, opts); err != nil {
+		var u *untouchedError
+		if errors.As(err, &u) {
+			// backup is same as input, so retry is allowed
+			os.Remove(backupPath)
+		}
+		result.Status, result.Error = report.StatusFailed, timeoutError(err)
+		return result
+	}
+	result.Bytes = fileSize(input)
+	result.Width, result.Height = fileImageSize(input)
+
+	return result
+}
+
+// Overlay mask on input and replace it
+// masked image is written to temporary file next to input and renamed over input,
+// so input is never left half written. errors before renaming are untouchedError
+func replaceFile(ctx context.Context, input string, opts []lgtm.Option) error {
+	info, err := os.Stat(input)
+	if err != nil {
+		return untouched(err)
+	}
+	// keep extension, which decides output format
+	temp, err := ioutil.TempFile(filepath.Dir(input), "."+filepath.Base(input)+".*"+filepath.Ext(input))
+	if err != nil {
+		return untouched(err)
+	}
+	temp.Close()
+	tempPath := temp.Name()
+
+	if err := lgtm.ProcessFileContext(ctx, input, tempPath, opts...); err != nil {
+		os.Remove(tempPath)
+		return untouched(err)
+	}
+	if err := os.Chmod(tempPath, info.Mode().Perm()); err != nil {
+		os.Remove(tempPath)
+		return untouched(err)
+	}
+	if err := os.Rename(tempPath, input); err != nil {
+		// input may be replaced partly on some file systems, so backup is kept
+		os.Remove(tempPath)
+		return err
+	}
+
+	return nil
+}
+
+// untouchedError is error of in-place mode which happened before input was touched
+type untouchedError struct {
+	err error
+}
+
+func (e *untouchedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *untouchedError) Unwrap() error {
+	return e.err
+}
+
+// Wrap error as untouchedError
+func untouched(err error) error {
+	return &untouchedError{err}
+}
+
+// Copy file keeping its mode
+func copyFile(src string, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(dst)
+		return err
+	}
+
+	return out.Close()
+}
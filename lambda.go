@@ -0,0 +1,86 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"flag"
+	"github.com/aws/aws-lambda-go/lambda"
+	"github.com/neko-neko/lgtmgen/config"
+	lgtmlambda "github.com/neko-neko/lgtmgen/lambda"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/mask_image"
+	"os"
+	"strings"
+)
+
+// Run as AWS Lambda function
+// e.g. bootstrap of custom runtime runs "lgtmgen lambda --destination s3://lgtm-bucket/lgtms/"
+func (cli *CLI) runLambda(args []string) int {
+	var (
+		destination string
+		maskPath    string
+		style       string
+		text        string
+	)
+
+	// load config file
+	conf, err := cli.loadConfig(args[1:])
+	if err != nil {
+		return ExitCodeError
+	}
+
+	// Define option flag parse
+	flags := flag.NewFlagSet(Name+" lambda", flag.ContinueOnError)
+	flags.SetOutput(cli.errStream)
+
+	flags.String("config", "", "Config file path (default ~/"+config.FileName+")")
+
+	flags.StringVar(&destination, "destination", os.Getenv("LGTMGEN_DESTINATION"), "Storage URI of images of S3 events(e.g. s3://lgtm-bucket/lgtms/)")
+
+	flags.StringVar(&maskPath, "mask", stringOr(conf.Mask, os.Getenv("LGTMGEN_MASK")), "Mask image path or embedded asset name. Overrides style")
+	flags.StringVar(&maskPath, "m", stringOr(conf.Mask, os.Getenv("LGTMGEN_MASK")), "Mask image path or embedded asset name(Short)")
+
+	styles := strings.Join(mask_image.StyleNames(), ", ")
+	flags.StringVar(&style, "style", stringOr(conf.Style, mask_image.DefaultStyle), "Mask style("+styles+" or user mask name)")
+
+	flags.StringVar(&text, "text", stringOr(conf.Text, os.Getenv("LGTMGEN_TEXT")), "Render text instead of mask image")
+	flags.StringVar(&text, "t", stringOr(conf.Text, os.Getenv("LGTMGEN_TEXT")), "Render text instead of mask image(Short)")
+
+	// Parse commandline flag
+	if err := flags.Parse(args[1:]); err != nil {
+		return ExitCodeError
+	}
+
+	// load mask image
+	mask, err := loadMask(maskPath, style, text, textOptions{})
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+
+	opts := []lgtm.Option{
+		lgtm.WithMask(mask.MaskImage),
+	}
+
+	// runs until runtime stops function
+	lambda.Start(lgtmlambda.NewHandler(destination, opts))
+
+	return ExitCodeOK
+}
@@ -0,0 +1,236 @@
+// Package lambda runs LGTM image generation on AWS Lambda.
+// API Gateway requests are answered with generated image,
+// images put in S3 bucket are written to destination storage.
+package lambda
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/aws/aws-lambda-go/events"
+	"github.com/disintegration/imaging"
+	"github.com/neko-neko/lgtmgen/fetcher"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/server"
+	"github.com/neko-neko/lgtmgen/storage"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// ErrUnknownEvent is returned when event is neither API Gateway request nor S3 event
+var ErrUnknownEvent = errors.New("unknown event")
+
+// Handler handles API Gateway(REST and HTTP API) requests and S3 events
+// it implements lambda.Handler of aws-lambda-go
+type Handler struct {
+	// Options are used for every generation
+	Options []lgtm.Option
+
+	// Fetcher downloads source image url of API request
+	// it is public fetcher since url is given by client
+	Fetcher *fetcher.Fetcher
+
+	// MaxDimension is max width and height of source image of API request, 0 is unlimited
+	MaxDimension int
+
+	// Destination is storage URI of images of S3 events
+	// e.g. s3://lgtm-bucket/lgtms/
+	Destination string
+}
+
+// constructor
+func NewHandler(destination string, opts []lgtm.Option) *Handler {
+	return &Handler{
+		Options:      opts,
+		Fetcher:      fetcher.NewPublicFetcher(),
+		MaxDimension: server.DefaultMaxDimension,
+		Destination:  destination,
+	}
+}
+
+// event has fields to tell shape of payload
+type event struct {
+	Records []struct {
+		EventSource string `json:"eventSource"`
+	} `json:"Records"`
+
+	// HTTPMethod is set by REST API, RequestContext.HTTP by HTTP API
+	HTTPMethod     string `json:"httpMethod"`
+	RequestContext struct {
+		HTTP struct {
+			Method string `json:"method"`
+		} `json:"http"`
+	} `json:"requestContext"`
+}
+
+// Invoke handles payload of event
+func (h *Handler) Invoke(ctx context.Context, payload []byte) ([]byte, error) {
+	var e event
+	if err := json.Unmarshal(payload, &e); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(e.Records) > 0 && e.Records[0].EventSource == "aws:s3":
+		var s3Event events.S3Event
+		if err := json.Unmarshal(payload, &s3Event); err != nil {
+			return nil, err
+		}
+		return nil, h.handleS3(ctx, s3Event)
+	case e.HTTPMethod != "":
+		var req events.APIGatewayProxyRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return json.Marshal(h.handleAPI(req.HTTPMethod, req.QueryStringParameters["url"], req.Body, req.IsBase64Encoded))
+	case e.RequestContext.HTTP.Method != "":
+		var req events.APIGatewayV2HTTPRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return json.Marshal(h.handleAPI(req.RequestContext.HTTP.Method, req.QueryStringParameters["url"], req.Body, req.IsBase64Encoded))
+	}
+
+	return nil, ErrUnknownEvent
+}
+
+// Generate image of API request like /generate of server
+// GET ?url=... masks image of url, POST masks image in body
+func (h *Handler) handleAPI(method string, sourceURL string, body string, encoded bool) events.APIGatewayProxyResponse {
+	var (
+		input []byte
+		err   error
+	)
+	switch method {
+	case http.MethodGet:
+		if sourceURL == "" {
+			return errorResponse(http.StatusBadRequest, errors.New("url is required"))
+		}
+		input, err = h.Fetcher.Fetch(sourceURL)
+	case http.MethodPost:
+		input = []byte(body)
+		if encoded {
+			input, err = base64.StdEncoding.DecodeString(body)
+		}
+	default:
+		return errorResponse(http.StatusMethodNotAllowed, errors.New("method not allowed"))
+	}
+	if err != nil {
+		return errorResponse(errorStatus(err), err)
+	}
+
+	// reject huge images before decoding them
+	if err := server.CheckDimension(input, h.MaxDimension); err != nil {
+		return errorResponse(errorStatus(err), err)
+	}
+
+	output, format, err := h.generate(input)
+	if err != nil {
+		return errorResponse(http.StatusBadRequest, err)
+	}
+
+	// binary body is base64 encoded for API Gateway
+	return events.APIGatewayProxyResponse{
+		StatusCode:      http.StatusOK,
+		Headers:         map[string]string{"Content-Type": lgtm.ContentType(format)},
+		Body:            base64.StdEncoding.EncodeToString(output),
+		IsBase64Encoded: true,
+	}
+}
+
+// Generate images put in bucket and write them to destination
+// objects under destination are skipped to avoid triggering itself
+func (h *Handler) handleS3(ctx context.Context, e events.S3Event) error {
+	if h.Destination == "" {
+		return errors.New("destination is required for S3 events")
+	}
+	destination, err := storage.Open(ctx, h.Destination)
+	if err != nil {
+		return err
+	}
+
+	var failed []string
+	for _, record := range e.Records {
+		// keys in event are URL encoded
+		key, err := url.QueryUnescape(record.S3.Object.Key)
+		if err != nil {
+			return err
+		}
+		source := "s3://" + record.S3.Bucket.Name + "/"
+		if strings.HasPrefix(source+key, strings.TrimSuffix(h.Destination, "/")+"/") {
+			continue
+		}
+		if err := h.maskObject(ctx, source, key, destination); err != nil {
+			failed = append(failed, fmt.Sprintf("%s%s: %s", source, key, err))
+		}
+	}
+	if len(failed) > 0 {
+		return errors.New(strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+// Mask object of source bucket and write it to destination with same key
+// objects which are not images are skipped
+func (h *Handler) maskObject(ctx context.Context, source string, key string, destination storage.Storage) error {
+	name := lgtm.OutputFilename(key, "")
+	format, err := lgtm.FormatFromFilename(name)
+	if err != nil {
+		return nil
+	}
+
+	bucket, err := storage.Open(ctx, source)
+	if err != nil {
+		return err
+	}
+	input, err := bucket.Read(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	var output bytes.Buffer
+	if err := lgtm.ProcessContext(ctx, bytes.NewReader(input), &output, format, h.Options...); err != nil {
+		return err
+	}
+
+	return destination.Write(ctx, path.Clean(name), output.Bytes(), lgtm.ContentType(format))
+}
+
+// Mask image in same format as source, PNG if format is unknown
+func (h *Handler) generate(input []byte) ([]byte, imaging.Format, error) {
+	format, err := lgtm.DetectFormat(input)
+	if err != nil {
+		format = imaging.PNG
+	}
+
+	var output bytes.Buffer
+	if err := lgtm.Process(bytes.NewReader(input), &output, format, h.Options...); err != nil {
+		return nil, 0, err
+	}
+
+	return output.Bytes(), format, nil
+}
+
+// Get status code of error of source image like server
+func errorStatus(err error) int {
+	if errors.Is(err, fetcher.ErrTooLarge) || errors.Is(err, server.ErrTooManyPixels) {
+		return http.StatusRequestEntityTooLarge
+	}
+
+	return http.StatusBadRequest
+}
+
+// Response of error message
+func errorResponse(code int, err error) events.APIGatewayProxyResponse {
+	return events.APIGatewayProxyResponse{
+		StatusCode: code,
+		Headers:    map[string]string{"Content-Type": "text/plain; charset=utf-8"},
+		Body:       err.Error(),
+	}
+}
@@ -0,0 +1,459 @@
+package lgtm
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/draw"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"math"
+)
+
+// pngSignature is first bytes of PNG file
+const pngSignature = "\x89PNG\r\n\x1a\n"
+
+// APNG dispose and blend operations of frame
+const (
+	apngDisposeNone       = 0
+	apngDisposeBackground = 1
+	apngDisposePrevious   = 2
+
+	apngBlendSource = 0
+)
+
+// ErrInvalidAPNG is returned when animation chunks of PNG are broken
+var ErrInvalidAPNG = errors.New("invalid apng")
+
+// apngFrame is frame of animated PNG
+type apngFrame struct {
+	image    image.Image
+	bounds   image.Rectangle
+	delayNum uint16
+	delayDen uint16
+	dispose  byte
+	blend    byte
+}
+
+// apng is animated PNG
+type apng struct {
+	width, height int
+	frames        []apngFrame
+
+	// plays is number of loops, 0 is infinite
+	plays uint32
+}
+
+// pngChunk is chunk of PNG stream
+type pngChunk struct {
+	name string
+	data []byte
+}
+
+// PNG has animation control chunk
+func isAPNG(data []byte) bool {
+	chunks, err := readChunks(data)
+	if err != nil {
+		return false
+	}
+	for _, chunk := range chunks {
+		switch chunk.name {
+		case "acTL":
+			return true
+		case "IDAT":
+			return false
+		}
+	}
+
+	return false
+}
+
+// Overlay mask on APNG stream and write it as APNG
+// every frame is written as full image
+func ProcessAPNG(r io.Reader, w io.Writer, opts ...Option) error {
+	return processAPNG(context.Background(), r, w, opts)
+}
+
+// ProcessAPNG which gives up between frames when ctx is done
+func processAPNG(ctx context.Context, r io.Reader, w io.Writer, opts []Option) error {
+	o, err := newOptions(opts)
+	if err != nil {
+		return err
+	}
+	input, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	src, err := decodeAPNG(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	dst := &apng{plays: src.plays}
+	canvas := image.NewNRGBA(image.Rect(0, 0, src.width, src.height))
+	for _, frame := range src.frames {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		// keep canvas to restore after this frame
+		var previous *image.NRGBA
+		if frame.dispose == apngDisposePrevious {
+			previous = image.NewNRGBA(canvas.Bounds())
+			draw.Draw(previous, previous.Bounds(), canvas, image.Point{}, draw.Src)
+		}
+
+		op := draw.Over
+		if frame.blend == apngBlendSource {
+			op = draw.Src
+		}
+		draw.Draw(canvas, frame.bounds, frame.image, frame.image.Bounds().Min, op)
+		maskedImage, err := o.overlay(canvas)
+		if err != nil {
+			return err
+		}
+		dst.frames = append(dst.frames, apngFrame{
+			image:    maskedImage,
+			bounds:   maskedImage.Bounds(),
+			delayNum: frame.delayNum,
+			delayDen: frame.delayDen,
+			dispose:  apngDisposeNone,
+			blend:    apngBlendSource,
+		})
+
+		switch frame.dispose {
+		case apngDisposeBackground:
+			draw.Draw(canvas, frame.bounds, image.Transparent, image.Point{}, draw.Src)
+		case apngDisposePrevious:
+			canvas = previous
+		}
+	}
+	if len(dst.frames) == 0 {
+		return ErrInvalidAPNG
+	}
+	dst.width, dst.height = dst.frames[0].bounds.Dx(), dst.frames[0].bounds.Dy()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return encodeAPNG(w, dst, o.pngCompression)
+}
+
+// Read chunks of PNG stream
+func readChunks(data []byte) ([]pngChunk, error) {
+	if !bytes.HasPrefix(data, []byte(pngSignature)) {
+		return nil, ErrInvalidAPNG
+	}
+	data = data[len(pngSignature):]
+
+	var chunks []pngChunk
+	for len(data) >= 12 {
+		length := binary.BigEndian.Uint32(data)
+		if uint64(length)+12 > uint64(len(data)) {
+			return nil, ErrInvalidAPNG
+		}
+		chunks = append(chunks, pngChunk{name: string(data[4:8]), data: data[8 : 8+length]})
+		data = data[12+length:]
+	}
+
+	return chunks, nil
+}
+
+// Decode frames of APNG
+// each frame is decoded as PNG built from header chunks and its data
+// decoding gives up between frames when ctx is done
+func decodeAPNG(ctx context.Context, data []byte) (*apng, error) {
+	chunks, err := readChunks(data)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &apng{}
+	var header []pngChunk
+	var frame *apngFrame
+	var frameData []byte
+	flush := func() error {
+		if frame == nil {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		img, err := decodeFrame(header, frame.bounds, frameData)
+		if err != nil {
+			return err
+		}
+		frame.image = img
+		a.frames = append(a.frames, *frame)
+		frame, frameData = nil, nil
+		return nil
+	}
+
+	for _, chunk := range chunks {
+		switch chunk.name {
+		case "IHDR":
+			if len(chunk.data) != 13 {
+				return nil, ErrInvalidAPNG
+			}
+			// PNG size is at most 2^31-1
+			a.width = int(binary.BigEndian.Uint32(chunk.data[0:]))
+			a.height = int(binary.BigEndian.Uint32(chunk.data[4:]))
+			if a.width == 0 || a.height == 0 || a.width > math.MaxInt32 || a.height > math.MaxInt32 {
+				return nil, ErrInvalidAPNG
+			}
+			header = append(header, chunk)
+		case "acTL":
+			if len(chunk.data) != 8 {
+				return nil, ErrInvalidAPNG
+			}
+			a.plays = binary.BigEndian.Uint32(chunk.data[4:])
+		case "fcTL":
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			if len(chunk.data) != 26 {
+				return nil, ErrInvalidAPNG
+			}
+			d := chunk.data
+			bounds, ok := frameBounds(d, a.width, a.height)
+			if !ok {
+				return nil, ErrInvalidAPNG
+			}
+			frame = &apngFrame{
+				bounds:   bounds,
+				delayNum: binary.BigEndian.Uint16(d[20:]),
+				delayDen: binary.BigEndian.Uint16(d[22:]),
+				dispose:  d[24],
+				blend:    d[25],
+			}
+		case "IDAT":
+			// default image is not part of animation without fcTL before it
+			if frame != nil {
+				frameData = append(frameData, chunk.data...)
+			}
+		case "fdAT":
+			if frame == nil || len(chunk.data) < 4 {
+				return nil, ErrInvalidAPNG
+			}
+			frameData = append(frameData, chunk.data[4:]...)
+		case "IEND":
+		default:
+			// palette, transparency and color space are shared by frames
+			if frameData == nil && len(a.frames) == 0 {
+				header = append(header, chunk)
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	if len(a.frames) == 0 {
+		return nil, ErrInvalidAPNG
+	}
+
+	return a, nil
+}
+
+// Get frame rectangle of fcTL data
+// frame must not be empty and must be inside canvas, so it is not larger than checked image size
+func frameBounds(d []byte, width int, height int) (image.Rectangle, bool) {
+	w, h := uint64(binary.BigEndian.Uint32(d[4:])), uint64(binary.BigEndian.Uint32(d[8:]))
+	x, y := uint64(binary.BigEndian.Uint32(d[12:])), uint64(binary.BigEndian.Uint32(d[16:]))
+	if w == 0 || h == 0 || x+w > uint64(width) || y+h > uint64(height) {
+		return image.Rectangle{}, false
+	}
+
+	return image.Rect(int(x), int(y), int(x+w), int(y+h)), true
+}
+
+// Decode frame data as PNG of frame size
+func decodeFrame(header []pngChunk, bounds image.Rectangle, data []byte) (image.Image, error) {
+	var buf bytes.Buffer
+	buf.WriteString(pngSignature)
+	for _, chunk := range header {
+		if chunk.name == "IHDR" {
+			ihdr := append([]byte(nil), chunk.data...)
+			binary.BigEndian.PutUint32(ihdr[0:], uint32(bounds.Dx()))
+			binary.BigEndian.PutUint32(ihdr[4:], uint32(bounds.Dy()))
+			chunk = pngChunk{name: "IHDR", data: ihdr}
+		}
+		writeChunk(&buf, chunk.name, chunk.data)
+	}
+	writeChunk(&buf, "IDAT", data)
+	writeChunk(&buf, "IEND", nil)
+
+	return png.Decode(&buf)
+}
+
+// Encode APNG of same size RGBA frames
+func encodeAPNG(w io.Writer, a *apng, level png.CompressionLevel) error {
+	var buf bytes.Buffer
+	buf.WriteString(pngSignature)
+
+	ihdr := make([]byte, 13)
+	binary.BigEndian.PutUint32(ihdr[0:], uint32(a.width))
+	binary.BigEndian.PutUint32(ihdr[4:], uint32(a.height))
+	ihdr[8], ihdr[9] = 8, 6 // 8 bit RGBA
+	writeChunk(&buf, "IHDR", ihdr)
+
+	actl := make([]byte, 8)
+	binary.BigEndian.PutUint32(actl[0:], uint32(len(a.frames)))
+	binary.BigEndian.PutUint32(actl[4:], a.plays)
+	writeChunk(&buf, "acTL", actl)
+
+	var sequence uint32
+	for i, frame := range a.frames {
+		fctl := make([]byte, 26)
+		binary.BigEndian.PutUint32(fctl[0:], sequence)
+		binary.BigEndian.PutUint32(fctl[4:], uint32(a.width))
+		binary.BigEndian.PutUint32(fctl[8:], uint32(a.height))
+		binary.BigEndian.PutUint16(fctl[20:], frame.delayNum)
+		binary.BigEndian.PutUint16(fctl[22:], frame.delayDen)
+		fctl[24], fctl[25] = frame.dispose, frame.blend
+		writeChunk(&buf, "fcTL", fctl)
+		sequence++
+
+		data, err := compressFrame(frame.image, level)
+		if err != nil {
+			return err
+		}
+		if i == 0 {
+			writeChunk(&buf, "IDAT", data)
+			continue
+		}
+		fdat := make([]byte, 4, 4+len(data))
+		binary.BigEndian.PutUint32(fdat, sequence)
+		writeChunk(&buf, "fdAT", append(fdat, data...))
+		sequence++
+	}
+	writeChunk(&buf, "IEND", nil)
+
+	_, err := buf.WriteTo(w)
+
+	return err
+}
+
+// Compress RGBA rows of image
+// each row uses filter of smallest sum like standard PNG encoder
+func compressFrame(img image.Image, level png.CompressionLevel) ([]byte, error) {
+	nrgba := image.NewNRGBA(image.Rect(0, 0, img.Bounds().Dx(), img.Bounds().Dy()))
+	draw.Draw(nrgba, nrgba.Bounds(), img, img.Bounds().Min, draw.Src)
+
+	var buf bytes.Buffer
+	z, err := zlib.NewWriterLevel(&buf, zlibLevel(level))
+	if err != nil {
+		return nil, err
+	}
+	rowSize := nrgba.Bounds().Dx() * 4
+	previous := make([]byte, rowSize)
+	for y := 0; y < nrgba.Bounds().Dy(); y++ {
+		row := nrgba.Pix[y*nrgba.Stride : y*nrgba.Stride+rowSize]
+		if _, err := z.Write(filterRow(row, previous)); err != nil {
+			return nil, err
+		}
+		previous = row
+	}
+	if err := z.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Filter row by none, sub, up or paeth with smallest sum of absolute values
+// first byte of result is filter type
+func filterRow(row []byte, previous []byte) []byte {
+	const bpp = 4
+	var best []byte
+	bestSum := -1
+	for filter := byte(0); filter < 5; filter++ {
+		out := make([]byte, len(row)+1)
+		out[0] = filter
+		sum := 0
+		for i := range row {
+			var a, b, c byte
+			if i >= bpp {
+				a, c = row[i-bpp], previous[i-bpp]
+			}
+			b = previous[i]
+			var predicted byte
+			switch filter {
+			case 1:
+				predicted = a
+			case 2:
+				predicted = b
+			case 3:
+				predicted = byte((int(a) + int(b)) / 2)
+			case 4:
+				predicted = paeth(a, b, c)
+			}
+			v := row[i] - predicted
+			out[i+1] = v
+			if v < 128 {
+				sum += int(v)
+			} else {
+				sum += 256 - int(v)
+			}
+		}
+		if bestSum < 0 || sum < bestSum {
+			best, bestSum = out, sum
+		}
+	}
+
+	return best
+}
+
+// Paeth predictor of PNG
+func paeth(a, b, c byte) byte {
+	p := int(a) + int(b) - int(c)
+	pa, pb, pc := p-int(a), p-int(b), p-int(c)
+	if pa < 0 {
+		pa = -pa
+	}
+	if pb < 0 {
+		pb = -pb
+	}
+	if pc < 0 {
+		pc = -pc
+	}
+	switch {
+	case pa <= pb && pa <= pc:
+		return a
+	case pb <= pc:
+		return b
+	}
+
+	return c
+}
+
+// Get zlib level of PNG compression level
+func zlibLevel(level png.CompressionLevel) int {
+	switch level {
+	case png.NoCompression:
+		return zlib.NoCompression
+	case png.BestSpeed:
+		return zlib.BestSpeed
+	case png.BestCompression:
+		return zlib.BestCompression
+	}
+
+	return zlib.DefaultCompression
+}
+
+// Write chunk with length and CRC
+func writeChunk(w io.Writer, name string, data []byte) {
+	var header [8]byte
+	binary.BigEndian.PutUint32(header[0:], uint32(len(data)))
+	copy(header[4:], name)
+	crc := crc32.NewIEEE()
+	crc.Write(header[4:])
+	crc.Write(data)
+
+	w.Write(header[:])
+	w.Write(data)
+	binary.Write(w, binary.BigEndian, crc.Sum32())
+}
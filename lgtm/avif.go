@@ -0,0 +1,17 @@
+//go:build avif
+
+package lgtm
+
+import (
+	"github.com/gen2brain/avif"
+	"image"
+	"io"
+)
+
+// register AVIF encoder
+// importing avif also registers AVIF decoder for input
+func init() {
+	encodeAVIF = func(w io.Writer, img image.Image, quality int) error {
+		return avif.Encode(w, img, avif.Options{Quality: quality, Speed: 8})
+	}
+}
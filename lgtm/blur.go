@@ -0,0 +1,45 @@
+package lgtm
+
+import (
+	"github.com/disintegration/imaging"
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+)
+
+// Blur image behind mask at point by Gaussian of radius
+// blurred region fades out over radius around mask bounds, so it has no hard edges
+func blurBehind(img *image.NRGBA, mask image.Point, p image.Point, radius float64) *image.NRGBA {
+	inner := image.Rectangle{Min: p, Max: p.Add(mask)}
+	feather := int(math.Ceil(radius))
+	region := inner.Inset(-feather).Intersect(img.Bounds())
+	if region.Empty() {
+		return img
+	}
+
+	// blur samples pixels around region
+	margin := int(math.Ceil(radius * 3))
+	source := region.Inset(-margin).Intersect(img.Bounds())
+	blurred := imaging.Blur(img.SubImage(source), radius)
+
+	weights := falloff(inner, feather, region)
+	draw.DrawMask(img, region, blurred, region.Min.Sub(source.Min), weights, region.Min, draw.Over)
+
+	return img
+}
+
+// Get weights of region which are full in rectangle and fall off linearly over feather around it
+func falloff(rect image.Rectangle, feather int, region image.Rectangle) *image.Alpha {
+	weights := image.NewAlpha(region)
+	for y := region.Min.Y; y < region.Max.Y; y++ {
+		for x := region.Min.X; x < region.Max.X; x++ {
+			dx := max(rect.Min.X-x, x-rect.Max.X+1, 0)
+			dy := max(rect.Min.Y-y, y-rect.Max.Y+1, 0)
+			weight := 1 - math.Hypot(float64(dx), float64(dy))/float64(feather+1)
+			weights.SetAlpha(x, y, color.Alpha{A: uint8(math.Max(weight, 0) * 0xff)})
+		}
+	}
+
+	return weights
+}
@@ -0,0 +1,98 @@
+package lgtm
+
+import (
+	"fmt"
+	"github.com/neko-neko/lgtmgen/text_image"
+	"image"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Border is frame drawn inside edges of composited image
+type Border struct {
+	Width int
+	Color color.Color
+}
+
+// Parse border of width and color
+// e.g.
+// "8:white"   => 8px white border
+// "4:#ffcc00" => 4px yellow border
+func ParseBorder(s string) (Border, error) {
+	values := strings.SplitN(strings.TrimSpace(s), ":", 2)
+	if len(values) != 2 {
+		return Border{}, fmt.Errorf("invalid border %s", s)
+	}
+	width, err := strconv.Atoi(strings.TrimSpace(values[0]))
+	if err != nil || width < 0 {
+		return Border{}, fmt.Errorf("invalid border %s", s)
+	}
+	c, err := text_image.ParseColor(values[1])
+	if err != nil {
+		return Border{}, err
+	}
+
+	return Border{Width: width, Color: c}, nil
+}
+
+// Round corners and draw border of composited image
+// corners are made transparent, border follows rounded edges
+func (o *options) finish(img *image.NRGBA) *image.NRGBA {
+	if o.radius <= 0 && o.border.Width <= 0 {
+		return img
+	}
+
+	bounds := img.Bounds()
+	width, height := float64(bounds.Dx()), float64(bounds.Dy())
+	radius := math.Min(float64(o.radius), math.Min(width, height)/2)
+
+	var border color.NRGBA
+	if o.border.Width > 0 {
+		border = color.NRGBAModel.Convert(o.border.Color).(color.NRGBA)
+	}
+
+	dst := image.NewNRGBA(bounds)
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < bounds.Dx(); x++ {
+			c := img.NRGBAAt(bounds.Min.X+x, bounds.Min.Y+y)
+			d := edgeDistance(float64(x)+0.5, float64(y)+0.5, width, height, radius)
+
+			if o.border.Width > 0 {
+				c = blend(c, border, clamp(float64(o.border.Width)-d+0.5))
+			}
+			c.A = uint8(float64(c.A) * clamp(d+0.5))
+
+			dst.SetNRGBA(bounds.Min.X+x, bounds.Min.Y+y, c)
+		}
+	}
+
+	return dst
+}
+
+// Distance of point from edge of rounded rectangle
+// it is negative outside of rectangle
+func edgeDistance(x, y, width, height, radius float64) float64 {
+	dx := math.Max(radius-x, x-(width-radius))
+	dy := math.Max(radius-y, y-(height-radius))
+	if dx > 0 && dy > 0 {
+		return radius - math.Hypot(dx, dy)
+	}
+
+	return math.Min(math.Min(x, width-x), math.Min(y, height-y))
+}
+
+// Blend color over base by ratio
+func blend(base color.NRGBA, over color.NRGBA, ratio float64) color.NRGBA {
+	ratio *= float64(over.A) / 0xff
+	mix := func(a, b uint8) uint8 {
+		return uint8(float64(a)*(1-ratio) + float64(b)*ratio + 0.5)
+	}
+
+	return color.NRGBA{R: mix(base.R, over.R), G: mix(base.G, over.G), B: mix(base.B, over.B), A: mix(base.A, 0xff)}
+}
+
+func clamp(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
@@ -0,0 +1,125 @@
+package lgtm
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+const (
+	// contrastThreshold is minimum luminance difference of mask and background
+	contrastThreshold = 0.4
+
+	// busyRange is luminance distance from middle gray where background is neither light nor dark
+	busyRange = 0.15
+
+	// outlineRatio is outline width to mask width
+	outlineRatio = 0.006
+)
+
+// Adjust mask to be readable on background under it
+// mask colors are inverted when mask and background are both light or both dark,
+// and outline of opposite color is added when contrast is still low
+// returned point is offset of adjusted mask to given mask
+func contrastMask(background image.Image, mask image.Image, at image.Point) (image.Image, image.Point) {
+	backgroundLuminance, maskLuminance, ok := luminanceUnder(background, mask, at)
+	if !ok {
+		return mask, image.Point{}
+	}
+
+	// light mask on dark background, dark mask on light background
+	if (maskLuminance >= 0.5) != (backgroundLuminance < 0.5) {
+		mask = invert(mask)
+		maskLuminance = 1 - maskLuminance
+	}
+	if abs(backgroundLuminance-maskLuminance) >= contrastThreshold && abs(backgroundLuminance-0.5) >= busyRange {
+		return mask, image.Point{}
+	}
+
+	outlineColor := color.Color(color.Black)
+	if maskLuminance < 0.5 {
+		outlineColor = color.White
+	}
+	radius := int(float64(mask.Bounds().Dx())*outlineRatio + 0.5)
+	if radius < 1 {
+		radius = 1
+	}
+
+	return outline(mask, outlineColor, radius), image.Pt(-radius, -radius)
+}
+
+// Average luminance of background and mask weighted by mask alpha
+// mask is placed at point of background
+func luminanceUnder(background image.Image, mask image.Image, at image.Point) (float64, float64, bool) {
+	var backgroundSum, maskSum, weight float64
+	bounds := mask.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBA64Model.Convert(mask.At(x, y)).(color.NRGBA64)
+			if c.A == 0 {
+				continue
+			}
+			p := at.Add(image.Pt(x-bounds.Min.X, y-bounds.Min.Y))
+			if !p.In(background.Bounds()) {
+				continue
+			}
+			a := float64(c.A) / 0xffff
+			backgroundSum += luminance(background.At(p.X, p.Y)) * a
+			maskSum += luminance(c) * a
+			weight += a
+		}
+	}
+	if weight == 0 {
+		return 0, 0, false
+	}
+
+	return backgroundSum / weight, maskSum / weight, true
+}
+
+// Relative luminance of color in 0-1
+func luminance(c color.Color) float64 {
+	n := color.NRGBA64Model.Convert(c).(color.NRGBA64)
+	return (0.2126*float64(n.R) + 0.7152*float64(n.G) + 0.0722*float64(n.B)) / 0xffff
+}
+
+// Invert colors of image keeping alpha
+func invert(img image.Image) *image.NRGBA {
+	bounds := img.Bounds()
+	dst := image.NewNRGBA(bounds)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			dst.SetNRGBA(x, y, color.NRGBA{R: 0xff - c.R, G: 0xff - c.G, B: 0xff - c.B, A: c.A})
+		}
+	}
+
+	return dst
+}
+
+// Draw outline of radius pixels under image
+// image is padded by radius to keep outline
+func outline(img image.Image, c color.Color, radius int) *image.NRGBA {
+	bounds := img.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, bounds.Dx()+radius*2, bounds.Dy()+radius*2))
+	fill := image.NewUniform(c)
+	for dy := -radius; dy <= radius; dy++ {
+		for dx := -radius; dx <= radius; dx++ {
+			if dx*dx+dy*dy > radius*radius {
+				continue
+			}
+			r := dst.Bounds().Add(image.Pt(dx, dy))
+			draw.DrawMask(dst, r, fill, image.Point{}, img, bounds.Min.Sub(image.Pt(radius, radius)), draw.Over)
+		}
+	}
+	draw.Draw(dst, dst.Bounds(), img, bounds.Min.Sub(image.Pt(radius, radius)), draw.Over)
+
+	return dst
+}
+
+func abs(v float64) float64 {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
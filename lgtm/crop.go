@@ -0,0 +1,57 @@
+package lgtm
+
+import (
+	"fmt"
+	"image"
+	"strconv"
+	"strings"
+)
+
+// namedAspectRatios are width to height ratio of named crops
+var namedAspectRatios = map[string]float64{
+	"square": 1,
+}
+
+// Parse aspect ratio
+// e.g.
+// "16:9"   => 1.777...
+// "square" => 1
+func ParseAspectRatio(s string) (float64, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if ratio, ok := namedAspectRatios[s]; ok {
+		return ratio, nil
+	}
+
+	values := strings.Split(s, ":")
+	if len(values) != 2 {
+		return 0, fmt.Errorf("invalid aspect ratio %s", s)
+	}
+	width, err := strconv.ParseFloat(strings.TrimSpace(values[0]), 64)
+	if err != nil || width <= 0 {
+		return 0, fmt.Errorf("invalid aspect ratio %s", s)
+	}
+	height, err := strconv.ParseFloat(strings.TrimSpace(values[1]), 64)
+	if err != nil || height <= 0 {
+		return 0, fmt.Errorf("invalid aspect ratio %s", s)
+	}
+
+	return width / height, nil
+}
+
+// Get centered rectangle of aspect ratio in bounds
+func cropRect(bounds image.Rectangle, ratio float64) image.Rectangle {
+	size := bounds.Size()
+	width, height := size.X, size.Y
+	if float64(width) > float64(height)*ratio {
+		width = int(float64(height)*ratio + 0.5)
+	} else {
+		height = int(float64(width)/ratio + 0.5)
+	}
+	if width < 1 || height < 1 {
+		return bounds
+	}
+
+	min := bounds.Min.Add(image.Pt((size.X-width)/2, (size.Y-height)/2))
+
+	return image.Rectangle{Min: min, Max: min.Add(image.Pt(width, height))}
+}
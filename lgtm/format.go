@@ -0,0 +1,110 @@
+package lgtm
+
+import (
+	"bytes"
+	"errors"
+	"github.com/disintegration/imaging"
+	"image"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// AVIF is AVIF image format
+// encoder is available when built with avif tag
+const AVIF imaging.Format = 100
+
+// ErrAVIFNotSupported is returned when AVIF encoder is not built in
+var ErrAVIFNotSupported = errors.New("avif is not supported in this build(build with -tags avif)")
+
+// encodeAVIF is registered by avif build
+var encodeAVIF func(w io.Writer, img image.Image, quality int) error
+
+// Get extensions of output formats
+func FormatNames() []string {
+	return []string{"jpg", "png", "gif", "tif", "bmp", "avif", "svg"}
+}
+
+// Get image format from extension
+// e.g.
+// ".jpg" => imaging.JPEG
+// "avif" => AVIF
+// "svg"  => SVG
+func FormatFromExtension(ext string) (imaging.Format, error) {
+	switch strings.ToLower(strings.TrimPrefix(ext, ".")) {
+	case "avif":
+		return AVIF, nil
+	case "svg":
+		return SVG, nil
+	}
+
+	return imaging.FormatFromExtension(ext)
+}
+
+// Get image format from file name
+func FormatFromFilename(filename string) (imaging.Format, error) {
+	return FormatFromExtension(filepath.Ext(filename))
+}
+
+// Detect image format from image header
+func DetectFormat(data []byte) (imaging.Format, error) {
+	_, name, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return 0, err
+	}
+
+	return FormatFromExtension(name)
+}
+
+// Get MIME type of image format
+// e.g.
+// imaging.JPEG => "image/jpeg"
+// AVIF         => "image/avif"
+// SVG          => "image/svg+xml"
+func ContentType(format imaging.Format) string {
+	switch format {
+	case AVIF:
+		return "image/avif"
+	case SVG:
+		return "image/svg+xml"
+	}
+
+	return "image/" + strings.ToLower(format.String())
+}
+
+// Formats which can be read but not written
+// outputs are saved as JPEG
+var decodeOnlyExtensions = map[string]bool{
+	".heic": true,
+	".heif": true,
+}
+
+// Get output file name of input file
+// extension is replaced with format if it is given
+// e.g.
+// "cat.png", ""     => "cat.png"
+// "IMG.HEIC", ""    => "IMG.jpg"
+// "scan.tif", "png" => "scan.png"
+func OutputFilename(filename string, format string) string {
+	ext := filepath.Ext(filename)
+	switch {
+	case format != "":
+		return strings.TrimSuffix(filename, ext) + "." + strings.ToLower(format)
+	case decodeOnlyExtensions[strings.ToLower(ext)]:
+		return strings.TrimSuffix(filename, ext) + ".jpg"
+	}
+
+	return filename
+}
+
+// Encode image in format
+func (o *options) encode(w io.Writer, img image.Image, format imaging.Format) error {
+	if format != AVIF {
+		return imaging.Encode(w, img, format, o.encodeOptions()...)
+	}
+	if encodeAVIF == nil {
+		return ErrAVIFNotSupported
+	}
+
+	return encodeAVIF(w, img, o.quality)
+}
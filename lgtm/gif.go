@@ -0,0 +1,142 @@
+package lgtm
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"io"
+)
+
+// Overlay mask on every frame of animated GIF
+func OverlayGIF(src *gif.GIF, opts ...Option) (*gif.GIF, error) {
+	return overlayGIF(context.Background(), src, opts)
+}
+
+// OverlayGIF which gives up between frames when ctx is done
+func overlayGIF(ctx context.Context, src *gif.GIF, opts []Option) (*gif.GIF, error) {
+	o, err := newOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	dst := &gif.GIF{
+		Image:     make([]*image.Paletted, 0, len(src.Image)),
+		Delay:     make([]int, 0, len(src.Image)),
+		Disposal:  make([]byte, 0, len(src.Image)),
+		LoopCount: src.LoopCount,
+	}
+
+	// frames are drawn on canvas to get full image
+	canvas := image.NewRGBA(gifBounds(src))
+	for i, frame := range src.Image {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var disposal byte
+		if i < len(src.Disposal) {
+			disposal = src.Disposal[i]
+		}
+
+		// keep canvas to restore after this frame
+		var previous *image.RGBA
+		if disposal == gif.DisposalPrevious {
+			previous = image.NewRGBA(canvas.Bounds())
+			draw.Draw(previous, previous.Bounds(), canvas, canvas.Bounds().Min, draw.Src)
+		}
+
+		draw.Draw(canvas, frame.Bounds(), frame, frame.Bounds().Min, draw.Over)
+		maskedImage, err := o.overlay(canvas)
+		if err != nil {
+			return nil, err
+		}
+
+		// masked frame always covers whole image
+		palette := frame.Palette
+		if o.quantizer != nil {
+			palette = o.quantizer.Quantize(make(color.Palette, 0, 256), maskedImage)
+		}
+		dst.Image = append(dst.Image, toPaletted(maskedImage, palette, o.drawer()))
+		dst.Delay = append(dst.Delay, src.Delay[i])
+		dst.Disposal = append(dst.Disposal, gif.DisposalNone)
+
+		switch disposal {
+		case gif.DisposalBackground:
+			draw.Draw(canvas, frame.Bounds(), image.Transparent, image.Point{}, draw.Src)
+		case gif.DisposalPrevious:
+			canvas = previous
+		}
+	}
+
+	return dst, nil
+}
+
+// Overlay mask on GIF stream and write it as animated GIF
+func ProcessGIF(r io.Reader, w io.Writer, opts ...Option) error {
+	return processGIF(context.Background(), r, w, opts)
+}
+
+// ProcessGIF which gives up when ctx is done
+func processGIF(ctx context.Context, r io.Reader, w io.Writer, opts []Option) error {
+	src, err := gif.DecodeAll(r)
+	if err != nil {
+		return err
+	}
+
+	maskedGIF, err := overlayGIF(ctx, src, opts)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return gif.EncodeAll(w, maskedGIF)
+}
+
+// Get logical screen bounds of GIF
+func gifBounds(g *gif.GIF) image.Rectangle {
+	if g.Config.Width > 0 && g.Config.Height > 0 {
+		return image.Rect(0, 0, g.Config.Width, g.Config.Height)
+	}
+
+	var bounds image.Rectangle
+	for _, frame := range g.Image {
+		bounds = bounds.Union(frame.Bounds())
+	}
+
+	return bounds
+}
+
+// Convert image to paletted image based on source frame palette
+// colors are mapped by drawer, e.g. draw.FloydSteinberg
+func toPaletted(img image.Image, palette color.Palette, drawer draw.Drawer) *image.Paletted {
+	p := make(color.Palette, len(palette), 256)
+	copy(p, palette)
+
+	// mask colors may not be in source palette
+	for _, c := range []color.Color{color.White, color.Black} {
+		if len(p) < cap(p) && !hasColor(p, c) {
+			p = append(p, c)
+		}
+	}
+
+	dst := image.NewPaletted(img.Bounds(), p)
+	drawer.Draw(dst, dst.Bounds(), img, img.Bounds().Min)
+
+	return dst
+}
+
+// Palette has exactly same color
+func hasColor(p color.Palette, c color.Color) bool {
+	r, g, b, a := c.RGBA()
+	for _, pc := range p {
+		pr, pg, pb, pa := pc.RGBA()
+		if pr == r && pg == g && pb == b && pa == a {
+			return true
+		}
+	}
+
+	return false
+}
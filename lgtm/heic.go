@@ -0,0 +1,6 @@
+//go:build heic
+
+package lgtm
+
+// register HEIC/HEIF decoder for input
+import _ "github.com/gen2brain/heic"
@@ -0,0 +1,345 @@
+// Package lgtm generates LGTM images.
+// It is the core of lgtmgen command and can be embedded in other programs.
+package lgtm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/disintegration/imaging"
+	"github.com/neko-neko/lgtmgen/effect"
+	"github.com/neko-neko/lgtmgen/icc"
+	"github.com/neko-neko/lgtmgen/metadata"
+	"image"
+	"image/color"
+	"io"
+	"io/ioutil"
+)
+
+// Overlay mask on source image
+func Overlay(src image.Image, opts ...Option) (*image.NRGBA, error) {
+	o, err := newOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.overlay(src)
+}
+
+// Overlay mask with resolved options
+// source keeps its size unless crop or output size is given
+// rounded corners and border are drawn on final size
+// mask is repeated across source in tile mode
+func (o *options) overlay(src image.Image) (*image.NRGBA, error) {
+	src, err := effect.Apply(src, o.effects...)
+	if err != nil {
+		return nil, err
+	}
+	if o.cropRatio > 0 {
+		src = imaging.Crop(src, cropRect(src.Bounds(), o.cropRatio))
+	}
+	if o.width > 0 && o.height > 0 {
+		src = imaging.Resize(src, o.width, o.height, imaging.Box)
+	}
+
+	size := src.Bounds().Size()
+	mask := o.scaleMask(size)
+	points := []image.Point{o.position.Point(size, mask.Bounds().Size())}
+	if o.tile {
+		points = tilePoints(size, mask.Bounds().Size(), o.tileSpacing)
+	}
+
+	dst := imaging.Clone(src)
+	for _, p := range points {
+		dst = o.stamp(dst, mask, p)
+	}
+
+	return o.finish(o.fit(dst)), nil
+}
+
+// Draw mask at point of image with blur, scrim, contrast and shadow
+func (o *options) stamp(img *image.NRGBA, mask image.Image, p image.Point) *image.NRGBA {
+	if o.blurRadius > 0 {
+		img = blurBehind(img, mask.Bounds().Size(), p, o.blurRadius)
+	}
+	if o.scrim.Opacity > 0 {
+		img = o.scrim.draw(img, mask.Bounds().Size(), p)
+	}
+	if o.autoContrast {
+		var offset image.Point
+		mask, offset = contrastMask(img, mask, p)
+		p = p.Add(offset)
+	}
+	if o.maskShadow {
+		shadow, offset := dropShadow(mask)
+		img = imaging.Overlay(img, shadow, p.Add(offset), o.opacity)
+	}
+
+	return imaging.Overlay(img, mask, p, o.opacity)
+}
+
+// Downscale image to fit in maximum size
+func (o *options) fit(img *image.NRGBA) *image.NRGBA {
+	size := img.Bounds().Size()
+	width, height := size.X, size.Y
+	if o.maxWidth > 0 && o.maxWidth < width {
+		width = o.maxWidth
+	}
+	if o.maxHeight > 0 && o.maxHeight < height {
+		height = o.maxHeight
+	}
+	if width == size.X && height == size.Y {
+		return img
+	}
+
+	return imaging.Fit(img, width, height, imaging.Lanczos)
+}
+
+// Resize mask to scale of background width
+// mask keeps aspect ratio and fits in background height
+// vector mask is rasterized at scaled size to keep it sharp
+func (o *options) scaleMask(background image.Point) image.Image {
+	size := o.mask.Bounds().Size()
+	width := int(float64(background.X) * o.maskScale)
+	height := width * size.Y / size.X
+	if height > background.Y {
+		height = background.Y
+		width = height * size.X / size.Y
+	}
+	if width < 1 || height < 1 || (width == size.X && height == size.Y) {
+		return o.mask
+	}
+	if o.vector != nil {
+		return o.rasterizeMask(width, height)
+	}
+
+	return imaging.Resize(o.mask, width, height, imaging.Lanczos)
+}
+
+// Rasterize vector mask to width and height of trimmed mask
+// vector is drawn larger by same ratio, then rotated and trimmed like mask
+func (o *options) rasterizeMask(width int, height int) image.Image {
+	ratio := float64(width) / float64(o.mask.Bounds().Dx())
+	size := o.vector.Bounds().Size()
+	img := o.vector.Rasterize(int(float64(size.X)*ratio+0.5), int(float64(size.Y)*ratio+0.5))
+	if o.rotate != 0 {
+		img = imaging.Rotate(img, o.rotate, color.Transparent)
+	}
+	img = trimTransparent(img)
+
+	// absorb rounding of trimmed size
+	if b := img.Bounds().Size(); b.X != width || b.Y != height {
+		return imaging.Resize(img, width, height, imaging.Lanczos)
+	}
+
+	return img
+}
+
+// Crop transparent margin of image
+func trimTransparent(img image.Image) image.Image {
+	bounds := img.Bounds()
+	visible := image.Rectangle{}
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if _, _, _, a := img.At(x, y).RGBA(); a != 0 {
+				visible = visible.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+	if visible.Empty() || visible == bounds {
+		return img
+	}
+
+	return imaging.Crop(img, visible)
+}
+
+// Overlay mask on image file and save it
+// output format is detected from out file extension, or from input if out has no known extension
+// animated GIF keeps its animation when saved as GIF, video is processed by ProcessVideo
+func ProcessFile(in string, out string, opts ...Option) error {
+	return ProcessFileContext(context.Background(), in, out, opts...)
+}
+
+// ProcessFile which gives up when ctx is done
+// out is never written after ctx is done
+func ProcessFileContext(ctx context.Context, in string, out string, opts ...Option) error {
+	if IsVideo(in) {
+		return ProcessVideo(ctx, in, out, opts...)
+	}
+
+	input, err := ioutil.ReadFile(in)
+	if err != nil {
+		return err
+	}
+
+	format, err := FormatFromFilename(out)
+	if err != nil {
+		if format, err = DetectFormat(input); err != nil {
+			return err
+		}
+	}
+
+	var output bytes.Buffer
+	if err := ProcessContext(ctx, bytes.NewReader(input), &output, format, opts...); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(out, output.Bytes(), 0644)
+}
+
+// Process which gives up when ctx is done
+// ctx is checked between decoding, overlay and encoding, and between frames of animation,
+// so it returns after running step is finished. w is written only if ctx is not done
+func ProcessContext(ctx context.Context, r io.Reader, w io.Writer, format imaging.Format, opts ...Option) error {
+	var output bytes.Buffer
+	if err := process(ctx, r, &output, format, opts...); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	_, err := w.Write(metadata.Mark(output.Bytes()))
+
+	return err
+}
+
+// Check pixels of source image by its header before decoding whole image
+// image of unknown format is left to decoder
+func (o *options) checkPixels(input []byte) error {
+	if o.maxPixels <= 0 {
+		return nil
+	}
+	config, _, err := image.DecodeConfig(bytes.NewReader(input))
+	if err != nil {
+		return nil
+	}
+
+	return o.checkSize(config.Width, config.Height)
+}
+
+// Check pixels of source of width and height, e.g. video frame
+func (o *options) checkSize(width int, height int) error {
+	if o.maxPixels <= 0 {
+		return nil
+	}
+	if pixels := int64(width) * int64(height); pixels > int64(o.maxPixels) {
+		return fmt.Errorf("%w: %dx%d exceeds %d pixels", ErrTooManyPixels, width, height, o.maxPixels)
+	}
+
+	return nil
+}
+
+// Overlay mask on image stream and write it in format
+// animated GIF and PNG keep their animation when format is same
+// SVG format embeds source image with text as vector, see WithText
+// PNG, JPEG and GIF outputs have marker of metadata, see metadata.IsMarked
+func Process(r io.Reader, w io.Writer, format imaging.Format, opts ...Option) error {
+	return ProcessContext(context.Background(), r, w, format, opts...)
+}
+
+// Process without marker which gives up when ctx is done
+func process(ctx context.Context, r io.Reader, w io.Writer, format imaging.Format, opts ...Option) error {
+	input, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	o, err := newOptions(opts)
+	if err != nil {
+		return err
+	}
+	if err := o.checkPixels(input); err != nil {
+		return err
+	}
+
+	if format == imaging.GIF && bytes.HasPrefix(input, []byte("GIF8")) {
+		return processGIF(ctx, bytes.NewReader(input), w, opts)
+	}
+	if format == imaging.PNG && isAPNG(input) {
+		return processAPNG(ctx, bytes.NewReader(input), w, opts)
+	}
+
+	srcImage, err := imaging.Decode(bytes.NewReader(input), imaging.AutoOrientation(o.autoOrient))
+	if err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// source of other profile than sRGB is composited in sRGB
+	profile := o.sourceProfile(input)
+	if profile != nil {
+		srcImage = profile.ToSRGB(srcImage)
+	}
+	if format == SVG {
+		return o.encodeSVG(w, srcImage)
+	}
+
+	maskedImage, err := o.overlay(srcImage)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	var dstImage image.Image = maskedImage
+
+	// reduce colors to source palette
+	if o.keepPalette && format == imaging.PNG {
+		config, _, err := image.DecodeConfig(bytes.NewReader(input))
+		if palette, ok := config.ColorModel.(color.Palette); err == nil && ok {
+			dstImage = toPaletted(dstImage, palette, o.drawer())
+		}
+	}
+
+	if profile == nil && (!o.keepMetadata || format != imaging.JPEG) {
+		return o.encode(w, dstImage, format)
+	}
+
+	var output bytes.Buffer
+	if err := o.encode(&output, dstImage, format); err != nil {
+		return err
+	}
+	data := output.Bytes()
+
+	// copy metadata of source JPEG
+	// profile of source is replaced by sRGB if pixels are converted
+	if o.keepMetadata && format == imaging.JPEG {
+		if meta, err := metadata.ReadJPEG(input); err == nil {
+			if o.autoOrient {
+				meta.ResetOrientation()
+			}
+			if profile != nil {
+				meta.RemoveICC()
+			}
+			if data, err = meta.WriteJPEG(data); err != nil {
+				return err
+			}
+		}
+	}
+	if profile != nil {
+		data = metadata.WriteICC(data, icc.SRGB())
+	}
+	_, err = w.Write(data)
+
+	return err
+}
+
+// Get ICC profile of source to convert it to sRGB
+// nil is returned for source of no profile, sRGB profile or unsupported profile
+func (o *options) sourceProfile(input []byte) *icc.Profile {
+	if !o.colorManagement {
+		return nil
+	}
+	data := metadata.ReadICC(input)
+	if data == nil {
+		return nil
+	}
+	profile, err := icc.Parse(data)
+	if err != nil || profile.IsSRGB() {
+		return nil
+	}
+
+	return profile
+}
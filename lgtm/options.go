@@ -0,0 +1,562 @@
+package lgtm
+
+import (
+	"errors"
+	"fmt"
+	"github.com/disintegration/imaging"
+	"github.com/neko-neko/lgtmgen/effect"
+	"github.com/neko-neko/lgtmgen/mask_image"
+	"github.com/neko-neko/lgtmgen/text_image"
+	"golang.org/x/image/font/opentype"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+)
+
+// DefaultMask is embedded mask image name
+const DefaultMask = "images/lgtm_mask.png"
+
+// DefaultMaskScale is mask width ratio to source image width
+const DefaultMaskScale = 0.6
+
+// DefaultQuality is JPEG encoding quality
+const DefaultQuality = 95
+
+// DefaultMaxPixels is max pixels of source image, e.g. 10000x10000
+// decoded NRGBA source takes 4 bytes per pixel and copies of it are made while compositing
+const DefaultMaxPixels = 100 * 1000 * 1000
+
+var (
+	// ErrInvalidMaskScale is returned when mask scale is out of range
+	ErrInvalidMaskScale = errors.New("mask scale must be greater than 0 and at most 1")
+
+	// ErrInvalidOpacity is returned when opacity is out of range
+	ErrInvalidOpacity = errors.New("opacity must be between 0 and 1")
+
+	// ErrInvalidQuality is returned when JPEG quality is out of range
+	ErrInvalidQuality = errors.New("quality must be between 1 and 100")
+
+	// ErrInvalidMaxSize is returned when maximum output size is negative
+	ErrInvalidMaxSize = errors.New("max width and height must not be negative")
+
+	// ErrInvalidPNGCompression is returned when PNG compression level is unknown
+	ErrInvalidPNGCompression = errors.New("png compression must be one of default, none, fast, best")
+
+	// ErrInvalidRadius is returned when corner radius is negative
+	ErrInvalidRadius = errors.New("corner radius must not be negative")
+
+	// ErrInvalidBlurRadius is returned when blur radius behind mask is negative
+	ErrInvalidBlurRadius = errors.New("blur radius must not be negative")
+
+	// ErrInvalidScrim is returned when scrim opacity is out of range
+	ErrInvalidScrim = errors.New("scrim must be between 0 and 1")
+
+	// ErrInvalidMaxPixels is returned when max pixels is negative
+	ErrInvalidMaxPixels = errors.New("max pixels must not be negative")
+
+	// ErrTooManyPixels is returned when source image has more pixels than max pixels
+	ErrTooManyPixels = errors.New("image has too many pixels")
+)
+
+// Rasterizer is mask which can be drawn at any size, such as SVG
+// it is used as image at its own size until it is scaled
+type Rasterizer interface {
+	image.Image
+	Rasterize(width int, height int) image.Image
+}
+
+type options struct {
+	mask      image.Image
+	maskScale float64
+	position  Position
+	opacity   float64
+
+	// tile repeats mask across source with spacing to mask size
+	tile        bool
+	tileSpacing float64
+
+	// vector is drawn at scaled size instead of resizing mask
+	vector Rasterizer
+
+	// rotate is mask angle in degrees counter-clockwise
+	rotate float64
+
+	// autoContrast inverts or outlines mask to be readable on source
+	autoContrast bool
+
+	// maskShadow draws blurred shadow behind mask
+	maskShadow bool
+
+	// blurRadius blurs source behind mask, 0 is off
+	blurRadius float64
+
+	// scrim is translucent layer behind mask
+	scrim Scrim
+
+	// autoOrient rotates source image by EXIF orientation on decoding
+	autoOrient bool
+
+	// keepMetadata copies EXIF, XMP and ICC profile of JPEG
+	keepMetadata bool
+
+	// colorManagement converts source of ICC profile to sRGB before compositing
+	colorManagement bool
+
+	// quality is JPEG encoding quality
+	quality int
+
+	// pngCompression is PNG compression level
+	pngCompression png.CompressionLevel
+
+	// keepPalette writes paletted PNG when source is paletted
+	keepPalette bool
+
+	// quantizer builds palette of GIF output, nil keeps default palette
+	quantizer draw.Quantizer
+
+	// dither maps colors to palette with Floyd-Steinberg error diffusion
+	dither bool
+
+	// effects are applied to source before overlay
+	effects []effect.Processor
+
+	// cropRatio is aspect ratio of center crop, 0 keeps source
+	cropRatio float64
+
+	// maxWidth and maxHeight bound output size, 0 is unlimited
+	maxWidth  int
+	maxHeight int
+
+	// maxPixels rejects larger source before decoding it, 0 is unlimited
+	maxPixels int
+
+	// radius rounds corners of output, border is drawn along edges
+	radius int
+	border Border
+
+	width  int
+	height int
+	text   string
+
+	// svgText is text of SVG output when mask is rendered by caller
+	svgText string
+
+	// textBox is visible size of rendered text before rotation
+	textBox image.Point
+
+	textStyle text_image.Style
+	font      *opentype.Font
+	emoji     text_image.EmojiSource
+}
+
+// Option configures image generation
+type Option func(*options) error
+
+// Use mask image
+func WithMask(mask image.Image) Option {
+	return func(o *options) error {
+		o.mask = mask
+		return nil
+	}
+}
+
+// Use mask image of file path or embedded asset name
+func WithMaskFile(name string) Option {
+	return func(o *options) error {
+		mask := mask_image.NewMaskImage()
+		if err := mask.LoadMaskImage(name); err != nil {
+			return err
+		}
+		o.mask = mask.MaskImage
+		return nil
+	}
+}
+
+// Render text instead of mask image
+func WithText(text string) Option {
+	return func(o *options) error {
+		o.text = text
+		return nil
+	}
+}
+
+// Write text as vector in SVG output without rendering it as mask
+// for callers which render text mask themselves, WithText is enough otherwise
+func WithSVGText(text string) Option {
+	return func(o *options) error {
+		o.svgText = text
+		return nil
+	}
+}
+
+// Decorate rendered text with color, stroke and shadow
+func WithTextStyle(style text_image.Style) Option {
+	return func(o *options) error {
+		o.textStyle = style
+		return nil
+	}
+}
+
+// Render text in TTF/OTF font file instead of embedded font
+func WithFont(path string) Option {
+	return func(o *options) error {
+		f, err := text_image.LoadFont(path)
+		if err != nil {
+			return err
+		}
+		o.font = f
+		return nil
+	}
+}
+
+// Composite emoji in text from source
+func WithEmoji(source text_image.EmojiSource) Option {
+	return func(o *options) error {
+		o.emoji = source
+		return nil
+	}
+}
+
+// Resize mask to scale of source image width
+// mask is fitted in source image height if it overflows
+func WithMaskScale(scale float64) Option {
+	return func(o *options) error {
+		if scale <= 0 || scale > 1 {
+			return ErrInvalidMaskScale
+		}
+		o.maskScale = scale
+		return nil
+	}
+}
+
+// Place mask at position
+// mask is placed on center by default
+func WithPosition(position Position) Option {
+	return func(o *options) error {
+		o.position = position
+		return nil
+	}
+}
+
+// Set mask opacity
+// mask is opaque by default
+func WithOpacity(opacity float64) Option {
+	return func(o *options) error {
+		if opacity < 0 || opacity > 1 {
+			return ErrInvalidOpacity
+		}
+		o.opacity = opacity
+		return nil
+	}
+}
+
+// Repeat mask across source image instead of placing it once
+// spacing is gap between masks to mask size
+func WithTile(spacing float64) Option {
+	return func(o *options) error {
+		if spacing < 0 {
+			return ErrInvalidTileSpacing
+		}
+		o.tile = true
+		o.tileSpacing = spacing
+		return nil
+	}
+}
+
+// Rotate mask counter-clockwise by degrees
+// negative degrees rotate clockwise
+func WithRotate(degrees float64) Option {
+	return func(o *options) error {
+		o.rotate = degrees
+		return nil
+	}
+}
+
+// Pick light or dark variant of mask by luminance of source under it
+// outline is added when source is neither light nor dark
+func WithAutoContrast(enabled bool) Option {
+	return func(o *options) error {
+		o.autoContrast = enabled
+		return nil
+	}
+}
+
+// Draw soft blurred shadow behind mask
+func WithMaskShadow(enabled bool) Option {
+	return func(o *options) error {
+		o.maskShadow = enabled
+		return nil
+	}
+}
+
+// Blur source behind mask by Gaussian of radius in pixels
+// 0 disables it
+func WithBlurBehind(radius float64) Option {
+	return func(o *options) error {
+		if radius < 0 {
+			return ErrInvalidBlurRadius
+		}
+		o.blurRadius = radius
+		return nil
+	}
+}
+
+// Draw translucent layer behind mask, e.g. 40% black box
+func WithScrim(scrim Scrim) Option {
+	return func(o *options) error {
+		if scrim.Opacity < 0 || scrim.Opacity > 1 {
+			return ErrInvalidScrim
+		}
+		if scrim.Color == nil {
+			scrim.Color = color.Black
+		}
+		o.scrim = scrim
+		return nil
+	}
+}
+
+// Rotate and flip source image by EXIF orientation on decoding
+// enabled by default
+func WithAutoOrient(enabled bool) Option {
+	return func(o *options) error {
+		o.autoOrient = enabled
+		return nil
+	}
+}
+
+// Copy EXIF, XMP and ICC profile from source to output
+// supported for JPEG to JPEG
+func WithKeepMetadata(enabled bool) Option {
+	return func(o *options) error {
+		o.keepMetadata = enabled
+		return nil
+	}
+}
+
+// Convert source of wide gamut ICC profile, e.g. Display P3, Adobe RGB, to sRGB before compositing
+// converted JPEG and PNG outputs have sRGB profile
+func WithColorManagement(enabled bool) Option {
+	return func(o *options) error {
+		o.colorManagement = enabled
+		return nil
+	}
+}
+
+// Set JPEG encoding quality(1-100)
+func WithQuality(quality int) Option {
+	return func(o *options) error {
+		if quality < 1 || quality > 100 {
+			return ErrInvalidQuality
+		}
+		o.quality = quality
+		return nil
+	}
+}
+
+// Set PNG compression level
+func WithPNGCompression(level png.CompressionLevel) Option {
+	return func(o *options) error {
+		o.pngCompression = level
+		return nil
+	}
+}
+
+// Write paletted PNG when source image is paletted
+// colors are reduced to source palette
+func WithKeepPalette(enabled bool) Option {
+	return func(o *options) error {
+		o.keepPalette = enabled
+		return nil
+	}
+}
+
+// Build palette of GIF output by quantizer
+// nil keeps palette of animated GIF and uses plan9 for others
+func WithQuantizer(quantizer draw.Quantizer) Option {
+	return func(o *options) error {
+		o.quantizer = quantizer
+		return nil
+	}
+}
+
+// Dither GIF and paletted PNG outputs by Floyd-Steinberg
+// colors are mapped to nearest palette color if disabled
+func WithDither(enabled bool) Option {
+	return func(o *options) error {
+		o.dither = enabled
+		return nil
+	}
+}
+
+// Parse PNG compression level name
+// e.g.
+// "none" => png.NoCompression
+// "best" => png.BestCompression
+func ParsePNGCompression(name string) (png.CompressionLevel, error) {
+	switch name {
+	case "", "default":
+		return png.DefaultCompression, nil
+	case "none":
+		return png.NoCompression, nil
+	case "fast":
+		return png.BestSpeed, nil
+	case "best":
+		return png.BestCompression, nil
+	}
+
+	return png.DefaultCompression, ErrInvalidPNGCompression
+}
+
+// Apply effects to source image in order before overlay
+func WithEffects(effects ...effect.Processor) Option {
+	return func(o *options) error {
+		o.effects = append(o.effects, effects...)
+		return nil
+	}
+}
+
+// Center-crop source image to aspect ratio(width / height) before overlay
+// 0 keeps source aspect ratio
+func WithCrop(ratio float64) Option {
+	return func(o *options) error {
+		if ratio < 0 {
+			return fmt.Errorf("invalid aspect ratio %g", ratio)
+		}
+		o.cropRatio = ratio
+		return nil
+	}
+}
+
+// Downscale composited image to fit in maximum size
+// 0 means unlimited, image is never upscaled
+func WithMaxSize(width int, height int) Option {
+	return func(o *options) error {
+		if width < 0 || height < 0 {
+			return ErrInvalidMaxSize
+		}
+		o.maxWidth = width
+		o.maxHeight = height
+		return nil
+	}
+}
+
+// Reject source image of more pixels than max before decoding it
+// it guards memory against decompression bomb, 0 means unlimited
+func WithMaxPixels(pixels int) Option {
+	return func(o *options) error {
+		if pixels < 0 {
+			return ErrInvalidMaxPixels
+		}
+		o.maxPixels = pixels
+		return nil
+	}
+}
+
+// Round corners of composited image by radius pixels
+// corners are transparent, so use output format with alpha channel
+func WithRoundedCorners(radius int) Option {
+	return func(o *options) error {
+		if radius < 0 {
+			return ErrInvalidRadius
+		}
+		o.radius = radius
+		return nil
+	}
+}
+
+// Draw border inside edges of composited image
+func WithBorder(border Border) Option {
+	return func(o *options) error {
+		if border.Width < 0 {
+			return fmt.Errorf("invalid border width %d", border.Width)
+		}
+		o.border = border
+		return nil
+	}
+}
+
+// Resize source image to output size before overlay
+// source image size is kept by default
+func WithSize(width int, height int) Option {
+	return func(o *options) error {
+		o.width = width
+		o.height = height
+		return nil
+	}
+}
+
+// Apply options and fill defaults
+func newOptions(opts []Option) (*options, error) {
+	o := &options{
+		maskScale: DefaultMaskScale,
+		position:  Center,
+		opacity:   1.0,
+
+		autoOrient:      true,
+		colorManagement: true,
+		dither:          true,
+		quality:         DefaultQuality,
+		maxPixels:       DefaultMaxPixels,
+		textStyle:       text_image.DefaultStyle,
+	}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+
+	// load default mask
+	if o.mask == nil {
+		if err := WithMaskFile(DefaultMask)(o); err != nil {
+			return nil, err
+		}
+	}
+
+	// render text as mask
+	if o.text != "" {
+		size := o.mask.Bounds().Size()
+		textImage, err := text_image.NewTextImage(size.X, size.Y)
+		if err != nil {
+			return nil, err
+		}
+		textImage.Style = o.textStyle
+		if o.font != nil {
+			textImage.Font = o.font
+		}
+		textImage.Emoji = o.emoji
+		renderedImage, err := textImage.Render(o.text)
+		if err != nil {
+			return nil, err
+		}
+		o.mask = renderedImage
+	}
+	if o.text != "" || o.svgText != "" {
+		o.textBox = trimTransparent(o.mask).Bounds().Size()
+	}
+
+	// vector mask is drawn again at scaled size
+	if v, ok := o.mask.(Rasterizer); ok {
+		o.vector = v
+	}
+
+	// rotated corners are transparent
+	if o.rotate != 0 {
+		o.mask = imaging.Rotate(o.mask, o.rotate, color.Transparent)
+	}
+
+	// scale is relative to visible part of mask
+	o.mask = trimTransparent(o.mask)
+
+	return o, nil
+}
+
+// Options of image encoder
+func (o *options) encodeOptions() []imaging.EncodeOption {
+	return []imaging.EncodeOption{
+		imaging.JPEGQuality(o.quality),
+		imaging.PNGCompressionLevel(o.pngCompression),
+		imaging.GIFQuantizer(o.quantizer),
+		imaging.GIFDrawer(o.drawer()),
+	}
+}
@@ -0,0 +1,112 @@
+package lgtm
+
+import (
+	"fmt"
+	"image"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MarginRatio is margin of named corner positions to background size
+const MarginRatio = 0.03
+
+// Position is placement of mask on source image
+type Position struct {
+	// X and Y are ratio (0.0-1.0) or pixel offset
+	X, Y float64
+
+	// Pixel means X and Y are pixel offset of mask top-left corner
+	Pixel bool
+
+	// Margin keeps mask apart from edges
+	Margin bool
+}
+
+// Center is default position
+var Center = Position{X: 0.5, Y: 0.5}
+
+// namedPositions are ratio of named positions
+var namedPositions = map[string]Position{
+	"center":       Center,
+	"top":          {X: 0.5, Y: 0, Margin: true},
+	"bottom":       {X: 0.5, Y: 1, Margin: true},
+	"left":         {X: 0, Y: 0.5, Margin: true},
+	"right":        {X: 1, Y: 0.5, Margin: true},
+	"top-left":     {X: 0, Y: 0, Margin: true},
+	"top-right":    {X: 1, Y: 0, Margin: true},
+	"bottom-left":  {X: 0, Y: 1, Margin: true},
+	"bottom-right": {X: 1, Y: 1, Margin: true},
+}
+
+// Get names of named positions
+func PositionNames() []string {
+	var names []string
+	for name := range namedPositions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Parse position
+// e.g.
+// "bottom-right" => named position
+// "20,40"        => mask top-left corner at (20px, 40px)
+// "25%,75%"      => mask point at 25%,75% of mask is placed at 25%,75% of image
+func ParsePosition(s string) (Position, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if p, ok := namedPositions[s]; ok {
+		return p, nil
+	}
+
+	coordinates := strings.Split(s, ",")
+	if len(coordinates) != 2 {
+		return Position{}, fmt.Errorf("invalid position %s", s)
+	}
+
+	xValue, xPercent, err := parseCoordinate(coordinates[0])
+	if err != nil {
+		return Position{}, fmt.Errorf("invalid position %s", s)
+	}
+	yValue, yPercent, err := parseCoordinate(coordinates[1])
+	if err != nil || xPercent != yPercent {
+		return Position{}, fmt.Errorf("invalid position %s", s)
+	}
+
+	if xPercent {
+		return Position{X: xValue / 100, Y: yValue / 100}, nil
+	}
+
+	return Position{X: xValue, Y: yValue, Pixel: true}, nil
+}
+
+// Parse coordinate value with optional percent suffix
+func parseCoordinate(s string) (float64, bool, error) {
+	s = strings.TrimSpace(s)
+	percent := strings.HasSuffix(s, "%")
+	value, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+
+	return value, percent, err
+}
+
+// Calculate mask top-left corner on background
+func (p Position) Point(background image.Point, mask image.Point) image.Point {
+	if p.Pixel {
+		return image.Pt(int(p.X), int(p.Y))
+	}
+
+	// free space around mask
+	spaceX := background.X - mask.X
+	spaceY := background.Y - mask.Y
+	offsetX, offsetY := 0, 0
+	if p.Margin {
+		offsetX = int(float64(background.X) * MarginRatio)
+		offsetY = int(float64(background.Y) * MarginRatio)
+		spaceX -= offsetX * 2
+		spaceY -= offsetY * 2
+	}
+
+	return image.Pt(offsetX+int(float64(spaceX)*p.X), offsetY+int(float64(spaceY)*p.Y))
+}
@@ -0,0 +1,162 @@
+package lgtm
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/draw"
+	"sort"
+)
+
+// Quantizers of GIF output
+// plan9 and websafe are fixed palettes, median-cut builds palette from colors of each image
+const (
+	QuantizerMedianCut = "median-cut"
+	QuantizerPlan9     = "plan9"
+	QuantizerWebSafe   = "websafe"
+)
+
+// maxQuantizeSamples is number of pixels sampled to build palette
+// larger images are sampled at intervals
+const maxQuantizeSamples = 256 * 1024
+
+// ErrInvalidQuantizer is returned when quantizer name is unknown
+var ErrInvalidQuantizer = errors.New("quantizer must be one of median-cut, plan9, websafe")
+
+// Get quantizer names
+func QuantizerNames() []string {
+	return []string{QuantizerMedianCut, QuantizerPlan9, QuantizerWebSafe}
+}
+
+// Parse quantizer name
+// nil is returned for empty name, which keeps palette of animated GIF and uses plan9 for others
+func ParseQuantizer(name string) (draw.Quantizer, error) {
+	switch name {
+	case "":
+		return nil, nil
+	case QuantizerMedianCut:
+		return medianCut{}, nil
+	case QuantizerPlan9:
+		return fixedPalette(palette.Plan9), nil
+	case QuantizerWebSafe:
+		return fixedPalette(palette.WebSafe), nil
+	}
+
+	return nil, ErrInvalidQuantizer
+}
+
+// fixedPalette is quantizer which always returns same palette
+type fixedPalette color.Palette
+
+// Quantize implements draw.Quantizer
+func (f fixedPalette) Quantize(p color.Palette, m image.Image) color.Palette {
+	for _, c := range f {
+		if len(p) == cap(p) {
+			break
+		}
+		p = append(p, c)
+	}
+
+	return p
+}
+
+// medianCut is quantizer which splits box of colors at median of its widest channel
+// until palette is filled, colors of palette are averages of boxes
+type medianCut struct{}
+
+// Quantize implements draw.Quantizer
+// transparent pixels get their own color
+func (medianCut) Quantize(p color.Palette, m image.Image) color.Palette {
+	bounds := m.Bounds()
+	step := 1
+	for bounds.Dx()*bounds.Dy()/(step*step) > maxQuantizeSamples {
+		step++
+	}
+
+	var pixels [][3]uint8
+	transparent := false
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += step {
+		for x := bounds.Min.X; x < bounds.Max.X; x += step {
+			c := color.NRGBAModel.Convert(m.At(x, y)).(color.NRGBA)
+			if c.A < 0x80 {
+				transparent = true
+				continue
+			}
+			pixels = append(pixels, [3]uint8{c.R, c.G, c.B})
+		}
+	}
+
+	size := cap(p) - len(p)
+	if transparent && size > 0 {
+		p = append(p, color.Transparent)
+		size--
+	}
+	if len(pixels) == 0 || size <= 0 {
+		return p
+	}
+
+	boxes := [][][3]uint8{pixels}
+	for len(boxes) < size {
+		// split box of most pixels which has different colors
+		index, channel := -1, 0
+		for i, box := range boxes {
+			c, width := widestChannel(box)
+			if width > 0 && (index < 0 || len(box) > len(boxes[index])) {
+				index, channel = i, c
+			}
+		}
+		if index < 0 {
+			break
+		}
+
+		box := boxes[index]
+		sort.Slice(box, func(i, j int) bool { return box[i][channel] < box[j][channel] })
+		median := len(box) / 2
+		boxes[index] = box[:median]
+		boxes = append(boxes, box[median:])
+	}
+
+	for _, box := range boxes {
+		var sum [3]int
+		for _, pixel := range box {
+			for c := range sum {
+				sum[c] += int(pixel[c])
+			}
+		}
+		n := len(box)
+		p = append(p, color.NRGBA{R: uint8(sum[0] / n), G: uint8(sum[1] / n), B: uint8(sum[2] / n), A: 0xff})
+	}
+
+	return p
+}
+
+// Get channel of box which has widest range of values
+func widestChannel(box [][3]uint8) (int, int) {
+	var channel, width int
+	for c := 0; c < 3; c++ {
+		low, high := uint8(0xff), uint8(0)
+		for _, pixel := range box {
+			if pixel[c] < low {
+				low = pixel[c]
+			}
+			if pixel[c] > high {
+				high = pixel[c]
+			}
+		}
+		if int(high)-int(low) > width {
+			channel, width = c, int(high)-int(low)
+		}
+	}
+
+	return channel, width
+}
+
+// Get drawer of paletted images
+func (o *options) drawer() draw.Drawer {
+	if o.dither {
+		return draw.FloydSteinberg
+	}
+
+	return draw.Src
+}
@@ -0,0 +1,48 @@
+package lgtm
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// scrimPaddingRatio is padding of scrim box around mask to mask height
+// box fades out over half of padding beyond it
+const scrimPaddingRatio = 0.2
+
+// Scrim is translucent layer drawn behind mask to make it readable
+type Scrim struct {
+	// Opacity of layer(0.0-1.0), 0 disables it
+	Opacity float64
+	Color   color.Color
+
+	// Gradient draws band of full width which fades out above and below mask instead of box
+	Gradient bool
+}
+
+// Draw scrim behind mask at point of image
+func (s Scrim) draw(img *image.NRGBA, mask image.Point, p image.Point) *image.NRGBA {
+	inner := image.Rectangle{Min: p, Max: p.Add(mask)}
+	bounds := img.Bounds()
+
+	var solid image.Rectangle
+	var feather int
+	if s.Gradient {
+		solid = image.Rect(bounds.Min.X, inner.Min.Y, bounds.Max.X, inner.Max.Y)
+		feather = mask.Y
+	} else {
+		padding := max(int(float64(mask.Y)*scrimPaddingRatio), 1)
+		solid = inner.Inset(-padding)
+		feather = max(padding/2, 1)
+	}
+	region := solid.Inset(-feather).Intersect(bounds)
+	if region.Empty() {
+		return img
+	}
+
+	c := color.NRGBAModel.Convert(s.Color).(color.NRGBA)
+	c.A = uint8(float64(c.A) * s.Opacity)
+	draw.DrawMask(img, region, image.NewUniform(c), image.Point{}, falloff(solid, feather, region), region.Min, draw.Over)
+
+	return img
+}
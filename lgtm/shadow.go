@@ -0,0 +1,40 @@
+package lgtm
+
+import (
+	"github.com/disintegration/imaging"
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+const (
+	// shadowBlurRatio is blur sigma of mask shadow to mask width
+	shadowBlurRatio = 0.01
+
+	// shadowOffsetRatio is offset of mask shadow to mask width
+	shadowOffsetRatio = 0.008
+)
+
+// ShadowColor is color of mask shadow
+var ShadowColor = color.NRGBA{A: 0xa0}
+
+// Render soft shadow of mask
+// shadow is padded to keep blurred edges, returned point is offset of shadow to mask
+func dropShadow(mask image.Image) (image.Image, image.Point) {
+	bounds := mask.Bounds()
+	sigma := float64(bounds.Dx()) * shadowBlurRatio
+	if sigma < 1 {
+		sigma = 1
+	}
+	padding := int(sigma*3 + 0.5)
+	offset := int(float64(bounds.Dx())*shadowOffsetRatio + 0.5)
+	if offset < 1 {
+		offset = 1
+	}
+
+	shadow := image.NewNRGBA(image.Rect(0, 0, bounds.Dx()+padding*2, bounds.Dy()+padding*2))
+	r := image.Rect(padding, padding, padding+bounds.Dx(), padding+bounds.Dy())
+	draw.DrawMask(shadow, r, image.NewUniform(ShadowColor), image.Point{}, mask, bounds.Min, draw.Src)
+
+	return imaging.Blur(shadow, sigma), image.Pt(offset-padding, offset-padding)
+}
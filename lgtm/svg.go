@@ -0,0 +1,158 @@
+package lgtm
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"github.com/disintegration/imaging"
+	"github.com/neko-neko/lgtmgen/effect"
+	"github.com/neko-neko/lgtmgen/text_image"
+	"image"
+	"image/color"
+	"io"
+	"strings"
+)
+
+// SVG is SVG format which wraps source image with vector text
+// it can be written but not read
+const SVG imaging.Format = 101
+
+// DefaultText is text of SVG output when no text is given
+const DefaultText = "LGTM"
+
+// svgFontFamily is font of SVG text, embedded font can not be referred
+const svgFontFamily = "Helvetica, Arial, sans-serif"
+
+// svgCapHeight is height of capital letters to font size
+const svgCapHeight = 0.72
+
+// Write source image embedded in SVG with text on top
+// text is laid out in same box as raster mask, mask image itself is not used
+func (o *options) encodeSVG(w io.Writer, src image.Image) error {
+	src, err := effect.Apply(src, o.effects...)
+	if err != nil {
+		return err
+	}
+	if o.cropRatio > 0 {
+		src = imaging.Crop(src, cropRect(src.Bounds(), o.cropRatio))
+	}
+	if o.width > 0 && o.height > 0 {
+		src = imaging.Resize(src, o.width, o.height, imaging.Box)
+	}
+	background := o.finish(o.fit(imaging.Clone(src)))
+
+	// photo is embedded as JPEG unless corners are transparent
+	var data bytes.Buffer
+	mime := "image/jpeg"
+	format := imaging.JPEG
+	if o.radius > 0 {
+		mime, format = "image/png", imaging.PNG
+	}
+	if err := o.encode(&data, background, format); err != nil {
+		return err
+	}
+
+	size := background.Bounds().Size()
+	mask := o.scaleMask(size).Bounds().Size()
+	points := []image.Point{o.position.Point(size, mask)}
+	if o.tile {
+		points = tilePoints(size, mask, o.tileSpacing)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n", size.X, size.Y, size.X, size.Y)
+	if o.maskShadow || o.textStyle.Shadow {
+		b.WriteString(`<defs><filter id="shadow"><feDropShadow dx="2" dy="2" stdDeviation="2" flood-opacity="0.5"/></filter></defs>` + "\n")
+	}
+	fmt.Fprintf(&b, `<image width="%d" height="%d" href="data:%s;base64,%s"/>`+"\n", size.X, size.Y, mime, base64.StdEncoding.EncodeToString(data.Bytes()))
+	for _, p := range points {
+		o.writeSVGText(&b, image.Rectangle{Min: p, Max: p.Add(mask)})
+	}
+	b.WriteString("</svg>\n")
+
+	_, err = io.WriteString(w, b.String())
+
+	return err
+}
+
+// Write text element fitted in box of scaled mask
+// font size follows line height, single line is stretched to box width
+func (o *options) writeSVGText(b *strings.Builder, box image.Rectangle) {
+	text := o.svgText
+	if text == "" {
+		text = o.text
+	}
+	if text == "" {
+		text = DefaultText
+	}
+	lines := strings.Split(text, "\n")
+
+	// text box before rotation, scaled like mask
+	ratio := float64(box.Dx()) / float64(o.mask.Bounds().Dx())
+	width := float64(o.textBox.X) * ratio
+	height := float64(o.textBox.Y) * ratio
+	if o.textBox.X == 0 || o.textBox.Y == 0 {
+		width, height = float64(box.Dx()), float64(box.Dy())
+	}
+	centerX := float64(box.Min.X) + float64(box.Dx())/2
+	centerY := float64(box.Min.Y) + float64(box.Dy())/2
+	spacing := o.textStyle.LineSpacing
+	if spacing <= 0 {
+		spacing = text_image.DefaultLineSpacing
+	}
+	fontSize := height / (float64(len(lines)-1)*spacing + svgCapHeight)
+
+	anchor, x := "middle", centerX
+	switch o.textStyle.Align {
+	case text_image.AlignLeft:
+		anchor, x = "start", centerX-width/2
+	case text_image.AlignRight:
+		anchor, x = "end", centerX+width/2
+	}
+
+	fmt.Fprintf(b, `<text font-family="%s" font-weight="bold" font-size="%.1f" text-anchor="%s" fill="%s"`, svgFontFamily, fontSize, anchor, svgColor(o.textStyle.Color))
+	if o.opacity < 1 {
+		fmt.Fprintf(b, ` opacity="%.2f"`, o.opacity)
+	}
+	if o.textStyle.StrokeWidth > 0 && o.textStyle.StrokeColor != nil {
+		fmt.Fprintf(b, ` stroke="%s" stroke-width="%.1f" stroke-linejoin="round" paint-order="stroke"`, svgColor(o.textStyle.StrokeColor), float64(o.textStyle.StrokeWidth)*2*ratio)
+	}
+	if o.maskShadow || o.textStyle.Shadow {
+		b.WriteString(` filter="url(#shadow)"`)
+	}
+	if o.rotate != 0 {
+		fmt.Fprintf(b, ` transform="rotate(%.2f %.1f %.1f)"`, -o.rotate, centerX, centerY)
+	}
+	b.WriteString(">")
+
+	// visible box starts at cap height of first line
+	top := centerY - height/2
+	for i, line := range lines {
+		y := top + fontSize*(svgCapHeight+spacing*float64(i))
+		fmt.Fprintf(b, `<tspan x="%.1f" y="%.1f"`, x, y)
+		if len(lines) == 1 {
+			fmt.Fprintf(b, ` textLength="%.1f" lengthAdjust="spacingAndGlyphs"`, width)
+		}
+		fmt.Fprintf(b, ">%s</tspan>", svgEscape(line))
+	}
+	b.WriteString("</text>\n")
+}
+
+// Format color as CSS rgba()
+func svgColor(c color.Color) string {
+	if c == nil {
+		return "none"
+	}
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+
+	return fmt.Sprintf("rgba(%d,%d,%d,%.3g)", n.R, n.G, n.B, float64(n.A)/0xff)
+}
+
+// Escape text for XML
+func svgEscape(s string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(s))
+
+	return buf.String()
+}
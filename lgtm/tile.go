@@ -0,0 +1,41 @@
+package lgtm
+
+import (
+	"errors"
+	"image"
+)
+
+// DefaultTileSpacing is gap between tiled masks to mask size
+const DefaultTileSpacing = 0.5
+
+// ErrInvalidTileSpacing is returned when tile spacing is negative
+var ErrInvalidTileSpacing = errors.New("tile spacing must not be negative")
+
+// Top-left points of masks repeated across background
+// rows are staggered by half step and pattern is centered on background
+func tilePoints(background image.Point, mask image.Point, spacing float64) []image.Point {
+	stepX := mask.X + int(float64(mask.X)*spacing+0.5)
+	stepY := mask.Y + int(float64(mask.Y)*spacing+0.5)
+	if stepX < 1 || stepY < 1 {
+		return nil
+	}
+
+	// start outside of background so that edges are covered
+	originX := (background.X-mask.X)/2%stepX - stepX
+	originY := (background.Y-mask.Y)/2%stepY - stepY
+
+	var points []image.Point
+	for row, y := 0, originY; y < background.Y; row, y = row+1, y+stepY {
+		x := originX
+		if row%2 == 1 {
+			x -= stepX / 2
+		}
+		for ; x < background.X; x += stepX {
+			if x+mask.X > 0 && y+mask.Y > 0 {
+				points = append(points, image.Pt(x, y))
+			}
+		}
+	}
+
+	return points
+}
@@ -0,0 +1,229 @@
+package lgtm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ErrFFmpegNotFound is returned when video is processed without ffmpeg
+var ErrFFmpegNotFound = errors.New("ffmpeg and ffprobe are required for video")
+
+// videoExtensions are video formats processed by ffmpeg
+var videoExtensions = map[string]bool{
+	".mp4":  true,
+	".m4v":  true,
+	".mov":  true,
+	".webm": true,
+}
+
+// File name is video
+func IsVideo(filename string) bool {
+	return videoExtensions[strings.ToLower(filepath.Ext(filename))]
+}
+
+// Overlay mask on every frame of video and save it by ffmpeg (experimental)
+// audio is re-encoded for output container, rotation metadata is not kept
+// poster frame is saved if out is image file
+func ProcessVideo(ctx context.Context, in string, out string, opts ...Option) error {
+	for _, tool := range []string{"ffmpeg", "ffprobe"} {
+		if _, err := exec.LookPath(tool); err != nil {
+			return ErrFFmpegNotFound
+		}
+	}
+
+	o, err := newOptions(opts)
+	if err != nil {
+		return err
+	}
+	width, height, rate, err := probeVideo(ctx, in)
+	if err != nil {
+		return err
+	}
+	// frames are decoded in memory, so large video is rejected before starting ffmpeg
+	if err := o.checkSize(width, height); err != nil {
+		return err
+	}
+
+	if !IsVideo(out) {
+		return processPoster(ctx, in, out, opts)
+	}
+
+	if err := o.overlayVideo(ctx, in, out, width, height, rate); err != nil {
+		// do not leave broken file
+		os.Remove(out)
+		return err
+	}
+
+	return nil
+}
+
+// Overlay mask on first frame of video and save it as image
+func processPoster(ctx context.Context, in string, out string, opts []Option) error {
+	poster, err := ffmpeg(ctx, "-noautorotate", "-i", in, "-frames:v", "1", "-f", "image2pipe", "-vcodec", "png", "-")
+	if err != nil {
+		return err
+	}
+	format, err := FormatFromFilename(out)
+	if err != nil {
+		return err
+	}
+
+	var output bytes.Buffer
+	if err := ProcessContext(ctx, bytes.NewReader(poster), &output, format, opts...); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(out, output.Bytes(), 0644)
+}
+
+// Get frame size and frame rate of first video stream
+// e.g. 1280, 720, "30000/1001"
+func probeVideo(ctx context.Context, in string) (int, int, string, error) {
+	cmd := exec.CommandContext(ctx, "ffprobe", "-v", "error", "-select_streams", "v:0",
+		"-show_entries", "stream=width,height,r_frame_rate", "-of", "csv=p=0", in)
+	output, err := cmd.Output()
+	if err != nil {
+		return 0, 0, "", commandError("ffprobe", err)
+	}
+
+	values := strings.Split(strings.TrimSpace(string(output)), ",")
+	if len(values) != 3 {
+		return 0, 0, "", fmt.Errorf("no video stream in %s", in)
+	}
+	width, err := strconv.Atoi(values[0])
+	if err != nil {
+		return 0, 0, "", err
+	}
+	height, err := strconv.Atoi(values[1])
+	if err != nil {
+		return 0, 0, "", err
+	}
+
+	return width, height, values[2], nil
+}
+
+// Decode frames by ffmpeg, overlay mask and encode them by another ffmpeg
+// output size is known after first frame is masked(e.g. crop, max size)
+func (o *options) overlayVideo(ctx context.Context, in string, out string, width int, height int, rate string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	var decoder, encoder *exec.Cmd
+	defer func() {
+		// commands are killed and reaped when returned by error
+		cancel()
+		if decoder != nil {
+			decoder.Wait()
+		}
+		if encoder != nil {
+			encoder.Wait()
+		}
+	}()
+
+	decoder = exec.CommandContext(ctx, "ffmpeg", "-v", "error", "-noautorotate", "-i", in,
+		"-map", "0:v:0", "-f", "rawvideo", "-pix_fmt", "rgba", "-")
+	frames, err := decoder.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	var decoderErr bytes.Buffer
+	decoder.Stderr = &decoderErr
+	if err := decoder.Start(); err != nil {
+		decoder = nil
+		return err
+	}
+
+	var encoderIn io.WriteCloser
+	var encoderErr bytes.Buffer
+	reader := bufio.NewReader(frames)
+	for {
+		frame := image.NewNRGBA(image.Rect(0, 0, width, height))
+		if _, err := io.ReadFull(reader, frame.Pix); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+
+		masked, err := o.overlay(frame)
+		if err != nil {
+			return err
+		}
+
+		if encoder == nil {
+			size := masked.Bounds().Size()
+			encoder = exec.CommandContext(ctx, "ffmpeg", "-v", "error", "-y",
+				"-f", "rawvideo", "-pix_fmt", "rgba", "-s", fmt.Sprintf("%dx%d", size.X, size.Y), "-r", rate, "-i", "-",
+				"-i", in, "-map", "0:v", "-map", "1:a?", "-shortest",
+				"-vf", "scale=trunc(iw/2)*2:trunc(ih/2)*2", "-pix_fmt", "yuv420p", out)
+			if encoderIn, err = encoder.StdinPipe(); err != nil {
+				return err
+			}
+			encoder.Stderr = &encoderErr
+			if err := encoder.Start(); err != nil {
+				encoder = nil
+				return err
+			}
+		}
+		if _, err := encoderIn.Write(imageBytes(masked)); err != nil {
+			return fmt.Errorf("ffmpeg: %s", strings.TrimSpace(encoderErr.String()))
+		}
+	}
+	if err := decoder.Wait(); err != nil {
+		return fmt.Errorf("ffmpeg: %s", strings.TrimSpace(decoderErr.String()))
+	}
+	if encoder == nil {
+		return fmt.Errorf("no video frame in %s", in)
+	}
+	encoderIn.Close()
+	if err := encoder.Wait(); err != nil {
+		return fmt.Errorf("ffmpeg: %s", strings.TrimSpace(encoderErr.String()))
+	}
+
+	return ctx.Err()
+}
+
+// Get pixels of image without row padding
+func imageBytes(img *image.NRGBA) []byte {
+	size := img.Bounds().Size()
+	if img.Stride == size.X*4 {
+		return img.Pix[:size.X*size.Y*4]
+	}
+
+	data := make([]byte, 0, size.X*size.Y*4)
+	for y := 0; y < size.Y; y++ {
+		offset := y * img.Stride
+		data = append(data, img.Pix[offset:offset+size.X*4]...)
+	}
+
+	return data
+}
+
+// Run ffmpeg and get stdout
+func ffmpeg(ctx context.Context, args ...string) ([]byte, error) {
+	output, err := exec.CommandContext(ctx, "ffmpeg", append([]string{"-v", "error"}, args...)...).Output()
+	if err != nil {
+		return nil, commandError("ffmpeg", err)
+	}
+
+	return output, nil
+}
+
+// Error with stderr of command
+func commandError(name string, err error) error {
+	var exitError *exec.ExitError
+	if errors.As(err, &exitError) && len(exitError.Stderr) > 0 {
+		return fmt.Errorf("%s: %s", name, strings.TrimSpace(string(exitError.Stderr)))
+	}
+
+	return fmt.Errorf("%s: %s", name, err)
+}
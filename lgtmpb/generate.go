@@ -0,0 +1,5 @@
+// Package lgtmpb is gRPC service of LGTM image generation.
+// code is generated from lgtmgen.proto
+package lgtmpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative lgtmgen.proto
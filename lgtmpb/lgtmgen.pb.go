@@ -0,0 +1,489 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: lgtmgen.proto
+
+package lgtmpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Options of generation, zero values are server defaults
+type Options struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// text is rendered instead of mask image
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// format is output format(e.g. png, jpg), same as input if empty
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// mask_scale is mask width ratio to image width
+	MaskScale float64 `protobuf:"fixed64,3,opt,name=mask_scale,json=maskScale,proto3" json:"mask_scale,omitempty"`
+	// position is mask position(e.g. center, bottom-right, 10%,90%)
+	Position string `protobuf:"bytes,4,opt,name=position,proto3" json:"position,omitempty"`
+	// opacity is mask opacity(0.0-1.0)
+	Opacity float64 `protobuf:"fixed64,5,opt,name=opacity,proto3" json:"opacity,omitempty"`
+	// rotate is mask angle in degrees counter-clockwise
+	Rotate float64 `protobuf:"fixed64,6,opt,name=rotate,proto3" json:"rotate,omitempty"`
+	// quality is JPEG and AVIF quality(1-100)
+	Quality       int32 `protobuf:"varint,7,opt,name=quality,proto3" json:"quality,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Options) Reset() {
+	*x = Options{}
+	mi := &file_lgtmgen_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Options) ProtoMessage() {}
+
+func (x *Options) ProtoReflect() protoreflect.Message {
+	mi := &file_lgtmgen_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Options.ProtoReflect.Descriptor instead.
+func (*Options) Descriptor() ([]byte, []int) {
+	return file_lgtmgen_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Options) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Options) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Options) GetMaskScale() float64 {
+	if x != nil {
+		return x.MaskScale
+	}
+	return 0
+}
+
+func (x *Options) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *Options) GetOpacity() float64 {
+	if x != nil {
+		return x.Opacity
+	}
+	return 0
+}
+
+func (x *Options) GetRotate() float64 {
+	if x != nil {
+		return x.Rotate
+	}
+	return 0
+}
+
+func (x *Options) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+type GenerateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         []byte                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Options       *Options               `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateRequest) Reset() {
+	*x = GenerateRequest{}
+	mi := &file_lgtmgen_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRequest) ProtoMessage() {}
+
+func (x *GenerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lgtmgen_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRequest.ProtoReflect.Descriptor instead.
+func (*GenerateRequest) Descriptor() ([]byte, []int) {
+	return file_lgtmgen_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenerateRequest) GetImage() []byte {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *GenerateRequest) GetOptions() *Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type GenerateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         []byte                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateResponse) Reset() {
+	*x = GenerateResponse{}
+	mi := &file_lgtmgen_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateResponse) ProtoMessage() {}
+
+func (x *GenerateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lgtmgen_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateResponse.ProtoReflect.Descriptor instead.
+func (*GenerateResponse) Descriptor() ([]byte, []int) {
+	return file_lgtmgen_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenerateResponse) GetImage() []byte {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *GenerateResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type GenerateStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Part:
+	//
+	//	*GenerateStreamRequest_Options
+	//	*GenerateStreamRequest_Chunk
+	Part          isGenerateStreamRequest_Part `protobuf_oneof:"part"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateStreamRequest) Reset() {
+	*x = GenerateStreamRequest{}
+	mi := &file_lgtmgen_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateStreamRequest) ProtoMessage() {}
+
+func (x *GenerateStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lgtmgen_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateStreamRequest.ProtoReflect.Descriptor instead.
+func (*GenerateStreamRequest) Descriptor() ([]byte, []int) {
+	return file_lgtmgen_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GenerateStreamRequest) GetPart() isGenerateStreamRequest_Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *GenerateStreamRequest) GetOptions() *Options {
+	if x != nil {
+		if x, ok := x.Part.(*GenerateStreamRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *GenerateStreamRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Part.(*GenerateStreamRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isGenerateStreamRequest_Part interface {
+	isGenerateStreamRequest_Part()
+}
+
+type GenerateStreamRequest_Options struct {
+	Options *Options `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type GenerateStreamRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*GenerateStreamRequest_Options) isGenerateStreamRequest_Part() {}
+
+func (*GenerateStreamRequest_Chunk) isGenerateStreamRequest_Part() {}
+
+type GenerateStreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Part:
+	//
+	//	*GenerateStreamResponse_ContentType
+	//	*GenerateStreamResponse_Chunk
+	Part          isGenerateStreamResponse_Part `protobuf_oneof:"part"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateStreamResponse) Reset() {
+	*x = GenerateStreamResponse{}
+	mi := &file_lgtmgen_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateStreamResponse) ProtoMessage() {}
+
+func (x *GenerateStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lgtmgen_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateStreamResponse.ProtoReflect.Descriptor instead.
+func (*GenerateStreamResponse) Descriptor() ([]byte, []int) {
+	return file_lgtmgen_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GenerateStreamResponse) GetPart() isGenerateStreamResponse_Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *GenerateStreamResponse) GetContentType() string {
+	if x != nil {
+		if x, ok := x.Part.(*GenerateStreamResponse_ContentType); ok {
+			return x.ContentType
+		}
+	}
+	return ""
+}
+
+func (x *GenerateStreamResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Part.(*GenerateStreamResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isGenerateStreamResponse_Part interface {
+	isGenerateStreamResponse_Part()
+}
+
+type GenerateStreamResponse_ContentType struct {
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3,oneof"`
+}
+
+type GenerateStreamResponse_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*GenerateStreamResponse_ContentType) isGenerateStreamResponse_Part() {}
+
+func (*GenerateStreamResponse_Chunk) isGenerateStreamResponse_Part() {}
+
+var File_lgtmgen_proto protoreflect.FileDescriptor
+
+const file_lgtmgen_proto_rawDesc = "" +
+	"\n" +
+	"\rlgtmgen.proto\x12\n" +
+	"lgtmgen.v1\"\xbc\x01\n" +
+	"\aOptions\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x1d\n" +
+	"\n" +
+	"mask_scale\x18\x03 \x01(\x01R\tmaskScale\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\tR\bposition\x12\x18\n" +
+	"\aopacity\x18\x05 \x01(\x01R\aopacity\x12\x16\n" +
+	"\x06rotate\x18\x06 \x01(\x01R\x06rotate\x12\x18\n" +
+	"\aquality\x18\a \x01(\x05R\aquality\"V\n" +
+	"\x0fGenerateRequest\x12\x14\n" +
+	"\x05image\x18\x01 \x01(\fR\x05image\x12-\n" +
+	"\aoptions\x18\x02 \x01(\v2\x13.lgtmgen.v1.OptionsR\aoptions\"K\n" +
+	"\x10GenerateResponse\x12\x14\n" +
+	"\x05image\x18\x01 \x01(\fR\x05image\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"h\n" +
+	"\x15GenerateStreamRequest\x12/\n" +
+	"\aoptions\x18\x01 \x01(\v2\x13.lgtmgen.v1.OptionsH\x00R\aoptions\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04part\"]\n" +
+	"\x16GenerateStreamResponse\x12#\n" +
+	"\fcontent_type\x18\x01 \x01(\tH\x00R\vcontentType\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04part2\xaf\x01\n" +
+	"\tGenerator\x12E\n" +
+	"\bGenerate\x12\x1b.lgtmgen.v1.GenerateRequest\x1a\x1c.lgtmgen.v1.GenerateResponse\x12[\n" +
+	"\x0eGenerateStream\x12!.lgtmgen.v1.GenerateStreamRequest\x1a\".lgtmgen.v1.GenerateStreamResponse(\x010\x01B%Z#github.com/neko-neko/lgtmgen/lgtmpbb\x06proto3"
+
+var (
+	file_lgtmgen_proto_rawDescOnce sync.Once
+	file_lgtmgen_proto_rawDescData []byte
+)
+
+func file_lgtmgen_proto_rawDescGZIP() []byte {
+	file_lgtmgen_proto_rawDescOnce.Do(func() {
+		file_lgtmgen_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_lgtmgen_proto_rawDesc), len(file_lgtmgen_proto_rawDesc)))
+	})
+	return file_lgtmgen_proto_rawDescData
+}
+
+var file_lgtmgen_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_lgtmgen_proto_goTypes = []any{
+	(*Options)(nil),                // 0: lgtmgen.v1.Options
+	(*GenerateRequest)(nil),        // 1: lgtmgen.v1.GenerateRequest
+	(*GenerateResponse)(nil),       // 2: lgtmgen.v1.GenerateResponse
+	(*GenerateStreamRequest)(nil),  // 3: lgtmgen.v1.GenerateStreamRequest
+	(*GenerateStreamResponse)(nil), // 4: lgtmgen.v1.GenerateStreamResponse
+}
+var file_lgtmgen_proto_depIdxs = []int32{
+	0, // 0: lgtmgen.v1.GenerateRequest.options:type_name -> lgtmgen.v1.Options
+	0, // 1: lgtmgen.v1.GenerateStreamRequest.options:type_name -> lgtmgen.v1.Options
+	1, // 2: lgtmgen.v1.Generator.Generate:input_type -> lgtmgen.v1.GenerateRequest
+	3, // 3: lgtmgen.v1.Generator.GenerateStream:input_type -> lgtmgen.v1.GenerateStreamRequest
+	2, // 4: lgtmgen.v1.Generator.Generate:output_type -> lgtmgen.v1.GenerateResponse
+	4, // 5: lgtmgen.v1.Generator.GenerateStream:output_type -> lgtmgen.v1.GenerateStreamResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_lgtmgen_proto_init() }
+func file_lgtmgen_proto_init() {
+	if File_lgtmgen_proto != nil {
+		return
+	}
+	file_lgtmgen_proto_msgTypes[3].OneofWrappers = []any{
+		(*GenerateStreamRequest_Options)(nil),
+		(*GenerateStreamRequest_Chunk)(nil),
+	}
+	file_lgtmgen_proto_msgTypes[4].OneofWrappers = []any{
+		(*GenerateStreamResponse_ContentType)(nil),
+		(*GenerateStreamResponse_Chunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lgtmgen_proto_rawDesc), len(file_lgtmgen_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_lgtmgen_proto_goTypes,
+		DependencyIndexes: file_lgtmgen_proto_depIdxs,
+		MessageInfos:      file_lgtmgen_proto_msgTypes,
+	}.Build()
+	File_lgtmgen_proto = out.File
+	file_lgtmgen_proto_goTypes = nil
+	file_lgtmgen_proto_depIdxs = nil
+}
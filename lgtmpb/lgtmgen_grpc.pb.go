@@ -0,0 +1,164 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: lgtmgen.proto
+
+package lgtmpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Generator_Generate_FullMethodName       = "/lgtmgen.v1.Generator/Generate"
+	Generator_GenerateStream_FullMethodName = "/lgtmgen.v1.Generator/GenerateStream"
+)
+
+// GeneratorClient is the client API for Generator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Generator masks images with LGTM
+type GeneratorClient interface {
+	// Generate masks whole image in a message
+	Generate(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*GenerateResponse, error)
+	// GenerateStream receives image in chunks and sends output in chunks
+	// first request must have options, use it for images larger than message size limit
+	GenerateStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[GenerateStreamRequest, GenerateStreamResponse], error)
+}
+
+type generatorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGeneratorClient(cc grpc.ClientConnInterface) GeneratorClient {
+	return &generatorClient{cc}
+}
+
+func (c *generatorClient) Generate(ctx context.Context, in *GenerateRequest, opts ...grpc.CallOption) (*GenerateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateResponse)
+	err := c.cc.Invoke(ctx, Generator_Generate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *generatorClient) GenerateStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[GenerateStreamRequest, GenerateStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Generator_ServiceDesc.Streams[0], Generator_GenerateStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GenerateStreamRequest, GenerateStreamResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Generator_GenerateStreamClient = grpc.BidiStreamingClient[GenerateStreamRequest, GenerateStreamResponse]
+
+// GeneratorServer is the server API for Generator service.
+// All implementations must embed UnimplementedGeneratorServer
+// for forward compatibility.
+//
+// Generator masks images with LGTM
+type GeneratorServer interface {
+	// Generate masks whole image in a message
+	Generate(context.Context, *GenerateRequest) (*GenerateResponse, error)
+	// GenerateStream receives image in chunks and sends output in chunks
+	// first request must have options, use it for images larger than message size limit
+	GenerateStream(grpc.BidiStreamingServer[GenerateStreamRequest, GenerateStreamResponse]) error
+	mustEmbedUnimplementedGeneratorServer()
+}
+
+// UnimplementedGeneratorServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGeneratorServer struct{}
+
+func (UnimplementedGeneratorServer) Generate(context.Context, *GenerateRequest) (*GenerateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Generate not implemented")
+}
+func (UnimplementedGeneratorServer) GenerateStream(grpc.BidiStreamingServer[GenerateStreamRequest, GenerateStreamResponse]) error {
+	return status.Error(codes.Unimplemented, "method GenerateStream not implemented")
+}
+func (UnimplementedGeneratorServer) mustEmbedUnimplementedGeneratorServer() {}
+func (UnimplementedGeneratorServer) testEmbeddedByValue()                   {}
+
+// UnsafeGeneratorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GeneratorServer will
+// result in compilation errors.
+type UnsafeGeneratorServer interface {
+	mustEmbedUnimplementedGeneratorServer()
+}
+
+func RegisterGeneratorServer(s grpc.ServiceRegistrar, srv GeneratorServer) {
+	// If the following call panics, it indicates UnimplementedGeneratorServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Generator_ServiceDesc, srv)
+}
+
+func _Generator_Generate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeneratorServer).Generate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Generator_Generate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeneratorServer).Generate(ctx, req.(*GenerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Generator_GenerateStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GeneratorServer).GenerateStream(&grpc.GenericServerStream[GenerateStreamRequest, GenerateStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Generator_GenerateStreamServer = grpc.BidiStreamingServer[GenerateStreamRequest, GenerateStreamResponse]
+
+// Generator_ServiceDesc is the grpc.ServiceDesc for Generator service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Generator_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "lgtmgen.v1.Generator",
+	HandlerType: (*GeneratorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Generate",
+			Handler:    _Generator_Generate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GenerateStream",
+			Handler:       _Generator_GenerateStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "lgtmgen.proto",
+}
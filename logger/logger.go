@@ -0,0 +1,131 @@
+// Package logger prints leveled messages of commandline.
+package logger
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// Level is verbosity of messages
+type Level int
+
+const (
+	// LevelQuiet prints errors only
+	LevelQuiet Level = iota
+
+	// LevelInfo prints warnings and summary, it is default
+	LevelInfo
+
+	// LevelVerbose prints result of each file
+	LevelVerbose
+
+	// LevelDebug prints timing and decode details
+	LevelDebug
+)
+
+// ANSI escape sequences of message colors
+const (
+	colorRed    = "\x1b[31m"
+	colorGreen  = "\x1b[32m"
+	colorYellow = "\x1b[33m"
+	colorReset  = "\x1b[0m"
+)
+
+// Logger writes messages of enabled level
+// results requested by user(e.g. markdown) are written to Out,
+// others including line of each file are written to Err
+type Logger struct {
+	Out, Err io.Writer
+	Level    Level
+
+	// ColorOut and ColorErr colorize messages written to Out and Err
+	// they should be enabled only for terminal
+	ColorOut, ColorErr bool
+
+	mu sync.Mutex
+}
+
+// constructor
+func New(out io.Writer, err io.Writer, level Level) *Logger {
+	return &Logger{Out: out, Err: err, Level: level}
+}
+
+// Level is enabled
+func (l *Logger) Enabled(level Level) bool {
+	return l.Level >= level
+}
+
+// Replace error writer and get previous one
+// e.g. progress bar wraps error writer while running
+func (l *Logger) SetErr(w io.Writer) io.Writer {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	previous := l.Err
+	l.Err = w
+
+	return previous
+}
+
+// Print error
+func (l *Logger) Errorf(format string, args ...interface{}) {
+	l.write(false, LevelQuiet, colorRed, format, args...)
+}
+
+// Print warning(e.g. skipped file)
+func (l *Logger) Warnf(format string, args ...interface{}) {
+	l.write(false, LevelInfo, colorYellow, format, args...)
+}
+
+// Print progress message(e.g. summary)
+func (l *Logger) Infof(format string, args ...interface{}) {
+	l.write(false, LevelInfo, "", format, args...)
+}
+
+// Print detail of each file
+func (l *Logger) Verbosef(format string, args ...interface{}) {
+	l.write(false, LevelVerbose, "", format, args...)
+}
+
+// Print timing and decode details
+func (l *Logger) Debugf(format string, args ...interface{}) {
+	l.write(false, LevelDebug, "", format, args...)
+}
+
+// Print result to Out if level is enabled
+func (l *Logger) Resultf(level Level, format string, args ...interface{}) {
+	l.write(true, level, "", format, args...)
+}
+
+// Print line of file to Err if level is enabled
+func (l *Logger) Printf(level Level, format string, args ...interface{}) {
+	l.write(false, level, "", format, args...)
+}
+
+// Print line of successful file to Err if level is enabled
+func (l *Logger) Successf(level Level, format string, args ...interface{}) {
+	l.write(false, level, colorGreen, format, args...)
+}
+
+// Write message with newline to Out or Err
+// message is wrapped by color if it is enabled for the writer
+func (l *Logger) write(out bool, level Level, color string, format string, args ...interface{}) {
+	if !l.Enabled(level) {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	w, colored := l.Err, l.ColorErr
+	if out {
+		w, colored = l.Out, l.ColorOut
+	}
+	message := strings.TrimSuffix(fmt.Sprintf(format, args...), "\n")
+	if colored && color != "" {
+		message = color + message + colorReset
+	}
+	io.WriteString(w, message+"\n")
+}
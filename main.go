@@ -24,7 +24,7 @@ import (
 )
 
 func main() {
-	cli := &CLI{outStream: os.Stdout, errStream: os.Stderr}
+	cli := &CLI{inStream: os.Stdin, outStream: os.Stdout, errStream: os.Stderr}
 	os.Exit(cli.Run(os.Args))
 }
 
@@ -2,37 +2,89 @@ package mask_image
 
 import (
 	"bytes"
-	"github.com/disintegration/imaging"
+	"errors"
 	"github.com/neko-neko/lgtmgen/images"
 	"image"
+	"image/color"
+	"io/fs"
 	"io/ioutil"
+	"path/filepath"
 )
 
+// ErrNoAlphaChannel is returned when the mask image has no alpha channel
+var ErrNoAlphaChannel = errors.New("mask image has no alpha channel")
+
 type MaskImage struct {
-	Width int
-	Height int
+	Width     int
+	Height    int
 	MaskImage image.Image
 }
 
 // constructor
 func NewMaskImage() *MaskImage {
 	return &MaskImage{
-		Width: 0,
-		Height: 0,
+		Width:     0,
+		Height:    0,
 		MaskImage: nil,
 	}
 }
 
 // Load mask image
+// maskImage is an embedded asset name or a file path
 func (m *MaskImage) LoadMaskImage(maskImage string) error {
-	imageByte, err := images.Asset(maskImage)
+	img, err := readImage(maskImage)
+	if err != nil {
+		return err
+	}
+
+	// mask must be transparent
+	if !hasAlphaChannel(img) {
+		return ErrNoAlphaChannel
+	}
+
+	m.setImage(img)
+
+	return nil
+}
+
+// Load watermark image
+// unlike mask, opaque image is allowed
+func (m *MaskImage) LoadWatermarkImage(watermarkImage string) error {
+	img, err := readImage(watermarkImage)
 	if err != nil {
 		return err
 	}
 
+	m.setImage(img)
+
+	return nil
+}
+
+// Read embedded asset or image file
+// SVG file is read as *SVGImage
+func readImage(name string) (image.Image, error) {
+	imageByte, err := images.Asset(name)
+	if err != nil {
+		// not embedded, read from file
+		imageByte, err = ioutil.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// SVG is kept as vector to be drawn at target size
+	if isSVG(name) {
+		return decodeSVG(imageByte)
+	}
+
 	// convert []byte to Image.image
-	img, _, _ := image.Decode(bytes.NewReader(imageByte))
+	img, _, err := image.Decode(bytes.NewReader(imageByte))
+
+	return img, err
+}
 
+// Set image and its size
+func (m *MaskImage) setImage(img image.Image) {
 	// load mask image config
 	size := img.Bounds().Size()
 
@@ -40,15 +92,13 @@ func (m *MaskImage) LoadMaskImage(maskImage string) error {
 	m.Height = size.Y
 	m.Width = size.X
 	m.MaskImage = img
-
-	return nil
 }
 
 // Get target image paths from target dir
-func (m *MaskImage) ReadImagePaths(target string) []string {
+func (m *MaskImage) ReadImagePaths(target string) ([]string, error) {
 	files, err := ioutil.ReadDir(target)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	// create full path lists
@@ -62,17 +112,47 @@ func (m *MaskImage) ReadImagePaths(target string) []string {
 		filesPaths = append(filesPaths, target+fileInfo.Name())
 	}
 
-	return filesPaths
+	return filesPaths, nil
 }
 
-// Execute mask
-func (m *MaskImage) OverlayImage(file string, maskImage image.Image, width int, height int) (*image.NRGBA, error) {
-	srcImage, err := imaging.Open(file)
+// Get target image paths from target dir and its subdirectories
+func (m *MaskImage) ReadImagePathsRecursive(target string) ([]string, error) {
+	var filesPaths []string
+	err := filepath.WalkDir(target, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// skip directory
+		if entry.IsDir() {
+			return nil
+		}
+
+		filesPaths = append(filesPaths, path)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	resizedImage := imaging.Resize(srcImage, width, height, imaging.Box)
-	maskedImage := imaging.OverlayCenter(resizedImage, maskImage, 1.0)
-	return maskedImage, nil
-}
\ No newline at end of file
+	return filesPaths, nil
+}
+
+// Has alpha channel
+func hasAlphaChannel(img image.Image) bool {
+	switch img.ColorModel() {
+	case color.RGBAModel, color.NRGBAModel, color.RGBA64Model, color.NRGBA64Model, color.AlphaModel, color.Alpha16Model:
+		return true
+	}
+
+	// paletted image has alpha if any palette entry is transparent
+	if paletted, ok := img.(*image.Paletted); ok {
+		for _, c := range paletted.Palette {
+			if _, _, _, a := c.RGBA(); a != 0xffff {
+				return true
+			}
+		}
+	}
+
+	return false
+}
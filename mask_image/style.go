@@ -0,0 +1,135 @@
+package mask_image
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/neko-neko/lgtmgen/images"
+	"image"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// DefaultStyle is name of default mask style
+const DefaultStyle = "classic"
+
+// UserStyleDir is directory of user-installed masks in home directory
+// e.g.
+// $HOME/.lgtmgen/masks/party.png => --style party
+const UserStyleDir = ".lgtmgen/masks"
+
+// Style is embedded mask image with name
+type Style struct {
+	// Name is value of --style
+	Name string
+
+	// Asset is embedded asset name or file path of mask image
+	Asset string
+
+	// Description is short explanation of style
+	Description string
+
+	// Embedded is true for built-in style
+	Embedded bool
+}
+
+// styles are embedded mask images by name
+var styles = map[string]Style{
+	"classic":  {Name: "classic", Asset: "images/lgtm_mask.png", Description: "White serif LGTM with shadow", Embedded: true},
+	"outline":  {Name: "outline", Asset: "images/lgtm_outline.png", Description: "White outlined LGTM", Embedded: true},
+	"comic":    {Name: "comic", Asset: "images/lgtm_comic.png", Description: "Yellow LGTM! with black border", Embedded: true},
+	"stamp":    {Name: "stamp", Asset: "images/lgtm_stamp.png", Description: "Red tilted LGTM stamp", Embedded: true},
+	"approved": {Name: "approved", Asset: "images/lgtm_approved.png", Description: "Green tilted APPROVED stamp", Embedded: true},
+	"wip":      {Name: "wip", Asset: "images/lgtm_wip.png", Description: "Orange WIP with black border", Embedded: true},
+}
+
+// Get style by name
+// built-in styles take precedence over user-installed masks
+func LookupStyle(name string) (Style, error) {
+	if style, ok := styles[name]; ok {
+		return style, nil
+	}
+	for _, style := range userStyles() {
+		if style.Name == name {
+			return style, nil
+		}
+	}
+
+	return Style{}, fmt.Errorf("unknown style %s", name)
+}
+
+// Get built-in and user-installed styles sorted by name
+func Styles() []Style {
+	list := make([]Style, 0, len(styles))
+	for _, style := range styles {
+		list = append(list, style)
+	}
+	for _, style := range userStyles() {
+		if _, ok := styles[style.Name]; !ok {
+			list = append(list, style)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+
+	return list
+}
+
+// Get built-in style names sorted
+func StyleNames() []string {
+	var names []string
+	for name := range styles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Get size of style mask image without decoding pixels
+func (s Style) Size() (image.Point, error) {
+	data, err := images.Asset(s.Asset)
+	if err != nil {
+		if data, err = ioutil.ReadFile(s.Asset); err != nil {
+			return image.Point{}, err
+		}
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return image.Point{}, err
+	}
+
+	return image.Pt(config.Width, config.Height), nil
+}
+
+// Get user-installed masks
+// file name without extension is style name
+func userStyles() []Style {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+	dir := filepath.Join(home, UserStyleDir)
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+
+	var list []Style
+	for _, file := range files {
+		ext := filepath.Ext(file.Name())
+		if file.IsDir() || strings.ToLower(ext) != ".png" {
+			continue
+		}
+		list = append(list, Style{
+			Name:        strings.TrimSuffix(file.Name(), ext),
+			Asset:       filepath.Join(dir, file.Name()),
+			Description: "User mask " + filepath.Join(dir, file.Name()),
+		})
+	}
+
+	return list
+}
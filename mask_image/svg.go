@@ -0,0 +1,62 @@
+package mask_image
+
+import (
+	"bytes"
+	"errors"
+	"github.com/srwiley/oksvg"
+	"github.com/srwiley/rasterx"
+	"image"
+	"math"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ErrInvalidSVG is returned when SVG mask has no size
+var ErrInvalidSVG = errors.New("svg mask has no width and height")
+
+// SVGImage is mask of SVG which is drawn at any size
+// it is an image rasterized at size of SVG viewBox
+type SVGImage struct {
+	image.Image
+
+	// mu guards icon, its target is changed on every rasterization
+	mu   sync.Mutex
+	icon *oksvg.SvgIcon
+}
+
+// Is SVG file name
+func isSVG(name string) bool {
+	return strings.EqualFold(filepath.Ext(name), ".svg")
+}
+
+// Parse SVG and rasterize it at size of viewBox
+func decodeSVG(data []byte) (*SVGImage, error) {
+	icon, err := oksvg.ReadIconStream(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	width := int(math.Ceil(icon.ViewBox.W))
+	height := int(math.Ceil(icon.ViewBox.H))
+	if width < 1 || height < 1 {
+		return nil, ErrInvalidSVG
+	}
+
+	s := &SVGImage{icon: icon}
+	s.Image = s.Rasterize(width, height)
+
+	return s, nil
+}
+
+// Rasterize SVG to fill width and height
+func (s *SVGImage) Rasterize(width int, height int) image.Image {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	scanner := rasterx.NewScannerGV(width, height, img, img.Bounds())
+	s.icon.SetTarget(0, 0, float64(width), float64(height))
+	s.icon.Draw(rasterx.NewDasher(width, height, scanner), 1)
+
+	return img
+}
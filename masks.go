@@ -0,0 +1,49 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/neko-neko/lgtmgen/mask_image"
+	"text/tabwriter"
+)
+
+// List available mask styles
+func (cli *CLI) runMasks(args []string) int {
+	flags := flag.NewFlagSet(Name+" masks", flag.ContinueOnError)
+	flags.SetOutput(cli.errStream)
+	if err := flags.Parse(args[1:]); err != nil {
+		return ExitCodeError
+	}
+
+	w := tabwriter.NewWriter(cli.outStream, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSIZE\tDESCRIPTION")
+	for _, style := range mask_image.Styles() {
+		size := "-"
+		if s, err := style.Size(); err == nil {
+			size = fmt.Sprintf("%dx%d", s.X, s.Y)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", style.Name, size, style.Description)
+	}
+	w.Flush()
+
+	return ExitCodeOK
+}
@@ -0,0 +1,135 @@
+package metadata
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io/ioutil"
+	"sort"
+)
+
+// maxICCChunk is bytes of ICC profile in APP2 segment
+// segment length, identifier and sequence numbers take 2, 12 and 2 bytes
+const maxICCChunk = 0xffff - 2 - 12 - 2
+
+// Read ICC profile of JPEG or PNG data
+// nil is returned if data has no profile
+func ReadICC(data []byte) []byte {
+	if bytes.HasPrefix(data, pngSignature) {
+		return readPNGICC(data)
+	}
+	m, err := ReadJPEG(data)
+	if err != nil {
+		return nil
+	}
+
+	// profile may be split into segments of sequence numbers
+	type chunk struct {
+		sequence byte
+		data     []byte
+	}
+	var chunks []chunk
+	for _, segment := range m.Segments {
+		payload := segment[4:]
+		if segment[1] != markerAPP2 || !bytes.HasPrefix(payload, iccHeader) || len(payload) < len(iccHeader)+2 {
+			continue
+		}
+		chunks = append(chunks, chunk{payload[len(iccHeader)], payload[len(iccHeader)+2:]})
+	}
+	sort.SliceStable(chunks, func(i, j int) bool { return chunks[i].sequence < chunks[j].sequence })
+
+	var profile []byte
+	for _, c := range chunks {
+		profile = append(profile, c.data...)
+	}
+
+	return profile
+}
+
+// Read profile of iCCP chunk before image data
+func readPNGICC(data []byte) []byte {
+	for i := len(pngSignature); i+8 <= len(data); {
+		length := int(binary.BigEndian.Uint32(data[i : i+4]))
+		kind := string(data[i+4 : i+8])
+		end := i + 12 + length
+		if kind == "IDAT" || end > len(data) {
+			return nil
+		}
+		if kind == "iCCP" {
+			// profile name, NUL and compression method precede compressed profile
+			chunk := data[i+8 : i+8+length]
+			name := bytes.IndexByte(chunk, 0)
+			if name < 0 || name+2 > len(chunk) {
+				return nil
+			}
+			r, err := zlib.NewReader(bytes.NewReader(chunk[name+2:]))
+			if err != nil {
+				return nil
+			}
+			profile, err := ioutil.ReadAll(r)
+			if err != nil {
+				return nil
+			}
+			return profile
+		}
+		i = end
+	}
+
+	return nil
+}
+
+// Embed ICC profile in JPEG or PNG data
+// profile of JPEG is put in APP2 segments after SOI, and of PNG in iCCP chunk after IHDR
+// data of other formats is returned as is
+func WriteICC(data []byte, profile []byte) []byte {
+	var at int
+	var block []byte
+	switch {
+	case bytes.HasPrefix(data, pngSignature):
+		var compressed bytes.Buffer
+		w := zlib.NewWriter(&compressed)
+		w.Write(profile)
+		w.Close()
+		at = len(pngSignature) + 25
+		block = pngChunk("iCCP", append([]byte("ICC Profile\x00\x00"), compressed.Bytes()...))
+	case len(data) >= 2 && data[0] == 0xff && data[1] == markerSOI:
+		at = 2
+		count := (len(profile) + maxICCChunk - 1) / maxICCChunk
+		for i := 0; i < count; i++ {
+			chunk := profile[i*maxICCChunk:]
+			if len(chunk) > maxICCChunk {
+				chunk = chunk[:maxICCChunk]
+			}
+			segment := []byte{0xff, markerAPP2, 0, 0}
+			segment = append(segment, iccHeader...)
+			segment = append(segment, byte(i+1), byte(count))
+			segment = append(segment, chunk...)
+			binary.BigEndian.PutUint16(segment[2:4], uint16(len(segment)-2))
+			block = append(block, segment...)
+		}
+	default:
+		return data
+	}
+	if at > len(data) {
+		return data
+	}
+
+	embedded := make([]byte, 0, len(data)+len(block))
+	embedded = append(embedded, data[:at]...)
+	embedded = append(embedded, block...)
+
+	return append(embedded, data[at:]...)
+}
+
+// Remove ICC profile segments
+// used when pixels are converted to other profile
+func (m *Metadata) RemoveICC() {
+	var segments [][]byte
+	for _, segment := range m.Segments {
+		if segment[1] == markerAPP2 && bytes.HasPrefix(segment[4:], iccHeader) {
+			continue
+		}
+		segments = append(segments, segment)
+	}
+	m.Segments = segments
+}
@@ -0,0 +1,162 @@
+package metadata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+)
+
+// Marker is embedded in outputs to tell them from sources
+// PNG has tEXt chunk "Software", JPEG has COM segment and GIF has comment extension
+const Marker = "lgtmgen"
+
+// markerKeyword is keyword of PNG tEXt chunk of marker
+const markerKeyword = "Software"
+
+// JPEG marker of comment segment
+const markerCOM = 0xfe
+
+// Signatures of formats which can be marked
+var (
+	pngSignature = []byte("\x89PNG\r\n\x1a\n")
+	gifSignature = []byte("GIF89a")
+)
+
+// Embed marker in PNG, JPEG or GIF data
+// marker is put in header, so it is found without reading whole file
+// data of other formats is returned as is
+func Mark(data []byte) []byte {
+	var at int
+	var block []byte
+	switch {
+	case bytes.HasPrefix(data, pngSignature):
+		// after IHDR chunk which must be first
+		at = len(pngSignature) + 25
+		block = pngChunk("tEXt", []byte(markerKeyword+"\x00"+Marker))
+	case len(data) >= 2 && data[0] == 0xff && data[1] == markerSOI:
+		at = 2
+		block = append([]byte{0xff, markerCOM, 0, 0}, Marker...)
+		binary.BigEndian.PutUint16(block[2:4], uint16(2+len(Marker)))
+	case bytes.HasPrefix(data, gifSignature):
+		at = gifHeaderSize(data)
+		block = append([]byte{0x21, 0xfe, byte(len(Marker))}, Marker...)
+		block = append(block, 0)
+	default:
+		return data
+	}
+	if at > len(data) {
+		return data
+	}
+
+	marked := make([]byte, 0, len(data)+len(block))
+	marked = append(marked, data[:at]...)
+	marked = append(marked, block...)
+
+	return append(marked, data[at:]...)
+}
+
+// Data has marker
+// data may be first bytes of file, e.g. 64KB
+func IsMarked(data []byte) bool {
+	switch {
+	case bytes.HasPrefix(data, pngSignature):
+		return isMarkedPNG(data)
+	case len(data) >= 2 && data[0] == 0xff && data[1] == markerSOI:
+		return isMarkedJPEG(data)
+	case bytes.HasPrefix(data, gifSignature):
+		return isMarkedGIF(data)
+	}
+
+	return false
+}
+
+// Build PNG chunk of type and data with its CRC
+func pngChunk(kind string, data []byte) []byte {
+	chunk := make([]byte, 8, 12+len(data))
+	binary.BigEndian.PutUint32(chunk[:4], uint32(len(data)))
+	copy(chunk[4:8], kind)
+	chunk = append(chunk, data...)
+
+	return binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+}
+
+// Find tEXt chunk of marker before image data
+func isMarkedPNG(data []byte) bool {
+	text := []byte(markerKeyword + "\x00" + Marker)
+	for i := len(pngSignature); i+8 <= len(data); {
+		length := int(binary.BigEndian.Uint32(data[i : i+4]))
+		kind := string(data[i+4 : i+8])
+		end := i + 12 + length
+		if kind == "IDAT" || length < 0 || end > len(data) {
+			return false
+		}
+		if kind == "tEXt" && bytes.Equal(data[i+8:i+8+length], text) {
+			return true
+		}
+		i = end
+	}
+
+	return false
+}
+
+// Find COM segment of marker before image data
+func isMarkedJPEG(data []byte) bool {
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xff {
+			return false
+		}
+		marker := data[i+1]
+		if marker == 0xff {
+			i++
+			continue
+		}
+		if marker == markerSOS || marker == markerEOI {
+			return false
+		}
+		end := i + 2 + int(binary.BigEndian.Uint16(data[i+2:i+4]))
+		if end > len(data) {
+			return false
+		}
+		if marker == markerCOM && string(data[i+4:end]) == Marker {
+			return true
+		}
+		i = end
+	}
+
+	return false
+}
+
+// Find comment extension of marker before first image
+func isMarkedGIF(data []byte) bool {
+	for i := gifHeaderSize(data); i+2 <= len(data) && data[i] == 0x21; {
+		label := data[i+1]
+		var comment []byte
+
+		// skip sub-blocks of extension
+		i += 2
+		for i < len(data) && data[i] != 0 {
+			end := i + 1 + int(data[i])
+			if end > len(data) {
+				return false
+			}
+			comment = append(comment, data[i+1:end]...)
+			i = end
+		}
+		if label == 0xfe && string(comment) == Marker {
+			return true
+		}
+		i++
+	}
+
+	return false
+}
+
+// Get size of GIF header, logical screen descriptor and global color table
+func gifHeaderSize(data []byte) int {
+	size := 13
+	if len(data) >= size && data[10]&0x80 != 0 {
+		size += 3 << (data[10]&0x07 + 1)
+	}
+
+	return size
+}
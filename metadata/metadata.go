@@ -0,0 +1,238 @@
+// Package metadata copies EXIF, XMP and ICC profile between JPEG images and marks outputs.
+package metadata
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"time"
+)
+
+// JPEG markers
+const (
+	markerSOI  = 0xd8
+	markerSOS  = 0xda
+	markerEOI  = 0xd9
+	markerAPP1 = 0xe1
+	markerAPP2 = 0xe2
+)
+
+// Identifiers of metadata segments
+var (
+	exifHeader = []byte("Exif\x00\x00")
+	xmpHeader  = []byte("http://ns.adobe.com/xap/1.0/\x00")
+	iccHeader  = []byte("ICC_PROFILE\x00")
+)
+
+// EXIF tags
+const (
+	// orientationTag is image orientation of IFD0
+	orientationTag = 0x0112
+
+	// dateTimeTag is modification date of IFD0
+	dateTimeTag = 0x0132
+
+	// exifIFDTag is offset of EXIF IFD in IFD0
+	exifIFDTag = 0x8769
+
+	// dateTimeOriginalTag is capture date of EXIF IFD
+	dateTimeOriginalTag = 0x9003
+)
+
+// exifTimeLayout is layout of EXIF dates
+const exifTimeLayout = "2006:01:02 15:04:05"
+
+var (
+	// ErrNotJPEG is returned when data is not JPEG
+	ErrNotJPEG = errors.New("not a JPEG image")
+
+	// ErrNoDate is returned when EXIF has no date
+	ErrNoDate = errors.New("no date in EXIF")
+)
+
+// Metadata is raw metadata segments of JPEG
+type Metadata struct {
+	// Segments include marker and length
+	Segments [][]byte
+}
+
+// Read metadata segments from JPEG data
+func ReadJPEG(data []byte) (*Metadata, error) {
+	if len(data) < 2 || data[0] != 0xff || data[1] != markerSOI {
+		return nil, ErrNotJPEG
+	}
+
+	m := &Metadata{}
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xff {
+			return nil, ErrNotJPEG
+		}
+		marker := data[i+1]
+
+		// fill bytes
+		if marker == 0xff {
+			i++
+			continue
+		}
+		// image data starts
+		if marker == markerSOS || marker == markerEOI {
+			break
+		}
+
+		length := int(binary.BigEndian.Uint16(data[i+2 : i+4]))
+		end := i + 2 + length
+		if length < 2 || end > len(data) {
+			return nil, ErrNotJPEG
+		}
+
+		payload := data[i+4 : end]
+		if isMetadata(marker, payload) {
+			segment := make([]byte, end-i)
+			copy(segment, data[i:end])
+			m.Segments = append(m.Segments, segment)
+		}
+		i = end
+	}
+
+	return m, nil
+}
+
+// Segment is EXIF, XMP or ICC profile
+func isMetadata(marker byte, payload []byte) bool {
+	switch marker {
+	case markerAPP1:
+		return bytes.HasPrefix(payload, exifHeader) || bytes.HasPrefix(payload, xmpHeader)
+	case markerAPP2:
+		return bytes.HasPrefix(payload, iccHeader)
+	}
+
+	return false
+}
+
+// Insert metadata segments into JPEG data after SOI marker
+func (m *Metadata) WriteJPEG(data []byte) ([]byte, error) {
+	if len(data) < 2 || data[0] != 0xff || data[1] != markerSOI {
+		return nil, ErrNotJPEG
+	}
+
+	var buf bytes.Buffer
+	buf.Write(data[:2])
+	for _, segment := range m.Segments {
+		buf.Write(segment)
+	}
+	buf.Write(data[2:])
+
+	return buf.Bytes(), nil
+}
+
+// Set EXIF orientation to normal
+// used when pixels are already rotated
+func (m *Metadata) ResetOrientation() {
+	for _, segment := range m.Segments {
+		if segment[1] != markerAPP1 || !bytes.HasPrefix(segment[4:], exifHeader) {
+			continue
+		}
+		resetOrientation(segment[4+len(exifHeader):])
+	}
+}
+
+// Get capture date of EXIF
+// DateTimeOriginal is used, or DateTime of IFD0 if it is missing
+// EXIF date has no time zone, so it is read as local time
+func (m *Metadata) CaptureTime() (time.Time, error) {
+	for _, segment := range m.Segments {
+		if segment[1] != markerAPP1 || !bytes.HasPrefix(segment[4:], exifHeader) {
+			continue
+		}
+		if t, ok := captureTime(segment[4+len(exifHeader):]); ok {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, ErrNoDate
+}
+
+// Rewrite orientation entry of IFD0 in TIFF structure
+func resetOrientation(tiff []byte) {
+	order := byteOrder(tiff)
+	if order == nil {
+		return
+	}
+
+	if entry, ok := findEntry(tiff, order, int(order.Uint32(tiff[4:8])), orientationTag); ok {
+		order.PutUint16(tiff[entry+8:entry+10], 1)
+	}
+}
+
+// Read capture date of TIFF structure
+func captureTime(tiff []byte) (time.Time, bool) {
+	order := byteOrder(tiff)
+	if order == nil {
+		return time.Time{}, false
+	}
+
+	ifd0 := int(order.Uint32(tiff[4:8]))
+	if entry, ok := findEntry(tiff, order, ifd0, exifIFDTag); ok {
+		exif := int(order.Uint32(tiff[entry+8 : entry+12]))
+		if t, ok := dateValue(tiff, order, exif, dateTimeOriginalTag); ok {
+			return t, true
+		}
+	}
+
+	return dateValue(tiff, order, ifd0, dateTimeTag)
+}
+
+// Get byte order of TIFF structure, nil if it is not TIFF
+func byteOrder(tiff []byte) binary.ByteOrder {
+	if len(tiff) < 8 {
+		return nil
+	}
+	switch string(tiff[:2]) {
+	case "II":
+		return binary.LittleEndian
+	case "MM":
+		return binary.BigEndian
+	}
+
+	return nil
+}
+
+// Find entry of tag in IFD at offset
+// offset of 12 bytes entry is returned
+func findEntry(tiff []byte, order binary.ByteOrder, offset int, tag uint16) (int, bool) {
+	if offset < 8 || offset+2 > len(tiff) {
+		return 0, false
+	}
+	count := int(order.Uint16(tiff[offset : offset+2]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 0, false
+		}
+		if order.Uint16(tiff[entry:entry+2]) == tag {
+			return entry, true
+		}
+	}
+
+	return 0, false
+}
+
+// Read ASCII date of tag in IFD at offset
+// date of 19 characters and NUL does not fit in entry, so value is at its offset
+func dateValue(tiff []byte, order binary.ByteOrder, offset int, tag uint16) (time.Time, bool) {
+	entry, ok := findEntry(tiff, order, offset, tag)
+	if !ok {
+		return time.Time{}, false
+	}
+	count := int(order.Uint32(tiff[entry+4 : entry+8]))
+	start := int(order.Uint32(tiff[entry+8 : entry+12]))
+	if count <= 4 || start < 0 || start+count > len(tiff) {
+		return time.Time{}, false
+	}
+
+	value := strings.TrimRight(string(tiff[start:start+count]), "\x00 ")
+	t, err := time.ParseInLocation(exifTimeLayout, value, time.Local)
+
+	return t, err == nil
+}
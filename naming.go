@@ -0,0 +1,312 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/neko-neko/lgtmgen/fetcher"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/metadata"
+	"github.com/neko-neko/lgtmgen/report"
+	"github.com/neko-neko/lgtmgen/storage"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// HashLength is number of hex digits of {{.Hash}}
+const HashLength = 8
+
+// HashNaming is name of naming outputs by SHA-256 of their content
+// "hash:N" uses first N hex digits
+const HashNaming = "hash"
+
+// DatePartition is name of putting outputs into YYYY/MM/DD directories
+// by EXIF capture date or modification time of input
+const DatePartition = "date"
+
+// headerReadLimit is bytes of input read to find EXIF or marker of output
+// EXIF is stored in APP1 segment of 64KB at most near start of JPEG
+const headerReadLimit = 256 * 1024
+
+// pendingPrefix is added to output which is written before it is named by its content
+const pendingPrefix = ".lgtmgen-pending-"
+
+var (
+	// ErrNamingConflict is returned when name template is given with prefix or suffix
+	ErrNamingConflict = errors.New("name template can not be combined with prefix or suffix")
+
+	// ErrHashNamingConflict is returned when content hash naming is given with name template
+	ErrHashNamingConflict = errors.New("hash name can not be combined with name template")
+)
+
+// naming decides output file name of input file
+type naming struct {
+	// format replaces extension if given
+	format string
+
+	// prefix and suffix are added to base name
+	// e.g. prefix="lgtm_" => lgtm_cat.jpg, suffix="_lgtm" => cat_lgtm.jpg
+	prefix string
+	suffix string
+
+	// template renders whole file name instead of prefix and suffix
+	template *template.Template
+
+	// hashLength is number of hex digits of SHA-256 of output which replaces base name
+	// 0 names output by input
+	hashLength int
+
+	// partition adds date directory before directory part of name
+	// e.g. "date" => 2024/05/01/cat.jpg
+	partition string
+}
+
+// nameData are variables of name template
+// e.g. "{{.Base}}-{{.Hash}}{{.Ext}}" => "cat-3f2a9c1e.jpg"
+type nameData struct {
+	// Base is input file name without extension
+	Base string
+
+	// Ext is output extension with dot
+	Ext string
+
+	// Date is date of run in YYYYMMDD
+	Date string
+
+	// Index is 1-based order of input in batch
+	Index int
+
+	// source is input file path or URL
+	source string
+}
+
+// Hash is first digits of SHA-256 of input file, or of URL for remote input
+func (d nameData) Hash() (string, error) {
+	data := []byte(d.source)
+	if !fetcher.IsURL(d.source) && !storage.IsRemote(d.source) {
+		var err error
+		if data, err = ioutil.ReadFile(d.source); err != nil {
+			return "", err
+		}
+	}
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])[:HashLength], nil
+}
+
+// constructor
+// name is empty or hash naming, e.g. "hash", "hash:12"
+// partition is empty or "date"
+func newNaming(format string, prefix string, suffix string, nameTemplate string, name string, partition string) (naming, error) {
+	n := naming{format: format, prefix: prefix, suffix: suffix}
+	if partition != "" && partition != DatePartition {
+		return n, fmt.Errorf("unknown partition %s(%s)", partition, DatePartition)
+	}
+	n.partition = partition
+	if name != "" {
+		length, err := parseHashNaming(name)
+		if err != nil {
+			return n, err
+		}
+		if nameTemplate != "" {
+			return n, ErrHashNamingConflict
+		}
+		n.hashLength = length
+	}
+	if nameTemplate == "" {
+		return n, nil
+	}
+	if prefix != "" || suffix != "" {
+		return n, ErrNamingConflict
+	}
+
+	t, err := template.New("name").Parse(nameTemplate)
+	if err != nil {
+		return n, err
+	}
+	n.template = t
+
+	return n, nil
+}
+
+// Parse hash naming into number of hex digits
+// e.g. "hash" => 64, "hash:12" => 12
+func parseHashNaming(name string) (int, error) {
+	digits := sha256.Size * 2
+	kind, length, ok := strings.Cut(name, ":")
+	if kind != HashNaming {
+		return 0, fmt.Errorf("unknown name %s(%s or %s:N)", name, HashNaming, HashNaming)
+	}
+	if !ok {
+		return digits, nil
+	}
+	n, err := strconv.Atoi(length)
+	if err != nil || n < 1 || n > digits {
+		return 0, fmt.Errorf("length of hash name must be between 1 and %d", digits)
+	}
+
+	return n, nil
+}
+
+// Get output file name of input file name
+// directory part of name is kept, source and index are used by name template
+// output of hash naming is pending until it is renamed by its content
+func (n naming) filename(name string, source string, index int) (string, error) {
+	name = lgtm.OutputFilename(name, n.format)
+	dir, base := filepath.Split(name)
+	ext := filepath.Ext(base)
+	if n.partition == DatePartition {
+		dir = sourceTime(source).Format("2006/01/02") + "/" + dir
+	}
+	if n.hashLength > 0 {
+		return dir + pendingPrefix + base, nil
+	}
+	if n.template == nil {
+		return dir + n.prefix + strings.TrimSuffix(base, ext) + n.suffix + ext, nil
+	}
+
+	var buf bytes.Buffer
+	err := n.template.Execute(&buf, nameData{
+		Base:   strings.TrimSuffix(base, ext),
+		Ext:    ext,
+		Date:   time.Now().Format("20060102"),
+		Index:  index,
+		source: source,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return dir + buf.String(), nil
+}
+
+// Get capture date of source from EXIF, or its modification time
+// URL, storage object and clipboard are dated by now
+func sourceTime(source string) time.Time {
+	if fetcher.IsURL(source) || storage.IsRemote(source) {
+		return time.Now()
+	}
+	file, err := os.Open(source)
+	if err != nil {
+		return time.Now()
+	}
+	defer file.Close()
+
+	header, _ := ioutil.ReadAll(io.LimitReader(file, headerReadLimit))
+	if m, err := metadata.ReadJPEG(header); err == nil {
+		if t, err := m.CaptureTime(); err == nil {
+			return t
+		}
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return time.Now()
+	}
+
+	return info.ModTime()
+}
+
+// Get name of pending output by hash of its content
+// e.g. ".lgtmgen-pending-cat.jpg" => "lgtm_3f2a9c1e.jpg" with prefix "lgtm_" and 8 digits
+func (n naming) contentName(pending string, hash []byte) string {
+	dir, base := filepath.Split(pending)
+
+	return dir + n.prefix + hex.EncodeToString(hash)[:n.hashLength] + n.suffix + filepath.Ext(base)
+}
+
+// Get name of pending output shown in dry run
+// e.g. ".lgtmgen-pending-cat.jpg" => "lgtm_<sha256>.jpg"
+func (n naming) planned(name string) string {
+	dir, base := filepath.Split(name)
+	if n.hashLength == 0 || !strings.HasPrefix(base, pendingPrefix) {
+		return name
+	}
+
+	return dir + n.prefix + "<sha256>" + n.suffix + filepath.Ext(base)
+}
+
+// Rename pending output of successful result by hash of its content
+// identical output of previous run is replaced by same content
+func (n naming) renameResult(result *report.Result) {
+	if n.hashLength == 0 || result.Status != report.StatusSuccess {
+		return
+	}
+
+	hash, err := fileHash(result.Output)
+	if err == nil {
+		name := n.contentName(result.Output, hash)
+		if err = os.Rename(result.Output, name); err == nil {
+			result.Output = name
+			return
+		}
+	}
+	os.Remove(result.Output)
+	result.Status, result.Error = report.StatusFailed, err
+}
+
+// Get SHA-256 of file
+func fileHash(path string) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
+
+// File name looks like output of this naming
+// outputs written next to inputs are not processed again
+func (n naming) isOutput(name string) bool {
+	base := filepath.Base(name)
+	if n.hashLength > 0 {
+		return strings.HasPrefix(base, pendingPrefix) || n.isHashName(base)
+	}
+	if n.prefix == "" && n.suffix == "" {
+		return false
+	}
+
+	return strings.HasPrefix(base, n.prefix) && strings.HasSuffix(strings.TrimSuffix(base, filepath.Ext(base)), n.suffix)
+}
+
+// Base name is prefix, hex digits of hash and suffix
+func (n naming) isHashName(base string) bool {
+	name := strings.TrimSuffix(base, filepath.Ext(base))
+	if !strings.HasPrefix(name, n.prefix) || !strings.HasSuffix(name, n.suffix) || len(name) != len(n.prefix)+n.hashLength+len(n.suffix) {
+		return false
+	}
+	_, err := hex.DecodeString(strings.TrimSuffix(strings.TrimPrefix(name, n.prefix), n.suffix) + strings.Repeat("0", n.hashLength%2))
+
+	return err == nil
+}
@@ -0,0 +1,55 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"github.com/neko-neko/lgtmgen/report"
+	"os/exec"
+	"runtime"
+)
+
+// Get command opening path in default viewer of platform
+func openCommand(path string) *exec.Cmd {
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", path)
+	case "windows":
+		// not through cmd.exe which parses & of file name as command separator
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", path)
+	default:
+		return exec.Command("xdg-open", path)
+	}
+}
+
+// Open output of successful result in default viewer
+// viewer keeps running after lgtmgen quits, so it is not waited
+func (cli *CLI) openResult(result *report.Result) {
+	if result.Status != report.StatusSuccess || result.Output == "" {
+		return
+	}
+
+	cmd := openCommand(result.Output)
+	if err := cmd.Start(); err != nil {
+		cli.log.Warnf("[open: %s] %s", err, result.Output)
+		return
+	}
+	cli.log.Debugf("[open] %s %s", cmd.Path, result.Output)
+	go cmd.Wait()
+}
@@ -0,0 +1,42 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"github.com/disintegration/imaging"
+	"github.com/neko-neko/lgtmgen/preview"
+	"github.com/neko-neko/lgtmgen/storage"
+)
+
+// Render local output image on terminal
+// remote and clipboard outputs have no file to read
+func previewOutput(mode preview.Mode) func(output string) (string, error) {
+	return func(output string) (string, error) {
+		if output == ClipboardName || storage.IsRemote(output) {
+			return "", nil
+		}
+		img, err := imaging.Open(output)
+		if err != nil {
+			return "", err
+		}
+
+		return preview.Render(img, mode, preview.DefaultColumns)
+	}
+}
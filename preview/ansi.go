@@ -0,0 +1,38 @@
+package preview
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+)
+
+// upperHalfBlock is drawn with top pixel as foreground and bottom pixel as background
+const upperHalfBlock = "▀"
+
+// Write image by half blocks, a cell is two vertical pixels
+// transparent pixels are drawn on black
+func writeANSI(buf *bytes.Buffer, img *image.NRGBA) {
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y += 2 {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			top := opaque(img.NRGBAAt(x, y))
+			bottom := color.NRGBA{A: 0xff}
+			if y+1 < bounds.Max.Y {
+				bottom = opaque(img.NRGBAAt(x, y+1))
+			}
+			fmt.Fprintf(buf, "\x1b[38;2;%d;%d;%dm\x1b[48;2;%d;%d;%dm%s", top.R, top.G, top.B, bottom.R, bottom.G, bottom.B, upperHalfBlock)
+		}
+		buf.WriteString("\x1b[0m\n")
+	}
+}
+
+// Composite color on black
+func opaque(c color.NRGBA) color.NRGBA {
+	return color.NRGBA{
+		R: uint8(uint16(c.R) * uint16(c.A) / 0xff),
+		G: uint8(uint16(c.G) * uint16(c.A) / 0xff),
+		B: uint8(uint16(c.B) * uint16(c.A) / 0xff),
+		A: 0xff,
+	}
+}
@@ -0,0 +1,38 @@
+package preview
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/png"
+)
+
+// kittyChunkSize is maximum payload size of an escape sequence
+const kittyChunkSize = 4096
+
+// Write PNG by kitty graphics protocol
+// terminal scales image to columns
+func writeKitty(buf *bytes.Buffer, img image.Image, columns int) error {
+	var data bytes.Buffer
+	if err := png.Encode(&data, img); err != nil {
+		return err
+	}
+	payload := base64.StdEncoding.EncodeToString(data.Bytes())
+
+	for i := 0; i < len(payload); i += kittyChunkSize {
+		end := i + kittyChunkSize
+		more := 1
+		if end >= len(payload) {
+			end, more = len(payload), 0
+		}
+		if i == 0 {
+			fmt.Fprintf(buf, "\x1b_Ga=T,f=100,c=%d,m=%d;%s\x1b\\", columns, more, payload[i:end])
+		} else {
+			fmt.Fprintf(buf, "\x1b_Gm=%d;%s\x1b\\", more, payload[i:end])
+		}
+	}
+	buf.WriteString("\n")
+
+	return nil
+}
@@ -0,0 +1,76 @@
+// Package preview draws images on terminal.
+package preview
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/disintegration/imaging"
+	"image"
+	"strings"
+)
+
+// Mode is terminal graphics protocol
+type Mode string
+
+const (
+	// ModeANSI draws two pixels per cell by 24-bit color half blocks
+	ModeANSI Mode = "ansi"
+
+	// ModeKitty sends PNG by kitty graphics protocol
+	ModeKitty Mode = "kitty"
+
+	// ModeSixel draws 256 colors sixel graphics
+	ModeSixel Mode = "sixel"
+)
+
+// DefaultColumns is preview width in terminal cells
+const DefaultColumns = 60
+
+// Mode names
+func Modes() []string {
+	return []string{string(ModeANSI), string(ModeKitty), string(ModeSixel)}
+}
+
+// Parse mode name
+func ParseMode(s string) (Mode, error) {
+	mode := Mode(strings.ToLower(strings.TrimSpace(s)))
+	switch mode {
+	case ModeANSI, ModeKitty, ModeSixel:
+		return mode, nil
+	}
+
+	return "", fmt.Errorf("unknown preview mode %s", s)
+}
+
+// Render image in mode fitted to width of columns
+// image is never enlarged
+func Render(img image.Image, mode Mode, columns int) (string, error) {
+	if columns <= 0 {
+		columns = DefaultColumns
+	}
+
+	var buf bytes.Buffer
+	switch mode {
+	case ModeANSI:
+		writeANSI(&buf, fitWidth(img, columns))
+	case ModeKitty:
+		if err := writeKitty(&buf, img, columns); err != nil {
+			return "", err
+		}
+	case ModeSixel:
+		writeSixel(&buf, fitWidth(img, columns*sixelCellWidth))
+	default:
+		return "", fmt.Errorf("unknown preview mode %s", mode)
+	}
+
+	return buf.String(), nil
+}
+
+// Downscale image to width keeping aspect ratio
+func fitWidth(img image.Image, width int) *image.NRGBA {
+	if img.Bounds().Dx() <= width {
+		return imaging.Clone(img)
+	}
+
+	return imaging.Resize(img, width, 0, imaging.Box)
+}
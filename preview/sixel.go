@@ -0,0 +1,77 @@
+package preview
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color/palette"
+	"image/draw"
+)
+
+// sixelCellWidth is assumed pixel width of a terminal cell
+const sixelCellWidth = 10
+
+// Write image as sixel graphics in Plan 9 palette
+// transparent pixels are drawn on black
+func writeSixel(buf *bytes.Buffer, img *image.NRGBA) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	background := image.NewNRGBA(bounds)
+	draw.Draw(background, bounds, image.Black, image.Point{}, draw.Src)
+	draw.Draw(background, bounds, img, bounds.Min, draw.Over)
+	paletted := image.NewPaletted(image.Rect(0, 0, width, height), palette.Plan9)
+	draw.FloydSteinberg.Draw(paletted, paletted.Bounds(), background, bounds.Min)
+
+	fmt.Fprintf(buf, "\x1bPq\"1;1;%d;%d", width, height)
+	for i, c := range paletted.Palette {
+		r, g, b, _ := c.RGBA()
+		fmt.Fprintf(buf, "#%d;2;%d;%d;%d", i, r*100/0xffff, g*100/0xffff, b*100/0xffff)
+	}
+
+	// each band is six rows, a color is drawn over band at a time
+	row := make([]byte, width)
+	for top := 0; top < height; top += 6 {
+		used := map[uint8]bool{}
+		for y := top; y < top+6 && y < height; y++ {
+			for x := 0; x < width; x++ {
+				used[paletted.ColorIndexAt(x, y)] = true
+			}
+		}
+		for index := 0; index < len(paletted.Palette); index++ {
+			if !used[uint8(index)] {
+				continue
+			}
+			for x := 0; x < width; x++ {
+				var bits byte
+				for dy := 0; dy < 6 && top+dy < height; dy++ {
+					if paletted.ColorIndexAt(x, top+dy) == uint8(index) {
+						bits |= 1 << uint(dy)
+					}
+				}
+				row[x] = '?' + bits
+			}
+			fmt.Fprintf(buf, "#%d", index)
+			writeSixelRun(buf, row)
+			buf.WriteByte('$')
+		}
+		buf.WriteByte('-')
+	}
+	buf.WriteString("\x1b\\\n")
+}
+
+// Write sixel characters with run-length encoding
+func writeSixelRun(buf *bytes.Buffer, row []byte) {
+	for i := 0; i < len(row); {
+		j := i
+		for j < len(row) && row[j] == row[i] {
+			j++
+		}
+		if n := j - i; n > 3 {
+			fmt.Fprintf(buf, "!%d%c", n, row[i])
+		} else {
+			buf.Write(row[i:j])
+		}
+		i = j
+	}
+}
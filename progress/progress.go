@@ -0,0 +1,123 @@
+// Package progress renders progress bar of batch processing.
+package progress
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Width is number of bar characters
+const Width = 30
+
+// Bar is progress bar written on a terminal line
+// Bar is also io.Writer to print messages above the bar
+type Bar struct {
+	out   io.Writer
+	total int
+	done  int
+	start time.Time
+	mu    sync.Mutex
+}
+
+// constructor
+func NewBar(out io.Writer, total int) *Bar {
+	b := &Bar{
+		out:   out,
+		total: total,
+		start: time.Now(),
+	}
+	b.render()
+
+	return b
+}
+
+// Count up processed items
+func (b *Bar) Increment() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.done++
+	b.render()
+}
+
+// Write message without breaking bar
+func (b *Bar) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.clear()
+	n, err := b.out.Write(p)
+	if !bytes.HasSuffix(p, []byte("\n")) {
+		fmt.Fprintln(b.out)
+	}
+	b.render()
+
+	return n, err
+}
+
+// Finish bar and move to next line
+func (b *Bar) Finish() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	fmt.Fprintln(b.out)
+}
+
+// Clear current line
+func (b *Bar) clear() {
+	fmt.Fprint(b.out, "\r\033[K")
+}
+
+// Draw bar
+// e.g.
+// [=============>                ] 12/25 ETA 8s
+func (b *Bar) render() {
+	ratio := 1.0
+	if b.total > 0 {
+		ratio = float64(b.done) / float64(b.total)
+	}
+
+	filled := int(ratio * Width)
+	bar := strings.Repeat("=", filled)
+	if filled < Width {
+		bar += ">" + strings.Repeat(" ", Width-filled-1)
+	}
+
+	b.clear()
+	fmt.Fprintf(b.out, "[%s] %d/%d %s", bar, b.done, b.total, b.eta())
+}
+
+// Estimate remaining time from average time per item
+func (b *Bar) eta() string {
+	if b.done == 0 {
+		return "ETA --"
+	}
+	if b.done >= b.total {
+		return "done in " + time.Since(b.start).Round(time.Second).String()
+	}
+
+	elapsed := time.Since(b.start)
+	remaining := elapsed / time.Duration(b.done) * time.Duration(b.total-b.done)
+
+	return "ETA " + remaining.Round(time.Second).String()
+}
+
+// Writer is a terminal
+func IsTerminal(w io.Writer) bool {
+	file, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,155 @@
+// Package provider finds random source images from image services.
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// DefaultTimeout is timeout of API request
+const DefaultTimeout = 10 * time.Second
+
+// Provider finds random image
+type Provider interface {
+	// RandomImageURL returns url of random image matching query
+	RandomImageURL(query string) (string, error)
+}
+
+// factories are registered providers by name
+var factories = map[string]func(apiKey string, client *http.Client) Provider{
+	"picsum":   newPicsum,
+	"unsplash": newUnsplash,
+	"giphy":    newGiphy,
+}
+
+// constructor
+func NewProvider(name string, apiKey string) (Provider, error) {
+	factory, ok := factories[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown provider %s", name)
+	}
+
+	return factory(apiKey, &http.Client{Timeout: DefaultTimeout}), nil
+}
+
+// Get registered provider names
+func Names() []string {
+	var names []string
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Request API and decode JSON response
+func getJSON(client *http.Client, req *http.Request, v interface{}) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s: %s", req.Method, req.URL.Host, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// Lorem Picsum does not need API key
+type picsum struct{}
+
+func newPicsum(apiKey string, client *http.Client) Provider {
+	return &picsum{}
+}
+
+// Picsum redirects to random image
+func (p *picsum) RandomImageURL(query string) (string, error) {
+	return "https://picsum.photos/640/480", nil
+}
+
+// Unsplash needs access key
+type unsplash struct {
+	apiKey string
+	client *http.Client
+}
+
+func newUnsplash(apiKey string, client *http.Client) Provider {
+	return &unsplash{apiKey: apiKey, client: client}
+}
+
+func (p *unsplash) RandomImageURL(query string) (string, error) {
+	if p.apiKey == "" {
+		return "", fmt.Errorf("unsplash requires API key")
+	}
+
+	params := url.Values{}
+	if query != "" {
+		params.Set("query", query)
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://api.unsplash.com/photos/random?"+params.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Client-ID "+p.apiKey)
+	req.Header.Set("Accept-Version", "v1")
+
+	var photo struct {
+		URLs struct {
+			Regular string `json:"regular"`
+		} `json:"urls"`
+	}
+	if err := getJSON(p.client, req, &photo); err != nil {
+		return "", err
+	}
+
+	return photo.URLs.Regular, nil
+}
+
+// Giphy needs API key
+type giphy struct {
+	apiKey string
+	client *http.Client
+}
+
+func newGiphy(apiKey string, client *http.Client) Provider {
+	return &giphy{apiKey: apiKey, client: client}
+}
+
+func (p *giphy) RandomImageURL(query string) (string, error) {
+	if p.apiKey == "" {
+		return "", fmt.Errorf("giphy requires API key")
+	}
+
+	params := url.Values{}
+	params.Set("api_key", p.apiKey)
+	params.Set("rating", "g")
+	if query != "" {
+		params.Set("tag", query)
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://api.giphy.com/v1/gifs/random?"+params.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	var random struct {
+		Data struct {
+			Images struct {
+				Original struct {
+					URL string `json:"url"`
+				} `json:"original"`
+			} `json:"images"`
+		} `json:"data"`
+	}
+	if err := getJSON(p.client, req, &random); err != nil {
+		return "", err
+	}
+
+	return random.Data.Images.Original.URL, nil
+}
@@ -0,0 +1,125 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/segmentio/kafka-go"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// kafkaQueue is Kafka topic
+// consumers of same consumer group share partitions
+type kafkaQueue struct {
+	reader *kafka.Reader
+	writer *kafka.Writer
+	topic  string
+
+	// fetched offsets of messages not committed yet in fetched order by partition
+	// an offset is committed only after all earlier offsets of its partition are done
+	mu       sync.Mutex
+	inflight map[int][]*kafkaOffset
+}
+
+// kafkaOffset is offset of fetched message
+type kafkaOffset struct {
+	offset int64
+	done   bool
+}
+
+// constructor
+// more brokers and group are given as kafka://broker1:9092/topic?brokers=broker2:9092,broker3:9092&group=name
+// reader and writer connect when they are used
+func newKafka(ctx context.Context, location *url.URL) (Queue, error) {
+	brokers := []string{location.Host}
+	if more := location.Query().Get("brokers"); more != "" {
+		brokers = append(brokers, strings.Split(more, ",")...)
+	}
+	topic := strings.Trim(location.Path, "/")
+
+	return &kafkaQueue{
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers: brokers,
+			Topic:   topic,
+			GroupID: stringOr(location.Query().Get("group"), DefaultGroup),
+		}),
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(brokers...),
+			Topic:                  topic,
+			AllowAutoTopicCreation: true,
+		},
+		topic:    topic,
+		inflight: map[int][]*kafkaOffset{},
+	}, nil
+}
+
+// Fetch message of topic
+func (q *kafkaQueue) Receive(ctx context.Context) (*Message, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, WaitTime)
+	defer cancel()
+	m, err := q.reader.FetchMessage(waitCtx)
+	if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	q.mu.Lock()
+	q.inflight[m.Partition] = append(q.inflight[m.Partition], &kafkaOffset{offset: m.Offset})
+	q.mu.Unlock()
+
+	return &Message{Body: m.Value, handle: fmt.Sprintf("%d:%d", m.Partition, m.Offset)}, nil
+}
+
+// Mark message done and commit contiguous done offsets of its partition
+// messages are processed concurrently, so the offset waits for earlier messages still in flight
+// failed message is committed too since later offsets can not be committed without it
+func (q *kafkaQueue) Done(ctx context.Context, message *Message, failed bool) error {
+	var m kafka.Message
+	if _, err := fmt.Sscanf(message.handle, "%d:%d", &m.Partition, &m.Offset); err != nil {
+		return err
+	}
+	m.Topic = q.topic
+
+	// commit under lock, so commits of a partition don't go backwards
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	offsets := q.inflight[m.Partition]
+	for _, o := range offsets {
+		if o.offset == m.Offset {
+			o.done = true
+		}
+	}
+	n := 0
+	for n < len(offsets) && offsets[n].done {
+		n++
+	}
+	if n == 0 {
+		return nil
+	}
+	m.Offset = offsets[n-1].offset
+	if err := q.reader.CommitMessages(ctx, m); err != nil {
+		return err
+	}
+	q.inflight[m.Partition] = offsets[n:]
+
+	return nil
+}
+
+// Offset of message is not committed, so it is fetched again after rebalance or restart
+// later offsets of its partition are not committed either
+func (q *kafkaQueue) Release(ctx context.Context, message *Message) error {
+	return nil
+}
+
+// Write message to topic
+func (q *kafkaQueue) Publish(ctx context.Context, body []byte) error {
+	return q.writer.WriteMessages(ctx, kafka.Message{Value: body})
+}
+
+// Close reader and writer
+func (q *kafkaQueue) Close() error {
+	return errors.Join(q.reader.Close(), q.writer.Close())
+}
@@ -0,0 +1,105 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"github.com/nats-io/nats.go"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// DefaultGroup is consumer group of NATS queue group and Kafka consumer group
+const DefaultGroup = "lgtmgen"
+
+// natsQueue is NATS subject
+// subscribers of same queue group share messages
+type natsQueue struct {
+	conn    *nats.Conn
+	subject string
+	group   string
+
+	mu  sync.Mutex
+	sub *nats.Subscription
+}
+
+// constructor
+// group is given as nats://host:4222/subject?group=name
+func newNATS(ctx context.Context, location *url.URL) (Queue, error) {
+	u := *location
+	u.Path, u.RawQuery = "", ""
+	conn, err := nats.Connect(u.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &natsQueue{
+		conn:    conn,
+		subject: strings.Trim(location.Path, "/"),
+		group:   stringOr(location.Query().Get("group"), DefaultGroup),
+	}, nil
+}
+
+// Receive message of subject
+// subscription starts at first receive, so publishing does not consume messages
+func (q *natsQueue) Receive(ctx context.Context) (*Message, error) {
+	q.mu.Lock()
+	if q.sub == nil {
+		sub, err := q.conn.QueueSubscribeSync(q.subject, q.group)
+		if err != nil {
+			q.mu.Unlock()
+			return nil, err
+		}
+		q.sub = sub
+	}
+	q.mu.Unlock()
+
+	waitCtx, cancel := context.WithTimeout(ctx, WaitTime)
+	defer cancel()
+	m, err := q.sub.NextMsgWithContext(waitCtx)
+	if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{Body: m.Data}, nil
+}
+
+// Core NATS does not redeliver messages, so there is nothing to complete
+func (q *natsQueue) Done(ctx context.Context, message *Message, failed bool) error {
+	return nil
+}
+
+// Core NATS can not return message, so it is lost
+func (q *natsQueue) Release(ctx context.Context, message *Message) error {
+	return nil
+}
+
+// Publish message to subject
+// message is flushed to server within WaitTime
+func (q *natsQueue) Publish(ctx context.Context, body []byte) error {
+	if err := q.conn.Publish(q.subject, body); err != nil {
+		return err
+	}
+	flushCtx, cancel := context.WithTimeout(ctx, WaitTime)
+	defer cancel()
+
+	return q.conn.FlushWithContext(flushCtx)
+}
+
+// Close connection
+func (q *natsQueue) Close() error {
+	q.conn.Close()
+	return nil
+}
+
+// Get s unless it is empty
+func stringOr(s string, defaultValue string) string {
+	if s == "" {
+		return defaultValue
+	}
+
+	return s
+}
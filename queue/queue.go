@@ -0,0 +1,86 @@
+// Package queue receives and publishes messages of SQS queues, Redis lists, NATS subjects and Kafka topics.
+package queue
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// WaitTime is max time Receive waits for a message
+const WaitTime = 20 * time.Second
+
+// Message is message received from queue
+type Message struct {
+	Body []byte
+
+	// handle identifies message to complete it
+	handle string
+}
+
+// Queue receives and publishes messages
+// messages of SQS, Redis and Kafka are delivered at least once, so consumer should be idempotent
+type Queue interface {
+	// Receive waits for a message up to WaitTime
+	// nil is returned when no message arrives
+	Receive(ctx context.Context) (*Message, error)
+
+	// Done completes message
+	// failed message is kept for retry or inspection if queue supports it
+	Done(ctx context.Context, message *Message, failed bool) error
+
+	// Release returns message to queue without completing it, e.g. on shutdown
+	// message is received again by another consumer if queue supports it
+	Release(ctx context.Context, message *Message) error
+
+	// Publish sends message
+	Publish(ctx context.Context, body []byte) error
+
+	// Close connections
+	Close() error
+}
+
+// factories are registered queues by URI scheme
+var factories = map[string]func(ctx context.Context, location *url.URL) (Queue, error){
+	"kafka":  newKafka,
+	"nats":   newNATS,
+	"redis":  newRedis,
+	"rediss": newRedis,
+	"sqs":    newSQS,
+}
+
+// constructor
+// e.g.
+// "sqs://sqs.us-east-1.amazonaws.com/123456789012/lgtm-jobs" => SQS queue of URL https://sqs.us-east-1.amazonaws.com/123456789012/lgtm-jobs
+// "redis://localhost:6379/lgtm-jobs"                         => Redis list lgtm-jobs
+// "nats://localhost:4222/images.uploaded"                    => NATS subject images.uploaded
+// "kafka://localhost:9092/images-uploaded"                   => Kafka topic images-uploaded
+func Open(ctx context.Context, location string) (Queue, error) {
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+	factory, ok := factories[strings.ToLower(u.Scheme)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported queue %s(supported: %s)", location, strings.Join(Schemes(), ", "))
+	}
+	if u.Host == "" || strings.Trim(u.Path, "/") == "" {
+		return nil, fmt.Errorf("host and name are required in %s", location)
+	}
+
+	return factory(ctx, u)
+}
+
+// Get registered URI schemes
+func Schemes() []string {
+	var schemes []string
+	for scheme := range factories {
+		schemes = append(schemes, scheme)
+	}
+	sort.Strings(schemes)
+
+	return schemes
+}
@@ -0,0 +1,92 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"github.com/redis/go-redis/v9"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Suffixes of lists of messages being processed and failed
+const (
+	ProcessingSuffix = ":processing"
+	FailedSuffix     = ":failed"
+)
+
+// redisWaitTime is shorter than WaitTime since blocking command is not canceled by context
+// so worker can stop soon
+const redisWaitTime = 5 * time.Second
+
+// redisQueue is Redis list
+// producers LPUSH messages, received message is moved to processing list until it is done
+type redisQueue struct {
+	client *redis.Client
+	list   string
+}
+
+// constructor
+// path is list name, password and db are given as redis://:password@host:6379/list?db=1
+func newRedis(ctx context.Context, location *url.URL) (Queue, error) {
+	list := strings.Trim(location.Path, "/")
+	u := *location
+	u.Path = ""
+	if db := u.Query().Get("db"); db != "" {
+		u.Path = "/" + db
+	}
+	u.RawQuery = ""
+	opts, err := redis.ParseURL(u.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &redisQueue{client: redis.NewClient(opts), list: list}, nil
+}
+
+// Move oldest message to processing list
+func (q *redisQueue) Receive(ctx context.Context) (*Message, error) {
+	body, err := q.client.BLMove(ctx, q.list, q.list+ProcessingSuffix, "RIGHT", "LEFT", redisWaitTime).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{Body: []byte(body), handle: body}, nil
+}
+
+// Remove message from processing list
+// failed message is pushed to failed list
+func (q *redisQueue) Done(ctx context.Context, message *Message, failed bool) error {
+	pipe := q.client.TxPipeline()
+	pipe.LRem(ctx, q.list+ProcessingSuffix, 1, message.handle)
+	if failed {
+		pipe.LPush(ctx, q.list+FailedSuffix, message.handle)
+	}
+	_, err := pipe.Exec(ctx)
+
+	return err
+}
+
+// Move message from processing list back to list
+// it is pushed to right end, so it is received next
+func (q *redisQueue) Release(ctx context.Context, message *Message) error {
+	pipe := q.client.TxPipeline()
+	pipe.LRem(ctx, q.list+ProcessingSuffix, 1, message.handle)
+	pipe.RPush(ctx, q.list, message.handle)
+	_, err := pipe.Exec(ctx)
+
+	return err
+}
+
+// Push message to list
+func (q *redisQueue) Publish(ctx context.Context, body []byte) error {
+	return q.client.LPush(ctx, q.list, body).Err()
+}
+
+// Close client
+func (q *redisQueue) Close() error {
+	return q.client.Close()
+}
@@ -0,0 +1,87 @@
+package queue
+
+import (
+	"context"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"net/url"
+)
+
+// sqsQueue is Amazon SQS queue
+// credentials and region are loaded from environment and shared config of AWS
+type sqsQueue struct {
+	client *sqs.Client
+	url    string
+}
+
+// constructor
+// queue URL is https URL of same host and path
+func newSQS(ctx context.Context, location *url.URL) (Queue, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	queueURL := *location
+	queueURL.Scheme = "https"
+
+	return &sqsQueue{client: sqs.NewFromConfig(cfg), url: queueURL.String()}, nil
+}
+
+// Receive message by long polling
+func (q *sqsQueue) Receive(ctx context.Context) (*Message, error) {
+	out, err := q.client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:            aws.String(q.url),
+		MaxNumberOfMessages: 1,
+		WaitTimeSeconds:     int32(WaitTime.Seconds()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(out.Messages) == 0 {
+		return nil, nil
+	}
+	m := out.Messages[0]
+
+	return &Message{Body: []byte(aws.ToString(m.Body)), handle: aws.ToString(m.ReceiptHandle)}, nil
+}
+
+// Delete succeeded message
+// failed message is received again after visibility timeout, or moved to dead-letter queue by redrive policy
+func (q *sqsQueue) Done(ctx context.Context, message *Message, failed bool) error {
+	if failed {
+		return nil
+	}
+	_, err := q.client.DeleteMessage(ctx, &sqs.DeleteMessageInput{
+		QueueUrl:      aws.String(q.url),
+		ReceiptHandle: aws.String(message.handle),
+	})
+
+	return err
+}
+
+// Make message visible again at once
+func (q *sqsQueue) Release(ctx context.Context, message *Message) error {
+	_, err := q.client.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+		QueueUrl:          aws.String(q.url),
+		ReceiptHandle:     aws.String(message.handle),
+		VisibilityTimeout: 0,
+	})
+
+	return err
+}
+
+// Send message
+func (q *sqsQueue) Publish(ctx context.Context, body []byte) error {
+	_, err := q.client.SendMessage(ctx, &sqs.SendMessageInput{
+		QueueUrl:    aws.String(q.url),
+		MessageBody: aws.String(string(body)),
+	})
+
+	return err
+}
+
+// SQS client has no connection to close
+func (q *sqsQueue) Close() error {
+	return nil
+}
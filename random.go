@@ -0,0 +1,196 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"context"
+	"flag"
+	"github.com/neko-neko/lgtmgen/config"
+	"github.com/neko-neko/lgtmgen/fetcher"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/mask_image"
+	"github.com/neko-neko/lgtmgen/preview"
+	"github.com/neko-neko/lgtmgen/provider"
+	"github.com/neko-neko/lgtmgen/report"
+	"github.com/neko-neko/lgtmgen/uploader"
+	"os"
+	"strings"
+)
+
+// DefaultProvider is default random image provider
+const DefaultProvider = "picsum"
+
+// Generate LGTM image from random image
+func (cli *CLI) runRandom(args []string) int {
+	var (
+		output       string
+		providerName string
+		apiKey       string
+		query        string
+		force        bool
+		maskPath     string
+		style        string
+		text         string
+		upload       string
+		uploadKey    string
+		markdown     bool
+		snippet      string
+		previewTo    string
+		printURI     bool
+		open         bool
+	)
+
+	// load config file
+	conf, err := cli.loadConfig(args[1:])
+	if err != nil {
+		return ExitCodeError
+	}
+
+	// Define option flag parse
+	flags := flag.NewFlagSet(Name+" random", flag.ContinueOnError)
+	flags.SetOutput(cli.errStream)
+
+	flags.String("config", "", "Config file path (default ~/"+config.FileName+")")
+
+	flags.StringVar(&output, "output", "", "Output file path")
+	flags.StringVar(&output, "o", "", "Output file path(Short)")
+
+	providers := strings.Join(provider.Names(), ", ")
+	flags.StringVar(&providerName, "provider", DefaultProvider, "Image provider("+providers+")")
+	flags.StringVar(&providerName, "p", DefaultProvider, "Image provider("+providers+")(Short)")
+
+	flags.StringVar(&apiKey, "key", os.Getenv("LGTMGEN_API_KEY"), "API key of provider")
+	flags.StringVar(&apiKey, "k", os.Getenv("LGTMGEN_API_KEY"), "API key of provider(Short)")
+
+	flags.StringVar(&query, "query", "", "Search query of random image")
+	flags.StringVar(&query, "q", "", "Search query of random image(Short)")
+
+	flags.BoolVar(&force, "force", false, "Force overwrite if outputfile exists")
+	flags.BoolVar(&force, "f", false, "Force overwrite if outputfile exists(Short)")
+
+	flags.StringVar(&maskPath, "mask", conf.Mask, "Mask image path or embedded asset name. Overrides style")
+	flags.StringVar(&maskPath, "m", conf.Mask, "Mask image path or embedded asset name(Short)")
+
+	styles := strings.Join(mask_image.StyleNames(), ", ")
+	flags.StringVar(&style, "style", stringOr(conf.Style, mask_image.DefaultStyle), "Mask style("+styles+" or user mask name)")
+
+	flags.StringVar(&text, "text", conf.Text, "Render text instead of mask image")
+	flags.StringVar(&text, "t", conf.Text, "Render text instead of mask image(Short)")
+
+	uploaders := strings.Join(uploader.Names(), ", ")
+	flags.StringVar(&upload, "upload", conf.Upload, "Upload output image("+uploaders+")")
+	flags.StringVar(&uploadKey, "upload-key", stringOr(conf.UploadKey, os.Getenv("LGTMGEN_UPLOAD_KEY")), "Client ID or key of uploader")
+	flags.BoolVar(&markdown, "print-markdown", false, "Print markdown image snippet of output image")
+	flags.StringVar(&snippet, "snippet", "", "Print image snippet of output image("+strings.Join(uploader.SnippetNames(), ", ")+")")
+	flags.BoolVar(&printURI, "print-data-uri", false, "Print output image as base64 data URI")
+	flags.StringVar(&previewTo, "preview", "", "Draw output image on terminal("+strings.Join(preview.Modes(), ", ")+")")
+	flags.BoolVar(&open, "open", false, "Open output image in default viewer")
+
+	// Parse commandline flag
+	if err := flags.Parse(args[1:]); err != nil {
+		return ExitCodeError
+	}
+
+	// has output?
+	if output == "" {
+		cli.log.Errorf("output file path is required.")
+		return ExitCodeError
+	}
+	outputFormat, err := lgtm.FormatFromFilename(output)
+	if err != nil {
+		cli.log.Errorf("[%s] %s", err, output)
+		return ExitCodeError
+	}
+	if existFile(output) && !force {
+		cli.log.Errorf("[already exists] %s", output)
+		return ExitCodeError
+	}
+
+	var previewMode preview.Mode
+	if previewTo != "" {
+		if previewMode, err = preview.ParseMode(previewTo); err != nil {
+			cli.log.Errorf("%s.", err)
+			return ExitCodeError
+		}
+	}
+
+	// load mask image
+	mask, err := loadMask(maskPath, style, text, textOptions{})
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+
+	// create uploader
+	up, err := newUploader(upload, uploadKey)
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+
+	// snippet printed after image
+	snippetFormat, err := newSnippet(snippet, markdown)
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+
+	// find random image
+	p, err := provider.NewProvider(providerName, apiKey)
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+	imageURL, err := p.RandomImageURL(query)
+	if err != nil {
+		cli.log.Errorf("[%s] %s", err, providerName)
+		return ExitCodeError
+	}
+	result := &report.Result{Input: imageURL, Output: output, Status: report.StatusSuccess}
+	opts := []lgtm.Option{
+		lgtm.WithMask(mask.MaskImage),
+	}
+	if body, err := fetcher.NewFetcher().Fetch(imageURL); err != nil {
+		result.Status, result.Error = report.StatusFailed, err
+	} else if err := writeImage(context.Background(), body, output, outputFormat, opts); err != nil {
+		result.Status, result.Error = report.StatusFailed, err
+	}
+	uploadResult(result, up)
+
+	reporter := report.NewTextReporter(cli.log)
+	reporter.Snippet = snippetFormat
+	if previewMode != "" {
+		reporter.Preview = previewOutput(previewMode)
+	}
+	if printURI {
+		reporter.DataURI = dataURI
+	}
+	reporter.Report(result)
+	reporter.Finish()
+	if open {
+		cli.openResult(result)
+	}
+
+	if result.Status != report.StatusSuccess {
+		return ExitCodeError
+	}
+
+	return ExitCodeOK
+}
@@ -0,0 +1,226 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/metadata"
+	"github.com/neko-neko/lgtmgen/report"
+	"github.com/neko-neko/lgtmgen/storage"
+	"github.com/neko-neko/lgtmgen/uploader"
+	"io"
+	"os"
+	"os/signal"
+	"path"
+	"sync"
+	"time"
+)
+
+// storageOptions are options to process images between storages
+// e.g. s3://bucket/images/ => s3://bucket/lgtms/
+type storageOptions struct {
+	source      string
+	destination string
+	names       naming
+	recursive   bool
+	hidden      bool
+	exts        extensions
+	skipDone    bool
+	force       bool
+	failFast    bool
+	dryRun      bool
+	jobs        int
+	opts        []lgtm.Option
+	uploader    uploader.Uploader
+	reporter    report.Reporter
+	limits      timeouts
+}
+
+// Mask images of source storage and write them into destination storage
+// either of them may be local directory
+func (cli *CLI) runStorage(s storageOptions) int {
+	ctx, stop := signal.NotifyContext(s.limits.deadline, os.Interrupt)
+	defer stop()
+
+	source, err := storage.Open(ctx, s.source)
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+	destination, err := storage.Open(ctx, s.destination)
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+	for _, st := range []storage.Storage{source, destination} {
+		if closer, ok := st.(io.Closer); ok {
+			defer closer.Close()
+		}
+	}
+	names, err := source.List(ctx, s.recursive)
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+
+	// skip hidden objects, objects of other extensions and objects which are not images
+	var objects []string
+	for _, name := range names {
+		if !s.hidden && isHidden(name) {
+			cli.log.Verbosef("[hidden] %s", source.URI(name))
+			continue
+		}
+		if !s.exts.match(name) {
+			cli.log.Verbosef("[excluded] %s", source.URI(name))
+			continue
+		}
+		if !isImageFile(name) {
+			cli.log.Verbosef("[not an image] %s", source.URI(name))
+			continue
+		}
+		objects = append(objects, name)
+	}
+
+	summary := report.NewSummary()
+	ordered := report.NewOrderedReporter(s.reporter)
+	wg := &sync.WaitGroup{}
+	semaphore := make(chan struct{}, s.jobs)
+	for index, name := range objects {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		if s.failFast && summary.HasFailure() {
+			<-semaphore
+			break
+		}
+		wg.Add(1)
+		go func(index int, name string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			var result *report.Result
+			outputName, err := s.names.filename(name, source.URI(name), index+1)
+			fileCtx, cancel := s.limits.file()
+			defer cancel()
+			if err != nil {
+				result = &report.Result{Input: source.URI(name), Status: report.StatusFailed, Error: err}
+			} else {
+				result = cli.maskObject(fileCtx, source, name, destination, outputName, s.names, s.force, s.skipDone, s.dryRun, s.opts, s.uploader)
+			}
+			summary.Add(result)
+			ordered.ReportAt(index, result)
+		}(index, name)
+	}
+	wg.Wait()
+
+	if s.limits.deadline.Err() != nil {
+		cli.log.Errorf("timed out, %d of %d images processed.", summary.Total, len(objects))
+		s.reporter.Finish()
+		return ExitCodeTimeout
+	}
+	if ctx.Err() != nil {
+		cli.log.Errorf("interrupted, %d of %d images processed.", summary.Total, len(objects))
+		s.reporter.Finish()
+		return ExitCodeInterrupted
+	}
+	s.reporter.Finish()
+
+	return batchExitCode(summary.Failed, len(objects))
+}
+
+// Mask image object and write it into destination
+// object of lgtmgen output is skipped after reading it if skipDone is true
+// written output is uploaded from memory if up is not nil
+func (cli *CLI) maskObject(ctx context.Context, source storage.Storage, name string, destination storage.Storage, outputName string, names naming, force bool, skipDone bool, dryRun bool, opts []lgtm.Option, up uploader.Uploader) *report.Result {
+	result := &report.Result{Input: source.URI(name), Output: destination.URI(outputName), Status: report.StatusSuccess}
+	start := time.Now()
+	defer func() { result.Duration = time.Since(start) }()
+
+	if !force {
+		var exists bool
+		err := cli.withRetry(ctx, result.Output, func() (err error) {
+			exists, err = destination.Exists(ctx, outputName)
+			return err
+		})
+		if err != nil {
+			result.Status, result.Error = report.StatusFailed, err
+			return result
+		}
+		if exists {
+			result.Status, result.Error = report.StatusSkipped, ErrAlreadyExists
+			return result
+		}
+	}
+	if dryRun {
+		result.Output = destination.URI(names.planned(outputName))
+		result.Status = report.StatusPending
+		return result
+	}
+
+	outputFormat, err := lgtm.FormatFromFilename(outputName)
+	if err != nil {
+		result.Status, result.Error = report.StatusFailed, err
+		return result
+	}
+	var body []byte
+	err = cli.withRetry(ctx, result.Input, func() (err error) {
+		body, err = source.Read(ctx, name)
+		return err
+	})
+	if err != nil {
+		result.Status, result.Error = report.StatusFailed, timeoutError(err)
+		return result
+	}
+	result.InputBytes = int64(len(body))
+	if skipDone && metadata.IsMarked(body) {
+		result.Output = ""
+		result.Status, result.Error = report.StatusSkipped, ErrAlreadyProcessed
+		return result
+	}
+	var output bytes.Buffer
+	if err := lgtm.ProcessContext(ctx, bytes.NewReader(body), &output, outputFormat, opts...); err != nil {
+		result.Status, result.Error = report.StatusFailed, timeoutError(err)
+		return result
+	}
+	if names.hashLength > 0 {
+		sum := sha256.Sum256(output.Bytes())
+		outputName = names.contentName(outputName, sum[:])
+		result.Output = destination.URI(outputName)
+	}
+	err = cli.withRetry(ctx, result.Output, func() error {
+		return destination.Write(ctx, outputName, output.Bytes(), lgtm.ContentType(outputFormat))
+	})
+	if err != nil {
+		result.Status, result.Error = report.StatusFailed, timeoutError(err)
+		return result
+	}
+	result.Bytes = int64(output.Len())
+	result.Width, result.Height = imageSize(bytes.NewReader(output.Bytes()))
+	uploadData(result, up, path.Base(outputName), output.Bytes())
+
+	return result
+}
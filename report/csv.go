@@ -0,0 +1,85 @@
+package report
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"sync"
+)
+
+// csvHeader is first row of CSV report
+var csvHeader = []string{"input", "output", "width", "height", "input_bytes", "output_bytes", "duration", "status", "error"}
+
+// CSVReporter writes a CSV row per result as auditable record of batch
+// width and height are dimensions of output, empty if unknown
+type CSVReporter struct {
+	out *csv.Writer
+	err error
+
+	mu sync.Mutex
+}
+
+// constructor
+// header is written first
+func NewCSVReporter(out io.Writer) *CSVReporter {
+	r := &CSVReporter{out: csv.NewWriter(out)}
+	r.write(csvHeader)
+
+	return r
+}
+
+func (r *CSVReporter) Report(result *Result) {
+	var message string
+	if result.Error != nil {
+		message = result.Error.Error()
+	}
+
+	r.write([]string{
+		result.Input,
+		result.Output,
+		optionalInt(int64(result.Width)),
+		optionalInt(int64(result.Height)),
+		optionalInt(result.InputBytes),
+		optionalInt(result.Bytes),
+		strconv.FormatFloat(result.Duration.Seconds(), 'f', 3, 64),
+		string(result.Status),
+		message,
+	})
+}
+
+// Flush written rows
+func (r *CSVReporter) Finish() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.out.Flush()
+	if r.err == nil {
+		r.err = r.out.Error()
+	}
+}
+
+// Err is first error of writing report
+func (r *CSVReporter) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.err
+}
+
+func (r *CSVReporter) write(record []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.out.Write(record); err != nil && r.err == nil {
+		r.err = err
+	}
+}
+
+// Format n or empty for 0
+func optionalInt(n int64) string {
+	if n == 0 {
+		return ""
+	}
+
+	return strconv.FormatInt(n, 10)
+}
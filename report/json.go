@@ -0,0 +1,83 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// JSONReporter prints a JSON object per line for each result and summary
+type JSONReporter struct {
+	Out     io.Writer
+	Summary *Summary
+
+	mu sync.Mutex
+}
+
+type jsonResult struct {
+	Type     string  `json:"type"`
+	Input    string  `json:"input"`
+	Output   string  `json:"output"`
+	Status   Status  `json:"status"`
+	Error    string  `json:"error,omitempty"`
+	URL      string  `json:"url,omitempty"`
+	Duration float64 `json:"duration"`
+}
+
+type jsonSummary struct {
+	Type      string  `json:"type"`
+	Total     int     `json:"total"`
+	Succeeded int     `json:"succeeded"`
+	Skipped   int     `json:"skipped"`
+	Failed    int     `json:"failed"`
+	Pending   int     `json:"pending,omitempty"`
+	Bytes     int64   `json:"bytes"`
+	Duration  float64 `json:"duration"`
+}
+
+// constructor
+func NewJSONReporter(out io.Writer) *JSONReporter {
+	return &JSONReporter{
+		Out:     out,
+		Summary: NewSummary(),
+	}
+}
+
+func (r *JSONReporter) Report(result *Result) {
+	r.Summary.Add(result)
+
+	record := &jsonResult{
+		Type:     "result",
+		Input:    result.Input,
+		Output:   result.Output,
+		Status:   result.Status,
+		URL:      result.URL,
+		Duration: result.Duration.Seconds(),
+	}
+	if result.Error != nil {
+		record.Error = result.Error.Error()
+	}
+
+	r.write(record)
+}
+
+// Print summary
+func (r *JSONReporter) Finish() {
+	r.write(&jsonSummary{
+		Type:      "summary",
+		Total:     r.Summary.Total,
+		Succeeded: r.Summary.Succeeded,
+		Skipped:   r.Summary.Skipped,
+		Failed:    r.Summary.Failed,
+		Pending:   r.Summary.Pending,
+		Bytes:     r.Summary.Bytes,
+		Duration:  r.Summary.Duration().Seconds(),
+	})
+}
+
+func (r *JSONReporter) write(v interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	json.NewEncoder(r.Out).Encode(v)
+}
@@ -0,0 +1,43 @@
+package report
+
+import (
+	"sync"
+)
+
+// OrderedReporter passes results to Reporter in order of their index
+// result is held until results of all preceding indexes are reported,
+// so results of concurrent processing are printed in input order
+type OrderedReporter struct {
+	Reporter Reporter
+
+	next    int
+	pending map[int]*Result
+
+	mu sync.Mutex
+}
+
+// constructor
+func NewOrderedReporter(reporter Reporter) *OrderedReporter {
+	return &OrderedReporter{
+		Reporter: reporter,
+		pending:  map[int]*Result{},
+	}
+}
+
+// Report result of index, index starts from 0
+// results which are ready are reported by caller of this
+func (o *OrderedReporter) ReportAt(index int, result *Result) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.pending[index] = result
+	for {
+		result, ok := o.pending[o.next]
+		if !ok {
+			return
+		}
+		delete(o.pending, o.next)
+		o.next++
+		o.Reporter.Report(result)
+	}
+}
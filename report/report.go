@@ -0,0 +1,141 @@
+// Package report prints results of processed images.
+package report
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Status of processed image
+type Status string
+
+const (
+	StatusSuccess Status = "success"
+	StatusSkipped Status = "skipped"
+	StatusFailed  Status = "failed"
+
+	// StatusPending is image which would be processed in dry run
+	StatusPending Status = "pending"
+)
+
+// Result of processed image
+type Result struct {
+	Input    string
+	Output   string
+	Status   Status
+	Error    error
+	Duration time.Duration
+
+	// URL is uploaded image url
+	URL string
+
+	// Bytes is size of written output
+	Bytes int64
+
+	// InputBytes is size of read input
+	InputBytes int64
+
+	// Width and Height are dimensions of output, 0 if unknown(e.g. SVG)
+	Width  int
+	Height int
+}
+
+// Reporter prints results
+// Report is called from multiple goroutines
+type Reporter interface {
+	Report(result *Result)
+	Finish()
+}
+
+// multiReporter passes results to every reporter
+type multiReporter []Reporter
+
+// Combine reporters into one
+// e.g. text lines on terminal and CSV report file
+func NewMultiReporter(reporters ...Reporter) Reporter {
+	return multiReporter(reporters)
+}
+
+func (m multiReporter) Report(result *Result) {
+	for _, r := range m {
+		r.Report(result)
+	}
+}
+
+func (m multiReporter) Finish() {
+	for _, r := range m {
+		r.Finish()
+	}
+}
+
+// Summary counts results
+type Summary struct {
+	Total     int
+	Succeeded int
+	Skipped   int
+	Failed    int
+	Pending   int
+	Start     time.Time
+
+	// Bytes is total size of written outputs
+	Bytes int64
+
+	mu sync.Mutex
+}
+
+// constructor
+func NewSummary() *Summary {
+	return &Summary{Start: time.Now()}
+}
+
+// Count result
+func (s *Summary) Add(result *Result) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.Total++
+	switch result.Status {
+	case StatusSuccess:
+		s.Succeeded++
+		s.Bytes += result.Bytes
+	case StatusSkipped:
+		s.Skipped++
+	case StatusFailed:
+		s.Failed++
+	case StatusPending:
+		s.Pending++
+	}
+}
+
+// Any result has failed
+func (s *Summary) HasFailure() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.Failed > 0
+}
+
+// Format byte size in human readable unit
+// e.g.
+// 512     => "512 B"
+// 1536000 => "1.5 MB"
+func FormatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	value, exp := float64(n)/unit, 0
+	for value >= unit && exp < 3 {
+		value /= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f %cB", value, "KMGT"[exp])
+}
+
+// Elapsed time from start
+func (s *Summary) Duration() time.Duration {
+	return time.Since(s.Start)
+}
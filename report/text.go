@@ -0,0 +1,115 @@
+package report
+
+import (
+	"github.com/neko-neko/lgtmgen/logger"
+	"strings"
+	"time"
+)
+
+// TextReporter prints a line per result
+// success lines are printed in verbose level, skip and error lines in default level
+type TextReporter struct {
+	Log *logger.Logger
+
+	// Quiet suppresses success lines
+	Quiet bool
+
+	// Each prints success lines in default level
+	Each bool
+
+	// Snippet formats ready-to-paste snippet of output if given
+	// e.g. uploader.Markdown
+	Snippet func(url string) string
+
+	// Preview renders output image on terminal if given
+	Preview func(output string) (string, error)
+
+	// DataURI encodes output image as data URI if given
+	DataURI func(output string) (string, error)
+
+	Summary *Summary
+}
+
+// constructor
+func NewTextReporter(log *logger.Logger) *TextReporter {
+	return &TextReporter{
+		Log:     log,
+		Summary: NewSummary(),
+	}
+}
+
+func (r *TextReporter) Report(result *Result) {
+	r.Summary.Add(result)
+
+	switch result.Status {
+	case StatusSkipped:
+		// input which is already output has no output
+		path := result.Output
+		if path == "" {
+			path = result.Input
+		}
+		r.Log.Warnf("[%s] %s", result.Error, path)
+	case StatusFailed:
+		r.Log.Errorf("[%s] %s", result.Error, result.Input)
+	case StatusPending:
+		r.Log.Printf(logger.LevelQuiet, "[would process] %s", result.Output)
+	case StatusSuccess:
+		switch {
+		case r.Quiet:
+		case r.Log.Enabled(logger.LevelDebug):
+			r.Log.Successf(logger.LevelDebug, "[success] %s (%s, %s)", result.Output, result.Duration.Round(time.Millisecond), FormatBytes(result.Bytes))
+		case r.Each:
+			r.Log.Successf(logger.LevelInfo, "[success] %s", result.Output)
+		default:
+			r.Log.Successf(logger.LevelVerbose, "[success] %s", result.Output)
+		}
+		if result.URL != "" {
+			r.Log.Resultf(logger.LevelQuiet, "[uploaded] %s", result.URL)
+		}
+		if r.Snippet != nil {
+			r.Log.Resultf(logger.LevelQuiet, "%s", r.Snippet(SnippetURL(result)))
+		}
+		if r.DataURI != nil {
+			r.print("data uri", r.DataURI, result.Output)
+		}
+		if r.Preview != nil {
+			r.print("preview", r.Preview, result.Output)
+		}
+	}
+}
+
+// Print rendering of output(e.g. preview, data URI)
+// output which can not be rendered(e.g. SVG preview) is warned
+func (r *TextReporter) print(name string, render func(output string) (string, error), output string) {
+	rendered, err := render(output)
+	if err != nil {
+		r.Log.Warnf("[%s: %s] %s", name, err, output)
+		return
+	}
+	if rendered != "" {
+		r.Log.Resultf(logger.LevelQuiet, "%s", strings.TrimSuffix(rendered, "\n"))
+	}
+}
+
+// Print summary
+// e.g.
+// 12 succeeded, 1 skipped, 0 failed in 3.2s (4.5 MB written)
+func (r *TextReporter) Finish() {
+	s := r.Summary
+	if s.Pending > 0 {
+		r.Log.Infof("%d would be processed, %d skipped, %d failed", s.Pending, s.Skipped, s.Failed)
+		return
+	}
+	r.Log.Infof("%d succeeded, %d skipped, %d failed in %s (%s written)",
+		s.Succeeded, s.Skipped, s.Failed, s.Duration().Round(10*time.Millisecond), FormatBytes(s.Bytes))
+}
+
+// Get url of image snippet of result
+// uploaded url is used if exists
+func SnippetURL(result *Result) string {
+	if result.URL != "" {
+		return result.URL
+	}
+
+	return result.Output
+}
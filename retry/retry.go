@@ -0,0 +1,83 @@
+// Package retry runs operations again when they fail by transient I/O error.
+package retry
+
+import (
+	"context"
+	"errors"
+	"net"
+	"syscall"
+	"time"
+)
+
+const (
+	// DefaultRetries is number of retries after first attempt
+	DefaultRetries = 2
+
+	// DefaultDelay is wait before first retry
+	DefaultDelay = 500 * time.Millisecond
+)
+
+// Policy retries operation failed by transient error with exponential backoff
+// zero Policy runs operation once
+type Policy struct {
+	// Retries is number of retries after first attempt
+	Retries int
+
+	// Delay is wait before first retry, it doubles on each retry
+	Delay time.Duration
+
+	// Notify is called with error and number of retry before each retry if given
+	Notify func(err error, retry int)
+}
+
+// Run operation until it succeeds, fails by permanent error or retries run out
+// ctx stops waiting for next retry
+func (p Policy) Do(ctx context.Context, operation func() error) error {
+	delay := p.Delay
+	for retry := 1; ; retry++ {
+		err := operation()
+		if err == nil || retry > p.Retries || !IsTransient(err) || ctx.Err() != nil {
+			return err
+		}
+		if p.Notify != nil {
+			p.Notify(err, retry)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+			delay *= 2
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		}
+	}
+}
+
+// temporary is error telling whether it may be solved by retry
+// e.g. fetcher.StatusError of 503
+type temporary interface {
+	Temporary() bool
+}
+
+// Error may be solved by retry
+// e.g. connection reset, stale NFS file handle, I/O error of network filesystem
+// io.ErrUnexpectedEOF is not, decoders report it for truncated images which fail every time
+func IsTransient(err error) bool {
+	var netError net.Error
+	if errors.As(err, &netError) {
+		return true
+	}
+	var t temporary
+	if errors.As(err, &t) {
+		return t.Temporary()
+	}
+
+	return errors.Is(err, syscall.EIO) ||
+		errors.Is(err, syscall.ESTALE) ||
+		errors.Is(err, syscall.ETIMEDOUT) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.EAGAIN) ||
+		errors.Is(err, syscall.EBUSY) ||
+		errors.Is(err, syscall.EINTR)
+}
@@ -0,0 +1,310 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"flag"
+	"github.com/neko-neko/lgtmgen/config"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/lgtmpb"
+	"github.com/neko-neko/lgtmgen/mask_image"
+	"github.com/neko-neko/lgtmgen/server"
+	"github.com/neko-neko/lgtmgen/slack"
+	"google.golang.org/grpc"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultAddr is default listen address of serve command
+const DefaultAddr = ":8080"
+
+// DefaultGRPCAddr is default listen address of gRPC server
+const DefaultGRPCAddr = ":50051"
+
+// DefaultRateBurst is default requests allowed at once for each client
+const DefaultRateBurst = 10
+
+// Timeouts of HTTP servers, slow clients can't hold connections longer than them
+// write timeout covers generating an image of request
+const (
+	httpReadHeaderTimeout = 10 * time.Second
+	httpReadTimeout       = time.Minute
+	httpWriteTimeout      = 2 * time.Minute
+	httpIdleTimeout       = 2 * time.Minute
+)
+
+// Run HTTP server
+func (cli *CLI) runServe(args []string) int {
+	var (
+		addr     string
+		maskPath string
+		style    string
+		text     string
+		useGRPC  bool
+		jobsDir  string
+		workers  int
+		queued   int
+		retained time.Duration
+		itemTime time.Duration
+
+		metricsAddr string
+
+		rateLimit     float64
+		rateBurst     int
+		maxUploadSize int64
+		maxDimension  int
+
+		slackToken         string
+		slackSigningSecret string
+	)
+
+	// load config file
+	conf, err := cli.loadConfig(args[1:])
+	if err != nil {
+		return ExitCodeError
+	}
+
+	// Define option flag parse
+	flags := flag.NewFlagSet(Name+" serve", flag.ContinueOnError)
+	flags.SetOutput(cli.errStream)
+
+	flags.String("config", "", "Config file path (default ~/"+config.FileName+")")
+
+	flags.StringVar(&addr, "addr", DefaultAddr, "Listen address")
+	flags.StringVar(&addr, "a", DefaultAddr, "Listen address(Short)")
+
+	flags.StringVar(&jobsDir, "jobs-dir", defaultJobsDir(), "Directory of job state and results of /jobs(empty disables /jobs)")
+	flags.IntVar(&workers, "workers", runtime.NumCPU(), "Number of images of jobs processed concurrently")
+	flags.IntVar(&queued, "jobs-max-queued", server.DefaultMaxQueuedItems, "Max images of jobs waiting for workers, more are rejected with 503(0 is unlimited)")
+	flags.DurationVar(&retained, "jobs-retention", server.DefaultJobRetention, "Keep finished jobs and their results for this duration(0 keeps them forever)")
+	flags.DurationVar(&itemTime, "jobs-item-timeout", server.DefaultJobItemTimeout, "Give up an image of job after this duration(0 is unlimited)")
+
+	flags.BoolVar(&useGRPC, "grpc", false, "Serve gRPC Generator service instead of HTTP(listen on "+DefaultGRPCAddr+" unless addr is given)")
+	flags.Float64Var(&rateLimit, "rate-limit", 0, "Requests per second allowed for each client IP(0 is unlimited)")
+	flags.IntVar(&rateBurst, "rate-burst", DefaultRateBurst, "Requests allowed at once for each client IP with rate-limit")
+	flags.Int64Var(&maxUploadSize, "max-upload-size", server.DefaultMaxUploadSize, "Max bytes of uploaded or downloaded source image")
+	flags.IntVar(&maxDimension, "max-dimension", server.DefaultMaxDimension, "Max width and height of source image(0 is unlimited)")
+
+	flags.StringVar(&metricsAddr, "metrics-addr", "", "Listen address of /metrics in gRPC mode(HTTP mode serves /metrics on addr)")
+
+	flags.StringVar(&maskPath, "mask", conf.Mask, "Mask image path or embedded asset name. Overrides style")
+	flags.StringVar(&maskPath, "m", conf.Mask, "Mask image path or embedded asset name(Short)")
+
+	styles := strings.Join(mask_image.StyleNames(), ", ")
+	flags.StringVar(&style, "style", stringOr(conf.Style, mask_image.DefaultStyle), "Mask style("+styles+" or user mask name)")
+
+	flags.StringVar(&text, "text", conf.Text, "Render text instead of mask image")
+	flags.StringVar(&text, "t", conf.Text, "Render text instead of mask image(Short)")
+
+	flags.StringVar(&slackToken, "slack-token", os.Getenv("SLACK_BOT_TOKEN"), "Slack bot token to enable /slack/command")
+	flags.StringVar(&slackSigningSecret, "slack-signing-secret", os.Getenv("SLACK_SIGNING_SECRET"), "Slack signing secret to verify requests(required with --slack-token)")
+
+	// Parse commandline flag
+	if err := flags.Parse(args[1:]); err != nil {
+		return ExitCodeError
+	}
+
+	keys := apiKeys(conf.APIKeys, os.Getenv("LGTMGEN_API_KEYS"))
+
+	if rateLimit < 0 || rateBurst < 1 {
+		cli.log.Errorf("rate-limit must not be negative and rate-burst must be greater than 0.")
+		return ExitCodeError
+	}
+	if maxUploadSize < 1 || maxDimension < 0 {
+		cli.log.Errorf("max-upload-size must be greater than 0 and max-dimension must not be negative.")
+		return ExitCodeError
+	}
+	if slackToken != "" && slackSigningSecret == "" {
+		cli.log.Errorf("slack-signing-secret is required with slack-token.")
+		return ExitCodeError
+	}
+
+	// load mask image
+	mask, err := loadMask(maskPath, style, text, textOptions{})
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+
+	opts := []lgtm.Option{
+		lgtm.WithMask(mask.MaskImage),
+	}
+
+	// gRPC service
+	if useGRPC {
+		if !isFlagSet(flags, "addr", "a") {
+			addr = DefaultGRPCAddr
+		}
+		var limiter *server.RateLimiter
+		if rateLimit > 0 {
+			limiter = server.NewRateLimiter(rateLimit, rateBurst)
+		}
+		generator := server.NewGRPCServer(opts, maxUploadSize, limiter)
+		generator.MaxDimension = maxDimension
+		var auth *server.Auth
+		if len(keys) > 0 {
+			auth = server.NewAuth(keys)
+		}
+		return cli.serveGRPC(addr, metricsAddr, generator, auth)
+	}
+
+	s := server.NewServer(opts)
+	s.MaxUploadSize = maxUploadSize
+	s.MaxDimension = maxDimension
+	s.Fetcher.MaxSize = maxUploadSize
+	if rateLimit > 0 {
+		s.Limiter = server.NewRateLimiter(rateLimit, rateBurst)
+	}
+	if len(keys) > 0 {
+		s.Auth = server.NewAuth(keys)
+	}
+
+	// asynchronous batch jobs
+	if jobsDir != "" {
+		if workers < 1 {
+			cli.log.Errorf("workers must be greater than 0.")
+			return ExitCodeError
+		}
+		if queued < 0 || retained < 0 || itemTime < 0 {
+			cli.log.Errorf("jobs-max-queued, jobs-retention and jobs-item-timeout must not be negative.")
+			return ExitCodeError
+		}
+		queue, err := server.NewJobQueue(jobsDir, workers, opts)
+		if err != nil {
+			cli.log.Errorf("fatal error %s.", err)
+			return ExitCodeError
+		}
+		queue.MaxDimension = maxDimension
+		queue.MaxQueued = queued
+		queue.Retention = retained
+		queue.ItemTimeout = itemTime
+		queue.Fetcher.MaxSize = maxUploadSize
+		s.Handle("/jobs", queue)
+		s.Handle("/jobs/", queue)
+	}
+
+	// Slack slash command
+	// it is public, so it is served only with signing secret
+	if slackToken != "" {
+		s.HandlePublic("/slack/command", slack.NewCommandHandler(slackToken, slackSigningSecret, opts))
+	}
+
+	cli.log.Infof("listening on %s", addr)
+	if err := newHTTPServer(addr, s).ListenAndServe(); err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+
+	return ExitCodeOK
+}
+
+// Get API keys of config and comma separated keys of env
+// keys of env have no rate limit
+func apiKeys(conf []config.APIKey, env string) []server.APIKey {
+	var keys []server.APIKey
+	for _, key := range conf {
+		if key.Key != "" {
+			keys = append(keys, server.APIKey(key))
+		}
+	}
+	for i, key := range strings.Split(env, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, server.APIKey{Name: "env" + strconv.Itoa(i), Key: key})
+		}
+	}
+
+	return keys
+}
+
+// Get default directory of jobs in cache directory
+func defaultJobsDir() string {
+	dir := defaultCacheDir()
+	if dir == "" {
+		return ""
+	}
+
+	return filepath.Join(dir, "jobs")
+}
+
+// Run gRPC server
+// messages up to max memory of HTTP upload are accepted, larger images are streamed
+// metrics are served over HTTP on metricsAddr unless it is empty, API key is required unless auth is nil
+func (cli *CLI) serveGRPC(addr string, metricsAddr string, generator *server.GRPCServer, auth *server.Auth) int {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+
+	if metricsAddr != "" {
+		go cli.serveMetrics(metricsAddr)
+	}
+
+	unary := []grpc.UnaryServerInterceptor{server.UnaryMetricsInterceptor}
+	stream := []grpc.StreamServerInterceptor{server.StreamMetricsInterceptor}
+	if auth != nil {
+		unary = append(unary, auth.UnaryInterceptor)
+		stream = append(stream, auth.StreamInterceptor)
+	}
+	s := grpc.NewServer(
+		grpc.MaxRecvMsgSize(server.MaxMemory),
+		grpc.MaxSendMsgSize(server.MaxMemory),
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	)
+	lgtmpb.RegisterGeneratorServer(s, generator)
+
+	cli.log.Infof("listening gRPC on %s", addr)
+	if err := s.Serve(listener); err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+
+	return ExitCodeOK
+}
+
+// Serve /metrics on its own listener for modes without HTTP server
+func (cli *CLI) serveMetrics(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", server.MetricsHandler())
+
+	cli.log.Infof("listening metrics on %s", addr)
+	if err := newHTTPServer(addr, mux).ListenAndServe(); err != nil {
+		cli.log.Errorf("metrics server error %s.", err)
+	}
+}
+
+// HTTP server of handler with timeouts
+func newHTTPServer(addr string, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: httpReadHeaderTimeout,
+		ReadTimeout:       httpReadTimeout,
+		WriteTimeout:      httpWriteTimeout,
+		IdleTimeout:       httpIdleTimeout,
+	}
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrUnauthorized is returned when request has no valid API key
+	ErrUnauthorized = errors.New("valid API key is required")
+
+	// ErrRateLimited is returned when API key exceeds its rate limit
+	ErrRateLimited = errors.New("too many requests")
+)
+
+// APIKey is key accepted by Auth
+type APIKey struct {
+	// Name tells whose key it is, e.g. team name
+	Name string
+
+	Key string
+
+	// RateLimit is requests per second allowed for key, 0 is unlimited
+	RateLimit float64
+
+	// RateBurst is requests allowed at once for key, at least 1
+	RateBurst int
+}
+
+// Auth authenticates requests by API key
+// key is given as "Authorization: Bearer <key>" or "X-API-Key: <key>" header,
+// gRPC requests give it as "authorization" metadata
+type Auth struct {
+	keys     []APIKey
+	limiters []*rate.Limiter
+}
+
+// constructor
+func NewAuth(keys []APIKey) *Auth {
+	a := &Auth{keys: keys, limiters: make([]*rate.Limiter, len(keys))}
+	for i, key := range keys {
+		if key.RateLimit > 0 {
+			burst := key.RateBurst
+			if burst < 1 {
+				burst = 1
+			}
+			a.limiters[i] = rate.NewLimiter(rate.Limit(key.RateLimit), burst)
+		}
+	}
+
+	return a
+}
+
+// Check key and consume token of its rate limit
+func (a *Auth) Check(key string) error {
+	// compare all keys in constant time not to leak which key is close
+	index := -1
+	for i, k := range a.keys {
+		if subtle.ConstantTimeCompare([]byte(k.Key), []byte(key)) == 1 && key != "" {
+			index = i
+		}
+	}
+	if index < 0 {
+		return ErrUnauthorized
+	}
+	if limiter := a.limiters[index]; limiter != nil && !limiter.Allow() {
+		return ErrRateLimited
+	}
+
+	return nil
+}
+
+// Get API key of HTTP request
+func requestKey(r *http.Request) string {
+	if key := r.Header.Get("X-API-Key"); key != "" {
+		return key
+	}
+
+	return bearerToken(r.Header.Get("Authorization"))
+}
+
+// Get token of bearer authorization
+func bearerToken(authorization string) string {
+	const prefix = "bearer "
+	if len(authorization) < len(prefix) || !strings.EqualFold(authorization[:len(prefix)], prefix) {
+		return ""
+	}
+
+	return strings.TrimSpace(authorization[len(prefix):])
+}
+
+// Check API key of HTTP request and respond error
+// returns false when request is rejected
+func (a *Auth) authorize(w http.ResponseWriter, r *http.Request) bool {
+	switch err := a.Check(requestKey(r)); err {
+	case nil:
+		return true
+	case ErrRateLimited:
+		countError(ErrorRateLimit)
+		w.Header().Set("Retry-After", "1")
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+	default:
+		countError(ErrorAuth)
+		w.Header().Set("WWW-Authenticate", `Bearer realm="lgtmgen"`)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+	}
+
+	return false
+}
+
+// Check API key of gRPC metadata
+func (a *Auth) authorizeContext(ctx context.Context) error {
+	var key string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			key = bearerToken(values[0])
+		}
+	}
+
+	switch err := a.Check(key); err {
+	case nil:
+		return nil
+	case ErrRateLimited:
+		countError(ErrorRateLimit)
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		countError(ErrorAuth)
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+}
+
+// Authenticate unary gRPC requests
+func (a *Auth) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authorizeContext(ctx); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// Authenticate streaming gRPC requests
+func (a *Auth) StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authorizeContext(stream.Context()); err != nil {
+		return err
+	}
+
+	return handler(srv, stream)
+}
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/disintegration/imaging"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/lgtmpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"io"
+	"net"
+)
+
+// ChunkSize is size of image chunk sent by GenerateStream
+const ChunkSize = 64 << 10
+
+// GRPCServer generates LGTM images over gRPC
+type GRPCServer struct {
+	lgtmpb.UnimplementedGeneratorServer
+
+	// Options are used for every generation, request options are applied after them
+	Options []lgtm.Option
+
+	// MaxDimension is max width and height of source image, 0 is unlimited
+	MaxDimension int
+
+	// MaxUploadSize is max bytes of source image of Generate and GenerateStream
+	MaxUploadSize int64
+
+	// Limiter limits requests per client IP, nil is unlimited
+	Limiter *RateLimiter
+}
+
+// constructor
+// maxUploadSize is max bytes of source image, limiter is nil for unlimited requests
+func NewGRPCServer(opts []lgtm.Option, maxUploadSize int64, limiter *RateLimiter) *GRPCServer {
+	return &GRPCServer{Options: opts, MaxDimension: DefaultMaxDimension, MaxUploadSize: maxUploadSize, Limiter: limiter}
+}
+
+// Generate LGTM image of request
+func (s *GRPCServer) Generate(ctx context.Context, req *lgtmpb.GenerateRequest) (*lgtmpb.GenerateResponse, error) {
+	if err := s.allow(ctx); err != nil {
+		return nil, err
+	}
+	if int64(len(req.GetImage())) > s.MaxUploadSize {
+		countError(ErrorUpload)
+		return nil, status.Error(codes.ResourceExhausted, "image is too large")
+	}
+	output, format, err := s.generate(ctx, req.GetImage(), req.GetOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	return &lgtmpb.GenerateResponse{Image: output, ContentType: lgtm.ContentType(format)}, nil
+}
+
+// Generate LGTM image received in chunks
+// options come first, output is sent as content type and chunks
+func (s *GRPCServer) GenerateStream(stream lgtmpb.Generator_GenerateStreamServer) error {
+	if err := s.allow(stream.Context()); err != nil {
+		return err
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	options := first.GetOptions()
+	if options == nil {
+		return status.Error(codes.InvalidArgument, "first message must be options")
+	}
+
+	var input bytes.Buffer
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if int64(input.Len()+len(req.GetChunk())) > s.MaxUploadSize {
+			countError(ErrorUpload)
+			return status.Error(codes.ResourceExhausted, "image is too large")
+		}
+		input.Write(req.GetChunk())
+	}
+
+	output, format, err := s.generate(stream.Context(), input.Bytes(), options)
+	if err != nil {
+		return err
+	}
+	err = stream.Send(&lgtmpb.GenerateStreamResponse{Part: &lgtmpb.GenerateStreamResponse_ContentType{ContentType: lgtm.ContentType(format)}})
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(output); i += ChunkSize {
+		end := i + ChunkSize
+		if end > len(output) {
+			end = len(output)
+		}
+		if err := stream.Send(&lgtmpb.GenerateStreamResponse{Part: &lgtmpb.GenerateStreamResponse_Chunk{Chunk: output[i:end]}}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Check rate limit of client IP of peer
+func (s *GRPCServer) allow(ctx context.Context) error {
+	if s.Limiter == nil {
+		return nil
+	}
+	ip := ""
+	if p, ok := peer.FromContext(ctx); ok {
+		ip = p.Addr.String()
+		if host, _, err := net.SplitHostPort(ip); err == nil {
+			ip = host
+		}
+	}
+	if !s.Limiter.Allow(ip) {
+		countError(ErrorRateLimit)
+		return status.Error(codes.ResourceExhausted, "too many requests")
+	}
+
+	return nil
+}
+
+// Mask image with server and request options
+// invalid options and images are invalid argument
+func (s *GRPCServer) generate(ctx context.Context, input []byte, options *lgtmpb.Options) ([]byte, imaging.Format, error) {
+	if err := CheckDimension(input, s.MaxDimension); errors.Is(err, ErrTooManyPixels) {
+		countError(ErrorUpload)
+		return nil, 0, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	output, format, err := Generate(ctx, "grpc", input, s.Options, protoOptions(options))
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return nil, 0, status.FromContextError(err).Err()
+	}
+	if err != nil {
+		return nil, 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return output, format, nil
+}
@@ -0,0 +1,457 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/neko-neko/lgtmgen/fetcher"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MaxJobURLs is max number of image urls in a job
+const MaxJobURLs = 100
+
+// DefaultMaxQueuedItems is default max number of items waiting for workers
+const DefaultMaxQueuedItems = 1000
+
+// DefaultJobRetention is default time finished jobs and their results are kept
+const DefaultJobRetention = 24 * time.Hour
+
+// DefaultJobItemTimeout is default time limit of generating an item
+const DefaultJobItemTimeout = 2 * time.Minute
+
+// jobSweepInterval is interval of dropping expired jobs
+const jobSweepInterval = 10 * time.Minute
+
+// ErrQueueFull is returned when job has more items than queue can take
+var ErrQueueFull = errors.New("job queue is full")
+
+// JobStatus is progress of job or its item
+type JobStatus string
+
+const (
+	JobQueued  JobStatus = "queued"
+	JobRunning JobStatus = "running"
+	JobDone    JobStatus = "done"
+
+	// JobFailed is item which could not be generated
+	// job is done even if some items failed
+	JobFailed JobStatus = "failed"
+)
+
+// Job is batch of image urls generated in background
+type Job struct {
+	ID        string         `json:"id"`
+	Status    JobStatus      `json:"status"`
+	Options   RequestOptions `json:"options"`
+	Items     []*JobItem     `json:"items"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// JobItem is image url of job and its result
+type JobItem struct {
+	URL    string    `json:"url"`
+	Status JobStatus `json:"status"`
+	Error  string    `json:"error,omitempty"`
+
+	// Result is path of generated image
+	// e.g. /jobs/{id}/results/0
+	Result      string `json:"result,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+}
+
+// jobTask is item of job processed by worker
+type jobTask struct {
+	id    string
+	index int
+}
+
+// JobQueue runs jobs by pool of workers
+// state of jobs and generated images are saved in directory, so unfinished jobs are resumed after restart
+type JobQueue struct {
+	// Options are used for every generation, job options are applied after them
+	Options []lgtm.Option
+
+	// Fetcher downloads image urls
+	Fetcher *fetcher.Fetcher
+
+	// MaxDimension is max width and height of source image, 0 is unlimited
+	MaxDimension int
+
+	// MaxQueued is max number of items waiting for workers, 0 is unlimited
+	// job which exceeds it is rejected
+	MaxQueued int
+
+	// Retention is time finished jobs and their results are kept, 0 keeps them forever
+	Retention time.Duration
+
+	// ItemTimeout is time limit of generating an item, 0 is unlimited
+	ItemTimeout time.Duration
+
+	dir    string
+	tasks  chan jobTask
+	mu     sync.Mutex
+	jobs   map[string]*Job
+	queued int
+	swept  time.Time
+}
+
+// constructor
+// saved jobs in dir are loaded and their unfinished items are queued again
+func NewJobQueue(dir string, workers int, opts []lgtm.Option) (*JobQueue, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	q := &JobQueue{
+		Options:      opts,
+		Fetcher:      fetcher.NewPublicFetcher(),
+		MaxDimension: DefaultMaxDimension,
+		MaxQueued:    DefaultMaxQueuedItems,
+		Retention:    DefaultJobRetention,
+		ItemTimeout:  DefaultJobItemTimeout,
+		dir:          dir,
+		tasks:        make(chan jobTask),
+		jobs:         map[string]*Job{},
+	}
+	if err := q.load(); err != nil {
+		return nil, err
+	}
+	for i := 0; i < workers; i++ {
+		go q.work()
+	}
+
+	// resumed items are queued even if they exceed MaxQueued
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, job := range q.jobs {
+		if job.Status != JobDone {
+			q.enqueue(job)
+		}
+	}
+
+	return q, nil
+}
+
+// ServeHTTP implements http.Handler
+// POST /jobs submits job
+// GET /jobs/{id} gets status and results of job
+// GET /jobs/{id}/results/{index} gets generated image
+func (q *JobQueue) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, "/jobs"), "/"), "/")
+	switch {
+	case parts[0] == "" && r.Method == http.MethodPost:
+		q.handleSubmit(w, r)
+	case len(parts) == 1 && parts[0] != "" && r.Method == http.MethodGet:
+		q.handleStatus(w, parts[0])
+	case len(parts) == 3 && parts[1] == "results" && r.Method == http.MethodGet:
+		q.handleResult(w, r, parts[0], parts[2])
+	case parts[0] == "":
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// Submit job of urls and options
+// e.g. {"urls": ["https://example.com/cat.jpg"], "options": {"text": "SHIP IT"}}
+func (q *JobQueue) handleSubmit(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		URLs    []string       `json:"urls"`
+		Options RequestOptions `json:"options"`
+	}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, MaxMemory)).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(req.URLs) == 0 || len(req.URLs) > MaxJobURLs {
+		http.Error(w, fmt.Sprintf("urls must have 1 to %d items", MaxJobURLs), http.StatusBadRequest)
+		return
+	}
+	if _, err := req.Options.lgtmOptions(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	job, err := q.Submit(req.URLs, req.Options)
+	if errors.Is(err, ErrQueueFull) {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Location", "/jobs/"+job.ID)
+	writeJSON(w, http.StatusAccepted, job)
+}
+
+// Respond job status
+func (q *JobQueue) handleStatus(w http.ResponseWriter, id string) {
+	job, ok := q.Get(id)
+	if !ok {
+		http.Error(w, "job not found", http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, job)
+}
+
+// Respond generated image of job item
+func (q *JobQueue) handleResult(w http.ResponseWriter, r *http.Request, id string, index string) {
+	job, ok := q.Get(id)
+	i, err := strconv.Atoi(index)
+	if !ok || err != nil || i < 0 || i >= len(job.Items) || job.Items[i].Status != JobDone {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", job.Items[i].ContentType)
+	http.ServeFile(w, r, q.resultPath(id, i))
+}
+
+// Submit job and queue its items
+// ErrQueueFull is returned if items exceed MaxQueued
+func (q *JobQueue) Submit(urls []string, options RequestOptions) (*Job, error) {
+	id, err := newJobID()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	job := &Job{ID: id, Status: JobQueued, Options: options, CreatedAt: now, UpdatedAt: now}
+	for _, url := range urls {
+		job.Items = append(job.Items, &JobItem{URL: url, Status: JobQueued})
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.sweep(now)
+	if q.MaxQueued > 0 && q.queued+len(job.Items) > q.MaxQueued {
+		return nil, ErrQueueFull
+	}
+	q.jobs[id] = job
+	if err := q.save(job); err != nil {
+		delete(q.jobs, id)
+		return nil, err
+	}
+	q.enqueue(job)
+
+	return copyJob(job), nil
+}
+
+// Get copy of job
+func (q *JobQueue) Get(id string) (*Job, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.sweep(time.Now())
+	job, ok := q.jobs[id]
+	if !ok {
+		return nil, false
+	}
+
+	return copyJob(job), true
+}
+
+// Send unfinished items of job to workers
+// sending does not block caller, mu must be held to count queued items
+func (q *JobQueue) enqueue(job *Job) {
+	var tasks []jobTask
+	for i, item := range job.Items {
+		if item.Status != JobDone && item.Status != JobFailed {
+			tasks = append(tasks, jobTask{id: job.ID, index: i})
+		}
+	}
+	q.queued += len(tasks)
+	go func() {
+		for _, task := range tasks {
+			q.tasks <- task
+		}
+	}()
+}
+
+// Process tasks of queue
+func (q *JobQueue) work() {
+	for task := range q.tasks {
+		q.update(task, func(job *Job, item *JobItem) {
+			q.queued--
+			job.Status, item.Status = JobRunning, JobRunning
+		})
+
+		q.mu.Lock()
+		job := q.jobs[task.id]
+		url, options := job.Items[task.index].URL, job.Options
+		q.mu.Unlock()
+
+		contentType, err := q.generate(task, url, options)
+		q.update(task, func(job *Job, item *JobItem) {
+			if err != nil {
+				item.Status, item.Error = JobFailed, err.Error()
+			} else {
+				item.Status, item.ContentType = JobDone, contentType
+				item.Result = fmt.Sprintf("/jobs/%s/results/%d", job.ID, task.index)
+			}
+			if finished(job) {
+				job.Status = JobDone
+			}
+		})
+	}
+}
+
+// Generate image of url and save it as result
+func (q *JobQueue) generate(task jobTask, url string, options RequestOptions) (string, error) {
+	input, err := q.Fetcher.Fetch(url)
+	if err != nil {
+		countError(ErrorFetch)
+		return "", err
+	}
+	if err := CheckDimension(input, q.MaxDimension); err != nil {
+		countError(ErrorFetch)
+		return "", err
+	}
+	ctx, cancel := context.Background(), context.CancelFunc(func() {})
+	if q.ItemTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, q.ItemTimeout)
+	}
+	defer cancel()
+	output, format, err := Generate(ctx, "job", input, q.Options, options)
+	if err != nil {
+		return "", err
+	}
+	path := q.resultPath(task.id, task.index)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+
+	return lgtm.ContentType(format), ioutil.WriteFile(path, output, 0644)
+}
+
+// Change job item and save job
+// failure of saving is ignored, job is still available until restart
+func (q *JobQueue) update(task jobTask, change func(job *Job, item *JobItem)) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	job := q.jobs[task.id]
+	change(job, job.Items[task.index])
+	job.UpdatedAt = time.Now()
+	_ = q.save(job)
+}
+
+// Save job state as JSON
+// file is replaced by rename so it is never half written
+func (q *JobQueue) save(job *Job) error {
+	data, err := json.MarshalIndent(job, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(q.dir, job.ID+".json")
+	if err := ioutil.WriteFile(path+".tmp", data, 0644); err != nil {
+		return err
+	}
+
+	return os.Rename(path+".tmp", path)
+}
+
+// Load saved jobs
+// items running when server stopped are queued again
+func (q *JobQueue) load() error {
+	paths, err := filepath.Glob(filepath.Join(q.dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var job Job
+		if err := json.Unmarshal(data, &job); err != nil {
+			return fmt.Errorf("%s: %s", path, err)
+		}
+		for _, item := range job.Items {
+			if item.Status == JobRunning {
+				item.Status = JobQueued
+			}
+		}
+		q.jobs[job.ID] = &job
+	}
+
+	return nil
+}
+
+// Drop finished jobs and their results older than retention
+// unfinished jobs are kept however old they are
+func (q *JobQueue) sweep(now time.Time) {
+	if q.Retention <= 0 || now.Sub(q.swept) < jobSweepInterval {
+		return
+	}
+	for id, job := range q.jobs {
+		if job.Status != JobDone || now.Sub(job.UpdatedAt) < q.Retention {
+			continue
+		}
+		os.Remove(filepath.Join(q.dir, id+".json"))
+		os.RemoveAll(filepath.Join(q.dir, id))
+		delete(q.jobs, id)
+	}
+	q.swept = now
+}
+
+// Get path of generated image of job item
+func (q *JobQueue) resultPath(id string, index int) string {
+	return filepath.Join(q.dir, id, strconv.Itoa(index))
+}
+
+// All items of job are done or failed
+func finished(job *Job) bool {
+	for _, item := range job.Items {
+		if item.Status != JobDone && item.Status != JobFailed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Copy job to read it without lock
+func copyJob(job *Job) *Job {
+	c := *job
+	c.Items = make([]*JobItem, len(job.Items))
+	for i, item := range job.Items {
+		copied := *item
+		c.Items[i] = &copied
+	}
+
+	return &c
+}
+
+// Generate random job ID
+func newJobID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// Respond value as JSON
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
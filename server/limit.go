@@ -0,0 +1,109 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"golang.org/x/time/rate"
+	"image"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultMaxUploadSize is default max bytes of uploaded image
+const DefaultMaxUploadSize = 20 << 20
+
+// DefaultMaxDimension is default max width and height of source image
+const DefaultMaxDimension = 8192
+
+// idleLimiterTTL is time until limiter of idle client is dropped
+const idleLimiterTTL = 10 * time.Minute
+
+// ErrTooManyPixels is returned when source image is wider or taller than max dimension
+var ErrTooManyPixels = errors.New("image dimension is too large")
+
+// RateLimiter limits requests per client IP by token bucket
+type RateLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mu      sync.Mutex
+	clients map[string]*client
+	swept   time.Time
+}
+
+// client is token bucket of an IP
+type client struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// constructor
+// each IP can make burst requests at once and perSecond requests per second on average
+func NewRateLimiter(perSecond float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		limit:   rate.Limit(perSecond),
+		burst:   burst,
+		clients: map[string]*client{},
+		swept:   time.Now(),
+	}
+}
+
+// Allow reports whether request of ip may proceed and consumes a token
+func (l *RateLimiter) Allow(ip string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.sweep(now)
+	c, ok := l.clients[ip]
+	if !ok {
+		c = &client{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.clients[ip] = c
+	}
+	c.lastSeen = now
+
+	return c.limiter.AllowN(now, 1)
+}
+
+// Drop limiters of idle clients so map does not grow forever
+// idle limiter is full of tokens, dropping it does not change limit
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.swept) < idleLimiterTTL {
+		return
+	}
+	for ip, c := range l.clients {
+		if now.Sub(c.lastSeen) >= idleLimiterTTL {
+			delete(l.clients, ip)
+		}
+	}
+	l.swept = now
+}
+
+// Get IP of request
+// X-Forwarded-For is not trusted since it is given by client
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+// Check dimension of source image before decoding whole image
+// ErrTooManyPixels is returned if width or height exceeds max
+// 0 is unlimited
+func CheckDimension(input []byte, max int) error {
+	config, _, err := image.DecodeConfig(bytes.NewReader(input))
+	if err != nil {
+		return err
+	}
+	if max > 0 && (config.Width > max || config.Height > max) {
+		return fmt.Errorf("%w: %dx%d exceeds %dx%d", ErrTooManyPixels, config.Width, config.Height, max, max)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Error types of lgtmgen_errors_total
+const (
+	ErrorFetch    = "fetch"
+	ErrorUpload   = "upload"
+	ErrorOptions  = "options"
+	ErrorGenerate = "generate"
+	ErrorCanceled = "canceled"
+
+	// ErrorRateLimit is request rejected by rate limiter
+	ErrorRateLimit = "rate_limit"
+
+	// ErrorAuth is request without valid API key
+	ErrorAuth = "auth"
+)
+
+var (
+	requestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "lgtmgen_requests_total",
+		Help: "Number of requests by handler and status code.",
+	}, []string{"handler", "code"})
+
+	generationSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "lgtmgen_generation_duration_seconds",
+		Help:    "Time to generate an image by source of request.",
+		Buckets: prometheus.ExponentialBuckets(0.01, 2, 12),
+	}, []string{"source"})
+
+	errorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "lgtmgen_errors_total",
+		Help: "Number of failed requests and generations by error type.",
+	}, []string{"type"})
+
+	processedBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "lgtmgen_processed_bytes_total",
+		Help: "Bytes of input and output images.",
+	}, []string{"direction"})
+)
+
+// Handler of Prometheus metrics
+func MetricsHandler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Count error of type
+func countError(errorType string) {
+	errorsTotal.WithLabelValues(errorType).Inc()
+}
+
+// Record generation of source
+// failed generation is counted as error, canceled one is not timed
+func observeGeneration(source string, start time.Time, input int, output int, err error) {
+	switch {
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		countError(ErrorCanceled)
+		return
+	case err != nil:
+		countError(ErrorGenerate)
+	}
+	generationSeconds.WithLabelValues(source).Observe(time.Since(start).Seconds())
+	processedBytes.WithLabelValues("in").Add(float64(input))
+	processedBytes.WithLabelValues("out").Add(float64(output))
+}
+
+// statusRecorder keeps status code written by handler
+type statusRecorder struct {
+	http.ResponseWriter
+	code int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.code = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// Count requests of handler
+// handler is label of pattern, not of request path
+func instrument(handler string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recorder := &statusRecorder{ResponseWriter: w, code: http.StatusOK}
+		h.ServeHTTP(recorder, r)
+		requestsTotal.WithLabelValues(handler, strconv.Itoa(recorder.code)).Inc()
+	})
+}
+
+// Count unary gRPC requests by method and status code
+func UnaryMetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	requestsTotal.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+
+	return resp, err
+}
+
+// Count streaming gRPC requests by method and status code
+func StreamMetricsInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, stream)
+	requestsTotal.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+
+	return err
+}
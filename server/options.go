@@ -0,0 +1,99 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"github.com/disintegration/imaging"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/lgtmpb"
+	"time"
+)
+
+// RequestOptions are generation options of a request
+// zero values are server defaults
+type RequestOptions struct {
+	Text      string  `json:"text,omitempty"`
+	Format    string  `json:"format,omitempty"`
+	MaskScale float64 `json:"mask_scale,omitempty"`
+	Position  string  `json:"position,omitempty"`
+	Opacity   float64 `json:"opacity,omitempty"`
+	Rotate    float64 `json:"rotate,omitempty"`
+	Quality   int     `json:"quality,omitempty"`
+}
+
+// Get request options of gRPC message
+func protoOptions(o *lgtmpb.Options) RequestOptions {
+	return RequestOptions{
+		Text:      o.GetText(),
+		Format:    o.GetFormat(),
+		MaskScale: o.GetMaskScale(),
+		Position:  o.GetPosition(),
+		Opacity:   o.GetOpacity(),
+		Rotate:    o.GetRotate(),
+		Quality:   int(o.GetQuality()),
+	}
+}
+
+// Convert to generation options
+// zero values are not applied
+func (o RequestOptions) lgtmOptions() ([]lgtm.Option, error) {
+	var opts []lgtm.Option
+	if o.Text != "" {
+		opts = append(opts, lgtm.WithText(o.Text))
+	}
+	if o.MaskScale != 0 {
+		opts = append(opts, lgtm.WithMaskScale(o.MaskScale))
+	}
+	if o.Position != "" {
+		position, err := lgtm.ParsePosition(o.Position)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, lgtm.WithPosition(position))
+	}
+	if o.Opacity != 0 {
+		opts = append(opts, lgtm.WithOpacity(o.Opacity))
+	}
+	if o.Rotate != 0 {
+		opts = append(opts, lgtm.WithRotate(o.Rotate))
+	}
+	if o.Quality != 0 {
+		opts = append(opts, lgtm.WithQuality(o.Quality))
+	}
+
+	return opts, nil
+}
+
+// Generate masks image with base and request options
+// output format is same as input unless requested, PNG if input format is unknown
+// source is metrics label of caller(e.g. http, grpc)
+func Generate(ctx context.Context, source string, input []byte, base []lgtm.Option, options RequestOptions) ([]byte, imaging.Format, error) {
+	opts, err := options.lgtmOptions()
+	if err != nil {
+		countError(ErrorOptions)
+		return nil, 0, err
+	}
+
+	var format imaging.Format
+	if options.Format != "" {
+		if format, err = lgtm.FormatFromExtension(options.Format); err != nil {
+			countError(ErrorOptions)
+			return nil, 0, err
+		}
+	} else if format, err = lgtm.DetectFormat(input); err != nil {
+		format = imaging.PNG
+	}
+
+	// base options are copied, they are shared by concurrent requests
+	opts = append(append([]lgtm.Option{}, base...), opts...)
+
+	var output bytes.Buffer
+	start := time.Now()
+	err = lgtm.ProcessContext(ctx, bytes.NewReader(input), &output, format, opts...)
+	observeGeneration(source, start, len(input), output.Len(), err)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return output.Bytes(), format, nil
+}
@@ -0,0 +1,164 @@
+// Package server provides LGTM image generation over HTTP.
+package server
+
+import (
+	"errors"
+	"fmt"
+	"github.com/neko-neko/lgtmgen/fetcher"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"io/ioutil"
+	"net/http"
+)
+
+// MaxMemory is max bytes of multipart form kept in memory
+const MaxMemory = 32 << 20
+
+type Server struct {
+	// Options are used for every generation
+	Options []lgtm.Option
+
+	// Fetcher downloads source image url
+	Fetcher *fetcher.Fetcher
+
+	// Limiter limits requests per client IP, nil is unlimited
+	// /metrics is not limited
+	Limiter *RateLimiter
+
+	// MaxUploadSize is max bytes of request body of POST /generate
+	MaxUploadSize int64
+
+	// MaxDimension is max width and height of source image, 0 is unlimited
+	MaxDimension int
+
+	// Auth requires API key except for public handlers, nil is open
+	Auth *Auth
+
+	mux    *http.ServeMux
+	public map[string]bool
+}
+
+// constructor
+func NewServer(opts []lgtm.Option) *Server {
+	s := &Server{
+		Options:       opts,
+		Fetcher:       fetcher.NewPublicFetcher(),
+		MaxUploadSize: DefaultMaxUploadSize,
+		MaxDimension:  DefaultMaxDimension,
+		mux:           http.NewServeMux(),
+		public:        map[string]bool{"/metrics": true},
+	}
+	s.Handle("/generate", http.HandlerFunc(s.handleGenerate))
+	s.mux.Handle("/metrics", MetricsHandler())
+
+	return s
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.Limiter != nil && r.URL.Path != "/metrics" && !s.Limiter.Allow(clientIP(r)) {
+		countError(ErrorRateLimit)
+		w.Header().Set("Retry-After", "1")
+		http.Error(w, "too many requests", http.StatusTooManyRequests)
+		return
+	}
+	if _, pattern := s.mux.Handler(r); s.Auth != nil && !s.public[pattern] && !s.Auth.authorize(w, r) {
+		return
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// Register additional handler such as chat integrations
+// requests are counted in metrics by pattern
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, instrument(pattern, handler))
+}
+
+// Register handler which does not require API key
+// e.g. webhooks verified by their own signature
+func (s *Server) HandlePublic(pattern string, handler http.Handler) {
+	s.Handle(pattern, handler)
+	s.public[pattern] = true
+}
+
+// Generate LGTM image
+// GET /generate?url=... masks image of url
+// POST /generate masks uploaded multipart "image" file
+func (s *Server) handleGenerate(w http.ResponseWriter, r *http.Request) {
+	var (
+		input []byte
+		err   error
+	)
+
+	switch r.Method {
+	case http.MethodGet:
+		if input, err = s.fetchImage(r.URL.Query().Get("url")); err != nil {
+			countError(ErrorFetch)
+		}
+	case http.MethodPost:
+		r.Body = http.MaxBytesReader(w, r.Body, s.MaxUploadSize)
+		if input, err = s.uploadedImage(r); err != nil {
+			countError(ErrorUpload)
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	// reject huge images before decoding them
+	if err := CheckDimension(input, s.MaxDimension); err != nil {
+		countError(ErrorUpload)
+		http.Error(w, err.Error(), errorStatus(err))
+		return
+	}
+
+	// respond with same format as source
+	output, format, err := Generate(r.Context(), "http", input, s.Options, RequestOptions{})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", lgtm.ContentType(format))
+	w.Write(output)
+}
+
+// Fetch source image from url
+func (s *Server) fetchImage(url string) ([]byte, error) {
+	if url == "" {
+		return nil, fmt.Errorf("url is required")
+	}
+
+	return s.Fetcher.Fetch(url)
+}
+
+// Read uploaded source image
+func (s *Server) uploadedImage(r *http.Request) ([]byte, error) {
+	if err := r.ParseMultipartForm(MaxMemory); err != nil {
+		return nil, err
+	}
+
+	file, _, err := r.FormFile("image")
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return ioutil.ReadAll(file)
+}
+
+// Status code of error of source image
+// too large images are 413, others are 400
+func errorStatus(err error) int {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) || errors.Is(err, fetcher.ErrTooLarge) || errors.Is(err, ErrTooManyPixels) {
+		return http.StatusRequestEntityTooLarge
+	}
+
+	return http.StatusBadRequest
+}
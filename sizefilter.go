@@ -0,0 +1,96 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"fmt"
+	"image"
+	"strconv"
+	"strings"
+)
+
+// sizeFilter skips inputs by dimensions read from their header
+type sizeFilter struct {
+	// min and max are inclusive, 0 of max is unlimited
+	min image.Point
+	max image.Point
+}
+
+// constructor
+// nil is returned if neither size is given
+func newSizeFilter(minSize string, maxSize string) (*sizeFilter, error) {
+	if minSize == "" && maxSize == "" {
+		return nil, nil
+	}
+
+	f := &sizeFilter{}
+	var err error
+	if minSize != "" {
+		if f.min, err = parseSize(minSize); err != nil {
+			return nil, err
+		}
+	}
+	if maxSize != "" {
+		if f.max, err = parseSize(maxSize); err != nil {
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+// Parse size of WIDTHxHEIGHT, single number is used for both
+// e.g. "200x200", "8000"
+func parseSize(value string) (image.Point, error) {
+	width, height, ok := strings.Cut(strings.ToLower(value), "x")
+	if !ok {
+		height = width
+	}
+	w, err := strconv.Atoi(width)
+	if err != nil || w < 0 {
+		return image.Point{}, fmt.Errorf("invalid size %s(WIDTHxHEIGHT)", value)
+	}
+	h, err := strconv.Atoi(height)
+	if err != nil || h < 0 {
+		return image.Point{}, fmt.Errorf("invalid size %s(WIDTHxHEIGHT)", value)
+	}
+
+	return image.Pt(w, h), nil
+}
+
+// Get reason to skip image file, empty if it is in range
+// images without header size(e.g. SVG) are kept, so errors of corrupt files are reported by processing
+func (f *sizeFilter) check(path string) string {
+	if f == nil {
+		return ""
+	}
+	width, height := fileImageSize(path)
+	if width == 0 || height == 0 {
+		return ""
+	}
+	if width < f.min.X || height < f.min.Y {
+		return fmt.Sprintf("too small %dx%d", width, height)
+	}
+	if f.max.X > 0 && width > f.max.X || f.max.Y > 0 && height > f.max.Y {
+		return fmt.Sprintf("too large %dx%d", width, height)
+	}
+
+	return ""
+}
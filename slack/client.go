@@ -0,0 +1,126 @@
+// Package slack provides Slack slash command integration.
+package slack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// APIEndpoint is base url of Slack Web API
+const APIEndpoint = "https://slack.com/api/"
+
+// DefaultTimeout is timeout of API request
+const DefaultTimeout = 60 * time.Second
+
+// Client calls Slack Web API with bot token
+type Client struct {
+	Token string
+	HTTP  *http.Client
+}
+
+// constructor
+func NewClient(token string) *Client {
+	return &Client{
+		Token: token,
+		HTTP:  &http.Client{Timeout: DefaultTimeout},
+	}
+}
+
+// Upload file to channel with comment
+// files.upload is retired, so external upload flow is used
+func (c *Client) UploadFile(channel string, name string, data []byte, comment string) error {
+	// reserve upload url
+	params := url.Values{}
+	params.Set("filename", name)
+	params.Set("length", strconv.Itoa(len(data)))
+	var reserved struct {
+		UploadURL string `json:"upload_url"`
+		FileID    string `json:"file_id"`
+	}
+	if err := c.call("files.getUploadURLExternal", params, &reserved); err != nil {
+		return err
+	}
+
+	// send file content
+	resp, err := c.HTTP.Post(reserved.UploadURL, "application/octet-stream", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack: upload %s", resp.Status)
+	}
+
+	// share file in channel
+	files, err := json.Marshal([]map[string]string{{"id": reserved.FileID, "title": name}})
+	if err != nil {
+		return err
+	}
+	params = url.Values{}
+	params.Set("files", string(files))
+	params.Set("channel_id", channel)
+	params.Set("initial_comment", comment)
+
+	return c.call("files.completeUploadExternal", params, nil)
+}
+
+// Call Web API method and decode response
+func (c *Client) call(method string, params url.Values, v interface{}) error {
+	req, err := http.NewRequest(http.MethodPost, APIEndpoint+method, bytes.NewBufferString(params.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var body bytes.Buffer
+	if _, err := body.ReadFrom(resp.Body); err != nil {
+		return err
+	}
+
+	var result struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(body.Bytes(), &result); err != nil {
+		return fmt.Errorf("slack: %s %s", method, resp.Status)
+	}
+	if !result.OK {
+		return fmt.Errorf("slack: %s %s", method, result.Error)
+	}
+
+	if v == nil {
+		return nil
+	}
+	return json.Unmarshal(body.Bytes(), v)
+}
+
+// Post message to response url of slash command
+func (c *Client) Respond(responseURL string, message *Message) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.HTTP.Post(responseURL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack: respond %s", resp.Status)
+	}
+
+	return nil
+}
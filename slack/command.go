@@ -0,0 +1,152 @@
+package slack
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/disintegration/imaging"
+	"github.com/neko-neko/lgtmgen/fetcher"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxBodySize is max bytes of slash command request
+const MaxBodySize = 1 << 20
+
+// MaxClockSkew is allowed difference of request timestamp
+const MaxClockSkew = 5 * time.Minute
+
+// ErrInvalidSignature is returned when request is not signed by Slack
+var ErrInvalidSignature = errors.New("invalid slack signature")
+
+// Message is slash command response
+type Message struct {
+	ResponseType string `json:"response_type"`
+	Text         string `json:"text"`
+}
+
+// CommandHandler handles "/lgtm <image-url>" slash command
+type CommandHandler struct {
+	// SigningSecret verifies request is sent by Slack, it is required
+	SigningSecret string
+
+	Client  *Client
+	Fetcher *fetcher.Fetcher
+	Options []lgtm.Option
+}
+
+// constructor
+func NewCommandHandler(token string, signingSecret string, opts []lgtm.Option) *CommandHandler {
+	return &CommandHandler{
+		SigningSecret: signingSecret,
+		Client:        NewClient(token),
+		Fetcher:       fetcher.NewPublicFetcher(),
+		Options:       opts,
+	}
+}
+
+// ServeHTTP implements http.Handler
+func (h *CommandHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, MaxBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err := h.verify(r.Header, body, time.Now()); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	imageURL := strings.TrimSpace(form.Get("text"))
+	if !fetcher.IsURL(imageURL) {
+		writeMessage(w, &Message{ResponseType: "ephemeral", Text: "Usage: " + form.Get("command") + " <image-url>"})
+		return
+	}
+
+	// Slack expects response within 3 seconds
+	go h.generate(imageURL, form.Get("channel_id"), form.Get("user_id"), form.Get("response_url"))
+	writeMessage(w, &Message{ResponseType: "ephemeral", Text: "Generating LGTM image..."})
+}
+
+// Generate LGTM image and share it in channel
+func (h *CommandHandler) generate(imageURL string, channel string, user string, responseURL string) {
+	if err := h.share(imageURL, channel, user); err != nil {
+		h.Client.Respond(responseURL, &Message{ResponseType: "ephemeral", Text: fmt.Sprintf("Failed to generate LGTM image: %s", err)})
+	}
+}
+
+func (h *CommandHandler) share(imageURL string, channel string, user string) error {
+	body, err := h.Fetcher.Fetch(imageURL)
+	if err != nil {
+		return err
+	}
+
+	// keep source format if possible
+	name := fetcher.FileName(imageURL)
+	format, err := imaging.FormatFromFilename(name)
+	if err != nil {
+		format = imaging.PNG
+		name = "lgtm.png"
+	}
+
+	var output bytes.Buffer
+	if err := lgtm.Process(bytes.NewReader(body), &output, format, h.Options...); err != nil {
+		return err
+	}
+
+	return h.Client.UploadFile(channel, name, output.Bytes(), fmt.Sprintf("<@%s> LGTM!", user))
+}
+
+// Verify request signature
+// every request is rejected without signing secret
+// https://api.slack.com/authentication/verifying-requests-from-slack
+func (h *CommandHandler) verify(header http.Header, body []byte, now time.Time) error {
+	if h.SigningSecret == "" {
+		return ErrInvalidSignature
+	}
+
+	timestamp, err := strconv.ParseInt(header.Get("X-Slack-Request-Timestamp"), 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if skew := now.Sub(time.Unix(timestamp, 0)); skew > MaxClockSkew || skew < -MaxClockSkew {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(h.SigningSecret))
+	fmt.Fprintf(mac, "v0:%d:", timestamp)
+	mac.Write(body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(header.Get("X-Slack-Signature"))) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Write slash command response
+func writeMessage(w http.ResponseWriter, message *Message) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(message)
+}
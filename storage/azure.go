@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// AzureBlobHost is host suffix of Azure Blob Storage endpoints
+const AzureBlobHost = ".blob.core.windows.net"
+
+// azureStorage is blobs under prefix of Azure Blob Storage container
+// location is az://account/container/prefix or https://account.blob.core.windows.net/container/prefix
+// credentials are AZURE_STORAGE_CONNECTION_STRING or found by DefaultAzureCredential
+type azureStorage struct {
+	client    *azblob.Client
+	account   string
+	container string
+	prefix    string
+}
+
+// constructor
+func newAzure(ctx context.Context, location *url.URL) (Storage, error) {
+	account := location.Host
+	if location.Scheme != "az" {
+		account = strings.TrimSuffix(location.Host, AzureBlobHost)
+	}
+	containerName, prefix, _ := strings.Cut(strings.TrimPrefix(location.Path, "/"), "/")
+	if containerName == "" {
+		return nil, fmt.Errorf("container is required in %s", location)
+	}
+	prefix = keyPrefix(&url.URL{Path: prefix})
+
+	var client *azblob.Client
+	var err error
+	if connectionString := os.Getenv("AZURE_STORAGE_CONNECTION_STRING"); connectionString != "" {
+		client, err = azblob.NewClientFromConnectionString(connectionString, nil)
+	} else {
+		var credential *azidentity.DefaultAzureCredential
+		if credential, err = azidentity.NewDefaultAzureCredential(nil); err == nil {
+			client, err = azblob.NewClient("https://"+account+AzureBlobHost+"/", credential, nil)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &azureStorage{client: client, account: account, container: containerName, prefix: prefix}, nil
+}
+
+// Location is https URL of Azure Blob Storage
+func isAzureURL(location *url.URL) bool {
+	return location.Scheme == "https" && strings.HasSuffix(location.Host, AzureBlobHost)
+}
+
+// List blobs under prefix
+func (s *azureStorage) List(ctx context.Context, recursive bool) ([]string, error) {
+	var names []string
+	pager := s.client.NewListBlobsFlatPager(s.container, &container.ListBlobsFlatOptions{Prefix: &s.prefix})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.Segment.BlobItems {
+			if name, ok := relativeName(*item.Name, s.prefix, recursive); ok {
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names, nil
+}
+
+// Read blob
+func (s *azureStorage) Read(ctx context.Context, name string) ([]byte, error) {
+	response, err := s.client.DownloadStream(ctx, s.container, s.prefix+name, nil)
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.BlobNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return ioutil.ReadAll(response.Body)
+}
+
+// Write blob with content type
+func (s *azureStorage) Write(ctx context.Context, name string, data []byte, contentType string) error {
+	_, err := s.client.UploadBuffer(ctx, s.container, s.prefix+name, data, &azblob.UploadBufferOptions{
+		HTTPHeaders: &blob.HTTPHeaders{BlobContentType: &contentType},
+	})
+
+	return err
+}
+
+// Exists blob
+func (s *azureStorage) Exists(ctx context.Context, name string) (bool, error) {
+	blobClient := s.client.ServiceClient().NewContainerClient(s.container).NewBlobClient(s.prefix + name)
+	_, err := blobClient.GetProperties(ctx, nil)
+	if bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// Get az:// URI of blob
+func (s *azureStorage) URI(name string) string {
+	return "az://" + s.account + "/" + s.container + "/" + s.prefix + name
+}
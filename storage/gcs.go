@@ -0,0 +1,105 @@
+package storage
+
+import (
+	gcs "cloud.google.com/go/storage"
+	"context"
+	"errors"
+	"google.golang.org/api/iterator"
+	"io/ioutil"
+	"net/url"
+)
+
+// gcsStorage is objects under prefix of Google Cloud Storage bucket
+// credentials are found by Application Default Credentials
+type gcsStorage struct {
+	client *gcs.Client
+	bucket *gcs.BucketHandle
+	name   string
+	prefix string
+}
+
+// constructor
+func newGCS(ctx context.Context, location *url.URL) (Storage, error) {
+	client, err := gcs.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gcsStorage{
+		client: client,
+		bucket: client.Bucket(location.Host),
+		name:   location.Host,
+		prefix: keyPrefix(location),
+	}, nil
+}
+
+// List objects under prefix
+func (s *gcsStorage) List(ctx context.Context, recursive bool) ([]string, error) {
+	query := &gcs.Query{Prefix: s.prefix}
+	if !recursive {
+		query.Delimiter = "/"
+	}
+
+	var names []string
+	objects := s.bucket.Objects(ctx, query)
+	for {
+		attrs, err := objects.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if name, ok := relativeName(attrs.Name, s.prefix, recursive); ok {
+			names = append(names, name)
+		}
+	}
+
+	return names, nil
+}
+
+// Read object
+func (s *gcsStorage) Read(ctx context.Context, name string) ([]byte, error) {
+	r, err := s.bucket.Object(s.prefix + name).NewReader(ctx)
+	if err != nil {
+		if errors.Is(err, gcs.ErrObjectNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	defer r.Close()
+
+	return ioutil.ReadAll(r)
+}
+
+// Write object with content type
+func (s *gcsStorage) Write(ctx context.Context, name string, data []byte, contentType string) error {
+	w := s.bucket.Object(s.prefix + name).NewWriter(ctx)
+	w.ContentType = contentType
+	if _, err := w.Write(data); err != nil {
+		w.Close()
+		return err
+	}
+
+	return w.Close()
+}
+
+// Exists object
+func (s *gcsStorage) Exists(ctx context.Context, name string) (bool, error) {
+	_, err := s.bucket.Object(s.prefix + name).Attrs(ctx)
+	if errors.Is(err, gcs.ErrObjectNotExist) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// Get gs:// URI of object
+func (s *gcsStorage) URI(name string) string {
+	return "gs://" + s.name + "/" + s.prefix + name
+}
+
+// Close client
+func (s *gcsStorage) Close() error {
+	return s.client.Close()
+}
@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// local is directory of local filesystem
+type local struct {
+	dir string
+}
+
+// constructor
+func newLocal(dir string) *local {
+	return &local{dir: dir}
+}
+
+// List files in directory
+func (l *local) List(ctx context.Context, recursive bool) ([]string, error) {
+	var names []string
+	err := filepath.WalkDir(l.dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if !recursive && filepath.Clean(path) != filepath.Clean(l.dir) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		name, err := filepath.Rel(l.dir, path)
+		if err != nil {
+			return err
+		}
+		names = append(names, filepath.ToSlash(name))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
+
+// Read file
+func (l *local) Read(ctx context.Context, name string) ([]byte, error) {
+	if err := checkName(name); err != nil {
+		return nil, err
+	}
+
+	return ioutil.ReadFile(l.path(name))
+}
+
+// Write file creating its directory
+// content type is not stored in filesystem
+func (l *local) Write(ctx context.Context, name string, data []byte, contentType string) error {
+	if err := checkName(name); err != nil {
+		return err
+	}
+	path := l.path(name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// Exists file
+func (l *local) Exists(ctx context.Context, name string) (bool, error) {
+	if err := checkName(name); err != nil {
+		return false, err
+	}
+	_, err := os.Stat(l.path(name))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// Get file path
+func (l *local) URI(name string) string {
+	return l.path(name)
+}
+
+// Get file path of name
+func (l *local) path(name string) string {
+	return filepath.Join(l.dir, filepath.FromSlash(name))
+}
+
+// Check name is inside directory after joining
+func checkName(name string) error {
+	if !filepath.IsLocal(filepath.FromSlash(name)) {
+		return fmt.Errorf("%w: %s", ErrInvalidName, name)
+	}
+
+	return nil
+}
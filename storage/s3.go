@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"io/ioutil"
+	"net/url"
+)
+
+// s3Storage is objects under prefix of Amazon S3 bucket
+// credentials and region are loaded from environment and shared config of AWS
+type s3Storage struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+// constructor
+func newS3(ctx context.Context, location *url.URL) (Storage, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Storage{
+		client: s3.NewFromConfig(cfg, func(o *s3.Options) {
+			// objects written by other tools may have no checksum
+			o.DisableLogOutputChecksumValidationSkipped = true
+		}),
+		bucket: location.Host,
+		prefix: keyPrefix(location),
+	}, nil
+}
+
+// List objects under prefix
+func (s *s3Storage) List(ctx context.Context, recursive bool) ([]string, error) {
+	input := &s3.ListObjectsV2Input{Bucket: aws.String(s.bucket), Prefix: aws.String(s.prefix)}
+	if !recursive {
+		input.Delimiter = aws.String("/")
+	}
+
+	var names []string
+	paginator := s3.NewListObjectsV2Paginator(s.client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range page.Contents {
+			if name, ok := relativeName(aws.ToString(object.Key), s.prefix, recursive); ok {
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names, nil
+}
+
+// Read object
+func (s *s3Storage) Read(ctx context.Context, name string) ([]byte, error) {
+	output, err := s.client.GetObject(ctx, &s3.GetObjectInput{Bucket: aws.String(s.bucket), Key: aws.String(s.prefix + name)})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	defer output.Body.Close()
+
+	return ioutil.ReadAll(output.Body)
+}
+
+// Write object with content type
+func (s *s3Storage) Write(ctx context.Context, name string, data []byte, contentType string) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(s.prefix + name),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(contentType),
+	})
+
+	return err
+}
+
+// Exists object
+func (s *s3Storage) Exists(ctx context.Context, name string) (bool, error) {
+	_, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{Bucket: aws.String(s.bucket), Key: aws.String(s.prefix + name)})
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Get s3:// URI of object
+func (s *s3Storage) URI(name string) string {
+	return "s3://" + s.bucket + "/" + s.prefix + name
+}
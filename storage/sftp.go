@@ -0,0 +1,313 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"os/user"
+	"path"
+	"path/filepath"
+	"syscall"
+	"time"
+)
+
+const (
+	// SFTPPoolSize is max number of connections to SFTP server
+	SFTPPoolSize = 4
+
+	// SFTPRetries is number of attempts of an operation failed by transient error
+	SFTPRetries = 3
+
+	// SFTPRetryDelay is wait before first retry, it doubles on each retry
+	SFTPRetryDelay = 500 * time.Millisecond
+)
+
+// sftpStorage is files under directory of SFTP server
+// location is sftp://user@host:port/path, password may be given by LGTMGEN_SFTP_PASSWORD
+// keys of ssh-agent and ~/.ssh are used, host key is verified by ~/.ssh/known_hosts
+type sftpStorage struct {
+	address string
+	config  *ssh.ClientConfig
+	uri     string
+	dir     string
+
+	// agentConn is connection to ssh-agent, nil without agent
+	agentConn net.Conn
+
+	// pool keeps idle connections, slots bounds number of open connections
+	pool  chan *sftp.Client
+	slots chan struct{}
+}
+
+// constructor
+func newSFTP(ctx context.Context, location *url.URL) (Storage, error) {
+	config, agentConn, err := sshConfig(location)
+	if err != nil {
+		return nil, err
+	}
+	port := location.Port()
+	if port == "" {
+		port = "22"
+	}
+	dir := location.Path
+	if dir == "" {
+		dir = "/"
+	}
+
+	return &sftpStorage{
+		address:   net.JoinHostPort(location.Hostname(), port),
+		config:    config,
+		uri:       "sftp://" + location.Host,
+		dir:       dir,
+		agentConn: agentConn,
+		pool:      make(chan *sftp.Client, SFTPPoolSize),
+		slots:     make(chan struct{}, SFTPPoolSize),
+	}, nil
+}
+
+// Build ssh client config of user and credentials
+// connection to ssh-agent is returned to be closed with storage
+func sshConfig(location *url.URL) (*ssh.ClientConfig, net.Conn, error) {
+	name := location.User.Username()
+	if name == "" {
+		current, err := user.Current()
+		if err != nil {
+			return nil, nil, err
+		}
+		name = current.Username
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, nil, err
+	}
+	hostKeyCallback, err := knownhosts.New(filepath.Join(home, ".ssh", "known_hosts"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("known_hosts is required to verify sftp server: %s", err)
+	}
+
+	// agent, key files and password in this order
+	var methods []ssh.AuthMethod
+	var agentConn net.Conn
+	if socket := os.Getenv("SSH_AUTH_SOCK"); socket != "" {
+		if conn, err := net.Dial("unix", socket); err == nil {
+			agentConn = conn
+			methods = append(methods, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+		}
+	}
+	var signers []ssh.Signer
+	for _, key := range []string{"id_ed25519", "id_ecdsa", "id_rsa"} {
+		data, err := ioutil.ReadFile(filepath.Join(home, ".ssh", key))
+		if err != nil {
+			continue
+		}
+		if signer, err := ssh.ParsePrivateKey(data); err == nil {
+			signers = append(signers, signer)
+		}
+	}
+	if len(signers) > 0 {
+		methods = append(methods, ssh.PublicKeys(signers...))
+	}
+	password, ok := location.User.Password()
+	if !ok {
+		password = os.Getenv("LGTMGEN_SFTP_PASSWORD")
+	}
+	if password != "" {
+		methods = append(methods, ssh.Password(password))
+	}
+
+	return &ssh.ClientConfig{
+		User:            name,
+		Auth:            methods,
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         30 * time.Second,
+	}, agentConn, nil
+}
+
+// List files in directory
+func (s *sftpStorage) List(ctx context.Context, recursive bool) ([]string, error) {
+	var names []string
+	err := s.do(ctx, func(client *sftp.Client) error {
+		names = nil
+		walker := client.Walk(s.dir)
+		for walker.Step() {
+			if err := walker.Err(); err != nil {
+				return err
+			}
+			if walker.Stat().IsDir() {
+				if !recursive && path.Clean(walker.Path()) != path.Clean(s.dir) {
+					walker.SkipDir()
+				}
+				continue
+			}
+			name, err := filepath.Rel(s.dir, walker.Path())
+			if err != nil {
+				return err
+			}
+			names = append(names, filepath.ToSlash(name))
+		}
+		return nil
+	})
+
+	return names, err
+}
+
+// Read file
+func (s *sftpStorage) Read(ctx context.Context, name string) ([]byte, error) {
+	var data []byte
+	err := s.do(ctx, func(client *sftp.Client) error {
+		file, err := client.Open(path.Join(s.dir, name))
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		data, err = ioutil.ReadAll(file)
+		return err
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+
+	return data, err
+}
+
+// Write file creating its directory
+// content type is not stored in filesystem
+func (s *sftpStorage) Write(ctx context.Context, name string, data []byte, contentType string) error {
+	return s.do(ctx, func(client *sftp.Client) error {
+		filePath := path.Join(s.dir, name)
+		if err := client.MkdirAll(path.Dir(filePath)); err != nil {
+			return err
+		}
+		file, err := client.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+		if err != nil {
+			return err
+		}
+		if _, err := file.Write(data); err != nil {
+			file.Close()
+			return err
+		}
+		return file.Close()
+	})
+}
+
+// Exists file
+func (s *sftpStorage) Exists(ctx context.Context, name string) (bool, error) {
+	exists := false
+	err := s.do(ctx, func(client *sftp.Client) error {
+		_, err := client.Stat(path.Join(s.dir, name))
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		exists = err == nil
+		return err
+	})
+
+	return exists, err
+}
+
+// Get sftp:// URI of file
+func (s *sftpStorage) URI(name string) string {
+	return s.uri + path.Join(s.dir, name)
+}
+
+// Close pooled connections and connection to ssh-agent
+func (s *sftpStorage) Close() error {
+	for {
+		select {
+		case client := <-s.pool:
+			client.Close()
+		default:
+			if s.agentConn != nil {
+				return s.agentConn.Close()
+			}
+			return nil
+		}
+	}
+}
+
+// Run operation with pooled connection
+// operation is retried with new connection on transient error
+func (s *sftpStorage) do(ctx context.Context, operation func(client *sftp.Client) error) error {
+	delay := SFTPRetryDelay
+	var err error
+	for attempt := 1; ; attempt++ {
+		var client *sftp.Client
+		if client, err = s.get(ctx); err == nil {
+			err = operation(client)
+			s.put(client, err)
+		}
+		if err == nil || !isTransient(err) || attempt == SFTPRetries {
+			return err
+		}
+
+		select {
+		case <-time.After(delay):
+			delay *= 2
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Get idle connection or connect new one
+func (s *sftpStorage) get(ctx context.Context) (*sftp.Client, error) {
+	select {
+	case client := <-s.pool:
+		return client, nil
+	default:
+	}
+
+	select {
+	case client := <-s.pool:
+		return client, nil
+	case s.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	conn, err := ssh.Dial("tcp", s.address, s.config)
+	if err != nil {
+		<-s.slots
+		return nil, err
+	}
+	client, err := sftp.NewClient(conn)
+	if err != nil {
+		conn.Close()
+		<-s.slots
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// Return connection to pool
+// connection is closed if it failed by transient error
+func (s *sftpStorage) put(client *sftp.Client, err error) {
+	if err != nil && isTransient(err) {
+		client.Close()
+		<-s.slots
+		return
+	}
+	s.pool <- client
+}
+
+// Error may be solved by retry
+// e.g. connection reset, server closed connection
+func isTransient(err error) bool {
+	var netError net.Error
+
+	return errors.As(err, &netError) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, sftp.ErrSSHFxConnectionLost) ||
+		errors.Is(err, syscall.ECONNRESET)
+}
@@ -0,0 +1,130 @@
+// Package storage reads and writes images of local directories and cloud buckets.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// ErrNotFound is returned when object does not exist
+var ErrNotFound = errors.New("not found")
+
+// ErrInvalidName is returned when name leaves location, e.g. "../cat.jpg"
+var ErrInvalidName = errors.New("invalid name")
+
+// Storage reads and writes objects under a location
+// e.g. directory, bucket prefix
+// names are slash separated paths relative to the location
+type Storage interface {
+	// List returns names of objects under location
+	// objects in sub directories are listed if recursive
+	List(ctx context.Context, recursive bool) ([]string, error)
+
+	// Read returns data of object
+	Read(ctx context.Context, name string) ([]byte, error)
+
+	// Write stores data as object of content type
+	Write(ctx context.Context, name string, data []byte, contentType string) error
+
+	// Exists reports whether object exists
+	Exists(ctx context.Context, name string) (bool, error)
+
+	// URI returns location of object to report
+	URI(name string) string
+}
+
+// factories are registered storages by URI scheme
+var factories = map[string]func(ctx context.Context, location *url.URL) (Storage, error){
+	"az":   newAzure,
+	"gs":   newGCS,
+	"s3":   newS3,
+	"sftp": newSFTP,
+}
+
+// constructor
+// location is URI of registered scheme or local directory path
+// e.g.
+// "s3://bucket/images/"                               => objects under images/ of bucket
+// "https://account.blob.core.windows.net/container/" => blobs of Azure container
+// "/path/to/images/"                                  => files of local directory
+func Open(ctx context.Context, location string) (Storage, error) {
+	if !IsRemote(location) {
+		return newLocal(location), nil
+	}
+
+	u, err := url.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("bucket is required in %s", location)
+	}
+	if isAzureURL(u) {
+		return newAzure(ctx, u)
+	}
+
+	return factories[strings.ToLower(u.Scheme)](ctx, u)
+}
+
+// Location is URI of registered storage or Azure Blob Storage URL
+func IsRemote(location string) bool {
+	scheme, _, ok := strings.Cut(location, "://")
+	if !ok {
+		return false
+	}
+	if _, ok := factories[strings.ToLower(scheme)]; ok {
+		return true
+	}
+	u, err := url.Parse(location)
+
+	return err == nil && isAzureURL(u)
+}
+
+// Get registered URI schemes
+func Schemes() []string {
+	var schemes []string
+	for scheme := range factories {
+		schemes = append(schemes, scheme)
+	}
+	sort.Strings(schemes)
+
+	return schemes
+}
+
+// Get key prefix of bucket location
+// e.g.
+// "s3://bucket/images" => "images/"
+// "s3://bucket/"       => ""
+func keyPrefix(location *url.URL) string {
+	prefix := strings.TrimPrefix(location.Path, "/")
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+
+	return prefix
+}
+
+// Get name of key relative to prefix
+// keys in sub directories are excluded unless recursive
+// name is key as is to read it, keys of empty, "." or ".." segments are excluded
+// since their names would leave location of other storages, e.g. "in/../../cat.jpg"
+func relativeName(key string, prefix string, recursive bool) (string, bool) {
+	name := strings.TrimPrefix(key, prefix)
+	if name == "" || strings.HasSuffix(name, "/") {
+		return "", false
+	}
+	if !recursive && strings.Contains(name, "/") {
+		return "", false
+	}
+	for _, segment := range strings.Split(name, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return "", false
+		}
+	}
+
+	return name, true
+}
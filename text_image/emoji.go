@@ -0,0 +1,172 @@
+package text_image
+
+import (
+	"fmt"
+	"image"
+	_ "image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// EmojiSource provides color images of emoji
+// fonts can not rasterize color emoji, so they are composited from images
+type EmojiSource interface {
+	// Emoji returns image of emoji sequence
+	Emoji(sequence string) (image.Image, bool)
+}
+
+// EmojiDir is directory of emoji PNG files named by code points
+// e.g.
+// Twemoji    "1f44d.png", "1f468-200d-1f4bb.png"
+// Noto Emoji "emoji_u1f44d.png", "emoji_u1f468_200d_1f4bb.png"
+type EmojiDir struct {
+	Dir string
+
+	mu    sync.Mutex
+	cache map[string]image.Image
+}
+
+// constructor
+func NewEmojiDir(dir string) (*EmojiDir, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+
+	return &EmojiDir{Dir: dir, cache: map[string]image.Image{}}, nil
+}
+
+// Find emoji image by code points
+// missing images are cached as nil
+func (d *EmojiDir) Emoji(sequence string) (image.Image, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if img, ok := d.cache[sequence]; ok {
+		return img, img != nil
+	}
+
+	var img image.Image
+	for _, name := range emojiFileNames(sequence) {
+		file, err := os.Open(filepath.Join(d.Dir, name))
+		if err != nil {
+			continue
+		}
+		img, _, err = image.Decode(file)
+		file.Close()
+		if err == nil {
+			break
+		}
+	}
+	d.cache[sequence] = img
+
+	return img, img != nil
+}
+
+// Candidate file names of emoji sequence
+// variation selector is optional in most emoji sets
+func emojiFileNames(sequence string) []string {
+	var all, stripped []string
+	for _, r := range sequence {
+		code := fmt.Sprintf("%x", r)
+		all = append(all, code)
+		if r != variationSelector {
+			stripped = append(stripped, code)
+		}
+	}
+
+	return []string{
+		strings.Join(all, "-") + ".png",
+		strings.Join(stripped, "-") + ".png",
+		"emoji_u" + strings.Join(stripped, "_") + ".png",
+		"emoji_u" + strings.Join(all, "_") + ".png",
+	}
+}
+
+// Code points composing emoji sequence
+const (
+	variationSelector = 0xfe0f
+	zeroWidthJoiner   = 0x200d
+	keycap            = 0x20e3
+)
+
+// segment is run of text or single emoji
+type segment struct {
+	text  string
+	emoji image.Image
+}
+
+// Split text into text runs and emoji found in source
+// emoji not found in source are left as text
+func splitEmoji(text string, source EmojiSource) []segment {
+	runes := []rune(text)
+	var segments []segment
+	var buf []rune
+	for i := 0; i < len(runes); {
+		if source != nil && isEmoji(runes[i]) {
+			end := emojiEnd(runes, i)
+			if img, ok := source.Emoji(string(runes[i:end])); ok {
+				if len(buf) > 0 {
+					segments = append(segments, segment{text: string(buf)})
+					buf = nil
+				}
+				segments = append(segments, segment{emoji: img})
+				i = end
+				continue
+			}
+		}
+		buf = append(buf, runes[i])
+		i++
+	}
+	if len(buf) > 0 {
+		segments = append(segments, segment{text: string(buf)})
+	}
+
+	return segments
+}
+
+// Get end index of emoji sequence starting at i
+func emojiEnd(runes []rune, i int) int {
+	// flags are pairs of regional indicators
+	if isRegionalIndicator(runes[i]) && i+1 < len(runes) && isRegionalIndicator(runes[i+1]) {
+		return i + 2
+	}
+
+	end := i + 1
+	for end < len(runes) {
+		switch r := runes[end]; {
+		case r == variationSelector, r == keycap, r >= 0x1f3fb && r <= 0x1f3ff:
+			end++
+		case r == zeroWidthJoiner && end+1 < len(runes):
+			end += 2
+		default:
+			return end
+		}
+	}
+
+	return end
+}
+
+// Rune starts emoji
+func isEmoji(r rune) bool {
+	switch {
+	case r >= 0x1f000 && r <= 0x1faff:
+		return true
+	case r >= 0x2600 && r <= 0x27bf:
+		return true
+	case r >= 0x2b00 && r <= 0x2bff:
+		return true
+	}
+
+	return false
+}
+
+// Rune is regional indicator of flag
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
@@ -0,0 +1,42 @@
+package text_image
+
+import (
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/opentype"
+	"io/ioutil"
+	"sync"
+)
+
+// parsed fonts by path
+// empty path is embedded font
+var (
+	fontMu    sync.Mutex
+	fontCache = map[string]*opentype.Font{}
+)
+
+// Load TTF/OTF font file
+// embedded font is loaded if path is empty, parsed fonts are cached
+func LoadFont(path string) (*opentype.Font, error) {
+	fontMu.Lock()
+	defer fontMu.Unlock()
+
+	if f, ok := fontCache[path]; ok {
+		return f, nil
+	}
+
+	data := gobold.TTF
+	if path != "" {
+		var err error
+		if data, err = ioutil.ReadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	f, err := opentype.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	fontCache[path] = f
+
+	return f, nil
+}
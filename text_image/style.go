@@ -0,0 +1,171 @@
+package text_image
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"strconv"
+	"strings"
+)
+
+// shadowOffsetRatio is shadow offset to font size
+const shadowOffsetRatio = 0.05
+
+// ShadowColor is color of drop shadow
+var ShadowColor = color.NRGBA{A: 0x80}
+
+// Align is horizontal alignment of lines
+type Align int
+
+// Alignments of lines
+const (
+	AlignCenter Align = iota
+	AlignLeft
+	AlignRight
+)
+
+// DefaultLineSpacing is distance of baselines to line height
+const DefaultLineSpacing = 1.2
+
+// Parse alignment name
+func ParseAlign(s string) (Align, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "center":
+		return AlignCenter, nil
+	case "left":
+		return AlignLeft, nil
+	case "right":
+		return AlignRight, nil
+	}
+
+	return AlignCenter, fmt.Errorf("invalid text align %s", s)
+}
+
+// Style is decoration and layout of rendered text
+type Style struct {
+	// Color fills text
+	Color color.Color
+
+	// StrokeColor outlines text by StrokeWidth pixels
+	StrokeColor color.Color
+	StrokeWidth int
+
+	// Shadow drops shadow on bottom-right of text
+	Shadow bool
+
+	// Align lines in text block
+	Align Align
+
+	// LineSpacing is distance of baselines to line height
+	// 0 means DefaultLineSpacing
+	LineSpacing float64
+}
+
+// DefaultStyle is white centered text without decoration
+var DefaultStyle = Style{Color: color.White, StrokeColor: color.Black}
+
+// Get line spacing or default
+func (s Style) lineSpacing() float64 {
+	if s.LineSpacing <= 0 {
+		return DefaultLineSpacing
+	}
+
+	return s.LineSpacing
+}
+
+// namedColors are colors accepted by name
+var namedColors = map[string]color.NRGBA{
+	"white":  {R: 0xff, G: 0xff, B: 0xff, A: 0xff},
+	"black":  {A: 0xff},
+	"red":    {R: 0xff, A: 0xff},
+	"green":  {G: 0x80, A: 0xff},
+	"blue":   {B: 0xff, A: 0xff},
+	"yellow": {R: 0xff, G: 0xff, A: 0xff},
+	"orange": {R: 0xff, G: 0xa5, A: 0xff},
+	"pink":   {R: 0xff, G: 0xc0, B: 0xcb, A: 0xff},
+	"gray":   {R: 0x80, G: 0x80, B: 0x80, A: 0xff},
+}
+
+// Parse color name or hex code
+// e.g.
+// "white"     => #ffffff
+// "#f80"      => #ff8800
+// "#ff880080" => #ff8800 with half alpha
+func ParseColor(s string) (color.NRGBA, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if c, ok := namedColors[s]; ok {
+		return c, nil
+	}
+
+	hex := strings.TrimPrefix(s, "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) == 6 {
+		hex += "ff"
+	}
+	if len(hex) != 8 {
+		return color.NRGBA{}, fmt.Errorf("invalid color %s", s)
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return color.NRGBA{}, fmt.Errorf("invalid color %s", s)
+	}
+
+	return color.NRGBA{R: uint8(v >> 24), G: uint8(v >> 16), B: uint8(v >> 8), A: uint8(v)}, nil
+}
+
+// Paint text coverage with style
+// layers are drawn in order of shadow, stroke and fill
+func (s Style) paint(coverage *image.Alpha, fontSize float64) *image.NRGBA {
+	dst := image.NewNRGBA(coverage.Bounds())
+
+	outline := coverage
+	if s.StrokeWidth > 0 {
+		outline = dilate(coverage, s.StrokeWidth)
+	}
+
+	if s.Shadow {
+		offset := int(fontSize*shadowOffsetRatio + 0.5)
+		if offset < 1 {
+			offset = 1
+		}
+		r := dst.Bounds().Add(image.Pt(offset, offset))
+		draw.DrawMask(dst, r, image.NewUniform(ShadowColor), image.Point{}, outline, image.Point{}, draw.Over)
+	}
+	if s.StrokeWidth > 0 {
+		draw.DrawMask(dst, dst.Bounds(), image.NewUniform(s.StrokeColor), image.Point{}, outline, image.Point{}, draw.Over)
+	}
+	draw.DrawMask(dst, dst.Bounds(), image.NewUniform(s.Color), image.Point{}, coverage, image.Point{}, draw.Over)
+
+	return dst
+}
+
+// Grow coverage by radius pixels
+func dilate(src *image.Alpha, radius int) *image.Alpha {
+	bounds := src.Bounds()
+	dst := image.NewAlpha(bounds)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			a := src.AlphaAt(x, y).A
+			if a == 0 {
+				continue
+			}
+			// spread covered pixel to circle
+			for dy := -radius; dy <= radius; dy++ {
+				for dx := -radius; dx <= radius; dx++ {
+					if dx*dx+dy*dy > radius*radius {
+						continue
+					}
+					p := image.Pt(x+dx, y+dy)
+					if p.In(bounds) && dst.AlphaAt(p.X, p.Y).A < a {
+						dst.SetAlpha(p.X, p.Y, color.Alpha{A: a})
+					}
+				}
+			}
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,193 @@
+package text_image
+
+import (
+	"errors"
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+	"image"
+	"strings"
+)
+
+// ErrEmptyText is returned when there is nothing to render
+var ErrEmptyText = errors.New("text is empty")
+
+// Text area ratio of the canvas
+const (
+	maxTextWidthRatio  = 0.8
+	maxTextHeightRatio = 0.3
+)
+
+// measureFontSize is font size used to measure text before fitting
+const measureFontSize = 100
+
+type TextImage struct {
+	Width  int
+	Height int
+	Font   *opentype.Font
+	Style  Style
+
+	// Emoji are composited from source if given
+	Emoji EmojiSource
+}
+
+// constructor
+func NewTextImage(width int, height int) (*TextImage, error) {
+	// load embedded font
+	f, err := LoadFont("")
+	if err != nil {
+		return nil, err
+	}
+
+	return &TextImage{
+		Width:  width,
+		Height: height,
+		Font:   f,
+		Style:  DefaultStyle,
+	}, nil
+}
+
+// Render text to transparent image
+// text is fitted into canvas and centered, lines are separated by \n
+func (t *TextImage) Render(text string) (*image.NRGBA, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, ErrEmptyText
+	}
+
+	var lines [][]segment
+	for _, line := range strings.Split(text, "\n") {
+		lines = append(lines, splitEmoji(strings.TrimSpace(line), t.Emoji))
+	}
+	spacing := t.Style.lineSpacing()
+
+	// measure text with base size
+	face, err := t.newFace(measureFontSize)
+	if err != nil {
+		return nil, err
+	}
+	width, height := measureLines(face, lines, spacing)
+	face.Close()
+
+	// fit font size into canvas
+	// each line may use text height ratio of canvas
+	heightRatio := maxTextHeightRatio * float64(len(lines))
+	if heightRatio > maxTextWidthRatio {
+		heightRatio = maxTextWidthRatio
+	}
+	size := measureFontSize * float64(t.Width) * maxTextWidthRatio / width
+	if heightSize := measureFontSize * float64(t.Height) * heightRatio / height; heightSize < size {
+		size = heightSize
+	}
+
+	face, err = t.newFace(size)
+	if err != nil {
+		return nil, err
+	}
+	defer face.Close()
+
+	// draw text coverage on center
+	coverage := image.NewAlpha(image.Rect(0, 0, t.Width, t.Height))
+	drawer := &font.Drawer{
+		Dst:  coverage,
+		Src:  image.Opaque,
+		Face: face,
+	}
+	metrics := face.Metrics()
+	lineHeight := metrics.Ascent + metrics.Descent
+	blockWidth, blockHeight := measureLines(face, lines, spacing)
+	left := (float64(t.Width) - blockWidth) / 2
+	baseline := fixed.Int26_6(((float64(t.Height)-blockHeight)/2)*64) + metrics.Ascent
+
+	// emoji are placed in line box and drawn after styling text
+	type placement struct {
+		img  image.Image
+		rect image.Rectangle
+	}
+	var placements []placement
+	for _, segments := range lines {
+		lineWidth := measureLine(face, segments)
+		x := left
+		switch t.Style.Align {
+		case AlignCenter:
+			x += (blockWidth - lineWidth) / 2
+		case AlignRight:
+			x += blockWidth - lineWidth
+		}
+		drawer.Dot = fixed.Point26_6{X: fixed.Int26_6(x * 64), Y: baseline}
+
+		for _, seg := range segments {
+			if seg.emoji == nil {
+				drawer.DrawString(seg.text)
+				continue
+			}
+			emojiWidth := emojiAdvance(seg.emoji, lineHeight)
+			min := image.Pt(drawer.Dot.X.Round(), (drawer.Dot.Y - metrics.Ascent).Round())
+			placements = append(placements, placement{
+				img:  seg.emoji,
+				rect: image.Rectangle{Min: min, Max: min.Add(image.Pt(emojiWidth.Round(), lineHeight.Round()))},
+			})
+			drawer.Dot.X += emojiWidth
+		}
+		baseline += fixed.Int26_6(float64(lineHeight) * spacing)
+	}
+
+	dst := t.Style.paint(coverage, size)
+	for _, p := range placements {
+		xdraw.CatmullRom.Scale(dst, p.rect, p.img, p.img.Bounds(), xdraw.Over, nil)
+	}
+
+	return dst, nil
+}
+
+// Create font face of size
+func (t *TextImage) newFace(size float64) (font.Face, error) {
+	return opentype.NewFace(t.Font, &opentype.FaceOptions{
+		Size:    size,
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+}
+
+// Measure width of widest line and height of lines in pixel
+func measureLines(face font.Face, lines [][]segment, spacing float64) (float64, float64) {
+	metrics := face.Metrics()
+	lineHeight := float64(metrics.Ascent+metrics.Descent) / 64
+
+	var width float64
+	for _, segments := range lines {
+		if w := measureLine(face, segments); w > width {
+			width = w
+		}
+	}
+	height := lineHeight * (1 + float64(len(lines)-1)*spacing)
+
+	return width, height
+}
+
+// Measure line width in pixel
+func measureLine(face font.Face, segments []segment) float64 {
+	lineHeight := face.Metrics().Ascent + face.Metrics().Descent
+
+	var advance fixed.Int26_6
+	for _, seg := range segments {
+		if seg.emoji != nil {
+			advance += emojiAdvance(seg.emoji, lineHeight)
+			continue
+		}
+		advance += font.MeasureString(face, seg.text)
+	}
+
+	return float64(advance) / 64
+}
+
+// Emoji width fitted in line height
+func emojiAdvance(img image.Image, lineHeight fixed.Int26_6) fixed.Int26_6 {
+	size := img.Bounds().Size()
+	if size.Y == 0 {
+		return 0
+	}
+
+	return lineHeight * fixed.Int26_6(size.X) / fixed.Int26_6(size.Y)
+}
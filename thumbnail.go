@@ -0,0 +1,68 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"github.com/disintegration/imaging"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/report"
+	"path/filepath"
+	"strings"
+)
+
+// ThumbnailSuffix is added to base name of thumbnail
+// e.g. cat.png => cat_thumb.jpg
+const ThumbnailSuffix = "_thumb"
+
+// ThumbnailQuality is JPEG quality of thumbnails
+const ThumbnailQuality = 85
+
+// Get thumbnail path of output
+func thumbnailPath(output string) string {
+	return strings.TrimSuffix(output, filepath.Ext(output)) + ThumbnailSuffix + ".jpg"
+}
+
+// File is thumbnail of output
+func isThumbnail(path string) bool {
+	return strings.HasSuffix(path, ThumbnailSuffix+".jpg")
+}
+
+// Write JPEG thumbnail of width next to output of successful result
+// output keeps success even if thumbnail fails, e.g. video output
+func (cli *CLI) thumbnailResult(result *report.Result, width int) {
+	if width <= 0 || result.Status != report.StatusSuccess || lgtm.IsVideo(result.Output) {
+		return
+	}
+
+	path := thumbnailPath(result.Output)
+	img, err := imaging.Open(result.Output)
+	if err != nil {
+		cli.log.Warnf("[thumbnail: %s] %s", err, path)
+		return
+	}
+	if img.Bounds().Dx() > width {
+		img = imaging.Resize(img, width, 0, imaging.Lanczos)
+	}
+	if err := imaging.Save(img, path, imaging.JPEGQuality(ThumbnailQuality)); err != nil {
+		cli.log.Warnf("[thumbnail: %s] %s", err, path)
+		return
+	}
+	cli.log.Verbosef("[thumbnail] %s", path)
+}
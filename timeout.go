@@ -0,0 +1,56 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrTimeout is reported when image is not processed in time
+var ErrTimeout = errors.New("timed out")
+
+// timeouts bound processing time of images
+type timeouts struct {
+	// deadline is done when overall timeout is exceeded
+	deadline context.Context
+
+	// perFile bounds time of each image, 0 is unlimited
+	perFile time.Duration
+}
+
+// Create context to process an image
+func (t timeouts) file() (context.Context, context.CancelFunc) {
+	if t.perFile <= 0 {
+		return context.WithCancel(t.deadline)
+	}
+
+	return context.WithTimeout(t.deadline, t.perFile)
+}
+
+// Replace context deadline error with ErrTimeout
+func timeoutError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrTimeout
+	}
+
+	return err
+}
@@ -0,0 +1,198 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/disintegration/imaging"
+	"github.com/neko-neko/lgtmgen/config"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/mask_image"
+	"github.com/neko-neko/lgtmgen/preview"
+	"github.com/neko-neko/lgtmgen/progress"
+	"github.com/neko-neko/lgtmgen/report"
+	"github.com/neko-neko/lgtmgen/tui"
+	"image"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Pick images and options interactively and generate them
+func (cli *CLI) runTUI(args []string) int {
+	var (
+		directory string
+		output    string
+		style     string
+		text      string
+		position  string
+		force     bool
+	)
+
+	// load config file
+	conf, err := cli.loadConfig(args[1:])
+	if err != nil {
+		return ExitCodeError
+	}
+
+	// Define option flag parse
+	flags := flag.NewFlagSet(Name+" tui", flag.ContinueOnError)
+	flags.SetOutput(cli.errStream)
+
+	flags.String("config", "", "Config file path (default ~/"+config.FileName+")")
+
+	flags.StringVar(&directory, "directory", ".", "Input directory path")
+	flags.StringVar(&directory, "d", ".", "Input directory path(Short)")
+
+	flags.StringVar(&output, "output", conf.Output, "Output directory path")
+	flags.StringVar(&output, "o", conf.Output, "Output directory path(Short)")
+
+	flags.BoolVar(&force, "force", false, "Force overwrite if outputfile exists")
+	flags.BoolVar(&force, "f", false, "Force overwrite if outputfile exists(Short)")
+
+	styles := strings.Join(mask_image.StyleNames(), ", ")
+	flags.StringVar(&style, "style", stringOr(conf.Style, mask_image.DefaultStyle), "Initial mask style("+styles+")")
+
+	flags.StringVar(&text, "text", conf.Text, "Initial text rendered instead of mask image")
+	flags.StringVar(&text, "t", conf.Text, "Initial text rendered instead of mask image(Short)")
+
+	flags.StringVar(&position, "position", stringOr(conf.Position, "center"), "Initial mask position")
+	flags.StringVar(&position, "p", stringOr(conf.Position, "center"), "Initial mask position(Short)")
+
+	// Parse commandline flag
+	if err := flags.Parse(args[1:]); err != nil {
+		return ExitCodeError
+	}
+
+	if output == "" {
+		cli.log.Errorf("output directory path is required.")
+		return ExitCodeError
+	}
+	if !progress.IsTerminal(cli.outStream) {
+		cli.log.Errorf("tui requires terminal.")
+		return ExitCodeError
+	}
+	files, err := listImages(directory)
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+
+	model := tui.New(tui.Config{
+		Files:     files,
+		Styles:    mask_image.StyleNames(),
+		Positions: lgtm.PositionNames(),
+		Settings:  tui.Settings{Style: style, Text: text, Position: position},
+		Preview:   previewSettings,
+		Generate: func(file string, settings tui.Settings) *report.Result {
+			outputFilePath := filepath.Join(output, lgtm.OutputFilename(filepath.Base(file), ""))
+			opts, err := settingsOptions(settings)
+			if err != nil {
+				return &report.Result{Input: file, Output: outputFilePath, Status: report.StatusFailed, Error: err}
+			}
+			return cli.maskFile(context.Background(), file, outputFilePath, force, nil, opts)
+		},
+	})
+	final, err := tea.NewProgram(model, tea.WithAltScreen(), tea.WithOutput(cli.outStream)).Run()
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+
+	// results are printed after leaving alternate screen
+	results := final.(tui.Model).Results()
+	if results == nil {
+		return ExitCodeOK
+	}
+	reporter := report.NewTextReporter(cli.log)
+	reporter.Each = true
+	for _, result := range results {
+		reporter.Report(result)
+	}
+	reporter.Finish()
+
+	return batchExitCode(reporter.Summary.Failed, len(results))
+}
+
+// List images of directory
+// hidden files and thumbnails are excluded
+func listImages(directory string) ([]string, error) {
+	entries, err := ioutil.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		path := filepath.Join(directory, entry.Name())
+		if entry.Mode().IsRegular() && !isHidden(entry.Name()) && isImageFile(path) && !isThumbnail(path) && !isComparison(path) {
+			files = append(files, path)
+		}
+	}
+
+	return files, nil
+}
+
+// Get LGTM options of settings
+func settingsOptions(settings tui.Settings) ([]lgtm.Option, error) {
+	mask, err := loadMask("", settings.Style, settings.Text, textOptions{})
+	if err != nil {
+		return nil, err
+	}
+	position, err := lgtm.ParsePosition(settings.Position)
+	if err != nil {
+		return nil, err
+	}
+
+	return []lgtm.Option{
+		lgtm.WithMask(mask.MaskImage),
+		lgtm.WithPosition(position),
+	}, nil
+}
+
+// Render preview of LGTM image of file with settings
+// image is downscaled first since terminal shows few pixels
+func previewSettings(file string, settings tui.Settings, columns int) (string, error) {
+	opts, err := settingsOptions(settings)
+	if err != nil {
+		return "", err
+	}
+	input, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer input.Close()
+
+	var output bytes.Buffer
+	opts = append(opts, lgtm.WithMaxSize(columns*2, columns*2))
+	if err := lgtm.Process(input, &output, imaging.PNG, opts...); err != nil {
+		return "", err
+	}
+	img, _, err := image.Decode(&output)
+	if err != nil {
+		return "", err
+	}
+
+	return preview.Render(img, preview.ModeANSI, columns)
+}
@@ -0,0 +1,440 @@
+// Package tui is interactive terminal UI to pick images and options of LGTM images.
+package tui
+
+import (
+	"fmt"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/neko-neko/lgtmgen/report"
+	"path/filepath"
+	"strings"
+)
+
+// Settings are options picked in UI
+type Settings struct {
+	Style    string
+	Text     string
+	Position string
+}
+
+// Config is images and options of UI
+type Config struct {
+	// Files are image paths listed in UI
+	Files []string
+
+	// Styles and Positions are choices of settings
+	Styles    []string
+	Positions []string
+
+	// Settings are initial settings
+	Settings Settings
+
+	// Preview renders LGTM image of file with settings in width of columns
+	Preview func(file string, settings Settings, columns int) (string, error)
+
+	// Generate writes LGTM image of file with settings
+	Generate func(file string, settings Settings) *report.Result
+}
+
+// focus is pane receiving keys
+type focus int
+
+const (
+	focusFiles focus = iota
+	focusSettings
+)
+
+// fields of settings pane
+const (
+	fieldStyle = iota
+	fieldText
+	fieldPosition
+	fieldCount
+)
+
+// state is phase of UI
+type state int
+
+const (
+	stateBrowsing state = iota
+	stateProcessing
+	stateDone
+)
+
+// previewMsg is rendered preview of key
+type previewMsg struct {
+	key  string
+	view string
+	err  error
+}
+
+// generatedMsg is result of a selected file
+type generatedMsg struct {
+	result *report.Result
+}
+
+// Model is bubbletea model of UI
+type Model struct {
+	config Config
+
+	settings Settings
+	focus    focus
+	field    int
+	cursor   int
+	selected map[int]bool
+	state    state
+
+	width, height int
+
+	// previewKey is key of last requested preview
+	previewKey string
+	preview    string
+	previewErr error
+
+	// queue is files to generate, results are generated ones
+	queue   []string
+	results []*report.Result
+}
+
+// constructor
+func New(config Config) Model {
+	return Model{
+		config:   config,
+		settings: config.Settings,
+		selected: map[int]bool{},
+		width:    80,
+		height:   24,
+	}
+}
+
+// Results are results of generated images
+// nil if UI quit without confirming
+func (m Model) Results() []*report.Result {
+	return m.results
+}
+
+// Init implements tea.Model
+func (m Model) Init() tea.Cmd {
+	return m.renderPreview()
+}
+
+// Update implements tea.Model
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.previewKey = ""
+		return m, m.renderPreview()
+	case previewMsg:
+		// drop stale preview
+		if msg.key == m.currentKey() {
+			m.preview, m.previewErr = msg.view, msg.err
+		}
+		return m, nil
+	case generatedMsg:
+		m.results = append(m.results, msg.result)
+		if len(m.queue) == 0 {
+			m.state = stateDone
+			return m, nil
+		}
+		return m, m.generateNext()
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+
+	return m, nil
+}
+
+// Handle key of current state and focus
+func (m Model) handleKey(key tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if key.Type == tea.KeyCtrlC {
+		return m, tea.Quit
+	}
+	switch m.state {
+	case stateProcessing:
+		return m, nil
+	case stateDone:
+		return m, tea.Quit
+	}
+
+	// text field takes typed keys
+	if m.focus == focusSettings && m.field == fieldText {
+		switch key.Type {
+		case tea.KeyRunes, tea.KeySpace:
+			m.settings.Text += string(key.Runes)
+			return m, m.renderPreview()
+		case tea.KeyBackspace:
+			if runes := []rune(m.settings.Text); len(runes) > 0 {
+				m.settings.Text = string(runes[:len(runes)-1])
+			}
+			return m, m.renderPreview()
+		}
+	}
+
+	switch key.String() {
+	case "q", "esc":
+		return m, tea.Quit
+	case "tab", "shift+tab":
+		m.focus = 1 - m.focus
+		return m, nil
+	case "enter":
+		return m.confirm()
+	case "up", "k":
+		if m.focus == focusFiles && m.cursor > 0 {
+			m.cursor--
+		} else if m.focus == focusSettings && m.field > 0 {
+			m.field--
+		}
+	case "down", "j":
+		if m.focus == focusFiles && m.cursor < len(m.config.Files)-1 {
+			m.cursor++
+		} else if m.focus == focusSettings && m.field < fieldCount-1 {
+			m.field++
+		}
+	case " ":
+		if m.focus == focusFiles {
+			m.selected[m.cursor] = !m.selected[m.cursor]
+		}
+		return m, nil
+	case "a":
+		if m.focus == focusFiles {
+			all := len(m.selectedFiles()) < len(m.config.Files)
+			for i := range m.config.Files {
+				m.selected[i] = all
+			}
+		}
+		return m, nil
+	case "left", "h":
+		m.change(-1)
+	case "right", "l":
+		m.change(1)
+	}
+
+	return m, m.renderPreview()
+}
+
+// Change choice of focused setting
+func (m *Model) change(delta int) {
+	if m.focus != focusSettings {
+		return
+	}
+	switch m.field {
+	case fieldStyle:
+		m.settings.Style = cycle(m.config.Styles, m.settings.Style, delta)
+	case fieldPosition:
+		m.settings.Position = cycle(m.config.Positions, m.settings.Position, delta)
+	}
+}
+
+// Start generating selected files, or file under cursor if none is selected
+func (m Model) confirm() (tea.Model, tea.Cmd) {
+	if len(m.config.Files) == 0 {
+		return m, nil
+	}
+	m.queue = m.selectedFiles()
+	if len(m.queue) == 0 {
+		m.queue = []string{m.config.Files[m.cursor]}
+	}
+	m.results = []*report.Result{}
+	m.state = stateProcessing
+
+	return m, m.generateNext()
+}
+
+// Generate next file of queue
+func (m *Model) generateNext() tea.Cmd {
+	file, settings, generate := m.queue[0], m.settings, m.config.Generate
+	m.queue = m.queue[1:]
+
+	return func() tea.Msg {
+		return generatedMsg{result: generate(file, settings)}
+	}
+}
+
+// Render preview of file under cursor in background
+// same preview is not rendered again
+func (m *Model) renderPreview() tea.Cmd {
+	if len(m.config.Files) == 0 || m.config.Preview == nil {
+		return nil
+	}
+	file, settings, columns, key := m.config.Files[m.cursor], m.settings, m.previewColumns(), m.currentKey()
+	if key == m.previewKey {
+		return nil
+	}
+	m.previewKey = key
+	preview := m.config.Preview
+
+	return func() tea.Msg {
+		view, err := preview(file, settings, columns)
+		return previewMsg{key: key, view: view, err: err}
+	}
+}
+
+// Get key of preview of file under cursor and settings
+func (m Model) currentKey() string {
+	if len(m.config.Files) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%s\x00%v\x00%d", m.config.Files[m.cursor], m.settings, m.previewColumns())
+}
+
+// Get width of preview pane
+// preview is right of list and fits in height, a cell draws two pixel rows
+func (m Model) previewColumns() int {
+	columns := m.width - listWidth - 2
+	if columns > m.height*3 {
+		columns = m.height * 3
+	}
+	if columns < 10 {
+		columns = 10
+	}
+
+	return columns
+}
+
+// Get selected files in listed order
+func (m Model) selectedFiles() []string {
+	var files []string
+	for i, file := range m.config.Files {
+		if m.selected[i] {
+			files = append(files, file)
+		}
+	}
+
+	return files
+}
+
+// Get next choice of current one
+func cycle(choices []string, current string, delta int) string {
+	if len(choices) == 0 {
+		return current
+	}
+	index := 0
+	for i, choice := range choices {
+		if choice == current {
+			index = i
+		}
+	}
+
+	return choices[(index+delta+len(choices))%len(choices)]
+}
+
+// listWidth is width of file list and settings pane
+const listWidth = 36
+
+// View implements tea.Model
+func (m Model) View() string {
+	switch m.state {
+	case stateProcessing:
+		return fmt.Sprintf("Generating %d/%d...\n", len(m.results)+1, len(m.results)+len(m.queue)+1)
+	case stateDone:
+		var b strings.Builder
+		for _, result := range m.results {
+			label, path := string(result.Status), result.Output
+			if result.Error != nil {
+				label = result.Error.Error()
+			}
+			if result.Status == report.StatusFailed {
+				path = result.Input
+			}
+			fmt.Fprintf(&b, "[%s] %s\n", label, path)
+		}
+		b.WriteString("\nPress any key to quit.\n")
+		return b.String()
+	}
+
+	left := append(m.fileLines(), "")
+	left = append(left, m.settingLines()...)
+	left = append(left, "", "tab: switch pane  space: select  a: all", "enter: generate  q: quit")
+
+	right := strings.Split(m.preview, "\n")
+	if m.previewErr != nil {
+		right = []string{m.previewErr.Error()}
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(left) || i < len(right); i++ {
+		var l, r string
+		if i < len(left) {
+			l = left[i]
+		}
+		if i < len(right) {
+			r = right[i]
+		}
+		fmt.Fprintf(&b, "%s  %s\n", pad(l, listWidth), r)
+	}
+
+	return b.String()
+}
+
+// Get lines of file list around cursor
+func (m Model) fileLines() []string {
+	rows := m.height - fieldCount - 6
+	if rows < 3 {
+		rows = 3
+	}
+	start := 0
+	if m.cursor >= rows {
+		start = m.cursor - rows + 1
+	}
+
+	lines := []string{"Images"}
+	if len(m.config.Files) == 0 {
+		return append(lines, "  (no images)")
+	}
+	for i := start; i < len(m.config.Files) && i < start+rows; i++ {
+		mark := "[ ]"
+		if m.selected[i] {
+			mark = "[x]"
+		}
+		line := fmt.Sprintf("%s %s", mark, filepath.Base(m.config.Files[i]))
+		lines = append(lines, highlight(pad(line, listWidth), m.focus == focusFiles && i == m.cursor))
+	}
+
+	return lines
+}
+
+// Get lines of settings pane
+func (m Model) settingLines() []string {
+	text := m.settings.Text
+	if m.focus == focusSettings && m.field == fieldText {
+		text += "_"
+	}
+	values := []string{
+		fieldStyle:    "Style    < " + m.settings.Style + " >",
+		fieldText:     "Text     " + text,
+		fieldPosition: "Position < " + m.settings.Position + " >",
+	}
+
+	lines := []string{"Settings"}
+	for i, value := range values {
+		lines = append(lines, highlight(pad(value, listWidth), m.focus == focusSettings && i == m.field))
+	}
+
+	return lines
+}
+
+// Pad or truncate line to width
+// highlighted line is not truncated, so lines are padded before highlight
+func pad(line string, width int) string {
+	visible := []rune(stripEscapes(line))
+	if len(visible) > width {
+		return string(visible[:width-1]) + "~"
+	}
+
+	return line + strings.Repeat(" ", width-len(visible))
+}
+
+// Reverse colors of focused line
+func highlight(line string, on bool) string {
+	if !on {
+		return line
+	}
+
+	return "\x1b[7m" + line + "\x1b[0m"
+}
+
+// Remove escape sequences of highlight
+func stripEscapes(line string) string {
+	return strings.NewReplacer("\x1b[7m", "", "\x1b[0m", "").Replace(line)
+}
@@ -0,0 +1,72 @@
+package uploader
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+)
+
+// ImgurEndpoint is image upload API of imgur
+const ImgurEndpoint = "https://api.imgur.com/3/image"
+
+// Imgur uploads anonymously with client ID
+type imgur struct {
+	clientID string
+	client   *http.Client
+}
+
+func newImgur(clientID string, client *http.Client) (Uploader, error) {
+	if clientID == "" {
+		return nil, fmt.Errorf("imgur requires client ID")
+	}
+
+	return &imgur{clientID: clientID, client: client}, nil
+}
+
+func (u *imgur) Upload(name string, data []byte) (string, error) {
+	// build multipart body
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("image", name)
+	if err != nil {
+		return "", err
+	}
+	if _, err := part.Write(data); err != nil {
+		return "", err
+	}
+	writer.WriteField("type", "file")
+	if err := writer.Close(); err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, ImgurEndpoint, body)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Client-ID "+u.clientID)
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Data struct {
+			Link  string      `json:"link"`
+			Error interface{} `json:"error"`
+		} `json:"data"`
+		Success bool `json:"success"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("imgur: %s", resp.Status)
+	}
+	if !result.Success {
+		return "", fmt.Errorf("imgur: %s %v", resp.Status, result.Data.Error)
+	}
+
+	return result.Data.Link, nil
+}
@@ -0,0 +1,40 @@
+package uploader
+
+import (
+	"fmt"
+	"html"
+	"sort"
+)
+
+// snippets are formats of image snippet by name
+// markdown is also body of GitHub comment
+var snippets = map[string]func(url string) string{
+	"markdown": Markdown,
+	"html":     HTML,
+}
+
+// Format HTML image snippet
+func HTML(url string) string {
+	return fmt.Sprintf(`<img src="%s" alt="LGTM">`, html.EscapeString(url))
+}
+
+// Get snippet format by name
+func Snippet(name string) (func(url string) string, error) {
+	snippet, ok := snippets[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown snippet %s", name)
+	}
+
+	return snippet, nil
+}
+
+// Get snippet names
+func SnippetNames() []string {
+	var names []string
+	for name := range snippets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
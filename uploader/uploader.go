@@ -0,0 +1,50 @@
+// Package uploader publishes generated images to image hosting services.
+package uploader
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// DefaultTimeout is timeout of upload request
+const DefaultTimeout = 60 * time.Second
+
+// Uploader publishes image
+type Uploader interface {
+	// Upload sends image data and returns its public url
+	Upload(name string, data []byte) (string, error)
+}
+
+// factories are registered uploaders by name
+var factories = map[string]func(credential string, client *http.Client) (Uploader, error){
+	"imgur": newImgur,
+}
+
+// constructor
+// credential is service specific key such as client ID
+func NewUploader(name string, credential string) (Uploader, error) {
+	factory, ok := factories[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown uploader %s", name)
+	}
+
+	return factory(credential, &http.Client{Timeout: DefaultTimeout})
+}
+
+// Get registered uploader names
+func Names() []string {
+	var names []string
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Format markdown image snippet
+func Markdown(url string) string {
+	return fmt.Sprintf("![LGTM](%s)", url)
+}
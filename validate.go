@@ -0,0 +1,206 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/neko-neko/lgtmgen/config"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/logger"
+	"github.com/neko-neko/lgtmgen/mask_image"
+	"image"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// DefaultMinMaskWidth is narrowest mask in pixels which is readable
+const DefaultMinMaskWidth = 48
+
+// validation checks sources against mask options
+type validation struct {
+	// mask is size of mask before scaling
+	mask      image.Point
+	maskScale float64
+
+	minMaskWidth int
+	maxPixels    int
+}
+
+// Check images of directory without generating anything
+// unsupported formats, corrupt headers and images too small for mask are reported
+func (cli *CLI) runValidate(args []string) int {
+	var (
+		directory    string
+		recursive    bool
+		hidden       bool
+		ext          string
+		verbose      bool
+		maskPath     string
+		style        string
+		text         string
+		maskScale    float64
+		minMaskWidth int
+		maxPixels    int
+	)
+
+	// load config file
+	conf, err := cli.loadConfig(args[1:])
+	if err != nil {
+		return ExitCodeError
+	}
+
+	flags := flag.NewFlagSet(Name+" validate", flag.ContinueOnError)
+	flags.SetOutput(cli.errStream)
+
+	flags.String("config", "", "Config file path (default ~/"+config.FileName+")")
+
+	flags.StringVar(&directory, "directory", "", "Input directory path")
+	flags.StringVar(&directory, "d", "", "Input directory path(Short)")
+
+	flags.BoolVar(&recursive, "recursive", false, "Check subdirectories recursively")
+	flags.BoolVar(&recursive, "r", false, "Check subdirectories recursively(Short)")
+	flags.BoolVar(&hidden, "include-hidden", false, "Check files and directories starting with dot(e.g. .DS_Store, ._cat.jpg)")
+	flags.StringVar(&ext, "ext", conf.Ext, "Comma separated extensions of files to check(e.g. jpg,jpeg,png). All files by default")
+
+	flags.BoolVar(&verbose, "verbose", false, "Print valid images too")
+	flags.BoolVar(&verbose, "v", false, "Print valid images too(Short)")
+
+	flags.StringVar(&maskPath, "mask", conf.Mask, "Mask image path or embedded asset name. Overrides style")
+	flags.StringVar(&maskPath, "m", conf.Mask, "Mask image path or embedded asset name(Short)")
+
+	styles := strings.Join(mask_image.StyleNames(), ", ")
+	flags.StringVar(&style, "style", stringOr(conf.Style, mask_image.DefaultStyle), "Mask style("+styles+" or user mask name)")
+
+	flags.StringVar(&text, "text", conf.Text, "Render text instead of mask image")
+	flags.StringVar(&text, "t", conf.Text, "Render text instead of mask image(Short)")
+
+	flags.Float64Var(&maskScale, "mask-scale", floatOr(conf.MaskScale, lgtm.DefaultMaskScale), "Mask width ratio to source image width")
+	flags.IntVar(&minMaskWidth, "min-mask-width", DefaultMinMaskWidth, "Report images whose mask would be narrower than this in pixels")
+	flags.IntVar(&maxPixels, "max-pixels", intOr(conf.MaxPixels, lgtm.DefaultMaxPixels), "Report source images of more pixels(0 is unlimited)")
+
+	if err := flags.Parse(args[1:]); err != nil {
+		return ExitCodeError
+	}
+
+	if directory == "" {
+		cli.log.Errorf("input directory path is required.")
+		return ExitCodeError
+	}
+	if maskScale <= 0 || maskScale > 1 {
+		cli.log.Errorf("%s.", lgtm.ErrInvalidMaskScale)
+		return ExitCodeError
+	}
+	if verbose {
+		cli.log.Level = logger.LevelVerbose
+	}
+
+	mask, err := loadMask(maskPath, style, text, textOptions{})
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+	v := validation{
+		mask:         mask.MaskImage.Bounds().Size(),
+		maskScale:    maskScale,
+		minMaskWidth: minMaskWidth,
+		maxPixels:    maxPixels,
+	}
+
+	// load target files
+	exts := parseExtensions(ext)
+	directory = addDirectorySuffix(directory)
+	var paths []string
+	if recursive {
+		paths, err = mask.ReadImagePathsRecursive(directory)
+	} else {
+		paths, err = mask.ReadImagePaths(directory)
+	}
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+	sort.Strings(paths)
+
+	var total, invalid int
+	for _, path := range paths {
+		// hidden files such as .DS_Store are not images to check
+		if relativePath, err := filepath.Rel(directory, path); err == nil && !hidden && isHidden(relativePath) {
+			continue
+		}
+		if !exts.match(path) {
+			continue
+		}
+		total++
+		if err := v.check(path); err != nil {
+			invalid++
+			cli.log.Errorf("[%s] %s", err, path)
+			continue
+		}
+		cli.log.Verbosef("[valid] %s", path)
+	}
+	cli.log.Infof("%d valid, %d invalid", total-invalid, invalid)
+
+	return batchExitCode(invalid, total)
+}
+
+// Check image file by its header
+func (v validation) check(path string) error {
+	if !isImageFile(path) {
+		return errors.New("unsupported format")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	header, _, err := image.DecodeConfig(file)
+	switch {
+	case errors.Is(err, image.ErrFormat):
+		return errors.New("unsupported format")
+	case err != nil:
+		return fmt.Errorf("corrupt: %s", err)
+	}
+
+	return v.checkSize(header.Width, header.Height)
+}
+
+// Check mask fits in image of size
+// mask is scaled to width of image like lgtm does, so it overflows short image
+func (v validation) checkSize(width int, height int) error {
+	if v.maxPixels > 0 && int64(width)*int64(height) > int64(v.maxPixels) {
+		return fmt.Errorf("too many pixels: %dx%d exceeds %d pixels", width, height, v.maxPixels)
+	}
+
+	maskWidth := int(float64(width) * v.maskScale)
+	maskHeight := maskWidth * v.mask.Y / v.mask.X
+	if maskWidth < v.minMaskWidth {
+		return fmt.Errorf("too small: mask would be %dpx wide in %dx%d", maskWidth, width, height)
+	}
+	if maskHeight > height {
+		return fmt.Errorf("too small: mask of %dx%d overflows %dx%d", maskWidth, maskHeight, width, height)
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"context"
+	"errors"
+	"github.com/fsnotify/fsnotify"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/report"
+	"github.com/neko-neko/lgtmgen/uploader"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// WatchDelay is quiet time after last change of file before processing it
+// image writers often create file and write it in several events
+const WatchDelay = 500 * time.Millisecond
+
+// ErrSameDirectory is returned when outputs would overwrite watched inputs
+var ErrSameDirectory = errors.New("output directory must differ from input directory unless prefix, suffix or hash name is given")
+
+// watchOptions are options to process changed files in watch mode
+type watchOptions struct {
+	directory string
+	output    string
+	names     naming
+	recursive bool
+	hidden    bool
+	exts      extensions
+	sizes     *sizeFilter
+	skipDone  bool
+	dryRun    bool
+	jobs      int
+	opts      []lgtm.Option
+	uploader  uploader.Uploader
+	reporter  report.Reporter
+	limits    timeouts
+	cache     *cache
+	thumbs    int
+	compared  *comparison
+}
+
+// Watch input directory and process new or modified images until ctx is done
+// modified images overwrite their outputs
+func (cli *CLI) watch(ctx context.Context, w watchOptions) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	if err := addWatch(watcher, w.directory, w.recursive, w.hidden); err != nil {
+		return err
+	}
+
+	// outputs written into input directory must not be processed again
+	// outputs next to inputs are told by prefix and suffix
+	output, err := filepath.Abs(w.output)
+	if err != nil {
+		return err
+	}
+	sameDirectory := filepath.Clean(w.directory) == filepath.Clean(w.output)
+	if sameDirectory && w.names.prefix == "" && w.names.suffix == "" && w.names.hashLength == 0 {
+		return ErrSameDirectory
+	}
+
+	// debounce events of each file
+	var mu sync.Mutex
+	timers := map[string]*time.Timer{}
+	wg := &sync.WaitGroup{}
+	semaphore := make(chan struct{}, w.jobs)
+
+	// index of name template counts processed files
+	var index int32
+	process := func(filePath string) {
+		defer wg.Done()
+		semaphore <- struct{}{}
+		defer func() { <-semaphore }()
+
+		// header is read after file is written
+		if reason := w.sizes.check(filePath); reason != "" {
+			cli.log.Verbosef("[%s] %s", reason, filePath)
+			return
+		}
+
+		relativePath, err := filepath.Rel(w.directory, filePath)
+		if err == nil {
+			relativePath, err = w.names.filename(relativePath, filePath, int(atomic.AddInt32(&index, 1)))
+		}
+		if err != nil {
+			w.reporter.Report(&report.Result{Input: filePath, Status: report.StatusFailed, Error: err})
+			return
+		}
+		outputFilePath := w.output + relativePath
+
+		var result *report.Result
+		switch {
+		case w.skipDone && isProcessed(filePath):
+			result = &report.Result{Input: filePath, Status: report.StatusSkipped, Error: ErrAlreadyProcessed}
+		case w.dryRun:
+			result = cli.planFile(filePath, w.names.planned(outputFilePath), true)
+		default:
+			ctx, cancel := w.limits.file()
+			defer cancel()
+			result = cli.maskFile(ctx, filePath, outputFilePath, true, w.cache, w.opts)
+			w.names.renameResult(result)
+		}
+		cli.thumbnailResult(result, w.thumbs)
+		cli.compareResult(result, w.compared)
+		uploadResult(result, w.uploader)
+		w.reporter.Report(result)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return nil
+		case err := <-watcher.Errors:
+			return err
+		case event := <-watcher.Events:
+			if event.Op&(fsnotify.Create|fsnotify.Write) == 0 {
+				continue
+			}
+
+			// hidden files are often written by OS and editors(e.g. .DS_Store, .cat.jpg.swp)
+			relativePath, err := filepath.Rel(w.directory, event.Name)
+			if err == nil && !w.hidden && isHidden(relativePath) {
+				continue
+			}
+
+			// watch new subdirectory
+			if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+				if w.recursive {
+					if err := addWatch(watcher, event.Name, true, w.hidden); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			if !isImageFile(event.Name) || !w.exts.match(event.Name) {
+				continue
+			}
+			if sameDirectory && (w.names.isOutput(event.Name) || isThumbnail(event.Name) || isComparison(event.Name)) || !sameDirectory && isUnder(event.Name, output) {
+				continue
+			}
+
+			// restart pending timer, or start new one if it has fired
+			mu.Lock()
+			if timer, ok := timers[event.Name]; ok && timer.Stop() {
+				timer.Reset(WatchDelay)
+			} else {
+				filePath := event.Name
+				wg.Add(1)
+				timers[filePath] = time.AfterFunc(WatchDelay, func() { process(filePath) })
+			}
+			mu.Unlock()
+		}
+	}
+}
+
+// Add directory and its subdirectories if recursive to watcher
+// hidden subdirectories are added only if hidden is true
+func addWatch(watcher *fsnotify.Watcher, directory string, recursive bool, hidden bool) error {
+	if !recursive {
+		return watcher.Add(directory)
+	}
+
+	return filepath.WalkDir(directory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+		if !hidden && path != directory && isHidden(entry.Name()) {
+			return filepath.SkipDir
+		}
+		return watcher.Add(path)
+	})
+}
+
+// Path is in directory
+func isUnder(path string, directory string) bool {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	relativePath, err := filepath.Rel(directory, path)
+
+	return err == nil && relativePath != ".." && !strings.HasPrefix(relativePath, ".."+string(filepath.Separator))
+}
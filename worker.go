@@ -0,0 +1,142 @@
+/*
+The MIT License (MIT)
+Copyright (c) 2016 neko-neko.
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+The above copyright notice and this permission notice shall be included in
+all copies or substantial portions of the Software.
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+THE SOFTWARE.
+*/
+package main
+
+import (
+	"context"
+	"flag"
+	"github.com/neko-neko/lgtmgen/config"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/mask_image"
+	"github.com/neko-neko/lgtmgen/queue"
+	"github.com/neko-neko/lgtmgen/worker"
+	"log"
+	"os"
+	"os/signal"
+	"runtime"
+	"strings"
+	"syscall"
+)
+
+// Run queue consumer
+// e.g. lgtmgen worker --queue sqs://sqs.us-east-1.amazonaws.com/123456789012/lgtm-jobs
+func (cli *CLI) runWorker(args []string) int {
+	var (
+		location    string
+		events      string
+		destination string
+		concurrency int
+		maskPath    string
+		style       string
+		text        string
+		metricsAddr string
+	)
+
+	// load config file
+	conf, err := cli.loadConfig(args[1:])
+	if err != nil {
+		return ExitCodeError
+	}
+
+	// Define option flag parse
+	flags := flag.NewFlagSet(Name+" worker", flag.ContinueOnError)
+	flags.SetOutput(cli.errStream)
+
+	flags.String("config", "", "Config file path (default ~/"+config.FileName+")")
+
+	schemes := strings.Join(queue.Schemes(), ", ")
+	flags.StringVar(&location, "queue", os.Getenv("LGTMGEN_QUEUE"), "Queue URI("+schemes+", e.g. redis://localhost:6379/lgtm-jobs)")
+	flags.StringVar(&location, "q", os.Getenv("LGTMGEN_QUEUE"), "Queue URI(Short)")
+
+	flags.StringVar(&events, "events", os.Getenv("LGTMGEN_EVENTS"), "Queue URI to publish lgtm-ready events of generated images(e.g. nats://localhost:4222/lgtm.ready)")
+	flags.StringVar(&destination, "destination", os.Getenv("LGTMGEN_DESTINATION"), "Storage URI or directory of outputs of jobs without destination(e.g. s3://bucket/lgtms/)")
+
+	flags.IntVar(&concurrency, "concurrency", intOr(conf.Concurrency, runtime.NumCPU()), "Number of jobs processed concurrently")
+
+	flags.StringVar(&maskPath, "mask", conf.Mask, "Mask image path or embedded asset name. Overrides style")
+	flags.StringVar(&maskPath, "m", conf.Mask, "Mask image path or embedded asset name(Short)")
+
+	styles := strings.Join(mask_image.StyleNames(), ", ")
+	flags.StringVar(&style, "style", stringOr(conf.Style, mask_image.DefaultStyle), "Mask style("+styles+" or user mask name)")
+
+	flags.StringVar(&text, "text", conf.Text, "Render text instead of mask image")
+	flags.StringVar(&text, "t", conf.Text, "Render text instead of mask image(Short)")
+
+	flags.StringVar(&metricsAddr, "metrics-addr", "", "Listen address of /metrics(empty disables it)")
+
+	// Parse commandline flag
+	if err := flags.Parse(args[1:]); err != nil {
+		return ExitCodeError
+	}
+
+	if location == "" {
+		cli.log.Errorf("queue is required.")
+		return ExitCodeError
+	}
+	if concurrency < 1 {
+		cli.log.Errorf("concurrency must be greater than 0.")
+		return ExitCodeError
+	}
+
+	// load mask image
+	mask, err := loadMask(maskPath, style, text, textOptions{})
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+
+	opts := []lgtm.Option{
+		lgtm.WithMask(mask.MaskImage),
+	}
+
+	// stop receiving on interrupt, unfinished jobs are released to queue
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	q, err := queue.Open(ctx, location)
+	if err != nil {
+		cli.log.Errorf("fatal error %s.", err)
+		return ExitCodeError
+	}
+	defer q.Close()
+
+	w := worker.NewWorker(q, concurrency, opts, log.New(cli.errStream, "", log.LstdFlags))
+	// default destination is directory
+	if destination != "" && !strings.HasSuffix(destination, "/") {
+		destination += "/"
+	}
+	w.Destination = destination
+	if events != "" {
+		if w.Events, err = queue.Open(ctx, events); err != nil {
+			cli.log.Errorf("fatal error %s.", err)
+			return ExitCodeError
+		}
+		defer w.Events.Close()
+	}
+
+	if metricsAddr != "" {
+		go cli.serveMetrics(metricsAddr)
+	}
+
+	cli.log.Infof("consuming %s", location)
+	w.Run(ctx)
+
+	return ExitCodeOK
+}
@@ -0,0 +1,290 @@
+// Package worker generates LGTM images of jobs received from queue.
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/neko-neko/lgtmgen/fetcher"
+	"github.com/neko-neko/lgtmgen/lgtm"
+	"github.com/neko-neko/lgtmgen/queue"
+	"github.com/neko-neko/lgtmgen/server"
+	"github.com/neko-neko/lgtmgen/storage"
+	"io"
+	"log"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// retryInterval is wait after queue fails to receive
+const retryInterval = 5 * time.Second
+
+// EventReady is type of event published when image is generated
+const EventReady = "lgtm-ready"
+
+// releaseTimeout is time limit of returning message to queue on shutdown
+const releaseTimeout = 10 * time.Second
+
+// ErrForbiddenPath is returned when job has local path outside of default destination
+var ErrForbiddenPath = errors.New("local path outside of destination is not allowed")
+
+// Job is payload of queue message
+// e.g. {"source": "s3://bucket/images/cat.jpg", "destination": "s3://bucket/lgtms/", "options": {"text": "SHIP IT"}}
+// image-uploaded event which has only source is job of default destination
+type Job struct {
+	// Source is http(s) URL, storage URI or local path of image
+	Source string `json:"source"`
+
+	// Destination is storage URI or local path of output
+	// name of source is used when it ends with slash
+	Destination string `json:"destination,omitempty"`
+
+	Options server.RequestOptions `json:"options"`
+}
+
+// Event is payload of message published to events queue
+// e.g. {"type": "lgtm-ready", "source": "s3://bucket/images/cat.jpg", "location": "s3://bucket/lgtms/cat.jpg", "content_type": "image/jpeg"}
+type Event struct {
+	Type        string `json:"type"`
+	Source      string `json:"source"`
+	Location    string `json:"location"`
+	ContentType string `json:"content_type"`
+}
+
+// Worker generates jobs of queue concurrently
+type Worker struct {
+	Queue queue.Queue
+
+	// Events receives lgtm-ready events of generated images, nil publishes nothing
+	Events queue.Queue
+
+	// Destination is used for jobs without destination
+	// local paths of jobs must be under it if it is local directory
+	Destination string
+
+	// Options are used for every generation, job options are applied after them
+	Options []lgtm.Option
+
+	// Fetcher downloads http(s) sources
+	Fetcher *fetcher.Fetcher
+
+	// Concurrency is number of jobs processed at once
+	Concurrency int
+
+	// Logger reports processed and failed jobs
+	Logger *log.Logger
+}
+
+// constructor
+func NewWorker(q queue.Queue, concurrency int, opts []lgtm.Option, logger *log.Logger) *Worker {
+	return &Worker{
+		Queue:       q,
+		Options:     opts,
+		Fetcher:     fetcher.NewFetcher(),
+		Concurrency: concurrency,
+		Logger:      logger,
+	}
+}
+
+// Run workers until ctx is done
+// job being processed when ctx is done is released to queue
+func (w *Worker) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < w.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.consume(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+// Receive and process messages until ctx is done
+func (w *Worker) consume(ctx context.Context) {
+	for ctx.Err() == nil {
+		message, err := w.Queue.Receive(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				w.Logger.Printf("[%s] receive\n", err)
+				sleep(ctx, retryInterval)
+			}
+			continue
+		}
+		if message == nil {
+			continue
+		}
+
+		event, err := w.process(ctx, message.Body)
+		if ctx.Err() != nil {
+			w.release(message)
+			return
+		}
+		if err == nil {
+			err = w.publish(ctx, event)
+		}
+		if err != nil {
+			w.Logger.Printf("[%s] %s\n", err, message.Body)
+		} else {
+			w.Logger.Printf("[done] %s\n", event.Location)
+		}
+		if err := w.Queue.Done(ctx, message, err != nil); err != nil {
+			w.Logger.Printf("[%s] complete %s\n", err, message.Body)
+		}
+	}
+}
+
+// Generate image of job message and write it to destination
+// event of output is returned
+func (w *Worker) process(ctx context.Context, body []byte) (*Event, error) {
+	var job Job
+	if err := json.Unmarshal(body, &job); err != nil {
+		return nil, err
+	}
+	if job.Destination == "" {
+		job.Destination = w.Destination
+	}
+	if job.Source == "" || job.Destination == "" {
+		return nil, errors.New("source and destination are required")
+	}
+	for _, location := range []string{job.Source, job.Destination} {
+		if err := w.checkPath(location); err != nil {
+			return nil, err
+		}
+	}
+
+	input, err := w.read(ctx, job.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	// destination extension decides format unless options have it
+	location, name := splitURI(job.Destination)
+	if name == "" {
+		_, sourceName := splitURI(job.Source)
+		name = lgtm.OutputFilename(sourceName, job.Options.Format)
+	}
+	if job.Options.Format == "" {
+		if _, err := lgtm.FormatFromFilename(name); err == nil {
+			job.Options.Format = strings.TrimPrefix(path.Ext(name), ".")
+		}
+	}
+
+	output, format, err := server.Generate(ctx, "worker", input, w.Options, job.Options)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := storage.Open(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+	// connections of storage, e.g. SSH of sftp, are not shared between jobs
+	if closer, ok := destination.(io.Closer); ok {
+		defer closer.Close()
+	}
+	if err := destination.Write(ctx, name, output, lgtm.ContentType(format)); err != nil {
+		return nil, err
+	}
+
+	return &Event{Type: EventReady, Source: job.Source, Location: destination.URI(name), ContentType: lgtm.ContentType(format)}, nil
+}
+
+// Return message to queue so another worker receives it
+// ctx of worker is already done, so message is released within releaseTimeout
+func (w *Worker) release(message *queue.Message) {
+	ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancel()
+	if err := w.Queue.Release(ctx, message); err != nil {
+		w.Logger.Printf("[%s] release %s\n", err, message.Body)
+	}
+}
+
+// Check location of job is URL, storage URI or local path under local default destination
+// jobs are given by anyone who can send messages, so they can not read or write other files
+func (w *Worker) checkPath(location string) error {
+	if fetcher.IsURL(location) || storage.IsRemote(location) {
+		return nil
+	}
+	if w.Destination == "" || storage.IsRemote(w.Destination) {
+		return fmt.Errorf("%w: %s", ErrForbiddenPath, location)
+	}
+	root, err := filepath.Abs(w.Destination)
+	if err != nil {
+		return err
+	}
+	target, err := filepath.Abs(location)
+	if err != nil {
+		return err
+	}
+	relative, err := filepath.Rel(root, target)
+	if err != nil || relative == ".." || strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("%w: %s", ErrForbiddenPath, location)
+	}
+
+	return nil
+}
+
+// Publish event to events queue
+func (w *Worker) publish(ctx context.Context, event *Event) error {
+	if w.Events == nil {
+		return nil
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return w.Events.Publish(ctx, body)
+}
+
+// Read source image of URL, storage URI or local path
+func (w *Worker) read(ctx context.Context, source string) ([]byte, error) {
+	if fetcher.IsURL(source) {
+		return w.Fetcher.Fetch(source)
+	}
+
+	location, name := splitURI(source)
+	if name == "" {
+		return nil, fmt.Errorf("source %s is not a file", source)
+	}
+	s, err := storage.Open(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+	if closer, ok := s.(io.Closer); ok {
+		defer closer.Close()
+	}
+
+	return s.Read(ctx, name)
+}
+
+// Split URI or path into its directory and name
+// e.g.
+// "s3://bucket/images/cat.jpg" => "s3://bucket/images/", "cat.jpg"
+// "s3://bucket/lgtms/"         => "s3://bucket/lgtms/", ""
+// "cat.jpg"                    => ".", "cat.jpg"
+func splitURI(uri string) (string, string) {
+	i := strings.LastIndex(uri, "/")
+	if i < 0 {
+		return ".", uri
+	}
+	if strings.HasSuffix(uri[:i+1], "://") {
+		return uri + "/", ""
+	}
+
+	return uri[:i+1], uri[i+1:]
+}
+
+// Wait duration or until ctx is done
+func sleep(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}